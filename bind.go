@@ -0,0 +1,451 @@
+package n
+
+import (
+	"encoding"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/phR0ze/n/pkg/schema"
+	"github.com/pkg/errors"
+)
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	timeType            = reflect.TypeOf(time.Time{})
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	pathIdentExp        = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`)
+)
+
+// bindField describes how a struct field maps to a key in a StringMap
+type bindField struct {
+	name      string // key name in the map
+	index     []int  // field index sequence including embedded structs
+	omitEmpty bool   // skip zero values when encoding
+	def       string // default value to use when the key is missing
+	hasDef    bool   // true if a default tag was given
+}
+
+// Encode converts the given struct or map into a *StringMap using the n, yaml or json field tags
+// in that order of precedence to name the keys. Nested structs and maps become nested maps, slices
+// become []interface{}, time.Duration values become duration strings e.g. 1m30s and time.Time
+// values become RFC3339 strings such that Decode can convert them back.
+func Encode(obj interface{}) (m *StringMap) {
+	m, _ = EncodeE(obj)
+	return
+}
+
+// EncodeE converts the given struct or map into a *StringMap using the n, yaml or json field tags
+// in that order of precedence to name the keys. Nested structs and maps become nested maps, slices
+// become []interface{}, time.Duration values become duration strings e.g. 1m30s and time.Time
+// values become RFC3339 strings such that Decode can convert them back. All fields that failed to
+// encode are reported in the returned schema.Errors with their jq style paths.
+func EncodeE(obj interface{}) (m *StringMap, err error) {
+	m = NewStringMapV()
+
+	var errs schema.Errors
+	val := encodeValue(&errs, ".", reflect.ValueOf(obj))
+	if len(errs) > 0 {
+		err = errs
+	}
+	switch x := val.(type) {
+	case nil:
+	case map[string]interface{}:
+		*m = StringMap(x)
+	default:
+		err = errors.Errorf("Encode requires a struct or map not %T", obj)
+	}
+	return
+}
+
+// decodeValue converts the given value into the target value appending any errors
+func decodeValue(errs *schema.Errors, path string, val interface{}, v reflect.Value) {
+	fail := func(format string, args ...interface{}) {
+		*errs = append(*errs, &schema.Error{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+	if x, ok := val.(interface{ O() interface{} }); ok {
+		val = x.O()
+	}
+
+	// Explicit nulls reset the target
+	if val == nil {
+		v.Set(reflect.Zero(v.Type()))
+		return
+	}
+
+	// Special types
+	switch {
+	case v.Type() == durationType:
+		if x, err := ToDurationE(val); err != nil {
+			fail("%v", err)
+		} else {
+			v.SetInt(int64(x))
+		}
+		return
+	case v.Type() == timeType:
+		if x, err := ToTimeE(val); err != nil {
+			fail("%v", err)
+		} else {
+			v.Set(reflect.ValueOf(x))
+		}
+		return
+	case v.Kind() != reflect.Ptr && v.CanAddr() && v.Addr().Type().Implements(textUnmarshalerType):
+		if x, ok := val.(string); ok {
+			if err := v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(x)); err != nil {
+				fail("%v", err)
+			}
+			return
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		decodeValue(errs, path, val, v.Elem())
+
+	case reflect.Interface:
+		rv := reflect.ValueOf(val)
+		if !rv.Type().AssignableTo(v.Type()) {
+			fail("failed to convert type %T to %v", val, v.Type())
+			return
+		}
+		v.Set(rv)
+
+	case reflect.Bool:
+		if x, err := ToBoolE(val); err != nil {
+			fail("%v", err)
+		} else {
+			v.SetBool(x)
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if f, ok := val.(float64); ok && (f >= math.MaxInt64 || f < math.MinInt64) {
+			fail("value %v overflows %v", f, v.Type())
+		} else if x, err := ToInt64E(val); err != nil {
+			fail("%v", err)
+		} else if v.OverflowInt(x) {
+			fail("value %d overflows %v", x, v.Type())
+		} else {
+			v.SetInt(x)
+		}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if f, err := ToFloat64E(val); err == nil && (f < 0 || f > math.MaxUint64) {
+			fail("value %v overflows %v", f, v.Type())
+		} else if x, err := ToUint64E(val); err != nil {
+			fail("%v", err)
+		} else if v.OverflowUint(x) {
+			fail("value %d overflows %v", x, v.Type())
+		} else {
+			v.SetUint(x)
+		}
+
+	case reflect.Float32, reflect.Float64:
+		if x, err := ToFloat64E(val); err != nil {
+			fail("%v", err)
+		} else if v.OverflowFloat(x) {
+			fail("value %v overflows %v", x, v.Type())
+		} else {
+			v.SetFloat(x)
+		}
+
+	case reflect.String:
+		switch reflect.ValueOf(val).Kind() {
+		case reflect.Map, reflect.Slice, reflect.Array, reflect.Struct:
+			fail("failed to convert type %T to string", val)
+		default:
+			v.SetString(ToString(val))
+		}
+
+	case reflect.Struct:
+		m, ok := val.(map[string]interface{})
+		if !ok {
+			fail("failed to convert type %T to %v", val, v.Type())
+			return
+		}
+		decodeStruct(errs, path, m, v)
+
+	case reflect.Slice, reflect.Array:
+		rv := reflect.ValueOf(val)
+		if v.Type().Elem().Kind() == reflect.Uint8 && rv.Kind() == reflect.String {
+			if v.Kind() == reflect.Slice {
+				v.SetBytes([]byte(rv.String()))
+			} else {
+				reflect.Copy(v, reflect.ValueOf([]byte(rv.String())))
+			}
+			return
+		}
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			fail("failed to convert type %T to %v", val, v.Type())
+			return
+		}
+		l := rv.Len()
+		if v.Kind() == reflect.Array {
+			if l > v.Len() {
+				fail("expected at most %d items but got %d", v.Len(), l)
+				l = v.Len()
+			}
+		} else {
+			v.Set(reflect.MakeSlice(v.Type(), l, l))
+		}
+		for i := 0; i < l; i++ {
+			decodeValue(errs, indexPath(path, i), rv.Index(i).Interface(), v.Index(i))
+		}
+
+	case reflect.Map:
+		rv := reflect.ValueOf(val)
+		if rv.Kind() != reflect.Map {
+			fail("failed to convert type %T to %v", val, v.Type())
+			return
+		}
+		if v.IsNil() {
+			v.Set(reflect.MakeMap(v.Type()))
+		}
+		keys := rv.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return ToString(keys[i].Interface()) < ToString(keys[j].Interface()) })
+		for _, key := range keys {
+			k := ToString(key.Interface())
+			kv := reflect.New(v.Type().Key()).Elem()
+			n := len(*errs)
+			decodeValue(errs, keyPath(path, k), k, kv)
+			if len(*errs) > n {
+				continue
+			}
+			ev := reflect.New(v.Type().Elem()).Elem()
+			decodeValue(errs, keyPath(path, k), rv.MapIndex(key).Interface(), ev)
+			if len(*errs) > n {
+				continue
+			}
+			v.SetMapIndex(kv, ev)
+		}
+
+	default:
+		fail("unsupported type %v", v.Type())
+	}
+}
+
+// decodeStruct populates the fields of the given struct value from the given map
+func decodeStruct(errs *schema.Errors, path string, m map[string]interface{}, v reflect.Value) {
+	for _, field := range bindFields(v.Type()) {
+		fv := fieldByIndex(v, field.index)
+		fpath := keyPath(path, field.name)
+
+		if val, ok := lookupKey(m, field.name); ok {
+			decodeValue(errs, fpath, val, fv)
+			continue
+		}
+
+		// Apply defaults for missing keys including those of nested structs
+		switch {
+		case field.hasDef && fv.IsZero():
+			var val interface{} = field.def
+			if fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() != reflect.Uint8 {
+				items := []interface{}{}
+				for _, item := range strings.Split(field.def, ",") {
+					items = append(items, strings.TrimSpace(item))
+				}
+				val = items
+			}
+			decodeValue(errs, fpath, val, fv)
+		case fv.Kind() == reflect.Struct && fv.Type() != timeType:
+			decodeStruct(errs, fpath, map[string]interface{}{}, fv)
+		}
+	}
+}
+
+// keyPath returns the jq style path to the given key of the object at path e.g. .foo.bar
+// quoting keys that are not simple identifiers e.g. ."foo bar"
+func keyPath(path, key string) string {
+	if path == "." {
+		path = ""
+	}
+	if pathIdentExp.MatchString(key) {
+		return path + "." + key
+	}
+	return fmt.Sprintf("%s.%q", path, key)
+}
+
+// indexPath returns the jq style path to the given index of the array at path e.g. .foo.[0]
+func indexPath(path string, i int) string {
+	if path == "." {
+		path = ""
+	}
+	return fmt.Sprintf("%s.[%d]", path, i)
+}
+
+// lookupKey returns the value for the given key falling back on a case insensitive match
+func lookupKey(m map[string]interface{}, key string) (val interface{}, ok bool) {
+	if val, ok = m[key]; ok {
+		return
+	}
+	for k, v := range m {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+	return
+}
+
+// fieldByIndex returns the field at the given index sequence allocating nil embedded pointers
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
+
+// encodeValue converts the given value into generic map, slice and scalar types appending any errors
+func encodeValue(errs *schema.Errors, path string, v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+
+	// Special types
+	switch {
+	case v.Type() == durationType:
+		return time.Duration(v.Int()).String()
+	case v.Type() == timeType:
+		return v.Interface().(time.Time).Format(time.RFC3339Nano)
+	case v.Kind() != reflect.Ptr && v.Type().Implements(textMarshalerType):
+		data, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			*errs = append(*errs, &schema.Error{Path: path, Message: err.Error()})
+			return nil
+		}
+		return string(data)
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return encodeValue(errs, path, v.Elem())
+
+	case reflect.Struct:
+		m := map[string]interface{}{}
+		for _, field := range bindFields(v.Type()) {
+			fv, ok := fieldByIndexE(v, field.index)
+			if !ok || (field.omitEmpty && fv.IsZero()) {
+				continue
+			}
+			m[field.name] = encodeValue(errs, keyPath(path, field.name), fv)
+		}
+		return m
+
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return string(reflect.ValueOf(v.Interface()).Bytes())
+		}
+		s := make([]interface{}, v.Len())
+		for i := range s {
+			s[i] = encodeValue(errs, indexPath(path, i), v.Index(i))
+		}
+		return s
+
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		m := map[string]interface{}{}
+		for _, key := range v.MapKeys() {
+			k := ToString(encodeValue(errs, path, key))
+			m[k] = encodeValue(errs, keyPath(path, k), v.MapIndex(key))
+		}
+		return m
+
+	case reflect.Bool, reflect.String, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Interface()
+	}
+
+	*errs = append(*errs, &schema.Error{Path: path, Message: fmt.Sprintf("unsupported type %v", v.Type())})
+	return nil
+}
+
+// fieldByIndexE returns the field at the given index sequence or false if an embedded pointer is nil
+func fieldByIndexE(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return v, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+// bindFields returns the bindable fields of the given struct type flattening embedded structs
+// that have no key name in their tags or use the inline option.
+func bindFields(typ reflect.Type) (fields []bindField) {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" && (!field.Anonymous || field.Type.Kind() == reflect.Ptr) {
+			continue
+		}
+
+		// Parse the tag of highest precedence
+		name, opts := "", []string{}
+		for _, key := range []string{"n", "yaml", "json"} {
+			if tag, ok := field.Tag.Lookup(key); ok {
+				parts := strings.Split(tag, ",")
+				name, opts = parts[0], parts[1:]
+				break
+			}
+		}
+		if name == "-" {
+			continue
+		}
+		f := bindField{name: name, index: []int{i}}
+		f.def, f.hasDef = field.Tag.Lookup("default")
+		inline := false
+		for _, opt := range opts {
+			switch opt {
+			case "omitempty":
+				f.omitEmpty = true
+			case "inline":
+				inline = true
+			}
+		}
+
+		// Flatten embedded structs
+		ftyp := field.Type
+		if ftyp.Kind() == reflect.Ptr {
+			ftyp = ftyp.Elem()
+		}
+		if ftyp.Kind() == reflect.Struct && (inline || (field.Anonymous && name == "")) {
+			for _, sub := range bindFields(ftyp) {
+				sub.index = append([]int{i}, sub.index...)
+				fields = append(fields, sub)
+			}
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if f.name == "" {
+			f.name = field.Name
+		}
+		fields = append(fields, f)
+	}
+	return
+}
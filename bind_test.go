@@ -0,0 +1,115 @@
+package n
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type bindBase struct {
+	Version int `yaml:"version"`
+}
+
+type bindServer struct {
+	Host    string        `json:"host"`
+	Port    int           `json:"port" default:"8080"`
+	Timeout time.Duration `json:"timeout" default:"30s"`
+	IP      net.IP        `json:"ip,omitempty"`
+}
+
+type bindConfig struct {
+	bindBase
+	Name     string                 `n:"name" yaml:"ignored"`
+	Debug    bool                   `yaml:"debug,omitempty"`
+	Servers  []bindServer           `yaml:"servers"`
+	Primary  *bindServer            `yaml:"primary,omitempty"`
+	Labels   map[string]string      `yaml:"labels,omitempty"`
+	Limits   map[string]float64     `yaml:"limits,omitempty"`
+	Tags     []string               `yaml:"tags" default:"a, b"`
+	Created  time.Time              `yaml:"created"`
+	Extra    map[string]interface{} `yaml:"extra,omitempty"`
+	Skipped  string                 `yaml:"-"`
+	Untagged string
+	private  string
+}
+
+// Encode
+//--------------------------------------------------------------------------------------------------
+func ExampleEncode() {
+	type Server struct {
+		Host    string        `yaml:"host"`
+		Timeout time.Duration `yaml:"timeout"`
+	}
+	fmt.Println(Encode(Server{Host: "localhost", Timeout: time.Minute}))
+	// Output: &map[host:localhost timeout:1m0s]
+}
+
+func TestEncode(t *testing.T) {
+
+	// nil and invalid
+	{
+		assert.Equal(t, NewStringMapV(), Encode(nil))
+		assert.Equal(t, NewStringMapV(), Encode((*bindConfig)(nil)))
+
+		m, err := EncodeE(1)
+		assert.Equal(t, NewStringMapV(), m)
+		assert.Equal(t, "Encode requires a struct or map not int", err.Error())
+	}
+
+	// struct
+	{
+		created := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+		cfg := &bindConfig{
+			bindBase: bindBase{Version: 2},
+			Name:     "foo",
+			Servers: []bindServer{
+				{Host: "localhost", Port: 80, Timeout: 5 * time.Second, IP: net.ParseIP("127.0.0.1")},
+			},
+			Labels:   map[string]string{"env": "prod"},
+			Created:  created,
+			Skipped:  "skipped",
+			Untagged: "untagged",
+			private:  "private",
+		}
+		m, err := EncodeE(cfg)
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"version": 2,
+			"name":    "foo",
+			"servers": []interface{}{
+				map[string]interface{}{"host": "localhost", "port": 80, "timeout": "5s", "ip": "127.0.0.1"},
+			},
+			"labels":   map[string]interface{}{"env": "prod"},
+			"tags":     nil,
+			"created":  "2020-01-02T03:04:05Z",
+			"Untagged": "untagged",
+		}, m.G())
+
+		// round trip
+		result := bindConfig{}
+		assert.Nil(t, m.Decode(&result))
+		cfg.Skipped, cfg.private = "", ""
+		assert.Equal(t, *cfg, result)
+	}
+
+	// map
+	{
+		m, err := EncodeE(map[int][]time.Duration{1: {time.Second}})
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"1": []interface{}{"1s"}}, m.G())
+	}
+
+	// unsupported types
+	{
+		m, err := EncodeE(struct {
+			Name string
+			Ch   chan int
+			Fns  []func()
+		}{Name: "foo", Ch: make(chan int), Fns: []func(){func() {}}})
+		assert.Equal(t, ".Ch: unsupported type chan int\n.Fns.[0]: unsupported type func()", err.Error())
+		assert.Equal(t, "foo", m.Get("Name").A())
+	}
+}
@@ -0,0 +1,18 @@
+// Package example demonstrates a nubgen generated Slice implementation for a user defined type.
+package example
+
+//go:generate go run .. -type=Package -test -samples=[]Package{{"a",1},{"b",2}}
+
+// Package is an example user defined type
+type Package struct {
+	Name    string
+	Version int
+}
+
+// Less orders packages by name then version
+func (p Package) Less(other Package) bool {
+	if p.Name != other.Name {
+		return p.Name < other.Name
+	}
+	return p.Version < other.Version
+}
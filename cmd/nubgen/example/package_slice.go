@@ -0,0 +1,1254 @@
+// Code generated by "nubgen -type=Package -test -samples=[]Package{{"a",1},{"b",2}}"; DO NOT EDIT.
+
+package example
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	nub "github.com/phR0ze/n"
+	"github.com/pkg/errors"
+)
+
+// PackageSlice implements the Slice interface providing a generic way to work with slice types
+// including convenience methods on par with rapid development languages.
+type PackageSlice []Package
+
+// NewPackageSlice creates a new *PackageSlice
+func NewPackageSlice(slice interface{}) *PackageSlice {
+	return ToPackageSlice(slice)
+}
+
+// NewPackageSliceV creates a new *PackageSlice from the given variadic elements. Always returns
+// at least a reference to an empty PackageSlice.
+func NewPackageSliceV(elems ...interface{}) *PackageSlice {
+	return ToPackageSlice(elems)
+}
+
+// A is an alias to String for brevity
+func (p *PackageSlice) A() string {
+	return p.String()
+}
+
+// All tests if this Slice is not empty or optionally if it contains
+// all of the given variadic elements. Incompatible types will return false.
+// Supports all possible Package conversions
+func (p *PackageSlice) All(elems ...interface{}) bool {
+	if p == nil || len(*p) == 0 {
+		return false
+	}
+
+	// Not looking for anything
+	if len(elems) == 0 {
+		return true
+	}
+
+	// Looking for something specific returns false if incompatible type
+	return p.AllS(elems)
+}
+
+// AllS tests if this Slice contains all of the given Slice's elements.
+// Incompatible types will return false.
+// Supports PackageSlice, *PackageSlice, []Package or *[]Package
+func (p *PackageSlice) AllS(slice interface{}) bool {
+	if p == nil || len(*p) == 0 {
+		return false
+	}
+	if elems, err := ToPackageSliceE(slice); err == nil {
+		for i := range *elems {
+			found := false
+			for j := range *p {
+				if (*p)[j] == (*elems)[i] {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	} else {
+		return false
+	}
+	return true
+}
+
+// Any tests if this Slice is not empty or optionally if it contains
+// any of the given variadic elements. Incompatible types will return false.
+// Supports all possible Package conversions
+func (p *PackageSlice) Any(elems ...interface{}) bool {
+	if p == nil || len(*p) == 0 {
+		return false
+	}
+
+	// Not looking for anything
+	if len(elems) == 0 {
+		return true
+	}
+
+	// Looking for something specific returns false if incompatible type
+	return p.AnyS(elems)
+}
+
+// AnyS tests if this Slice contains any of the given Slice's elements.
+// Incompatible types will return false.
+// Supports PackageSlice, *PackageSlice, []Package or *[]Package
+func (p *PackageSlice) AnyS(slice interface{}) bool {
+	if p == nil || len(*p) == 0 {
+		return false
+	}
+	if elems, err := ToPackageSliceE(slice); err == nil {
+		for i := range *elems {
+			for j := range *p {
+				if (*p)[j] == (*elems)[i] {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// AnyW tests if this Slice contains any that match the lambda selector.
+func (p *PackageSlice) AnyW(sel func(nub.O) bool) bool {
+	return p.CountW(sel) != 0
+}
+
+// Append an element to the end of this Slice and returns a reference to this Slice.
+func (p *PackageSlice) Append(elem interface{}) nub.ISlice {
+	if p == nil {
+		p = NewPackageSliceV()
+	}
+	if x, err := toPackageE(elem); err == nil {
+		*p = append(*p, x)
+	}
+	return p
+}
+
+// AppendV appends the variadic elements to the end of this Slice and returns a reference to this Slice.
+func (p *PackageSlice) AppendV(elems ...interface{}) nub.ISlice {
+	if p == nil {
+		p = NewPackageSliceV()
+	}
+	for _, elem := range elems {
+		if x, err := toPackageE(elem); err == nil {
+			*p = append(*p, x)
+		}
+	}
+	return p
+}
+
+// At returns the element at the given index location. Allows for negative notation.
+func (p *PackageSlice) At(i int) (elem *nub.Object) {
+	elem = &nub.Object{}
+	if p == nil {
+		return
+	}
+	if i = nubAbsIndex(len(*p), i); i == -1 {
+		return
+	}
+	elem = nub.Obj((*p)[i])
+	return
+}
+
+// BinarySearch searches this already sorted Slice for the given element returning its index and
+// true if found or the index it would be inserted at and false if not.
+func (p *PackageSlice) BinarySearch(elem interface{}) (i int, found bool) {
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	x, err := toPackageE(elem)
+	if err != nil {
+		return
+	}
+	i = sort.Search(len(*p), func(j int) bool { return !((*p)[j].Less(x)) })
+	found = i < len(*p) && (*p)[i] == x
+	return
+}
+
+// Chunk creates a new slice of Slices each holding n elements copied from this Slice in order.
+// The last chunk will hold the remaining elements if the length isn't evenly divisible by n.
+// An empty slice is returned if n is less than 1.
+func (p *PackageSlice) Chunk(n int) (chunks nub.ISlice) {
+	x := []interface{}{}
+	if p == nil || len(*p) == 0 || n < 1 {
+		return nub.NewInterSliceV(x...)
+	}
+	for i := 0; i < len(*p); i += n {
+		j := i + n
+		if j > len(*p) {
+			j = len(*p)
+		}
+		x = append(x, p.Copy(i, j-1))
+	}
+	return nub.NewInterSliceV(x...)
+}
+
+// Clear modifies this Slice to clear out all elements and returns a reference to this Slice.
+func (p *PackageSlice) Clear() nub.ISlice {
+	if p == nil {
+		p = NewPackageSliceV()
+	} else {
+		p.Drop()
+	}
+	return p
+}
+
+// Concat returns a new Slice by appending the given Slice to this Slice using variadic expansion.
+// Supports PackageSlice, *PackageSlice, []Package or *[]Package
+func (p *PackageSlice) Concat(slice interface{}) (new nub.ISlice) {
+	return p.Copy().ConcatM(slice)
+}
+
+// ConcatM modifies this Slice by appending the given Slice using variadic expansion and returns a reference to this Slice.
+// Supports PackageSlice, *PackageSlice, []Package or *[]Package
+func (p *PackageSlice) ConcatM(slice interface{}) nub.ISlice {
+	if p == nil {
+		p = NewPackageSliceV()
+	}
+	if elems, err := ToPackageSliceE(slice); err == nil {
+		*p = append(*p, *elems...)
+	}
+	return p
+}
+
+// Copy returns a new Slice with the indicated range of elements copied from this Slice.
+// Expects nothing, in which case everything is copied, or two indices i and j, in which
+// case positive and negative notation is supported and uses an inclusive behavior such
+// that Slice(0, -1) includes index -1 as opposed to Go's exclusive behavior. Out of
+// bounds indices will be moved within bounds.
+//
+// An empty Slice is returned if indicies are mutually exclusive or nothing can be returned.
+func (p *PackageSlice) Copy(indices ...int) (new nub.ISlice) {
+	if p == nil || len(*p) == 0 {
+		return NewPackageSliceV()
+	}
+
+	// Handle index manipulation
+	i, j, err := nubAbsIndices(len(*p), indices...)
+	if err != nil {
+		return NewPackageSliceV()
+	}
+
+	// Copy elements over to new Slice
+	x := make([]Package, j-i, j-i)
+	copy(x, (*p)[i:j])
+	return NewPackageSlice(x)
+}
+
+// Count the number of elements in this Slice equal to the given element.
+func (p *PackageSlice) Count(elem interface{}) (cnt int) {
+	if y, ok := elem.(Package); ok {
+		cnt = p.CountW(func(x nub.O) bool { return nub.ExB(x.(Package) == y) })
+	}
+	return
+}
+
+// CountW counts the number of elements in this Slice that match the lambda selector.
+func (p *PackageSlice) CountW(sel func(nub.O) bool) (cnt int) {
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	for i := range *p {
+		if sel((*p)[i]) {
+			cnt++
+		}
+	}
+	return
+}
+
+// Difference returns a new Slice with the uniq elements of this Slice that are not in the given Slice
+// while preserving order.
+// Supports PackageSlice, *PackageSlice, []Package or *[]Package
+func (p *PackageSlice) Difference(slice interface{}) (new nub.ISlice) {
+	other := ToPackageSlice(slice)
+	set, uniq := nub.NewSet(other), nub.NewSet(p)
+	return p.Select(func(x nub.O) bool { return !set.Contains(x) && uniq.Remove(x) })
+}
+
+// Disjoint checks if this Slice has no elements in common with the given Slice.
+// Supports PackageSlice, *PackageSlice, []Package or *[]Package
+func (p *PackageSlice) Disjoint(slice interface{}) bool {
+	other := ToPackageSlice(slice)
+	return nub.NewSet(p).Disjoint(other)
+}
+
+// Drop modifies this Slice to delete the indicated range of elements and returns a referece to this Slice.
+// Expects nothing, in which case everything is dropped, or two indices i and j, in which case positive and
+// negative notation is supported and uses an inclusive behavior such that DropAt(0, -1) includes index -1
+// as opposed to Go's exclusive behavior. Out of bounds indices will be moved within bounds.
+func (p *PackageSlice) Drop(indices ...int) nub.ISlice {
+	if p == nil || len(*p) == 0 {
+		return p
+	}
+
+	// Handle index manipulation
+	i, j, err := nubAbsIndices(len(*p), indices...)
+	if err != nil {
+		return p
+	}
+
+	// Execute
+	n := j - i
+	if i+n < len(*p) {
+		*p = append((*p)[:i], (*p)[i+n:]...)
+	} else {
+		*p = (*p)[:i]
+	}
+	return p
+}
+
+// DropAt modifies this Slice to delete the element at the given index location. Allows for negative notation.
+// Returns a reference to this Slice.
+func (p *PackageSlice) DropAt(i int) nub.ISlice {
+	return p.Drop(i, i)
+}
+
+// DropFirst modifies this Slice to delete the first element and returns a reference to this Slice.
+func (p *PackageSlice) DropFirst() nub.ISlice {
+	return p.Drop(0, 0)
+}
+
+// DropFirstN modifies this Slice to delete the first n elements and returns a reference to this Slice.
+func (p *PackageSlice) DropFirstN(n int) nub.ISlice {
+	if n == 0 {
+		return p
+	}
+	return p.Drop(0, nubAbs(n)-1)
+}
+
+// DropLast modifies this Slice to delete the last element and returns a reference to this Slice.
+func (p *PackageSlice) DropLast() nub.ISlice {
+	return p.Drop(-1, -1)
+}
+
+// DropLastN modifies thi Slice to delete the last n elements and returns a reference to this Slice.
+func (p *PackageSlice) DropLastN(n int) nub.ISlice {
+	if n == 0 {
+		return p
+	}
+	return p.Drop(nubAbsNeg(n), -1)
+}
+
+// DropW modifies this Slice to delete the elements that match the lambda selector and returns a reference to this Slice.
+// The slice is updated instantly when lambda expression is evaluated not after DropW completes.
+func (p *PackageSlice) DropW(sel func(nub.O) bool) nub.ISlice {
+	if p == nil || len(*p) == 0 {
+		return p
+	}
+	l := len(*p)
+	for i := 0; i < l; i++ {
+		if sel((*p)[i]) {
+			p.DropAt(i)
+			l--
+			i--
+		}
+	}
+	return p
+}
+
+// Each calls the given lambda once for each element in this Slice, passing in that element
+// as a parameter. Returns a reference to this Slice
+func (p *PackageSlice) Each(action func(nub.O)) nub.ISlice {
+	if p == nil {
+		return p
+	}
+	for i := range *p {
+		action((*p)[i])
+	}
+	return p
+}
+
+// EachE calls the given lambda once for each element in this Slice, passing in that element
+// as a parameter. Returns a reference to this Slice and any error from the lambda.
+func (p *PackageSlice) EachE(action func(nub.O) error) (nub.ISlice, error) {
+	var err error
+	if p == nil {
+		return p, err
+	}
+	for i := range *p {
+		if err = action((*p)[i]); err != nil {
+			return p, err
+		}
+	}
+	return p, err
+}
+
+// EachI calls the given lambda once for each element in this Slice, passing in the index and element
+// as a parameter. Returns a reference to this Slice
+func (p *PackageSlice) EachI(action func(int, nub.O)) nub.ISlice {
+	if p == nil {
+		return p
+	}
+	for i := range *p {
+		action(i, (*p)[i])
+	}
+	return p
+}
+
+// EachIE calls the given lambda once for each element in this Slice, passing in the index and element
+// as a parameter. Returns a reference to this Slice and any error from the lambda.
+func (p *PackageSlice) EachIE(action func(int, nub.O) error) (nub.ISlice, error) {
+	var err error
+	if p == nil {
+		return p, err
+	}
+	for i := range *p {
+		if err = action(i, (*p)[i]); err != nil {
+			return p, err
+		}
+	}
+	return p, err
+}
+
+// EachP calls the given lambda once for each element in this Slice, passing in the context and
+// element as parameters, fanning the calls out to the given number of workers or runtime.NumCPU()
+// workers if less than 1. The first error from the lambda or the given context cancels
+// the context passed to the lambda and skips the remaining elements. Returns a reference to this
+// Slice and the first error.
+func (p *PackageSlice) EachP(ctx context.Context, workers int, action func(context.Context, nub.O) error) (nub.ISlice, error) {
+	if p == nil {
+		return p, nil
+	}
+	err := nubEachP(ctx, len(*p), workers, func(ctx context.Context, i int) error {
+		return action(ctx, (*p)[i])
+	})
+	return p, err
+}
+
+// EachR calls the given lambda once for each element in this Slice in reverse, passing in that element
+// as a parameter. Returns a reference to this Slice
+func (p *PackageSlice) EachR(action func(nub.O)) nub.ISlice {
+	if p == nil {
+		return p
+	}
+	for i := len(*p) - 1; i >= 0; i-- {
+		action((*p)[i])
+	}
+	return p
+}
+
+// EachRE calls the given lambda once for each element in this Slice in reverse, passing in that element
+// as a parameter. Returns a reference to this Slice and any error from the lambda.
+func (p *PackageSlice) EachRE(action func(nub.O) error) (nub.ISlice, error) {
+	var err error
+	if p == nil {
+		return p, err
+	}
+	for i := len(*p) - 1; i >= 0; i-- {
+		if err = action((*p)[i]); err != nil {
+			return p, err
+		}
+	}
+	return p, err
+}
+
+// EachRI calls the given lambda once for each element in this Slice in reverse, passing in that element
+// as a parameter. Returns a reference to this Slice
+func (p *PackageSlice) EachRI(action func(int, nub.O)) nub.ISlice {
+	if p == nil {
+		return p
+	}
+	for i := len(*p) - 1; i >= 0; i-- {
+		action(i, (*p)[i])
+	}
+	return p
+}
+
+// EachRIE calls the given lambda once for each element in this Slice in reverse, passing in that element
+// as a parameter. Returns a reference to this Slice and any error from the lambda.
+func (p *PackageSlice) EachRIE(action func(int, nub.O) error) (nub.ISlice, error) {
+	var err error
+	if p == nil {
+		return p, err
+	}
+	for i := len(*p) - 1; i >= 0; i-- {
+		if err = action(i, (*p)[i]); err != nil {
+			return p, err
+		}
+	}
+	return p, err
+}
+
+// Empty tests if this Slice is empty.
+func (p *PackageSlice) Empty() bool {
+	if p == nil || len(*p) == 0 {
+		return true
+	}
+	return false
+}
+
+// First returns the first element in this Slice as Object.
+// Object.Nil() == true will be returned when there are no elements in the slice.
+func (p *PackageSlice) First() (elem *nub.Object) {
+	return p.At(0)
+}
+
+// FirstN returns the first n elements in this slice as a Slice reference to the original.
+// Best effort is used such that as many as can be will be returned up until the request is satisfied.
+func (p *PackageSlice) FirstN(n int) nub.ISlice {
+	if n == 0 {
+		return NewPackageSliceV()
+	}
+	return p.Slice(0, nubAbs(n)-1)
+}
+
+// FlatMap creates a new slice with the modified elements from the lambda expanding any slice
+// results one level into the new slice.
+func (p *PackageSlice) FlatMap(mod func(nub.O) nub.O) nub.ISlice {
+	if p == nil || len(*p) == 0 {
+		return NewPackageSliceV()
+	}
+	x := []interface{}{}
+	for i := range *p {
+		x = nubFlatAppend(x, mod((*p)[i]))
+	}
+	return nubNewSliceOf(x, NewPackageSliceV())
+}
+
+// Flatten returns a new Slice with any nested slice elements expanded one level into it.
+// The elements of this Slice can't be slices so it is the same as Copy.
+func (p *PackageSlice) Flatten() (new nub.ISlice) {
+	return p.Copy()
+}
+
+// G returns the underlying data structure as a builtin Go type
+func (p *PackageSlice) G() []Package {
+	return p.O().([]Package)
+}
+
+// GroupBy creates a new map of Slices with the elements of this Slice grouped by the key the
+// lambda returns for them. Keys are converted to strings and kept in the order they are
+// first seen while the elements keep their order in each group.
+func (p *PackageSlice) GroupBy(key func(nub.O) nub.O) (groups nub.IMap) {
+	m := nub.NewOrderedMapV()
+	if p == nil || len(*p) == 0 {
+		return m
+	}
+	for i := range *p {
+		k := nub.ToString(key((*p)[i]))
+		if group := m.Get(k); !group.Nil() {
+			*group.O().(*PackageSlice) = append(*group.O().(*PackageSlice), (*p)[i])
+		} else {
+			m.Set(k, &PackageSlice{(*p)[i]})
+		}
+	}
+	return m
+}
+
+// Index returns the index of the first element in this Slice where element == elem
+// Returns a -1 if the element was not not found.
+func (p *PackageSlice) Index(elem interface{}) (loc int) {
+	loc = -1
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	if x, err := toPackageE(elem); err == nil {
+		for i := range *p {
+			if (*p)[i] == x {
+				return i
+			}
+		}
+	}
+	return
+}
+
+// Insert modifies this Slice to insert the given elements before the element(s) with the given index.
+// Negative indices count backwards from the end of the slice, where -1 is the last element. If a
+// negative index is used, the given element will be inserted after that element, so using an index
+// of -1 will insert the element at the end of the slice. If a Slice is given all elements will be
+// inserted starting from the beging until the end. Slice is returned for chaining. Invalid
+// index locations will not change the slice.
+func (p *PackageSlice) Insert(i int, obj interface{}) nub.ISlice {
+	if p == nil || len(*p) == 0 {
+		return p.ConcatM(obj)
+	}
+
+	// Insert the item before j if pos and after j if neg
+	j := i
+	if j = nubAbsIndex(len(*p), j); j == -1 {
+		return p
+	}
+	if i < 0 {
+		j++
+	}
+	if elems, err := ToPackageSliceE(obj); err == nil {
+		if j == 0 {
+			*p = append(*elems, *p...)
+		} else if j < len(*p) {
+			*p = append(*p, *elems...)           // ensures enough space exists
+			copy((*p)[j+len(*elems):], (*p)[j:]) // shifts right elements drop added
+			copy((*p)[j:], *elems)               // set new in locations vacated
+		} else {
+			*p = append(*p, *elems...)
+		}
+	}
+	return p
+}
+
+// InterSlice returns true if the underlying implementation is a RefSlice
+func (p *PackageSlice) InterSlice() bool {
+	return false
+}
+
+// Intersect returns a new Slice with the uniq elements of this Slice that are also in the given Slice
+// while preserving order.
+// Supports PackageSlice, *PackageSlice, []Package or *[]Package
+func (p *PackageSlice) Intersect(slice interface{}) (new nub.ISlice) {
+	other := ToPackageSlice(slice)
+	set, uniq := nub.NewSet(other), nub.NewSet(p)
+	return p.Select(func(x nub.O) bool { return set.Contains(x) && uniq.Remove(x) })
+}
+
+// Iter returns an Iterator over the elements of this Slice in order.
+func (p *PackageSlice) Iter() (it nub.Iterator) {
+	i := 0
+	return nub.NewIterator(func() (nub.O, bool) {
+		if p == nil || i >= len(*p) {
+			return nil, false
+		}
+		i++
+		return (*p)[i-1], true
+	})
+}
+
+// IsSubset checks if all elements of this Slice are in the given Slice.
+// Supports PackageSlice, *PackageSlice, []Package or *[]Package
+func (p *PackageSlice) IsSubset(slice interface{}) bool {
+	other := ToPackageSlice(slice)
+	return nub.NewSet(p).IsSubset(other)
+}
+
+// Join converts each element into a string then joins them together using the given separator or comma by default.
+func (p *PackageSlice) Join(separator ...string) (str *nub.Object) {
+	if p == nil || len(*p) == 0 {
+		str = nub.Obj("")
+		return
+	}
+	sep := ","
+	if len(separator) > 0 {
+		sep = separator[0]
+	}
+
+	var builder strings.Builder
+	for i := range *p {
+		builder.WriteString(fmt.Sprint((*p)[i]))
+		if i+1 < len(*p) {
+			builder.WriteString(sep)
+		}
+	}
+	str = nub.Obj(builder.String())
+	return
+}
+
+// Last returns the last element in this Slice as an Object.
+// Object.Nil() == true will be returned if there are no elements in the slice.
+func (p *PackageSlice) Last() (elem *nub.Object) {
+	return p.At(-1)
+}
+
+// LastN returns the last n elements in this Slice as a Slice reference to the original.
+// Best effort is used such that as many as can be will be returned up until the request is satisfied.
+func (p *PackageSlice) LastN(n int) nub.ISlice {
+	if n == 0 {
+		return NewPackageSliceV()
+	}
+	return p.Slice(nubAbsNeg(n), -1)
+}
+
+// Len returns the number of elements in this Slice
+func (p *PackageSlice) Len() int {
+	if p == nil {
+		return 0
+	}
+	return len(*p)
+}
+
+// Less returns true if the element indexed by i is less than the element indexed by j.
+func (p *PackageSlice) Less(i, j int) bool {
+	if p == nil || len(*p) < 2 || i < 0 || j < 0 || i >= len(*p) || j >= len(*p) {
+		return false
+	}
+	return (*p)[i].Less((*p)[j])
+}
+
+// Map creates a new slice with the modified elements from the lambda.
+func (p *PackageSlice) Map(mod func(nub.O) nub.O) nub.ISlice {
+	var slice nub.ISlice
+	if p == nil || len(*p) == 0 {
+		return NewPackageSliceV()
+	}
+	for i := range *p {
+		v := mod((*p)[i])
+		if slice == nil {
+			slice = nub.Slice(v)
+		} else {
+			slice.Append(v)
+		}
+	}
+	return slice
+}
+
+// MapP creates a new slice with the modified elements from the lambda, fanning the calls out to
+// the given number of workers or runtime.NumCPU() workers if less than 1 while preserving the
+// element order. The first error from the lambda or the given context cancels the context passed
+// to the lambda and skips the remaining elements. Returns an empty Slice and the first error on
+// failure.
+func (p *PackageSlice) MapP(ctx context.Context, workers int, mod func(context.Context, nub.O) (nub.O, error)) (nub.ISlice, error) {
+	if p == nil || len(*p) == 0 {
+		return NewPackageSliceV(), nil
+	}
+	results := make([]interface{}, len(*p))
+	err := nubEachP(ctx, len(results), workers, func(ctx context.Context, i int) (err error) {
+		results[i], err = mod(ctx, (*p)[i])
+		return
+	})
+	if err != nil {
+		return NewPackageSliceV(), err
+	}
+	slice := nub.Slice(results[0])
+	for i := 1; i < len(results); i++ {
+		slice.Append(results[i])
+	}
+	return slice, nil
+}
+
+// Nil tests if this Slice is nil
+func (p *PackageSlice) Nil() bool {
+	if p == nil {
+		return true
+	}
+	return false
+}
+
+// O returns the underlying data structure as is
+func (p *PackageSlice) O() interface{} {
+	if p == nil {
+		return []Package{}
+	}
+	return []Package(*p)
+}
+
+// Pair simply returns the first and second Slice elements as Objects
+func (p *PackageSlice) Pair() (first, second *nub.Object) {
+	first, second = &nub.Object{}, &nub.Object{}
+	if p == nil {
+		return
+	}
+	if len(*p) > 0 {
+		first = p.At(0)
+	}
+	if len(*p) > 1 {
+		second = p.At(1)
+	}
+	return
+}
+
+// Partition creates two new slices the first with the elements that match the lambda selector
+// and the second with the elements that don't while preserving element order.
+func (p *PackageSlice) Partition(sel func(nub.O) bool) (match, rest nub.ISlice) {
+	x, y := NewPackageSliceV(), NewPackageSliceV()
+	if p == nil || len(*p) == 0 {
+		return x, y
+	}
+	for i := range *p {
+		if sel((*p)[i]) {
+			*x = append(*x, (*p)[i])
+		} else {
+			*y = append(*y, (*p)[i])
+		}
+	}
+	return x, y
+}
+
+// Pop modifies this Slice to remove the last element and returns the removed element as an Object.
+func (p *PackageSlice) Pop() (elem *nub.Object) {
+	elem = p.Last()
+	p.DropLast()
+	return
+}
+
+// PopN modifies this Slice to remove the last n elements and returns the removed elements as a new Slice.
+func (p *PackageSlice) PopN(n int) (new nub.ISlice) {
+	if n == 0 {
+		return NewPackageSliceV()
+	}
+	new = p.Copy(nubAbsNeg(n), -1)
+	p.DropLastN(n)
+	return
+}
+
+// Prepend modifies this Slice to add the given element at the begining and returns a reference to this Slice.
+func (p *PackageSlice) Prepend(elem interface{}) nub.ISlice {
+	return p.Insert(0, elem)
+}
+
+// RefSlice returns true if the underlying implementation is a RefSlice
+func (p *PackageSlice) RefSlice() bool {
+	return false
+}
+
+// Reverse returns a new Slice with the order of the elements reversed.
+func (p *PackageSlice) Reverse() (new nub.ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().ReverseM()
+}
+
+// ReverseM modifies this Slice reversing the order of the elements and returns a reference to this Slice.
+func (p *PackageSlice) ReverseM() nub.ISlice {
+	if p == nil || len(*p) == 0 {
+		return p
+	}
+	for i, j := 0, len(*p)-1; i < j; i, j = i+1, j-1 {
+		p.Swap(i, j)
+	}
+	return p
+}
+
+// S is an alias to ToStringSlice
+func (p *PackageSlice) S() (slice *nub.StringSlice) {
+	return p.ToStringSlice()
+}
+
+// Select creates a new slice with the elements that match the lambda selector.
+func (p *PackageSlice) Select(sel func(nub.O) bool) (new nub.ISlice) {
+	slice := NewPackageSliceV()
+	if p == nil || len(*p) == 0 {
+		return slice
+	}
+	for i := range *p {
+		if sel((*p)[i]) {
+			*slice = append(*slice, (*p)[i])
+		}
+	}
+	return slice
+}
+
+// SelectP creates a new slice with the elements that match the lambda selector, fanning the calls
+// out to the given number of workers or runtime.NumCPU() workers if less than 1 while preserving
+// the element order. The first error from the lambda or the given context cancels the
+// context passed to the lambda and skips the remaining elements. Returns an empty Slice and the
+// first error on failure.
+func (p *PackageSlice) SelectP(ctx context.Context, workers int, sel func(context.Context, nub.O) (bool, error)) (new nub.ISlice, err error) {
+	slice := NewPackageSliceV()
+	if p == nil || len(*p) == 0 {
+		return slice, nil
+	}
+	hits := make([]bool, len(*p))
+	if err = nubEachP(ctx, len(hits), workers, func(ctx context.Context, i int) (err error) {
+		hits[i], err = sel(ctx, (*p)[i])
+		return
+	}); err != nil {
+		return slice, err
+	}
+	for i := range hits {
+		if hits[i] {
+			*slice = append(*slice, (*p)[i])
+		}
+	}
+	return slice, nil
+}
+
+// Set the element(s) at the given index location to the given element(s). Allows for negative notation.
+// Returns a reference to this Slice and swallows any errors.
+func (p *PackageSlice) Set(i int, elems interface{}) nub.ISlice {
+	slice, _ := p.SetE(i, elems)
+	return slice
+}
+
+// SetE the element(s) at the given index location to the given element(s). Allows for negative notation.
+// Returns a referenc to this Slice and an error if out of bounds or elem is the wrong type.
+func (p *PackageSlice) SetE(i int, elems interface{}) (nub.ISlice, error) {
+	var err error
+	if p == nil {
+		return p, err
+	}
+	if i = nubAbsIndex(len(*p), i); i == -1 {
+		err = errors.Errorf("slice assignment is out of bounds")
+		return p, err
+	}
+
+	// Account for length of elems
+	if x, err := ToPackageSliceE(elems); err == nil {
+		if len(*x) > 0 {
+			copy((*p)[i:], *x)
+		}
+	} else {
+		err = errors.Wrapf(err, "can't set type '%T' in '%T'", elems, p)
+	}
+	return p, err
+}
+
+// Shift modifies this Slice to remove the first element and returns the removed element as an Object.
+func (p *PackageSlice) Shift() (elem *nub.Object) {
+	elem = p.First()
+	p.DropFirst()
+	return
+}
+
+// ShiftN modifies this Slice to remove the first n elements and returns the removed elements as a new Slice.
+func (p *PackageSlice) ShiftN(n int) (new nub.ISlice) {
+	if n == 0 {
+		return NewPackageSliceV()
+	}
+	new = p.Copy(0, nubAbs(n)-1)
+	p.DropFirstN(n)
+	return
+}
+
+// Single reports true if there is only one element in this Slice.
+func (p *PackageSlice) Single() bool {
+	return p.Len() == 1
+}
+
+// Slice returns a range of elements from this Slice as a Slice reference to the original. Allows for negative notation.
+// Expects nothing, in which case everything is included, or two indices i and j, in which case an inclusive behavior
+// is used such that Slice(0, -1) includes index -1 as opposed to Go's exclusive behavior. Out of bounds indices will
+// be moved within bounds.
+//
+// An empty Slice is returned if indicies are mutually exclusive or nothing can be returned.
+func (p *PackageSlice) Slice(indices ...int) nub.ISlice {
+	if p == nil || len(*p) == 0 {
+		return NewPackageSliceV()
+	}
+
+	// Handle index manipulation
+	i, j, err := nubAbsIndices(len(*p), indices...)
+	if err != nil {
+		return NewPackageSliceV()
+	}
+
+	slice := PackageSlice((*p)[i:j])
+	return &slice
+}
+
+// Sort returns a new Slice with sorted elements.
+func (p *PackageSlice) Sort() (new nub.ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortM()
+}
+
+// SortBy returns a new Slice with the elements sorted using the given less lambda.
+func (p *PackageSlice) SortBy(less func(a, b nub.O) bool) (new nub.ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortByM(less)
+}
+
+// SortByM modifies this Slice sorting the elements using the given less lambda and returns a
+// reference to this Slice.
+func (p *PackageSlice) SortByM(less func(a, b nub.O) bool) nub.ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sort.Slice(*p, func(i, j int) bool {
+		return less((*p)[i], (*p)[j])
+	})
+	return p
+}
+
+// SortM modifies this Slice sorting the elements and returns a reference to this Slice.
+func (p *PackageSlice) SortM() nub.ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sort.Sort(p)
+	return p
+}
+
+// SortReverse returns a new Slice sorting the elements in reverse.
+func (p *PackageSlice) SortReverse() (new nub.ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortReverseM()
+}
+
+// SortReverseM modifies this Slice sorting the elements in reverse and returns a reference to this Slice.
+func (p *PackageSlice) SortReverseM() nub.ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sort.Sort(sort.Reverse(p))
+	return p
+}
+
+// SortStable returns a new Slice with the elements sorted using the given less lambda while
+// keeping equal elements in their original order.
+func (p *PackageSlice) SortStable(less func(a, b nub.O) bool) (new nub.ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortStableM(less)
+}
+
+// SortStableM modifies this Slice sorting the elements using the given less lambda while keeping
+// equal elements in their original order and returns a reference to this Slice.
+func (p *PackageSlice) SortStableM(less func(a, b nub.O) bool) nub.ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sort.SliceStable(*p, func(i, j int) bool {
+		return less((*p)[i], (*p)[j])
+	})
+	return p
+}
+
+// Returns a string representation of this Slice, implements the Stringer interface
+func (p *PackageSlice) String() string {
+	var builder strings.Builder
+	builder.WriteString("[")
+	if p != nil {
+		for i := range *p {
+			builder.WriteString(fmt.Sprint((*p)[i]))
+			if i+1 < len(*p) {
+				builder.WriteString(" ")
+			}
+		}
+	}
+	builder.WriteString("]")
+	return builder.String()
+}
+
+// Swap modifies this Slice swapping the indicated elements.
+func (p *PackageSlice) Swap(i, j int) {
+	if p == nil || len(*p) < 2 || i < 0 || j < 0 || i >= len(*p) || j >= len(*p) {
+		return
+	}
+	(*p)[i], (*p)[j] = (*p)[j], (*p)[i]
+}
+
+// SymmetricDifference returns a new Slice with the uniq elements of this Slice that are not in the
+// given Slice followed by the uniq elements of the given Slice that are not in this Slice while
+// preserving order.
+// Supports PackageSlice, *PackageSlice, []Package or *[]Package
+func (p *PackageSlice) SymmetricDifference(slice interface{}) (new nub.ISlice) {
+	other := ToPackageSlice(slice)
+	return p.Difference(other).ConcatM(other.Difference(p))
+}
+
+// Take modifies this Slice removing the indicated range of elements from this Slice and returning them as a new Slice.
+// Expects nothing, in which case everything is taken, or two indices i and j, in which case positive and negative
+// notation is supported and uses an inclusive behavior such that Take(0, -1) includes index -1 as opposed to Go's
+// exclusive behavior. Out of bounds indices will be moved within bounds.
+func (p *PackageSlice) Take(indices ...int) (new nub.ISlice) {
+	new = p.Copy(indices...)
+	p.Drop(indices...)
+	return
+}
+
+// TakeAt modifies this Slice removing the elemement at the given index location and returns the removed element as an Object.
+// Allows for negative notation.
+func (p *PackageSlice) TakeAt(i int) (elem *nub.Object) {
+	elem = p.At(i)
+	p.DropAt(i)
+	return
+}
+
+// TakeW modifies this Slice removing the elements that match the lambda selector and returns them as a new Slice.
+func (p *PackageSlice) TakeW(sel func(nub.O) bool) (new nub.ISlice) {
+	slice := NewPackageSliceV()
+	if p == nil || len(*p) == 0 {
+		return slice
+	}
+	l := len(*p)
+	for i := 0; i < l; i++ {
+		if sel((*p)[i]) {
+			*slice = append(*slice, (*p)[i])
+			p.DropAt(i)
+			l--
+			i--
+		}
+	}
+	return slice
+}
+
+// ToInts converts the underlying slice into a []int
+func (p *PackageSlice) ToInts() (slice []int) {
+	return p.ToIntSlice().G()
+}
+
+// ToIntSlice converts the underlying slice into a *IntSlice
+func (p *PackageSlice) ToIntSlice() (slice *nub.IntSlice) {
+	return nub.ToIntSlice(p.O())
+}
+
+// ToInterSlice converts the given slice to a generic []interface{} slice
+func (p *PackageSlice) ToInterSlice() (slice []interface{}) {
+	return nub.ToInterSlice(p.O()).G()
+}
+
+// ToStringSlice converts the underlying slice into a *StringSlice using the same element
+// formatting as String
+func (p *PackageSlice) ToStringSlice() (slice *nub.StringSlice) {
+	slice = nub.NewStringSliceV()
+	if p == nil {
+		return
+	}
+	for i := range *p {
+		*slice = append(*slice, fmt.Sprint((*p)[i]))
+	}
+	return
+}
+
+// ToStrs converts the underlying slice into a []string slice
+func (p *PackageSlice) ToStrs() (slice []string) {
+	return p.ToStringSlice().G()
+}
+
+// Union returns a new Slice by joining uniq elements from this Slice with uniq elements from the given Slice while preserving order.
+// Supports PackageSlice, *PackageSlice, []Package or *[]Package
+func (p *PackageSlice) Union(slice interface{}) (new nub.ISlice) {
+	return p.Copy().UnionM(slice)
+}
+
+// UnionM modifies this Slice by joining uniq elements from this Slice with uniq elements from the given Slice while preserving order.
+// Supports PackageSlice, *PackageSlice, []Package or *[]Package
+func (p *PackageSlice) UnionM(slice interface{}) nub.ISlice {
+	return p.ConcatM(slice).UniqM()
+}
+
+// Uniq returns a new Slice with all non uniq elements removed while preserving element order.
+// Cost for this call vs the UniqM is roughly the same, this one is appending that one dropping.
+func (p *PackageSlice) Uniq() (new nub.ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	m := map[Package]bool{}
+	slice := NewPackageSliceV()
+	for i := range *p {
+		if k := (*p)[i]; !m[k] {
+			m[k] = true
+			*slice = append(*slice, (*p)[i])
+		}
+	}
+	return slice
+}
+
+// UniqM modifies this Slice to remove all non uniq elements while preserving element order.
+// Cost for this call vs the Uniq is roughly the same, this one is dropping that one appending.
+func (p *PackageSlice) UniqM() nub.ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	m := map[Package]bool{}
+	l := len(*p)
+	for i := 0; i < l; i++ {
+		if k := (*p)[i]; m[k] {
+			p.DropAt(i)
+			l--
+			i--
+		} else {
+			m[k] = true
+		}
+	}
+	return p
+}
+
+// Window creates a new slice of Slices each holding n consecutive elements copied from this Slice
+// starting at every step elements i.e. a sliding window. Only full windows are included and an
+// empty slice is returned if n or step are less than 1.
+func (p *PackageSlice) Window(n, step int) (windows nub.ISlice) {
+	x := []interface{}{}
+	if p == nil || len(*p) == 0 || n < 1 || step < 1 {
+		return nub.NewInterSliceV(x...)
+	}
+	for i := 0; i+n <= len(*p); i += step {
+		x = append(x, p.Copy(i, i+n-1))
+	}
+	return nub.NewInterSliceV(x...)
+}
+
+// Zip creates a new slice of pairs, as InterSlices, combining each element of this Slice with
+// the element at the same index in the given Slice. The result is as long as the shorter Slice.
+func (p *PackageSlice) Zip(slice interface{}) (new nub.ISlice) {
+	x := []interface{}{}
+	other, ok := slice.(nub.ISlice)
+	if !ok {
+		other = nub.Slice(slice)
+	}
+	if p == nil || len(*p) == 0 || other.Len() == 0 {
+		return nub.NewInterSliceV(x...)
+	}
+	for i := 0; i < len(*p) && i < other.Len(); i++ {
+		x = append(x, nub.NewInterSliceV((*p)[i], other.At(i).O()))
+	}
+	return nub.NewInterSliceV(x...)
+}
+
+// ToPackageSlice converts the given obj into a *PackageSlice. Supports Package, *Package,
+// []Package, *[]Package, []*Package, Objects, Slices and []interface{} of these.
+func ToPackageSlice(obj interface{}) *PackageSlice {
+	x, _ := ToPackageSliceE(obj)
+	return x
+}
+
+// ToPackageSliceE converts the given obj into a *PackageSlice. Supports Package, *Package,
+// []Package, *[]Package, []*Package, Objects, Slices and []interface{} of these.
+func ToPackageSliceE(obj interface{}) (val *PackageSlice, err error) {
+	val = &PackageSlice{}
+	if x, ok := obj.(nub.ISlice); ok {
+		obj = x.O()
+	}
+
+	switch x := obj.(type) {
+	case nil:
+	case []Package:
+		v := PackageSlice(x)
+		val = &v
+	case *[]Package:
+		if x != nil {
+			v := PackageSlice(*x)
+			val = &v
+		}
+	case []*Package:
+		for i := range x {
+			if x[i] != nil {
+				*val = append(*val, *x[i])
+			}
+		}
+	case []interface{}:
+		for i := range x {
+			if v, e := toPackageE(x[i]); e == nil {
+				*val = append(*val, v)
+			} else {
+				err = errors.WithMessagef(e, "unable to convert %T to []Package", x)
+			}
+		}
+	default:
+		if v, e := toPackageE(x); e == nil {
+			*val = append(*val, v)
+		} else {
+			err = errors.Errorf("unable to convert type %T to []Package", x)
+		}
+	}
+	return
+}
+
+// toPackageE converts the given obj into a Package. Supports Package, *Package and Objects.
+func toPackageE(obj interface{}) (val Package, err error) {
+	switch x := obj.(type) {
+	case Package:
+		val = x
+	case *Package:
+		if x == nil {
+			err = errors.Errorf("unable to convert nil *Package to Package")
+			return
+		}
+		val = *x
+	case nub.Object:
+		return toPackageE(x.O())
+	case *nub.Object:
+		return toPackageE(x.O())
+	default:
+		err = errors.Errorf("unable to convert type %T to Package", x)
+	}
+	return
+}
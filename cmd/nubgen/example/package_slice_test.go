@@ -0,0 +1,1404 @@
+// Code generated by "nubgen -type=Package -test -samples=[]Package{{"a",1},{"b",2}}"; DO NOT EDIT.
+
+package example
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	nub "github.com/phR0ze/n"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// packageSamples are the sample elements given to nubgen in ascending order
+var packageSamples = []Package{{"a", 1}, {"b", 2}}
+
+// NewPackageSlice
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_NewPackageSlice(t *testing.T) {
+
+	// array
+	{
+		var array [2]Package
+		array[0] = packageSamples[0]
+		array[1] = packageSamples[1]
+		assert.Equal(t, []Package{packageSamples[0], packageSamples[1]}, NewPackageSlice(array[:]).O())
+	}
+
+	// empty
+	{
+		assert.Equal(t, []Package{}, NewPackageSlice(nil).O())
+		assert.Equal(t, []Package{}, NewPackageSlice([]Package{}).O())
+	}
+
+	// conversion
+	{
+		assert.Equal(t, []Package{packageSamples[0]}, NewPackageSlice(packageSamples[0]).O())
+		assert.Equal(t, []Package{packageSamples[0]}, NewPackageSlice(&packageSamples[0]).O())
+		assert.Equal(t, []Package{packageSamples[0], packageSamples[1]}, NewPackageSlice([]interface{}{&packageSamples[0], packageSamples[1]}).O())
+		assert.Equal(t, []Package{}, NewPackageSlice("foo").O())
+	}
+}
+
+// NewPackageSliceV
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_NewPackageSliceV(t *testing.T) {
+	assert.Equal(t, []Package{}, NewPackageSliceV().O())
+	assert.Equal(t, []Package{packageSamples[0]}, NewPackageSliceV(packageSamples[0]).O())
+	assert.Equal(t, []Package{packageSamples[0], packageSamples[1]}, NewPackageSliceV(packageSamples[0], packageSamples[1]).O())
+}
+
+// A
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_A(t *testing.T) {
+	assert.Equal(t, "[]", (*PackageSlice)(nil).A())
+	assert.Equal(t, fmt.Sprintf("[%v %v]", packageSamples[0], packageSamples[1]), NewPackageSliceV(packageSamples[0], packageSamples[1]).A())
+}
+
+// All
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_All(t *testing.T) {
+	var slice *PackageSlice
+	assert.False(t, slice.All())
+	assert.False(t, NewPackageSliceV().All(packageSamples[0]))
+	assert.True(t, NewPackageSliceV(packageSamples[0]).All())
+	assert.True(t, NewPackageSliceV(packageSamples[0], packageSamples[1]).All(packageSamples[0], packageSamples[1]))
+	assert.False(t, NewPackageSliceV(packageSamples[0]).All(packageSamples[0], packageSamples[1]))
+	assert.False(t, NewPackageSliceV(packageSamples[0]).All("foo"))
+}
+
+// AllS
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_AllS(t *testing.T) {
+	var slice *PackageSlice
+	assert.False(t, slice.AllS([]Package{packageSamples[0]}))
+	assert.False(t, NewPackageSliceV().AllS([]Package{packageSamples[0]}))
+	assert.True(t, NewPackageSliceV(packageSamples[0], packageSamples[1]).AllS([]Package{packageSamples[0], packageSamples[1]}))
+	assert.True(t, NewPackageSliceV(packageSamples[0], packageSamples[1]).AllS(NewPackageSliceV(packageSamples[1])))
+	assert.False(t, NewPackageSliceV(packageSamples[0]).AllS([]Package{packageSamples[0], packageSamples[1]}))
+	assert.True(t, NewPackageSliceV(packageSamples[0]).AllS(nil))
+}
+
+// Any
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Any(t *testing.T) {
+	var slice *PackageSlice
+	assert.False(t, slice.Any())
+	assert.False(t, NewPackageSliceV().Any(packageSamples[0]))
+	assert.True(t, NewPackageSliceV(packageSamples[0]).Any())
+	assert.True(t, NewPackageSliceV(packageSamples[0]).Any(packageSamples[0], packageSamples[1]))
+	assert.False(t, NewPackageSliceV(packageSamples[0]).Any(packageSamples[1]))
+	assert.False(t, NewPackageSliceV(packageSamples[0]).Any("foo"))
+}
+
+// AnyS
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_AnyS(t *testing.T) {
+	var slice *PackageSlice
+	assert.False(t, slice.AnyS([]Package{packageSamples[0]}))
+	assert.False(t, NewPackageSliceV().AnyS([]Package{packageSamples[0]}))
+	assert.True(t, NewPackageSliceV(packageSamples[0]).AnyS([]Package{packageSamples[0], packageSamples[1]}))
+	assert.True(t, NewPackageSliceV(packageSamples[0], packageSamples[1]).AnyS(NewPackageSliceV(packageSamples[1])))
+	assert.False(t, NewPackageSliceV(packageSamples[0]).AnyS([]Package{packageSamples[1]}))
+	assert.False(t, NewPackageSliceV(packageSamples[0]).AnyS(nil))
+}
+
+// AnyW
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_AnyW(t *testing.T) {
+	var slice *PackageSlice
+	assert.False(t, slice.AnyW(func(x nub.O) bool { return true }))
+	assert.False(t, NewPackageSliceV().AnyW(func(x nub.O) bool { return true }))
+	assert.True(t, NewPackageSliceV(packageSamples[0], packageSamples[1]).AnyW(func(x nub.O) bool { return x.(Package) == packageSamples[1] }))
+	assert.False(t, NewPackageSliceV(packageSamples[0]).AnyW(func(x nub.O) bool { return x.(Package) == packageSamples[1] }))
+}
+
+// Append
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Append(t *testing.T) {
+
+	// nil
+	{
+		var slice *PackageSlice
+		assert.Equal(t, NewPackageSliceV(packageSamples[0]), slice.Append(packageSamples[0]))
+		assert.Equal(t, (*PackageSlice)(nil), slice)
+	}
+
+	// modifies in place
+	{
+		slice := NewPackageSliceV(packageSamples[0])
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), slice.Append(packageSamples[1]))
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), slice)
+	}
+
+	// conversion
+	{
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), NewPackageSliceV(packageSamples[0]).Append(&packageSamples[1]))
+		assert.Equal(t, NewPackageSliceV(packageSamples[0]), NewPackageSliceV(packageSamples[0]).Append("foo"))
+	}
+}
+
+// AppendV
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_AppendV(t *testing.T) {
+
+	// nil
+	{
+		var slice *PackageSlice
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), slice.AppendV(packageSamples[0], packageSamples[1]))
+		assert.Equal(t, (*PackageSlice)(nil), slice)
+	}
+
+	// modifies in place
+	{
+		slice := NewPackageSliceV(packageSamples[0])
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]), slice.AppendV(packageSamples[1], &packageSamples[0]))
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]), slice)
+	}
+}
+
+// At
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_At(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, nub.Obj(nil), slice.At(0))
+	assert.Equal(t, nub.Obj(nil), NewPackageSliceV().At(0))
+	assert.Equal(t, nub.Obj(packageSamples[0]), NewPackageSliceV(packageSamples[0], packageSamples[1]).At(0))
+	assert.Equal(t, nub.Obj(packageSamples[1]), NewPackageSliceV(packageSamples[0], packageSamples[1]).At(1))
+	assert.Equal(t, nub.Obj(packageSamples[1]), NewPackageSliceV(packageSamples[0], packageSamples[1]).At(-1))
+	assert.Equal(t, nub.Obj(packageSamples[0]), NewPackageSliceV(packageSamples[0], packageSamples[1]).At(-2))
+	assert.Equal(t, nub.Obj(nil), NewPackageSliceV(packageSamples[0], packageSamples[1]).At(2))
+	assert.Equal(t, nub.Obj(nil), NewPackageSliceV(packageSamples[0], packageSamples[1]).At(-3))
+}
+
+// BinarySearch
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_BinarySearch(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *PackageSlice
+		i, found := slice.BinarySearch(packageSamples[0])
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+		i, found = NewPackageSliceV().BinarySearch(packageSamples[0])
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// found
+	{
+		i, found := NewPackageSliceV(packageSamples[0], packageSamples[1]).BinarySearch(packageSamples[0])
+		assert.Equal(t, 0, i)
+		assert.True(t, found)
+		i, found = NewPackageSliceV(packageSamples[0], packageSamples[1]).BinarySearch(&packageSamples[1])
+		assert.Equal(t, 1, i)
+		assert.True(t, found)
+	}
+
+	// not found returns the insertion point
+	{
+		i, found := NewPackageSliceV(packageSamples[0]).BinarySearch(packageSamples[1])
+		assert.Equal(t, 1, i)
+		assert.False(t, found)
+		i, found = NewPackageSliceV(packageSamples[1]).BinarySearch(packageSamples[0])
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// invalid element
+	{
+		i, found := NewPackageSliceV(packageSamples[0]).BinarySearch("foo")
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+}
+
+// Chunk
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Chunk(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *PackageSlice
+		assert.Equal(t, 0, slice.Chunk(2).Len())
+		assert.Equal(t, 0, NewPackageSliceV().Chunk(2).Len())
+		assert.Equal(t, 0, NewPackageSliceV(packageSamples[0]).Chunk(0).Len())
+	}
+
+	// remainder in the last chunk
+	{
+		slice := NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0])
+		chunks := slice.Chunk(2)
+		assert.Equal(t, 2, chunks.Len())
+		assert.Equal(t, []Package{packageSamples[0], packageSamples[1]}, chunks.At(0).O().(nub.ISlice).O())
+		assert.Equal(t, []Package{packageSamples[0]}, chunks.At(1).O().(nub.ISlice).O())
+
+		// chunks are copies
+		chunks.At(0).O().(nub.ISlice).Set(0, packageSamples[1])
+		assert.Equal(t, []Package{packageSamples[0], packageSamples[1], packageSamples[0]}, slice.O())
+	}
+}
+
+// Clear
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Clear(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, NewPackageSliceV(), slice.Clear())
+	assert.Equal(t, (*PackageSlice)(nil), slice)
+
+	slice = NewPackageSliceV(packageSamples[0], packageSamples[1])
+	assert.Equal(t, NewPackageSliceV(), slice.Clear())
+	assert.Equal(t, NewPackageSliceV(), slice)
+}
+
+// Concat
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Concat(t *testing.T) {
+
+	// nil
+	{
+		var slice *PackageSlice
+		assert.Equal(t, NewPackageSliceV(packageSamples[0]), slice.Concat([]Package{packageSamples[0]}))
+		assert.Equal(t, (*PackageSlice)(nil), slice)
+	}
+
+	// new slice
+	{
+		slice := NewPackageSliceV(packageSamples[0])
+		concat := slice.Concat(NewPackageSliceV(packageSamples[1]))
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), concat)
+		assert.Equal(t, NewPackageSliceV(packageSamples[0]), slice)
+	}
+
+	// conversion
+	{
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), NewPackageSliceV(packageSamples[0]).Concat([]interface{}{&packageSamples[1]}))
+		assert.Equal(t, NewPackageSliceV(packageSamples[0]), NewPackageSliceV(packageSamples[0]).Concat(nil))
+	}
+}
+
+// ConcatM
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_ConcatM(t *testing.T) {
+
+	// nil
+	{
+		var slice *PackageSlice
+		assert.Equal(t, NewPackageSliceV(packageSamples[0]), slice.ConcatM([]Package{packageSamples[0]}))
+		assert.Equal(t, (*PackageSlice)(nil), slice)
+	}
+
+	// modifies in place
+	{
+		slice := NewPackageSliceV(packageSamples[0])
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), slice.ConcatM(NewPackageSliceV(packageSamples[1])))
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), slice)
+	}
+}
+
+// Copy
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Copy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *PackageSlice
+		assert.Equal(t, NewPackageSliceV(), slice.Copy())
+		assert.Equal(t, NewPackageSliceV(), NewPackageSliceV().Copy(0, -1))
+	}
+
+	// copy is independent
+	{
+		slice := NewPackageSliceV(packageSamples[0], packageSamples[1])
+		copy := slice.Copy()
+		copy.Set(0, packageSamples[1])
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), slice)
+		assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[1]), copy)
+	}
+
+	// ranges
+	{
+		slice := NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0])
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]), slice.Copy(0, -1))
+		assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0]), slice.Copy(1, -1))
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), slice.Copy(0, 1))
+		assert.Equal(t, NewPackageSliceV(packageSamples[1]), slice.Copy(-2, -2))
+		assert.Equal(t, NewPackageSliceV(), slice.Copy(2, 1))
+	}
+}
+
+// Count
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Count(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, 0, slice.Count(packageSamples[0]))
+	assert.Equal(t, 0, NewPackageSliceV().Count(packageSamples[0]))
+	assert.Equal(t, 2, NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]).Count(packageSamples[0]))
+	assert.Equal(t, 1, NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]).Count(packageSamples[1]))
+	assert.Equal(t, 0, NewPackageSliceV(packageSamples[0]).Count("foo"))
+}
+
+// CountW
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_CountW(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, 0, slice.CountW(func(x nub.O) bool { return true }))
+	assert.Equal(t, 0, NewPackageSliceV().CountW(func(x nub.O) bool { return true }))
+	assert.Equal(t, 3, NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]).CountW(func(x nub.O) bool { return true }))
+	assert.Equal(t, 2, NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]).CountW(func(x nub.O) bool { return x.(Package) == packageSamples[0] }))
+}
+
+// Difference
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Difference(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, []Package{}, slice.Difference([]Package{packageSamples[0]}).O())
+	assert.Equal(t, []Package{packageSamples[0], packageSamples[1]}, NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]).Difference(nil).O())
+	assert.Equal(t, []Package{packageSamples[1]}, NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]).Difference(NewPackageSliceV(packageSamples[0])).O())
+	assert.Equal(t, []Package{}, NewPackageSliceV(packageSamples[0], packageSamples[1]).Difference([]Package{packageSamples[1], packageSamples[0]}).O())
+}
+
+// Disjoint
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Disjoint(t *testing.T) {
+	var slice *PackageSlice
+	assert.True(t, slice.Disjoint([]Package{packageSamples[0]}))
+	assert.True(t, NewPackageSliceV(packageSamples[0]).Disjoint(nil))
+	assert.True(t, NewPackageSliceV(packageSamples[0]).Disjoint(NewPackageSliceV(packageSamples[1])))
+	assert.False(t, NewPackageSliceV(packageSamples[0], packageSamples[1]).Disjoint([]Package{packageSamples[1]}))
+}
+
+// Drop
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Drop(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *PackageSlice
+		assert.Equal(t, (*PackageSlice)(nil), slice.Drop(0, 1))
+		assert.Equal(t, NewPackageSliceV(), NewPackageSliceV().Drop(0, 1))
+	}
+
+	// drop all
+	{
+		slice := NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0])
+		assert.Equal(t, NewPackageSliceV(), slice.Drop())
+		assert.Equal(t, NewPackageSliceV(), slice)
+	}
+
+	// ranges
+	{
+		assert.Equal(t, NewPackageSliceV(packageSamples[0]), NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]).Drop(1, -1))
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[0]), NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]).Drop(1, 1))
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]).Drop(-1, -1))
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]), NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]).Drop(2, 1))
+	}
+}
+
+// DropAt
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_DropAt(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, (*PackageSlice)(nil), slice.DropAt(0))
+	assert.Equal(t, NewPackageSliceV(), NewPackageSliceV().DropAt(0))
+	assert.Equal(t, NewPackageSliceV(packageSamples[1]), NewPackageSliceV(packageSamples[0], packageSamples[1]).DropAt(0))
+	assert.Equal(t, NewPackageSliceV(packageSamples[0]), NewPackageSliceV(packageSamples[0], packageSamples[1]).DropAt(-1))
+	assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), NewPackageSliceV(packageSamples[0], packageSamples[1]).DropAt(2))
+}
+
+// DropFirst
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_DropFirst(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, (*PackageSlice)(nil), slice.DropFirst())
+	assert.Equal(t, NewPackageSliceV(), NewPackageSliceV().DropFirst())
+	assert.Equal(t, NewPackageSliceV(packageSamples[1]), NewPackageSliceV(packageSamples[0], packageSamples[1]).DropFirst())
+}
+
+// DropFirstN
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_DropFirstN(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, (*PackageSlice)(nil), slice.DropFirstN(1))
+	assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), NewPackageSliceV(packageSamples[0], packageSamples[1]).DropFirstN(0))
+	assert.Equal(t, NewPackageSliceV(packageSamples[1]), NewPackageSliceV(packageSamples[0], packageSamples[1]).DropFirstN(1))
+	assert.Equal(t, NewPackageSliceV(), NewPackageSliceV(packageSamples[0], packageSamples[1]).DropFirstN(5))
+}
+
+// DropLast
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_DropLast(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, (*PackageSlice)(nil), slice.DropLast())
+	assert.Equal(t, NewPackageSliceV(), NewPackageSliceV().DropLast())
+	assert.Equal(t, NewPackageSliceV(packageSamples[0]), NewPackageSliceV(packageSamples[0], packageSamples[1]).DropLast())
+}
+
+// DropLastN
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_DropLastN(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, (*PackageSlice)(nil), slice.DropLastN(1))
+	assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), NewPackageSliceV(packageSamples[0], packageSamples[1]).DropLastN(0))
+	assert.Equal(t, NewPackageSliceV(packageSamples[0]), NewPackageSliceV(packageSamples[0], packageSamples[1]).DropLastN(1))
+	assert.Equal(t, NewPackageSliceV(), NewPackageSliceV(packageSamples[0], packageSamples[1]).DropLastN(5))
+}
+
+// DropW
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_DropW(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, (*PackageSlice)(nil), slice.DropW(func(x nub.O) bool { return true }))
+	assert.Equal(t, NewPackageSliceV(), NewPackageSliceV(packageSamples[0], packageSamples[1]).DropW(func(x nub.O) bool { return true }))
+	assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[0]), NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]).DropW(func(x nub.O) bool {
+		return x.(Package) == packageSamples[1]
+	}))
+}
+
+// Each
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Each(t *testing.T) {
+	var slice *PackageSlice
+	slice.Each(func(x nub.O) { assert.Fail(t, "should not be called") })
+
+	var results []Package
+	NewPackageSliceV(packageSamples[0], packageSamples[1]).Each(func(x nub.O) {
+		results = append(results, x.(Package))
+	})
+	assert.Equal(t, []Package{packageSamples[0], packageSamples[1]}, results)
+}
+
+// EachE
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_EachE(t *testing.T) {
+
+	// nil
+	{
+		var slice *PackageSlice
+		_, err := slice.EachE(func(x nub.O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []Package
+		_, err := NewPackageSliceV(packageSamples[0], packageSamples[1]).EachE(func(x nub.O) error {
+			if x.(Package) == packageSamples[1] {
+				return nub.Break
+			}
+			results = append(results, x.(Package))
+			return nil
+		})
+		assert.Equal(t, nub.Break, err)
+		assert.Equal(t, []Package{packageSamples[0]}, results)
+	}
+}
+
+// EachI
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_EachI(t *testing.T) {
+	var slice *PackageSlice
+	slice.EachI(func(i int, x nub.O) { assert.Fail(t, "should not be called") })
+
+	var results []int
+	NewPackageSliceV(packageSamples[0], packageSamples[1]).EachI(func(i int, x nub.O) {
+		results = append(results, i)
+	})
+	assert.Equal(t, []int{0, 1}, results)
+}
+
+// EachIE
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_EachIE(t *testing.T) {
+
+	// nil
+	{
+		var slice *PackageSlice
+		_, err := slice.EachIE(func(i int, x nub.O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []int
+		_, err := NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]).EachIE(func(i int, x nub.O) error {
+			if i == 1 {
+				return nub.Break
+			}
+			results = append(results, i)
+			return nil
+		})
+		assert.Equal(t, nub.Break, err)
+		assert.Equal(t, []int{0}, results)
+	}
+}
+
+// EachP
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_EachP(t *testing.T) {
+
+	// nil
+	{
+		var slice *PackageSlice
+		_, err := slice.EachP(context.Background(), 2, func(ctx context.Context, x nub.O) error {
+			return nil
+		})
+		assert.Nil(t, err)
+	}
+
+	// first error is returned
+	{
+		_, err := NewPackageSliceV(packageSamples[0], packageSamples[1]).EachP(context.Background(), 0, func(ctx context.Context, x nub.O) error {
+			if x.(Package) == packageSamples[1] {
+				return errors.New("failed")
+			}
+			return nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+
+	// cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := NewPackageSliceV(packageSamples[0], packageSamples[1]).EachP(ctx, 2, func(ctx context.Context, x nub.O) error {
+			return nil
+		})
+		assert.Equal(t, context.Canceled, err)
+	}
+}
+
+// EachR
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_EachR(t *testing.T) {
+	var slice *PackageSlice
+	slice.EachR(func(x nub.O) { assert.Fail(t, "should not be called") })
+
+	var results []Package
+	NewPackageSliceV(packageSamples[0], packageSamples[1]).EachR(func(x nub.O) {
+		results = append(results, x.(Package))
+	})
+	assert.Equal(t, []Package{packageSamples[1], packageSamples[0]}, results)
+}
+
+// EachRE
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_EachRE(t *testing.T) {
+
+	// nil
+	{
+		var slice *PackageSlice
+		_, err := slice.EachRE(func(x nub.O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []Package
+		_, err := NewPackageSliceV(packageSamples[0], packageSamples[1]).EachRE(func(x nub.O) error {
+			if x.(Package) == packageSamples[0] {
+				return nub.Break
+			}
+			results = append(results, x.(Package))
+			return nil
+		})
+		assert.Equal(t, nub.Break, err)
+		assert.Equal(t, []Package{packageSamples[1]}, results)
+	}
+}
+
+// EachRI
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_EachRI(t *testing.T) {
+	var slice *PackageSlice
+	slice.EachRI(func(i int, x nub.O) { assert.Fail(t, "should not be called") })
+
+	var results []int
+	NewPackageSliceV(packageSamples[0], packageSamples[1]).EachRI(func(i int, x nub.O) {
+		results = append(results, i)
+	})
+	assert.Equal(t, []int{1, 0}, results)
+}
+
+// EachRIE
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_EachRIE(t *testing.T) {
+
+	// nil
+	{
+		var slice *PackageSlice
+		_, err := slice.EachRIE(func(i int, x nub.O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []int
+		_, err := NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]).EachRIE(func(i int, x nub.O) error {
+			if i == 1 {
+				return nub.Break
+			}
+			results = append(results, i)
+			return nil
+		})
+		assert.Equal(t, nub.Break, err)
+		assert.Equal(t, []int{2}, results)
+	}
+}
+
+// Empty
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Empty(t *testing.T) {
+	assert.True(t, (*PackageSlice)(nil).Empty())
+	assert.True(t, NewPackageSliceV().Empty())
+	assert.False(t, NewPackageSliceV(packageSamples[0]).Empty())
+}
+
+// First
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_First(t *testing.T) {
+	assert.Equal(t, nub.Obj(nil), (*PackageSlice)(nil).First())
+	assert.Equal(t, nub.Obj(nil), NewPackageSliceV().First())
+	assert.Equal(t, nub.Obj(packageSamples[0]), NewPackageSliceV(packageSamples[0], packageSamples[1]).First())
+}
+
+// FirstN
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_FirstN(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, NewPackageSliceV(), slice.FirstN(1))
+	assert.Equal(t, NewPackageSliceV(), NewPackageSliceV(packageSamples[0]).FirstN(0))
+	assert.Equal(t, NewPackageSliceV(packageSamples[0]), NewPackageSliceV(packageSamples[0], packageSamples[1]).FirstN(1))
+	assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), NewPackageSliceV(packageSamples[0], packageSamples[1]).FirstN(5))
+
+	// reference to the original
+	{
+		slice := NewPackageSliceV(packageSamples[0], packageSamples[1])
+		slice.FirstN(1).Set(0, packageSamples[1])
+		assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[1]), slice)
+	}
+}
+
+// FlatMap
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_FlatMap(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, 0, slice.FlatMap(func(x nub.O) nub.O { return x }).Len())
+	assert.Equal(t, 0, NewPackageSliceV().FlatMap(func(x nub.O) nub.O { return x }).Len())
+	assert.Equal(t, nub.NewStringSliceV(strings.Join(strings.Fields(fmt.Sprint(packageSamples[0])), "_"), strings.Join(strings.Fields(fmt.Sprint(packageSamples[0])), "_"), strings.Join(strings.Fields(fmt.Sprint(packageSamples[1])), "_"), strings.Join(strings.Fields(fmt.Sprint(packageSamples[1])), "_")), NewPackageSliceV(packageSamples[0], packageSamples[1]).FlatMap(func(x nub.O) nub.O {
+		return []string{strings.Join(strings.Fields(fmt.Sprint(x.(Package))), "_"), strings.Join(strings.Fields(fmt.Sprint(x.(Package))), "_")}
+	}))
+}
+
+// Flatten
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Flatten(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, 0, slice.Flatten().Len())
+
+	// already flat so it is a copy
+	{
+		slice := NewPackageSliceV(packageSamples[0], packageSamples[1])
+		new := slice.Flatten()
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), new)
+		new.Set(0, packageSamples[1])
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), slice)
+	}
+}
+
+// G
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_G(t *testing.T) {
+	assert.Equal(t, []Package{}, (*PackageSlice)(nil).G())
+	assert.Equal(t, []Package{}, NewPackageSliceV().G())
+	assert.Equal(t, []Package{packageSamples[0], packageSamples[1]}, NewPackageSliceV(packageSamples[0], packageSamples[1]).G())
+}
+
+// GroupBy
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_GroupBy(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, 0, slice.GroupBy(func(x nub.O) nub.O { return x }).Len())
+	assert.Equal(t, 0, NewPackageSliceV().GroupBy(func(x nub.O) nub.O { return x }).Len())
+
+	// groups keep first seen order
+	{
+		groups := NewPackageSliceV(packageSamples[1], packageSamples[0], packageSamples[1]).GroupBy(func(x nub.O) nub.O {
+			return x.(Package) == packageSamples[0]
+		})
+		assert.Equal(t, []string{"false", "true"}, groups.Keys().O())
+		assert.Equal(t, []Package{packageSamples[1], packageSamples[1]}, groups.Get(false).O().(nub.ISlice).O())
+		assert.Equal(t, []Package{packageSamples[0]}, groups.Get(true).O().(nub.ISlice).O())
+	}
+}
+
+// Index
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Index(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, -1, slice.Index(packageSamples[0]))
+	assert.Equal(t, -1, NewPackageSliceV().Index(packageSamples[0]))
+	assert.Equal(t, 0, NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]).Index(packageSamples[0]))
+	assert.Equal(t, 1, NewPackageSliceV(packageSamples[0], packageSamples[1]).Index(&packageSamples[1]))
+	assert.Equal(t, -1, NewPackageSliceV(packageSamples[0]).Index(packageSamples[1]))
+	assert.Equal(t, -1, NewPackageSliceV(packageSamples[0]).Index("foo"))
+}
+
+// Insert
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Insert(t *testing.T) {
+
+	// nil
+	{
+		var slice *PackageSlice
+		assert.Equal(t, NewPackageSliceV(packageSamples[0]), slice.Insert(0, packageSamples[0]))
+		assert.Equal(t, (*PackageSlice)(nil), slice)
+	}
+
+	// positions
+	{
+		assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0]), NewPackageSliceV(packageSamples[0]).Insert(0, packageSamples[1]))
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), NewPackageSliceV(packageSamples[0]).Insert(-1, packageSamples[1]))
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[1], packageSamples[0]), NewPackageSliceV(packageSamples[0], packageSamples[0]).Insert(1, []Package{packageSamples[1], packageSamples[1]}))
+		assert.Equal(t, NewPackageSliceV(packageSamples[0]), NewPackageSliceV(packageSamples[0]).Insert(5, packageSamples[1]))
+	}
+
+	// conversion
+	{
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]), NewPackageSliceV(packageSamples[0], packageSamples[0]).Insert(1, &packageSamples[1]))
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[0]), NewPackageSliceV(packageSamples[0], packageSamples[0]).Insert(1, "foo"))
+	}
+}
+
+// InterSlice
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_InterSlice(t *testing.T) {
+	assert.False(t, (*PackageSlice)(nil).InterSlice())
+	assert.False(t, NewPackageSliceV(packageSamples[0]).InterSlice())
+}
+
+// Intersect
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Intersect(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, []Package{}, slice.Intersect([]Package{packageSamples[0]}).O())
+	assert.Equal(t, []Package{}, NewPackageSliceV(packageSamples[0]).Intersect(nil).O())
+	assert.Equal(t, []Package{packageSamples[1], packageSamples[0]}, NewPackageSliceV(packageSamples[1], packageSamples[0], packageSamples[1]).Intersect(NewPackageSliceV(packageSamples[0], packageSamples[1])).O())
+	assert.Equal(t, []Package{}, NewPackageSliceV(packageSamples[0], packageSamples[0]).Intersect([]Package{packageSamples[1]}).O())
+}
+
+// Iter
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Iter(t *testing.T) {
+	assert.False(t, (*PackageSlice)(nil).Iter().Next())
+	assert.False(t, NewPackageSliceV().Iter().Next())
+
+	it := NewPackageSliceV(packageSamples[0], packageSamples[1]).Iter()
+	assert.Equal(t, -1, it.Index())
+	assert.True(t, it.Next())
+	assert.Equal(t, 0, it.Index())
+	assert.Equal(t, nub.Obj(packageSamples[0]).O(), it.Value())
+	assert.True(t, it.Next())
+	assert.Equal(t, 1, it.Index())
+	assert.Equal(t, nub.Obj(packageSamples[1]).O(), it.Value())
+	assert.False(t, it.Next())
+	assert.Nil(t, it.Value())
+	assert.Nil(t, it.Err())
+}
+
+// IsSubset
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_IsSubset(t *testing.T) {
+	var slice *PackageSlice
+	assert.True(t, slice.IsSubset([]Package{packageSamples[0]}))
+	assert.True(t, NewPackageSliceV(packageSamples[1]).IsSubset(NewPackageSliceV(packageSamples[0], packageSamples[1])))
+	assert.False(t, NewPackageSliceV(packageSamples[0], packageSamples[1]).IsSubset([]Package{packageSamples[1]}))
+	assert.False(t, NewPackageSliceV(packageSamples[0]).IsSubset(nil))
+}
+
+// Join
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Join(t *testing.T) {
+	assert.Equal(t, nub.Obj(""), (*PackageSlice)(nil).Join())
+	assert.Equal(t, nub.Obj(""), NewPackageSliceV().Join())
+	assert.Equal(t, nub.Obj(fmt.Sprint(packageSamples[0])), NewPackageSliceV(packageSamples[0]).Join())
+	assert.Equal(t, nub.Obj(fmt.Sprintf("%v.%v", packageSamples[0], packageSamples[1])), NewPackageSliceV(packageSamples[0], packageSamples[1]).Join("."))
+}
+
+// Last
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Last(t *testing.T) {
+	assert.Equal(t, nub.Obj(nil), (*PackageSlice)(nil).Last())
+	assert.Equal(t, nub.Obj(nil), NewPackageSliceV().Last())
+	assert.Equal(t, nub.Obj(packageSamples[1]), NewPackageSliceV(packageSamples[0], packageSamples[1]).Last())
+}
+
+// LastN
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_LastN(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, NewPackageSliceV(), slice.LastN(1))
+	assert.Equal(t, NewPackageSliceV(), NewPackageSliceV(packageSamples[0]).LastN(0))
+	assert.Equal(t, NewPackageSliceV(packageSamples[1]), NewPackageSliceV(packageSamples[0], packageSamples[1]).LastN(1))
+	assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), NewPackageSliceV(packageSamples[0], packageSamples[1]).LastN(5))
+}
+
+// Len
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Len(t *testing.T) {
+	assert.Equal(t, 0, (*PackageSlice)(nil).Len())
+	assert.Equal(t, 0, NewPackageSliceV().Len())
+	assert.Equal(t, 2, NewPackageSliceV(packageSamples[0], packageSamples[1]).Len())
+}
+
+// Less
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Less(t *testing.T) {
+	assert.False(t, (*PackageSlice)(nil).Less(0, 1))
+	assert.False(t, NewPackageSliceV(packageSamples[0]).Less(0, 1))
+	assert.True(t, NewPackageSliceV(packageSamples[0], packageSamples[1]).Less(0, 1))
+	assert.False(t, NewPackageSliceV(packageSamples[0], packageSamples[1]).Less(1, 0))
+	assert.False(t, NewPackageSliceV(packageSamples[0], packageSamples[0]).Less(0, 1))
+	assert.False(t, NewPackageSliceV(packageSamples[0], packageSamples[1]).Less(0, 2))
+}
+
+// Map
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Map(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, NewPackageSliceV(), slice.Map(func(x nub.O) nub.O { return x }))
+	assert.Equal(t, 2, NewPackageSliceV(packageSamples[0], packageSamples[1]).Map(func(x nub.O) nub.O { return x }).Len())
+
+	// type change
+	{
+		assert.Equal(t, nub.NewStringSliceV(strings.Join(strings.Fields(fmt.Sprint(packageSamples[0])), "_"), strings.Join(strings.Fields(fmt.Sprint(packageSamples[1])), "_")), NewPackageSliceV(packageSamples[0], packageSamples[1]).Map(func(x nub.O) nub.O {
+			return strings.Join(strings.Fields(fmt.Sprint(x.(Package))), "_")
+		}))
+	}
+}
+
+// MapP
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_MapP(t *testing.T) {
+
+	// nil
+	{
+		var slice *PackageSlice
+		new, err := slice.MapP(context.Background(), 2, func(ctx context.Context, x nub.O) (nub.O, error) {
+			return x, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// first error is returned
+	{
+		_, err := NewPackageSliceV(packageSamples[0], packageSamples[1]).MapP(context.Background(), 0, func(ctx context.Context, x nub.O) (nub.O, error) {
+			if x.(Package) == packageSamples[1] {
+				return nil, errors.New("failed")
+			}
+			return x, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+}
+
+// Nil
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Nil(t *testing.T) {
+	assert.True(t, (*PackageSlice)(nil).Nil())
+	assert.False(t, NewPackageSliceV().Nil())
+	assert.False(t, NewPackageSliceV(packageSamples[0]).Nil())
+}
+
+// O
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_O(t *testing.T) {
+	assert.Equal(t, []Package{}, (*PackageSlice)(nil).O())
+	assert.Equal(t, []Package{}, NewPackageSliceV().O())
+	assert.Equal(t, []Package{packageSamples[0], packageSamples[1]}, NewPackageSliceV(packageSamples[0], packageSamples[1]).O())
+}
+
+// Pair
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Pair(t *testing.T) {
+
+	// nil
+	{
+		first, second := (*PackageSlice)(nil).Pair()
+		assert.Equal(t, nub.Obj(nil), first)
+		assert.Equal(t, nub.Obj(nil), second)
+	}
+
+	// one value
+	{
+		first, second := NewPackageSliceV(packageSamples[0]).Pair()
+		assert.Equal(t, nub.Obj(packageSamples[0]), first)
+		assert.Equal(t, nub.Obj(nil), second)
+	}
+
+	// two values
+	{
+		first, second := NewPackageSliceV(packageSamples[0], packageSamples[1]).Pair()
+		assert.Equal(t, nub.Obj(packageSamples[0]), first)
+		assert.Equal(t, nub.Obj(packageSamples[1]), second)
+	}
+}
+
+// Partition
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Partition(t *testing.T) {
+
+	// nil
+	{
+		var slice *PackageSlice
+		match, rest := slice.Partition(func(x nub.O) bool { return true })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+	}
+
+	// all match
+	{
+		match, rest := NewPackageSliceV(packageSamples[0], packageSamples[1]).Partition(func(x nub.O) bool { return true })
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), match)
+		assert.Equal(t, NewPackageSliceV(), rest)
+	}
+}
+
+// Pop
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Pop(t *testing.T) {
+	assert.Equal(t, nub.Obj(nil), (*PackageSlice)(nil).Pop())
+	assert.Equal(t, nub.Obj(nil), NewPackageSliceV().Pop())
+
+	slice := NewPackageSliceV(packageSamples[0], packageSamples[1])
+	assert.Equal(t, nub.Obj(packageSamples[1]), slice.Pop())
+	assert.Equal(t, NewPackageSliceV(packageSamples[0]), slice)
+}
+
+// PopN
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_PopN(t *testing.T) {
+	assert.Equal(t, NewPackageSliceV(), (*PackageSlice)(nil).PopN(1))
+	assert.Equal(t, NewPackageSliceV(), NewPackageSliceV(packageSamples[0]).PopN(0))
+
+	slice := NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0])
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0]), slice.PopN(2))
+	assert.Equal(t, NewPackageSliceV(packageSamples[0]), slice)
+	assert.Equal(t, NewPackageSliceV(packageSamples[0]), slice.PopN(5))
+	assert.Equal(t, NewPackageSliceV(), slice)
+}
+
+// Prepend
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Prepend(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, NewPackageSliceV(packageSamples[0]), slice.Prepend(packageSamples[0]))
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0]), NewPackageSliceV(packageSamples[0]).Prepend(&packageSamples[1]))
+}
+
+// RefSlice
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_RefSlice(t *testing.T) {
+	assert.False(t, (*PackageSlice)(nil).RefSlice())
+	assert.False(t, NewPackageSliceV(packageSamples[0]).RefSlice())
+}
+
+// Reverse
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Reverse(t *testing.T) {
+	assert.Equal(t, NewPackageSliceV(), (*PackageSlice)(nil).Reverse())
+
+	slice := NewPackageSliceV(packageSamples[0], packageSamples[1])
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0]), slice.Reverse())
+	assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), slice)
+}
+
+// ReverseM
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_ReverseM(t *testing.T) {
+	assert.Equal(t, (*PackageSlice)(nil), (*PackageSlice)(nil).ReverseM())
+
+	slice := NewPackageSliceV(packageSamples[0], packageSamples[1])
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0]), slice.ReverseM())
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0]), slice)
+}
+
+// S
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_S(t *testing.T) {
+	assert.Equal(t, nub.NewStringSliceV(), (*PackageSlice)(nil).S())
+	assert.Equal(t, nub.NewStringSliceV(fmt.Sprint(packageSamples[0]), fmt.Sprint(packageSamples[1])), NewPackageSliceV(packageSamples[0], packageSamples[1]).S())
+}
+
+// Select
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Select(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, NewPackageSliceV(), slice.Select(func(x nub.O) bool { return true }))
+	assert.Equal(t, NewPackageSliceV(), NewPackageSliceV(packageSamples[0]).Select(func(x nub.O) bool { return false }))
+	assert.Equal(t, NewPackageSliceV(packageSamples[1]), NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]).Select(func(x nub.O) bool {
+		return x.(Package) == packageSamples[1]
+	}))
+}
+
+// SelectP
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_SelectP(t *testing.T) {
+
+	// nil
+	{
+		var slice *PackageSlice
+		new, err := slice.SelectP(context.Background(), 2, func(ctx context.Context, x nub.O) (bool, error) {
+			return true, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// first error is returned
+	{
+		_, err := NewPackageSliceV(packageSamples[0], packageSamples[1]).SelectP(context.Background(), 0, func(ctx context.Context, x nub.O) (bool, error) {
+			if x.(Package) == packageSamples[1] {
+				return false, errors.New("failed")
+			}
+			return true, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+}
+
+// Set
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Set(t *testing.T) {
+	assert.Equal(t, (*PackageSlice)(nil), (*PackageSlice)(nil).Set(0, packageSamples[0]))
+	assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), NewPackageSliceV(packageSamples[0], packageSamples[0]).Set(-1, packageSamples[1]))
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[1]), NewPackageSliceV(packageSamples[0], packageSamples[0]).Set(0, []Package{packageSamples[1], packageSamples[1]}))
+	assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[0]), NewPackageSliceV(packageSamples[0], packageSamples[0]).Set(5, packageSamples[1]))
+}
+
+// SetE
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_SetE(t *testing.T) {
+
+	// nil
+	{
+		slice, err := (*PackageSlice)(nil).SetE(0, packageSamples[0])
+		assert.Nil(t, err)
+		assert.Equal(t, (*PackageSlice)(nil), slice)
+	}
+
+	// conversion
+	{
+		slice, err := NewPackageSliceV(packageSamples[0], packageSamples[0]).SetE(0, &packageSamples[1])
+		assert.Nil(t, err)
+		assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0]), slice)
+	}
+
+	// out of bounds
+	{
+		slice, err := NewPackageSliceV(packageSamples[0]).SetE(2, packageSamples[1])
+		assert.Equal(t, "slice assignment is out of bounds", err.Error())
+		assert.Equal(t, NewPackageSliceV(packageSamples[0]), slice)
+	}
+}
+
+// Shift
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Shift(t *testing.T) {
+	assert.Equal(t, nub.Obj(nil), (*PackageSlice)(nil).Shift())
+	assert.Equal(t, nub.Obj(nil), NewPackageSliceV().Shift())
+
+	slice := NewPackageSliceV(packageSamples[0], packageSamples[1])
+	assert.Equal(t, nub.Obj(packageSamples[0]), slice.Shift())
+	assert.Equal(t, NewPackageSliceV(packageSamples[1]), slice)
+}
+
+// ShiftN
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_ShiftN(t *testing.T) {
+	assert.Equal(t, NewPackageSliceV(), (*PackageSlice)(nil).ShiftN(1))
+	assert.Equal(t, NewPackageSliceV(), NewPackageSliceV(packageSamples[0]).ShiftN(0))
+
+	slice := NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0])
+	assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), slice.ShiftN(2))
+	assert.Equal(t, NewPackageSliceV(packageSamples[0]), slice)
+	assert.Equal(t, NewPackageSliceV(packageSamples[0]), slice.ShiftN(5))
+	assert.Equal(t, NewPackageSliceV(), slice)
+}
+
+// Single
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Single(t *testing.T) {
+	assert.False(t, (*PackageSlice)(nil).Single())
+	assert.False(t, NewPackageSliceV().Single())
+	assert.True(t, NewPackageSliceV(packageSamples[0]).Single())
+	assert.False(t, NewPackageSliceV(packageSamples[0], packageSamples[1]).Single())
+}
+
+// Slice
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Slice(t *testing.T) {
+	assert.Equal(t, NewPackageSliceV(), (*PackageSlice)(nil).Slice(0, -1))
+	assert.Equal(t, NewPackageSliceV(), NewPackageSliceV().Slice(0, -1))
+
+	// reference to the original
+	{
+		slice := NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0])
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]), slice.Slice())
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), slice.Slice(0, 1))
+		assert.Equal(t, NewPackageSliceV(), slice.Slice(2, 1))
+		slice.Slice(-1, -1).Set(0, packageSamples[1])
+		assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[1]), slice)
+	}
+}
+
+// Sort
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Sort(t *testing.T) {
+	assert.Equal(t, NewPackageSliceV(), (*PackageSlice)(nil).Sort())
+
+	slice := NewPackageSliceV(packageSamples[1], packageSamples[0], packageSamples[1], packageSamples[0])
+	assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[0], packageSamples[1], packageSamples[1]), slice.Sort())
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0], packageSamples[1], packageSamples[0]), slice)
+}
+
+// SortBy
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_SortBy(t *testing.T) {
+	assert.Equal(t, NewPackageSliceV(), (*PackageSlice)(nil).SortBy(func(a, b nub.O) bool { return false }))
+
+	slice := NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0])
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0], packageSamples[0]), slice.SortBy(func(a, b nub.O) bool {
+		return b.(Package).Less(a.(Package))
+	}))
+	assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]), slice)
+}
+
+// SortByM
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_SortByM(t *testing.T) {
+	assert.Equal(t, (*PackageSlice)(nil), (*PackageSlice)(nil).SortByM(func(a, b nub.O) bool { return false }))
+
+	slice := NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0])
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0], packageSamples[0]), slice.SortByM(func(a, b nub.O) bool {
+		return b.(Package).Less(a.(Package))
+	}))
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0], packageSamples[0]), slice)
+}
+
+// SortM
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_SortM(t *testing.T) {
+	assert.Equal(t, (*PackageSlice)(nil), (*PackageSlice)(nil).SortM())
+
+	slice := NewPackageSliceV(packageSamples[1], packageSamples[0], packageSamples[1])
+	assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[1]), slice.SortM())
+	assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[1]), slice)
+}
+
+// SortReverse
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_SortReverse(t *testing.T) {
+	assert.Equal(t, NewPackageSliceV(), (*PackageSlice)(nil).SortReverse())
+
+	slice := NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0])
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0], packageSamples[0]), slice.SortReverse())
+	assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]), slice)
+}
+
+// SortReverseM
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_SortReverseM(t *testing.T) {
+	assert.Equal(t, (*PackageSlice)(nil), (*PackageSlice)(nil).SortReverseM())
+
+	slice := NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0])
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0], packageSamples[0]), slice.SortReverseM())
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0], packageSamples[0]), slice)
+}
+
+// SortStable
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_SortStable(t *testing.T) {
+	assert.Equal(t, NewPackageSliceV(), (*PackageSlice)(nil).SortStable(func(a, b nub.O) bool { return false }))
+
+	slice := NewPackageSliceV(packageSamples[1], packageSamples[0], packageSamples[1])
+	assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[1]), slice.SortStable(func(a, b nub.O) bool {
+		return a.(Package).Less(b.(Package))
+	}))
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0], packageSamples[1]), slice)
+}
+
+// SortStableM
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_SortStableM(t *testing.T) {
+	assert.Equal(t, (*PackageSlice)(nil), (*PackageSlice)(nil).SortStableM(func(a, b nub.O) bool { return false }))
+
+	slice := NewPackageSliceV(packageSamples[1], packageSamples[0], packageSamples[1])
+	assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[1]), slice.SortStableM(func(a, b nub.O) bool {
+		return a.(Package).Less(b.(Package))
+	}))
+	assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[1]), slice)
+}
+
+// String
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_String(t *testing.T) {
+	assert.Equal(t, "[]", (*PackageSlice)(nil).String())
+	assert.Equal(t, "[]", NewPackageSliceV().String())
+	assert.Equal(t, fmt.Sprintf("[%v %v]", packageSamples[0], packageSamples[1]), NewPackageSliceV(packageSamples[0], packageSamples[1]).String())
+}
+
+// Swap
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Swap(t *testing.T) {
+	var slice *PackageSlice
+	slice.Swap(0, 1)
+	assert.Equal(t, (*PackageSlice)(nil), slice)
+
+	slice = NewPackageSliceV(packageSamples[0], packageSamples[1])
+	slice.Swap(0, 2)
+	assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[1]), slice)
+	slice.Swap(1, 0)
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0]), slice)
+}
+
+// SymmetricDifference
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_SymmetricDifference(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, []Package{packageSamples[0]}, slice.SymmetricDifference([]Package{packageSamples[0], packageSamples[0]}).O())
+	assert.Equal(t, []Package{packageSamples[0]}, NewPackageSliceV(packageSamples[0]).SymmetricDifference(nil).O())
+	assert.Equal(t, []Package{}, NewPackageSliceV(packageSamples[0], packageSamples[1]).SymmetricDifference(NewPackageSliceV(packageSamples[1], packageSamples[0])).O())
+	assert.Equal(t, []Package{packageSamples[1]}, NewPackageSliceV(packageSamples[0]).SymmetricDifference([]Package{packageSamples[0], packageSamples[1]}).O())
+}
+
+// Take
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Take(t *testing.T) {
+	assert.Equal(t, NewPackageSliceV(), (*PackageSlice)(nil).Take(0, 1))
+
+	slice := NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0])
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0]), slice.Take(1, -1))
+	assert.Equal(t, NewPackageSliceV(packageSamples[0]), slice)
+	assert.Equal(t, NewPackageSliceV(packageSamples[0]), slice.Take())
+	assert.Equal(t, NewPackageSliceV(), slice)
+}
+
+// TakeAt
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_TakeAt(t *testing.T) {
+	assert.Equal(t, nub.Obj(nil), (*PackageSlice)(nil).TakeAt(0))
+
+	slice := NewPackageSliceV(packageSamples[0], packageSamples[1])
+	assert.Equal(t, nub.Obj(nil), slice.TakeAt(2))
+	assert.Equal(t, nub.Obj(packageSamples[1]), slice.TakeAt(-1))
+	assert.Equal(t, NewPackageSliceV(packageSamples[0]), slice)
+}
+
+// TakeW
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_TakeW(t *testing.T) {
+	assert.Equal(t, NewPackageSliceV(), (*PackageSlice)(nil).TakeW(func(x nub.O) bool { return true }))
+
+	slice := NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0])
+	assert.Equal(t, NewPackageSliceV(packageSamples[1]), slice.TakeW(func(x nub.O) bool {
+		return x.(Package) == packageSamples[1]
+	}))
+	assert.Equal(t, NewPackageSliceV(packageSamples[0], packageSamples[0]), slice)
+}
+
+// ToStringSlice
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_ToStringSlice(t *testing.T) {
+	assert.Equal(t, nub.NewStringSliceV(), (*PackageSlice)(nil).ToStringSlice())
+	assert.Equal(t, nub.NewStringSliceV(fmt.Sprint(packageSamples[0]), fmt.Sprint(packageSamples[1])), NewPackageSliceV(packageSamples[0], packageSamples[1]).ToStringSlice())
+}
+
+// ToStrs
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_ToStrs(t *testing.T) {
+	assert.Equal(t, []string{}, (*PackageSlice)(nil).ToStrs())
+	assert.Equal(t, []string{fmt.Sprint(packageSamples[0]), fmt.Sprint(packageSamples[1])}, NewPackageSliceV(packageSamples[0], packageSamples[1]).ToStrs())
+}
+
+// ToInterSlice
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_ToInterSlice(t *testing.T) {
+	assert.Equal(t, []interface{}{}, (*PackageSlice)(nil).ToInterSlice())
+	assert.Equal(t, []interface{}{packageSamples[0], packageSamples[1]}, NewPackageSliceV(packageSamples[0], packageSamples[1]).ToInterSlice())
+}
+
+// Union
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Union(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, NewPackageSliceV(packageSamples[0]), slice.Union([]Package{packageSamples[0], packageSamples[0]}))
+
+	slice = NewPackageSliceV(packageSamples[1], packageSamples[1])
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0]), slice.Union(NewPackageSliceV(packageSamples[0])))
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[1]), slice)
+}
+
+// UnionM
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_UnionM(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, NewPackageSliceV(packageSamples[0]), slice.UnionM([]Package{packageSamples[0], packageSamples[0]}))
+	assert.Equal(t, (*PackageSlice)(nil), slice)
+
+	slice = NewPackageSliceV(packageSamples[1], packageSamples[1])
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0]), slice.UnionM(NewPackageSliceV(packageSamples[0])))
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0]), slice)
+}
+
+// Uniq
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Uniq(t *testing.T) {
+	assert.Equal(t, NewPackageSliceV(), (*PackageSlice)(nil).Uniq())
+
+	slice := NewPackageSliceV(packageSamples[1], packageSamples[0], packageSamples[1], packageSamples[0])
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0]), slice.Uniq())
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0], packageSamples[1], packageSamples[0]), slice)
+}
+
+// UniqM
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_UniqM(t *testing.T) {
+	assert.Equal(t, (*PackageSlice)(nil), (*PackageSlice)(nil).UniqM())
+
+	slice := NewPackageSliceV(packageSamples[1], packageSamples[0], packageSamples[1], packageSamples[0])
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0]), slice.UniqM())
+	assert.Equal(t, NewPackageSliceV(packageSamples[1], packageSamples[0]), slice)
+}
+
+// Window
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Window(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, 0, slice.Window(2, 1).Len())
+	assert.Equal(t, 0, NewPackageSliceV(packageSamples[0], packageSamples[1]).Window(0, 1).Len())
+	assert.Equal(t, 0, NewPackageSliceV(packageSamples[0], packageSamples[1]).Window(3, 1).Len())
+
+	windows := NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]).Window(2, 1)
+	assert.Equal(t, 2, windows.Len())
+	assert.Equal(t, []Package{packageSamples[0], packageSamples[1]}, windows.At(0).O().(nub.ISlice).O())
+	assert.Equal(t, []Package{packageSamples[1], packageSamples[0]}, windows.At(1).O().(nub.ISlice).O())
+}
+
+// Zip
+//--------------------------------------------------------------------------------------------------
+func TestPackageSlice_Zip(t *testing.T) {
+	var slice *PackageSlice
+	assert.Equal(t, 0, slice.Zip([]string{"a"}).Len())
+	assert.Equal(t, 0, NewPackageSliceV(packageSamples[0]).Zip(nil).Len())
+
+	pairs := NewPackageSliceV(packageSamples[0], packageSamples[1], packageSamples[0]).Zip([]string{"a", "b"})
+	assert.Equal(t, 2, pairs.Len())
+	assert.Equal(t, []interface{}{packageSamples[0], "a"}, pairs.At(0).O().(nub.ISlice).O())
+	assert.Equal(t, []interface{}{packageSamples[1], "b"}, pairs.At(1).O().(nub.ISlice).O())
+}
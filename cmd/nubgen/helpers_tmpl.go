@@ -0,0 +1,183 @@
+package main
+
+// helpersFile is the name of the file the helpers are generated into for packages other than n
+const helpersFile = "nub_helpers.go"
+
+// helpersTmpl is the template for the unexported copies of the n helpers used by the generated
+// Slice implementations in packages other than n. The output only depends on the package name so
+// generating several types in the same package simply regenerates the same file.
+const helpersTmpl = `// Code generated by nubgen; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"sync"
+
+	nub "github.com/phR0ze/n"
+	"github.com/pkg/errors"
+)
+
+// nubAbs passes positive values through and converts negative values to positive
+func nubAbs(i int) int {
+	if i < 0 {
+		return i * -1
+	}
+	return i
+}
+
+// nubAbsNeg passes negative values through and converts positive values to negative
+func nubAbsNeg(i int) int {
+	if i < 0 {
+		return i
+	}
+	return i * -1
+}
+
+// nubAbsIndex gets the absolute value for the given pos/neg index into a slice of the given
+// length. A return of -1 indicates out of bounds.
+func nubAbsIndex(len, i int) (abs int) {
+	if abs = i; i < 0 {
+		abs = len + i
+	}
+	if abs < 0 || abs >= len {
+		abs = -1
+	}
+	return
+}
+
+// nubAbsIndices converts the given pos/neg indices into positive notation within the bounds of a
+// slice of the given length. Returns an error if the indices are mutually exclusive.
+func nubAbsIndices(l int, indices ...int) (i int, j int, err error) {
+	i, j = 0, -1
+	if len(indices) == 2 {
+		i, j = indices[0], indices[1]
+	} else if len(indices) == 1 {
+		err = errors.Errorf("only one index given")
+		return
+	}
+	if i < 0 {
+		i = l + i
+	}
+	if j < 0 {
+		j = l + j
+	}
+	if i > j {
+		err = errors.Errorf("indices are mutually exclusive")
+		return
+	}
+	if i < 0 {
+		i = 0
+	}
+	if j >= l {
+		j = l - 1
+	}
+
+	// Go has an exclusive behavior by default and we want inclusive
+	j++
+	return
+}
+
+// nubEachP calls the given lambda once for each index in the range [0, n) fanning the calls out to
+// the given number of workers or runtime.NumCPU() workers if less than 1. The first error from the
+// lambda or the given context cancels the context passed to the lambda, skips the indices not yet
+// started and is returned once all running lambdas have completed.
+func nubEachP(ctx context.Context, n, workers int, action func(context.Context, int) error) (err error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err = ctx.Err(); err != nil || n == 0 {
+		return
+	}
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+	if workers > n {
+		workers = n
+	}
+	wctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var once sync.Once
+	fail := func(e error) {
+		once.Do(func() {
+			err = e
+			cancel()
+		})
+	}
+
+	var wg sync.WaitGroup
+	indices := make(chan int)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				if wctx.Err() != nil {
+					continue
+				}
+				if e := action(wctx, i); e != nil {
+					fail(e)
+				}
+			}
+		}()
+	}
+	for i := 0; i < n && wctx.Err() == nil; i++ {
+		select {
+		case indices <- i:
+		case <-wctx.Done():
+		}
+	}
+	close(indices)
+	wg.Wait()
+
+	// Cancellation of the given context is reported if the lambdas didn't fail first
+	if e := ctx.Err(); e != nil {
+		fail(e)
+	}
+	return
+}
+
+// nubFlatAppend appends the given object to the given values expanding it one level if it is a slice
+func nubFlatAppend(vals []interface{}, obj interface{}) []interface{} {
+	if x, ok := obj.(nub.ISlice); ok {
+		obj = x.O()
+	}
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return append(vals, obj)
+	}
+	for i := 0; i < v.Len(); i++ {
+		vals = append(vals, v.Index(i).Interface())
+	}
+	return vals
+}
+
+// nubNewSliceOf creates a new Slice from the given values when they all share the same non slice
+// type or an *InterSlice of the values otherwise. Values of the given empty Slice's element type
+// are returned as that Slice type. Returns the given empty Slice if there are no values.
+func nubNewSliceOf(vals []interface{}, empty nub.ISlice) (new nub.ISlice) {
+	if len(vals) == 0 {
+		return empty
+	}
+	typ := reflect.TypeOf(vals[0])
+	for i := range vals {
+		if typ == nil || reflect.TypeOf(vals[i]) != typ {
+			return nub.NewInterSlice(vals)
+		}
+	}
+	if _, ok := vals[0].(nub.ISlice); ok || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array {
+		return nub.NewInterSlice(vals)
+	}
+	v := reflect.MakeSlice(reflect.SliceOf(typ), 0, len(vals))
+	for i := range vals {
+		v = reflect.Append(v, reflect.ValueOf(vals[i]))
+	}
+	if reflect.TypeOf(empty.O()) == v.Type() {
+		return empty.Concat(v.Interface())
+	}
+	return nub.Slice(v.Interface())
+}
+`
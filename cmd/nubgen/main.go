@@ -0,0 +1,263 @@
+// Package main implements nubgen, a tool to generate fully typed Slice implementations for user
+// defined types providing the same API as the builtin Slice types without any reflection.
+//
+// Add a go:generate directive next to the type in your package and run go generate:
+//
+//	//go:generate nubgen -type=Package
+//
+// will generate the PackageSlice type in package_slice.go implementing the n.ISlice interface
+// along with the unexported helpers it depends on in nub_helpers.go.
+// The type must be comparable. Sorting is supported when the type has a Less(other T) bool method
+// otherwise the sorting functions panic the same as they do for RefSlice.
+//
+// Tests mirroring the builtin Slice tests are generated in package_slice_test.go with -test which
+// requires at least two sample elements in ascending order:
+//
+//	//go:generate nubgen -type=Package -test -samples=[]Package{{"a",1},{"b",2}}
+//
+// Running nubgen in package n itself generates the builtin bool, byte, time.Duration and
+// time.Time Slice implementations.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	typeNames = flag.String("type", "", "comma separated list of type names; must be set")
+	tests     = flag.Bool("test", false, "generate tests for the Slice implementations")
+	samples   = flag.String("samples", "", "Go expression of type []T with at least two ascending samples for the tests")
+)
+
+// usage prints the usage for nubgen
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage of nubgen:\n")
+	fmt.Fprintf(os.Stderr, "\tnubgen -type T [-test -samples []T{...}] [directory]\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("nubgen: ")
+	flag.Usage = usage
+	flag.Parse()
+	if len(*typeNames) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	dir := "."
+	if args := flag.Args(); len(args) > 0 {
+		dir = args[0]
+	}
+
+	g := &Generator{
+		Header:  fmt.Sprintf("// Code generated by \"nubgen %s\"; DO NOT EDIT.", strings.Join(os.Args[1:], " ")),
+		Tests:   *tests,
+		Samples: *samples,
+	}
+	files, err := g.Generate(dir, strings.Split(*typeNames, ","))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, name := range sortedKeys(files) {
+		if err = ioutil.WriteFile(filepath.Join(dir, name), files[name], 0644); err != nil {
+			log.Fatal(errors.Wrapf(err, "failed to write %s", name))
+		}
+	}
+}
+
+// Generator generates the Slice implementations for the types of a package
+type Generator struct {
+	Header  string // header comment for the generated files
+	Tests   bool   // generate tests for the Slice implementations
+	Samples string // Go expression of the user samples for the tests
+}
+
+// Generate parses the package in the given directory and generates the Slice implementations for
+// the given type names returning the generated source keyed by file name.
+func (g *Generator) Generate(dir string, names []string) (files map[string][]byte, err error) {
+	pkg, err := parsePackage(dir)
+	if err != nil {
+		return
+	}
+
+	files = map[string][]byte{}
+	if !pkg.self {
+		var src []byte
+		if src, err = execute(helpersTmpl, Type{Package: pkg.name}); err != nil {
+			return
+		}
+		if files[helpersFile], err = format.Source(src); err != nil {
+			err = errors.Wrap(err, "failed to format generated helpers")
+			return
+		}
+	}
+	for _, name := range names {
+		var t Type
+		if t, err = pkg.lookup(strings.TrimSpace(name), g.Samples); err != nil {
+			return
+		}
+		if g.Tests && t.User && t.Samples == "" {
+			err = errors.Errorf("-samples is required to generate tests for type %s", t.Elem)
+			return
+		}
+		t.Header, t.Package, t.N = g.Header, pkg.name, pkg.qualifier()
+		t.Examples = !t.User
+
+		var src []byte
+		if src, err = execute(sliceTmpl, t); err != nil {
+			return
+		}
+		if src, err = format.Source(src); err != nil {
+			err = errors.Wrapf(err, "failed to format generated code for type %s", t.Elem)
+			return
+		}
+		files[t.File] = src
+
+		if g.Tests {
+			// tests keep the section separators unformatted so only validate the syntax
+			if src, err = execute(sliceTestTmpl, t); err != nil {
+				return
+			}
+			if _, err = parser.ParseFile(token.NewFileSet(), "", src, 0); err != nil {
+				err = errors.Wrapf(err, "failed to parse generated tests for type %s", t.Elem)
+				return
+			}
+			files[strings.TrimSuffix(t.File, ".go")+"_test.go"] = src
+		}
+	}
+	return
+}
+
+// Package is the parsed package the Slice implementations are generated into
+type Package struct {
+	name  string                   // name of the package
+	self  bool                     // package is n itself
+	types map[string]*ast.TypeSpec // declared types by name
+	less  map[string]bool          // types with a Less method
+}
+
+// parsePackage parses the non test Go files in the given directory
+func parsePackage(dir string) (pkg *Package, err error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		if strings.HasSuffix(info.Name(), "_test.go") {
+			return false
+		}
+
+		// Skip files excluded by build constraints e.g. generators tagged with ignore
+		match, _ := build.Default.MatchFile(dir, info.Name())
+		return match
+	}, 0)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to parse package in %s", dir)
+		return
+	}
+	if len(pkgs) != 1 {
+		err = errors.Errorf("expected one package in %s but found %d", dir, len(pkgs))
+		return
+	}
+
+	pkg = &Package{types: map[string]*ast.TypeSpec{}, less: map[string]bool{}}
+	for name, p := range pkgs {
+		pkg.name = name
+		for _, file := range p.Files {
+			for _, decl := range file.Decls {
+				switch x := decl.(type) {
+				case *ast.GenDecl:
+					for _, spec := range x.Specs {
+						if ts, ok := spec.(*ast.TypeSpec); ok {
+							pkg.types[ts.Name.Name] = ts
+						}
+					}
+				case *ast.FuncDecl:
+					if x.Recv != nil && len(x.Recv.List) == 1 && x.Name.Name == "Less" {
+						recv := x.Recv.List[0].Type
+						if star, ok := recv.(*ast.StarExpr); ok {
+							recv = star.X
+						}
+						if ident, ok := recv.(*ast.Ident); ok {
+							pkg.less[ident.Name] = true
+						}
+					}
+				}
+			}
+		}
+	}
+	_, pkg.self = pkg.types["ISlice"]
+	return
+}
+
+// lookup the given type name returning its Type
+func (p *Package) lookup(name, samples string) (t Type, err error) {
+	if t, ok := builtins[name]; ok {
+		if !p.self {
+			err = errors.Errorf("builtin type %s is only supported in package n", name)
+		}
+		return t, err
+	}
+	if _, ok := p.types[name]; !ok {
+		err = errors.Errorf("type %s not found in package %s", name, p.name)
+		return
+	}
+	return newUserType(name, p.less[name], samples)
+}
+
+// qualifier returns the qualifier for n identifiers in this package. Package n is imported as
+// nub to avoid clashing with the common n parameter name.
+func (p *Package) qualifier() string {
+	if p.self {
+		return ""
+	}
+	return "nub."
+}
+
+// execute the given template for the given type. Helpers referenced with fn resolve to the
+// unexported n helpers in package n and to their generated nub prefixed copies elsewhere.
+func execute(text string, t Type) (src []byte, err error) {
+	tmpl, err := template.New(t.Slice).Funcs(template.FuncMap{
+		"fn": func(name string) string {
+			if t.N == "" {
+				return name
+			}
+			return "nub" + strings.ToUpper(name[:1]) + name[1:]
+		},
+	}).Parse(text)
+	if err != nil {
+		err = errors.Wrap(err, "failed to parse template")
+		return
+	}
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, t); err != nil {
+		err = errors.Wrapf(err, "failed to execute template for type %s", t.Elem)
+		return
+	}
+	src = buf.Bytes()
+	return
+}
+
+// sortedKeys returns the keys of the given files in sorted order
+func sortedKeys(files map[string][]byte) (keys []string) {
+	for k := range files {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Generate
+//--------------------------------------------------------------------------------------------------
+func TestGenerator_Generate(t *testing.T) {
+
+	// builtins are up to date
+	{
+		g := &Generator{Header: `// Code generated by "nubgen -type=bool,byte,time.Duration,time.Time -test"; DO NOT EDIT.`, Tests: true}
+		files, err := g.Generate("../..", []string{"bool", "byte", "time.Duration", "time.Time"})
+		assert.Nil(t, err)
+		assert.Equal(t, 8, len(files))
+		for name, src := range files {
+			data, err := ioutil.ReadFile(filepath.Join("../..", name))
+			assert.Nil(t, err)
+			assert.Equal(t, string(data), string(src), name)
+		}
+	}
+
+	// example is up to date
+	{
+		g := &Generator{
+			Header:  `// Code generated by "nubgen -type=Package -test -samples=[]Package{{"a",1},{"b",2}}"; DO NOT EDIT.`,
+			Tests:   true,
+			Samples: `[]Package{{"a",1},{"b",2}}`,
+		}
+		files, err := g.Generate("example", []string{"Package"})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"nub_helpers.go", "package_slice.go", "package_slice_test.go"}, sortedKeys(files))
+		for name, src := range files {
+			data, err := ioutil.ReadFile(filepath.Join("example", name))
+			assert.Nil(t, err)
+			assert.Equal(t, string(data), string(src), name)
+		}
+	}
+
+	// no tests
+	{
+		files, err := (&Generator{}).Generate("example", []string{" Package "})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"nub_helpers.go", "package_slice.go"}, sortedKeys(files))
+	}
+
+	// helpers are unexported copies in user packages only
+	{
+		files, err := (&Generator{}).Generate("example", []string{"Package"})
+		assert.Nil(t, err)
+		assert.Contains(t, string(files["package_slice.go"]), "nubAbsIndex(len(*p), i)")
+		assert.NotContains(t, string(files["package_slice.go"]), "nub.AbsIndex")
+
+		files, err = (&Generator{}).Generate("../..", []string{"bool"})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"slice_bool.go"}, sortedKeys(files))
+		assert.Contains(t, string(files["slice_bool.go"]), "absIndex(len(*p), i)")
+	}
+
+	// invalid
+	{
+		_, err := (&Generator{}).Generate("foo", []string{"Package"})
+		assert.Contains(t, err.Error(), "failed to parse package in foo")
+
+		_, err = (&Generator{}).Generate("example", []string{"Foo"})
+		assert.Equal(t, "type Foo not found in package example", err.Error())
+
+		_, err = (&Generator{}).Generate("example", []string{"bool"})
+		assert.Equal(t, "builtin type bool is only supported in package n", err.Error())
+
+		_, err = (&Generator{Tests: true}).Generate("example", []string{"Package"})
+		assert.Equal(t, "-samples is required to generate tests for type Package", err.Error())
+
+		_, err = (&Generator{Tests: true, Samples: "[]Package{"}).Generate("example", []string{"Package"})
+		assert.Contains(t, err.Error(), "failed to parse samples")
+	}
+}
+
+// parsePackage
+//--------------------------------------------------------------------------------------------------
+func TestParsePackage(t *testing.T) {
+
+	// user package
+	{
+		pkg, err := parsePackage("example")
+		assert.Nil(t, err)
+		assert.Equal(t, "example", pkg.name)
+		assert.False(t, pkg.self)
+		assert.Equal(t, "nub.", pkg.qualifier())
+		assert.True(t, pkg.less["Package"])
+		assert.NotNil(t, pkg.types["Package"])
+	}
+
+	// package n
+	{
+		pkg, err := parsePackage("../..")
+		assert.Nil(t, err)
+		assert.Equal(t, "n", pkg.name)
+		assert.True(t, pkg.self)
+		assert.Equal(t, "", pkg.qualifier())
+	}
+}
+
+// Str
+//--------------------------------------------------------------------------------------------------
+func TestType_Str(t *testing.T) {
+
+	// builtin
+	{
+		typ := builtins["time.Duration"]
+		assert.Equal(t, `"1s"`, typ.Str("{A}"))
+		assert.Equal(t, `"[1s 1m0s]"`, typ.Str("[{A} {B}]"))
+	}
+
+	// user
+	{
+		typ, err := newUserType("Package", true, `[]Package{{"a",1},{"b",2}}`)
+		assert.Nil(t, err)
+		assert.Equal(t, `[]Package{{"a", 1}, {"b", 2}}`, typ.Samples)
+		assert.Equal(t, "fmt.Sprint(packageSamples[0])", typ.Str("{A}"))
+		assert.Equal(t, `fmt.Sprintf("[%v %v]", packageSamples[0], packageSamples[1])`, typ.Str("[{A} {B}]"))
+		assert.Equal(t, `fmt.Sprintf("%v.%v", packageSamples[0], packageSamples[1])`, typ.Str("{A}.{B}"))
+		assert.Equal(t, `"100%"`, typ.Str("100%"))
+	}
+}
+
+// Word
+//--------------------------------------------------------------------------------------------------
+func TestType_Word(t *testing.T) {
+	typ := builtins["time.Duration"]
+	assert.Equal(t, `"1s"`, typ.Word(typ.A))
+	assert.Equal(t, "x.String()", typ.Word("x"))
+
+	typ, _ = newUserType("Package", false, "")
+	assert.False(t, typ.Ordered)
+	assert.Equal(t, `strings.Join(strings.Fields(fmt.Sprint(x)), "_")`, typ.Word("x"))
+}
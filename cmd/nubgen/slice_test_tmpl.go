@@ -0,0 +1,2247 @@
+package main
+
+// sliceTestTmpl is the template for the tests of the typed ISlice implementation
+const sliceTestTmpl = `{{.Header}}
+
+package {{.Package}}
+
+import (
+	"context"
+	"fmt"
+{{- if .User}}
+	"strings"
+{{- end}}
+	"testing"
+{{- if .Time}}
+	"time"
+{{- end}}
+{{if .N}}
+	nub "github.com/phR0ze/n"
+{{- end}}
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+{{- if .User}}
+
+// {{.SamplesVar}} are the sample elements given to nubgen in ascending order
+var {{.SamplesVar}} = {{.Samples}}
+{{- end}}
+
+// New{{.Slice}}
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func ExampleNew{{.Slice}}() {
+	slice := New{{.Slice}}([]{{.Elem}}{ {{- .A}}, {{.B}}})
+	fmt.Println(slice)
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_New{{.Slice}}(t *testing.T) {
+
+	// array
+	{
+		var array [2]{{.Elem}}
+		array[0] = {{.A}}
+		array[1] = {{.B}}
+{{- if not .User}}
+		assert.Equal(t, []{{.Elem}}{ {{- .A}}, {{.B}}}, New{{.Slice}}(array).O())
+{{- end}}
+		assert.Equal(t, []{{.Elem}}{ {{- .A}}, {{.B}}}, New{{.Slice}}(array[:]).O())
+	}
+
+	// empty
+	{
+		assert.Equal(t, []{{.Elem}}{}, New{{.Slice}}(nil).O())
+		assert.Equal(t, []{{.Elem}}{}, New{{.Slice}}([]{{.Elem}}{}).O())
+	}
+
+	// conversion
+	{
+		assert.Equal(t, []{{.Elem}}{ {{- .A}}}, New{{.Slice}}({{.A}}).O())
+		assert.Equal(t, []{{.Elem}}{ {{- .A}}}, New{{.Slice}}({{.StrA}}).O())
+		assert.Equal(t, []{{.Elem}}{ {{- .A}}, {{.B}}}, New{{.Slice}}([]interface{}{ {{- .StrA}}, {{.B}}}).O())
+		assert.Equal(t, []{{.Elem}}{}, New{{.Slice}}({{.Bad}}).O())
+	}
+}
+
+// New{{.Slice}}V
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func ExampleNew{{.Slice}}V() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice)
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_New{{.Slice}}V(t *testing.T) {
+	assert.Equal(t, []{{.Elem}}{}, New{{.Slice}}V().O())
+	assert.Equal(t, []{{.Elem}}{ {{- .A}}}, New{{.Slice}}V({{.A}}).O())
+	assert.Equal(t, []{{.Elem}}{ {{- .A}}, {{.B}}}, New{{.Slice}}V({{.A}}, {{.B}}).O())
+}
+
+// A
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_A() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.A())
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_A(t *testing.T) {
+	assert.Equal(t, "[]", (*{{.Slice}})(nil).A())
+	assert.Equal(t, {{.Str "[{A} {B}]"}}, New{{.Slice}}V({{.A}}, {{.B}}).A())
+}
+
+// All
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_All() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.All({{.A}}, {{.B}}))
+	// Output: true
+}
+
+{{end -}}
+func Test{{.Slice}}_All(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.False(t, slice.All())
+	assert.False(t, New{{.Slice}}V().All({{.A}}))
+	assert.True(t, New{{.Slice}}V({{.A}}).All())
+	assert.True(t, New{{.Slice}}V({{.A}}, {{.B}}).All({{.A}}, {{.B}}))
+	assert.False(t, New{{.Slice}}V({{.A}}).All({{.A}}, {{.B}}))
+	assert.False(t, New{{.Slice}}V({{.A}}).All({{.Bad}}))
+}
+
+// AllS
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_AllS() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.AllS([]{{.Elem}}{ {{- .A}}, {{.B}}}))
+	// Output: true
+}
+
+{{end -}}
+func Test{{.Slice}}_AllS(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.False(t, slice.AllS([]{{.Elem}}{ {{- .A}}}))
+	assert.False(t, New{{.Slice}}V().AllS([]{{.Elem}}{ {{- .A}}}))
+	assert.True(t, New{{.Slice}}V({{.A}}, {{.B}}).AllS([]{{.Elem}}{ {{- .A}}, {{.B}}}))
+	assert.True(t, New{{.Slice}}V({{.A}}, {{.B}}).AllS(New{{.Slice}}V({{.B}})))
+	assert.False(t, New{{.Slice}}V({{.A}}).AllS([]{{.Elem}}{ {{- .A}}, {{.B}}}))
+	assert.True(t, New{{.Slice}}V({{.A}}).AllS(nil))
+}
+
+// Any
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Any() {
+	slice := New{{.Slice}}V({{.A}})
+	fmt.Println(slice.Any({{.A}}, {{.B}}))
+	// Output: true
+}
+
+{{end -}}
+func Test{{.Slice}}_Any(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.False(t, slice.Any())
+	assert.False(t, New{{.Slice}}V().Any({{.A}}))
+	assert.True(t, New{{.Slice}}V({{.A}}).Any())
+	assert.True(t, New{{.Slice}}V({{.A}}).Any({{.A}}, {{.B}}))
+	assert.False(t, New{{.Slice}}V({{.A}}).Any({{.B}}))
+	assert.False(t, New{{.Slice}}V({{.A}}).Any({{.Bad}}))
+}
+
+// AnyS
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_AnyS() {
+	slice := New{{.Slice}}V({{.A}})
+	fmt.Println(slice.AnyS([]{{.Elem}}{ {{- .A}}, {{.B}}}))
+	// Output: true
+}
+
+{{end -}}
+func Test{{.Slice}}_AnyS(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.False(t, slice.AnyS([]{{.Elem}}{ {{- .A}}}))
+	assert.False(t, New{{.Slice}}V().AnyS([]{{.Elem}}{ {{- .A}}}))
+	assert.True(t, New{{.Slice}}V({{.A}}).AnyS([]{{.Elem}}{ {{- .A}}, {{.B}}}))
+	assert.True(t, New{{.Slice}}V({{.A}}, {{.B}}).AnyS(New{{.Slice}}V({{.B}})))
+	assert.False(t, New{{.Slice}}V({{.A}}).AnyS([]{{.Elem}}{ {{- .B}}}))
+	assert.False(t, New{{.Slice}}V({{.A}}).AnyS(nil))
+}
+
+// AnyW
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_AnyW() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.AnyW(func(x {{.N}}O) bool {
+		return {{.Equal (.Assert "x") .B}}
+	}))
+	// Output: true
+}
+
+{{end -}}
+func Test{{.Slice}}_AnyW(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.False(t, slice.AnyW(func(x {{.N}}O) bool { return true }))
+	assert.False(t, New{{.Slice}}V().AnyW(func(x {{.N}}O) bool { return true }))
+	assert.True(t, New{{.Slice}}V({{.A}}, {{.B}}).AnyW(func(x {{.N}}O) bool { return {{.Equal (.Assert "x") .B}} }))
+	assert.False(t, New{{.Slice}}V({{.A}}).AnyW(func(x {{.N}}O) bool { return {{.Equal (.Assert "x") .B}} }))
+}
+
+// Append
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Append() {
+	slice := New{{.Slice}}V({{.A}})
+	fmt.Println(slice.Append({{.B}}))
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_Append(t *testing.T) {
+
+	// nil
+	{
+		var slice *{{.Slice}}
+		assert.Equal(t, New{{.Slice}}V({{.A}}), slice.Append({{.A}}))
+		assert.Equal(t, (*{{.Slice}})(nil), slice)
+	}
+
+	// modifies in place
+	{
+		slice := New{{.Slice}}V({{.A}})
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), slice.Append({{.B}}))
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), slice)
+	}
+
+	// conversion
+	{
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), New{{.Slice}}V({{.A}}).Append({{.StrB}}))
+		assert.Equal(t, New{{.Slice}}V({{.A}}), New{{.Slice}}V({{.A}}).Append({{.Bad}}))
+	}
+}
+
+// AppendV
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_AppendV() {
+	slice := New{{.Slice}}V({{.A}})
+	fmt.Println(slice.AppendV({{.B}}, {{.A}}))
+	// Output: [{{.SA}} {{.SB}} {{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_AppendV(t *testing.T) {
+
+	// nil
+	{
+		var slice *{{.Slice}}
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), slice.AppendV({{.A}}, {{.B}}))
+		assert.Equal(t, (*{{.Slice}})(nil), slice)
+	}
+
+	// modifies in place
+	{
+		slice := New{{.Slice}}V({{.A}})
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}), slice.AppendV({{.B}}, {{.StrA}}))
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}), slice)
+	}
+}
+
+// At
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_At() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.At(-1).O())
+	// Output: {{.OB}}
+}
+
+{{end -}}
+func Test{{.Slice}}_At(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, {{.N}}Obj(nil), slice.At(0))
+	assert.Equal(t, {{.N}}Obj(nil), New{{.Slice}}V().At(0))
+	assert.Equal(t, {{.N}}Obj({{.A}}), New{{.Slice}}V({{.A}}, {{.B}}).At(0))
+	assert.Equal(t, {{.N}}Obj({{.B}}), New{{.Slice}}V({{.A}}, {{.B}}).At(1))
+	assert.Equal(t, {{.N}}Obj({{.B}}), New{{.Slice}}V({{.A}}, {{.B}}).At(-1))
+	assert.Equal(t, {{.N}}Obj({{.A}}), New{{.Slice}}V({{.A}}, {{.B}}).At(-2))
+	assert.Equal(t, {{.N}}Obj(nil), New{{.Slice}}V({{.A}}, {{.B}}).At(2))
+	assert.Equal(t, {{.N}}Obj(nil), New{{.Slice}}V({{.A}}, {{.B}}).At(-3))
+}
+{{if .Ordered}}
+// BinarySearch
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_BinarySearch() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.BinarySearch({{.B}}))
+	// Output: 1 true
+}
+
+{{end -}}
+func Test{{.Slice}}_BinarySearch(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *{{.Slice}}
+		i, found := slice.BinarySearch({{.A}})
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+		i, found = New{{.Slice}}V().BinarySearch({{.A}})
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// found
+	{
+		i, found := New{{.Slice}}V({{.A}}, {{.B}}).BinarySearch({{.A}})
+		assert.Equal(t, 0, i)
+		assert.True(t, found)
+		i, found = New{{.Slice}}V({{.A}}, {{.B}}).BinarySearch({{.StrB}})
+		assert.Equal(t, 1, i)
+		assert.True(t, found)
+	}
+
+	// not found returns the insertion point
+	{
+		i, found := New{{.Slice}}V({{.A}}).BinarySearch({{.B}})
+		assert.Equal(t, 1, i)
+		assert.False(t, found)
+		i, found = New{{.Slice}}V({{.B}}).BinarySearch({{.A}})
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// invalid element
+	{
+		i, found := New{{.Slice}}V({{.A}}).BinarySearch({{.Bad}})
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+}
+{{end}}
+// Chunk
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Chunk() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	slice.Chunk(2).Each(func(x {{.N}}O) {
+		fmt.Print(x)
+	})
+	// Output: [{{.SA}} {{.SB}}][{{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_Chunk(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *{{.Slice}}
+		assert.Equal(t, 0, slice.Chunk(2).Len())
+		assert.Equal(t, 0, New{{.Slice}}V().Chunk(2).Len())
+		assert.Equal(t, 0, New{{.Slice}}V({{.A}}).Chunk(0).Len())
+	}
+
+	// remainder in the last chunk
+	{
+		slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+		chunks := slice.Chunk(2)
+		assert.Equal(t, 2, chunks.Len())
+		assert.Equal(t, []{{.Elem}}{ {{- .A}}, {{.B}}}, chunks.At(0).O().({{.N}}ISlice).O())
+		assert.Equal(t, []{{.Elem}}{ {{- .A}}}, chunks.At(1).O().({{.N}}ISlice).O())
+
+		// chunks are copies
+		chunks.At(0).O().({{.N}}ISlice).Set(0, {{.B}})
+		assert.Equal(t, []{{.Elem}}{ {{- .A}}, {{.B}}, {{.A}}}, slice.O())
+	}
+}
+
+// Clear
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Clear() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.Clear())
+	// Output: []
+}
+
+{{end -}}
+func Test{{.Slice}}_Clear(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, New{{.Slice}}V(), slice.Clear())
+	assert.Equal(t, (*{{.Slice}})(nil), slice)
+
+	slice = New{{.Slice}}V({{.A}}, {{.B}})
+	assert.Equal(t, New{{.Slice}}V(), slice.Clear())
+	assert.Equal(t, New{{.Slice}}V(), slice)
+}
+
+// Concat
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Concat() {
+	slice := New{{.Slice}}V({{.A}})
+	fmt.Println(slice.Concat([]{{.Elem}}{ {{- .B}}}))
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_Concat(t *testing.T) {
+
+	// nil
+	{
+		var slice *{{.Slice}}
+		assert.Equal(t, New{{.Slice}}V({{.A}}), slice.Concat([]{{.Elem}}{ {{- .A}}}))
+		assert.Equal(t, (*{{.Slice}})(nil), slice)
+	}
+
+	// new slice
+	{
+		slice := New{{.Slice}}V({{.A}})
+		concat := slice.Concat(New{{.Slice}}V({{.B}}))
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), concat)
+		assert.Equal(t, New{{.Slice}}V({{.A}}), slice)
+	}
+
+	// conversion
+	{
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), New{{.Slice}}V({{.A}}).Concat([]interface{}{ {{- .StrB}}}))
+		assert.Equal(t, New{{.Slice}}V({{.A}}), New{{.Slice}}V({{.A}}).Concat(nil))
+	}
+}
+
+// ConcatM
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_ConcatM() {
+	slice := New{{.Slice}}V({{.A}})
+	fmt.Println(slice.ConcatM([]{{.Elem}}{ {{- .B}}}))
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_ConcatM(t *testing.T) {
+
+	// nil
+	{
+		var slice *{{.Slice}}
+		assert.Equal(t, New{{.Slice}}V({{.A}}), slice.ConcatM([]{{.Elem}}{ {{- .A}}}))
+		assert.Equal(t, (*{{.Slice}})(nil), slice)
+	}
+
+	// modifies in place
+	{
+		slice := New{{.Slice}}V({{.A}})
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), slice.ConcatM(New{{.Slice}}V({{.B}})))
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), slice)
+	}
+}
+
+// Copy
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Copy() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.Copy())
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_Copy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *{{.Slice}}
+		assert.Equal(t, New{{.Slice}}V(), slice.Copy())
+		assert.Equal(t, New{{.Slice}}V(), New{{.Slice}}V().Copy(0, -1))
+	}
+
+	// copy is independent
+	{
+		slice := New{{.Slice}}V({{.A}}, {{.B}})
+		copy := slice.Copy()
+		copy.Set(0, {{.B}})
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), slice)
+		assert.Equal(t, New{{.Slice}}V({{.B}}, {{.B}}), copy)
+	}
+
+	// ranges
+	{
+		slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}), slice.Copy(0, -1))
+		assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}), slice.Copy(1, -1))
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), slice.Copy(0, 1))
+		assert.Equal(t, New{{.Slice}}V({{.B}}), slice.Copy(-2, -2))
+		assert.Equal(t, New{{.Slice}}V(), slice.Copy(2, 1))
+	}
+}
+
+// Count
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Count() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	fmt.Println(slice.Count({{.A}}))
+	// Output: 2
+}
+
+{{end -}}
+func Test{{.Slice}}_Count(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, 0, slice.Count({{.A}}))
+	assert.Equal(t, 0, New{{.Slice}}V().Count({{.A}}))
+	assert.Equal(t, 2, New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}).Count({{.A}}))
+	assert.Equal(t, 1, New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}).Count({{.B}}))
+	assert.Equal(t, 0, New{{.Slice}}V({{.A}}).Count({{.Bad}}))
+}
+
+// CountW
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_CountW() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	fmt.Println(slice.CountW(func(x {{.N}}O) bool {
+		return {{.Equal (.Assert "x") .B}}
+	}))
+	// Output: 1
+}
+
+{{end -}}
+func Test{{.Slice}}_CountW(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, 0, slice.CountW(func(x {{.N}}O) bool { return true }))
+	assert.Equal(t, 0, New{{.Slice}}V().CountW(func(x {{.N}}O) bool { return true }))
+	assert.Equal(t, 3, New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}).CountW(func(x {{.N}}O) bool { return true }))
+	assert.Equal(t, 2, New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}).CountW(func(x {{.N}}O) bool { return {{.Equal (.Assert "x") .A}} }))
+}
+
+// Difference
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Difference() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	fmt.Println(slice.Difference([]{{.Elem}}{ {{- .B}}}))
+	// Output: [{{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_Difference(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, []{{.Elem}}{}, slice.Difference([]{{.Elem}}{ {{- .A}}}).O())
+	assert.Equal(t, []{{.Elem}}{ {{- .A}}, {{.B}}}, New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}).Difference(nil).O())
+	assert.Equal(t, []{{.Elem}}{ {{- .B}}}, New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}).Difference(New{{.Slice}}V({{.A}})).O())
+	assert.Equal(t, []{{.Elem}}{}, New{{.Slice}}V({{.A}}, {{.B}}).Difference([]{{.Elem}}{ {{- .B}}, {{.A}}}).O())
+}
+
+// Disjoint
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Disjoint() {
+	slice := New{{.Slice}}V({{.A}}, {{.A}})
+	fmt.Println(slice.Disjoint([]{{.Elem}}{ {{- .B}}}))
+	// Output: true
+}
+
+{{end -}}
+func Test{{.Slice}}_Disjoint(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.True(t, slice.Disjoint([]{{.Elem}}{ {{- .A}}}))
+	assert.True(t, New{{.Slice}}V({{.A}}).Disjoint(nil))
+	assert.True(t, New{{.Slice}}V({{.A}}).Disjoint(New{{.Slice}}V({{.B}})))
+	assert.False(t, New{{.Slice}}V({{.A}}, {{.B}}).Disjoint([]{{.Elem}}{ {{- .B}}}))
+}
+
+// Drop
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Drop() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	fmt.Println(slice.Drop(0, 1))
+	// Output: [{{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_Drop(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *{{.Slice}}
+		assert.Equal(t, (*{{.Slice}})(nil), slice.Drop(0, 1))
+		assert.Equal(t, New{{.Slice}}V(), New{{.Slice}}V().Drop(0, 1))
+	}
+
+	// drop all
+	{
+		slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+		assert.Equal(t, New{{.Slice}}V(), slice.Drop())
+		assert.Equal(t, New{{.Slice}}V(), slice)
+	}
+
+	// ranges
+	{
+		assert.Equal(t, New{{.Slice}}V({{.A}}), New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}).Drop(1, -1))
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.A}}), New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}).Drop(1, 1))
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}).Drop(-1, -1))
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}), New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}).Drop(2, 1))
+	}
+}
+
+// DropAt
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_DropAt() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	fmt.Println(slice.DropAt(1))
+	// Output: [{{.SA}} {{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_DropAt(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, (*{{.Slice}})(nil), slice.DropAt(0))
+	assert.Equal(t, New{{.Slice}}V(), New{{.Slice}}V().DropAt(0))
+	assert.Equal(t, New{{.Slice}}V({{.B}}), New{{.Slice}}V({{.A}}, {{.B}}).DropAt(0))
+	assert.Equal(t, New{{.Slice}}V({{.A}}), New{{.Slice}}V({{.A}}, {{.B}}).DropAt(-1))
+	assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), New{{.Slice}}V({{.A}}, {{.B}}).DropAt(2))
+}
+
+// DropFirst
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_DropFirst() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.DropFirst())
+	// Output: [{{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_DropFirst(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, (*{{.Slice}})(nil), slice.DropFirst())
+	assert.Equal(t, New{{.Slice}}V(), New{{.Slice}}V().DropFirst())
+	assert.Equal(t, New{{.Slice}}V({{.B}}), New{{.Slice}}V({{.A}}, {{.B}}).DropFirst())
+}
+
+// DropFirstN
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_DropFirstN() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	fmt.Println(slice.DropFirstN(2))
+	// Output: [{{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_DropFirstN(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, (*{{.Slice}})(nil), slice.DropFirstN(1))
+	assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), New{{.Slice}}V({{.A}}, {{.B}}).DropFirstN(0))
+	assert.Equal(t, New{{.Slice}}V({{.B}}), New{{.Slice}}V({{.A}}, {{.B}}).DropFirstN(1))
+	assert.Equal(t, New{{.Slice}}V(), New{{.Slice}}V({{.A}}, {{.B}}).DropFirstN(5))
+}
+
+// DropLast
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_DropLast() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.DropLast())
+	// Output: [{{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_DropLast(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, (*{{.Slice}})(nil), slice.DropLast())
+	assert.Equal(t, New{{.Slice}}V(), New{{.Slice}}V().DropLast())
+	assert.Equal(t, New{{.Slice}}V({{.A}}), New{{.Slice}}V({{.A}}, {{.B}}).DropLast())
+}
+
+// DropLastN
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_DropLastN() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	fmt.Println(slice.DropLastN(2))
+	// Output: [{{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_DropLastN(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, (*{{.Slice}})(nil), slice.DropLastN(1))
+	assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), New{{.Slice}}V({{.A}}, {{.B}}).DropLastN(0))
+	assert.Equal(t, New{{.Slice}}V({{.A}}), New{{.Slice}}V({{.A}}, {{.B}}).DropLastN(1))
+	assert.Equal(t, New{{.Slice}}V(), New{{.Slice}}V({{.A}}, {{.B}}).DropLastN(5))
+}
+
+// DropW
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_DropW() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	fmt.Println(slice.DropW(func(x {{.N}}O) bool {
+		return {{.Equal (.Assert "x") .A}}
+	}))
+	// Output: [{{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_DropW(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, (*{{.Slice}})(nil), slice.DropW(func(x {{.N}}O) bool { return true }))
+	assert.Equal(t, New{{.Slice}}V(), New{{.Slice}}V({{.A}}, {{.B}}).DropW(func(x {{.N}}O) bool { return true }))
+	assert.Equal(t, New{{.Slice}}V({{.A}}, {{.A}}), New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}).DropW(func(x {{.N}}O) bool {
+		return {{.Equal (.Assert "x") .B}}
+	}))
+}
+
+// Each
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Each() {
+	New{{.Slice}}V({{.A}}, {{.B}}).Each(func(x {{.N}}O) {
+		fmt.Print({{.Format (.Assert "x")}}, ";")
+	})
+	// Output: {{.SA}};{{.SB}};
+}
+
+{{end -}}
+func Test{{.Slice}}_Each(t *testing.T) {
+	var slice *{{.Slice}}
+	slice.Each(func(x {{.N}}O) { assert.Fail(t, "should not be called") })
+
+	var results []{{.Elem}}
+	New{{.Slice}}V({{.A}}, {{.B}}).Each(func(x {{.N}}O) {
+		results = append(results, x.({{.Elem}}))
+	})
+	assert.Equal(t, []{{.Elem}}{ {{- .A}}, {{.B}}}, results)
+}
+
+// EachE
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_EachE() {
+	New{{.Slice}}V({{.A}}, {{.B}}).EachE(func(x {{.N}}O) error {
+		fmt.Print({{.Format (.Assert "x")}}, ";")
+		return nil
+	})
+	// Output: {{.SA}};{{.SB}};
+}
+
+{{end -}}
+func Test{{.Slice}}_EachE(t *testing.T) {
+
+	// nil
+	{
+		var slice *{{.Slice}}
+		_, err := slice.EachE(func(x {{.N}}O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []{{.Elem}}
+		_, err := New{{.Slice}}V({{.A}}, {{.B}}).EachE(func(x {{.N}}O) error {
+			if {{.Equal (.Assert "x") .B}} {
+				return {{.N}}Break
+			}
+			results = append(results, x.({{.Elem}}))
+			return nil
+		})
+		assert.Equal(t, {{.N}}Break, err)
+		assert.Equal(t, []{{.Elem}}{ {{- .A}}}, results)
+	}
+}
+
+// EachI
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_EachI() {
+	New{{.Slice}}V({{.A}}, {{.B}}).EachI(func(i int, x {{.N}}O) {
+		fmt.Print(i, ":", {{.Format (.Assert "x")}}, ";")
+	})
+	// Output: 0:{{.SA}};1:{{.SB}};
+}
+
+{{end -}}
+func Test{{.Slice}}_EachI(t *testing.T) {
+	var slice *{{.Slice}}
+	slice.EachI(func(i int, x {{.N}}O) { assert.Fail(t, "should not be called") })
+
+	var results []int
+	New{{.Slice}}V({{.A}}, {{.B}}).EachI(func(i int, x {{.N}}O) {
+		results = append(results, i)
+	})
+	assert.Equal(t, []int{0, 1}, results)
+}
+
+// EachIE
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_EachIE() {
+	New{{.Slice}}V({{.A}}, {{.B}}).EachIE(func(i int, x {{.N}}O) error {
+		fmt.Print(i, ":", {{.Format (.Assert "x")}}, ";")
+		return nil
+	})
+	// Output: 0:{{.SA}};1:{{.SB}};
+}
+
+{{end -}}
+func Test{{.Slice}}_EachIE(t *testing.T) {
+
+	// nil
+	{
+		var slice *{{.Slice}}
+		_, err := slice.EachIE(func(i int, x {{.N}}O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []int
+		_, err := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}).EachIE(func(i int, x {{.N}}O) error {
+			if i == 1 {
+				return {{.N}}Break
+			}
+			results = append(results, i)
+			return nil
+		})
+		assert.Equal(t, {{.N}}Break, err)
+		assert.Equal(t, []int{0}, results)
+	}
+}
+
+// EachP
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_EachP() {
+	slice, err := New{{.Slice}}V({{.A}}, {{.B}}).EachP(context.Background(), 2, func(ctx context.Context, x {{.N}}O) error {
+		return nil
+	})
+	fmt.Println(slice, err)
+	// Output: [{{.SA}} {{.SB}}] <nil>
+}
+
+{{end -}}
+func Test{{.Slice}}_EachP(t *testing.T) {
+
+	// nil
+	{
+		var slice *{{.Slice}}
+		_, err := slice.EachP(context.Background(), 2, func(ctx context.Context, x {{.N}}O) error {
+			return nil
+		})
+		assert.Nil(t, err)
+	}
+
+	// first error is returned
+	{
+		_, err := New{{.Slice}}V({{.A}}, {{.B}}).EachP(context.Background(), 0, func(ctx context.Context, x {{.N}}O) error {
+			if {{.Equal (.Assert "x") .B}} {
+				return errors.New("failed")
+			}
+			return nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+
+	// cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := New{{.Slice}}V({{.A}}, {{.B}}).EachP(ctx, 2, func(ctx context.Context, x {{.N}}O) error {
+			return nil
+		})
+		assert.Equal(t, context.Canceled, err)
+	}
+}
+
+// EachR
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_EachR() {
+	New{{.Slice}}V({{.A}}, {{.B}}).EachR(func(x {{.N}}O) {
+		fmt.Print({{.Format (.Assert "x")}}, ";")
+	})
+	// Output: {{.SB}};{{.SA}};
+}
+
+{{end -}}
+func Test{{.Slice}}_EachR(t *testing.T) {
+	var slice *{{.Slice}}
+	slice.EachR(func(x {{.N}}O) { assert.Fail(t, "should not be called") })
+
+	var results []{{.Elem}}
+	New{{.Slice}}V({{.A}}, {{.B}}).EachR(func(x {{.N}}O) {
+		results = append(results, x.({{.Elem}}))
+	})
+	assert.Equal(t, []{{.Elem}}{ {{- .B}}, {{.A}}}, results)
+}
+
+// EachRE
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_EachRE() {
+	New{{.Slice}}V({{.A}}, {{.B}}).EachRE(func(x {{.N}}O) error {
+		fmt.Print({{.Format (.Assert "x")}}, ";")
+		return nil
+	})
+	// Output: {{.SB}};{{.SA}};
+}
+
+{{end -}}
+func Test{{.Slice}}_EachRE(t *testing.T) {
+
+	// nil
+	{
+		var slice *{{.Slice}}
+		_, err := slice.EachRE(func(x {{.N}}O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []{{.Elem}}
+		_, err := New{{.Slice}}V({{.A}}, {{.B}}).EachRE(func(x {{.N}}O) error {
+			if {{.Equal (.Assert "x") .A}} {
+				return {{.N}}Break
+			}
+			results = append(results, x.({{.Elem}}))
+			return nil
+		})
+		assert.Equal(t, {{.N}}Break, err)
+		assert.Equal(t, []{{.Elem}}{ {{- .B}}}, results)
+	}
+}
+
+// EachRI
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_EachRI() {
+	New{{.Slice}}V({{.A}}, {{.B}}).EachRI(func(i int, x {{.N}}O) {
+		fmt.Print(i, ":", {{.Format (.Assert "x")}}, ";")
+	})
+	// Output: 1:{{.SB}};0:{{.SA}};
+}
+
+{{end -}}
+func Test{{.Slice}}_EachRI(t *testing.T) {
+	var slice *{{.Slice}}
+	slice.EachRI(func(i int, x {{.N}}O) { assert.Fail(t, "should not be called") })
+
+	var results []int
+	New{{.Slice}}V({{.A}}, {{.B}}).EachRI(func(i int, x {{.N}}O) {
+		results = append(results, i)
+	})
+	assert.Equal(t, []int{1, 0}, results)
+}
+
+// EachRIE
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_EachRIE() {
+	New{{.Slice}}V({{.A}}, {{.B}}).EachRIE(func(i int, x {{.N}}O) error {
+		fmt.Print(i, ":", {{.Format (.Assert "x")}}, ";")
+		return nil
+	})
+	// Output: 1:{{.SB}};0:{{.SA}};
+}
+
+{{end -}}
+func Test{{.Slice}}_EachRIE(t *testing.T) {
+
+	// nil
+	{
+		var slice *{{.Slice}}
+		_, err := slice.EachRIE(func(i int, x {{.N}}O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []int
+		_, err := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}).EachRIE(func(i int, x {{.N}}O) error {
+			if i == 1 {
+				return {{.N}}Break
+			}
+			results = append(results, i)
+			return nil
+		})
+		assert.Equal(t, {{.N}}Break, err)
+		assert.Equal(t, []int{2}, results)
+	}
+}
+
+// Empty
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Empty() {
+	fmt.Println(New{{.Slice}}V().Empty())
+	// Output: true
+}
+
+{{end -}}
+func Test{{.Slice}}_Empty(t *testing.T) {
+	assert.True(t, (*{{.Slice}})(nil).Empty())
+	assert.True(t, New{{.Slice}}V().Empty())
+	assert.False(t, New{{.Slice}}V({{.A}}).Empty())
+}
+
+// First
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_First() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.First().O())
+	// Output: {{.OA}}
+}
+
+{{end -}}
+func Test{{.Slice}}_First(t *testing.T) {
+	assert.Equal(t, {{.N}}Obj(nil), (*{{.Slice}})(nil).First())
+	assert.Equal(t, {{.N}}Obj(nil), New{{.Slice}}V().First())
+	assert.Equal(t, {{.N}}Obj({{.A}}), New{{.Slice}}V({{.A}}, {{.B}}).First())
+}
+
+// FirstN
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_FirstN() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	fmt.Println(slice.FirstN(2))
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_FirstN(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, New{{.Slice}}V(), slice.FirstN(1))
+	assert.Equal(t, New{{.Slice}}V(), New{{.Slice}}V({{.A}}).FirstN(0))
+	assert.Equal(t, New{{.Slice}}V({{.A}}), New{{.Slice}}V({{.A}}, {{.B}}).FirstN(1))
+	assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), New{{.Slice}}V({{.A}}, {{.B}}).FirstN(5))
+
+	// reference to the original
+	{
+		slice := New{{.Slice}}V({{.A}}, {{.B}})
+		slice.FirstN(1).Set(0, {{.B}})
+		assert.Equal(t, New{{.Slice}}V({{.B}}, {{.B}}), slice)
+	}
+}
+
+// FlatMap
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_FlatMap() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.FlatMap(func(x {{.N}}O) {{.N}}O {
+		return []interface{}{x, x}
+	}))
+	// Output: [{{.SA}} {{.SA}} {{.SB}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_FlatMap(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, 0, slice.FlatMap(func(x {{.N}}O) {{.N}}O { return x }).Len())
+	assert.Equal(t, 0, New{{.Slice}}V().FlatMap(func(x {{.N}}O) {{.N}}O { return x }).Len())
+	assert.Equal(t, {{.N}}NewStringSliceV({{.Word .A}}, {{.Word .A}}, {{.Word .B}}, {{.Word .B}}), New{{.Slice}}V({{.A}}, {{.B}}).FlatMap(func(x {{.N}}O) {{.N}}O {
+		return []string{ {{- .Word (.Assert "x")}}, {{.Word (.Assert "x")}}}
+	}))
+}
+
+// Flatten
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Flatten() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.Flatten())
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_Flatten(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, 0, slice.Flatten().Len())
+
+	// already flat so it is a copy
+	{
+		slice := New{{.Slice}}V({{.A}}, {{.B}})
+		new := slice.Flatten()
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), new)
+		new.Set(0, {{.B}})
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), slice)
+	}
+}
+
+// G
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_G() {
+	fmt.Println(len(New{{.Slice}}V({{.A}}, {{.B}}).G()))
+	// Output: 2
+}
+
+{{end -}}
+func Test{{.Slice}}_G(t *testing.T) {
+	assert.Equal(t, []{{.Elem}}{}, (*{{.Slice}})(nil).G())
+	assert.Equal(t, []{{.Elem}}{}, New{{.Slice}}V().G())
+	assert.Equal(t, []{{.Elem}}{ {{- .A}}, {{.B}}}, New{{.Slice}}V({{.A}}, {{.B}}).G())
+}
+
+// GroupBy
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_GroupBy() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	groups := slice.GroupBy(func(x {{.N}}O) {{.N}}O {
+		return {{.Equal (.Assert "x") .A}}
+	})
+	fmt.Println(groups.Get(true).O(), groups.Get(false).O())
+	// Output: [{{.SA}} {{.SA}}] [{{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_GroupBy(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, 0, slice.GroupBy(func(x {{.N}}O) {{.N}}O { return x }).Len())
+	assert.Equal(t, 0, New{{.Slice}}V().GroupBy(func(x {{.N}}O) {{.N}}O { return x }).Len())
+
+	// groups keep first seen order
+	{
+		groups := New{{.Slice}}V({{.B}}, {{.A}}, {{.B}}).GroupBy(func(x {{.N}}O) {{.N}}O {
+			return {{.Equal (.Assert "x") .A}}
+		})
+		assert.Equal(t, []string{"false", "true"}, groups.Keys().O())
+		assert.Equal(t, []{{.Elem}}{ {{- .B}}, {{.B}}}, groups.Get(false).O().({{.N}}ISlice).O())
+		assert.Equal(t, []{{.Elem}}{ {{- .A}}}, groups.Get(true).O().({{.N}}ISlice).O())
+	}
+}
+
+// Index
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Index() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.Index({{.B}}))
+	// Output: 1
+}
+
+{{end -}}
+func Test{{.Slice}}_Index(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, -1, slice.Index({{.A}}))
+	assert.Equal(t, -1, New{{.Slice}}V().Index({{.A}}))
+	assert.Equal(t, 0, New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}).Index({{.A}}))
+	assert.Equal(t, 1, New{{.Slice}}V({{.A}}, {{.B}}).Index({{.StrB}}))
+	assert.Equal(t, -1, New{{.Slice}}V({{.A}}).Index({{.B}}))
+	assert.Equal(t, -1, New{{.Slice}}V({{.A}}).Index({{.Bad}}))
+}
+
+// Insert
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Insert() {
+	slice := New{{.Slice}}V({{.A}}, {{.A}})
+	fmt.Println(slice.Insert(1, {{.B}}))
+	// Output: [{{.SA}} {{.SB}} {{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_Insert(t *testing.T) {
+
+	// nil
+	{
+		var slice *{{.Slice}}
+		assert.Equal(t, New{{.Slice}}V({{.A}}), slice.Insert(0, {{.A}}))
+		assert.Equal(t, (*{{.Slice}})(nil), slice)
+	}
+
+	// positions
+	{
+		assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}), New{{.Slice}}V({{.A}}).Insert(0, {{.B}}))
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), New{{.Slice}}V({{.A}}).Insert(-1, {{.B}}))
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}, {{.B}}, {{.A}}), New{{.Slice}}V({{.A}}, {{.A}}).Insert(1, []{{.Elem}}{ {{- .B}}, {{.B}}}))
+		assert.Equal(t, New{{.Slice}}V({{.A}}), New{{.Slice}}V({{.A}}).Insert(5, {{.B}}))
+	}
+
+	// conversion
+	{
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}), New{{.Slice}}V({{.A}}, {{.A}}).Insert(1, {{.StrB}}))
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.A}}), New{{.Slice}}V({{.A}}, {{.A}}).Insert(1, {{.Bad}}))
+	}
+}
+
+// InterSlice
+//--------------------------------------------------------------------------------------------------
+func Test{{.Slice}}_InterSlice(t *testing.T) {
+	assert.False(t, (*{{.Slice}})(nil).InterSlice())
+	assert.False(t, New{{.Slice}}V({{.A}}).InterSlice())
+}
+
+// Intersect
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Intersect() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	fmt.Println(slice.Intersect([]{{.Elem}}{ {{- .A}}}))
+	// Output: [{{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_Intersect(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, []{{.Elem}}{}, slice.Intersect([]{{.Elem}}{ {{- .A}}}).O())
+	assert.Equal(t, []{{.Elem}}{}, New{{.Slice}}V({{.A}}).Intersect(nil).O())
+	assert.Equal(t, []{{.Elem}}{ {{- .B}}, {{.A}}}, New{{.Slice}}V({{.B}}, {{.A}}, {{.B}}).Intersect(New{{.Slice}}V({{.A}}, {{.B}})).O())
+	assert.Equal(t, []{{.Elem}}{}, New{{.Slice}}V({{.A}}, {{.A}}).Intersect([]{{.Elem}}{ {{- .B}}}).O())
+}
+
+// Iter
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Iter() {
+	it := New{{.Slice}}V({{.A}}, {{.B}}).Iter()
+	for it.Next() {
+		fmt.Println(it.Index(), it.Value())
+	}
+	// Output:
+	// 0 {{.OA}}
+	// 1 {{.OB}}
+}
+
+{{end -}}
+func Test{{.Slice}}_Iter(t *testing.T) {
+	assert.False(t, (*{{.Slice}})(nil).Iter().Next())
+	assert.False(t, New{{.Slice}}V().Iter().Next())
+
+	it := New{{.Slice}}V({{.A}}, {{.B}}).Iter()
+	assert.Equal(t, -1, it.Index())
+	assert.True(t, it.Next())
+	assert.Equal(t, 0, it.Index())
+	assert.Equal(t, {{.N}}Obj({{.A}}).O(), it.Value())
+	assert.True(t, it.Next())
+	assert.Equal(t, 1, it.Index())
+	assert.Equal(t, {{.N}}Obj({{.B}}).O(), it.Value())
+	assert.False(t, it.Next())
+	assert.Nil(t, it.Value())
+	assert.Nil(t, it.Err())
+}
+
+// IsSubset
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_IsSubset() {
+	slice := New{{.Slice}}V({{.A}}, {{.A}})
+	fmt.Println(slice.IsSubset([]{{.Elem}}{ {{- .A}}, {{.B}}}))
+	// Output: true
+}
+
+{{end -}}
+func Test{{.Slice}}_IsSubset(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.True(t, slice.IsSubset([]{{.Elem}}{ {{- .A}}}))
+	assert.True(t, New{{.Slice}}V({{.B}}).IsSubset(New{{.Slice}}V({{.A}}, {{.B}})))
+	assert.False(t, New{{.Slice}}V({{.A}}, {{.B}}).IsSubset([]{{.Elem}}{ {{- .B}}}))
+	assert.False(t, New{{.Slice}}V({{.A}}).IsSubset(nil))
+}
+
+// Join
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Join() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.Join())
+	// Output: {{.SA}},{{.SB}}
+}
+
+{{end -}}
+func Test{{.Slice}}_Join(t *testing.T) {
+	assert.Equal(t, {{.N}}Obj(""), (*{{.Slice}})(nil).Join())
+	assert.Equal(t, {{.N}}Obj(""), New{{.Slice}}V().Join())
+	assert.Equal(t, {{.N}}Obj({{.Str "{A}"}}), New{{.Slice}}V({{.A}}).Join())
+	assert.Equal(t, {{.N}}Obj({{.Str "{A}.{B}"}}), New{{.Slice}}V({{.A}}, {{.B}}).Join("."))
+}
+
+// Last
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Last() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.Last().O())
+	// Output: {{.OB}}
+}
+
+{{end -}}
+func Test{{.Slice}}_Last(t *testing.T) {
+	assert.Equal(t, {{.N}}Obj(nil), (*{{.Slice}})(nil).Last())
+	assert.Equal(t, {{.N}}Obj(nil), New{{.Slice}}V().Last())
+	assert.Equal(t, {{.N}}Obj({{.B}}), New{{.Slice}}V({{.A}}, {{.B}}).Last())
+}
+
+// LastN
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_LastN() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	fmt.Println(slice.LastN(2))
+	// Output: [{{.SB}} {{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_LastN(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, New{{.Slice}}V(), slice.LastN(1))
+	assert.Equal(t, New{{.Slice}}V(), New{{.Slice}}V({{.A}}).LastN(0))
+	assert.Equal(t, New{{.Slice}}V({{.B}}), New{{.Slice}}V({{.A}}, {{.B}}).LastN(1))
+	assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), New{{.Slice}}V({{.A}}, {{.B}}).LastN(5))
+}
+
+// Len
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Len() {
+	fmt.Println(New{{.Slice}}V({{.A}}, {{.B}}).Len())
+	// Output: 2
+}
+
+{{end -}}
+func Test{{.Slice}}_Len(t *testing.T) {
+	assert.Equal(t, 0, (*{{.Slice}})(nil).Len())
+	assert.Equal(t, 0, New{{.Slice}}V().Len())
+	assert.Equal(t, 2, New{{.Slice}}V({{.A}}, {{.B}}).Len())
+}
+{{if .Ordered}}
+// Less
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Less() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.Less(0, 1))
+	// Output: true
+}
+
+{{end -}}
+func Test{{.Slice}}_Less(t *testing.T) {
+	assert.False(t, (*{{.Slice}})(nil).Less(0, 1))
+	assert.False(t, New{{.Slice}}V({{.A}}).Less(0, 1))
+	assert.True(t, New{{.Slice}}V({{.A}}, {{.B}}).Less(0, 1))
+	assert.False(t, New{{.Slice}}V({{.A}}, {{.B}}).Less(1, 0))
+	assert.False(t, New{{.Slice}}V({{.A}}, {{.A}}).Less(0, 1))
+	assert.False(t, New{{.Slice}}V({{.A}}, {{.B}}).Less(0, 2))
+}
+{{end}}
+// Map
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Map() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.Map(func(x {{.N}}O) {{.N}}O {
+		return {{.B}}
+	}))
+	// Output: [{{.SB}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_Map(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, New{{.Slice}}V(), slice.Map(func(x {{.N}}O) {{.N}}O { return x }))
+	assert.Equal(t, 2, New{{.Slice}}V({{.A}}, {{.B}}).Map(func(x {{.N}}O) {{.N}}O { return x }).Len())
+
+	// type change
+	{
+		assert.Equal(t, {{.N}}NewStringSliceV({{.Word .A}}, {{.Word .B}}), New{{.Slice}}V({{.A}}, {{.B}}).Map(func(x {{.N}}O) {{.N}}O {
+			return {{.Word (.Assert "x")}}
+		}))
+	}
+}
+
+// MapP
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_MapP() {
+	slice, _ := New{{.Slice}}V({{.A}}, {{.B}}).MapP(context.Background(), 2, func(ctx context.Context, x {{.N}}O) ({{.N}}O, error) {
+		return x, nil
+	})
+	fmt.Println(slice)
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_MapP(t *testing.T) {
+
+	// nil
+	{
+		var slice *{{.Slice}}
+		new, err := slice.MapP(context.Background(), 2, func(ctx context.Context, x {{.N}}O) ({{.N}}O, error) {
+			return x, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// first error is returned
+	{
+		_, err := New{{.Slice}}V({{.A}}, {{.B}}).MapP(context.Background(), 0, func(ctx context.Context, x {{.N}}O) ({{.N}}O, error) {
+			if {{.Equal (.Assert "x") .B}} {
+				return nil, errors.New("failed")
+			}
+			return x, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+}
+
+// Nil
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Nil() {
+	var slice *{{.Slice}}
+	fmt.Println(slice.Nil())
+	// Output: true
+}
+
+{{end -}}
+func Test{{.Slice}}_Nil(t *testing.T) {
+	assert.True(t, (*{{.Slice}})(nil).Nil())
+	assert.False(t, New{{.Slice}}V().Nil())
+	assert.False(t, New{{.Slice}}V({{.A}}).Nil())
+}
+
+// O
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_O() {
+	fmt.Println(len(New{{.Slice}}V({{.A}}, {{.B}}).O().([]{{.Elem}})))
+	// Output: 2
+}
+
+{{end -}}
+func Test{{.Slice}}_O(t *testing.T) {
+	assert.Equal(t, []{{.Elem}}{}, (*{{.Slice}})(nil).O())
+	assert.Equal(t, []{{.Elem}}{}, New{{.Slice}}V().O())
+	assert.Equal(t, []{{.Elem}}{ {{- .A}}, {{.B}}}, New{{.Slice}}V({{.A}}, {{.B}}).O())
+}
+
+// Pair
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Pair() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	first, second := slice.Pair()
+	fmt.Println(first.O(), second.O())
+	// Output: {{.OA}} {{.OB}}
+}
+
+{{end -}}
+func Test{{.Slice}}_Pair(t *testing.T) {
+
+	// nil
+	{
+		first, second := (*{{.Slice}})(nil).Pair()
+		assert.Equal(t, {{.N}}Obj(nil), first)
+		assert.Equal(t, {{.N}}Obj(nil), second)
+	}
+
+	// one value
+	{
+		first, second := New{{.Slice}}V({{.A}}).Pair()
+		assert.Equal(t, {{.N}}Obj({{.A}}), first)
+		assert.Equal(t, {{.N}}Obj(nil), second)
+	}
+
+	// two values
+	{
+		first, second := New{{.Slice}}V({{.A}}, {{.B}}).Pair()
+		assert.Equal(t, {{.N}}Obj({{.A}}), first)
+		assert.Equal(t, {{.N}}Obj({{.B}}), second)
+	}
+}
+
+// Partition
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Partition() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	match, rest := slice.Partition(func(x {{.N}}O) bool {
+		return {{.Equal (.Assert "x") .A}}
+	})
+	fmt.Println(match, rest)
+	// Output: [{{.SA}} {{.SA}}] [{{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_Partition(t *testing.T) {
+
+	// nil
+	{
+		var slice *{{.Slice}}
+		match, rest := slice.Partition(func(x {{.N}}O) bool { return true })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+	}
+
+	// all match
+	{
+		match, rest := New{{.Slice}}V({{.A}}, {{.B}}).Partition(func(x {{.N}}O) bool { return true })
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), match)
+		assert.Equal(t, New{{.Slice}}V(), rest)
+	}
+}
+
+// Pop
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Pop() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.Pop().O())
+	// Output: {{.OB}}
+}
+
+{{end -}}
+func Test{{.Slice}}_Pop(t *testing.T) {
+	assert.Equal(t, {{.N}}Obj(nil), (*{{.Slice}})(nil).Pop())
+	assert.Equal(t, {{.N}}Obj(nil), New{{.Slice}}V().Pop())
+
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	assert.Equal(t, {{.N}}Obj({{.B}}), slice.Pop())
+	assert.Equal(t, New{{.Slice}}V({{.A}}), slice)
+}
+
+// PopN
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_PopN() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	fmt.Println(slice.PopN(2))
+	// Output: [{{.SB}} {{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_PopN(t *testing.T) {
+	assert.Equal(t, New{{.Slice}}V(), (*{{.Slice}})(nil).PopN(1))
+	assert.Equal(t, New{{.Slice}}V(), New{{.Slice}}V({{.A}}).PopN(0))
+
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}), slice.PopN(2))
+	assert.Equal(t, New{{.Slice}}V({{.A}}), slice)
+	assert.Equal(t, New{{.Slice}}V({{.A}}), slice.PopN(5))
+	assert.Equal(t, New{{.Slice}}V(), slice)
+}
+
+// Prepend
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Prepend() {
+	slice := New{{.Slice}}V({{.B}})
+	fmt.Println(slice.Prepend({{.A}}))
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_Prepend(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, New{{.Slice}}V({{.A}}), slice.Prepend({{.A}}))
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}), New{{.Slice}}V({{.A}}).Prepend({{.StrB}}))
+}
+
+// RefSlice
+//--------------------------------------------------------------------------------------------------
+func Test{{.Slice}}_RefSlice(t *testing.T) {
+	assert.False(t, (*{{.Slice}})(nil).RefSlice())
+	assert.False(t, New{{.Slice}}V({{.A}}).RefSlice())
+}
+
+// Reverse
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Reverse() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.Reverse())
+	// Output: [{{.SB}} {{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_Reverse(t *testing.T) {
+	assert.Equal(t, New{{.Slice}}V(), (*{{.Slice}})(nil).Reverse())
+
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}), slice.Reverse())
+	assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), slice)
+}
+
+// ReverseM
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_ReverseM() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.ReverseM())
+	// Output: [{{.SB}} {{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_ReverseM(t *testing.T) {
+	assert.Equal(t, (*{{.Slice}})(nil), (*{{.Slice}})(nil).ReverseM())
+
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}), slice.ReverseM())
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}), slice)
+}
+
+// S
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_S() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.S())
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_S(t *testing.T) {
+	assert.Equal(t, {{.N}}NewStringSliceV(), (*{{.Slice}})(nil).S())
+	assert.Equal(t, {{.N}}NewStringSliceV({{.Str "{A}"}}, {{.Str "{B}"}}), New{{.Slice}}V({{.A}}, {{.B}}).S())
+}
+
+// Select
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Select() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	fmt.Println(slice.Select(func(x {{.N}}O) bool {
+		return {{.Equal (.Assert "x") .A}}
+	}))
+	// Output: [{{.SA}} {{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_Select(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, New{{.Slice}}V(), slice.Select(func(x {{.N}}O) bool { return true }))
+	assert.Equal(t, New{{.Slice}}V(), New{{.Slice}}V({{.A}}).Select(func(x {{.N}}O) bool { return false }))
+	assert.Equal(t, New{{.Slice}}V({{.B}}), New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}).Select(func(x {{.N}}O) bool {
+		return {{.Equal (.Assert "x") .B}}
+	}))
+}
+
+// SelectP
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_SelectP() {
+	slice, _ := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}).SelectP(context.Background(), 2, func(ctx context.Context, x {{.N}}O) (bool, error) {
+		return {{.Equal (.Assert "x") .A}}, nil
+	})
+	fmt.Println(slice)
+	// Output: [{{.SA}} {{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_SelectP(t *testing.T) {
+
+	// nil
+	{
+		var slice *{{.Slice}}
+		new, err := slice.SelectP(context.Background(), 2, func(ctx context.Context, x {{.N}}O) (bool, error) {
+			return true, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// first error is returned
+	{
+		_, err := New{{.Slice}}V({{.A}}, {{.B}}).SelectP(context.Background(), 0, func(ctx context.Context, x {{.N}}O) (bool, error) {
+			if {{.Equal (.Assert "x") .B}} {
+				return false, errors.New("failed")
+			}
+			return true, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+}
+
+// Set
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Set() {
+	slice := New{{.Slice}}V({{.A}}, {{.A}})
+	fmt.Println(slice.Set(0, {{.B}}))
+	// Output: [{{.SB}} {{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_Set(t *testing.T) {
+	assert.Equal(t, (*{{.Slice}})(nil), (*{{.Slice}})(nil).Set(0, {{.A}}))
+	assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), New{{.Slice}}V({{.A}}, {{.A}}).Set(-1, {{.B}}))
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.B}}), New{{.Slice}}V({{.A}}, {{.A}}).Set(0, []{{.Elem}}{ {{- .B}}, {{.B}}}))
+	assert.Equal(t, New{{.Slice}}V({{.A}}, {{.A}}), New{{.Slice}}V({{.A}}, {{.A}}).Set(5, {{.B}}))
+}
+
+// SetE
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_SetE() {
+	slice := New{{.Slice}}V({{.A}}, {{.A}})
+	fmt.Println(slice.SetE(1, {{.B}}))
+	// Output: [{{.SA}} {{.SB}}] <nil>
+}
+
+{{end -}}
+func Test{{.Slice}}_SetE(t *testing.T) {
+
+	// nil
+	{
+		slice, err := (*{{.Slice}})(nil).SetE(0, {{.A}})
+		assert.Nil(t, err)
+		assert.Equal(t, (*{{.Slice}})(nil), slice)
+	}
+
+	// conversion
+	{
+		slice, err := New{{.Slice}}V({{.A}}, {{.A}}).SetE(0, {{.StrB}})
+		assert.Nil(t, err)
+		assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}), slice)
+	}
+
+	// out of bounds
+	{
+		slice, err := New{{.Slice}}V({{.A}}).SetE(2, {{.B}})
+		assert.Equal(t, "slice assignment is out of bounds", err.Error())
+		assert.Equal(t, New{{.Slice}}V({{.A}}), slice)
+	}
+}
+
+// Shift
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Shift() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.Shift().O())
+	// Output: {{.OA}}
+}
+
+{{end -}}
+func Test{{.Slice}}_Shift(t *testing.T) {
+	assert.Equal(t, {{.N}}Obj(nil), (*{{.Slice}})(nil).Shift())
+	assert.Equal(t, {{.N}}Obj(nil), New{{.Slice}}V().Shift())
+
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	assert.Equal(t, {{.N}}Obj({{.A}}), slice.Shift())
+	assert.Equal(t, New{{.Slice}}V({{.B}}), slice)
+}
+
+// ShiftN
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_ShiftN() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	fmt.Println(slice.ShiftN(2))
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_ShiftN(t *testing.T) {
+	assert.Equal(t, New{{.Slice}}V(), (*{{.Slice}})(nil).ShiftN(1))
+	assert.Equal(t, New{{.Slice}}V(), New{{.Slice}}V({{.A}}).ShiftN(0))
+
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), slice.ShiftN(2))
+	assert.Equal(t, New{{.Slice}}V({{.A}}), slice)
+	assert.Equal(t, New{{.Slice}}V({{.A}}), slice.ShiftN(5))
+	assert.Equal(t, New{{.Slice}}V(), slice)
+}
+
+// Single
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Single() {
+	fmt.Println(New{{.Slice}}V({{.A}}).Single())
+	// Output: true
+}
+
+{{end -}}
+func Test{{.Slice}}_Single(t *testing.T) {
+	assert.False(t, (*{{.Slice}})(nil).Single())
+	assert.False(t, New{{.Slice}}V().Single())
+	assert.True(t, New{{.Slice}}V({{.A}}).Single())
+	assert.False(t, New{{.Slice}}V({{.A}}, {{.B}}).Single())
+}
+
+// Slice
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Slice() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	fmt.Println(slice.Slice(1, -1))
+	// Output: [{{.SB}} {{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_Slice(t *testing.T) {
+	assert.Equal(t, New{{.Slice}}V(), (*{{.Slice}})(nil).Slice(0, -1))
+	assert.Equal(t, New{{.Slice}}V(), New{{.Slice}}V().Slice(0, -1))
+
+	// reference to the original
+	{
+		slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}), slice.Slice())
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), slice.Slice(0, 1))
+		assert.Equal(t, New{{.Slice}}V(), slice.Slice(2, 1))
+		slice.Slice(-1, -1).Set(0, {{.B}})
+		assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}, {{.B}}), slice)
+	}
+}
+{{if .Ordered}}
+// Sort
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Sort() {
+	slice := New{{.Slice}}V({{.B}}, {{.A}})
+	fmt.Println(slice.Sort())
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_Sort(t *testing.T) {
+	assert.Equal(t, New{{.Slice}}V(), (*{{.Slice}})(nil).Sort())
+
+	slice := New{{.Slice}}V({{.B}}, {{.A}}, {{.B}}, {{.A}})
+	assert.Equal(t, New{{.Slice}}V({{.A}}, {{.A}}, {{.B}}, {{.B}}), slice.Sort())
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}, {{.B}}, {{.A}}), slice)
+}
+{{end}}{{if .Ordered}}
+// SortBy
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_SortBy() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.SortBy(func(a, b {{.N}}O) bool {
+		return {{.Less (.Assert "b") (.Assert "a")}}
+	}))
+	// Output: [{{.SB}} {{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_SortBy(t *testing.T) {
+	assert.Equal(t, New{{.Slice}}V(), (*{{.Slice}})(nil).SortBy(func(a, b {{.N}}O) bool { return false }))
+
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}, {{.A}}), slice.SortBy(func(a, b {{.N}}O) bool {
+		return {{.Less (.Assert "b") (.Assert "a")}}
+	}))
+	assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}), slice)
+}
+{{end}}{{if .Ordered}}
+// SortByM
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_SortByM() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.SortByM(func(a, b {{.N}}O) bool {
+		return {{.Less (.Assert "b") (.Assert "a")}}
+	}))
+	// Output: [{{.SB}} {{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_SortByM(t *testing.T) {
+	assert.Equal(t, (*{{.Slice}})(nil), (*{{.Slice}})(nil).SortByM(func(a, b {{.N}}O) bool { return false }))
+
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}, {{.A}}), slice.SortByM(func(a, b {{.N}}O) bool {
+		return {{.Less (.Assert "b") (.Assert "a")}}
+	}))
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}, {{.A}}), slice)
+}
+{{end}}{{if .Ordered}}
+// SortM
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_SortM() {
+	slice := New{{.Slice}}V({{.B}}, {{.A}})
+	fmt.Println(slice.SortM())
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_SortM(t *testing.T) {
+	assert.Equal(t, (*{{.Slice}})(nil), (*{{.Slice}})(nil).SortM())
+
+	slice := New{{.Slice}}V({{.B}}, {{.A}}, {{.B}})
+	assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}, {{.B}}), slice.SortM())
+	assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}, {{.B}}), slice)
+}
+{{end}}{{if .Ordered}}
+// SortReverse
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_SortReverse() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.SortReverse())
+	// Output: [{{.SB}} {{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_SortReverse(t *testing.T) {
+	assert.Equal(t, New{{.Slice}}V(), (*{{.Slice}})(nil).SortReverse())
+
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}, {{.A}}), slice.SortReverse())
+	assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}), slice)
+}
+{{end}}{{if .Ordered}}
+// SortReverseM
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_SortReverseM() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.SortReverseM())
+	// Output: [{{.SB}} {{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_SortReverseM(t *testing.T) {
+	assert.Equal(t, (*{{.Slice}})(nil), (*{{.Slice}})(nil).SortReverseM())
+
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}, {{.A}}), slice.SortReverseM())
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}, {{.A}}), slice)
+}
+{{end}}{{if .Ordered}}
+// SortStable
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_SortStable() {
+	slice := New{{.Slice}}V({{.B}}, {{.A}})
+	fmt.Println(slice.SortStable(func(a, b {{.N}}O) bool {
+		return {{.Less (.Assert "a") (.Assert "b")}}
+	}))
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_SortStable(t *testing.T) {
+	assert.Equal(t, New{{.Slice}}V(), (*{{.Slice}})(nil).SortStable(func(a, b {{.N}}O) bool { return false }))
+
+	slice := New{{.Slice}}V({{.B}}, {{.A}}, {{.B}})
+	assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}, {{.B}}), slice.SortStable(func(a, b {{.N}}O) bool {
+		return {{.Less (.Assert "a") (.Assert "b")}}
+	}))
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}, {{.B}}), slice)
+}
+{{end}}{{if .Ordered}}
+// SortStableM
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_SortStableM() {
+	slice := New{{.Slice}}V({{.B}}, {{.A}})
+	fmt.Println(slice.SortStableM(func(a, b {{.N}}O) bool {
+		return {{.Less (.Assert "a") (.Assert "b")}}
+	}))
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_SortStableM(t *testing.T) {
+	assert.Equal(t, (*{{.Slice}})(nil), (*{{.Slice}})(nil).SortStableM(func(a, b {{.N}}O) bool { return false }))
+
+	slice := New{{.Slice}}V({{.B}}, {{.A}}, {{.B}})
+	assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}, {{.B}}), slice.SortStableM(func(a, b {{.N}}O) bool {
+		return {{.Less (.Assert "a") (.Assert "b")}}
+	}))
+	assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}, {{.B}}), slice)
+}
+{{end}}
+// String
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_String() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.String())
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_String(t *testing.T) {
+	assert.Equal(t, "[]", (*{{.Slice}})(nil).String())
+	assert.Equal(t, "[]", New{{.Slice}}V().String())
+	assert.Equal(t, {{.Str "[{A} {B}]"}}, New{{.Slice}}V({{.A}}, {{.B}}).String())
+}
+
+// Swap
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Swap() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	slice.Swap(0, 1)
+	fmt.Println(slice)
+	// Output: [{{.SB}} {{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_Swap(t *testing.T) {
+	var slice *{{.Slice}}
+	slice.Swap(0, 1)
+	assert.Equal(t, (*{{.Slice}})(nil), slice)
+
+	slice = New{{.Slice}}V({{.A}}, {{.B}})
+	slice.Swap(0, 2)
+	assert.Equal(t, New{{.Slice}}V({{.A}}, {{.B}}), slice)
+	slice.Swap(1, 0)
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}), slice)
+}
+
+// SymmetricDifference
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_SymmetricDifference() {
+	slice := New{{.Slice}}V({{.A}}, {{.A}})
+	fmt.Println(slice.SymmetricDifference([]{{.Elem}}{ {{- .B}}}))
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_SymmetricDifference(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, []{{.Elem}}{ {{- .A}}}, slice.SymmetricDifference([]{{.Elem}}{ {{- .A}}, {{.A}}}).O())
+	assert.Equal(t, []{{.Elem}}{ {{- .A}}}, New{{.Slice}}V({{.A}}).SymmetricDifference(nil).O())
+	assert.Equal(t, []{{.Elem}}{}, New{{.Slice}}V({{.A}}, {{.B}}).SymmetricDifference(New{{.Slice}}V({{.B}}, {{.A}})).O())
+	assert.Equal(t, []{{.Elem}}{ {{- .B}}}, New{{.Slice}}V({{.A}}).SymmetricDifference([]{{.Elem}}{ {{- .A}}, {{.B}}}).O())
+}
+
+// Take
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Take() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	fmt.Println(slice.Take(0, 1))
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_Take(t *testing.T) {
+	assert.Equal(t, New{{.Slice}}V(), (*{{.Slice}})(nil).Take(0, 1))
+
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}), slice.Take(1, -1))
+	assert.Equal(t, New{{.Slice}}V({{.A}}), slice)
+	assert.Equal(t, New{{.Slice}}V({{.A}}), slice.Take())
+	assert.Equal(t, New{{.Slice}}V(), slice)
+}
+
+// TakeAt
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_TakeAt() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	fmt.Println(slice.TakeAt(1).O())
+	// Output: {{.OB}}
+}
+
+{{end -}}
+func Test{{.Slice}}_TakeAt(t *testing.T) {
+	assert.Equal(t, {{.N}}Obj(nil), (*{{.Slice}})(nil).TakeAt(0))
+
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	assert.Equal(t, {{.N}}Obj(nil), slice.TakeAt(2))
+	assert.Equal(t, {{.N}}Obj({{.B}}), slice.TakeAt(-1))
+	assert.Equal(t, New{{.Slice}}V({{.A}}), slice)
+}
+
+// TakeW
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_TakeW() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	fmt.Println(slice.TakeW(func(x {{.N}}O) bool {
+		return {{.Equal (.Assert "x") .A}}
+	}))
+	// Output: [{{.SA}} {{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_TakeW(t *testing.T) {
+	assert.Equal(t, New{{.Slice}}V(), (*{{.Slice}})(nil).TakeW(func(x {{.N}}O) bool { return true }))
+
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	assert.Equal(t, New{{.Slice}}V({{.B}}), slice.TakeW(func(x {{.N}}O) bool {
+		return {{.Equal (.Assert "x") .B}}
+	}))
+	assert.Equal(t, New{{.Slice}}V({{.A}}, {{.A}}), slice)
+}
+
+// ToStringSlice
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_ToStringSlice() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.ToStringSlice())
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_ToStringSlice(t *testing.T) {
+	assert.Equal(t, {{.N}}NewStringSliceV(), (*{{.Slice}})(nil).ToStringSlice())
+	assert.Equal(t, {{.N}}NewStringSliceV({{.Str "{A}"}}, {{.Str "{B}"}}), New{{.Slice}}V({{.A}}, {{.B}}).ToStringSlice())
+}
+
+// ToStrs
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_ToStrs() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(slice.ToStrs())
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_ToStrs(t *testing.T) {
+	assert.Equal(t, []string{}, (*{{.Slice}})(nil).ToStrs())
+	assert.Equal(t, []string{ {{- .Str "{A}"}}, {{.Str "{B}"}}}, New{{.Slice}}V({{.A}}, {{.B}}).ToStrs())
+}
+
+// ToInterSlice
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_ToInterSlice() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	fmt.Println(len(slice.ToInterSlice()))
+	// Output: 2
+}
+
+{{end -}}
+func Test{{.Slice}}_ToInterSlice(t *testing.T) {
+	assert.Equal(t, []interface{}{}, (*{{.Slice}})(nil).ToInterSlice())
+	assert.Equal(t, []interface{}{ {{- .A}}, {{.B}}}, New{{.Slice}}V({{.A}}, {{.B}}).ToInterSlice())
+}
+
+// Union
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Union() {
+	slice := New{{.Slice}}V({{.A}}, {{.A}})
+	fmt.Println(slice.Union([]{{.Elem}}{ {{- .B}}, {{.A}}}))
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_Union(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, New{{.Slice}}V({{.A}}), slice.Union([]{{.Elem}}{ {{- .A}}, {{.A}}}))
+
+	slice = New{{.Slice}}V({{.B}}, {{.B}})
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}), slice.Union(New{{.Slice}}V({{.A}})))
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.B}}), slice)
+}
+
+// UnionM
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_UnionM() {
+	slice := New{{.Slice}}V({{.A}}, {{.A}})
+	fmt.Println(slice.UnionM([]{{.Elem}}{ {{- .B}}, {{.A}}}))
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_UnionM(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, New{{.Slice}}V({{.A}}), slice.UnionM([]{{.Elem}}{ {{- .A}}, {{.A}}}))
+	assert.Equal(t, (*{{.Slice}})(nil), slice)
+
+	slice = New{{.Slice}}V({{.B}}, {{.B}})
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}), slice.UnionM(New{{.Slice}}V({{.A}})))
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}), slice)
+}
+
+// Uniq
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Uniq() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	fmt.Println(slice.Uniq())
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_Uniq(t *testing.T) {
+	assert.Equal(t, New{{.Slice}}V(), (*{{.Slice}})(nil).Uniq())
+
+	slice := New{{.Slice}}V({{.B}}, {{.A}}, {{.B}}, {{.A}})
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}), slice.Uniq())
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}, {{.B}}, {{.A}}), slice)
+}
+
+// UniqM
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_UniqM() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	fmt.Println(slice.UniqM())
+	// Output: [{{.SA}} {{.SB}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_UniqM(t *testing.T) {
+	assert.Equal(t, (*{{.Slice}})(nil), (*{{.Slice}})(nil).UniqM())
+
+	slice := New{{.Slice}}V({{.B}}, {{.A}}, {{.B}}, {{.A}})
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}), slice.UniqM())
+	assert.Equal(t, New{{.Slice}}V({{.B}}, {{.A}}), slice)
+}
+
+// Window
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Window() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}})
+	slice.Window(2, 1).Each(func(x {{.N}}O) {
+		fmt.Print(x)
+	})
+	// Output: [{{.SA}} {{.SB}}][{{.SB}} {{.SA}}]
+}
+
+{{end -}}
+func Test{{.Slice}}_Window(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, 0, slice.Window(2, 1).Len())
+	assert.Equal(t, 0, New{{.Slice}}V({{.A}}, {{.B}}).Window(0, 1).Len())
+	assert.Equal(t, 0, New{{.Slice}}V({{.A}}, {{.B}}).Window(3, 1).Len())
+
+	windows := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}).Window(2, 1)
+	assert.Equal(t, 2, windows.Len())
+	assert.Equal(t, []{{.Elem}}{ {{- .A}}, {{.B}}}, windows.At(0).O().({{.N}}ISlice).O())
+	assert.Equal(t, []{{.Elem}}{ {{- .B}}, {{.A}}}, windows.At(1).O().({{.N}}ISlice).O())
+}
+
+// Zip
+//--------------------------------------------------------------------------------------------------
+{{if .Examples -}}
+func Example{{.Slice}}_Zip() {
+	slice := New{{.Slice}}V({{.A}}, {{.B}})
+	pair := slice.Zip([]string{"a", "b"}).At(1).O().({{.N}}ISlice)
+	fmt.Println(pair.At(0).O(), pair.At(1).O())
+	// Output: {{.OB}} b
+}
+
+{{end -}}
+func Test{{.Slice}}_Zip(t *testing.T) {
+	var slice *{{.Slice}}
+	assert.Equal(t, 0, slice.Zip([]string{"a"}).Len())
+	assert.Equal(t, 0, New{{.Slice}}V({{.A}}).Zip(nil).Len())
+
+	pairs := New{{.Slice}}V({{.A}}, {{.B}}, {{.A}}).Zip([]string{"a", "b"})
+	assert.Equal(t, 2, pairs.Len())
+	assert.Equal(t, []interface{}{ {{- .A}}, "a"}, pairs.At(0).O().({{.N}}ISlice).O())
+	assert.Equal(t, []interface{}{ {{- .B}}, "b"}, pairs.At(1).O().({{.N}}ISlice).O())
+}
+`
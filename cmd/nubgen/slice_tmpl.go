@@ -0,0 +1,1283 @@
+package main
+
+// sliceTmpl is the template for the typed ISlice implementation
+const sliceTmpl = `{{.Header}}
+
+package {{.Package}}
+
+import (
+	"context"
+{{- if .Fmt}}
+	"fmt"
+{{- end}}
+	"sort"
+	"strings"
+{{- if .Time}}
+	"time"
+{{- end}}
+{{if .N}}
+	nub "github.com/phR0ze/n"
+{{- end}}
+	"github.com/pkg/errors"
+)
+
+// {{.Slice}} implements the Slice interface providing a generic way to work with slice types
+// including convenience methods on par with rapid development languages.
+type {{.Slice}} []{{.Elem}}
+
+// New{{.Slice}} creates a new *{{.Slice}}
+func New{{.Slice}}(slice interface{}) *{{.Slice}} {
+	return To{{.Slice}}(slice)
+}
+
+// New{{.Slice}}V creates a new *{{.Slice}} from the given variadic elements. Always returns
+// at least a reference to an empty {{.Slice}}.
+func New{{.Slice}}V(elems ...interface{}) *{{.Slice}} {
+	return To{{.Slice}}(elems)
+}
+
+// A is an alias to String for brevity
+func (p *{{.Slice}}) A() string {
+	return p.String()
+}
+
+// All tests if this Slice is not empty or optionally if it contains
+// all of the given variadic elements. Incompatible types will return false.
+// Supports all possible {{.Elem}} conversions
+func (p *{{.Slice}}) All(elems ...interface{}) bool {
+	if p == nil || len(*p) == 0 {
+		return false
+	}
+
+	// Not looking for anything
+	if len(elems) == 0 {
+		return true
+	}
+
+	// Looking for something specific returns false if incompatible type
+	return p.AllS(elems)
+}
+
+// AllS tests if this Slice contains all of the given Slice's elements.
+// Incompatible types will return false.
+// Supports {{.Slice}}, *{{.Slice}}, []{{.Elem}} or *[]{{.Elem}}
+func (p *{{.Slice}}) AllS(slice interface{}) bool {
+	if p == nil || len(*p) == 0 {
+		return false
+	}
+	if elems, err := To{{.Slice}}E(slice); err == nil {
+		for i := range *elems {
+			found := false
+			for j := range *p {
+				if {{.Equal "(*p)[j]" "(*elems)[i]"}} {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	} else {
+		return false
+	}
+	return true
+}
+
+// Any tests if this Slice is not empty or optionally if it contains
+// any of the given variadic elements. Incompatible types will return false.
+// Supports all possible {{.Elem}} conversions
+func (p *{{.Slice}}) Any(elems ...interface{}) bool {
+	if p == nil || len(*p) == 0 {
+		return false
+	}
+
+	// Not looking for anything
+	if len(elems) == 0 {
+		return true
+	}
+
+	// Looking for something specific returns false if incompatible type
+	return p.AnyS(elems)
+}
+
+// AnyS tests if this Slice contains any of the given Slice's elements.
+// Incompatible types will return false.
+// Supports {{.Slice}}, *{{.Slice}}, []{{.Elem}} or *[]{{.Elem}}
+func (p *{{.Slice}}) AnyS(slice interface{}) bool {
+	if p == nil || len(*p) == 0 {
+		return false
+	}
+	if elems, err := To{{.Slice}}E(slice); err == nil {
+		for i := range *elems {
+			for j := range *p {
+				if {{.Equal "(*p)[j]" "(*elems)[i]"}} {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// AnyW tests if this Slice contains any that match the lambda selector.
+func (p *{{.Slice}}) AnyW(sel func({{.N}}O) bool) bool {
+	return p.CountW(sel) != 0
+}
+
+// Append an element to the end of this Slice and returns a reference to this Slice.
+func (p *{{.Slice}}) Append(elem interface{}) {{.N}}ISlice {
+	if p == nil {
+		p = New{{.Slice}}V()
+	}
+	if x, err := {{.Conv}}(elem); err == nil {
+		*p = append(*p, x)
+	}
+	return p
+}
+
+// AppendV appends the variadic elements to the end of this Slice and returns a reference to this Slice.
+func (p *{{.Slice}}) AppendV(elems ...interface{}) {{.N}}ISlice {
+	if p == nil {
+		p = New{{.Slice}}V()
+	}
+	for _, elem := range elems {
+		if x, err := {{.Conv}}(elem); err == nil {
+			*p = append(*p, x)
+		}
+	}
+	return p
+}
+
+// At returns the element at the given index location. Allows for negative notation.
+func (p *{{.Slice}}) At(i int) (elem *{{.N}}Object) {
+	elem = &{{.N}}Object{}
+	if p == nil {
+		return
+	}
+	if i = {{fn "absIndex"}}(len(*p), i); i == -1 {
+		return
+	}
+{{- if .N}}
+	elem = {{.N}}Obj((*p)[i])
+{{- else}}
+	elem.o = (*p)[i]
+{{- end}}
+	return
+}
+
+// BinarySearch searches this already sorted Slice for the given element returning its index and
+// true if found or the index it would be inserted at and false if not.
+func (p *{{.Slice}}) BinarySearch(elem interface{}) (i int, found bool) {
+	if p == nil || len(*p) == 0 {
+		return
+	}
+{{- if .Ordered}}
+	x, err := {{.Conv}}(elem)
+	if err != nil {
+		return
+	}
+	i = sort.Search(len(*p), func(j int) bool { return !({{.Less "(*p)[j]" "x"}}) })
+	found = i < len(*p) && {{.Equal "(*p)[i]" "x"}}
+	return
+{{- else}}
+	panic("unsupported comparable type '{{.Elem}}'")
+{{- end}}
+}
+
+// Chunk creates a new slice of Slices each holding n elements copied from this Slice in order.
+// The last chunk will hold the remaining elements if the length isn't evenly divisible by n.
+// An empty slice is returned if n is less than 1.
+func (p *{{.Slice}}) Chunk(n int) (chunks {{.N}}ISlice) {
+	x := []interface{}{}
+	if p == nil || len(*p) == 0 || n < 1 {
+		return {{.N}}NewInterSliceV(x...)
+	}
+	for i := 0; i < len(*p); i += n {
+		j := i + n
+		if j > len(*p) {
+			j = len(*p)
+		}
+		x = append(x, p.Copy(i, j-1))
+	}
+	return {{.N}}NewInterSliceV(x...)
+}
+
+// Clear modifies this Slice to clear out all elements and returns a reference to this Slice.
+func (p *{{.Slice}}) Clear() {{.N}}ISlice {
+	if p == nil {
+		p = New{{.Slice}}V()
+	} else {
+		p.Drop()
+	}
+	return p
+}
+
+// Concat returns a new Slice by appending the given Slice to this Slice using variadic expansion.
+// Supports {{.Slice}}, *{{.Slice}}, []{{.Elem}} or *[]{{.Elem}}
+func (p *{{.Slice}}) Concat(slice interface{}) (new {{.N}}ISlice) {
+	return p.Copy().ConcatM(slice)
+}
+
+// ConcatM modifies this Slice by appending the given Slice using variadic expansion and returns a reference to this Slice.
+// Supports {{.Slice}}, *{{.Slice}}, []{{.Elem}} or *[]{{.Elem}}
+func (p *{{.Slice}}) ConcatM(slice interface{}) {{.N}}ISlice {
+	if p == nil {
+		p = New{{.Slice}}V()
+	}
+	if elems, err := To{{.Slice}}E(slice); err == nil {
+		*p = append(*p, *elems...)
+	}
+	return p
+}
+
+// Copy returns a new Slice with the indicated range of elements copied from this Slice.
+// Expects nothing, in which case everything is copied, or two indices i and j, in which
+// case positive and negative notation is supported and uses an inclusive behavior such
+// that Slice(0, -1) includes index -1 as opposed to Go's exclusive behavior. Out of
+// bounds indices will be moved within bounds.
+//
+// An empty Slice is returned if indicies are mutually exclusive or nothing can be returned.
+func (p *{{.Slice}}) Copy(indices ...int) (new {{.N}}ISlice) {
+	if p == nil || len(*p) == 0 {
+		return New{{.Slice}}V()
+	}
+
+	// Handle index manipulation
+	i, j, err := {{fn "absIndices"}}(len(*p), indices...)
+	if err != nil {
+		return New{{.Slice}}V()
+	}
+
+	// Copy elements over to new Slice
+	x := make([]{{.Elem}}, j-i, j-i)
+	copy(x, (*p)[i:j])
+	return New{{.Slice}}(x)
+}
+
+// Count the number of elements in this Slice equal to the given element.
+func (p *{{.Slice}}) Count(elem interface{}) (cnt int) {
+	if y, ok := elem.({{.Elem}}); ok {
+		cnt = p.CountW(func(x {{.N}}O) bool { return {{.N}}ExB({{.Equal (.Assert "x") "y"}}) })
+	}
+	return
+}
+
+// CountW counts the number of elements in this Slice that match the lambda selector.
+func (p *{{.Slice}}) CountW(sel func({{.N}}O) bool) (cnt int) {
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	for i := range *p {
+		if sel((*p)[i]) {
+			cnt++
+		}
+	}
+	return
+}
+
+// Difference returns a new Slice with the uniq elements of this Slice that are not in the given Slice
+// while preserving order.
+// Supports {{.Slice}}, *{{.Slice}}, []{{.Elem}} or *[]{{.Elem}}
+func (p *{{.Slice}}) Difference(slice interface{}) (new {{.N}}ISlice) {
+	other := To{{.Slice}}(slice)
+	set, uniq := {{.N}}NewSet(other), {{.N}}NewSet(p)
+	return p.Select(func(x {{.N}}O) bool { return !set.Contains(x) && uniq.Remove(x) })
+}
+
+// Disjoint checks if this Slice has no elements in common with the given Slice.
+// Supports {{.Slice}}, *{{.Slice}}, []{{.Elem}} or *[]{{.Elem}}
+func (p *{{.Slice}}) Disjoint(slice interface{}) bool {
+	other := To{{.Slice}}(slice)
+	return {{.N}}NewSet(p).Disjoint(other)
+}
+
+// Drop modifies this Slice to delete the indicated range of elements and returns a referece to this Slice.
+// Expects nothing, in which case everything is dropped, or two indices i and j, in which case positive and
+// negative notation is supported and uses an inclusive behavior such that DropAt(0, -1) includes index -1
+// as opposed to Go's exclusive behavior. Out of bounds indices will be moved within bounds.
+func (p *{{.Slice}}) Drop(indices ...int) {{.N}}ISlice {
+	if p == nil || len(*p) == 0 {
+		return p
+	}
+
+	// Handle index manipulation
+	i, j, err := {{fn "absIndices"}}(len(*p), indices...)
+	if err != nil {
+		return p
+	}
+
+	// Execute
+	n := j - i
+	if i+n < len(*p) {
+		*p = append((*p)[:i], (*p)[i+n:]...)
+	} else {
+		*p = (*p)[:i]
+	}
+	return p
+}
+
+// DropAt modifies this Slice to delete the element at the given index location. Allows for negative notation.
+// Returns a reference to this Slice.
+func (p *{{.Slice}}) DropAt(i int) {{.N}}ISlice {
+	return p.Drop(i, i)
+}
+
+// DropFirst modifies this Slice to delete the first element and returns a reference to this Slice.
+func (p *{{.Slice}}) DropFirst() {{.N}}ISlice {
+	return p.Drop(0, 0)
+}
+
+// DropFirstN modifies this Slice to delete the first n elements and returns a reference to this Slice.
+func (p *{{.Slice}}) DropFirstN(n int) {{.N}}ISlice {
+	if n == 0 {
+		return p
+	}
+	return p.Drop(0, {{fn "abs"}}(n)-1)
+}
+
+// DropLast modifies this Slice to delete the last element and returns a reference to this Slice.
+func (p *{{.Slice}}) DropLast() {{.N}}ISlice {
+	return p.Drop(-1, -1)
+}
+
+// DropLastN modifies thi Slice to delete the last n elements and returns a reference to this Slice.
+func (p *{{.Slice}}) DropLastN(n int) {{.N}}ISlice {
+	if n == 0 {
+		return p
+	}
+	return p.Drop({{fn "absNeg"}}(n), -1)
+}
+
+// DropW modifies this Slice to delete the elements that match the lambda selector and returns a reference to this Slice.
+// The slice is updated instantly when lambda expression is evaluated not after DropW completes.
+func (p *{{.Slice}}) DropW(sel func({{.N}}O) bool) {{.N}}ISlice {
+	if p == nil || len(*p) == 0 {
+		return p
+	}
+	l := len(*p)
+	for i := 0; i < l; i++ {
+		if sel((*p)[i]) {
+			p.DropAt(i)
+			l--
+			i--
+		}
+	}
+	return p
+}
+
+// Each calls the given lambda once for each element in this Slice, passing in that element
+// as a parameter. Returns a reference to this Slice
+func (p *{{.Slice}}) Each(action func({{.N}}O)) {{.N}}ISlice {
+	if p == nil {
+		return p
+	}
+	for i := range *p {
+		action((*p)[i])
+	}
+	return p
+}
+
+// EachE calls the given lambda once for each element in this Slice, passing in that element
+// as a parameter. Returns a reference to this Slice and any error from the lambda.
+func (p *{{.Slice}}) EachE(action func({{.N}}O) error) ({{.N}}ISlice, error) {
+	var err error
+	if p == nil {
+		return p, err
+	}
+	for i := range *p {
+		if err = action((*p)[i]); err != nil {
+			return p, err
+		}
+	}
+	return p, err
+}
+
+// EachI calls the given lambda once for each element in this Slice, passing in the index and element
+// as a parameter. Returns a reference to this Slice
+func (p *{{.Slice}}) EachI(action func(int, {{.N}}O)) {{.N}}ISlice {
+	if p == nil {
+		return p
+	}
+	for i := range *p {
+		action(i, (*p)[i])
+	}
+	return p
+}
+
+// EachIE calls the given lambda once for each element in this Slice, passing in the index and element
+// as a parameter. Returns a reference to this Slice and any error from the lambda.
+func (p *{{.Slice}}) EachIE(action func(int, {{.N}}O) error) ({{.N}}ISlice, error) {
+	var err error
+	if p == nil {
+		return p, err
+	}
+	for i := range *p {
+		if err = action(i, (*p)[i]); err != nil {
+			return p, err
+		}
+	}
+	return p, err
+}
+
+// EachP calls the given lambda once for each element in this Slice, passing in the context and
+// element as parameters, fanning the calls out to the given number of workers or runtime.NumCPU()
+// workers if less than 1. The first error from the lambda or the given context cancels
+// the context passed to the lambda and skips the remaining elements. Returns a reference to this
+// Slice and the first error.
+func (p *{{.Slice}}) EachP(ctx context.Context, workers int, action func(context.Context, {{.N}}O) error) ({{.N}}ISlice, error) {
+	if p == nil {
+		return p, nil
+	}
+	err := {{fn "eachP"}}(ctx, len(*p), workers, func(ctx context.Context, i int) error {
+		return action(ctx, (*p)[i])
+	})
+	return p, err
+}
+
+// EachR calls the given lambda once for each element in this Slice in reverse, passing in that element
+// as a parameter. Returns a reference to this Slice
+func (p *{{.Slice}}) EachR(action func({{.N}}O)) {{.N}}ISlice {
+	if p == nil {
+		return p
+	}
+	for i := len(*p) - 1; i >= 0; i-- {
+		action((*p)[i])
+	}
+	return p
+}
+
+// EachRE calls the given lambda once for each element in this Slice in reverse, passing in that element
+// as a parameter. Returns a reference to this Slice and any error from the lambda.
+func (p *{{.Slice}}) EachRE(action func({{.N}}O) error) ({{.N}}ISlice, error) {
+	var err error
+	if p == nil {
+		return p, err
+	}
+	for i := len(*p) - 1; i >= 0; i-- {
+		if err = action((*p)[i]); err != nil {
+			return p, err
+		}
+	}
+	return p, err
+}
+
+// EachRI calls the given lambda once for each element in this Slice in reverse, passing in that element
+// as a parameter. Returns a reference to this Slice
+func (p *{{.Slice}}) EachRI(action func(int, {{.N}}O)) {{.N}}ISlice {
+	if p == nil {
+		return p
+	}
+	for i := len(*p) - 1; i >= 0; i-- {
+		action(i, (*p)[i])
+	}
+	return p
+}
+
+// EachRIE calls the given lambda once for each element in this Slice in reverse, passing in that element
+// as a parameter. Returns a reference to this Slice and any error from the lambda.
+func (p *{{.Slice}}) EachRIE(action func(int, {{.N}}O) error) ({{.N}}ISlice, error) {
+	var err error
+	if p == nil {
+		return p, err
+	}
+	for i := len(*p) - 1; i >= 0; i-- {
+		if err = action(i, (*p)[i]); err != nil {
+			return p, err
+		}
+	}
+	return p, err
+}
+
+// Empty tests if this Slice is empty.
+func (p *{{.Slice}}) Empty() bool {
+	if p == nil || len(*p) == 0 {
+		return true
+	}
+	return false
+}
+
+// First returns the first element in this Slice as Object.
+// Object.Nil() == true will be returned when there are no elements in the slice.
+func (p *{{.Slice}}) First() (elem *{{.N}}Object) {
+	return p.At(0)
+}
+
+// FirstN returns the first n elements in this slice as a Slice reference to the original.
+// Best effort is used such that as many as can be will be returned up until the request is satisfied.
+func (p *{{.Slice}}) FirstN(n int) {{.N}}ISlice {
+	if n == 0 {
+		return New{{.Slice}}V()
+	}
+	return p.Slice(0, {{fn "abs"}}(n)-1)
+}
+
+// FlatMap creates a new slice with the modified elements from the lambda expanding any slice
+// results one level into the new slice.
+func (p *{{.Slice}}) FlatMap(mod func({{.N}}O) {{.N}}O) {{.N}}ISlice {
+	if p == nil || len(*p) == 0 {
+		return New{{.Slice}}V()
+	}
+	x := []interface{}{}
+	for i := range *p {
+		x = {{fn "flatAppend"}}(x, mod((*p)[i]))
+	}
+	return {{fn "newSliceOf"}}(x, New{{.Slice}}V())
+}
+
+// Flatten returns a new Slice with any nested slice elements expanded one level into it.
+// The elements of this Slice can't be slices so it is the same as Copy.
+func (p *{{.Slice}}) Flatten() (new {{.N}}ISlice) {
+	return p.Copy()
+}
+
+// G returns the underlying data structure as a builtin Go type
+func (p *{{.Slice}}) G() []{{.Elem}} {
+	return p.O().([]{{.Elem}})
+}
+
+// GroupBy creates a new map of Slices with the elements of this Slice grouped by the key the
+// lambda returns for them. Keys are converted to strings and kept in the order they are
+// first seen while the elements keep their order in each group.
+func (p *{{.Slice}}) GroupBy(key func({{.N}}O) {{.N}}O) (groups {{.N}}IMap) {
+	m := {{.N}}NewOrderedMapV()
+	if p == nil || len(*p) == 0 {
+		return m
+	}
+	for i := range *p {
+		k := {{.N}}ToString(key((*p)[i]))
+{{- if .N}}
+		if group := m.Get(k); !group.Nil() {
+			*group.O().(*{{.Slice}}) = append(*group.O().(*{{.Slice}}), (*p)[i])
+{{- else}}
+		if group, ok := m.m[k]; ok {
+			*group.(*{{.Slice}}) = append(*group.(*{{.Slice}}), (*p)[i])
+{{- end}}
+		} else {
+			m.Set(k, &{{.Slice}}{(*p)[i]})
+		}
+	}
+	return m
+}
+
+// Index returns the index of the first element in this Slice where element == elem
+// Returns a -1 if the element was not not found.
+func (p *{{.Slice}}) Index(elem interface{}) (loc int) {
+	loc = -1
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	if x, err := {{.Conv}}(elem); err == nil {
+		for i := range *p {
+			if {{.Equal "(*p)[i]" "x"}} {
+				return i
+			}
+		}
+	}
+	return
+}
+
+// Insert modifies this Slice to insert the given elements before the element(s) with the given index.
+// Negative indices count backwards from the end of the slice, where -1 is the last element. If a
+// negative index is used, the given element will be inserted after that element, so using an index
+// of -1 will insert the element at the end of the slice. If a Slice is given all elements will be
+// inserted starting from the beging until the end. Slice is returned for chaining. Invalid
+// index locations will not change the slice.
+func (p *{{.Slice}}) Insert(i int, obj interface{}) {{.N}}ISlice {
+	if p == nil || len(*p) == 0 {
+		return p.ConcatM(obj)
+	}
+
+	// Insert the item before j if pos and after j if neg
+	j := i
+	if j = {{fn "absIndex"}}(len(*p), j); j == -1 {
+		return p
+	}
+	if i < 0 {
+		j++
+	}
+	if elems, err := To{{.Slice}}E(obj); err == nil {
+		if j == 0 {
+			*p = append(*elems, *p...)
+		} else if j < len(*p) {
+			*p = append(*p, *elems...)           // ensures enough space exists
+			copy((*p)[j+len(*elems):], (*p)[j:]) // shifts right elements drop added
+			copy((*p)[j:], *elems)               // set new in locations vacated
+		} else {
+			*p = append(*p, *elems...)
+		}
+	}
+	return p
+}
+
+// InterSlice returns true if the underlying implementation is a RefSlice
+func (p *{{.Slice}}) InterSlice() bool {
+	return false
+}
+
+// Intersect returns a new Slice with the uniq elements of this Slice that are also in the given Slice
+// while preserving order.
+// Supports {{.Slice}}, *{{.Slice}}, []{{.Elem}} or *[]{{.Elem}}
+func (p *{{.Slice}}) Intersect(slice interface{}) (new {{.N}}ISlice) {
+	other := To{{.Slice}}(slice)
+	set, uniq := {{.N}}NewSet(other), {{.N}}NewSet(p)
+	return p.Select(func(x {{.N}}O) bool { return set.Contains(x) && uniq.Remove(x) })
+}
+
+// Iter returns an Iterator over the elements of this Slice in order.
+func (p *{{.Slice}}) Iter() (it {{.N}}Iterator) {
+	i := 0
+	return {{.N}}NewIterator(func() ({{.N}}O, bool) {
+		if p == nil || i >= len(*p) {
+			return nil, false
+		}
+		i++
+		return (*p)[i-1], true
+	})
+}
+
+// IsSubset checks if all elements of this Slice are in the given Slice.
+// Supports {{.Slice}}, *{{.Slice}}, []{{.Elem}} or *[]{{.Elem}}
+func (p *{{.Slice}}) IsSubset(slice interface{}) bool {
+	other := To{{.Slice}}(slice)
+	return {{.N}}NewSet(p).IsSubset(other)
+}
+
+// Join converts each element into a string then joins them together using the given separator or comma by default.
+func (p *{{.Slice}}) Join(separator ...string) (str *{{.N}}Object) {
+	if p == nil || len(*p) == 0 {
+		str = {{if .N}}{{.N}}Obj(""){{else}}&Object{""}{{end}}
+		return
+	}
+	sep := ","
+	if len(separator) > 0 {
+		sep = separator[0]
+	}
+
+	var builder strings.Builder
+	for i := range *p {
+		builder.WriteString({{.Format "(*p)[i]"}})
+		if i+1 < len(*p) {
+			builder.WriteString(sep)
+		}
+	}
+	str = {{if .N}}{{.N}}Obj(builder.String()){{else}}&Object{builder.String()}{{end}}
+	return
+}
+
+// Last returns the last element in this Slice as an Object.
+// Object.Nil() == true will be returned if there are no elements in the slice.
+func (p *{{.Slice}}) Last() (elem *{{.N}}Object) {
+	return p.At(-1)
+}
+
+// LastN returns the last n elements in this Slice as a Slice reference to the original.
+// Best effort is used such that as many as can be will be returned up until the request is satisfied.
+func (p *{{.Slice}}) LastN(n int) {{.N}}ISlice {
+	if n == 0 {
+		return New{{.Slice}}V()
+	}
+	return p.Slice({{fn "absNeg"}}(n), -1)
+}
+
+// Len returns the number of elements in this Slice
+func (p *{{.Slice}}) Len() int {
+	if p == nil {
+		return 0
+	}
+	return len(*p)
+}
+
+// Less returns true if the element indexed by i is less than the element indexed by j.
+func (p *{{.Slice}}) Less(i, j int) bool {
+	if p == nil || len(*p) < 2 || i < 0 || j < 0 || i >= len(*p) || j >= len(*p) {
+		return false
+	}
+{{- if .Ordered}}
+	return {{.Less "(*p)[i]" "(*p)[j]"}}
+{{- else}}
+	panic("unsupported comparable type '{{.Elem}}'")
+{{- end}}
+}
+
+// Map creates a new slice with the modified elements from the lambda.
+func (p *{{.Slice}}) Map(mod func({{.N}}O) {{.N}}O) {{.N}}ISlice {
+	var slice {{.N}}ISlice
+	if p == nil || len(*p) == 0 {
+		return New{{.Slice}}V()
+	}
+	for i := range *p {
+		v := mod((*p)[i])
+		if slice == nil {
+			slice = {{.N}}Slice(v)
+		} else {
+			slice.Append(v)
+		}
+	}
+	return slice
+}
+
+// MapP creates a new slice with the modified elements from the lambda, fanning the calls out to
+// the given number of workers or runtime.NumCPU() workers if less than 1 while preserving the
+// element order. The first error from the lambda or the given context cancels the context passed
+// to the lambda and skips the remaining elements. Returns an empty Slice and the first error on
+// failure.
+func (p *{{.Slice}}) MapP(ctx context.Context, workers int, mod func(context.Context, {{.N}}O) ({{.N}}O, error)) ({{.N}}ISlice, error) {
+	if p == nil || len(*p) == 0 {
+		return New{{.Slice}}V(), nil
+	}
+	results := make([]interface{}, len(*p))
+	err := {{fn "eachP"}}(ctx, len(results), workers, func(ctx context.Context, i int) (err error) {
+		results[i], err = mod(ctx, (*p)[i])
+		return
+	})
+	if err != nil {
+		return New{{.Slice}}V(), err
+	}
+	slice := {{.N}}Slice(results[0])
+	for i := 1; i < len(results); i++ {
+		slice.Append(results[i])
+	}
+	return slice, nil
+}
+
+// Nil tests if this Slice is nil
+func (p *{{.Slice}}) Nil() bool {
+	if p == nil {
+		return true
+	}
+	return false
+}
+
+// O returns the underlying data structure as is
+func (p *{{.Slice}}) O() interface{} {
+	if p == nil {
+		return []{{.Elem}}{}
+	}
+	return []{{.Elem}}(*p)
+}
+
+// Pair simply returns the first and second Slice elements as Objects
+func (p *{{.Slice}}) Pair() (first, second *{{.N}}Object) {
+	first, second = &{{.N}}Object{}, &{{.N}}Object{}
+	if p == nil {
+		return
+	}
+	if len(*p) > 0 {
+		first = p.At(0)
+	}
+	if len(*p) > 1 {
+		second = p.At(1)
+	}
+	return
+}
+
+// Partition creates two new slices the first with the elements that match the lambda selector
+// and the second with the elements that don't while preserving element order.
+func (p *{{.Slice}}) Partition(sel func({{.N}}O) bool) (match, rest {{.N}}ISlice) {
+	x, y := New{{.Slice}}V(), New{{.Slice}}V()
+	if p == nil || len(*p) == 0 {
+		return x, y
+	}
+	for i := range *p {
+		if sel((*p)[i]) {
+			*x = append(*x, (*p)[i])
+		} else {
+			*y = append(*y, (*p)[i])
+		}
+	}
+	return x, y
+}
+
+// Pop modifies this Slice to remove the last element and returns the removed element as an Object.
+func (p *{{.Slice}}) Pop() (elem *{{.N}}Object) {
+	elem = p.Last()
+	p.DropLast()
+	return
+}
+
+// PopN modifies this Slice to remove the last n elements and returns the removed elements as a new Slice.
+func (p *{{.Slice}}) PopN(n int) (new {{.N}}ISlice) {
+	if n == 0 {
+		return New{{.Slice}}V()
+	}
+	new = p.Copy({{fn "absNeg"}}(n), -1)
+	p.DropLastN(n)
+	return
+}
+
+// Prepend modifies this Slice to add the given element at the begining and returns a reference to this Slice.
+func (p *{{.Slice}}) Prepend(elem interface{}) {{.N}}ISlice {
+	return p.Insert(0, elem)
+}
+
+// RefSlice returns true if the underlying implementation is a RefSlice
+func (p *{{.Slice}}) RefSlice() bool {
+	return false
+}
+
+// Reverse returns a new Slice with the order of the elements reversed.
+func (p *{{.Slice}}) Reverse() (new {{.N}}ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().ReverseM()
+}
+
+// ReverseM modifies this Slice reversing the order of the elements and returns a reference to this Slice.
+func (p *{{.Slice}}) ReverseM() {{.N}}ISlice {
+	if p == nil || len(*p) == 0 {
+		return p
+	}
+	for i, j := 0, len(*p)-1; i < j; i, j = i+1, j-1 {
+		p.Swap(i, j)
+	}
+	return p
+}
+
+// S is an alias to ToStringSlice
+func (p *{{.Slice}}) S() (slice *{{.N}}StringSlice) {
+	return p.ToStringSlice()
+}
+
+// Select creates a new slice with the elements that match the lambda selector.
+func (p *{{.Slice}}) Select(sel func({{.N}}O) bool) (new {{.N}}ISlice) {
+	slice := New{{.Slice}}V()
+	if p == nil || len(*p) == 0 {
+		return slice
+	}
+	for i := range *p {
+		if sel((*p)[i]) {
+			*slice = append(*slice, (*p)[i])
+		}
+	}
+	return slice
+}
+
+// SelectP creates a new slice with the elements that match the lambda selector, fanning the calls
+// out to the given number of workers or runtime.NumCPU() workers if less than 1 while preserving
+// the element order. The first error from the lambda or the given context cancels the
+// context passed to the lambda and skips the remaining elements. Returns an empty Slice and the
+// first error on failure.
+func (p *{{.Slice}}) SelectP(ctx context.Context, workers int, sel func(context.Context, {{.N}}O) (bool, error)) (new {{.N}}ISlice, err error) {
+	slice := New{{.Slice}}V()
+	if p == nil || len(*p) == 0 {
+		return slice, nil
+	}
+	hits := make([]bool, len(*p))
+	if err = {{fn "eachP"}}(ctx, len(hits), workers, func(ctx context.Context, i int) (err error) {
+		hits[i], err = sel(ctx, (*p)[i])
+		return
+	}); err != nil {
+		return slice, err
+	}
+	for i := range hits {
+		if hits[i] {
+			*slice = append(*slice, (*p)[i])
+		}
+	}
+	return slice, nil
+}
+
+// Set the element(s) at the given index location to the given element(s). Allows for negative notation.
+// Returns a reference to this Slice and swallows any errors.
+func (p *{{.Slice}}) Set(i int, elems interface{}) {{.N}}ISlice {
+	slice, _ := p.SetE(i, elems)
+	return slice
+}
+
+// SetE the element(s) at the given index location to the given element(s). Allows for negative notation.
+// Returns a referenc to this Slice and an error if out of bounds or elem is the wrong type.
+func (p *{{.Slice}}) SetE(i int, elems interface{}) ({{.N}}ISlice, error) {
+	var err error
+	if p == nil {
+		return p, err
+	}
+	if i = {{fn "absIndex"}}(len(*p), i); i == -1 {
+		err = errors.Errorf("slice assignment is out of bounds")
+		return p, err
+	}
+
+	// Account for length of elems
+	if x, err := To{{.Slice}}E(elems); err == nil {
+		if len(*x) > 0 {
+			copy((*p)[i:], *x)
+		}
+	} else {
+		err = errors.Wrapf(err, "can't set type '%T' in '%T'", elems, p)
+	}
+	return p, err
+}
+
+// Shift modifies this Slice to remove the first element and returns the removed element as an Object.
+func (p *{{.Slice}}) Shift() (elem *{{.N}}Object) {
+	elem = p.First()
+	p.DropFirst()
+	return
+}
+
+// ShiftN modifies this Slice to remove the first n elements and returns the removed elements as a new Slice.
+func (p *{{.Slice}}) ShiftN(n int) (new {{.N}}ISlice) {
+	if n == 0 {
+		return New{{.Slice}}V()
+	}
+	new = p.Copy(0, {{fn "abs"}}(n)-1)
+	p.DropFirstN(n)
+	return
+}
+
+// Single reports true if there is only one element in this Slice.
+func (p *{{.Slice}}) Single() bool {
+	return p.Len() == 1
+}
+
+// Slice returns a range of elements from this Slice as a Slice reference to the original. Allows for negative notation.
+// Expects nothing, in which case everything is included, or two indices i and j, in which case an inclusive behavior
+// is used such that Slice(0, -1) includes index -1 as opposed to Go's exclusive behavior. Out of bounds indices will
+// be moved within bounds.
+//
+// An empty Slice is returned if indicies are mutually exclusive or nothing can be returned.
+func (p *{{.Slice}}) Slice(indices ...int) {{.N}}ISlice {
+	if p == nil || len(*p) == 0 {
+		return New{{.Slice}}V()
+	}
+
+	// Handle index manipulation
+	i, j, err := {{fn "absIndices"}}(len(*p), indices...)
+	if err != nil {
+		return New{{.Slice}}V()
+	}
+
+	slice := {{.Slice}}((*p)[i:j])
+	return &slice
+}
+
+// Sort returns a new Slice with sorted elements.
+func (p *{{.Slice}}) Sort() (new {{.N}}ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortM()
+}
+
+// SortBy returns a new Slice with the elements sorted using the given less lambda.
+func (p *{{.Slice}}) SortBy(less func(a, b {{.N}}O) bool) (new {{.N}}ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortByM(less)
+}
+
+// SortByM modifies this Slice sorting the elements using the given less lambda and returns a
+// reference to this Slice.
+func (p *{{.Slice}}) SortByM(less func(a, b {{.N}}O) bool) {{.N}}ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sort.Slice(*p, func(i, j int) bool {
+		return less((*p)[i], (*p)[j])
+	})
+	return p
+}
+
+// SortM modifies this Slice sorting the elements and returns a reference to this Slice.
+func (p *{{.Slice}}) SortM() {{.N}}ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sort.Sort(p)
+	return p
+}
+
+// SortReverse returns a new Slice sorting the elements in reverse.
+func (p *{{.Slice}}) SortReverse() (new {{.N}}ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortReverseM()
+}
+
+// SortReverseM modifies this Slice sorting the elements in reverse and returns a reference to this Slice.
+func (p *{{.Slice}}) SortReverseM() {{.N}}ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sort.Sort(sort.Reverse(p))
+	return p
+}
+
+// SortStable returns a new Slice with the elements sorted using the given less lambda while
+// keeping equal elements in their original order.
+func (p *{{.Slice}}) SortStable(less func(a, b {{.N}}O) bool) (new {{.N}}ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortStableM(less)
+}
+
+// SortStableM modifies this Slice sorting the elements using the given less lambda while keeping
+// equal elements in their original order and returns a reference to this Slice.
+func (p *{{.Slice}}) SortStableM(less func(a, b {{.N}}O) bool) {{.N}}ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sort.SliceStable(*p, func(i, j int) bool {
+		return less((*p)[i], (*p)[j])
+	})
+	return p
+}
+
+// Returns a string representation of this Slice, implements the Stringer interface
+func (p *{{.Slice}}) String() string {
+	var builder strings.Builder
+	builder.WriteString("[")
+	if p != nil {
+		for i := range *p {
+			builder.WriteString({{.Format "(*p)[i]"}})
+			if i+1 < len(*p) {
+				builder.WriteString(" ")
+			}
+		}
+	}
+	builder.WriteString("]")
+	return builder.String()
+}
+
+// Swap modifies this Slice swapping the indicated elements.
+func (p *{{.Slice}}) Swap(i, j int) {
+	if p == nil || len(*p) < 2 || i < 0 || j < 0 || i >= len(*p) || j >= len(*p) {
+		return
+	}
+	(*p)[i], (*p)[j] = (*p)[j], (*p)[i]
+}
+
+// SymmetricDifference returns a new Slice with the uniq elements of this Slice that are not in the
+// given Slice followed by the uniq elements of the given Slice that are not in this Slice while
+// preserving order.
+// Supports {{.Slice}}, *{{.Slice}}, []{{.Elem}} or *[]{{.Elem}}
+func (p *{{.Slice}}) SymmetricDifference(slice interface{}) (new {{.N}}ISlice) {
+	other := To{{.Slice}}(slice)
+	return p.Difference(other).ConcatM(other.Difference(p))
+}
+
+// Take modifies this Slice removing the indicated range of elements from this Slice and returning them as a new Slice.
+// Expects nothing, in which case everything is taken, or two indices i and j, in which case positive and negative
+// notation is supported and uses an inclusive behavior such that Take(0, -1) includes index -1 as opposed to Go's
+// exclusive behavior. Out of bounds indices will be moved within bounds.
+func (p *{{.Slice}}) Take(indices ...int) (new {{.N}}ISlice) {
+	new = p.Copy(indices...)
+	p.Drop(indices...)
+	return
+}
+
+// TakeAt modifies this Slice removing the elemement at the given index location and returns the removed element as an Object.
+// Allows for negative notation.
+func (p *{{.Slice}}) TakeAt(i int) (elem *{{.N}}Object) {
+	elem = p.At(i)
+	p.DropAt(i)
+	return
+}
+
+// TakeW modifies this Slice removing the elements that match the lambda selector and returns them as a new Slice.
+func (p *{{.Slice}}) TakeW(sel func({{.N}}O) bool) (new {{.N}}ISlice) {
+	slice := New{{.Slice}}V()
+	if p == nil || len(*p) == 0 {
+		return slice
+	}
+	l := len(*p)
+	for i := 0; i < l; i++ {
+		if sel((*p)[i]) {
+			*slice = append(*slice, (*p)[i])
+			p.DropAt(i)
+			l--
+			i--
+		}
+	}
+	return slice
+}
+
+// ToInts converts the underlying slice into a []int
+func (p *{{.Slice}}) ToInts() (slice []int) {
+	return p.ToIntSlice().G()
+}
+
+// ToIntSlice converts the underlying slice into a *IntSlice
+func (p *{{.Slice}}) ToIntSlice() (slice *{{.N}}IntSlice) {
+	return {{.N}}ToIntSlice(p.O())
+}
+
+// ToInterSlice converts the given slice to a generic []interface{} slice
+func (p *{{.Slice}}) ToInterSlice() (slice []interface{}) {
+	return {{.N}}ToInterSlice(p.O()).G()
+}
+
+// ToStringSlice converts the underlying slice into a *StringSlice using the same element
+// formatting as String
+func (p *{{.Slice}}) ToStringSlice() (slice *{{.N}}StringSlice) {
+	slice = {{.N}}NewStringSliceV()
+	if p == nil {
+		return
+	}
+	for i := range *p {
+		*slice = append(*slice, {{.Format "(*p)[i]"}})
+	}
+	return
+}
+
+// ToStrs converts the underlying slice into a []string slice
+func (p *{{.Slice}}) ToStrs() (slice []string) {
+	return p.ToStringSlice().G()
+}
+
+// Union returns a new Slice by joining uniq elements from this Slice with uniq elements from the given Slice while preserving order.
+// Supports {{.Slice}}, *{{.Slice}}, []{{.Elem}} or *[]{{.Elem}}
+func (p *{{.Slice}}) Union(slice interface{}) (new {{.N}}ISlice) {
+	return p.Copy().UnionM(slice)
+}
+
+// UnionM modifies this Slice by joining uniq elements from this Slice with uniq elements from the given Slice while preserving order.
+// Supports {{.Slice}}, *{{.Slice}}, []{{.Elem}} or *[]{{.Elem}}
+func (p *{{.Slice}}) UnionM(slice interface{}) {{.N}}ISlice {
+	return p.ConcatM(slice).UniqM()
+}
+
+// Uniq returns a new Slice with all non uniq elements removed while preserving element order.
+// Cost for this call vs the UniqM is roughly the same, this one is appending that one dropping.
+func (p *{{.Slice}}) Uniq() (new {{.N}}ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	m := map[{{.KeyType}}]bool{}
+	slice := New{{.Slice}}V()
+	for i := range *p {
+		if k := {{.Key "(*p)[i]"}}; !m[k] {
+			m[k] = true
+			*slice = append(*slice, (*p)[i])
+		}
+	}
+	return slice
+}
+
+// UniqM modifies this Slice to remove all non uniq elements while preserving element order.
+// Cost for this call vs the Uniq is roughly the same, this one is dropping that one appending.
+func (p *{{.Slice}}) UniqM() {{.N}}ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	m := map[{{.KeyType}}]bool{}
+	l := len(*p)
+	for i := 0; i < l; i++ {
+		if k := {{.Key "(*p)[i]"}}; m[k] {
+			p.DropAt(i)
+			l--
+			i--
+		} else {
+			m[k] = true
+		}
+	}
+	return p
+}
+
+// Window creates a new slice of Slices each holding n consecutive elements copied from this Slice
+// starting at every step elements i.e. a sliding window. Only full windows are included and an
+// empty slice is returned if n or step are less than 1.
+func (p *{{.Slice}}) Window(n, step int) (windows {{.N}}ISlice) {
+	x := []interface{}{}
+	if p == nil || len(*p) == 0 || n < 1 || step < 1 {
+		return {{.N}}NewInterSliceV(x...)
+	}
+	for i := 0; i+n <= len(*p); i += step {
+		x = append(x, p.Copy(i, i+n-1))
+	}
+	return {{.N}}NewInterSliceV(x...)
+}
+
+// Zip creates a new slice of pairs, as InterSlices, combining each element of this Slice with
+// the element at the same index in the given Slice. The result is as long as the shorter Slice.
+func (p *{{.Slice}}) Zip(slice interface{}) (new {{.N}}ISlice) {
+	x := []interface{}{}
+	other, ok := slice.({{.N}}ISlice)
+	if !ok {
+		other = {{.N}}Slice(slice)
+	}
+	if p == nil || len(*p) == 0 || other.Len() == 0 {
+		return {{.N}}NewInterSliceV(x...)
+	}
+	for i := 0; i < len(*p) && i < other.Len(); i++ {
+		x = append(x, {{.N}}NewInterSliceV((*p)[i], other.At(i).O()))
+	}
+	return {{.N}}NewInterSliceV(x...)
+}
+{{- if .User}}
+
+// To{{.Slice}} converts the given obj into a *{{.Slice}}. Supports {{.Elem}}, *{{.Elem}},
+// []{{.Elem}}, *[]{{.Elem}}, []*{{.Elem}}, Objects, Slices and []interface{} of these.
+func To{{.Slice}}(obj interface{}) *{{.Slice}} {
+	x, _ := To{{.Slice}}E(obj)
+	return x
+}
+
+// To{{.Slice}}E converts the given obj into a *{{.Slice}}. Supports {{.Elem}}, *{{.Elem}},
+// []{{.Elem}}, *[]{{.Elem}}, []*{{.Elem}}, Objects, Slices and []interface{} of these.
+func To{{.Slice}}E(obj interface{}) (val *{{.Slice}}, err error) {
+	val = &{{.Slice}}{}
+	if x, ok := obj.({{.N}}ISlice); ok {
+		obj = x.O()
+	}
+
+	switch x := obj.(type) {
+	case nil:
+	case []{{.Elem}}:
+		v := {{.Slice}}(x)
+		val = &v
+	case *[]{{.Elem}}:
+		if x != nil {
+			v := {{.Slice}}(*x)
+			val = &v
+		}
+	case []*{{.Elem}}:
+		for i := range x {
+			if x[i] != nil {
+				*val = append(*val, *x[i])
+			}
+		}
+	case []interface{}:
+		for i := range x {
+			if v, e := {{.Conv}}(x[i]); e == nil {
+				*val = append(*val, v)
+			} else {
+				err = errors.WithMessagef(e, "unable to convert %T to []{{.Elem}}", x)
+			}
+		}
+	default:
+		if v, e := {{.Conv}}(x); e == nil {
+			*val = append(*val, v)
+		} else {
+			err = errors.Errorf("unable to convert type %T to []{{.Elem}}", x)
+		}
+	}
+	return
+}
+
+// {{.Conv}} converts the given obj into a {{.Elem}}. Supports {{.Elem}}, *{{.Elem}} and Objects.
+func {{.Conv}}(obj interface{}) (val {{.Elem}}, err error) {
+	switch x := obj.(type) {
+	case {{.Elem}}:
+		val = x
+	case *{{.Elem}}:
+		if x == nil {
+			err = errors.Errorf("unable to convert nil *{{.Elem}} to {{.Elem}}")
+			return
+		}
+		val = *x
+	case {{.N}}Object:
+		return {{.Conv}}(x.O())
+	case *{{.N}}Object:
+		return {{.Conv}}(x.O())
+	default:
+		err = errors.Errorf("unable to convert type %T to {{.Elem}}", x)
+	}
+	return
+}
+{{- end}}
+`
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// Type describes the element type of a Slice implementation to generate and provides the
+// snippets of code needed by the templates to work with it.
+type Type struct {
+	Header     string // generated code header
+	Package    string // name of the package to generate into
+	N          string // qualifier for n identifiers, empty when generating into package n
+	File       string // file name of the generated Slice implementation
+	Slice      string // name of the Slice type e.g. BoolSlice
+	Elem       string // element type e.g. bool
+	Conv       string // function converting an interface{} to the element type
+	Time       bool   // element type requires the time package
+	User       bool   // element type is a user defined type
+	Ordered    bool   // element type supports ordering i.e. sorting
+	Examples   bool   // generate Example functions with the tests
+	EqualF     string // format for comparing two elements for equality
+	LessF      string // format for comparing two elements for ordering
+	FormatF    string // format for converting an element to a string
+	KeyF       string // format for converting an element to a map key
+	KeyType    string // type of the map key
+	SamplesVar string // name of the variable holding the user samples
+	Samples    string // Go expression of the user samples
+	A          string // sample element less than B
+	B          string // sample element greater than A
+	SA         string // A formatted
+	SB         string // B formatted
+	OA         string // A printed with fmt
+	OB         string // B printed with fmt
+	StrA       string // expression converting to A
+	StrB       string // expression converting to B
+	Bad        string // expression failing conversion
+}
+
+// builtins are the supported non user element types that are generated into package n
+var builtins = map[string]Type{
+	"bool": {
+		File: "slice_bool.go", Slice: "BoolSlice", Elem: "bool", Conv: "ToBoolE", Ordered: true,
+		EqualF: "%s == %s", LessF: "!%s && %s", FormatF: `fmt.Sprintf("%%t", %s)`, KeyF: "%s", KeyType: "bool",
+		A: "false", B: "true", SA: "false", SB: "true", OA: "false", OB: "true",
+		StrA: `"false"`, StrB: `"true"`, Bad: `"foo"`,
+	},
+	"byte": {
+		File: "slice_byte.go", Slice: "ByteSlice", Elem: "byte", Conv: "ToUint8E", Ordered: true,
+		EqualF: "%s == %s", LessF: "%s < %s", FormatF: `fmt.Sprintf("%%d", %s)`, KeyF: "%s", KeyType: "byte",
+		A: "byte(1)", B: "byte(2)", SA: "1", SB: "2", OA: "1", OB: "2",
+		StrA: `"1"`, StrB: `"2"`, Bad: `"foo"`,
+	},
+	"time.Duration": {
+		File: "slice_duration.go", Slice: "DurationSlice", Elem: "time.Duration", Conv: "ToDurationE", Time: true, Ordered: true,
+		EqualF: "%s == %s", LessF: "%s < %s", FormatF: "%s.String()", KeyF: "%s", KeyType: "time.Duration",
+		A: "time.Second", B: "time.Minute", SA: "1s", SB: "1m0s", OA: "1s", OB: "1m0s",
+		StrA: `"1s"`, StrB: `"1m0s"`, Bad: `"foo"`,
+	},
+	"time.Time": {
+		File: "slice_time.go", Slice: "TimeSlice", Elem: "time.Time", Conv: "ToTimeE", Time: true, Ordered: true,
+		EqualF: "%s.Equal(%s)", LessF: "%s.Before(%s)", FormatF: "%s.Format(time.RFC3339Nano)", KeyF: "%s.Round(0).UTC()", KeyType: "time.Time",
+		A: "time.Unix(0, 0).UTC()", B: "time.Unix(60, 0).UTC()",
+		SA: "1970-01-01T00:00:00Z", SB: "1970-01-01T00:01:00Z",
+		OA: "1970-01-01 00:00:00 +0000 UTC", OB: "1970-01-01 00:01:00 +0000 UTC",
+		StrA: `"1970-01-01T00:00:00Z"`, StrB: `"1970-01-01T00:01:00Z"`, Bad: `"foo"`,
+	},
+}
+
+// newUserType creates a new Type for the given user defined type. The type's sorting support is
+// based on it having a Less method and the samples are used for the generated tests.
+func newUserType(name string, less bool, samples string) (t Type, err error) {
+	if samples != "" {
+		expr, e := parser.ParseExpr(samples)
+		if e != nil {
+			err = errors.Wrapf(e, "failed to parse samples %q", samples)
+			return
+		}
+		var buf bytes.Buffer
+		if err = format.Node(&buf, token.NewFileSet(), expr); err != nil {
+			err = errors.Wrapf(err, "failed to format samples %q", samples)
+			return
+		}
+		samples = buf.String()
+	}
+
+	samplesVar := lowerFirst(name) + "Samples"
+	t = Type{
+		File:       strings.ToLower(name) + "_slice.go",
+		Slice:      name + "Slice",
+		Elem:       name,
+		Conv:       "to" + name + "E",
+		User:       true,
+		Ordered:    less,
+		EqualF:     "%s == %s",
+		LessF:      "%s.Less(%s)",
+		FormatF:    "fmt.Sprint(%s)",
+		KeyF:       "%s",
+		KeyType:    name,
+		SamplesVar: samplesVar,
+		Samples:    samples,
+		A:          samplesVar + "[0]",
+		B:          samplesVar + "[1]",
+		StrA:       "&" + samplesVar + "[0]",
+		StrB:       "&" + samplesVar + "[1]",
+		Bad:        `"foo"`,
+	}
+	return
+}
+
+// Equal returns the code comparing the given elements for equality
+func (t Type) Equal(a, b string) string {
+	return fmt.Sprintf(t.EqualF, a, b)
+}
+
+// Less returns the code checking if element a is ordered before element b
+func (t Type) Less(a, b string) string {
+	return fmt.Sprintf(t.LessF, a, b)
+}
+
+// Format returns the code converting the given element to a string
+func (t Type) Format(a string) string {
+	return fmt.Sprintf(t.FormatF, a)
+}
+
+// Key returns the code converting the given element to a map key
+func (t Type) Key(a string) string {
+	return fmt.Sprintf(t.KeyF, a)
+}
+
+// Assert returns the code asserting the given interface{} is the element type
+func (t Type) Assert(a string) string {
+	return fmt.Sprintf("%s.(%s)", a, t.Elem)
+}
+
+// Fmt returns true if the generated implementation requires the fmt package
+func (t Type) Fmt() bool {
+	return strings.Contains(t.FormatF, "fmt.")
+}
+
+// Str returns the code for the given string with {A} and {B} replaced by the formatted samples.
+// Builtin samples are known ahead of time and are simply quoted while user samples are formatted
+// at runtime.
+func (t Type) Str(s string) string {
+	if !t.User {
+		return strconv.Quote(strings.NewReplacer("{A}", t.SA, "{B}", t.SB).Replace(s))
+	}
+	if s == "{A}" {
+		return fmt.Sprintf("fmt.Sprint(%s)", t.A)
+	}
+	if s == "{B}" {
+		return fmt.Sprintf("fmt.Sprint(%s)", t.B)
+	}
+	var args []string
+	for i := 0; i < len(s); i++ {
+		if strings.HasPrefix(s[i:], "{A}") {
+			args = append(args, t.A)
+		} else if strings.HasPrefix(s[i:], "{B}") {
+			args = append(args, t.B)
+		}
+	}
+	format := strings.NewReplacer("%", "%%").Replace(s)
+	format = strings.NewReplacer("{A}", "%v", "{B}", "%v").Replace(format)
+	if len(args) == 0 {
+		return strconv.Quote(s)
+	}
+	return fmt.Sprintf("fmt.Sprintf(%s, %s)", strconv.Quote(format), strings.Join(args, ", "))
+}
+
+// Word returns the code converting the given element to a string without whitespace. Strings
+// with whitespace are split into multiple elements when used to create a StringSlice.
+func (t Type) Word(a string) string {
+	if t.User {
+		return fmt.Sprintf("strings.Join(strings.Fields(%s), \"_\")", t.Format(a))
+	}
+	switch a {
+	case t.A:
+		return t.Str("{A}")
+	case t.B:
+		return t.Str("{B}")
+	}
+	return t.Format(a)
+}
+
+// lowerFirst returns the given string with the first rune lower cased
+func lowerFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToLower(r)) + s[size:]
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/ghodss/yaml"
 	"github.com/pkg/errors"
+	yaml2 "gopkg.in/yaml.v2"
 )
 
 var gUseLocalTime bool
@@ -1619,6 +1620,27 @@ func Reference(obj interface{}) interface{} {
 	case *map[string]*uint64:
 		return x
 
+	// map[int]bool
+	//----------------------------------------------------------------------------------------------
+	case map[int]bool:
+		return &x
+	case *map[int]bool:
+		return x
+
+	// map[float64]bool
+	//----------------------------------------------------------------------------------------------
+	case map[float64]bool:
+		return &x
+	case *map[float64]bool:
+		return x
+
+	// map[rune]bool
+	//----------------------------------------------------------------------------------------------
+	case map[rune]bool:
+		return &x
+	case *map[rune]bool:
+		return x
+
 	// template.CSS
 	//----------------------------------------------------------------------------------------------
 	case template.CSS:
@@ -1839,13 +1861,138 @@ func ToBoolE(obj interface{}) (val bool, err error) {
 	return
 }
 
+// ToBoolSlice convert an interface to a BoolSlice type which will never be nil
+func ToBoolSlice(obj interface{}) *BoolSlice {
+	x, _ := ToBoolSliceE(obj)
+	if x == nil {
+		return &BoolSlice{}
+	}
+	return x
+}
+
+// ToBoolSliceE convert an interface to a BoolSlice type. Elements are converted with ToBoolE.
+func ToBoolSliceE(obj interface{}) (val *BoolSlice, err error) {
+	val = &BoolSlice{}
+	if x, ok := obj.(ISlice); ok {
+		obj = x.O()
+	}
+	o := DeReference(obj)
+
+	// Optimized types
+	switch x := o.(type) {
+	case nil:
+	case bool:
+		*val = append(*val, x)
+	case []bool:
+		v := BoolSlice(x)
+		val = &v
+	case Str:
+		var v bool
+		if v, err = ToBoolE(string(x)); err == nil {
+			*val = append(*val, v)
+		}
+	case []interface{}:
+		for i := range x {
+			if v, e := ToBoolE(x[i]); e == nil {
+				*val = append(*val, v)
+			} else {
+				err = errors.WithMessagef(e, "unable to convert %T to []bool", x)
+			}
+		}
+
+	// fall back on reflection for other slice types and single elements
+	//----------------------------------------------------------------------------------------------
+	default:
+		v := reflect.ValueOf(x)
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			if y, e := ToBoolE(x); e == nil {
+				*val = append(*val, y)
+			} else {
+				err = errors.Errorf("unable to convert type %T to []bool", x)
+			}
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			if y, e := ToBoolE(v.Index(i).Interface()); e == nil {
+				*val = append(*val, y)
+			} else {
+				err = errors.WithMessagef(e, "unable to convert %T to []bool", x)
+			}
+		}
+	}
+	return
+}
+
+// ToByteSlice convert an interface to a ByteSlice type which will never be nil
+func ToByteSlice(obj interface{}) *ByteSlice {
+	x, _ := ToByteSliceE(obj)
+	if x == nil {
+		return &ByteSlice{}
+	}
+	return x
+}
+
+// ToByteSliceE convert an interface to a ByteSlice type. Elements are converted with ToUint8E.
+func ToByteSliceE(obj interface{}) (val *ByteSlice, err error) {
+	val = &ByteSlice{}
+	if x, ok := obj.(ISlice); ok {
+		obj = x.O()
+	}
+	o := DeReference(obj)
+
+	// Optimized types
+	switch x := o.(type) {
+	case nil:
+	case byte:
+		*val = append(*val, x)
+	case []byte:
+		v := ByteSlice(x)
+		val = &v
+	case Str:
+		var v byte
+		if v, err = ToUint8E(string(x)); err == nil {
+			*val = append(*val, v)
+		}
+	case []interface{}:
+		for i := range x {
+			if v, e := ToUint8E(x[i]); e == nil {
+				*val = append(*val, v)
+			} else {
+				err = errors.WithMessagef(e, "unable to convert %T to []byte", x)
+			}
+		}
+
+	// fall back on reflection for other slice types and single elements
+	//----------------------------------------------------------------------------------------------
+	default:
+		v := reflect.ValueOf(x)
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			if y, e := ToUint8E(x); e == nil {
+				*val = append(*val, y)
+			} else {
+				err = errors.Errorf("unable to convert type %T to []byte", x)
+			}
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			if y, e := ToUint8E(v.Index(i).Interface()); e == nil {
+				*val = append(*val, y)
+			} else {
+				err = errors.WithMessagef(e, "unable to convert %T to []byte", x)
+			}
+		}
+	}
+	return
+}
+
 // ToDuration converts an interface to a time.Duration type.
 func ToDuration(obj interface{}) (val time.Duration) {
 	x, _ := ToDurationE(obj)
 	return x
 }
 
-// ToDurationE converts an interface to a time.Duration type.
+// ToDurationE converts an interface to a time.Duration type. Strings are parsed as either an
+// integer number of nanoseconds or a Go duration string e.g. 1h30m, 500ms.
 func ToDurationE(obj interface{}) (val time.Duration, err error) {
 	o := DeReference(obj)
 
@@ -1857,12 +2004,85 @@ func ToDurationE(obj interface{}) (val time.Duration, err error) {
 		val = time.Duration(ToInt64(x))
 	case float32, float64:
 		val = time.Duration(ToFloat64(x))
+	case Str:
+		return ToDurationE(string(x))
+	case string:
+
+		// Try int conversion first
+		if v, e := strconv.ParseInt(x, 10, 64); e == nil {
+			return time.Duration(v), nil
+		}
+		if val, err = time.ParseDuration(x); err != nil {
+			err = errors.Wrapf(err, "failed to convert string to time.Duration")
+		}
 	default:
 		err = errors.Errorf("failed to convert type %T to time.Duration", obj)
 	}
 	return
 }
 
+// ToDurationSlice convert an interface to a DurationSlice type which will never be nil
+func ToDurationSlice(obj interface{}) *DurationSlice {
+	x, _ := ToDurationSliceE(obj)
+	if x == nil {
+		return &DurationSlice{}
+	}
+	return x
+}
+
+// ToDurationSliceE convert an interface to a DurationSlice type. Elements are converted with ToDurationE.
+func ToDurationSliceE(obj interface{}) (val *DurationSlice, err error) {
+	val = &DurationSlice{}
+	if x, ok := obj.(ISlice); ok {
+		obj = x.O()
+	}
+	o := DeReference(obj)
+
+	// Optimized types
+	switch x := o.(type) {
+	case nil:
+	case time.Duration:
+		*val = append(*val, x)
+	case []time.Duration:
+		v := DurationSlice(x)
+		val = &v
+	case Str:
+		var v time.Duration
+		if v, err = ToDurationE(string(x)); err == nil {
+			*val = append(*val, v)
+		}
+	case []interface{}:
+		for i := range x {
+			if v, e := ToDurationE(x[i]); e == nil {
+				*val = append(*val, v)
+			} else {
+				err = errors.WithMessagef(e, "unable to convert %T to []time.Duration", x)
+			}
+		}
+
+	// fall back on reflection for other slice types and single elements
+	//----------------------------------------------------------------------------------------------
+	default:
+		v := reflect.ValueOf(x)
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			if y, e := ToDurationE(x); e == nil {
+				*val = append(*val, y)
+			} else {
+				err = errors.Errorf("unable to convert type %T to []time.Duration", x)
+			}
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			if y, e := ToDurationE(v.Index(i).Interface()); e == nil {
+				*val = append(*val, y)
+			} else {
+				err = errors.WithMessagef(e, "unable to convert %T to []time.Duration", x)
+			}
+		}
+	}
+	return
+}
+
 // C is an alias to ToChar for brevity
 func C(obj interface{}) *Char {
 	return ToChar(obj)
@@ -4053,6 +4273,66 @@ func ToMapSliceE(obj interface{}) (val *MapSlice, err error) {
 	return
 }
 
+// ToOrderedMap converts an interface to an *OrderedMap type. Supports converting yaml and json
+// strings as well keeping their key order. Maps without an order e.g. map[string]interface{}
+// get their keys sorted.
+func ToOrderedMap(obj interface{}) *OrderedMap {
+	x, _ := ToOrderedMapE(obj)
+	if x == nil {
+		return NewOrderedMapV()
+	}
+	return x
+}
+
+// ToOrderedMapE converts an interface to an *OrderedMap type. Supports converting yaml and json
+// strings as well keeping their key order. Maps without an order e.g. map[string]interface{}
+// get their keys sorted.
+func ToOrderedMapE(obj interface{}) (val *OrderedMap, err error) {
+	val = NewOrderedMapV()
+	o := Reference(obj)
+
+	// Optimized types
+	switch x := o.(type) {
+	case nil:
+
+	// byte and string
+	//----------------------------------------------------------------------------------------------
+	case *[]byte, *string:
+		var data []byte
+		if y, ok := x.(*string); ok {
+			data = []byte(*y)
+		} else {
+			data = *(x.(*[]byte))
+		}
+		items := yaml2.MapSlice{}
+		if err = yaml2.Unmarshal(data, &items); err != nil {
+			err = errors.Wrap(err, "failed to unmarshal bytes into OrderedMap")
+			return
+		}
+		val = orderedValue(items).(*OrderedMap)
+
+	// OrderedMap
+	//----------------------------------------------------------------------------------------------
+	case *OrderedMap:
+		if x != nil {
+			val = x
+		}
+	case yaml2.MapSlice:
+		val = orderedValue(x).(*OrderedMap)
+
+	// fall back on StringMap conversion
+	//----------------------------------------------------------------------------------------------
+	default:
+		var m *StringMap
+		if m, err = ToStringMapE(x); err != nil {
+			err = errors.Errorf("unable to convert type %T to an OrderedMap", x)
+			return
+		}
+		val = orderedValue(m).(*OrderedMap)
+	}
+	return
+}
+
 // R is an alias to ToRune for brevity
 func R(obj interface{}) rune {
 	return rune(*ToChar(obj))
@@ -4692,6 +4972,20 @@ func ToStringMapE(obj interface{}) (val *StringMap, err error) {
 			val = x
 		}
 
+	// OrderedMap
+	//----------------------------------------------------------------------------------------------
+	case *OrderedMap:
+		if x != nil {
+			val = x.ToStringMap()
+		}
+
+	// RefMap
+	//----------------------------------------------------------------------------------------------
+	case *RefMap:
+		if x != nil {
+			val, err = ToStringMapE(x.O())
+		}
+
 	// fall back on reflection
 	//----------------------------------------------------------------------------------------------
 	default:
@@ -5426,6 +5720,68 @@ func ToTimeE(obj interface{}) (val time.Time, err error) {
 	return
 }
 
+// ToTimeSlice convert an interface to a TimeSlice type which will never be nil
+func ToTimeSlice(obj interface{}) *TimeSlice {
+	x, _ := ToTimeSliceE(obj)
+	if x == nil {
+		return &TimeSlice{}
+	}
+	return x
+}
+
+// ToTimeSliceE convert an interface to a TimeSlice type. Elements are converted with ToTimeE.
+func ToTimeSliceE(obj interface{}) (val *TimeSlice, err error) {
+	val = &TimeSlice{}
+	if x, ok := obj.(ISlice); ok {
+		obj = x.O()
+	}
+	o := DeReference(obj)
+
+	// Optimized types
+	switch x := o.(type) {
+	case nil:
+	case time.Time:
+		*val = append(*val, x)
+	case []time.Time:
+		v := TimeSlice(x)
+		val = &v
+	case Str:
+		var v time.Time
+		if v, err = ToTimeE(string(x)); err == nil {
+			*val = append(*val, v)
+		}
+	case []interface{}:
+		for i := range x {
+			if v, e := ToTimeE(x[i]); e == nil {
+				*val = append(*val, v)
+			} else {
+				err = errors.WithMessagef(e, "unable to convert %T to []time.Time", x)
+			}
+		}
+
+	// fall back on reflection for other slice types and single elements
+	//----------------------------------------------------------------------------------------------
+	default:
+		v := reflect.ValueOf(x)
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			if y, e := ToTimeE(x); e == nil {
+				*val = append(*val, y)
+			} else {
+				err = errors.Errorf("unable to convert type %T to []time.Time", x)
+			}
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			if y, e := ToTimeE(v.Index(i).Interface()); e == nil {
+				*val = append(*val, y)
+			} else {
+				err = errors.WithMessagef(e, "unable to convert %T to []time.Time", x)
+			}
+		}
+	}
+	return
+}
+
 // ToUint convert an interface to an uint type.
 func ToUint(obj interface{}) uint {
 	x, _ := ToUintE(obj)
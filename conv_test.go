@@ -777,6 +777,30 @@ func TestReference(t *testing.T) {
 			assert.Equal(t, (*map[string]int64)(nil), Reference((*map[string]int64)(nil)))
 			assert.Equal(t, (*map[string]*int64)(nil), Reference((*map[string]*int64)(nil)))
 		}
+
+		// map[int]bool
+		{
+			map2 := map[int]bool{1: true}
+			assert.Equal(t, &map2, Reference(map2))
+			assert.Equal(t, &map2, Reference(&map2))
+			assert.Equal(t, (*map[int]bool)(nil), Reference((*map[int]bool)(nil)))
+		}
+
+		// map[float64]bool
+		{
+			map2 := map[float64]bool{1.0: true}
+			assert.Equal(t, &map2, Reference(map2))
+			assert.Equal(t, &map2, Reference(&map2))
+			assert.Equal(t, (*map[float64]bool)(nil), Reference((*map[float64]bool)(nil)))
+		}
+
+		// map[rune]bool
+		{
+			map2 := map[rune]bool{'1': true}
+			assert.Equal(t, &map2, Reference(map2))
+			assert.Equal(t, &map2, Reference(&map2))
+			assert.Equal(t, (*map[rune]bool)(nil), Reference((*map[rune]bool)(nil)))
+		}
 	}
 
 	// template.CSS
@@ -1386,6 +1410,184 @@ func TestToBoolE(t *testing.T) {
 	}
 }
 
+// ToBoolSliceE
+//--------------------------------------------------------------------------------------------------
+func ExampleToBoolSliceE() {
+	fmt.Println(ToBoolSliceE([]string{"true", "0"}))
+	// Output: [true false] <nil>
+}
+
+func TestToBoolSliceE(t *testing.T) {
+
+	// invalid
+	{
+		val, err := ToBoolSliceE(nil)
+		assert.Nil(t, err)
+		assert.Equal(t, &BoolSlice{}, val)
+
+		val, err = ToBoolSliceE(&TestObj{})
+		assert.Equal(t, "unable to convert type n.TestObj to []bool", err.Error())
+		assert.Equal(t, &BoolSlice{}, val)
+
+		val, err = ToBoolSliceE([]string{"true", "bogus"})
+		assert.Equal(t, `unable to convert []string to []bool: failed to convert string to bool: strconv.ParseBool: parsing "bogus": invalid syntax`, err.Error())
+		assert.Equal(t, &BoolSlice{true}, val)
+	}
+
+	// single elements
+	{
+		assert.Equal(t, &BoolSlice{true}, ToBoolSlice(true))
+		assert.Equal(t, &BoolSlice{false}, ToBoolSlice((*bool)(nil)))
+		assert.Equal(t, &BoolSlice{true}, ToBoolSlice(1))
+		assert.Equal(t, &BoolSlice{true}, ToBoolSlice("true"))
+		assert.Equal(t, &BoolSlice{true}, ToBoolSlice(A("true")))
+	}
+
+	// slices
+	{
+		assert.Equal(t, &BoolSlice{true, false}, ToBoolSlice([]bool{true, false}))
+		assert.Equal(t, &BoolSlice{true, false}, ToBoolSlice(&[]bool{true, false}))
+		assert.Equal(t, &BoolSlice{true, false}, ToBoolSlice([]int{1, 0}))
+		assert.Equal(t, &BoolSlice{true, false}, ToBoolSlice([]interface{}{true, "false"}))
+		assert.Equal(t, &BoolSlice{true, false}, ToBoolSlice(NewBoolSliceV(true, false)))
+		assert.Equal(t, &BoolSlice{true, false}, ToBoolSlice(NewIntSliceV(1, 0)))
+		assert.Equal(t, &BoolSlice{}, ToBoolSlice((*BoolSlice)(nil)))
+	}
+}
+
+// ToByteSliceE
+//--------------------------------------------------------------------------------------------------
+func ExampleToByteSliceE() {
+	fmt.Println(ToByteSliceE([]int{1, 2}))
+	// Output: [1 2] <nil>
+}
+
+func TestToByteSliceE(t *testing.T) {
+
+	// invalid
+	{
+		val, err := ToByteSliceE(nil)
+		assert.Nil(t, err)
+		assert.Equal(t, &ByteSlice{}, val)
+
+		val, err = ToByteSliceE(&TestObj{})
+		assert.Equal(t, "unable to convert type n.TestObj to []byte", err.Error())
+		assert.Equal(t, &ByteSlice{}, val)
+	}
+
+	// single elements
+	{
+		assert.Equal(t, &ByteSlice{1}, ToByteSlice(byte(1)))
+		assert.Equal(t, &ByteSlice{1}, ToByteSlice(1))
+		assert.Equal(t, &ByteSlice{1}, ToByteSlice(true))
+		assert.Equal(t, &ByteSlice{5}, ToByteSlice("5"))
+	}
+
+	// slices
+	{
+		assert.Equal(t, &ByteSlice{0x61, 0x62}, ToByteSlice([]byte("ab")))
+		assert.Equal(t, &ByteSlice{1, 2}, ToByteSlice(&[]byte{1, 2}))
+		assert.Equal(t, &ByteSlice{1, 2}, ToByteSlice([]int{1, 2}))
+		assert.Equal(t, &ByteSlice{1, 2}, ToByteSlice([]interface{}{1, "2"}))
+		assert.Equal(t, &ByteSlice{1, 2}, ToByteSlice(NewIntSliceV(1, 2)))
+		assert.Equal(t, &ByteSlice{}, ToByteSlice((*ByteSlice)(nil)))
+	}
+}
+
+// ToDuration
+//--------------------------------------------------------------------------------------------------
+func ExampleToDuration() {
+	fmt.Println(ToDuration("1m30s"))
+	// Output: 1m30s
+}
+
+func TestToDurationE(t *testing.T) {
+
+	// nil
+	{
+		val, err := ToDurationE(nil)
+		assert.Nil(t, err)
+		assert.Equal(t, time.Duration(0), val)
+	}
+
+	// numerics
+	{
+		val, err := ToDurationE(5)
+		assert.Nil(t, err)
+		assert.Equal(t, time.Duration(5), val)
+
+		val, err = ToDurationE(float64(5))
+		assert.Nil(t, err)
+		assert.Equal(t, time.Duration(5), val)
+
+		val, err = ToDurationE(time.Second)
+		assert.Nil(t, err)
+		assert.Equal(t, time.Second, val)
+	}
+
+	// strings
+	{
+		val, err := ToDurationE("1h30m")
+		assert.Nil(t, err)
+		assert.Equal(t, 90*time.Minute, val)
+
+		val, err = ToDurationE(A("500ms"))
+		assert.Nil(t, err)
+		assert.Equal(t, 500*time.Millisecond, val)
+
+		val, err = ToDurationE("100")
+		assert.Nil(t, err)
+		assert.Equal(t, time.Duration(100), val)
+
+		val, err = ToDurationE("bogus")
+		assert.Equal(t, `failed to convert string to time.Duration: time: invalid duration "bogus"`, err.Error())
+		assert.Equal(t, time.Duration(0), val)
+	}
+
+	// invalid
+	{
+		_, err := ToDurationE(true)
+		assert.Equal(t, "failed to convert type bool to time.Duration", err.Error())
+	}
+}
+
+// ToDurationSliceE
+//--------------------------------------------------------------------------------------------------
+func ExampleToDurationSliceE() {
+	fmt.Println(ToDurationSliceE([]string{"1s", "1m30s"}))
+	// Output: [1s 1m30s] <nil>
+}
+
+func TestToDurationSliceE(t *testing.T) {
+
+	// invalid
+	{
+		val, err := ToDurationSliceE(nil)
+		assert.Nil(t, err)
+		assert.Equal(t, &DurationSlice{}, val)
+
+		val, err = ToDurationSliceE(true)
+		assert.Equal(t, "unable to convert type bool to []time.Duration", err.Error())
+		assert.Equal(t, &DurationSlice{}, val)
+	}
+
+	// single elements
+	{
+		assert.Equal(t, &DurationSlice{time.Second}, ToDurationSlice(time.Second))
+		assert.Equal(t, &DurationSlice{5}, ToDurationSlice(5))
+		assert.Equal(t, &DurationSlice{time.Minute}, ToDurationSlice("1m"))
+	}
+
+	// slices
+	{
+		assert.Equal(t, &DurationSlice{time.Second, time.Minute}, ToDurationSlice([]time.Duration{time.Second, time.Minute}))
+		assert.Equal(t, &DurationSlice{time.Second}, ToDurationSlice(&[]time.Duration{time.Second}))
+		assert.Equal(t, &DurationSlice{1, 2}, ToDurationSlice([]int64{1, 2}))
+		assert.Equal(t, &DurationSlice{time.Second, 2}, ToDurationSlice([]interface{}{"1s", 2}))
+		assert.Equal(t, &DurationSlice{}, ToDurationSlice((*DurationSlice)(nil)))
+	}
+}
+
 // ToChar
 //--------------------------------------------------------------------------------------------------
 func ExampleToChar() {
@@ -4820,3 +5022,44 @@ func TestTime(t *testing.T) {
 		assert.Equal(t, time.Time{}, ToTime(nil))
 	}
 }
+
+// ToTimeSliceE
+//--------------------------------------------------------------------------------------------------
+func ExampleToTimeSliceE() {
+	fmt.Println(ToTimeSliceE([]string{"2008-01-10"}))
+	// Output: [2008-01-10T00:00:00Z] <nil>
+}
+
+func TestToTimeSliceE(t *testing.T) {
+	t1 := time.Date(2008, 1, 10, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2008, 10, 2, 0, 0, 0, 0, time.UTC)
+
+	// invalid
+	{
+		val, err := ToTimeSliceE(nil)
+		assert.Nil(t, err)
+		assert.Equal(t, &TimeSlice{}, val)
+
+		val, err = ToTimeSliceE(true)
+		assert.Equal(t, "unable to convert type bool to []time.Time", err.Error())
+		assert.Equal(t, &TimeSlice{}, val)
+	}
+
+	// single elements
+	{
+		assert.Equal(t, &TimeSlice{t1}, ToTimeSlice(t1))
+		assert.Equal(t, &TimeSlice{t1}, ToTimeSlice(&t1))
+		assert.Equal(t, &TimeSlice{t1}, ToTimeSlice("2008-01-10"))
+		assert.Equal(t, &TimeSlice{time.Unix(5, 0).UTC()}, ToTimeSlice(5))
+	}
+
+	// slices
+	{
+		assert.Equal(t, &TimeSlice{t1, t2}, ToTimeSlice([]time.Time{t1, t2}))
+		assert.Equal(t, &TimeSlice{t1, t2}, ToTimeSlice(&[]time.Time{t1, t2}))
+		assert.Equal(t, &TimeSlice{t1, t2}, ToTimeSlice([]string{"2008-01-10", "October 2, 2008"}))
+		assert.Equal(t, &TimeSlice{t1, t2}, ToTimeSlice([]interface{}{t1, "October 2, 2008"}))
+		assert.Equal(t, &TimeSlice{t1}, ToTimeSlice(NewTimeSliceV(t1)))
+		assert.Equal(t, &TimeSlice{}, ToTimeSlice((*TimeSlice)(nil)))
+	}
+}
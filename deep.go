@@ -0,0 +1,385 @@
+package n
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/phR0ze/n/pkg/opt"
+)
+
+// DeepCopy returns a copy of the given obj recursively copying all nested maps, slices and arrays
+// such that no mutable state is shared with the original. The types of the given obj and its
+// nested values are retained. Pointers to maps, slices and arrays, e.g. *StringMap, are copied as
+// well while pointers to other types are simply shared.
+func DeepCopy(obj interface{}) interface{} {
+	switch x := obj.(type) {
+	case nil:
+		return nil
+	case Object:
+		return Object{DeepCopy(x.o)}
+	case *Object:
+		if x == nil {
+			return x
+		}
+		return &Object{DeepCopy(x.o)}
+	case *StringMap:
+		if x == nil {
+			return x
+		}
+		return x.DeepCopy()
+	case *InterSlice:
+		if x == nil {
+			return x
+		}
+		return x.DeepCopy()
+	case *MapSlice:
+		if x == nil {
+			return x
+		}
+		return x.DeepCopy()
+	case map[string]interface{}:
+		if x == nil {
+			return x
+		}
+		m := make(map[string]interface{}, len(x))
+		for k, v := range x {
+			m[k] = DeepCopy(v)
+		}
+		return m
+	case []interface{}:
+		if x == nil {
+			return x
+		}
+		s := make([]interface{}, len(x))
+		for i := range x {
+			s[i] = DeepCopy(x[i])
+		}
+		return s
+	}
+	return deepCopy(reflect.ValueOf(obj)).Interface()
+}
+
+// DeepEqual checks if the given objects are deeply equal in content. Maps, including StringMap,
+// are compared by the string form of their keys and slices, including InterSlice and MapSlice, by
+// their elements in order regardless of the container types. Objects are compared by the value
+// they hold and pointers by the value they point to. All other values must be of the same type
+// unless coerced.
+//
+// Supported options: CoerceOpt to compare numeric values of different types by value
+func DeepEqual(a, b interface{}, opts ...*opt.Opt) bool {
+	return deepEqual(a, b, getCoerceOpt(opts))
+}
+
+// Hash returns a stable sha256 hex encoded hash of the content of the given obj usable as a cache
+// key. Values that are DeepEqual with the same options produce the same hash e.g. map keys are
+// hashed in sorted order and a StringMap hashes the same as the equivalent Go map.
+//
+// Supported options: CoerceOpt to hash numeric values of different types by value
+func Hash(obj interface{}, opts ...*opt.Opt) string {
+	h := sha256.New()
+	deepHash(h, obj, getCoerceOpt(opts))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// DeepCopy returns a copy of this Map recursively copying all nested maps and slices.
+func (p *StringMap) DeepCopy() (new *StringMap) {
+	new = NewStringMapV()
+	if p == nil {
+		return
+	}
+	for k, v := range *p {
+		(*new)[k] = DeepCopy(v)
+	}
+	return
+}
+
+// DeepEqual checks if this Map is deeply equal in content to the given obj.
+//
+// Supported options: CoerceOpt to compare numeric values of different types by value
+func (p *StringMap) DeepEqual(obj interface{}, opts ...*opt.Opt) bool {
+	return DeepEqual(p, obj, opts...)
+}
+
+// Hash returns a stable sha256 hex encoded hash of the content of this Map.
+//
+// Supported options: CoerceOpt to hash numeric values of different types by value
+func (p *StringMap) Hash(opts ...*opt.Opt) string {
+	return Hash(p, opts...)
+}
+
+// DeepCopy returns a copy of this Slice recursively copying all nested maps and slices.
+func (p *MapSlice) DeepCopy() (new *MapSlice) {
+	new = NewMapSliceV()
+	if p == nil {
+		return
+	}
+	for i := range *p {
+		*new = append(*new, DeepCopy((*p)[i]).(map[string]interface{}))
+	}
+	return
+}
+
+// DeepEqual checks if this Slice is deeply equal in content to the given obj.
+//
+// Supported options: CoerceOpt to compare numeric values of different types by value
+func (p *MapSlice) DeepEqual(obj interface{}, opts ...*opt.Opt) bool {
+	return DeepEqual(p, obj, opts...)
+}
+
+// Hash returns a stable sha256 hex encoded hash of the content of this Slice.
+//
+// Supported options: CoerceOpt to hash numeric values of different types by value
+func (p *MapSlice) Hash(opts ...*opt.Opt) string {
+	return Hash(p, opts...)
+}
+
+// DeepCopy returns a copy of this Slice recursively copying all nested maps and slices.
+func (p *InterSlice) DeepCopy() (new *InterSlice) {
+	new = NewInterSliceV()
+	if p == nil {
+		return
+	}
+	for i := range *p {
+		*new = append(*new, DeepCopy((*p)[i]))
+	}
+	return
+}
+
+// DeepEqual checks if this Slice is deeply equal in content to the given obj.
+//
+// Supported options: CoerceOpt to compare numeric values of different types by value
+func (p *InterSlice) DeepEqual(obj interface{}, opts ...*opt.Opt) bool {
+	return DeepEqual(p, obj, opts...)
+}
+
+// Hash returns a stable sha256 hex encoded hash of the content of this Slice.
+//
+// Supported options: CoerceOpt to hash numeric values of different types by value
+func (p *InterSlice) Hash(opts ...*opt.Opt) string {
+	return Hash(p, opts...)
+}
+
+// DeepCopy returns a new Object holding a copy of this Object's value recursively copying all
+// nested maps and slices.
+func (p *Object) DeepCopy() *Object {
+	if p == nil {
+		return &Object{}
+	}
+	return &Object{DeepCopy(p.o)}
+}
+
+// DeepEqual checks if this Object's value is deeply equal in content to the given obj.
+//
+// Supported options: CoerceOpt to compare numeric values of different types by value
+func (p *Object) DeepEqual(obj interface{}, opts ...*opt.Opt) bool {
+	return DeepEqual(p.O(), obj, opts...)
+}
+
+// Hash returns a stable sha256 hex encoded hash of the content of this Object's value.
+//
+// Supported options: CoerceOpt to hash numeric values of different types by value
+func (p *Object) Hash(opts ...*opt.Opt) string {
+	return Hash(p.O(), opts...)
+}
+
+// deepCopy recursively copies the maps, slices and arrays of the given value using reflection
+func deepCopy(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		if x, ok := v.Interface().(*Object); ok {
+			return reflect.ValueOf(x.DeepCopy())
+		}
+		switch v.Elem().Kind() {
+		case reflect.Map, reflect.Slice, reflect.Array:
+			x := reflect.New(v.Elem().Type())
+			x.Elem().Set(deepCopy(v.Elem()))
+			return x
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		x := reflect.New(v.Type()).Elem()
+		x.Set(reflect.ValueOf(DeepCopy(v.Elem().Interface())))
+		return x
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		x := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for _, k := range v.MapKeys() {
+			x.SetMapIndex(k, deepCopy(v.MapIndex(k)))
+		}
+		return x
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		x := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			x.Index(i).Set(deepCopy(v.Index(i)))
+		}
+		return x
+	case reflect.Array:
+		x := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			x.Index(i).Set(deepCopy(v.Index(i)))
+		}
+		return x
+	}
+	return v
+}
+
+// deepValue unwraps the given obj to the value it holds or points to
+func deepValue(obj interface{}) reflect.Value {
+	for {
+		switch x := obj.(type) {
+		case Object:
+			obj = x.o
+			continue
+		case *Object:
+			obj = x.O()
+			continue
+		case Str:
+			return reflect.ValueOf(string(x))
+		case *Str:
+			if x == nil {
+				return reflect.Value{}
+			}
+			return reflect.ValueOf(string(*x))
+		}
+		v := reflect.ValueOf(obj)
+		if v.Kind() != reflect.Ptr {
+			return v
+		}
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		obj = v.Elem().Interface()
+	}
+}
+
+// deepEqual recursively compares the content of the given objects
+func deepEqual(a, b interface{}, coerce bool) bool {
+	x, y := deepValue(a), deepValue(b)
+	if !x.IsValid() || !y.IsValid() {
+		return x.IsValid() == y.IsValid()
+	}
+
+	switch {
+	case x.Kind() == reflect.Map && y.Kind() == reflect.Map:
+		if x.Len() != y.Len() {
+			return false
+		}
+		keys := map[string]reflect.Value{}
+		for _, k := range y.MapKeys() {
+			keys[fmt.Sprint(k.Interface())] = y.MapIndex(k)
+		}
+		for _, k := range x.MapKeys() {
+			yv, ok := keys[fmt.Sprint(k.Interface())]
+			if !ok || !deepEqual(x.MapIndex(k).Interface(), yv.Interface(), coerce) {
+				return false
+			}
+		}
+		return true
+
+	case deepList(x) && deepList(y):
+		if x.Len() != y.Len() {
+			return false
+		}
+		for i := 0; i < x.Len(); i++ {
+			if !deepEqual(x.Index(i).Interface(), y.Index(i).Interface(), coerce) {
+				return false
+			}
+		}
+		return true
+
+	case coerce && deepNumber(x) && deepNumber(y):
+		return deepNumberKey(x) == deepNumberKey(y)
+	}
+	return x.Type() == y.Type() && reflect.DeepEqual(x.Interface(), y.Interface())
+}
+
+// deepHash recursively writes the canonical form of the given obj to the given hash
+func deepHash(h hash.Hash, obj interface{}, coerce bool) {
+	v := deepValue(obj)
+	switch {
+	case !v.IsValid():
+		h.Write([]byte("nil;"))
+
+	case v.Kind() == reflect.Map:
+		keys := make([]string, 0, v.Len())
+		vals := map[string]reflect.Value{}
+		for _, k := range v.MapKeys() {
+			key := fmt.Sprint(k.Interface())
+			keys = append(keys, key)
+			vals[key] = v.MapIndex(k)
+		}
+		sort.Strings(keys)
+		h.Write([]byte("map{"))
+		for _, k := range keys {
+			h.Write([]byte(strconv.Quote(k) + ":"))
+			deepHash(h, vals[k].Interface(), coerce)
+		}
+		h.Write([]byte("};"))
+
+	case deepList(v):
+		h.Write([]byte("list["))
+		for i := 0; i < v.Len(); i++ {
+			deepHash(h, v.Index(i).Interface(), coerce)
+		}
+		h.Write([]byte("];"))
+
+	case coerce && deepNumber(v):
+		h.Write([]byte("number:" + deepNumberKey(v) + ";"))
+
+	default:
+		h.Write([]byte(setHash(v.Interface()) + ";"))
+	}
+}
+
+// deepList checks if the given value is a slice or array
+func deepList(v reflect.Value) bool {
+	return v.Kind() == reflect.Slice || v.Kind() == reflect.Array
+}
+
+// deepNumber checks if the given value is an int, uint or float of any size
+func deepNumber(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// deepNumberKey returns a string form of the given numeric value that is the same for equal
+// values of different types. Integral floats are formatted as integers.
+func deepNumberKey(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10)
+	}
+	f := v.Float()
+	if f == math.Trunc(f) {
+		if f >= math.MinInt64 && f < math.MaxInt64 {
+			return strconv.FormatInt(int64(f), 10)
+		}
+		if f > 0 && f < math.MaxUint64 {
+			return strconv.FormatUint(uint64(f), 10)
+		}
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
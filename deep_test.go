@@ -0,0 +1,322 @@
+package n
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// DeepCopy
+//--------------------------------------------------------------------------------------------------
+func ExampleDeepCopy() {
+	base := map[string]interface{}{"server": map[string]interface{}{"port": 80}}
+	copy := DeepCopy(base).(map[string]interface{})
+	copy["server"].(map[string]interface{})["port"] = 8080
+	fmt.Println(base["server"], copy["server"])
+	// Output: map[port:80] map[port:8080]
+}
+
+func TestDeepCopy(t *testing.T) {
+
+	// nil
+	{
+		assert.Nil(t, DeepCopy(nil))
+		assert.Equal(t, (*StringMap)(nil), DeepCopy((*StringMap)(nil)))
+		assert.Equal(t, (*InterSlice)(nil), DeepCopy((*InterSlice)(nil)))
+		assert.Equal(t, (*MapSlice)(nil), DeepCopy((*MapSlice)(nil)))
+		assert.Equal(t, (*Object)(nil), DeepCopy((*Object)(nil)))
+		assert.Equal(t, map[string]interface{}(nil), DeepCopy(map[string]interface{}(nil)))
+		assert.Equal(t, []interface{}(nil), DeepCopy([]interface{}(nil)))
+		assert.Equal(t, []string(nil), DeepCopy([]string(nil)))
+		assert.Equal(t, (*StringSlice)(nil), DeepCopy((*StringSlice)(nil)))
+	}
+
+	// scalars are returned as is
+	{
+		assert.Equal(t, 1, DeepCopy(1))
+		assert.Equal(t, "foo", DeepCopy("foo"))
+		assert.Equal(t, time.Unix(0, 0), DeepCopy(time.Unix(0, 0)))
+	}
+
+	// nested maps and slices are not shared
+	{
+		src := map[string]interface{}{
+			"map":    map[string]interface{}{"a": 1},
+			"nmap":   M(map[string]interface{}{"b": 2}),
+			"slice":  []interface{}{map[string]interface{}{"c": 3}},
+			"strs":   []string{"d"},
+			"nstrs":  NewStringSliceV("e"),
+			"array":  [1][]int{{1}},
+			"object": Obj(map[string]interface{}{"f": 4}),
+		}
+		dst := DeepCopy(src).(map[string]interface{})
+		assert.Equal(t, src, dst)
+
+		dst["map"].(map[string]interface{})["a"] = 10
+		dst["nmap"].(*StringMap).Set("b", 20)
+		dst["slice"].([]interface{})[0].(map[string]interface{})["c"] = 30
+		dst["strs"].([]string)[0] = "x"
+		dst["nstrs"].(*StringSlice).Set(0, "y")
+		array := dst["array"].([1][]int)
+		array[0][0] = 5
+		dst["object"].(*Object).MG()["f"] = 40
+
+		assert.Equal(t, map[string]interface{}{"a": 1}, src["map"])
+		assert.Equal(t, M(map[string]interface{}{"b": 2}), src["nmap"])
+		assert.Equal(t, []interface{}{map[string]interface{}{"c": 3}}, src["slice"])
+		assert.Equal(t, []string{"d"}, src["strs"])
+		assert.Equal(t, NewStringSliceV("e"), src["nstrs"])
+		assert.Equal(t, [1][]int{{1}}, src["array"])
+		assert.Equal(t, Obj(map[string]interface{}{"f": 4}), src["object"])
+	}
+
+	// typed containers of pointers
+	{
+		src := map[string]*StringMap{"a": M(map[string]interface{}{"b": 1})}
+		dst := DeepCopy(src).(map[string]*StringMap)
+		dst["a"].Set("b", 2)
+		assert.Equal(t, 1, src["a"].Get("b").O())
+	}
+
+	// objects
+	{
+		src := Object{[]interface{}{1}}
+		dst := DeepCopy(src).(Object)
+		dst.o.([]interface{})[0] = 2
+		assert.Equal(t, []interface{}{1}, src.o)
+	}
+
+	// other pointers are shared
+	{
+		i := 1
+		assert.Equal(t, &i, DeepCopy(&i))
+		assert.True(t, &i == DeepCopy(&i))
+	}
+}
+
+// DeepEqual
+//--------------------------------------------------------------------------------------------------
+func ExampleDeepEqual() {
+	var decoded map[string]interface{}
+	json.Unmarshal([]byte(`{"port": 80, "hosts": ["a"]}`), &decoded)
+	expected := map[string]interface{}{"port": 80, "hosts": []string{"a"}}
+	fmt.Println(DeepEqual(decoded, expected), DeepEqual(decoded, expected, CoerceOpt(true)))
+	// Output: false true
+}
+
+func TestDeepEqual(t *testing.T) {
+
+	// nil
+	{
+		assert.True(t, DeepEqual(nil, nil))
+		assert.True(t, DeepEqual(nil, (*StringMap)(nil)))
+		assert.True(t, DeepEqual((*Object)(nil), Obj(nil)))
+		assert.False(t, DeepEqual(nil, 0))
+		assert.False(t, DeepEqual(NewStringMapV(), nil))
+		assert.True(t, DeepEqual(NewStringMapV(), map[string]interface{}(nil)))
+	}
+
+	// scalars
+	{
+		assert.True(t, DeepEqual(1, 1))
+		assert.False(t, DeepEqual(1, 2))
+		assert.False(t, DeepEqual(1, int64(1)))
+		assert.False(t, DeepEqual(1, float64(1)))
+		assert.True(t, DeepEqual("a", A("a")))
+		assert.True(t, DeepEqual(Obj("a"), "a"))
+		assert.True(t, DeepEqual(time.Unix(0, 0), time.Unix(0, 0)))
+	}
+
+	// numeric coercion
+	{
+		assert.True(t, DeepEqual(1, int64(1), CoerceOpt(true)))
+		assert.True(t, DeepEqual(1, float64(1), CoerceOpt(true)))
+		assert.True(t, DeepEqual(uint8(1), float32(1), CoerceOpt(true)))
+		assert.True(t, DeepEqual(1.5, float32(1.5), CoerceOpt(true)))
+		assert.True(t, DeepEqual(uint64(1e19), float64(1e19), CoerceOpt(true)))
+		assert.False(t, DeepEqual(1, 1.5, CoerceOpt(true)))
+		assert.False(t, DeepEqual(-1, uint(1), CoerceOpt(true)))
+		assert.False(t, DeepEqual(1, "1", CoerceOpt(true)))
+	}
+
+	// maps
+	{
+		a := map[string]interface{}{"a": map[string]interface{}{"b": 1}}
+		assert.True(t, DeepEqual(a, map[string]interface{}{"a": map[string]interface{}{"b": 1}}))
+		assert.True(t, DeepEqual(a, M(map[string]interface{}{"a": M(map[string]interface{}{"b": 1})})))
+		assert.True(t, DeepEqual(a, map[interface{}]interface{}{"a": map[string]int{"b": 1}}))
+		assert.False(t, DeepEqual(a, map[string]interface{}{"a": map[string]interface{}{"b": 2}}))
+		assert.False(t, DeepEqual(a, map[string]interface{}{"b": map[string]interface{}{"b": 1}}))
+		assert.False(t, DeepEqual(a, map[string]interface{}{"a": 1, "b": 2}))
+		assert.False(t, DeepEqual(a, []interface{}{1}))
+	}
+
+	// slices
+	{
+		assert.True(t, DeepEqual([]interface{}{1, "a"}, NewInterSliceV(1, "a")))
+		assert.True(t, DeepEqual([]string{"a"}, [1]string{"a"}))
+		assert.True(t, DeepEqual(NewStringSliceV("a"), []interface{}{"a"}))
+		assert.False(t, DeepEqual([]interface{}{1, "a"}, []interface{}{"a", 1}))
+		assert.False(t, DeepEqual([]int{1}, []int{1, 2}))
+		assert.True(t, DeepEqual([]interface{}{1.0}, []int{1}, CoerceOpt(true)))
+	}
+}
+
+// Hash
+//--------------------------------------------------------------------------------------------------
+func ExampleHash() {
+	a := map[string]interface{}{"name": "n", "tags": []string{"go"}}
+	b := M(map[string]interface{}{"tags": []string{"go"}, "name": "n"})
+	fmt.Println(Hash(a) == Hash(b))
+	// Output: true
+}
+
+func TestHash(t *testing.T) {
+	assert.Equal(t, 64, len(Hash(nil)))
+	assert.Equal(t, Hash(nil), Hash((*StringMap)(nil)))
+	assert.Equal(t, Hash(1), Hash(1))
+	assert.Equal(t, Hash("a"), Hash(A("a")))
+	assert.NotEqual(t, Hash(1), Hash("1"))
+	assert.NotEqual(t, Hash(1), Hash(int64(1)))
+	assert.NotEqual(t, Hash([]int{1, 2}), Hash([]int{2, 1}))
+	assert.NotEqual(t, Hash([]interface{}{[]int{1}, 2}), Hash([]interface{}{1, []int{2}}))
+	assert.NotEqual(t, Hash(map[string]interface{}{"a": "b"}), Hash(map[string]interface{}{"a:b": nil}))
+
+	// content equality
+	{
+		a := map[string]interface{}{"a": []interface{}{1, map[string]interface{}{"b": true}}}
+		b := M(map[string]interface{}{"a": NewInterSliceV(1, M(map[string]interface{}{"b": true}))})
+		assert.Equal(t, Hash(a), Hash(b))
+		assert.Equal(t, Hash(a), b.Hash())
+		assert.Equal(t, Hash(time.Unix(0, 0)), Hash(time.Unix(0, 0).UTC()))
+	}
+
+	// numeric coercion
+	{
+		assert.Equal(t, Hash(1, CoerceOpt(true)), Hash(float64(1), CoerceOpt(true)))
+		assert.Equal(t, Hash([]int{1}, CoerceOpt(true)), Hash([]interface{}{uint8(1)}, CoerceOpt(true)))
+		assert.NotEqual(t, Hash(1, CoerceOpt(true)), Hash(1.5, CoerceOpt(true)))
+		assert.NotEqual(t, Hash(1, CoerceOpt(true)), Hash("1", CoerceOpt(true)))
+	}
+}
+
+// StringMap DeepCopy
+//--------------------------------------------------------------------------------------------------
+func TestStringMap_DeepCopy(t *testing.T) {
+	assert.Equal(t, NewStringMapV(), (*StringMap)(nil).DeepCopy())
+
+	// merged layers no longer share nested maps
+	{
+		base := M(map[string]interface{}{"server": map[string]interface{}{"port": 80}})
+		layer := base.DeepCopy()
+		layer.Merge(M(map[string]interface{}{"server": map[string]interface{}{"port": 8080}}))
+		assert.Equal(t, 80, base.Query("server.port").O())
+		assert.Equal(t, 8080, layer.Query("server.port").O())
+
+		shallow := base.Copy().(*StringMap)
+		shallow.Query("server").MG()["port"] = 90
+		assert.Equal(t, 90, base.Query("server.port").O())
+	}
+}
+
+// StringMap DeepEqual
+//--------------------------------------------------------------------------------------------------
+func TestStringMap_DeepEqual(t *testing.T) {
+	assert.True(t, (*StringMap)(nil).DeepEqual(nil))
+	assert.True(t, M(map[string]interface{}{"a": 1}).DeepEqual(map[string]interface{}{"a": 1}))
+	assert.False(t, M(map[string]interface{}{"a": 1}).DeepEqual(map[string]interface{}{"a": 1.0}))
+	assert.True(t, M(map[string]interface{}{"a": 1}).DeepEqual(map[string]interface{}{"a": 1.0}, CoerceOpt(true)))
+}
+
+// StringMap Hash
+//--------------------------------------------------------------------------------------------------
+func TestStringMap_Hash(t *testing.T) {
+	assert.Equal(t, Hash(nil), (*StringMap)(nil).Hash())
+	assert.Equal(t, Hash(map[string]interface{}{"a": 1}), M(map[string]interface{}{"a": 1}).Hash())
+	assert.Equal(t, M(map[string]interface{}{"a": 1}).Hash(CoerceOpt(true)), M(map[string]interface{}{"a": 1.0}).Hash(CoerceOpt(true)))
+}
+
+// MapSlice DeepCopy
+//--------------------------------------------------------------------------------------------------
+func TestMapSlice_DeepCopy(t *testing.T) {
+	assert.Equal(t, NewMapSliceV(), (*MapSlice)(nil).DeepCopy())
+
+	src := NewMapSliceV(map[string]interface{}{"a": map[string]interface{}{"b": 1}})
+	dst := src.DeepCopy()
+	assert.Equal(t, src, dst)
+	(*dst)[0]["a"].(map[string]interface{})["b"] = 2
+	assert.Equal(t, NewMapSliceV(map[string]interface{}{"a": map[string]interface{}{"b": 1}}), src)
+}
+
+// MapSlice DeepEqual
+//--------------------------------------------------------------------------------------------------
+func TestMapSlice_DeepEqual(t *testing.T) {
+	assert.True(t, (*MapSlice)(nil).DeepEqual(nil))
+	assert.True(t, NewMapSliceV(map[string]interface{}{"a": 1}).DeepEqual([]interface{}{M(map[string]interface{}{"a": 1})}))
+	assert.False(t, NewMapSliceV(map[string]interface{}{"a": 1}).DeepEqual([]interface{}{}))
+}
+
+// MapSlice Hash
+//--------------------------------------------------------------------------------------------------
+func TestMapSlice_Hash(t *testing.T) {
+	assert.Equal(t, Hash([]interface{}{map[string]interface{}{"a": 1}}), NewMapSliceV(map[string]interface{}{"a": 1}).Hash())
+}
+
+// InterSlice DeepCopy
+//--------------------------------------------------------------------------------------------------
+func TestInterSlice_DeepCopy(t *testing.T) {
+	assert.Equal(t, NewInterSliceV(), (*InterSlice)(nil).DeepCopy())
+
+	src := NewInterSliceV([]int{1}, map[string]interface{}{"a": 1})
+	dst := src.DeepCopy()
+	assert.Equal(t, src, dst)
+	(*dst)[0].([]int)[0] = 2
+	(*dst)[1].(map[string]interface{})["a"] = 2
+	assert.Equal(t, NewInterSliceV([]int{1}, map[string]interface{}{"a": 1}), src)
+}
+
+// InterSlice DeepEqual
+//--------------------------------------------------------------------------------------------------
+func TestInterSlice_DeepEqual(t *testing.T) {
+	assert.True(t, (*InterSlice)(nil).DeepEqual(nil))
+	assert.True(t, NewInterSliceV(1, "a").DeepEqual([]interface{}{1, "a"}))
+	assert.True(t, NewInterSliceV(1, 2).DeepEqual([]float64{1, 2}, CoerceOpt(true)))
+}
+
+// InterSlice Hash
+//--------------------------------------------------------------------------------------------------
+func TestInterSlice_Hash(t *testing.T) {
+	assert.Equal(t, Hash([]int{1, 2}, CoerceOpt(true)), NewInterSliceV(1, 2).Hash(CoerceOpt(true)))
+	assert.NotEqual(t, Hash([]int{1, 2}), NewInterSliceV(2, 1).Hash())
+}
+
+// Object DeepCopy
+//--------------------------------------------------------------------------------------------------
+func TestObject_DeepCopy(t *testing.T) {
+	assert.Equal(t, &Object{}, (*Object)(nil).DeepCopy())
+
+	src := Obj(map[string]interface{}{"a": []interface{}{1}})
+	dst := src.DeepCopy()
+	assert.Equal(t, src, dst)
+	dst.MG()["a"].([]interface{})[0] = 2
+	assert.Equal(t, Obj(map[string]interface{}{"a": []interface{}{1}}), src)
+}
+
+// Object DeepEqual
+//--------------------------------------------------------------------------------------------------
+func TestObject_DeepEqual(t *testing.T) {
+	assert.True(t, (*Object)(nil).DeepEqual(nil))
+	assert.True(t, Obj([]int{1}).DeepEqual(Obj([]interface{}{1})))
+	assert.False(t, Obj(1).DeepEqual(1.0))
+	assert.True(t, Obj(1).DeepEqual(1.0, CoerceOpt(true)))
+}
+
+// Object Hash
+//--------------------------------------------------------------------------------------------------
+func TestObject_Hash(t *testing.T) {
+	assert.Equal(t, Hash(nil), (*Object)(nil).Hash())
+	assert.Equal(t, Hash("a"), Obj("a").Hash())
+}
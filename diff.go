@@ -0,0 +1,373 @@
+package n
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EditOp is the kind of operation of a single Edit in an EditScript
+type EditOp int
+
+const (
+	// EditKeep keeps the element from the first Slice as is
+	EditKeep EditOp = iota
+
+	// EditDelete deletes the element from the first Slice
+	EditDelete
+
+	// EditInsert inserts the element from the second Slice
+	EditInsert
+)
+
+// String returns the symbol of the EditOp as used in a diff i.e. " ", "-" or "+"
+func (p EditOp) String() string {
+	switch p {
+	case EditDelete:
+		return "-"
+	case EditInsert:
+		return "+"
+	}
+	return " "
+}
+
+// Edit is a single operation of an EditScript. A is the index of the element in the first Slice
+// or -1 for inserts and B is the index of the element in the second Slice or -1 for deletes.
+type Edit struct {
+	Op   EditOp
+	A    int
+	B    int
+	Elem interface{}
+}
+
+// EditScript is the shortest sequence of edits transforming one Slice into another as computed
+// by Diff and DiffBy.
+type EditScript struct {
+	Edits []Edit
+	a, b  ISlice
+}
+
+// Diff computes the shortest edit script transforming Slice a into Slice b using the Myers
+// diff algorithm. Elements are compared by type and value so deep types like maps are supported.
+func Diff(a, b ISlice) (script *EditScript) {
+	return DiffBy(a, b, nil)
+}
+
+// DiffBy computes the shortest edit script transforming Slice a into Slice b using the Myers diff
+// algorithm comparing the elements by the key returned from the given lambda. This allows for
+// elements to be matched by identity e.g. the name of a MapSlice entry rather than its full value.
+func DiffBy(a, b ISlice, key func(O) O) (script *EditScript) {
+	if a == nil {
+		a = NewInterSliceV()
+	}
+	if b == nil {
+		b = NewInterSliceV()
+	}
+	script = &EditScript{a: a, b: b}
+	x, y := diffKeys(a, key), diffKeys(b, key)
+
+	// Trim the common prefix and suffix to reduce the work of the diff
+	start, endX, endY := 0, len(x), len(y)
+	for start < endX && start < endY && x[start] == y[start] {
+		start++
+	}
+	for endX > start && endY > start && x[endX-1] == y[endY-1] {
+		endX--
+		endY--
+	}
+
+	for i := 0; i < start; i++ {
+		script.Edits = append(script.Edits, Edit{Op: EditKeep, A: i, B: i, Elem: a.At(i).O()})
+	}
+	for _, edit := range myers(x[start:endX], y[start:endY]) {
+		if edit.A != -1 {
+			edit.A += start
+		}
+		if edit.B != -1 {
+			edit.B += start
+		}
+		if edit.Op == EditInsert {
+			edit.Elem = b.At(edit.B).O()
+		} else {
+			edit.Elem = a.At(edit.A).O()
+		}
+		script.Edits = append(script.Edits, edit)
+	}
+	for i := endX; i < len(x); i++ {
+		script.Edits = append(script.Edits, Edit{Op: EditKeep, A: i, B: endY + i - endX, Elem: a.At(i).O()})
+	}
+	return
+}
+
+// Added returns the elements inserted from the second Slice as a new Slice of its type.
+func (p *EditScript) Added() (new ISlice) {
+	return p.elems(EditInsert)
+}
+
+// Changed returns true if the edit script contains any inserts or deletes.
+func (p *EditScript) Changed() bool {
+	if p == nil {
+		return false
+	}
+	for i := range p.Edits {
+		if p.Edits[i].Op != EditKeep {
+			return true
+		}
+	}
+	return false
+}
+
+// Len returns the number of edits in this EditScript
+func (p *EditScript) Len() int {
+	if p == nil {
+		return 0
+	}
+	return len(p.Edits)
+}
+
+// Removed returns the elements deleted from the first Slice as a new Slice of its type.
+func (p *EditScript) Removed() (new ISlice) {
+	return p.elems(EditDelete)
+}
+
+// String returns a string representation of this EditScript with one line per edit prefixed by
+// the edit's operation symbol, implements the Stringer interface
+func (p *EditScript) String() string {
+	if p == nil {
+		return ""
+	}
+	var builder strings.Builder
+	for i := range p.Edits {
+		builder.WriteString(fmt.Sprintf("%s%v\n", p.Edits[i].Op, ToString(p.Edits[i].Elem)))
+	}
+	return builder.String()
+}
+
+// elems returns the elements of the edits with the given operation as a new Slice of the type
+// of the Slice the elements came from.
+func (p *EditScript) elems(op EditOp) (new ISlice) {
+	if p == nil {
+		return NewInterSliceV()
+	}
+	src := p.a
+	if op == EditInsert {
+		src = p.b
+	}
+	new = src.Copy().Clear()
+	for i := range p.Edits {
+		if p.Edits[i].Op == op {
+			new.Append(p.Edits[i].Elem)
+		}
+	}
+	return
+}
+
+// UnifiedDiff renders the differences between this Slice and the given Slice as lines in the
+// unified diff format with the given number of lines of context around each hunk. The from and
+// to names are used for the file headers. An empty Str is returned if there are no differences.
+func (p *StringSlice) UnifiedDiff(slice interface{}, from, to string, context int) (diff *Str) {
+	diff = A("")
+	if context < 0 {
+		context = 0
+	}
+	script := Diff(p, ToStringSlice(slice))
+	if !script.Changed() {
+		return
+	}
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("--- %s\n+++ %s\n", from, to))
+	edits := script.Edits
+	for i := 0; i < len(edits); {
+
+		// Find the start of the next hunk
+		if edits[i].Op == EditKeep {
+			i++
+			continue
+		}
+		start := i - context
+		if start < 0 {
+			start = 0
+		}
+
+		// Extend the hunk while changes are within twice the context of each other
+		end, keeps := i, 0
+		for j := i; j < len(edits); j++ {
+			if edits[j].Op != EditKeep {
+				end, keeps = j+1, 0
+			} else if keeps++; keeps > 2*context {
+				break
+			}
+		}
+		stop := end + context
+		if stop > len(edits) {
+			stop = len(edits)
+		}
+
+		// Write out the hunk
+		startA, startB, lenA, lenB := unifiedStart(edits, start)
+		for j := start; j < stop; j++ {
+			if edits[j].Op != EditInsert {
+				lenA++
+			}
+			if edits[j].Op != EditDelete {
+				lenB++
+			}
+		}
+		builder.WriteString(fmt.Sprintf("@@ -%s +%s @@\n", unifiedRange(startA, lenA), unifiedRange(startB, lenB)))
+		for j := start; j < stop; j++ {
+			builder.WriteString(fmt.Sprintf("%s%s\n", edits[j].Op, edits[j].Elem))
+		}
+		i = stop
+	}
+	return A(builder.String())
+}
+
+// diffKeys returns the comparison keys for the elements of the given Slice
+func diffKeys(slice ISlice, key func(O) O) (keys []string) {
+	keys = make([]string, 0, slice.Len())
+	slice.Each(func(x O) {
+		if key != nil {
+			x = key(x)
+		}
+		keys = append(keys, setHash(x))
+	})
+	return
+}
+
+// myers computes the shortest edit script transforming x into y using the linear space variant of
+// the Myers O(ND) algorithm returning the edits with indices relative to x and y. Rather than
+// tracing every step the middle snake of the edit path is found from both ends and the two halves
+// either side of it are diffed recursively so memory stays O(N+M).
+func myers(x, y []string) (edits []Edit) {
+	size := 2*((len(x)+len(y)+1)/2) + 3
+	d := &myersDiff{x: x, y: y, vf: make([]int, size), vb: make([]int, size)}
+	d.diff(0, len(x), 0, len(y))
+	return d.edits
+}
+
+// myersDiff tracks the state of a linear space Myers diff
+type myersDiff struct {
+	x, y   []string // the keys being diffed
+	vf, vb []int    // furthest reaching forward and backward paths by diagonal
+	edits  []Edit   // edits in order
+}
+
+// diff appends the edits transforming x[i:n] into y[j:m]
+func (p *myersDiff) diff(i, n, j, m int) {
+
+	// Common prefix and suffix are kept as is
+	for i < n && j < m && p.x[i] == p.y[j] {
+		p.edits = append(p.edits, Edit{Op: EditKeep, A: i, B: j})
+		i++
+		j++
+	}
+	suffix := 0
+	for n-suffix > i && m-suffix > j && p.x[n-suffix-1] == p.y[m-suffix-1] {
+		suffix++
+	}
+	n, m = n-suffix, m-suffix
+
+	switch {
+	case i == n:
+		for ; j < m; j++ {
+			p.edits = append(p.edits, Edit{Op: EditInsert, A: -1, B: j})
+		}
+	case j == m:
+		for ; i < n; i++ {
+			p.edits = append(p.edits, Edit{Op: EditDelete, A: i, B: -1})
+		}
+	default:
+		xs, ys, xe, ye := p.middleSnake(i, n, j, m)
+		p.diff(i, xs, j, ys)
+		for ; xs < xe; xs, ys = xs+1, ys+1 {
+			p.edits = append(p.edits, Edit{Op: EditKeep, A: xs, B: ys})
+		}
+		p.diff(xe, n, ye, m)
+	}
+
+	for k := 0; k < suffix; k++ {
+		p.edits = append(p.edits, Edit{Op: EditKeep, A: n + k, B: m + k})
+	}
+}
+
+// middleSnake finds the middle snake of the shortest edit path transforming x[i0:n0] into
+// y[j0:m0] by searching forward from the start and backward from the end until the paths overlap
+// returning the start and end of the snake.
+func (p *myersDiff) middleSnake(i0, n0, j0, m0 int) (xs, ys, xe, ye int) {
+	x, y := p.x[i0:n0], p.y[j0:m0]
+	n, m := len(x), len(y)
+	delta := n - m
+	odd := delta&1 != 0
+	max := (n + m + 1) / 2
+	offset := max + 1
+	p.vf[offset+1], p.vb[offset+1] = 0, 0
+
+	for d := 0; d <= max; d++ {
+
+		// Forward paths overlapping the backward paths of the previous step
+		for k := -d; k <= d; k += 2 {
+			var i int
+			if k == -d || (k != d && p.vf[offset+k-1] < p.vf[offset+k+1]) {
+				i = p.vf[offset+k+1]
+			} else {
+				i = p.vf[offset+k-1] + 1
+			}
+			j := i - k
+			si, sj := i, j
+			for i < n && j < m && x[i] == y[j] {
+				i++
+				j++
+			}
+			p.vf[offset+k] = i
+			if odd && k >= delta-(d-1) && k <= delta+(d-1) && i+p.vb[offset+delta-k] >= n {
+				return i0 + si, j0 + sj, i0 + i, j0 + j
+			}
+		}
+
+		// Backward paths, indexed from the end, overlapping the forward paths of this step
+		for k := -d; k <= d; k += 2 {
+			var i int
+			if k == -d || (k != d && p.vb[offset+k-1] < p.vb[offset+k+1]) {
+				i = p.vb[offset+k+1]
+			} else {
+				i = p.vb[offset+k-1] + 1
+			}
+			j := i - k
+			si, sj := i, j
+			for i < n && j < m && x[n-i-1] == y[m-j-1] {
+				i++
+				j++
+			}
+			p.vb[offset+k] = i
+			if !odd && delta-k >= -d && delta-k <= d && i+p.vf[offset+delta-k] >= n {
+				return i0 + n - i, j0 + m - j, i0 + n - si, j0 + m - sj
+			}
+		}
+	}
+	panic("unreachable: myers paths must overlap")
+}
+
+// unifiedStart returns the zero based line numbers in the first and second Slice at the given
+// edit along with zeroed lengths.
+func unifiedStart(edits []Edit, start int) (a, b, lenA, lenB int) {
+	for i := 0; i < start; i++ {
+		if edits[i].Op != EditInsert {
+			a++
+		}
+		if edits[i].Op != EditDelete {
+			b++
+		}
+	}
+	return
+}
+
+// unifiedRange formats the given zero based start line and length as a unified diff range
+func unifiedRange(start, length int) string {
+	switch length {
+	case 0:
+		return fmt.Sprintf("%d,0", start)
+	case 1:
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, length)
+}
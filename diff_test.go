@@ -0,0 +1,280 @@
+package n
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Diff
+//--------------------------------------------------------------------------------------------------
+func ExampleDiff() {
+	script := Diff(NewStringSliceV("go", "vim", "git"), NewStringSliceV("go", "git", "curl"))
+	fmt.Println(script.Removed(), script.Added())
+	// Output: [vim] [curl]
+}
+
+func TestDiff(t *testing.T) {
+
+	// nil and empty
+	{
+		assert.Equal(t, 0, Diff(nil, nil).Len())
+		assert.False(t, Diff(nil, nil).Changed())
+		assert.Equal(t, 0, Diff(NewIntSliceV(), (*IntSlice)(nil)).Len())
+		assert.Equal(t, []Edit{{Op: EditInsert, A: -1, B: 0, Elem: 1}}, Diff(nil, NewIntSliceV(1)).Edits)
+		assert.Equal(t, []Edit{{Op: EditDelete, A: 0, B: -1, Elem: 1}}, Diff(NewIntSliceV(1), nil).Edits)
+	}
+
+	// equal
+	{
+		script := Diff(NewIntSliceV(1, 2, 3), NewIntSliceV(1, 2, 3))
+		assert.False(t, script.Changed())
+		assert.Equal(t, []Edit{
+			{Op: EditKeep, A: 0, B: 0, Elem: 1},
+			{Op: EditKeep, A: 1, B: 1, Elem: 2},
+			{Op: EditKeep, A: 2, B: 2, Elem: 3},
+		}, script.Edits)
+	}
+
+	// insert, delete and keep
+	{
+		script := Diff(NewStringSliceV("a", "b", "c", "d"), NewStringSliceV("a", "c", "e", "d"))
+		assert.True(t, script.Changed())
+		assert.Equal(t, []Edit{
+			{Op: EditKeep, A: 0, B: 0, Elem: "a"},
+			{Op: EditDelete, A: 1, B: -1, Elem: "b"},
+			{Op: EditKeep, A: 2, B: 1, Elem: "c"},
+			{Op: EditInsert, A: -1, B: 2, Elem: "e"},
+			{Op: EditKeep, A: 3, B: 3, Elem: "d"},
+		}, script.Edits)
+		assert.Equal(t, " a\n-b\n c\n+e\n d\n", script.String())
+	}
+
+	// classic myers example
+	{
+		script := Diff(NewStringSliceV("A", "B", "C", "A", "B", "B", "A"), NewStringSliceV("C", "B", "A", "B", "A", "C"))
+		assert.Equal(t, "-A\n+C\n B\n-C\n A\n B\n-B\n A\n+C\n", script.String())
+	}
+
+	// memory is linear in the input size
+	{
+		a, b := make([]string, 4000), make([]string, 4000)
+		for i := range a {
+			a[i], b[i] = fmt.Sprint("a", i), fmt.Sprint("b", i)
+		}
+		var before, after runtime.MemStats
+		runtime.ReadMemStats(&before)
+		script := Diff(NewStringSlice(a), NewStringSlice(b))
+		runtime.ReadMemStats(&after)
+		assert.Equal(t, 8000, script.Len())
+		assert.True(t, after.TotalAlloc-before.TotalAlloc < 32<<20, after.TotalAlloc-before.TotalAlloc)
+	}
+
+	// types are retained
+	{
+		script := Diff(NewIntSliceV(1, 2, 3), NewIntSliceV(2, 3, 4))
+		assert.Equal(t, NewIntSliceV(1), script.Removed())
+		assert.Equal(t, NewIntSliceV(4), script.Added())
+
+		script = Diff(NewStringSliceV("a b", "c"), NewStringSliceV("c", "d e"))
+		assert.Equal(t, NewStringSliceV("a b"), script.Removed())
+		assert.Equal(t, NewStringSliceV("d e"), script.Added())
+	}
+
+	// deep values
+	{
+		a := NewMapSliceV(map[string]interface{}{"name": "a"}, map[string]interface{}{"name": "b"})
+		b := NewMapSliceV(map[string]interface{}{"name": "b"})
+		script := Diff(a, b)
+		assert.Equal(t, []Edit{
+			{Op: EditDelete, A: 0, B: -1, Elem: map[string]interface{}{"name": "a"}},
+			{Op: EditKeep, A: 1, B: 0, Elem: map[string]interface{}{"name": "b"}},
+		}, script.Edits)
+		assert.Equal(t, 1, script.Removed().Len())
+		assert.Equal(t, 0, script.Added().Len())
+	}
+
+	// edit scripts are minimal and transform a into b
+	{
+		r := rand.New(rand.NewSource(1))
+		for n := 0; n < 200; n++ {
+			a, b := []int{}, []int{}
+			for i := r.Intn(12); i > 0; i-- {
+				a = append(a, r.Intn(4))
+			}
+			for i := r.Intn(12); i > 0; i-- {
+				b = append(b, r.Intn(4))
+			}
+			script := Diff(NewIntSlice(a), NewIntSlice(b))
+
+			result, changes := []int{}, 0
+			for _, edit := range script.Edits {
+				switch edit.Op {
+				case EditKeep:
+					assert.Equal(t, a[edit.A], b[edit.B])
+					result = append(result, a[edit.A])
+				case EditInsert:
+					result = append(result, b[edit.B])
+					changes++
+				case EditDelete:
+					changes++
+				}
+			}
+			assert.Equal(t, b, result)
+			assert.Equal(t, len(a)+len(b)-2*lcsLen(a, b), changes)
+		}
+	}
+}
+
+func BenchmarkDiff(b *testing.B) {
+	x, y := make([]string, 4000), make([]string, 4000)
+	for i := range x {
+		x[i], y[i] = fmt.Sprint(i), fmt.Sprint(i)
+		if i%10 == 0 {
+			y[i] = "changed"
+		}
+	}
+	a, c := NewStringSlice(x), NewStringSlice(y)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Diff(a, c)
+	}
+}
+
+// lcsLen returns the length of the longest common subsequence of the given slices
+func lcsLen(a, b []int) int {
+	dp := make([][]int, len(a)+1)
+	for i := range dp {
+		dp[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				dp[i][j] = dp[i+1][j+1] + 1
+			} else if dp[i+1][j] > dp[i][j+1] {
+				dp[i][j] = dp[i+1][j]
+			} else {
+				dp[i][j] = dp[i][j+1]
+			}
+		}
+	}
+	return dp[0][0]
+}
+
+// DiffBy
+//--------------------------------------------------------------------------------------------------
+func ExampleDiffBy() {
+	a := NewMapSliceV(map[string]interface{}{"name": "vim", "ver": 1}, map[string]interface{}{"name": "git", "ver": 1})
+	b := NewMapSliceV(map[string]interface{}{"name": "vim", "ver": 2}, map[string]interface{}{"name": "go", "ver": 1})
+	script := DiffBy(a, b, func(x O) O { return x.(map[string]interface{})["name"] })
+	for _, edit := range script.Edits {
+		fmt.Println(edit.Op, edit.Elem.(map[string]interface{})["name"])
+	}
+	// Output:
+	//   vim
+	// - git
+	// + go
+}
+
+func TestDiffBy(t *testing.T) {
+
+	// nil key is the same as Diff
+	{
+		assert.Equal(t, Diff(NewIntSliceV(1, 2), NewIntSliceV(2)), DiffBy(NewIntSliceV(1, 2), NewIntSliceV(2), nil))
+	}
+
+	// identity by key
+	{
+		a := NewMapSliceV(map[string]interface{}{"name": "a", "ver": 1})
+		b := NewMapSliceV(map[string]interface{}{"name": "a", "ver": 2})
+		assert.True(t, Diff(a, b).Changed())
+		script := DiffBy(a, b, func(x O) O { return x.(map[string]interface{})["name"] })
+		assert.False(t, script.Changed())
+		assert.Equal(t, Edit{Op: EditKeep, A: 0, B: 0, Elem: map[string]interface{}{"name": "a", "ver": 1}}, script.Edits[0])
+		assert.Equal(t, map[string]interface{}{"name": "a", "ver": 2}, b.At(script.Edits[0].B).O())
+	}
+
+	// case insensitive
+	{
+		script := DiffBy(NewStringSliceV("A", "b"), NewStringSliceV("a", "B", "c"), func(x O) O { return ToStr(x).ToLower().A() })
+		assert.Equal(t, " A\n b\n+c\n", script.String())
+	}
+}
+
+// EditOp
+//--------------------------------------------------------------------------------------------------
+func TestEditOp_String(t *testing.T) {
+	assert.Equal(t, " ", EditKeep.String())
+	assert.Equal(t, "-", EditDelete.String())
+	assert.Equal(t, "+", EditInsert.String())
+}
+
+// EditScript
+//--------------------------------------------------------------------------------------------------
+func TestEditScript(t *testing.T) {
+	var script *EditScript
+	assert.Equal(t, 0, script.Len())
+	assert.False(t, script.Changed())
+	assert.Equal(t, "", script.String())
+	assert.Equal(t, NewInterSliceV(), script.Added())
+	assert.Equal(t, NewInterSliceV(), script.Removed())
+}
+
+// UnifiedDiff
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_UnifiedDiff() {
+	a := NewStringSliceV("[user]", "name = foo", "email = foo@bar.com", "[core]", "editor = vim")
+	b := NewStringSliceV("[user]", "name = bar", "email = foo@bar.com", "[core]", "editor = vim")
+	fmt.Print(a.UnifiedDiff(b, "a/.gitconfig", "b/.gitconfig", 1))
+	// Output:
+	// --- a/.gitconfig
+	// +++ b/.gitconfig
+	// @@ -1,3 +1,3 @@
+	//  [user]
+	// -name = foo
+	// +name = bar
+	//  email = foo@bar.com
+}
+
+func TestStringSlice_UnifiedDiff(t *testing.T) {
+
+	// nil or equal
+	{
+		var slice *StringSlice
+		assert.Equal(t, A(""), slice.UnifiedDiff(nil, "a", "b", 3))
+		assert.Equal(t, A(""), NewStringSliceV("1", "2").UnifiedDiff([]string{"1", "2"}, "a", "b", 3))
+	}
+
+	// from empty
+	{
+		assert.Equal(t, "--- a\n+++ b\n@@ -0,0 +1,2 @@\n+1\n+2\n", NewStringSliceV().UnifiedDiff([]string{"1", "2"}, "a", "b", 3).A())
+	}
+
+	// to empty
+	{
+		assert.Equal(t, "--- a\n+++ b\n@@ -1 +0,0 @@\n-1\n", NewStringSliceV("1").UnifiedDiff(nil, "a", "b", 3).A())
+	}
+
+	// negative context
+	{
+		diff := NewStringSliceV("1", "2", "3").UnifiedDiff([]string{"1", "x", "3"}, "a", "b", -1)
+		assert.Equal(t, "--- a\n+++ b\n@@ -2 +2 @@\n-2\n+x\n", diff.A())
+	}
+
+	// separate hunks
+	{
+		a := NewStringSliceV("1", "2", "3", "4", "5", "6", "7", "8", "9")
+		diff := a.UnifiedDiff([]string{"x", "2", "3", "4", "5", "6", "7", "8", "9", "10"}, "a", "b", 1)
+		assert.Equal(t, "--- a\n+++ b\n@@ -1,2 +1,2 @@\n-1\n+x\n 2\n@@ -9 +9,2 @@\n 9\n+10\n", diff.A())
+	}
+
+	// merged hunks when within twice the context
+	{
+		a := NewStringSliceV("1", "2", "3", "4", "5", "6")
+		diff := a.UnifiedDiff([]string{"x", "2", "3", "y", "5", "6"}, "a", "b", 1)
+		assert.Equal(t, "--- a\n+++ b\n@@ -1,5 +1,5 @@\n-1\n+x\n 2\n 3\n-4\n+y\n 5\n", diff.A())
+	}
+}
@@ -0,0 +1,604 @@
+package n
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/phR0ze/n/pkg/jq"
+	"github.com/phR0ze/n/pkg/sys"
+	"github.com/pkg/errors"
+	yaml3 "gopkg.in/yaml.v3"
+)
+
+// YAMLDoc provides round trip editing of YAML documents preserving comments, key order, anchors,
+// aliases and quoting styles. Values are queried and edited using the same jq type selectors as
+// StringMap.Query, Inject and Remove and written back out with only the edited values changed,
+// which makes it safe to use on hand commented files. Editing through an alias edits the anchored
+// value it refers to. Indentation is detected from the original document while blank lines and
+// the spacing before line comments are normalized.
+type YAMLDoc struct {
+	root   *yaml3.Node // document node
+	indent int         // indentation to use when writing out
+}
+
+// NewYAMLDoc creates a new *YAMLDoc from the given YAML string or []byte, or from any other value
+// e.g. maps by converting it into a document. Returns an empty document on error.
+func NewYAMLDoc(obj interface{}) *YAMLDoc {
+	doc, err := NewYAMLDocE(obj)
+	if err != nil {
+		return NewYAMLDocV()
+	}
+	return doc
+}
+
+// NewYAMLDocE creates a new *YAMLDoc from the given YAML string or []byte, or from any other value
+// e.g. maps by converting it into a document. Only single document YAML is supported.
+func NewYAMLDocE(obj interface{}) (doc *YAMLDoc, err error) {
+	doc = NewYAMLDocV()
+
+	var data []byte
+	switch x := obj.(type) {
+	case nil:
+		return
+	case []byte:
+		data = x
+	case *[]byte:
+		if x != nil {
+			data = *x
+		}
+	case string:
+		data = []byte(x)
+	case *string:
+		if x != nil {
+			data = []byte(*x)
+		}
+	case *Str:
+		data = []byte(x.A())
+	default:
+		var node *yaml3.Node
+		if node, err = yamlDocNode(obj); err != nil {
+			return
+		}
+		doc.root.Content = []*yaml3.Node{node}
+		return
+	}
+
+	// Parse the document tree
+	decoder := yaml3.NewDecoder(bytes.NewReader(data))
+	root := &yaml3.Node{}
+	if err = decoder.Decode(root); err != nil {
+		if err == io.EOF {
+			err = nil
+		} else {
+			err = errors.Wrap(err, "failed to parse yaml document")
+		}
+		return
+	}
+	if e := decoder.Decode(&yaml3.Node{}); e != io.EOF {
+		err = errors.Errorf("failed to parse yaml document, only a single document is supported")
+		return
+	}
+	doc.root = root
+	doc.indent = yamlDocIndent(root, 0)
+	return
+}
+
+// NewYAMLDocV creates a new empty *YAMLDoc containing an empty map
+func NewYAMLDocV() *YAMLDoc {
+	return &YAMLDoc{
+		root: &yaml3.Node{
+			Kind:    yaml3.DocumentNode,
+			Content: []*yaml3.Node{{Kind: yaml3.MappingNode, Tag: "!!map"}},
+		},
+	}
+}
+
+// Inject sets the value for the given key location, using jq type selectors. Returns a reference to this document.
+func (p *YAMLDoc) Inject(key string, val interface{}) *YAMLDoc {
+	doc, _ := p.InjectE(key, val)
+	if doc == nil {
+		return p
+	}
+	return doc
+}
+
+// InjectE sets the value for the given key location, using jq type selectors. Missing maps are
+// created along the way and new keys are appended to their map. Replaced values keep their
+// comments and anchor while strings keep their quoting style. Returns a reference to this document.
+func (p *YAMLDoc) InjectE(key string, val interface{}) (doc *YAMLDoc, err error) {
+	if p == nil {
+		p = NewYAMLDocV()
+	}
+	doc = p
+
+	var keys *StringSlice
+	if keys, err = KeysFromSelector(key); err != nil {
+		return
+	}
+	var node *yaml3.Node
+	if node, err = yamlDocNode(val); err != nil {
+		return
+	}
+
+	// Replace the whole document as no keys were given
+	if !keys.Any() {
+		setYAMLDocNode(p.content(), node)
+		return
+	}
+
+	// Inject at given selector location
+	target := p.content()
+	ks := keys.G()
+	for i, key := range ks {
+
+		// All array selector is handled on previous loop
+		if key == "[]" {
+			break
+		}
+		drill := i+1 < len(ks) && ks[i+1] != "[]"
+		target = yamlDocResolve(target)
+
+		// Convert scalars into maps when drilling into them
+		if target.Kind != yaml3.MappingNode && target.Kind != yaml3.SequenceNode {
+			setYAMLDocNode(target, &yaml3.Node{Kind: yaml3.MappingNode, Tag: "!!map"})
+		}
+
+		switch target.Kind {
+
+		// Identifier Index: .foo, .foo.bar
+		case yaml3.MappingNode:
+			v, local := yamlDocKey(target, key)
+			switch {
+			case v != nil && !drill && local:
+				setYAMLDocNode(v, node)
+			case v != nil && drill:
+				target = v
+			default:
+				next := node
+				if drill {
+					next = &yaml3.Node{Kind: yaml3.MappingNode, Tag: "!!map"}
+				}
+				target.Content = append(target.Content, &yaml3.Node{Kind: yaml3.ScalarNode, Tag: "!!str", Value: key}, next)
+				target = next
+			}
+
+		// Array Index/Iterator: .[2], .[-1], .[], .[key==val]
+		case yaml3.SequenceNode:
+			var j int
+			if j, err = yamlDocIndex(target, key); err != nil {
+				doc = nil
+				return
+			}
+			if j == -1 {
+				return
+			}
+			if i+1 < len(ks) {
+				target = target.Content[j]
+			} else {
+				setYAMLDocNode(target.Content[j], node)
+			}
+		}
+	}
+	return
+}
+
+// M converts the document into a *StringMap. Returns an empty *StringMap if the document is not a map.
+func (p *YAMLDoc) M() (m *StringMap) {
+	return ToStringMap(p.O())
+}
+
+// O decodes the document into Go types e.g. map[string]interface{}, []interface{}, int, string
+func (p *YAMLDoc) O() interface{} {
+	if p == nil {
+		return map[string]interface{}{}
+	}
+	var val interface{}
+	_ = p.content().Decode(&val)
+	return val
+}
+
+// Query returns the value at the given key location, using jq type selectors. Returns empty *Object if not found.
+// see dot notation from https://stedolan.github.io/jq/manual/#Basicfilters with some caveats
+func (p *YAMLDoc) Query(key string) (val *Object) {
+	val, _ = p.QueryE(key)
+	if val == nil {
+		val = &Object{}
+	}
+	return
+}
+
+// QueryE returns the value at the given key location, using jq type selectors. Returns empty *Object if not found.
+// Values are decoded into Go types e.g. maps into map[string]interface{} following aliases and merge keys.
+// Expressions beyond simple key and index selectors are evaluated with the full jq language.
+func (p *YAMLDoc) QueryE(key string) (val *Object, err error) {
+	val = &Object{}
+	if p == nil {
+		return
+	}
+
+	// Hand off full jq expressions to the jq engine
+	if jqSelector(key) {
+		var results []interface{}
+		if results, err = jq.Eval(key, p.O()); err != nil {
+			val = nil
+			return
+		}
+		switch len(results) {
+		case 0:
+		case 1:
+			val.o = results[0]
+		default:
+			val.o = results
+		}
+		return
+	}
+
+	var keys *StringSlice
+	if keys, err = KeysFromSelector(key); err != nil {
+		return
+	}
+	target := p.content()
+	for _, key := range keys.G() {
+		target = yamlDocResolve(target)
+		switch target.Kind {
+		case yaml3.MappingNode:
+			if target, _ = yamlDocKey(target, key); target == nil {
+				return
+			}
+		case yaml3.SequenceNode:
+			var i int
+			if i, err = yamlDocIndex(target, key); err != nil || i == -1 {
+				return
+			}
+			target = target.Content[i]
+		default:
+			return
+		}
+	}
+	if err = target.Decode(&val.o); err != nil {
+		err = errors.Wrapf(err, "failed to decode value at %s", key)
+	}
+	return
+}
+
+// Remove deletes the value at the given key location, using jq type selectors. Returns a reference to this document.
+func (p *YAMLDoc) Remove(key string) *YAMLDoc {
+	doc, _ := p.RemoveE(key)
+	if doc == nil {
+		return p
+	}
+	return doc
+}
+
+// RemoveE deletes the value at the given key location along with its comments, using jq type
+// selectors. Returns a reference to this document.
+func (p *YAMLDoc) RemoveE(key string) (doc *YAMLDoc, err error) {
+	if p == nil {
+		p = NewYAMLDocV()
+	}
+	doc = p
+
+	var keys *StringSlice
+	if keys, err = KeysFromSelector(key); err != nil {
+		return
+	}
+	if err = removeYAMLDocNode(p.content(), keys.G()); err != nil {
+		doc = nil
+		return
+	}
+
+	// Aliases to removed anchors would no longer parse so the anchored content moves into them
+	yamlDocInlineAliases(p.root, map[*yaml3.Node]bool{}, map[*yaml3.Node]*yaml3.Node{})
+	return
+}
+
+// YAML converts the document into a YAML string
+func (p *YAMLDoc) YAML() (data string) {
+	data, _ = p.YAMLE()
+	return
+}
+
+// YAMLE converts the document into a YAML string
+func (p *YAMLDoc) YAMLE() (data string, err error) {
+	if p == nil {
+		p = NewYAMLDocV()
+	}
+	indent := p.indent
+	if indent == 0 {
+		indent = 2
+	}
+
+	// Merge keys would otherwise be written out with an explicit !!merge tag
+	merges := yamlDocMergeKeys(p.root, nil)
+	for _, key := range merges {
+		key.Tag = ""
+	}
+	defer func() {
+		for _, key := range merges {
+			key.Tag = "!!merge"
+		}
+	}()
+
+	buf := &bytes.Buffer{}
+	encoder := yaml3.NewEncoder(buf)
+	encoder.SetIndent(indent)
+	if err = encoder.Encode(p.root); err != nil {
+		err = errors.Wrap(err, "failed to marshal yaml document")
+		return
+	}
+	if err = encoder.Close(); err != nil {
+		err = errors.Wrap(err, "failed to marshal yaml document")
+		return
+	}
+	data = buf.String()
+	return
+}
+
+// WriteYAML converts the document into a YAML string then writes it out to disk.
+func (p *YAMLDoc) WriteYAML(filename string) (err error) {
+	var data string
+	if data, err = p.YAMLE(); err != nil {
+		return
+	}
+	if err = sys.WriteString(filename, data); err != nil {
+		err = errors.Wrapf(err, "failed to write out yaml data to file %s", filename)
+	}
+	return
+}
+
+// content returns the top level value node of the document creating an empty map if needed
+func (p *YAMLDoc) content() *yaml3.Node {
+	if len(p.root.Content) == 0 {
+		p.root.Content = []*yaml3.Node{{Kind: yaml3.MappingNode, Tag: "!!map"}}
+	}
+	return p.root.Content[0]
+}
+
+// yamlDocIndent detects the indentation used in the given document tree by comparing the column
+// of a nested map with its parent key. Returns 0 if it couldn't be detected.
+func yamlDocIndent(node *yaml3.Node, depth int) int {
+	if depth > 100 {
+		return 0
+	}
+	for i := range node.Content {
+		child := node.Content[i]
+		if node.Kind == yaml3.MappingNode && i%2 == 1 && child.Style&yaml3.FlowStyle == 0 &&
+			child.Kind == yaml3.MappingNode && len(child.Content) > 0 {
+			if indent := child.Content[0].Column - node.Content[i-1].Column; indent > 0 {
+				return indent
+			}
+		}
+		if indent := yamlDocIndent(child, depth+1); indent > 0 {
+			return indent
+		}
+	}
+	return 0
+}
+
+// yamlDocIndex resolves the given array selector e.g. [2], [-1], [], [key==val] for the given
+// sequence node returning -1 for the all selector or when no element matched.
+func yamlDocIndex(node *yaml3.Node, selector string) (i int, err error) {
+	var k, v string
+	if i, k, v, err = IdxFromSelector(selector, len(node.Content)); err != nil {
+		return
+	}
+
+	// Select by key==value, e.g. .[k==v]
+	if k != "" && v != "" {
+		i = -1
+		for j := range node.Content {
+			if elem := yamlDocResolve(node.Content[j]); elem.Kind == yaml3.MappingNode {
+				if hit, _ := yamlDocKey(elem, k); hit != nil && yamlDocResolve(hit).Value == v {
+					i = j
+					break
+				}
+			}
+		}
+	}
+	return
+}
+
+// yamlDocKey returns the value node for the given key in the given mapping node falling back
+// on any merge keys e.g. <<: *base. Local is false when the value came from a merge key.
+func yamlDocKey(node *yaml3.Node, key string) (val *yaml3.Node, local bool) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key && node.Content[i].Tag != "!!merge" {
+			return node.Content[i+1], true
+		}
+	}
+
+	// Fall back on merge keys
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Tag != "!!merge" {
+			continue
+		}
+		merges := []*yaml3.Node{node.Content[i+1]}
+		if x := yamlDocResolve(node.Content[i+1]); x.Kind == yaml3.SequenceNode {
+			merges = x.Content
+		}
+		for _, merge := range merges {
+			if x := yamlDocResolve(merge); x.Kind == yaml3.MappingNode {
+				if val, _ = yamlDocKey(x, key); val != nil {
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// yamlDocMergeKeys returns all the merge key nodes e.g. <<: *base in the given document tree
+func yamlDocMergeKeys(node *yaml3.Node, keys []*yaml3.Node) []*yaml3.Node {
+	for i, child := range node.Content {
+		if node.Kind == yaml3.MappingNode && i%2 == 0 && child.Tag == "!!merge" {
+			keys = append(keys, child)
+		}
+		keys = yamlDocMergeKeys(child, keys)
+	}
+	return keys
+}
+
+// yamlDocNode converts the given value into a document node. Maps without an order of their own
+// e.g. map[string]interface{} get their keys sorted.
+func yamlDocNode(val interface{}) (node *yaml3.Node, err error) {
+	switch x := val.(type) {
+	case *yaml3.Node:
+		node = x
+	case *YAMLDoc:
+		node = x.content()
+	case *OrderedMap:
+		node = &yaml3.Node{Kind: yaml3.MappingNode, Tag: "!!map"}
+		for _, k := range x.keys {
+			var v *yaml3.Node
+			if v, err = yamlDocNode(x.m[k]); err != nil {
+				return
+			}
+			node.Content = append(node.Content, &yaml3.Node{Kind: yaml3.ScalarNode, Tag: "!!str", Value: k}, v)
+		}
+	case []interface{}:
+		node = &yaml3.Node{Kind: yaml3.SequenceNode, Tag: "!!seq"}
+		for i := range x {
+			var v *yaml3.Node
+			if v, err = yamlDocNode(x[i]); err != nil {
+				return
+			}
+			node.Content = append(node.Content, v)
+		}
+	case interface{ O() interface{} }:
+		return yamlDocNode(x.O())
+	default:
+		if orderedMap(val) {
+			return yamlDocNode(orderedValue(val))
+		}
+		defer func() {
+			if r := recover(); r != nil {
+				err = errors.Errorf("failed to convert type %T to a yaml node: %v", val, r)
+			}
+		}()
+		node = &yaml3.Node{}
+		if err = node.Encode(val); err != nil {
+			err = errors.Wrapf(err, "failed to convert type %T to a yaml node", val)
+		}
+	}
+	return
+}
+
+// yamlDocInlineAliases walks the given node in document order moving the content of any anchor
+// not yet seen, e.g. because it was removed, into its first alias leaving an alias in its place and
+// pointing the remaining aliases at that node. Seen tracks the nodes visited and moved the anchors already inlined.
+func yamlDocInlineAliases(node *yaml3.Node, seen map[*yaml3.Node]bool, moved map[*yaml3.Node]*yaml3.Node) {
+	if node.Kind == yaml3.AliasNode && node.Alias != nil {
+		if to, ok := moved[node.Alias]; ok {
+			node.Alias = to
+		} else if !seen[node.Alias] {
+			from := node.Alias
+			node.Kind, node.Style, node.Tag, node.Value = from.Kind, from.Style, from.Tag, from.Value
+			node.Anchor, node.Alias, node.Content = from.Anchor, from.Alias, from.Content
+			from.Kind, from.Style, from.Tag, from.Value = yaml3.AliasNode, 0, "", node.Anchor
+			from.Anchor, from.Alias, from.Content = "", node, nil
+			moved[from] = node
+		}
+	}
+	seen[node] = true
+	for _, child := range node.Content {
+		yamlDocInlineAliases(child, seen, moved)
+	}
+}
+
+// yamlDocResolve follows document and alias nodes to the node they refer to
+func yamlDocResolve(node *yaml3.Node) *yaml3.Node {
+	for depth := 0; depth < 100; depth++ {
+		switch {
+		case node.Kind == yaml3.DocumentNode && len(node.Content) > 0:
+			node = node.Content[0]
+		case node.Kind == yaml3.AliasNode && node.Alias != nil:
+			node = node.Alias
+		default:
+			return node
+		}
+	}
+	return node
+}
+
+// setYAMLDocNode replaces the given node's value in place with the new node's value keeping the
+// original comments and anchor. Strings keep their quoting style and collections their flow style.
+func setYAMLDocNode(node, new *yaml3.Node) {
+	style := new.Style
+	quoted := yaml3.SingleQuotedStyle | yaml3.DoubleQuotedStyle | yaml3.LiteralStyle | yaml3.FoldedStyle
+	switch {
+	case node.Kind == yaml3.ScalarNode && new.Kind == yaml3.ScalarNode && new.Tag == "!!str" && node.Style&quoted != 0:
+		style = node.Style
+	case node.Kind == new.Kind && node.Kind != yaml3.ScalarNode && node.Style&yaml3.FlowStyle != 0:
+		style |= yaml3.FlowStyle
+	}
+	node.Kind, node.Tag, node.Value, node.Style = new.Kind, new.Tag, new.Value, style
+	node.Content, node.Alias = new.Content, new.Alias
+}
+
+// keepYAMLDocFootComment moves the given foot comments of the child at index i that is about to be
+// removed onto the previous child or the parent node when it is the first child. Foot comments
+// are typically trailing comments of a section that would otherwise be lost with the child.
+func keepYAMLDocFootComment(node *yaml3.Node, i int, comments ...string) {
+	target := node
+	if i > 0 {
+		target = node.Content[i-1]
+	}
+	for _, comment := range comments {
+		if comment == "" {
+			continue
+		}
+		if target.FootComment != "" {
+			target.FootComment += "\n"
+		}
+		target.FootComment += comment
+	}
+}
+
+// removeYAMLDocNode removes the value at the location of the given keys from the given node
+func removeYAMLDocNode(node *yaml3.Node, keys []string) (err error) {
+	if len(keys) == 0 {
+		return
+	}
+	node = yamlDocResolve(node)
+
+	switch node.Kind {
+
+	// Identifier Index: .foo, .foo.bar
+	case yaml3.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == keys[0] && node.Content[i].Tag != "!!merge" {
+				if len(keys) == 1 {
+					keepYAMLDocFootComment(node, i, node.Content[i].FootComment, node.Content[i+1].FootComment)
+					node.Content = append(node.Content[:i], node.Content[i+2:]...)
+					return
+				}
+				return removeYAMLDocNode(node.Content[i+1], keys[1:])
+			}
+		}
+
+	// Array Index/Iterator: .[2], .[-1], .[], .[key==val]
+	case yaml3.SequenceNode:
+		var i int
+		if i, err = yamlDocIndex(node, keys[0]); err != nil {
+			return
+		}
+		switch {
+		case i == -1 && keys[0] != "[]":
+			// no element matched the key value selector
+		case i == -1 && len(keys) == 1:
+			node.Content = nil
+		case i == -1:
+			for j := range node.Content {
+				if err = removeYAMLDocNode(node.Content[j], keys[1:]); err != nil {
+					return
+				}
+			}
+		case len(keys) == 1:
+			keepYAMLDocFootComment(node, i, node.Content[i].FootComment)
+			node.Content = append(node.Content[:i], node.Content[i+1:]...)
+		default:
+			err = removeYAMLDocNode(node.Content[i], keys[1:])
+		}
+	}
+	return
+}
@@ -0,0 +1,317 @@
+package n
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/phR0ze/n/pkg/sys"
+	"github.com/stretchr/testify/assert"
+)
+
+var testYAMLDoc = `# Deployment manifest
+name: "web" # quoted name
+replicas: 2
+labels: &labels
+  app: 'web'
+  tier: frontend
+# containers section
+containers:
+  - name: nginx # main container
+    image: nginx:1.17
+    ports: [80, 443]
+  - name: sidecar
+    image: envoy
+    labels: *labels
+defaults: &defaults
+  timeout: 30s
+  retries: 3
+service:
+  <<: *defaults
+  retries: 5
+notes: |
+  multi line
+  text
+# end of file
+`
+
+// NewYAMLDoc
+//--------------------------------------------------------------------------------------------------
+func ExampleNewYAMLDoc() {
+	doc := NewYAMLDoc("# replicas to run\nreplicas: 1 # at least one\nname: 'web'\n")
+	doc.Inject("replicas", 3).Inject("name", "api")
+	fmt.Print(doc.YAML())
+	// Output:
+	// # replicas to run
+	// replicas: 3 # at least one
+	// name: 'api'
+}
+
+func TestNewYAMLDoc(t *testing.T) {
+
+	// round trip unchanged
+	{
+		doc, err := NewYAMLDocE(testYAMLDoc)
+		assert.Nil(t, err)
+		assert.Equal(t, testYAMLDoc, doc.YAML())
+	}
+
+	// detects indentation
+	{
+		data := "a:\n    b: 1\n    c:\n        d: 1\n"
+		assert.Equal(t, data, NewYAMLDoc([]byte(data)).YAML())
+		assert.Equal(t, "a: 1\n", NewYAMLDoc(A("a: 1\n")).YAML())
+	}
+
+	// empty and nil
+	{
+		assert.Equal(t, "{}\n", NewYAMLDoc("").YAML())
+		assert.Equal(t, "{}\n", NewYAMLDoc(nil).YAML())
+		assert.Equal(t, "{}\n", (*YAMLDoc)(nil).YAML())
+	}
+
+	// from values
+	{
+		assert.Equal(t, "b: 1\na: 2\n", NewYAMLDoc(NewOrderedMapV("b", 1, "a", 2)).YAML())
+		assert.Equal(t, "a:\n  c: 2\n  d: 1\nb:\n  - 1\n", NewYAMLDoc(map[string]interface{}{
+			"b": []interface{}{1}, "a": map[string]interface{}{"d": 1, "c": 2},
+		}).YAML())
+		assert.Equal(t, "- 1\n- foo\n", NewYAMLDoc([]interface{}{1, "foo"}).YAML())
+	}
+
+	// invalid
+	{
+		_, err := NewYAMLDocE("a: [")
+		assert.Contains(t, err.Error(), "failed to parse yaml document")
+		_, err = NewYAMLDocE("a: 1\n---\nb: 1\n")
+		assert.Equal(t, "failed to parse yaml document, only a single document is supported", err.Error())
+		assert.Equal(t, "{}\n", NewYAMLDoc("a: [").YAML())
+		_, err = NewYAMLDocE(func() {})
+		assert.NotNil(t, err)
+	}
+}
+
+// Inject
+//--------------------------------------------------------------------------------------------------
+func TestYAMLDoc_Inject(t *testing.T) {
+
+	// keeps comments, quoting and anchors
+	{
+		doc := NewYAMLDoc(testYAMLDoc)
+		doc.Inject("name", "api")
+		doc.Inject("replicas", 3)
+		doc.Inject("labels.app", "api")
+		doc.Inject("containers.[name==nginx].image", "nginx:1.19")
+		doc.Inject("containers.[0].ports.[1]", 8443)
+		doc.Inject("service.timeout", "60s")
+		doc.Inject("notes", "changed\n")
+		doc.Inject("owner.team", "platform")
+		assert.Equal(t, `# Deployment manifest
+name: "api" # quoted name
+replicas: 3
+labels: &labels
+  app: 'api'
+  tier: frontend
+# containers section
+containers:
+  - name: nginx # main container
+    image: nginx:1.19
+    ports: [80, 8443]
+  - name: sidecar
+    image: envoy
+    labels: *labels
+defaults: &defaults
+  timeout: 30s
+  retries: 3
+service:
+  <<: *defaults
+  retries: 5
+  timeout: 60s
+notes: |
+  changed
+# end of file
+
+owner:
+  team: platform
+`, doc.YAML())
+
+		// edits through aliases are seen everywhere
+		assert.Equal(t, "api", doc.Query("containers.[1].labels.app").O())
+	}
+
+	// replace collections and quoting when required
+	{
+		doc := NewYAMLDoc("a: foo # comment\nb: {c: 1}\nc: [1, 2]\n")
+		doc.Inject("a", "123")
+		doc.Inject("b", map[string]interface{}{"d": 2})
+		doc.Inject("c", []interface{}{3})
+		assert.Equal(t, "a: \"123\" # comment\nb: {d: 2}\nc: [3]\n", doc.YAML())
+		assert.Equal(t, "123", doc.Query("a").O())
+	}
+
+	// create missing maps replacing scalars
+	{
+		doc := NewYAMLDoc("a: 1\n")
+		doc.Inject("a.b.c", true)
+		assert.Equal(t, "a:\n  b:\n    c: true\n", doc.YAML())
+	}
+
+	// root
+	{
+		doc := NewYAMLDoc("a: 1\n")
+		doc.Inject(".", NewOrderedMapV("b", 2))
+		assert.Equal(t, "b: 2\n", doc.YAML())
+		assert.Equal(t, "a: 1\n", (*YAMLDoc)(nil).Inject("a", 1).YAML())
+	}
+
+	// invalid
+	{
+		doc := NewYAMLDoc("a: [1]\n")
+		_, err := doc.InjectE("a.[5]", 1)
+		assert.Equal(t, "invalid array index 5", err.Error())
+		_, err = doc.InjectE("a", func() {})
+		assert.NotNil(t, err)
+		doc.Inject("a.[name==bogus]", 1)
+		assert.Equal(t, "a: [1]\n", doc.Inject("a.[5]", 1).YAML())
+	}
+}
+
+// M
+//--------------------------------------------------------------------------------------------------
+func TestYAMLDoc_M(t *testing.T) {
+	doc := NewYAMLDoc("a: 1\nb: &b [1, 2]\nc: *b\n")
+	assert.Equal(t, map[string]interface{}{
+		"a": 1, "b": []interface{}{1, 2}, "c": []interface{}{1, 2},
+	}, doc.M().G())
+	assert.Equal(t, NewStringMapV(), NewYAMLDoc("- 1\n").M())
+	assert.Equal(t, []interface{}{1}, NewYAMLDoc("- 1\n").O())
+	assert.Equal(t, map[string]interface{}{}, (*YAMLDoc)(nil).O())
+}
+
+// Query
+//--------------------------------------------------------------------------------------------------
+func TestYAMLDoc_Query(t *testing.T) {
+	doc := NewYAMLDoc(testYAMLDoc)
+
+	// simple selectors
+	{
+		assert.Equal(t, "web", doc.Query("name").O())
+		assert.Equal(t, 2, doc.Query(".replicas").O())
+		assert.Equal(t, "envoy", doc.Query("containers.[name==sidecar].image").O())
+		assert.Equal(t, []interface{}{80, 443}, doc.Query("containers.[0].ports").O())
+		assert.Equal(t, map[string]interface{}{"app": "web", "tier": "frontend"}, doc.Query("containers.[-1].labels").O())
+		assert.Equal(t, "30s", doc.Query("service.timeout").O())
+		assert.Equal(t, 5, doc.Query("service.retries").O())
+		assert.Equal(t, "multi line\ntext\n", doc.Query("notes").O())
+	}
+
+	// not found
+	{
+		assert.Nil(t, doc.Query("bogus").O())
+		assert.Nil(t, doc.Query("name.bogus").O())
+		assert.Nil(t, doc.Query("containers.[name==bogus]").O())
+		assert.Nil(t, (*YAMLDoc)(nil).Query("name").O())
+
+		_, err := doc.QueryE("containers.[5]")
+		assert.Equal(t, "invalid array index 5", err.Error())
+	}
+
+	// jq expressions
+	{
+		assert.Equal(t, []interface{}{"nginx", "sidecar"}, doc.Query("[.containers[].name]").O())
+		assert.Equal(t, []interface{}{"nginx", "sidecar"}, doc.Query(".containers[].name").O())
+
+		val, err := doc.QueryE(".containers | bogus")
+		assert.Nil(t, val)
+		assert.NotNil(t, err)
+	}
+}
+
+// Remove
+//--------------------------------------------------------------------------------------------------
+func TestYAMLDoc_Remove(t *testing.T) {
+
+	// keeps remaining comments
+	{
+		doc := NewYAMLDoc(testYAMLDoc)
+		doc.Remove("replicas")
+		doc.Remove("containers.[name==nginx].ports")
+		doc.Remove("containers.[1]")
+		doc.Remove("defaults.retries")
+		doc.Remove("service.bogus")
+		doc.Remove("notes")
+		assert.Equal(t, `# Deployment manifest
+name: "web" # quoted name
+labels: &labels
+  app: 'web'
+  tier: frontend
+# containers section
+containers:
+  - name: nginx # main container
+    image: nginx:1.17
+defaults: &defaults
+  timeout: 30s
+service:
+  <<: *defaults
+  retries: 5
+
+# end of file
+`, doc.YAML())
+	}
+
+	// arrays
+	{
+		doc := NewYAMLDoc("a:\n  - {b: 1, c: 1}\n  - {b: 2, c: 2}\n")
+		doc.Remove("a.[].c")
+		assert.Equal(t, "a:\n  - {b: 1}\n  - {b: 2}\n", doc.YAML())
+		doc.Remove("a.[name==bogus]")
+		doc.Remove("a.[]")
+		assert.Equal(t, "a: []\n", doc.YAML())
+
+		_, err := doc.RemoveE("a.[1]")
+		assert.Equal(t, "invalid array index 1", err.Error())
+		assert.Equal(t, "{}\n", (*YAMLDoc)(nil).Remove("a").YAML())
+	}
+
+	// anchors still in use are inlined into the first alias
+	{
+		doc := NewYAMLDoc("base: &base\n  a: 1\n  n: &n {b: 2}\nx: *n\ny:\n  <<: *base\n  c: 3\nz: *base\n")
+		doc.Remove("base")
+		data, err := doc.YAMLE()
+		assert.Nil(t, err)
+		assert.Equal(t, "x: &n {b: 2}\ny:\n  <<: &base\n    a: 1\n    n: *n\n  c: 3\nz: *base\n", data)
+		assert.Equal(t, map[string]interface{}{"b": 2}, NewYAMLDoc(data).Query("x").ToStringMap().G())
+		assert.Equal(t, "1", NewYAMLDoc(data).Query("z.a").A())
+		assert.Equal(t, "1", NewYAMLDoc(data).Query("y.a").A())
+
+		doc.Remove("x")
+		doc.Remove("y")
+		assert.Equal(t, "z: &base\n  a: 1\n  n: &n {b: 2}\n", doc.YAML())
+		assert.Equal(t, "2", NewYAMLDoc(doc.YAML()).Query("z.n.b").A())
+	}
+}
+
+// WriteYAML
+//--------------------------------------------------------------------------------------------------
+func TestYAMLDoc_WriteYAML(t *testing.T) {
+	clearTmpDir()
+	filename := path.Join(tmpDir, "doc.yaml")
+	assert.Nil(t, sys.WriteString(filename, testYAMLDoc))
+
+	doc, err := LoadYAMLDocE(filename)
+	assert.Nil(t, err)
+	doc.Inject("replicas", 5)
+	assert.Nil(t, doc.WriteYAML(filename))
+
+	data, err := sys.ReadString(filename)
+	assert.Nil(t, err)
+	assert.Equal(t, strings.Replace(testYAMLDoc, "replicas: 2", "replicas: 5", 1), data)
+	assert.Equal(t, 5, LoadYAMLDoc(filename).Query("replicas").O())
+
+	_, err = LoadYAMLDocE(path.Join(tmpDir, "bogus.yaml"))
+	assert.Contains(t, err.Error(), "failed to read in the yaml file")
+	assert.Nil(t, sys.WriteString(filename, "a: ["))
+	_, err = LoadYAMLDocE(filename)
+	assert.Contains(t, err.Error(), "failed to load yaml file")
+}
@@ -0,0 +1,218 @@
+package n
+
+import (
+	"math"
+	"unicode"
+)
+
+// Fuzzy scoring weights favoring consecutive matches and matches at the start of words
+const (
+	fuzzyMatch       = 16 // score for each matched rune
+	fuzzyBoundary    = 8  // bonus for a match at the start of a word
+	fuzzyConsecutive = 8  // bonus for a match directly following the previous match
+	fuzzyCase        = 1  // bonus for a match with the same case
+	fuzzyGapStart    = 3  // penalty for a gap between matches
+	fuzzyGapExtend   = 1  // penalty for each additional rune in a gap between matches
+)
+
+// damerau computes the unrestricted Damerau-Levenshtein distance between the given runes
+func damerau(a, b []rune) int {
+	// Distance matrix with an extra border row and column of maximum distances
+	max := len(a) + len(b)
+	d := make([][]int, len(a)+2)
+	for i := range d {
+		d[i] = make([]int, len(b)+2)
+	}
+	d[0][0] = max
+	for i := 0; i <= len(a); i++ {
+		d[i+1][0], d[i+1][1] = max, i
+	}
+	for j := 0; j <= len(b); j++ {
+		d[0][j+1], d[1][j+1] = max, j
+	}
+
+	// Last row each rune was seen in a
+	last := map[rune]int{}
+	for i := 1; i <= len(a); i++ {
+		db := 0
+		for j := 1; j <= len(b); j++ {
+			k, l, cost := last[b[j-1]], db, 1
+			if a[i-1] == b[j-1] {
+				cost, db = 0, j
+			}
+			d[i+1][j+1] = minInt(d[i][j]+cost, d[i+1][j]+1, d[i][j+1]+1, d[k][l]+(i-k-1)+1+(j-l-1))
+		}
+		last[a[i-1]] = i
+	}
+	return d[len(a)+1][len(b)+1]
+}
+
+// fuzzyBonus returns the bonus for matching the rune at the given index i.e. at the start of a word
+func fuzzyBonus(runes []rune, i int) int {
+	if i == 0 {
+		return fuzzyBoundary
+	}
+	prev, r := runes[i-1], runes[i]
+	if (!unicode.IsLetter(prev) && !unicode.IsDigit(prev)) || (unicode.IsLower(prev) && unicode.IsUpper(r)) {
+		return fuzzyBoundary
+	}
+	return 0
+}
+
+// fuzzyScore computes the best score for the given query as a case insensitive subsequence of the
+// given runes. Scores are computed row by row for each query rune where each cell holds the best
+// score with that query rune matched at that position.
+func fuzzyScore(runes, query []rune) (int, bool) {
+	if len(query) == 0 {
+		return 0, true
+	}
+	if len(query) > len(runes) {
+		return 0, false
+	}
+
+	const none = math.MinInt32
+	prev, cur := make([]int, len(runes)), make([]int, len(runes))
+	for i := range query {
+		q := unicode.ToLower(query[i])
+
+		// best is the maximum of the previous row's scores, offset by their position, that can be
+		// reached with a gap to allow for a linear gap penalty
+		best := none
+		for j := range runes {
+			if i > 0 && j >= 2 && prev[j-2] != none && prev[j-2]+(j-2)*fuzzyGapExtend > best {
+				best = prev[j-2] + (j-2)*fuzzyGapExtend
+			}
+			cur[j] = none
+			if unicode.ToLower(runes[j]) != q {
+				continue
+			}
+			score := fuzzyMatch + fuzzyBonus(runes, j)
+			if runes[j] == query[i] {
+				score += fuzzyCase
+			}
+			if i == 0 {
+				cur[j] = score
+				continue
+			}
+			from := none
+			if j >= 1 && prev[j-1] != none {
+				from = prev[j-1] + fuzzyConsecutive
+			}
+			if best != none && best-fuzzyGapStart-(j-2)*fuzzyGapExtend > from {
+				from = best - fuzzyGapStart - (j-2)*fuzzyGapExtend
+			}
+			if from != none {
+				cur[j] = from + score
+			}
+		}
+		prev, cur = cur, prev
+	}
+
+	score := none
+	for _, x := range prev {
+		if x > score {
+			score = x
+		}
+	}
+	if score == none {
+		return 0, false
+	}
+	return score, true
+}
+
+// jaroWinkler computes the Jaro-Winkler similarity between the given runes
+func jaroWinkler(a, b []rune) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	// Count the matching runes within the match window
+	window := len(a)
+	if len(b) > window {
+		window = len(b)
+	}
+	if window = window/2 - 1; window < 0 {
+		window = 0
+	}
+	matchedA, matchedB := make([]bool, len(a)), make([]bool, len(b))
+	matches := 0
+	for i := range a {
+		lo, hi := i-window, i+window+1
+		if lo < 0 {
+			lo = 0
+		}
+		if hi > len(b) {
+			hi = len(b)
+		}
+		for j := lo; j < hi; j++ {
+			if !matchedB[j] && a[i] == b[j] {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	// Count the matching runes that are out of order
+	transpositions := 0
+	for i, j := 0, 0; i < len(a); i++ {
+		if !matchedA[i] {
+			continue
+		}
+		for !matchedB[j] {
+			j++
+		}
+		if a[i] != b[j] {
+			transpositions++
+		}
+		j++
+	}
+	m := float64(matches)
+	jaro := (m/float64(len(a)) + m/float64(len(b)) + (m-float64(transpositions/2))/m) / 3
+
+	// Boost similar strings sharing a common prefix of up to 4 runes
+	if jaro <= 0.7 {
+		return jaro
+	}
+	prefix := 0
+	for prefix < 4 && prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
+
+// levenshtein computes the Levenshtein distance between the given runes
+func levenshtein(a, b []rune) int {
+	prev, cur := make([]int, len(b)+1), make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(prev[j-1]+cost, prev[j]+1, cur[j-1]+1)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// minInt returns the smallest of the given ints
+func minInt(x int, y ...int) int {
+	for i := range y {
+		if y[i] < x {
+			x = y[i]
+		}
+	}
+	return x
+}
@@ -0,0 +1,188 @@
+package n
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Word case modes used when joining words for case conversions
+const (
+	caseLower = iota // all runes lower case
+	caseUpper        // all runes upper case
+	caseTitle        // first rune upper case and the rest lower case
+	caseCamel        // first word lower case and the rest title case
+)
+
+// transliterations maps Latin letters without a canonical decomposition to their ASCII form
+var transliterations = map[rune]string{
+	'Æ': "AE", 'æ': "ae", 'Ð': "D", 'ð': "d", 'Đ': "D", 'đ': "d", 'Ħ': "H", 'ħ': "h",
+	'ı': "i", 'Ĳ': "IJ", 'ĳ': "ij", 'ĸ': "k", 'Ŀ': "L", 'ŀ': "l", 'Ł': "L", 'ł': "l",
+	'Ŋ': "N", 'ŋ': "n", 'Ø': "O", 'ø': "o", 'Œ': "OE", 'œ': "oe", 'ß': "ss", 'ẞ': "SS",
+	'Þ': "TH", 'þ': "th", 'Ŧ': "T", 'ŧ': "t",
+}
+
+// uncountables are English nouns with the same singular and plural form
+var uncountables = map[string]bool{
+	"aircraft": true, "deer": true, "equipment": true, "feedback": true, "fish": true,
+	"hardware": true, "information": true, "metadata": true, "money": true, "moose": true,
+	"news": true, "rice": true, "series": true, "sheep": true, "software": true, "species": true,
+}
+
+// irregulars maps English singular nouns that don't follow the plural or singular rules to their
+// plural
+var irregulars = map[string]string{
+	"alias": "aliases", "analysis": "analyses", "appendix": "appendices", "axis": "axes", "cache": "caches",
+	"cactus": "cacti", "calf": "calves", "child": "children", "cookie": "cookies", "crisis": "crises",
+	"criterion": "criteria", "datum": "data", "echo": "echoes", "elf": "elves", "focus": "foci",
+	"foot": "feet", "fungus": "fungi", "goose": "geese", "half": "halves", "hero": "heroes",
+	"index": "indices", "knife": "knives", "leaf": "leaves", "life": "lives", "loaf": "loaves",
+	"man": "men", "matrix": "matrices", "medium": "media", "mouse": "mice", "movie": "movies",
+	"nucleus": "nuclei", "ox": "oxen", "person": "people", "phenomenon": "phenomena",
+	"potato": "potatoes", "quiz": "quizzes", "radius": "radii", "shelf": "shelves",
+	"stimulus": "stimuli", "thesis": "theses", "thief": "thieves", "tomato": "tomatoes",
+	"tooth": "teeth", "veto": "vetoes", "vertex": "vertices", "wife": "wives", "wolf": "wolves",
+	"woman": "women",
+}
+
+// singulars maps irregular English plural nouns to their singular
+var singulars = func() map[string]string {
+	m := map[string]string{}
+	for k, v := range irregulars {
+		m[v] = k
+	}
+	return m
+}()
+
+// caseWords splits the given runes into words for case conversions returning the start and end
+// index of each word. Words are split on anything other than letters, marks and digits, on lower
+// case or digit to upper case transitions and at the end of acronyms e.g. "HTTPServer" splits into
+// "HTTP" and "Server".
+func caseWords(runes []rune) (words [][2]int) {
+	start := -1
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsMark(r) && !unicode.IsDigit(r) {
+			if start != -1 {
+				words = append(words, [2]int{start, i})
+				start = -1
+			}
+			continue
+		}
+		if start != -1 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) ||
+				(unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				words = append(words, [2]int{start, i})
+				start = i
+			}
+		}
+		if start == -1 {
+			start = i
+		}
+	}
+	if start != -1 {
+		words = append(words, [2]int{start, len(runes)})
+	}
+	return
+}
+
+// caseJoin splits the given runes into words and joins them back together with the given
+// separator converting each word to the given case mode
+func caseJoin(runes []rune, separator string, mode int) *Str {
+	new := NewStrV()
+	for i, word := range caseWords(runes) {
+		if i > 0 {
+			*new = append(*new, []rune(separator)...)
+		}
+		for j, r := range runes[word[0]:word[1]] {
+			switch {
+			case mode == caseUpper:
+				r = unicode.ToUpper(r)
+			case j == 0 && (mode == caseTitle || (mode == caseCamel && i > 0)):
+				r = unicode.ToUpper(r)
+			default:
+				r = unicode.ToLower(r)
+			}
+			*new = append(*new, r)
+		}
+	}
+	return new
+}
+
+// transliterate converts accented Latin letters in the given runes to their ASCII form e.g. 'é'
+// becomes 'e' and 'ß' becomes "ss". Other runes are returned as is.
+func transliterate(runes []rune) []rune {
+	result := make([]rune, 0, len(runes))
+	for _, r := range decomposeRunes(runes) {
+		if x, ok := transliterations[r]; ok {
+			result = append(result, []rune(x)...)
+		} else if !unicode.Is(unicode.Mn, r) {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// inflect applies the given English noun inflection to the last word of the given runes keeping
+// the case of the original word i.e. lower, upper or title case.
+func inflect(runes []rune, f func(word string) string) []rune {
+	words := caseWords(runes)
+	if len(words) == 0 {
+		return append([]rune{}, runes...)
+	}
+	start, end := words[len(words)-1][0], words[len(words)-1][1]
+	word := string(runes[start:end])
+	result := []rune(f(strings.ToLower(word)))
+
+	// Keep the case of the original word
+	if len(runes[start:end]) > 1 && word == strings.ToUpper(word) {
+		result = []rune(strings.ToUpper(string(result)))
+	} else if unicode.IsUpper(runes[start]) {
+		result[0] = unicode.ToUpper(result[0])
+	}
+	return append(append(append([]rune{}, runes[:start]...), result...), runes[end:]...)
+}
+
+// pluralize returns the English plural form of the given lower case singular noun
+func pluralize(word string) string {
+	if plural, ok := irregulars[word]; ok {
+		return plural
+	}
+	if uncountables[word] || singulars[word] != "" {
+		return word
+	}
+	switch {
+	case strings.HasSuffix(word, "y") && len(word) > 1 && !strings.ContainsRune("aeiou", rune(word[len(word)-2])):
+		return word[:len(word)-1] + "ies"
+	case strings.HasSuffix(word, "s"), strings.HasSuffix(word, "x"), strings.HasSuffix(word, "z"),
+		strings.HasSuffix(word, "ch"), strings.HasSuffix(word, "sh"):
+		return word + "es"
+	}
+	return word + "s"
+}
+
+// singularize returns the English singular form of the given lower case plural noun
+func singularize(word string) string {
+	if singular, ok := singulars[word]; ok {
+		return singular
+	}
+	if uncountables[word] || irregulars[word] != "" {
+		return word
+	}
+	switch {
+	case strings.HasSuffix(word, "ies"):
+		if len(word) <= 4 {
+			return word[:len(word)-1]
+		}
+		return word[:len(word)-3] + "y"
+	case strings.HasSuffix(word, "sses"), strings.HasSuffix(word, "shes"), strings.HasSuffix(word, "ches"),
+		strings.HasSuffix(word, "xes"), strings.HasSuffix(word, "zzes"):
+		return word[:len(word)-2]
+	case strings.HasSuffix(word, "uses") && len(word) > 4 && !strings.ContainsRune("aeiou", rune(word[len(word)-5])):
+		return word[:len(word)-2]
+	case strings.HasSuffix(word, "ss"), strings.HasSuffix(word, "us"), strings.HasSuffix(word, "is"):
+		return word
+	case strings.HasSuffix(word, "s"):
+		return word[:len(word)-1]
+	}
+	return word
+}
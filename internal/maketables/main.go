@@ -0,0 +1,267 @@
+// maketables generates unicode_tables.go from the Unicode Character Database files
+// UnicodeData.txt, CompositionExclusions.txt, CaseFolding.txt and EastAsianWidth.txt. It lives in
+// its own directory rather than behind a go:generate directive as it downloads the files by
+// default, run it explicitly from the repository root with make tables or:
+//
+//	go run ./internal/maketables [-ucd=https://www.unicode.org/Public/14.0.0/ucd] [-output=unicode_tables.go]
+//
+// The -ucd flag may be either a URL or a local directory containing the files.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	ucd    = flag.String("ucd", "https://www.unicode.org/Public/14.0.0/ucd", "URL or directory of the Unicode Character Database")
+	output = flag.String("output", "unicode_tables.go", "file to write the generated tables to")
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("maketables: ")
+	flag.Parse()
+
+	// Canonical combining classes and single level canonical decompositions
+	combining := map[rune]int{}
+	decomp := map[rune][]rune{}
+	each("UnicodeData.txt", func(fields []string) {
+		r := parseRune(fields[0])
+		if ccc, _ := strconv.Atoi(fields[3]); ccc != 0 {
+			combining[r] = ccc
+		}
+		if fields[5] != "" && !strings.HasPrefix(fields[5], "<") {
+			decomp[r] = parseRunes(fields[5])
+		}
+	})
+
+	// Primary composites are the pair decompositions not excluded or starting with a non starter
+	exclusions := map[rune]bool{}
+	each("CompositionExclusions.txt", func(fields []string) {
+		exclusions[parseRune(fields[0])] = true
+	})
+	compose := map[[2]rune]rune{}
+	for r, d := range decomp {
+		if len(d) == 2 && !exclusions[r] && combining[r] == 0 && combining[d[0]] == 0 {
+			compose[[2]rune{d[0], d[1]}] = r
+		}
+	}
+
+	// Full case foldings that differ from the simple case folding built into the unicode package
+	fold := map[rune][]rune{}
+	each("CaseFolding.txt", func(fields []string) {
+		if strings.TrimSpace(fields[1]) == "F" {
+			fold[parseRune(fields[0])] = parseRunes(fields[2])
+		}
+	})
+
+	// East Asian Wide and Fullwidth runes
+	wide := []unicode.Range32{}
+	version := each("EastAsianWidth.txt", func(fields []string) {
+		if w := strings.TrimSpace(fields[1]); w != "W" && w != "F" {
+			return
+		}
+		lo, hi := fields[0], fields[0]
+		if i := strings.Index(fields[0], ".."); i != -1 {
+			lo, hi = fields[0][:i], fields[0][i+2:]
+		}
+		r := unicode.Range32{Lo: uint32(parseRune(lo)), Hi: uint32(parseRune(hi)), Stride: 1}
+		if l := len(wide); l > 0 && wide[l-1].Hi+1 == r.Lo {
+			wide[l-1].Hi = r.Hi
+		} else {
+			wide = append(wide, r)
+		}
+	})
+
+	// Write out the tables
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "// Code generated by \"go run ./internal/maketables\"; DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "package n\n\nimport \"unicode\"\n\n")
+	fmt.Fprintf(buf, "// unicodeVersion is the version of the Unicode Character Database the tables were generated from\n")
+	fmt.Fprintf(buf, "const unicodeVersion = %q\n\n", version)
+
+	fmt.Fprintf(buf, "// unicodeCombining maps runes to their non zero canonical combining class\n")
+	fmt.Fprintf(buf, "var unicodeCombining = map[rune]uint8{\n")
+	for _, r := range sortedRunes(combining) {
+		fmt.Fprintf(buf, "0x%04X: %d,\n", r, combining[r])
+	}
+	fmt.Fprintf(buf, "}\n\n")
+
+	fmt.Fprintf(buf, "// unicodeDecomposition maps runes to their full canonical decomposition excluding Hangul\n")
+	fmt.Fprintf(buf, "// syllables which are decomposed algorithmically\n")
+	fmt.Fprintf(buf, "var unicodeDecomposition = map[rune][]rune{\n")
+	for _, r := range sortedRunes(decomp) {
+		fmt.Fprintf(buf, "0x%04X: {%s},\n", r, formatRunes(decompose(decomp, r)))
+	}
+	fmt.Fprintf(buf, "}\n\n")
+
+	fmt.Fprintf(buf, "// unicodeComposition maps pairs of runes to their primary composite excluding Hangul\n")
+	fmt.Fprintf(buf, "// syllables which are composed algorithmically\n")
+	fmt.Fprintf(buf, "var unicodeComposition = map[[2]rune]rune{\n")
+	pairs := make([][2]rune, 0, len(compose))
+	for pair := range compose {
+		pairs = append(pairs, pair)
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i][0] < pairs[j][0] || (pairs[i][0] == pairs[j][0] && pairs[i][1] < pairs[j][1])
+	})
+	for _, pair := range pairs {
+		fmt.Fprintf(buf, "{0x%04X, 0x%04X}: 0x%04X,\n", pair[0], pair[1], compose[pair])
+	}
+	fmt.Fprintf(buf, "}\n\n")
+
+	fmt.Fprintf(buf, "// unicodeFold maps runes to their full case folding where it expands to multiple runes\n")
+	fmt.Fprintf(buf, "var unicodeFold = map[rune][]rune{\n")
+	for _, r := range sortedRunes(fold) {
+		fmt.Fprintf(buf, "0x%04X: {%s},\n", r, formatRunes(fold[r]))
+	}
+	fmt.Fprintf(buf, "}\n\n")
+
+	fmt.Fprintf(buf, "// unicodeWide contains the East Asian Wide and Fullwidth runes\n")
+	fmt.Fprintf(buf, "var unicodeWide = &unicode.RangeTable{\n")
+	latinOffset := 0
+	fmt.Fprintf(buf, "R16: []unicode.Range16{\n")
+	for _, r := range wide {
+		if r.Hi <= unicode.MaxLatin1 {
+			latinOffset++
+		}
+		if r.Hi <= 0xFFFF {
+			fmt.Fprintf(buf, "{Lo: 0x%04X, Hi: 0x%04X, Stride: 1},\n", r.Lo, r.Hi)
+		}
+	}
+	fmt.Fprintf(buf, "},\nR32: []unicode.Range32{\n")
+	for _, r := range wide {
+		if r.Hi > 0xFFFF {
+			if r.Lo <= 0xFFFF {
+				log.Fatalf("wide range %X..%X crosses the 16 bit boundary", r.Lo, r.Hi)
+			}
+			fmt.Fprintf(buf, "{Lo: 0x%04X, Hi: 0x%04X, Stride: 1},\n", r.Lo, r.Hi)
+		}
+	}
+	fmt.Fprintf(buf, "},\nLatinOffset: %d,\n}\n", latinOffset)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = ioutil.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// each calls the given lambda with the semicolon separated fields of each data line in the given
+// database file and returns the version found in the file header if any.
+func each(name string, f func(fields []string)) (version string) {
+	var reader io.Reader
+	if strings.HasPrefix(*ucd, "http://") || strings.HasPrefix(*ucd, "https://") {
+		resp, err := http.Get(*ucd + "/" + name)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("failed to download %s: %s", name, resp.Status)
+		}
+		reader = resp.Body
+	} else {
+		file, err := os.Open(filepath.Join(*ucd, name))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	header := regexp.MustCompile(`^#\s*\S+-(\d+\.\d+\.\d+)\.txt`)
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if m := header.FindStringSubmatch(line); m != nil && version == "" {
+			version = m[1]
+		}
+		if i := strings.Index(line, "#"); i != -1 {
+			line = line[:i]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		f(fields)
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	return
+}
+
+// decompose recursively applies the given single level decompositions to the given rune
+func decompose(decomp map[rune][]rune, r rune) (result []rune) {
+	d, ok := decomp[r]
+	if !ok {
+		return []rune{r}
+	}
+	for _, x := range d {
+		result = append(result, decompose(decomp, x)...)
+	}
+	return
+}
+
+// formatRunes formats the given runes as a comma separated list of hex literals
+func formatRunes(runes []rune) string {
+	result := make([]string, len(runes))
+	for i, r := range runes {
+		result[i] = fmt.Sprintf("0x%04X", r)
+	}
+	return strings.Join(result, ", ")
+}
+
+// parseRune parses the given hex code point
+func parseRune(s string) rune {
+	r, err := strconv.ParseUint(strings.TrimSpace(s), 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return rune(r)
+}
+
+// parseRunes parses the given space separated hex code points
+func parseRunes(s string) (result []rune) {
+	for _, x := range strings.Fields(s) {
+		result = append(result, parseRune(x))
+	}
+	return
+}
+
+// sortedRunes returns the keys of the given map in order
+func sortedRunes(m interface{}) (result []rune) {
+	switch x := m.(type) {
+	case map[rune]int:
+		for r := range x {
+			result = append(result, r)
+		}
+	case map[rune][]rune:
+		for r := range x {
+			result = append(result, r)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return
+}
@@ -0,0 +1,113 @@
+package n
+
+import (
+	"github.com/phR0ze/n/pkg/buf/runes"
+	"github.com/phR0ze/n/pkg/errs"
+)
+
+// Iterator provides a way to iterate over the elements of any Slice, Map, Str or other source one
+// at a time with a plain for loop. Iteration can be stopped at any point by simply breaking out of
+// the loop without the need for sentinel errors as with EachE.
+//
+//	it := slice.Iter()
+//	for it.Next() {
+//		fmt.Println(it.Index(), it.Value())
+//	}
+//	if it.Err() != nil {
+//		...
+//	}
+type Iterator interface {
+	Err() error // Err returns the error, if any, that stopped the iteration.
+	Index() int // Index returns the zero based index of the current element or -1 before the first call to Next.
+	Next() bool // Next advances to the next element returning false when there are no more elements or an error occurred.
+	Value() O   // Value returns the current element or nil when there isn't one.
+}
+
+// Pair is the key-value pair element yielded by Map Iterators
+type Pair struct {
+	Key O // key of the pair
+	Val O // value of the pair
+}
+
+// iterator provides a lambda backed implementation of the Iterator interface
+type iterator struct {
+	next  func() (O, bool, error) // returns the next element if one exists
+	val   O                       // current element
+	index int                     // index of the current element
+	err   error                   // error that stopped the iteration
+	done  bool                    // iteration has completed
+}
+
+// NewIterator creates a new Iterator from the given lambda which returns the next element and
+// true or false once there are no more elements. The lambda won't be called again once it has
+// returned false.
+func NewIterator(next func() (O, bool)) Iterator {
+	return NewIteratorE(func() (O, bool, error) {
+		x, ok := next()
+		return x, ok, nil
+	})
+}
+
+// RuneScannerIter returns an Iterator over the remaining runes of the given runes.Scanner from its
+// current location adjusting its positioning as it goes. Elements will be a runes.Rune.
+func RuneScannerIter(scanner *runes.Scanner) Iterator {
+	return NewIteratorE(func() (O, bool, error) {
+		r, err := scanner.ReadE()
+		if err == errs.EOF || (err == nil && scanner.Size() == 0) {
+			return nil, false, nil
+		}
+		return r, err == nil, err
+	})
+}
+
+// NewIteratorE creates a new Iterator from the given lambda which returns the next element and
+// true or false once there are no more elements. An error from the lambda stops the iteration and
+// is returned from Err. The lambda won't be called again once it has returned false or an error.
+func NewIteratorE(next func() (O, bool, error)) Iterator {
+	return &iterator{next: next, index: -1}
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (p *iterator) Err() error {
+	return p.err
+}
+
+// Index returns the zero based index of the current element or -1 before the first call to Next.
+func (p *iterator) Index() int {
+	return p.index
+}
+
+// Next advances to the next element returning false when there are no more elements or an error
+// occurred.
+func (p *iterator) Next() bool {
+	if p.done {
+		return false
+	}
+	x, ok, err := p.next()
+	if err != nil || !ok {
+		p.val, p.err, p.done = nil, err, true
+		return false
+	}
+	p.val = x
+	p.index++
+	return true
+}
+
+// Value returns the current element or nil when there isn't one.
+func (p *iterator) Value() O {
+	return p.val
+}
+
+// newMapIter creates a new Iterator over the key-value pairs of the given Map in key order
+func newMapIter(m IMap) Iterator {
+	keys := m.Keys()
+	i := 0
+	return NewIterator(func() (O, bool) {
+		if i >= keys.Len() {
+			return nil, false
+		}
+		key := keys.At(i).O()
+		i++
+		return Pair{Key: key, Val: m.Get(key).O()}, true
+	})
+}
@@ -0,0 +1,142 @@
+package n
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/phR0ze/n/pkg/buf"
+	"github.com/phR0ze/n/pkg/buf/runes"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// NewIterator
+//--------------------------------------------------------------------------------------------------
+func ExampleNewIterator() {
+	i := 0
+	it := NewIterator(func() (O, bool) {
+		i++
+		return i * i, i <= 3
+	})
+	for it.Next() {
+		fmt.Print(it.Value(), " ")
+	}
+	// Output: 1 4 9
+}
+
+func TestNewIterator(t *testing.T) {
+
+	// empty
+	{
+		it := NewIterator(func() (O, bool) { return nil, false })
+		assert.Equal(t, -1, it.Index())
+		assert.False(t, it.Next())
+		assert.Equal(t, -1, it.Index())
+		assert.Nil(t, it.Value())
+		assert.Nil(t, it.Err())
+	}
+
+	// lambda isn't called again once exhausted
+	{
+		calls := 0
+		it := NewIterator(func() (O, bool) {
+			calls++
+			return calls, calls <= 2
+		})
+		assert.True(t, it.Next())
+		assert.Equal(t, 0, it.Index())
+		assert.Equal(t, 1, it.Value())
+		assert.True(t, it.Next())
+		assert.Equal(t, 1, it.Index())
+		assert.Equal(t, 2, it.Value())
+		assert.False(t, it.Next())
+		assert.False(t, it.Next())
+		assert.Equal(t, 3, calls)
+		assert.Equal(t, 1, it.Index())
+		assert.Nil(t, it.Value())
+	}
+
+	// break out early
+	{
+		result := []O{}
+		for it := NewIntSliceV(1, 2, 3, 4).Iter(); it.Next(); {
+			if it.Value().(int) > 2 {
+				break
+			}
+			result = append(result, it.Value())
+		}
+		assert.Equal(t, []O{1, 2}, result)
+	}
+}
+
+// NewIteratorE
+//--------------------------------------------------------------------------------------------------
+func TestNewIteratorE(t *testing.T) {
+
+	// no error
+	{
+		it := NewIteratorE(func() (O, bool, error) { return nil, false, nil })
+		assert.False(t, it.Next())
+		assert.Nil(t, it.Err())
+	}
+
+	// error stops the iteration
+	{
+		calls := 0
+		it := NewIteratorE(func() (O, bool, error) {
+			if calls++; calls > 1 {
+				return calls, true, errors.New("failed")
+			}
+			return calls, true, nil
+		})
+		assert.True(t, it.Next())
+		assert.Equal(t, 1, it.Value())
+		assert.False(t, it.Next())
+		assert.Nil(t, it.Value())
+		assert.Equal(t, 0, it.Index())
+		assert.Equal(t, "failed", it.Err().Error())
+		assert.False(t, it.Next())
+		assert.Equal(t, 2, calls)
+	}
+}
+
+// RuneScannerIter
+//--------------------------------------------------------------------------------------------------
+func TestRuneScannerIter(t *testing.T) {
+
+	// empty
+	{
+		it := RuneScannerIter(runes.NewScanner(strings.NewReader("")))
+		assert.False(t, it.Next())
+		assert.Nil(t, it.Err())
+	}
+
+	// from the current position
+	{
+		scanner := runes.NewScanner(strings.NewReader("f\no"))
+		scanner.Read()
+		it := RuneScannerIter(scanner)
+		assert.True(t, it.Next())
+		assert.Equal(t, 0, it.Index())
+		assert.Equal(t, runes.Rune{Val: '\n', Pos: buf.Position{Line: 0, Col: 1, Offset: 1}}, it.Value())
+		assert.True(t, it.Next())
+		assert.Equal(t, runes.Rune{Val: 'o', Pos: buf.Position{Line: 1, Col: 0, Offset: 2}}, it.Value())
+		assert.False(t, it.Next())
+		assert.Nil(t, it.Err())
+		assert.Equal(t, 3, scanner.Pos.Offset)
+	}
+
+	// read error
+	{
+		it := RuneScannerIter(runes.NewScanner(iterErrReader{}))
+		assert.False(t, it.Next())
+		assert.Equal(t, "failed to read all data from source reader: read failed", it.Err().Error())
+	}
+}
+
+type iterErrReader struct{}
+
+func (iterErrReader) Read(p []byte) (int, error) {
+	return 0, errors.New("read failed")
+}
@@ -0,0 +1,357 @@
+package n
+
+import (
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// setIndexExp matches array indexes not preceded by a dot e.g. a.b[0] for conversion to a.b.[0]
+	setIndexExp = regexp.MustCompile(`([^.])\[`)
+
+	// indexKeyExp matches a positive array index key as returned by KeysFromSelector e.g. [0]
+	indexKeyExp = regexp.MustCompile(`^\[(\d+)\]$`)
+)
+
+// maxIndexGrowth limits how far past the end of an array an index may be injected to keep a
+// single key e.g. APP_LIST__999999999 from allocating an enormous array
+const maxIndexGrowth = 1024
+
+// ConfigLoader builds a *StringMap from layers of configuration e.g. YAML files, environment
+// variables and command line overrides where each layer overrides the previous ones. The source
+// of every final leaf value is tracked to make debugging precedence easy.
+type ConfigLoader struct {
+	m       *StringMap
+	sources map[string]string
+}
+
+// NewConfigLoader creates a new ConfigLoader optionally starting with the given map as the first
+// layer with a source of 'default'.
+func NewConfigLoader(m ...IMap) (loader *ConfigLoader) {
+	loader = &ConfigLoader{m: NewStringMapV(), sources: map[string]string{}}
+	if len(m) > 0 {
+		loader.Merge(m[0], "default")
+	}
+	return
+}
+
+// Env overlays environment variables with the given prefix onto the map. The prefix and an
+// underscore are trimmed from the variable name, a double underscore separates nested keys and
+// numeric keys index into arrays e.g. APP_DB__HOST sets db.host and APP_SERVERS__0__PORT sets
+// servers.[0].port. Keys are lower cased unless they match an existing key case insensitively.
+// Values are type inferred as numbers, bools, null or lists e.g. [a, b]. Defaults to using
+// os.Environ when no environ is given. An empty prefix overlays all variables given. Variables
+// indexing more than 1024 items past the end of an array are skipped. Returns a reference to
+// the loader.
+func (p *ConfigLoader) Env(prefix string, environ ...string) *ConfigLoader {
+	if len(environ) == 0 {
+		environ = os.Environ()
+	}
+	environ = append([]string{}, environ...)
+	sort.Strings(environ)
+	if prefix != "" {
+		prefix = strings.TrimSuffix(prefix, "_") + "_"
+	}
+
+	for _, env := range environ {
+		pair := strings.SplitN(env, "=", 2)
+		if len(pair) != 2 || !strings.HasPrefix(pair[0], prefix) || len(pair[0]) == len(prefix) {
+			continue
+		}
+
+		keys := []string{}
+		for _, key := range strings.Split(strings.TrimPrefix(pair[0], prefix), "__") {
+			if _, e := strconv.Atoi(key); e == nil {
+				keys = append(keys, "["+key+"]")
+			} else {
+				keys = append(keys, strings.ToLower(key))
+			}
+		}
+		_ = p.inject(keys, inferValue(pair[1]), "env:"+pair[0], true)
+	}
+	return p
+}
+
+// LoadJSONE reads in the given JSON file and merges it onto the map with a source of 'file:<filepath>'
+func (p *ConfigLoader) LoadJSONE(filepath string) (err error) {
+	var m *StringMap
+	if m, err = LoadJSONE(filepath); err != nil {
+		return
+	}
+	p.Merge(m, "file:"+filepath)
+	return
+}
+
+// LoadYAMLE reads in the given YAML file and merges it onto the map with a source of 'file:<filepath>'
+func (p *ConfigLoader) LoadYAMLE(filepath string) (err error) {
+	var m *StringMap
+	if m, err = LoadYAMLE(filepath); err != nil {
+		return
+	}
+	p.Merge(m, "file:"+filepath)
+	return
+}
+
+// M returns the resulting *StringMap of all layers
+func (p *ConfigLoader) M() *StringMap {
+	return p.m
+}
+
+// Merge the given map onto the map using Merge semantics recording the given source for all of
+// its leaf values. The given map is deep copied so later layers never modify it. Returns a
+// reference to the loader.
+func (p *ConfigLoader) Merge(m IMap, source string) *ConfigLoader {
+	x, err := ToStringMapE(m)
+	if err != nil || m == nil {
+		return p
+	}
+	x = x.DeepCopy()
+	p.m.Merge(x)
+	p.trackMerge(".", x, source)
+	return p
+}
+
+// Set overlays the given command line style overrides onto the map e.g. a.b[0].c=value
+// using the Inject selector syntax creating any missing maps and arrays along the way. Values are type inferred as numbers, bools, null or lists
+// e.g. [a, b] and quoted values are always strings. Returns a reference to the loader.
+func (p *ConfigLoader) Set(overrides ...string) *ConfigLoader {
+	_ = p.SetE(overrides...)
+	return p
+}
+
+// SetE overlays the given command line style overrides onto the map e.g. a.b[0].c=value
+// using the Inject selector syntax creating any missing maps and arrays along the way. Values are type inferred as numbers, bools, null or lists
+// e.g. [a, b] and quoted values are always strings.
+func (p *ConfigLoader) SetE(overrides ...string) (err error) {
+	for _, override := range overrides {
+		pair := strings.SplitN(override, "=", 2)
+		if len(pair) != 2 || pair[0] == "" {
+			return errors.Errorf("invalid override %q, expected key=value", override)
+		}
+
+		var keys *StringSlice
+		if keys, err = KeysFromSelector(setIndexExp.ReplaceAllString(pair[0], "$1.[")); err != nil {
+			return errors.Wrapf(err, "invalid override %q", override)
+		}
+		if !keys.Any() {
+			return errors.Errorf("invalid override %q, expected key=value", override)
+		}
+		if err = p.inject(keys.G(), inferValue(pair[1]), "--set "+pair[0], false); err != nil {
+			return errors.Wrapf(err, "invalid override %q", override)
+		}
+	}
+	return
+}
+
+// Source returns the source that set the value at the given selector location e.g. .db.host.
+// A value set as part of a parent e.g. a list is reported with the parent's source. Returns
+// empty if the selector was never set.
+func (p *ConfigLoader) Source(selector string) string {
+	keys, err := KeysFromSelector(setIndexExp.ReplaceAllString(selector, "$1.["))
+	if err != nil {
+		return ""
+	}
+	path := "."
+	if source, ok := p.sources[path]; ok {
+		return source
+	}
+	for _, key := range keys.G() {
+		path = joinPath(path, key)
+		if source, ok := p.sources[path]; ok {
+			return source
+		}
+	}
+	return ""
+}
+
+// Sources returns a copy of all leaf value paths e.g. .servers.[0].port mapped to their source
+func (p *ConfigLoader) Sources() map[string]string {
+	sources := map[string]string{}
+	for k, v := range p.sources {
+		sources[k] = v
+	}
+	return sources
+}
+
+// inject the given value at the location of the given keys creating any missing maps and
+// arrays along the way then record the source. Optionally resolves keys against existing keys
+// case insensitively.
+func (p *ConfigLoader) inject(keys []string, val interface{}, source string, fold bool) (err error) {
+	var root interface{}
+	if root, err = injectPath(p.m.G(), keys, val, fold); err != nil {
+		return
+	}
+	*p.m = StringMap(root.(map[string]interface{}))
+
+	path := "."
+	for _, key := range keys {
+		path = joinPath(path, key)
+	}
+	p.track(path, val, source)
+	return
+}
+
+// track records the given source for the given path and all leaves beneath it removing any
+// sources for values that were replaced.
+func (p *ConfigLoader) track(path string, val interface{}, source string) {
+	for k := range p.sources {
+		if k == path || isChildPath(k, path) || isChildPath(path, k) {
+			delete(p.sources, k)
+		}
+	}
+	walkLeaves(path, val, func(path string, val interface{}) {
+		p.sources[path] = source
+	})
+}
+
+// trackMerge records the given source for the values of the given merged map. Maps are merged
+// recursively while all other values including arrays replace the existing value.
+func (p *ConfigLoader) trackMerge(path string, val interface{}, source string) {
+	if x, ok := val.(interface{ O() interface{} }); ok {
+		val = x.O()
+	}
+	if x, ok := val.(map[string]interface{}); ok {
+		for k, v := range x {
+			p.trackMerge(keyPath(path, k), v, source)
+		}
+		return
+	}
+	p.track(path, val, source)
+}
+
+// injectPath sets the given value at the location of the given keys creating any missing maps
+// and arrays along the way and returns the possibly new container. Returns an error if an index
+// is more than maxIndexGrowth items past the end of its array. Optionally resolves keys
+// against existing keys case insensitively updating the keys in place. StringMap.Inject can't
+// be used here as it only creates missing maps, never arrays, so a.b[0].c on an empty map would
+// set a key named [0] and it has no support for resolving keys case insensitively.
+func injectPath(obj interface{}, keys []string, val interface{}, fold bool) (new interface{}, err error) {
+	if x, ok := obj.(interface{ O() interface{} }); ok {
+		obj = x.O()
+	}
+	if len(keys) == 0 {
+		return val, nil
+	}
+
+	// Array index
+	if match := indexKeyExp.FindStringSubmatch(keys[0]); match != nil {
+		s, ok := obj.([]interface{})
+		if !ok {
+			s = []interface{}{}
+		}
+		i, e := strconv.Atoi(match[1])
+		if e != nil || i-len(s) >= maxIndexGrowth {
+			return nil, errors.Errorf("array index %s is more than %d past the end of the array", match[1], maxIndexGrowth)
+		}
+		for len(s) <= i {
+			s = append(s, nil)
+		}
+		var v interface{}
+		if v, err = injectPath(s[i], keys[1:], val, fold); err != nil {
+			return
+		}
+		s[i] = v
+		return s, nil
+	}
+
+	// Map key
+	m, ok := obj.(map[string]interface{})
+	if !ok {
+		m = map[string]interface{}{}
+	}
+	if fold {
+		if _, exists := m[keys[0]]; !exists {
+			for k := range m {
+				if strings.EqualFold(k, keys[0]) {
+					keys[0] = k
+					break
+				}
+			}
+		}
+	}
+	var v interface{}
+	if v, err = injectPath(m[keys[0]], keys[1:], val, fold); err != nil {
+		return
+	}
+	m[keys[0]] = v
+	return m, nil
+}
+
+// walkLeaves calls the given function for every leaf value beneath the given path. Empty maps
+// and arrays are considered leaves.
+func walkLeaves(path string, val interface{}, fn func(path string, val interface{})) {
+	if x, ok := val.(interface{ O() interface{} }); ok {
+		val = x.O()
+	}
+	switch x := val.(type) {
+	case map[string]interface{}:
+		if len(x) == 0 && path != "." {
+			fn(path, x)
+		}
+		for k, v := range x {
+			walkLeaves(keyPath(path, k), v, fn)
+		}
+	case []interface{}:
+		if len(x) == 0 {
+			fn(path, x)
+		}
+		for i := range x {
+			walkLeaves(indexPath(path, i), x[i], fn)
+		}
+	default:
+		fn(path, x)
+	}
+}
+
+// joinPath appends the given selector key e.g. foo or [0] to the given jq style path
+func joinPath(path, key string) string {
+	if match := indexKeyExp.FindStringSubmatch(key); match != nil {
+		i, _ := strconv.Atoi(match[1])
+		return indexPath(path, i)
+	}
+	return keyPath(path, key)
+}
+
+// isChildPath returns true if the given path is beneath the given parent path
+func isChildPath(path, parent string) bool {
+	if parent == "." {
+		return path != "."
+	}
+	return strings.HasPrefix(path, parent+".")
+}
+
+// inferValue converts the given string into a number, bool, nil or list where possible.
+// Quoted strings are always returned as strings without the quotes.
+func inferValue(val string) interface{} {
+	trimmed := strings.TrimSpace(val)
+	switch {
+	case len(trimmed) >= 2 && (trimmed[0] == '"' || trimmed[0] == '\'') && trimmed[len(trimmed)-1] == trimmed[0]:
+		return trimmed[1 : len(trimmed)-1]
+	case len(trimmed) >= 2 && trimmed[0] == '[' && trimmed[len(trimmed)-1] == ']':
+		items := []interface{}{}
+		if inner := strings.TrimSpace(trimmed[1 : len(trimmed)-1]); inner != "" {
+			for _, item := range strings.Split(inner, ",") {
+				items = append(items, inferValue(strings.TrimSpace(item)))
+			}
+		}
+		return items
+	case trimmed == "null" || trimmed == "~":
+		return nil
+	case strings.EqualFold(trimmed, "true"):
+		return true
+	case strings.EqualFold(trimmed, "false"):
+		return false
+	}
+	if i, err := strconv.Atoi(trimmed); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(trimmed, 64); err == nil && !strings.ContainsAny(trimmed, "xXnN") {
+		return f
+	}
+	return val
+}
@@ -0,0 +1,229 @@
+package n
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/phR0ze/n/pkg/patch"
+	"github.com/phR0ze/n/pkg/sys"
+	"github.com/stretchr/testify/assert"
+)
+
+// NewConfigLoader
+//--------------------------------------------------------------------------------------------------
+func ExampleNewConfigLoader() {
+	loader := NewConfigLoader(ToStringMap("db:\n  host: localhost\n  port: 5432\n"))
+	loader.Env("APP", "APP_DB__PORT=6543").Set("db.user=admin")
+	fmt.Println(loader.M().Query("db"))
+	fmt.Println(loader.Source("db.port"))
+	// Output: &map[host:localhost port:6543 user:admin]
+	// env:APP_DB__PORT
+}
+
+func TestNewConfigLoader(t *testing.T) {
+	{
+		loader := NewConfigLoader()
+		assert.Equal(t, NewStringMapV(), loader.M())
+		assert.Equal(t, map[string]string{}, loader.Sources())
+	}
+	{
+		loader := NewConfigLoader(ToStringMap("a: 1\nb: {c: [1, 2], d: {}}\n"))
+		assert.Equal(t, map[string]string{
+			".a": "default", ".b.c.[0]": "default", ".b.c.[1]": "default",
+		}, loader.Sources())
+	}
+}
+
+// Env
+//--------------------------------------------------------------------------------------------------
+func TestConfigLoader_Env(t *testing.T) {
+
+	// nesting, arrays and type inference
+	{
+		loader := NewConfigLoader(ToStringMap("logLevel: info\nservers:\n  - host: one\n    port: 80\n"))
+		loader.Env("APP_", []string{
+			"APP_LOGLEVEL=debug",
+			"APP_DB__HOST=localhost",
+			"APP_DB__PORT=5432",
+			"APP_DB__SSL=true",
+			"APP_DB__RATIO=0.5",
+			"APP_DB__NAME='1234'",
+			"APP_TAGS=[a, 2, false]",
+			"APP_SERVERS__0__PORT=8080",
+			"APP_SERVERS__2__HOST=three",
+			"APP_=ignored",
+			"OTHER_DB__HOST=ignored",
+			"APP_EMPTY=",
+			"APP_NULL=null",
+		}...)
+		assert.Equal(t, map[string]interface{}{
+			"logLevel": "debug",
+			"db": map[string]interface{}{
+				"host": "localhost", "port": 5432, "ssl": true, "ratio": 0.5, "name": "1234",
+			},
+			"tags": []interface{}{"a", 2, false},
+			"servers": []interface{}{
+				map[string]interface{}{"host": "one", "port": 8080},
+				nil,
+				map[string]interface{}{"host": "three"},
+			},
+			"empty": "",
+			"null":  nil,
+		}, patch.DeepCopy(loader.M()))
+
+		assert.Equal(t, "env:APP_LOGLEVEL", loader.Source("logLevel"))
+		assert.Equal(t, "env:APP_DB__HOST", loader.Source(".db.host"))
+		assert.Equal(t, "env:APP_TAGS", loader.Source("tags.[1]"))
+		assert.Equal(t, "env:APP_SERVERS__0__PORT", loader.Source("servers[0].port"))
+		assert.Equal(t, "default", loader.Source("servers[0].host"))
+		assert.Equal(t, "env:APP_SERVERS__2__HOST", loader.Source("servers[2].host"))
+		assert.Equal(t, "", loader.Source("servers[1]"))
+		assert.Equal(t, "", loader.Source("bogus"))
+	}
+
+	// os.Environ
+	{
+		os.Setenv("NUB_TEST_LOADER__VALUE", "1")
+		defer os.Unsetenv("NUB_TEST_LOADER__VALUE")
+		loader := NewConfigLoader().Env("NUB_TEST")
+		assert.Equal(t, map[string]interface{}{"loader": map[string]interface{}{"value": 1}}, loader.M().G())
+		assert.Equal(t, "env:NUB_TEST_LOADER__VALUE", loader.Source("loader.value"))
+	}
+
+	// indexes far past the end of an array are skipped
+	{
+		loader := NewConfigLoader().Env("APP", "APP_LIST__999999999=x", "APP_LIST__1023=y", "APP_OTHER__1024=z")
+		list := loader.M().G()["list"].([]interface{})
+		assert.Equal(t, 1024, len(list))
+		assert.Equal(t, "y", list[1023])
+		assert.Equal(t, []string{"list"}, loader.M().Keys().ToStrs())
+		assert.Equal(t, map[string]string{".list.[1023]": "env:APP_LIST__1023"}, loader.Sources())
+	}
+}
+
+// Merge
+//--------------------------------------------------------------------------------------------------
+func TestConfigLoader_Merge(t *testing.T) {
+	loader := NewConfigLoader(ToStringMap("a: 1\nb:\n  c: [1, 2, 3]\n  d: 1\ne:\n  f: 1\n"))
+	loader.Merge(ToStringMap("b:\n  c: [4]\ne: 2\ng: {h: 1}\n"), "override")
+	loader.Merge(nil, "nil")
+	assert.Equal(t, map[string]string{
+		".a": "default", ".b.c.[0]": "override", ".b.d": "default", ".e": "override", ".g.h": "override",
+	}, loader.Sources())
+	assert.Equal(t, "override", loader.Source("g.h"))
+	assert.Equal(t, "", loader.Source("g"))
+	assert.Equal(t, "", loader.Source("g.x"))
+	assert.Equal(t, []interface{}{float64(4)}, loader.M().Query("b.c").O())
+
+	// layers never modify the given maps
+	{
+		defaults := ToStringMap("db: {host: local, ports: [1]}\n")
+		override := ToStringMap("db: {host: prod}\n")
+		loader := NewConfigLoader(defaults).Merge(override, "override")
+		loader.Env("APP", "APP_DB__USER=admin").Set("db.port=5", "db.ports[0]=2")
+		assert.Equal(t, ToStringMap("db: {host: local, ports: [1]}\n"), defaults)
+		assert.Equal(t, ToStringMap("db: {host: prod}\n"), override)
+		assert.Equal(t, map[string]interface{}{"db": map[string]interface{}{
+			"host": "prod", "port": 5, "ports": []interface{}{2}, "user": "admin",
+		}}, loader.M().G())
+	}
+}
+
+// LoadYAMLE
+//--------------------------------------------------------------------------------------------------
+func TestConfigLoader_LoadYAMLE(t *testing.T) {
+	clearTmpDir()
+	yamlFile := path.Join(tmpDir, "config.yaml")
+	jsonFile := path.Join(tmpDir, "config.json")
+	assert.Nil(t, sys.WriteString(yamlFile, "db:\n  host: localhost\n  port: 5432\n"))
+	assert.Nil(t, sys.WriteString(jsonFile, `{"db": {"port": 6543}}`))
+
+	loader := NewConfigLoader()
+	assert.Nil(t, loader.LoadYAMLE(yamlFile))
+	assert.Nil(t, loader.LoadJSONE(jsonFile))
+	assert.NotNil(t, loader.LoadYAMLE(path.Join(tmpDir, "bogus.yaml")))
+	assert.Equal(t, "file:"+yamlFile, loader.Source("db.host"))
+	assert.Equal(t, "file:"+jsonFile, loader.Source("db.port"))
+	assert.Equal(t, float64(6543), loader.M().Query("db.port").O())
+}
+
+// Set
+//--------------------------------------------------------------------------------------------------
+func TestConfigLoader_Set(t *testing.T) {
+
+	// selectors and type inference
+	{
+		loader := NewConfigLoader(ToStringMap("a:\n  b:\n    - c: 1\n      d: 1\n"))
+		err := loader.SetE(
+			"a.b[0].c=2",
+			"a.b[1].c=true",
+			`x."y.z"=1.5`,
+			"list=[1, two]",
+			"quoted=\"007\"",
+			"eq=a=b",
+			"a.e.[0]=[]",
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"a": map[string]interface{}{
+				"b": []interface{}{
+					map[string]interface{}{"c": 2, "d": float64(1)},
+					map[string]interface{}{"c": true},
+				},
+				"e": []interface{}{[]interface{}{}},
+			},
+			"x":      map[string]interface{}{"y.z": 1.5},
+			"list":   []interface{}{1, "two"},
+			"quoted": "007",
+			"eq":     "a=b",
+		}, patch.DeepCopy(loader.M()))
+		assert.Equal(t, "--set a.b[0].c", loader.Source("a.b.[0].c"))
+		assert.Equal(t, "default", loader.Source("a.b.[0].d"))
+		assert.Equal(t, "--set a.b[1].c", loader.Source(".a.b.[1].c"))
+		assert.Equal(t, `--set x."y.z"`, loader.Source(`x."y.z"`))
+		assert.Equal(t, "--set a.e.[0]", loader.Source("a.e.[0]"))
+	}
+
+	// replacing a map removes the sources beneath it
+	{
+		loader := NewConfigLoader(ToStringMap("a:\n  b: 1\n  c: 1\n"))
+		loader.Set("a=1")
+		assert.Equal(t, map[string]string{".a": "--set a"}, loader.Sources())
+		loader.Set("a.b=2")
+		assert.Equal(t, map[string]string{".a.b": "--set a.b"}, loader.Sources())
+	}
+
+	// invalid
+	{
+		loader := NewConfigLoader()
+		assert.Equal(t, `invalid override "a", expected key=value`, loader.SetE("a").Error())
+		assert.Equal(t, `invalid override "=1", expected key=value`, loader.SetE("=1").Error())
+		assert.Equal(t, `invalid override ".=1", expected key=value`, loader.SetE(".=1").Error())
+		assert.Equal(t, `invalid override "a\"=1": imbalanced quotes`, loader.SetE(`a"=1`).Error())
+		assert.Equal(t, `invalid override "a[1024]=1": array index 1024 is more than 1024 past the end of the array`, loader.SetE("a[1024]=1").Error())
+		assert.Equal(t, `invalid override "b.[0].c[99999999999999999999]=1": array index 99999999999999999999 is more than 1024 past the end of the array`, loader.SetE("b.[0].c[99999999999999999999]=1").Error())
+		assert.Equal(t, map[string]interface{}{}, loader.M().G())
+	}
+}
+
+// inferValue
+//--------------------------------------------------------------------------------------------------
+func TestInferValue(t *testing.T) {
+	assert.Equal(t, 1, inferValue("1"))
+	assert.Equal(t, -1, inferValue(" -1 "))
+	assert.Equal(t, 1.5, inferValue("1.5"))
+	assert.Equal(t, true, inferValue("TRUE"))
+	assert.Equal(t, false, inferValue("false"))
+	assert.Equal(t, nil, inferValue("null"))
+	assert.Equal(t, nil, inferValue("~"))
+	assert.Equal(t, "yes", inferValue("yes"))
+	assert.Equal(t, "NaN", inferValue("NaN"))
+	assert.Equal(t, "0x10", inferValue("0x10"))
+	assert.Equal(t, "1", inferValue(`"1"`))
+	assert.Equal(t, "true", inferValue(`'true'`))
+	assert.Equal(t, " a ", inferValue(" a "))
+	assert.Equal(t, []interface{}{}, inferValue("[]"))
+	assert.Equal(t, []interface{}{1, "b", "c d"}, inferValue("[1, b,'c d']"))
+}
@@ -1,163 +1,392 @@
 package n
 
-// import (
-// 	"fmt"
-// 	"testing"
-
-// 	"github.com/stretchr/testify/assert"
-// )
-
-// // NewIntMapBool
-// //--------------------------------------------------------------------------------------------------
-// func BenchmarkNewIntMap_Go(t *testing.B) {
-// 	ints := Range(0, nines6)
-// 	for i := 0; i < len(ints); i += 10 {
-// 		_ = []int{i, i + 1, i + 2, i + 3, i + 4, i + 5, i + 6, i + 7, i + 8, i + 9}
-// 	}
-// }
-
-// func BenchmarkNewIntSlice_Slice(t *testing.B) {
-// 	ints := Range(0, nines6)
-// 	for i := 0; i < len(ints); i += 10 {
-// 		_ = NewIntSlice([]int{i, i + 1, i + 2, i + 3, i + 4, i + 5, i + 6, i + 7, i + 8, i + 9})
-// 	}
-// }
-
-// func ExampleNewIntSlice() {
-// 	slice := NewIntSlice([]int{1, 2, 3})
-// 	fmt.Println(slice.O())
-// 	// Output: [1 2 3]
-// }
-
-// func TestIntSlice_NewIntSlice(t *testing.T) {
-
-// 	// array
-// 	var array [2]int
-// 	array[0] = 1
-// 	array[1] = 2
-// 	assert.Equal(t, []int{1, 2}, NewIntSlice(array[:]).O())
-
-// 	// empty
-// 	assert.Equal(t, []int{}, NewIntSlice([]int{}).O())
-
-// 	// slice
-// 	assert.Equal(t, []int{0}, NewIntSlice([]int{0}).O())
-// 	assert.Equal(t, []int{1, 2}, NewIntSlice([]int{1, 2}).O())
-// }
-
-// // NewIntSliceV
-// //--------------------------------------------------------------------------------------------------
-// func BenchmarkNewIntSliceV_Go(t *testing.B) {
-// 	ints := Range(0, nines6)
-// 	for i := 0; i < len(ints); i += 10 {
-// 		_ = append([]int{}, i, i+1, i+2, i+3, i+4, i+5, i+6, i+7, i+8, i+9)
-// 	}
-// }
-
-// func BenchmarkNewIntSliceV_Slice(t *testing.B) {
-// 	ints := Range(0, nines6)
-// 	for i := 0; i < len(ints); i += 10 {
-// 		_ = NewIntSliceV(i, i+1, i+2, i+3, i+4, i+5, i+6, i+7, i+8, i+9)
-// 	}
-// }
-
-// func ExampleNewIntSliceV_empty() {
-// 	slice := NewIntSliceV()
-// 	fmt.Println(slice.O())
-// 	// Output: []
-// }
-
-// func ExampleNewIntSliceV_variadic() {
-// 	slice := NewIntSliceV(1, 2, 3)
-// 	fmt.Println(slice.O())
-// 	// Output: [1 2 3]
-// }
-
-// func TestIntSlice_NewIntSliceV(t *testing.T) {
-
-// 	// array
-// 	var array [2]int
-// 	array[0] = 1
-// 	array[1] = 2
-// 	assert.Equal(t, []int{1, 2}, NewIntSliceV(array[:]...).O())
-
-// 	// empty
-// 	assert.Equal(t, []int{}, NewIntSliceV().O())
-
-// 	// multiples
-// 	assert.Equal(t, []int{1}, NewIntSliceV(1).O())
-// 	assert.Equal(t, []int{1, 2}, NewIntSliceV(1, 2).O())
-// 	assert.Equal(t, []int{1, 2}, NewIntSliceV([]int{1, 2}...).O())
-// }
-
-// // Any
-// //--------------------------------------------------------------------------------------------------
-// func BenchmarkIntSlice_Any_Go(t *testing.B) {
-// 	any := func(list []int, x []int) bool {
-// 		for i := range x {
-// 			for j := range list {
-// 				if list[j] == x[i] {
-// 					return true
-// 				}
-// 			}
-// 		}
-// 		return false
-// 	}
-
-// 	// test here
-// 	ints := Range(0, nines4)
-// 	for i := range ints {
-// 		any(ints, []int{i})
-// 	}
-// }
-
-// func BenchmarkIntSlice_Any_Slice(t *testing.B) {
-// 	src := Range(0, nines4)
-// 	slice := NewIntSlice(src)
-// 	for i := range src {
-// 		slice.Any(i)
-// 	}
-// }
-
-// func ExampleIntSlice_Any_empty() {
-// 	slice := NewIntSliceV()
-// 	fmt.Println(slice.Any())
-// 	// Output: false
-// }
-
-// func ExampleIntSlice_Any_notEmpty() {
-// 	slice := NewIntSliceV(1, 2, 3)
-// 	fmt.Println(slice.Any())
-// 	// Output: true
-// }
-
-// func ExampleIntSlice_Any_contains() {
-// 	slice := NewIntSliceV(1, 2, 3)
-// 	fmt.Println(slice.Any(1))
-// 	// Output: true
-// }
-
-// func ExampleIntSlice_Any_containsAny() {
-// 	slice := NewIntSliceV(1, 2, 3)
-// 	fmt.Println(slice.Any(0, 1))
-// 	// Output: true
-// }
-
-// func TestIntSlice_Any(t *testing.T) {
-
-// 	// empty
-// 	var nilSlice *IntSlice
-// 	assert.False(t, nilSlice.Any())
-// 	assert.False(t, NewIntSliceV().Any())
-
-// 	// single
-// 	assert.True(t, NewIntSliceV(2).Any())
-
-// 	// invalid
-// 	assert.False(t, NewIntSliceV(1, 2).Any(Object{2}))
-
-// 	assert.True(t, NewIntSliceV(1, 2, 3).Any(2))
-// 	assert.False(t, NewIntSliceV(1, 2, 3).Any(4))
-// 	assert.True(t, NewIntSliceV(1, 2, 3).Any(4, 3))
-// 	assert.False(t, NewIntSliceV(1, 2, 3).Any(4, 5))
-// }
+import (
+	"fmt"
+	"path"
+	"testing"
+
+	"github.com/phR0ze/n/pkg/sys"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// NewFloatMapBool
+//--------------------------------------------------------------------------------------------------
+func ExampleNewFloatMapBool() {
+	m := NewFloatMapBool(map[float64]bool{2.5: true, 1.5: false})
+	fmt.Println(m)
+	// Output: &map[1.5:false 2.5:true]
+}
+
+func TestNewFloatMapBool(t *testing.T) {
+	assert.Equal(t, &FloatMapBool{}, NewFloatMapBool())
+	assert.Equal(t, &FloatMapBool{1.5: true}, NewFloatMapBool(map[float64]bool{1.5: true}))
+}
+
+// Any
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_Any(t *testing.T) {
+	assert.Equal(t, false, (*FloatMapBool)(nil).Any())
+	assert.Equal(t, false, NewFloatMapBool().Any())
+
+	m := NewFloatMapBool(map[float64]bool{1.5: true, 2.5: false})
+	assert.Equal(t, true, m.Any())
+	assert.Equal(t, true, m.Any(3.5, 2.5))
+	assert.Equal(t, true, m.Any("1.5"))
+	assert.Equal(t, false, m.Any(3.5))
+	assert.Equal(t, false, m.Any("bogus"))
+
+	assert.Equal(t, true, m.AnyS([]float64{3.5, 1.5}))
+	assert.Equal(t, false, m.AnyS([]float64{3.5}))
+	assert.Equal(t, false, m.AnyS(nil))
+	assert.Equal(t, true, m.AnyW(func(k, v O) bool { return k == 2.5 && v == false }))
+	assert.Equal(t, false, m.AnyW(func(k, v O) bool { return k == 3.5 }))
+}
+
+// Clear
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_Clear(t *testing.T) {
+	assert.Equal(t, NewFloatMapBool(), (*FloatMapBool)(nil).Clear())
+
+	m := NewFloatMapBool(map[float64]bool{1.5: true, 2.5: false})
+	assert.Equal(t, NewFloatMapBool(), m.Clear())
+	assert.Equal(t, NewFloatMapBool(), m)
+}
+
+// Copy
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_Copy(t *testing.T) {
+	assert.Equal(t, NewFloatMapBool(), (*FloatMapBool)(nil).Copy())
+
+	m := NewFloatMapBool(map[float64]bool{1.5: true, 2.5: false})
+	cp := m.Copy()
+	assert.Equal(t, m, cp)
+	cp.Set(1.5, false)
+	assert.Equal(t, true, m.Get(1.5).O())
+
+	assert.Equal(t, NewFloatMapBool(map[float64]bool{2.5: false}), m.Copy(2.5, 3.5, "bogus"))
+}
+
+// CountW
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_CountW(t *testing.T) {
+	assert.Equal(t, 0, (*FloatMapBool)(nil).CountW(func(k, v O) bool { return true }))
+
+	m := NewFloatMapBool(map[float64]bool{1.5: true, 2.5: false, 3.5: true})
+	assert.Equal(t, 2, m.CountW(func(k, v O) bool { return v.(bool) }))
+	assert.Equal(t, 3, m.CountW(func(k, v O) bool { return true }))
+}
+
+// Delete
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_Delete(t *testing.T) {
+	assert.Equal(t, &Object{}, (*FloatMapBool)(nil).Delete(1.5))
+	assert.Equal(t, (*FloatMapBool)(nil), (*FloatMapBool)(nil).DeleteM(1.5))
+
+	m := NewFloatMapBool(map[float64]bool{1.5: true, 2.5: false, 3.5: true})
+	assert.Equal(t, true, m.Delete(1.5).O())
+	assert.Equal(t, &Object{}, m.Delete(1.5))
+	assert.Equal(t, &Object{}, m.Delete("bogus"))
+	assert.Equal(t, NewFloatMapBool(map[float64]bool{3.5: true}), m.DeleteM(2.5))
+}
+
+// DeleteS
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_DeleteS(t *testing.T) {
+	assert.Equal(t, NewInterSliceV(), (*FloatMapBool)(nil).DeleteS([]float64{1.5}))
+
+	m := NewFloatMapBool(map[float64]bool{1.5: true, 2.5: false, 3.5: true})
+	assert.Equal(t, NewInterSliceV(false, true), m.DeleteS([]float64{2.5, 1.5}))
+	assert.Equal(t, NewFloatMapBool(map[float64]bool{3.5: true}), m)
+}
+
+// DeleteW
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_DeleteW(t *testing.T) {
+	assert.Equal(t, (*FloatMapBool)(nil), (*FloatMapBool)(nil).DeleteW(func(k, v O) bool { return true }))
+
+	m := NewFloatMapBool(map[float64]bool{1.5: true, 2.5: false, 3.5: true})
+	assert.Equal(t, NewFloatMapBool(map[float64]bool{2.5: false}), m.DeleteW(func(k, v O) bool { return v.(bool) }))
+}
+
+// Each
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatMapBool_Each() {
+	m := NewFloatMapBool(map[float64]bool{2.5: true, 1.5: false})
+	m.Each(func(k, v O) {
+		fmt.Printf("%v:%v\n", k, v)
+	})
+	// Output: 1.5:false
+	// 2.5:true
+}
+
+func TestFloatMapBool_Each(t *testing.T) {
+	(*FloatMapBool)(nil).Each(func(k, v O) { assert.Fail(t, "shouldn't be called") })
+	(*FloatMapBool)(nil).EachR(func(k, v O) { assert.Fail(t, "shouldn't be called") })
+
+	m := NewFloatMapBool(map[float64]bool{3.5: true, 1.5: true, 2.5: false})
+
+	// sorted key order
+	{
+		keys := []float64{}
+		assert.Equal(t, m, m.Each(func(k, v O) { keys = append(keys, k.(float64)) }))
+		assert.Equal(t, []float64{1.5, 2.5, 3.5}, keys)
+	}
+
+	// reverse sorted key order
+	{
+		keys := []float64{}
+		assert.Equal(t, m, m.EachR(func(k, v O) { keys = append(keys, k.(float64)) }))
+		assert.Equal(t, []float64{3.5, 2.5, 1.5}, keys)
+	}
+
+	// stop on the first error
+	{
+		keys := []float64{}
+		_, err := m.EachE(func(k, v O) error {
+			keys = append(keys, k.(float64))
+			if !v.(bool) {
+				return errors.New("failed")
+			}
+			return nil
+		})
+		assert.Equal(t, "failed", err.Error())
+		assert.Equal(t, []float64{1.5, 2.5}, keys)
+
+		keys = []float64{}
+		_, err = m.EachRE(func(k, v O) error {
+			keys = append(keys, k.(float64))
+			return Break
+		})
+		assert.Equal(t, Break, err)
+		assert.Equal(t, []float64{3.5}, keys)
+
+		_, err = (*FloatMapBool)(nil).EachE(func(k, v O) error { return Break })
+		assert.Nil(t, err)
+		_, err = (*FloatMapBool)(nil).EachRE(func(k, v O) error { return Break })
+		assert.Nil(t, err)
+	}
+}
+
+// Empty
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_Empty(t *testing.T) {
+	assert.Equal(t, true, (*FloatMapBool)(nil).Empty())
+	assert.Equal(t, true, NewFloatMapBool().Empty())
+	assert.Equal(t, false, NewFloatMapBool(map[float64]bool{1.5: true}).Empty())
+}
+
+// Exists
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_Exists(t *testing.T) {
+	assert.Equal(t, false, (*FloatMapBool)(nil).Exists(1.5))
+
+	m := NewFloatMapBool(map[float64]bool{1.5: false})
+	assert.Equal(t, true, m.Exists(1.5))
+	assert.Equal(t, false, m.Exists(2.5))
+	assert.Equal(t, false, m.Exists("bogus"))
+}
+
+// Get
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_Get(t *testing.T) {
+	assert.Equal(t, &Object{}, (*FloatMapBool)(nil).Get(1.5))
+
+	m := NewFloatMapBool(map[float64]bool{1.5: false})
+	assert.Equal(t, false, m.Get(1.5).O())
+	assert.Equal(t, false, m.Get("1.5").O())
+	assert.Equal(t, &Object{}, m.Get(2.5))
+	assert.Equal(t, false, m.Generic())
+}
+
+// Inject
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_Inject(t *testing.T) {
+	assert.Equal(t, NewFloatMapBool(map[float64]bool{1.5: true}), (*FloatMapBool)(nil).Inject(`"1.5"`, true))
+
+	m := NewFloatMapBool(map[float64]bool{1.5: false})
+	assert.Equal(t, NewFloatMapBool(map[float64]bool{1.5: true, 2.5: true}), m.Inject(`"1.5"`, true).Inject(`."2.5"`, "true"))
+
+	_, err := m.InjectE("1.5.2.5", true)
+	assert.Equal(t, "invalid key selector 1.5.2.5, only a single key is supported", err.Error())
+	_, err = m.InjectE(`"1.5"`, "bogus")
+	assert.Contains(t, err.Error(), "failed to inject value for key "+`"1.5"`)
+}
+
+// Iter
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_Iter(t *testing.T) {
+	assert.False(t, (*FloatMapBool)(nil).Iter().Next())
+	assert.False(t, NewFloatMapBool().Iter().Next())
+
+	it := NewFloatMapBool(map[float64]bool{2.2: false, 1.1: true}).Iter()
+	assert.True(t, it.Next())
+	assert.Equal(t, 0, it.Index())
+	assert.Equal(t, Pair{Key: 1.1, Val: true}, it.Value())
+	assert.True(t, it.Next())
+	assert.Equal(t, 1, it.Index())
+	assert.Equal(t, Pair{Key: 2.2, Val: false}, it.Value())
+	assert.False(t, it.Next())
+	assert.Nil(t, it.Err())
+}
+
+// Keys
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_Keys(t *testing.T) {
+	assert.Equal(t, []float64{}, (*FloatMapBool)(nil).Keys().O())
+	assert.Equal(t, []float64{}, NewFloatMapBool().Keys().O())
+	assert.Equal(t, []float64{1.5, 2.5, 3.5}, NewFloatMapBool(map[float64]bool{3.5: true, 1.5: true, 2.5: false}).Keys().O())
+}
+
+// Len
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_Len(t *testing.T) {
+	assert.Equal(t, 0, (*FloatMapBool)(nil).Len())
+	assert.Equal(t, 2, NewFloatMapBool(map[float64]bool{1.5: true, 2.5: false}).Len())
+	assert.Equal(t, true, NewFloatMapBool(map[float64]bool{1.5: true}).Single())
+	assert.Equal(t, false, NewFloatMapBool().Single())
+}
+
+// M
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_M(t *testing.T) {
+	assert.Equal(t, NewStringMapV(), (*FloatMapBool)(nil).M())
+
+	m := NewFloatMapBool(map[float64]bool{1.5: true, 2.5: false})
+	assert.Equal(t, NewStringMapV(map[string]interface{}{"1.5": true, "2.5": false}), m.M())
+	assert.Equal(t, map[string]interface{}{"1.5": true, "2.5": false}, m.MG())
+	assert.Equal(t, m.MG(), ToStringMap(m).G())
+}
+
+// Merge
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_Merge(t *testing.T) {
+	assert.Equal(t, NewFloatMapBool(map[float64]bool{1.5: true}), (*FloatMapBool)(nil).Merge(NewFloatMapBool(map[float64]bool{1.5: true})))
+
+	m := NewFloatMapBool(map[float64]bool{1.5: true, 2.5: false})
+	m.Merge(NewStringMapV(map[string]interface{}{"2.5": true, "3.5": "false", "1.5": "bogus"}))
+	assert.Equal(t, NewFloatMapBool(map[float64]bool{1.5: true, 2.5: true, 3.5: false}), m)
+	m.Merge(NewFloatMapBool(map[float64]bool{1.5: false}), "1.5")
+	assert.Equal(t, true, m.Get(1.5).O())
+	m.Merge(nil)
+	assert.Equal(t, 3, m.Len())
+}
+
+// O
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_O(t *testing.T) {
+	assert.Equal(t, map[float64]bool{}, (*FloatMapBool)(nil).O())
+	assert.Equal(t, map[float64]bool{1.5: true}, NewFloatMapBool(map[float64]bool{1.5: true}).O())
+	assert.Equal(t, true, (*FloatMapBool)(nil).Nil())
+	assert.Equal(t, false, NewFloatMapBool().Nil())
+}
+
+// Query
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_Query(t *testing.T) {
+	m := NewFloatMapBool(map[float64]bool{1.5: true, 2.5: false})
+	assert.Equal(t, true, m.Query(`"1.5"`).O())
+	assert.Equal(t, false, m.Query(`"2.5"`).O())
+	assert.Equal(t, nil, m.Query(`"3.5"`).O())
+	assert.Equal(t, []interface{}{true, false}, m.QueryS(`."1.5", ."2.5"`).O())
+}
+
+// Remove
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_Remove(t *testing.T) {
+	assert.Equal(t, (*FloatMapBool)(nil), (*FloatMapBool)(nil).Remove(`"1.5"`))
+
+	m := NewFloatMapBool(map[float64]bool{1.5: true, 2.5: false})
+	assert.Equal(t, NewFloatMapBool(map[float64]bool{2.5: false}), m.Remove(`"1.5"`).Remove(`"3.5"`))
+
+	_, err := m.RemoveE("2.5.1.5")
+	assert.Equal(t, "invalid key selector 2.5.1.5, only a single key is supported", err.Error())
+}
+
+// Reverse
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_Reverse(t *testing.T) {
+	assert.Equal(t, NewOrderedMapV(), (*FloatMapBool)(nil).Reverse())
+
+	m := NewFloatMapBool(map[float64]bool{2.5: true, 1.5: false})
+	assert.Equal(t, NewOrderedMapV("2.5", true, "1.5", false), m.Reverse())
+}
+
+// Select
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_Select(t *testing.T) {
+	assert.Equal(t, NewFloatMapBool(), (*FloatMapBool)(nil).Select(func(k, v O) bool { return true }))
+
+	m := NewFloatMapBool(map[float64]bool{1.5: true, 2.5: false, 3.5: true})
+	assert.Equal(t, NewFloatMapBool(map[float64]bool{1.5: true, 3.5: true}), m.Select(func(k, v O) bool { return v.(bool) }))
+	assert.Equal(t, 3, m.Len())
+}
+
+// Set
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_Set(t *testing.T) {
+	assert.Equal(t, false, (*FloatMapBool)(nil).Set(1.5, true))
+	assert.Equal(t, NewFloatMapBool(map[float64]bool{1.5: true}), (*FloatMapBool)(nil).SetM(1.5, true))
+
+	m := NewFloatMapBool()
+	assert.Equal(t, true, m.Set(1.5, true))
+	assert.Equal(t, false, m.Set(1.5, false))
+	assert.Equal(t, false, (*m)[1.5])
+	assert.Equal(t, false, m.Set("bogus", true))
+	assert.Equal(t, false, m.Set(2.5, "bogus"))
+	assert.Equal(t, 1, m.Len())
+}
+
+// Sort
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_Sort(t *testing.T) {
+	assert.Equal(t, NewFloatMapBool(), (*FloatMapBool)(nil).Sort())
+
+	m := NewFloatMapBool(map[float64]bool{2.5: true, 1.5: false})
+	assert.Equal(t, m, m.Sort())
+	assert.Equal(t, []float64{1.5, 2.5}, m.Sort().Keys().O())
+}
+
+// String
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_String(t *testing.T) {
+	assert.Equal(t, "&map[]", (*FloatMapBool)(nil).String())
+	assert.Equal(t, "&map[1.5:true 2.5:false]", NewFloatMapBool(map[float64]bool{2.5: false, 1.5: true}).String())
+}
+
+// Values
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_Values(t *testing.T) {
+	assert.Equal(t, []bool{}, (*FloatMapBool)(nil).Values().O())
+	assert.Equal(t, []bool{true, false, true}, NewFloatMapBool(map[float64]bool{3.5: true, 1.5: true, 2.5: false}).Values().O())
+}
+
+// YAML
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_YAML(t *testing.T) {
+	m := NewFloatMapBool(map[float64]bool{2.5: false, 1.5: true})
+	assert.Equal(t, "\"1.5\": true\n\"2.5\": false\n", m.YAML())
+	assert.Equal(t, "{}\n", (*FloatMapBool)(nil).YAML())
+}
+
+// WriteJSON
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_WriteJSON(t *testing.T) {
+	clearTmpDir()
+	filename := path.Join(tmpDir, "map.json")
+	m := NewFloatMapBool(map[float64]bool{1.5: true})
+	assert.Nil(t, m.WriteJSON(filename))
+	assert.Equal(t, m.M(), LoadJSON(filename))
+}
+
+// WriteYAML
+//--------------------------------------------------------------------------------------------------
+func TestFloatMapBool_WriteYAML(t *testing.T) {
+	clearTmpDir()
+	filename := path.Join(tmpDir, "map.yaml")
+	m := NewFloatMapBool(map[float64]bool{1.5: true})
+	assert.Nil(t, m.WriteYAML(filename))
+	data, err := sys.ReadString(filename)
+	assert.Nil(t, err)
+	assert.Equal(t, "\"1.5\": true\n", data)
+}
@@ -1,7 +1,18 @@
 package n
 
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/phR0ze/n/pkg/enc/json"
+	"github.com/phR0ze/n/pkg/enc/yaml"
+	"github.com/pkg/errors"
+)
+
 // IntMapBool implements the Map interface providing a generic way to work with map types
-// including convenience methods on par with rapid development languages.
+// including convenience methods on par with rapid development languages. Iteration is done
+// in sorted key order.
 type IntMapBool map[int]bool
 
 // NewIntMapBool creates a new empty IntMapBool if nothing given else simply
@@ -21,8 +32,11 @@ func (p *IntMapBool) Any(keys ...interface{}) bool {
 	if p == nil || len(*p) == 0 {
 		return false
 	}
+	if len(keys) == 0 {
+		return true
+	}
 	for i := 0; i < len(keys); i++ {
-		if key, ok := keys[i].(int); ok {
+		if key, err := intMapBoolKey(keys[i]); err == nil {
 			if _, ok := (*p)[key]; ok {
 				return true
 			}
@@ -31,6 +45,268 @@ func (p *IntMapBool) Any(keys ...interface{}) bool {
 	return false
 }
 
+// AnyS tests if this Map contains any of the given Slice's elements as keys.
+func (p *IntMapBool) AnyS(keys interface{}) bool {
+	if p == nil || len(*p) == 0 {
+		return false
+	}
+	x := ToInterSlice(keys)
+	if x.Len() == 0 {
+		return false
+	}
+	return p.Any(*x...)
+}
+
+// AnyW tests if this Map contains any key-value pairs that match the lambda selector.
+func (p *IntMapBool) AnyW(sel func(k, v O) bool) bool {
+	return p.CountW(sel) != 0
+}
+
+// Clear modifies this Map to clear out all key-value pairs and returns a reference to this Map.
+func (p *IntMapBool) Clear() IMap {
+	if p == nil {
+		p = NewIntMapBool()
+	} else if len(*p) > 0 {
+		*p = *NewIntMapBool()
+	}
+	return p
+}
+
+// Copy returns a new Map with the indicated key-value pairs copied from this Map or all if not given.
+func (p *IntMapBool) Copy(keys ...interface{}) (new IMap) {
+	val := NewIntMapBool()
+	if p == nil || len(*p) == 0 {
+		return val
+	}
+
+	// Copy target keys or all keys
+	if len(keys) == 0 {
+		for k := range *p {
+			(*val)[k] = (*p)[k]
+		}
+	} else {
+		for i := range keys {
+			if k, err := intMapBoolKey(keys[i]); err == nil {
+				if v, ok := (*p)[k]; ok {
+					(*val)[k] = v
+				}
+			}
+		}
+	}
+	return val
+}
+
+// CountW counts the number of key-value pairs in this Map that match the lambda selector.
+func (p *IntMapBool) CountW(sel func(k, v O) bool) (cnt int) {
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	for k, v := range *p {
+		if sel(k, v) {
+			cnt++
+		}
+	}
+	return
+}
+
+// Delete modifies this Map to delete the indicated key-value pair and returns the value from the Map.
+func (p *IntMapBool) Delete(key interface{}) (val *Object) {
+	val = &Object{}
+	if p == nil {
+		return
+	}
+	if k, err := intMapBoolKey(key); err == nil {
+		if v, ok := (*p)[k]; ok {
+			val.o = v
+			delete(*p, k)
+		}
+	}
+	return
+}
+
+// DeleteM modifies this Map to delete the indicated key-value pair and returns a reference to this Map rather than the key-value pair.
+func (p *IntMapBool) DeleteM(key interface{}) IMap {
+	if p == nil {
+		return p
+	}
+	p.Delete(key)
+	return p
+}
+
+// DeleteS modifies this Map to delete the indicated key-value pairs and returns the values from the Map as a Slice.
+func (p *IntMapBool) DeleteS(keys interface{}) (vals ISlice) {
+	vals = NewInterSliceV()
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	for _, key := range *ToInterSlice(keys) {
+		if val := p.Delete(key); !val.Nil() {
+			vals.Append(val.O())
+		}
+	}
+	return
+}
+
+// DeleteW modifies this Map to delete the key-value pairs that match the lambda selector and returns a reference to this Map.
+func (p *IntMapBool) DeleteW(sel func(k, v O) bool) IMap {
+	if p == nil {
+		return p
+	}
+	for k, v := range *p {
+		if sel(k, v) {
+			delete(*p, k)
+		}
+	}
+	return p
+}
+
+// Each calls the given lambda once for each key-value pair in this Map in sorted key order,
+// passing in the key and value as parameters; Returns a reference to this Map.
+func (p *IntMapBool) Each(action func(k, v O)) IMap {
+	if p == nil {
+		return p
+	}
+	for _, k := range intMapBoolKeys(*p) {
+		action(k, (*p)[k])
+	}
+	return p
+}
+
+// EachE calls the given lambda once for each key-value pair in this Map in sorted key order,
+// passing in the key and value as parameters; Returns a reference to this Map and any error from the lambda.
+func (p *IntMapBool) EachE(action func(k, v O) error) (IMap, error) {
+	var err error
+	if p == nil {
+		return p, err
+	}
+	for _, k := range intMapBoolKeys(*p) {
+		if err = action(k, (*p)[k]); err != nil {
+			return p, err
+		}
+	}
+	return p, err
+}
+
+// EachR calls the given lambda once for each key-value pair in this Map in reverse sorted key order,
+// passing in the key and value as parameters; Returns a reference to this Map.
+func (p *IntMapBool) EachR(action func(k, v O)) IMap {
+	if p == nil {
+		return p
+	}
+	keys := intMapBoolKeys(*p)
+	for i := len(keys) - 1; i >= 0; i-- {
+		action(keys[i], (*p)[keys[i]])
+	}
+	return p
+}
+
+// EachRE calls the given lambda once for each key-value pair in this Map in reverse sorted key order,
+// passing in the key and value as parameters; Returns a reference to this Map and any error from the lambda.
+func (p *IntMapBool) EachRE(action func(k, v O) error) (IMap, error) {
+	var err error
+	if p == nil {
+		return p, err
+	}
+	keys := intMapBoolKeys(*p)
+	for i := len(keys) - 1; i >= 0; i-- {
+		if err = action(keys[i], (*p)[keys[i]]); err != nil {
+			return p, err
+		}
+	}
+	return p, err
+}
+
+// Empty tests if this Map is empty.
+func (p *IntMapBool) Empty() bool {
+	if p == nil || len(*p) == 0 {
+		return true
+	}
+	return false
+}
+
+// Exists checks if the given key exists in this Map.
+func (p *IntMapBool) Exists(key interface{}) bool {
+	if p == nil {
+		return false
+	}
+	if k, err := intMapBoolKey(key); err == nil {
+		if _, ok := (*p)[k]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// G returns the underlying data structure as a Go type.
+func (p *IntMapBool) G() map[int]bool {
+	if p == nil {
+		return map[int]bool{}
+	}
+	return map[int]bool(*p)
+}
+
+// Generic returns true if the underlying implementation uses reflection
+func (p *IntMapBool) Generic() bool {
+	return false
+}
+
+// Get returns the value at the given key location. Returns empty *Object if not found.
+func (p *IntMapBool) Get(key interface{}) (val *Object) {
+	val = &Object{}
+	if p == nil {
+		return
+	}
+	if k, err := intMapBoolKey(key); err == nil {
+		if v, ok := (*p)[k]; ok {
+			val.o = v
+		}
+	}
+	return
+}
+
+// Inject sets the value for the given key location, using jq type selectors. Returns a reference to this Map.
+// Only a single key is supported as this Map can't hold nested values.
+func (p *IntMapBool) Inject(key string, val interface{}) IMap {
+	m, _ := p.InjectE(key, val)
+	return m
+}
+
+// InjectE sets the value for the given key location, using jq type selectors. Returns a reference to this Map.
+// Only a single key is supported as this Map can't hold nested values.
+func (p *IntMapBool) InjectE(key string, val interface{}) (m IMap, err error) {
+	if p == nil {
+		p = NewIntMapBool()
+	}
+	m = p
+
+	var k int
+	var v bool
+	if k, err = intMapBoolSelector(key); err != nil {
+		return
+	}
+	if v, err = ToBoolE(val); err != nil {
+		err = errors.Wrapf(err, "failed to inject value for key %s", key)
+		return
+	}
+	(*p)[k] = v
+	return
+}
+
+// Iter returns an Iterator over the key-value pairs of this Map in key order. Element will be a Pair.
+func (p *IntMapBool) Iter() (it Iterator) {
+	return newMapIter(p)
+}
+
+// Keys returns all the keys in this Map as a ISlice of the key type in sorted order.
+func (p *IntMapBool) Keys() ISlice {
+	if p == nil {
+		return NewIntSliceV()
+	}
+	keys := NewIntSliceV()
+	*keys = append(*keys, intMapBoolKeys(*p)...)
+	return keys
+}
+
 // Len returns the number of elements in this Map.
 func (p *IntMapBool) Len() int {
 	if p == nil {
@@ -39,19 +315,241 @@ func (p *IntMapBool) Len() int {
 	return len(*p)
 }
 
-// Set the value for the given key to the given val. Returns true if the key did not yet exists in this Map.
-func (p *IntMapBool) Set(key, val interface{}) bool {
+// M is an alias to ToStringMap
+func (p *IntMapBool) M() (m *StringMap) {
+	return p.ToStringMap()
+}
+
+// MG is an alias ToStringMapG
+func (p *IntMapBool) MG() (m map[string]interface{}) {
+	return p.ToStringMapG()
+}
+
+// Merge modifies this Map by overriding its values with the given map where they both exist and returns a
+// reference to this Map. Keys and values that can't be converted to this Map's types are skipped. Only the
+// root location is supported as this Map can't hold nested values.
+func (p *IntMapBool) Merge(m IMap, location ...string) IMap {
 	if p == nil {
-		return false
+		p = NewIntMapBool()
 	}
-	k, okk := key.(int)
-	v, okv := val.(bool)
-	if !okk || !okv {
-		return false
+	if len(location) > 0 && location[0] != "" && location[0] != "." {
+		return p
 	}
-	if _, ok := (*p)[k]; !ok {
-		(*p)[k] = v
+	x, err := ToStringMapE(m)
+	if err != nil {
+		return p
+	}
+	for key, val := range *x {
+		if k, err := intMapBoolKey(key); err == nil {
+			if v, err := ToBoolE(val); err == nil {
+				(*p)[k] = v
+			}
+		}
+	}
+	return p
+}
+
+// Nil tests if this Map is nil.
+func (p *IntMapBool) Nil() bool {
+	if p == nil {
 		return true
 	}
 	return false
 }
+
+// O returns the underlying data structure as is
+func (p *IntMapBool) O() interface{} {
+	if p == nil {
+		return map[int]bool{}
+	}
+	return map[int]bool(*p)
+}
+
+// Query returns the value at the given key location, using jq type selectors. Returns empty *Object if not found.
+func (p *IntMapBool) Query(key string) (val *Object) {
+	val, _ = p.QueryE(key)
+	return
+}
+
+// QueryE returns the value at the given key location, using jq type selectors. Returns empty *Object if not found.
+func (p *IntMapBool) QueryE(key string) (val *Object, err error) {
+	return p.ToStringMap().QueryE(key)
+}
+
+// QueryS returns all values produced by the given jq expression as a Slice of the results.
+func (p *IntMapBool) QueryS(key string) (vals ISlice) {
+	vals, _ = p.QuerySE(key)
+	return
+}
+
+// QuerySE returns all values produced by the given jq expression as a Slice of the results.
+func (p *IntMapBool) QuerySE(key string) (vals ISlice, err error) {
+	return p.ToStringMap().QuerySE(key)
+}
+
+// Remove modifies this map to remove the value at the given key location, using jq type selectors. Returns a reference to this Map
+func (p *IntMapBool) Remove(key string) IMap {
+	m, _ := p.RemoveE(key)
+	return m
+}
+
+// RemoveE modifies this map to remove the value at the given key location, using jq type selectors. Returns a reference to this Map
+func (p *IntMapBool) RemoveE(key string) (m IMap, err error) {
+	if p == nil {
+		return p, err
+	}
+	m = p
+
+	var k int
+	if k, err = intMapBoolSelector(key); err != nil {
+		return
+	}
+	delete(*p, k)
+	return
+}
+
+// Reverse returns a new Map with the key-value pairs in reverse sorted key order. As this Map type
+// has no key order of its own the result is an *OrderedMap with the keys converted to strings.
+func (p *IntMapBool) Reverse() (new IMap) {
+	return reverseMap(p)
+}
+
+// Select returns a new Map with the key-value pairs that match the lambda selector.
+func (p *IntMapBool) Select(sel func(k, v O) bool) (new IMap) {
+	val := NewIntMapBool()
+	if p == nil {
+		return val
+	}
+	for k, v := range *p {
+		if sel(k, v) {
+			(*val)[k] = v
+		}
+	}
+	return val
+}
+
+// Set the value for the given key to the given val. Returns true if the key did not yet exists in this Map.
+func (p *IntMapBool) Set(key, val interface{}) (new bool) {
+	if p == nil {
+		return
+	}
+	k, err := intMapBoolKey(key)
+	if err != nil {
+		return
+	}
+	v, err := ToBoolE(val)
+	if err != nil {
+		return
+	}
+	if _, ok := (*p)[k]; !ok {
+		new = true
+	}
+	(*p)[k] = v
+	return
+}
+
+// SetM the value for the given key to the given val creating map if necessary. Returns a reference to this Map.
+func (p *IntMapBool) SetM(key, val interface{}) IMap {
+	if p == nil {
+		p = NewIntMapBool()
+	}
+	p.Set(key, val)
+	return p
+}
+
+// Single reports true if there is only one key-value pair in this Map.
+func (p *IntMapBool) Single() bool {
+	return p.Len() == 1
+}
+
+// Sort returns a new Map with the key-value pairs in sorted key order. As this Map type always
+// iterates in sorted key order this is simply a copy of all key-value pairs.
+func (p *IntMapBool) Sort() (new IMap) {
+	return p.Copy()
+}
+
+// String returns a string representation of this Map in sorted key order, implements the Stringer interface
+func (p *IntMapBool) String() string {
+	return fmt.Sprintf("&%v", p.G())
+}
+
+// ToStringMap converts the map to a *StringMap
+func (p *IntMapBool) ToStringMap() (m *StringMap) {
+	new := StringMap(p.ToStringMapG())
+	return &new
+}
+
+// ToStringMapG converts the map to a Golang map[string]interface{}
+func (p *IntMapBool) ToStringMapG() (m map[string]interface{}) {
+	m = map[string]interface{}{}
+	if p != nil {
+		for k, v := range *p {
+			m[strconv.Itoa(k)] = v
+		}
+	}
+	return
+}
+
+// Values returns all the values in this Map as a ISlice in sorted key order.
+func (p *IntMapBool) Values() ISlice {
+	vals := []bool{}
+	if p != nil {
+		for _, k := range intMapBoolKeys(*p) {
+			vals = append(vals, (*p)[k])
+		}
+	}
+	return NewRefSlice(vals)
+}
+
+// YAML converts the Map into a YAML string
+func (p *IntMapBool) YAML() (data string) {
+	data, _ = p.YAMLE()
+	return
+}
+
+// YAMLE converts the Map into a YAML string
+func (p *IntMapBool) YAMLE() (data string, err error) {
+	var _data []byte
+	if _data, err = yaml.Marshal(p.ToStringMapG()); err != nil {
+		err = errors.Wrapf(err, "failed to marshal map[string]interface{}")
+		return
+	}
+	data = string(_data)
+	return
+}
+
+// WriteJSON converts the Map into a map[string]interface{} then calls
+// json.WriteJSON on it to write it out to disk.
+func (p *IntMapBool) WriteJSON(filename string) (err error) {
+	return json.WriteJSON(filename, p.ToStringMapG())
+}
+
+// WriteYAML converts the Map into a map[string]interface{} then calls
+// yaml.WriteYAML on it to write it out to disk.
+func (p *IntMapBool) WriteYAML(filename string) (err error) {
+	return yaml.WriteYAML(filename, p.ToStringMapG())
+}
+
+// intMapBoolKey converts the given key into the IntMapBool key type
+func intMapBoolKey(obj interface{}) (key int, err error) {
+	return ToIntE(obj)
+}
+
+// intMapBoolKeys returns the keys of the given map in sorted order
+func intMapBoolKeys(m map[int]bool) (keys []int) {
+	keys = make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return
+}
+
+// intMapBoolSelector converts the given jq type key selector into the IntMapBool key type
+func intMapBoolSelector(selector string) (key int, err error) {
+	var k string
+	if k, err = flatKeyFromSelector(selector); err != nil {
+		return
+	}
+	return intMapBoolKey(k)
+}
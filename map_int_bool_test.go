@@ -1,163 +1,392 @@
 package n
 
-// import (
-// 	"fmt"
-// 	"testing"
-
-// 	"github.com/stretchr/testify/assert"
-// )
-
-// // NewIntMapBool
-// //--------------------------------------------------------------------------------------------------
-// func BenchmarkNewIntMap_Go(t *testing.B) {
-// 	ints := Range(0, nines6)
-// 	for i := 0; i < len(ints); i += 10 {
-// 		_ = []int{i, i + 1, i + 2, i + 3, i + 4, i + 5, i + 6, i + 7, i + 8, i + 9}
-// 	}
-// }
-
-// func BenchmarkNewIntSlice_Slice(t *testing.B) {
-// 	ints := Range(0, nines6)
-// 	for i := 0; i < len(ints); i += 10 {
-// 		_ = NewIntSlice([]int{i, i + 1, i + 2, i + 3, i + 4, i + 5, i + 6, i + 7, i + 8, i + 9})
-// 	}
-// }
-
-// func ExampleNewIntSlice() {
-// 	slice := NewIntSlice([]int{1, 2, 3})
-// 	fmt.Println(slice.O())
-// 	// Output: [1 2 3]
-// }
-
-// func TestIntSlice_NewIntSlice(t *testing.T) {
-
-// 	// array
-// 	var array [2]int
-// 	array[0] = 1
-// 	array[1] = 2
-// 	assert.Equal(t, []int{1, 2}, NewIntSlice(array[:]).O())
-
-// 	// empty
-// 	assert.Equal(t, []int{}, NewIntSlice([]int{}).O())
-
-// 	// slice
-// 	assert.Equal(t, []int{0}, NewIntSlice([]int{0}).O())
-// 	assert.Equal(t, []int{1, 2}, NewIntSlice([]int{1, 2}).O())
-// }
-
-// // NewIntSliceV
-// //--------------------------------------------------------------------------------------------------
-// func BenchmarkNewIntSliceV_Go(t *testing.B) {
-// 	ints := Range(0, nines6)
-// 	for i := 0; i < len(ints); i += 10 {
-// 		_ = append([]int{}, i, i+1, i+2, i+3, i+4, i+5, i+6, i+7, i+8, i+9)
-// 	}
-// }
-
-// func BenchmarkNewIntSliceV_Slice(t *testing.B) {
-// 	ints := Range(0, nines6)
-// 	for i := 0; i < len(ints); i += 10 {
-// 		_ = NewIntSliceV(i, i+1, i+2, i+3, i+4, i+5, i+6, i+7, i+8, i+9)
-// 	}
-// }
-
-// func ExampleNewIntSliceV_empty() {
-// 	slice := NewIntSliceV()
-// 	fmt.Println(slice.O())
-// 	// Output: []
-// }
-
-// func ExampleNewIntSliceV_variadic() {
-// 	slice := NewIntSliceV(1, 2, 3)
-// 	fmt.Println(slice.O())
-// 	// Output: [1 2 3]
-// }
-
-// func TestIntSlice_NewIntSliceV(t *testing.T) {
-
-// 	// array
-// 	var array [2]int
-// 	array[0] = 1
-// 	array[1] = 2
-// 	assert.Equal(t, []int{1, 2}, NewIntSliceV(array[:]...).O())
-
-// 	// empty
-// 	assert.Equal(t, []int{}, NewIntSliceV().O())
-
-// 	// multiples
-// 	assert.Equal(t, []int{1}, NewIntSliceV(1).O())
-// 	assert.Equal(t, []int{1, 2}, NewIntSliceV(1, 2).O())
-// 	assert.Equal(t, []int{1, 2}, NewIntSliceV([]int{1, 2}...).O())
-// }
-
-// // Any
-// //--------------------------------------------------------------------------------------------------
-// func BenchmarkIntSlice_Any_Go(t *testing.B) {
-// 	any := func(list []int, x []int) bool {
-// 		for i := range x {
-// 			for j := range list {
-// 				if list[j] == x[i] {
-// 					return true
-// 				}
-// 			}
-// 		}
-// 		return false
-// 	}
-
-// 	// test here
-// 	ints := Range(0, nines4)
-// 	for i := range ints {
-// 		any(ints, []int{i})
-// 	}
-// }
-
-// func BenchmarkIntSlice_Any_Slice(t *testing.B) {
-// 	src := Range(0, nines4)
-// 	slice := NewIntSlice(src)
-// 	for i := range src {
-// 		slice.Any(i)
-// 	}
-// }
-
-// func ExampleIntSlice_Any_empty() {
-// 	slice := NewIntSliceV()
-// 	fmt.Println(slice.Any())
-// 	// Output: false
-// }
-
-// func ExampleIntSlice_Any_notEmpty() {
-// 	slice := NewIntSliceV(1, 2, 3)
-// 	fmt.Println(slice.Any())
-// 	// Output: true
-// }
-
-// func ExampleIntSlice_Any_contains() {
-// 	slice := NewIntSliceV(1, 2, 3)
-// 	fmt.Println(slice.Any(1))
-// 	// Output: true
-// }
-
-// func ExampleIntSlice_Any_containsAny() {
-// 	slice := NewIntSliceV(1, 2, 3)
-// 	fmt.Println(slice.Any(0, 1))
-// 	// Output: true
-// }
-
-// func TestIntSlice_Any(t *testing.T) {
-
-// 	// empty
-// 	var nilSlice *IntSlice
-// 	assert.False(t, nilSlice.Any())
-// 	assert.False(t, NewIntSliceV().Any())
-
-// 	// single
-// 	assert.True(t, NewIntSliceV(2).Any())
-
-// 	// invalid
-// 	assert.False(t, NewIntSliceV(1, 2).Any(Object{2}))
-
-// 	assert.True(t, NewIntSliceV(1, 2, 3).Any(2))
-// 	assert.False(t, NewIntSliceV(1, 2, 3).Any(4))
-// 	assert.True(t, NewIntSliceV(1, 2, 3).Any(4, 3))
-// 	assert.False(t, NewIntSliceV(1, 2, 3).Any(4, 5))
-// }
+import (
+	"fmt"
+	"path"
+	"testing"
+
+	"github.com/phR0ze/n/pkg/sys"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// NewIntMapBool
+//--------------------------------------------------------------------------------------------------
+func ExampleNewIntMapBool() {
+	m := NewIntMapBool(map[int]bool{2: true, 1: false})
+	fmt.Println(m)
+	// Output: &map[1:false 2:true]
+}
+
+func TestNewIntMapBool(t *testing.T) {
+	assert.Equal(t, &IntMapBool{}, NewIntMapBool())
+	assert.Equal(t, &IntMapBool{1: true}, NewIntMapBool(map[int]bool{1: true}))
+}
+
+// Any
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_Any(t *testing.T) {
+	assert.Equal(t, false, (*IntMapBool)(nil).Any())
+	assert.Equal(t, false, NewIntMapBool().Any())
+
+	m := NewIntMapBool(map[int]bool{1: true, 2: false})
+	assert.Equal(t, true, m.Any())
+	assert.Equal(t, true, m.Any(3, 2))
+	assert.Equal(t, true, m.Any("1"))
+	assert.Equal(t, false, m.Any(3))
+	assert.Equal(t, false, m.Any("bogus"))
+
+	assert.Equal(t, true, m.AnyS([]int{3, 1}))
+	assert.Equal(t, false, m.AnyS([]int{3}))
+	assert.Equal(t, false, m.AnyS(nil))
+	assert.Equal(t, true, m.AnyW(func(k, v O) bool { return k == 2 && v == false }))
+	assert.Equal(t, false, m.AnyW(func(k, v O) bool { return k == 3 }))
+}
+
+// Clear
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_Clear(t *testing.T) {
+	assert.Equal(t, NewIntMapBool(), (*IntMapBool)(nil).Clear())
+
+	m := NewIntMapBool(map[int]bool{1: true, 2: false})
+	assert.Equal(t, NewIntMapBool(), m.Clear())
+	assert.Equal(t, NewIntMapBool(), m)
+}
+
+// Copy
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_Copy(t *testing.T) {
+	assert.Equal(t, NewIntMapBool(), (*IntMapBool)(nil).Copy())
+
+	m := NewIntMapBool(map[int]bool{1: true, 2: false})
+	cp := m.Copy()
+	assert.Equal(t, m, cp)
+	cp.Set(1, false)
+	assert.Equal(t, true, m.Get(1).O())
+
+	assert.Equal(t, NewIntMapBool(map[int]bool{2: false}), m.Copy(2, 3, "bogus"))
+}
+
+// CountW
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_CountW(t *testing.T) {
+	assert.Equal(t, 0, (*IntMapBool)(nil).CountW(func(k, v O) bool { return true }))
+
+	m := NewIntMapBool(map[int]bool{1: true, 2: false, 3: true})
+	assert.Equal(t, 2, m.CountW(func(k, v O) bool { return v.(bool) }))
+	assert.Equal(t, 3, m.CountW(func(k, v O) bool { return true }))
+}
+
+// Delete
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_Delete(t *testing.T) {
+	assert.Equal(t, &Object{}, (*IntMapBool)(nil).Delete(1))
+	assert.Equal(t, (*IntMapBool)(nil), (*IntMapBool)(nil).DeleteM(1))
+
+	m := NewIntMapBool(map[int]bool{1: true, 2: false, 3: true})
+	assert.Equal(t, true, m.Delete(1).O())
+	assert.Equal(t, &Object{}, m.Delete(1))
+	assert.Equal(t, &Object{}, m.Delete("bogus"))
+	assert.Equal(t, NewIntMapBool(map[int]bool{3: true}), m.DeleteM(2))
+}
+
+// DeleteS
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_DeleteS(t *testing.T) {
+	assert.Equal(t, NewInterSliceV(), (*IntMapBool)(nil).DeleteS([]int{1}))
+
+	m := NewIntMapBool(map[int]bool{1: true, 2: false, 3: true})
+	assert.Equal(t, NewInterSliceV(false, true), m.DeleteS([]int{2, 1}))
+	assert.Equal(t, NewIntMapBool(map[int]bool{3: true}), m)
+}
+
+// DeleteW
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_DeleteW(t *testing.T) {
+	assert.Equal(t, (*IntMapBool)(nil), (*IntMapBool)(nil).DeleteW(func(k, v O) bool { return true }))
+
+	m := NewIntMapBool(map[int]bool{1: true, 2: false, 3: true})
+	assert.Equal(t, NewIntMapBool(map[int]bool{2: false}), m.DeleteW(func(k, v O) bool { return v.(bool) }))
+}
+
+// Each
+//--------------------------------------------------------------------------------------------------
+func ExampleIntMapBool_Each() {
+	m := NewIntMapBool(map[int]bool{2: true, 1: false})
+	m.Each(func(k, v O) {
+		fmt.Printf("%v:%v\n", k, v)
+	})
+	// Output: 1:false
+	// 2:true
+}
+
+func TestIntMapBool_Each(t *testing.T) {
+	(*IntMapBool)(nil).Each(func(k, v O) { assert.Fail(t, "shouldn't be called") })
+	(*IntMapBool)(nil).EachR(func(k, v O) { assert.Fail(t, "shouldn't be called") })
+
+	m := NewIntMapBool(map[int]bool{3: true, 1: true, 2: false})
+
+	// sorted key order
+	{
+		keys := []int{}
+		assert.Equal(t, m, m.Each(func(k, v O) { keys = append(keys, k.(int)) }))
+		assert.Equal(t, []int{1, 2, 3}, keys)
+	}
+
+	// reverse sorted key order
+	{
+		keys := []int{}
+		assert.Equal(t, m, m.EachR(func(k, v O) { keys = append(keys, k.(int)) }))
+		assert.Equal(t, []int{3, 2, 1}, keys)
+	}
+
+	// stop on the first error
+	{
+		keys := []int{}
+		_, err := m.EachE(func(k, v O) error {
+			keys = append(keys, k.(int))
+			if !v.(bool) {
+				return errors.New("failed")
+			}
+			return nil
+		})
+		assert.Equal(t, "failed", err.Error())
+		assert.Equal(t, []int{1, 2}, keys)
+
+		keys = []int{}
+		_, err = m.EachRE(func(k, v O) error {
+			keys = append(keys, k.(int))
+			return Break
+		})
+		assert.Equal(t, Break, err)
+		assert.Equal(t, []int{3}, keys)
+
+		_, err = (*IntMapBool)(nil).EachE(func(k, v O) error { return Break })
+		assert.Nil(t, err)
+		_, err = (*IntMapBool)(nil).EachRE(func(k, v O) error { return Break })
+		assert.Nil(t, err)
+	}
+}
+
+// Empty
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_Empty(t *testing.T) {
+	assert.Equal(t, true, (*IntMapBool)(nil).Empty())
+	assert.Equal(t, true, NewIntMapBool().Empty())
+	assert.Equal(t, false, NewIntMapBool(map[int]bool{1: true}).Empty())
+}
+
+// Exists
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_Exists(t *testing.T) {
+	assert.Equal(t, false, (*IntMapBool)(nil).Exists(1))
+
+	m := NewIntMapBool(map[int]bool{1: false})
+	assert.Equal(t, true, m.Exists(1))
+	assert.Equal(t, false, m.Exists(2))
+	assert.Equal(t, false, m.Exists("bogus"))
+}
+
+// Get
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_Get(t *testing.T) {
+	assert.Equal(t, &Object{}, (*IntMapBool)(nil).Get(1))
+
+	m := NewIntMapBool(map[int]bool{1: false})
+	assert.Equal(t, false, m.Get(1).O())
+	assert.Equal(t, false, m.Get("1").O())
+	assert.Equal(t, &Object{}, m.Get(2))
+	assert.Equal(t, false, m.Generic())
+}
+
+// Inject
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_Inject(t *testing.T) {
+	assert.Equal(t, NewIntMapBool(map[int]bool{1: true}), (*IntMapBool)(nil).Inject("1", true))
+
+	m := NewIntMapBool(map[int]bool{1: false})
+	assert.Equal(t, NewIntMapBool(map[int]bool{1: true, 2: true}), m.Inject("1", true).Inject(".2", "true"))
+
+	_, err := m.InjectE("1.2", true)
+	assert.Equal(t, "invalid key selector 1.2, only a single key is supported", err.Error())
+	_, err = m.InjectE("1", "bogus")
+	assert.Contains(t, err.Error(), "failed to inject value for key "+"1")
+}
+
+// Iter
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_Iter(t *testing.T) {
+	assert.False(t, (*IntMapBool)(nil).Iter().Next())
+	assert.False(t, NewIntMapBool().Iter().Next())
+
+	it := NewIntMapBool(map[int]bool{2: false, 1: true}).Iter()
+	assert.True(t, it.Next())
+	assert.Equal(t, 0, it.Index())
+	assert.Equal(t, Pair{Key: 1, Val: true}, it.Value())
+	assert.True(t, it.Next())
+	assert.Equal(t, 1, it.Index())
+	assert.Equal(t, Pair{Key: 2, Val: false}, it.Value())
+	assert.False(t, it.Next())
+	assert.Nil(t, it.Err())
+}
+
+// Keys
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_Keys(t *testing.T) {
+	assert.Equal(t, []int{}, (*IntMapBool)(nil).Keys().O())
+	assert.Equal(t, []int{}, NewIntMapBool().Keys().O())
+	assert.Equal(t, []int{1, 2, 3}, NewIntMapBool(map[int]bool{3: true, 1: true, 2: false}).Keys().O())
+}
+
+// Len
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_Len(t *testing.T) {
+	assert.Equal(t, 0, (*IntMapBool)(nil).Len())
+	assert.Equal(t, 2, NewIntMapBool(map[int]bool{1: true, 2: false}).Len())
+	assert.Equal(t, true, NewIntMapBool(map[int]bool{1: true}).Single())
+	assert.Equal(t, false, NewIntMapBool().Single())
+}
+
+// M
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_M(t *testing.T) {
+	assert.Equal(t, NewStringMapV(), (*IntMapBool)(nil).M())
+
+	m := NewIntMapBool(map[int]bool{1: true, 2: false})
+	assert.Equal(t, NewStringMapV(map[string]interface{}{"1": true, "2": false}), m.M())
+	assert.Equal(t, map[string]interface{}{"1": true, "2": false}, m.MG())
+	assert.Equal(t, m.MG(), ToStringMap(m).G())
+}
+
+// Merge
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_Merge(t *testing.T) {
+	assert.Equal(t, NewIntMapBool(map[int]bool{1: true}), (*IntMapBool)(nil).Merge(NewIntMapBool(map[int]bool{1: true})))
+
+	m := NewIntMapBool(map[int]bool{1: true, 2: false})
+	m.Merge(NewStringMapV(map[string]interface{}{"2": true, "3": "false", "1": "bogus"}))
+	assert.Equal(t, NewIntMapBool(map[int]bool{1: true, 2: true, 3: false}), m)
+	m.Merge(NewIntMapBool(map[int]bool{1: false}), "1")
+	assert.Equal(t, true, m.Get(1).O())
+	m.Merge(nil)
+	assert.Equal(t, 3, m.Len())
+}
+
+// O
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_O(t *testing.T) {
+	assert.Equal(t, map[int]bool{}, (*IntMapBool)(nil).O())
+	assert.Equal(t, map[int]bool{1: true}, NewIntMapBool(map[int]bool{1: true}).O())
+	assert.Equal(t, true, (*IntMapBool)(nil).Nil())
+	assert.Equal(t, false, NewIntMapBool().Nil())
+}
+
+// Query
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_Query(t *testing.T) {
+	m := NewIntMapBool(map[int]bool{1: true, 2: false})
+	assert.Equal(t, true, m.Query("1").O())
+	assert.Equal(t, false, m.Query(`"2"`).O())
+	assert.Equal(t, nil, m.Query("3").O())
+	assert.Equal(t, []interface{}{true, false}, m.QueryS(`."1", ."2"`).O())
+}
+
+// Remove
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_Remove(t *testing.T) {
+	assert.Equal(t, (*IntMapBool)(nil), (*IntMapBool)(nil).Remove("1"))
+
+	m := NewIntMapBool(map[int]bool{1: true, 2: false})
+	assert.Equal(t, NewIntMapBool(map[int]bool{2: false}), m.Remove("1").Remove("3"))
+
+	_, err := m.RemoveE("2.1")
+	assert.Equal(t, "invalid key selector 2.1, only a single key is supported", err.Error())
+}
+
+// Reverse
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_Reverse(t *testing.T) {
+	assert.Equal(t, NewOrderedMapV(), (*IntMapBool)(nil).Reverse())
+
+	m := NewIntMapBool(map[int]bool{2: true, 1: false, 10: true})
+	assert.Equal(t, NewOrderedMapV("10", true, "2", true, "1", false), m.Reverse())
+}
+
+// Select
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_Select(t *testing.T) {
+	assert.Equal(t, NewIntMapBool(), (*IntMapBool)(nil).Select(func(k, v O) bool { return true }))
+
+	m := NewIntMapBool(map[int]bool{1: true, 2: false, 3: true})
+	assert.Equal(t, NewIntMapBool(map[int]bool{1: true, 3: true}), m.Select(func(k, v O) bool { return v.(bool) }))
+	assert.Equal(t, 3, m.Len())
+}
+
+// Set
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_Set(t *testing.T) {
+	assert.Equal(t, false, (*IntMapBool)(nil).Set(1, true))
+	assert.Equal(t, NewIntMapBool(map[int]bool{1: true}), (*IntMapBool)(nil).SetM(1, true))
+
+	m := NewIntMapBool()
+	assert.Equal(t, true, m.Set(1, true))
+	assert.Equal(t, false, m.Set(1, false))
+	assert.Equal(t, false, (*m)[1])
+	assert.Equal(t, false, m.Set("bogus", true))
+	assert.Equal(t, false, m.Set(2, "bogus"))
+	assert.Equal(t, 1, m.Len())
+}
+
+// Sort
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_Sort(t *testing.T) {
+	assert.Equal(t, NewIntMapBool(), (*IntMapBool)(nil).Sort())
+
+	m := NewIntMapBool(map[int]bool{2: true, 1: false})
+	assert.Equal(t, m, m.Sort())
+	assert.Equal(t, []int{1, 2}, m.Sort().Keys().O())
+}
+
+// String
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_String(t *testing.T) {
+	assert.Equal(t, "&map[]", (*IntMapBool)(nil).String())
+	assert.Equal(t, "&map[1:true 2:false]", NewIntMapBool(map[int]bool{2: false, 1: true}).String())
+}
+
+// Values
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_Values(t *testing.T) {
+	assert.Equal(t, []bool{}, (*IntMapBool)(nil).Values().O())
+	assert.Equal(t, []bool{true, false, true}, NewIntMapBool(map[int]bool{3: true, 1: true, 2: false}).Values().O())
+}
+
+// YAML
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_YAML(t *testing.T) {
+	m := NewIntMapBool(map[int]bool{2: false, 1: true})
+	assert.Equal(t, "\"1\": true\n\"2\": false\n", m.YAML())
+	assert.Equal(t, "{}\n", (*IntMapBool)(nil).YAML())
+}
+
+// WriteJSON
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_WriteJSON(t *testing.T) {
+	clearTmpDir()
+	filename := path.Join(tmpDir, "map.json")
+	m := NewIntMapBool(map[int]bool{1: true})
+	assert.Nil(t, m.WriteJSON(filename))
+	assert.Equal(t, m.M(), LoadJSON(filename))
+}
+
+// WriteYAML
+//--------------------------------------------------------------------------------------------------
+func TestIntMapBool_WriteYAML(t *testing.T) {
+	clearTmpDir()
+	filename := path.Join(tmpDir, "map.yaml")
+	m := NewIntMapBool(map[int]bool{1: true})
+	assert.Nil(t, m.WriteYAML(filename))
+	data, err := sys.ReadString(filename)
+	assert.Nil(t, err)
+	assert.Equal(t, "\"1\": true\n", data)
+}
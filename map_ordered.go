@@ -0,0 +1,1026 @@
+package n
+
+import (
+	"bytes"
+	gojson "encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/phR0ze/n/pkg/enc/json"
+	"github.com/phR0ze/n/pkg/enc/yaml"
+	"github.com/phR0ze/n/pkg/jq"
+	"github.com/phR0ze/n/pkg/sys"
+	"github.com/pkg/errors"
+)
+
+// OrderedMap implements the Map interface providing a generic way to work with map types
+// while preserving the insertion order of the keys. New keys are appended to the end while
+// updating an existing key keeps its position. Nested maps are stored as *OrderedMap and both
+// the JSON and YAML marshaling keep the key order, making it ideal for editing configuration
+// files without reshuffling them.
+type OrderedMap struct {
+	keys []string
+	m    map[string]interface{}
+}
+
+// NewOrderedMap converts the given interface{} into an *OrderedMap
+func NewOrderedMap(obj interface{}) *OrderedMap {
+	return ToOrderedMap(obj)
+}
+
+// NewOrderedMapV creates a new *OrderedMap from the given variadic key value pairs in the order
+// they are given e.g. NewOrderedMapV("b", 1, "a", 2). A trailing key without a value is set to nil.
+func NewOrderedMapV(pairs ...interface{}) *OrderedMap {
+	new := &OrderedMap{keys: []string{}, m: map[string]interface{}{}}
+	for i := 0; i < len(pairs); i += 2 {
+		var val interface{}
+		if i+1 < len(pairs) {
+			val = pairs[i+1]
+		}
+		new.Set(pairs[i], val)
+	}
+	return new
+}
+
+// Any tests if this Map is not empty or optionally if it contains any of the given variadic keys.
+func (p *OrderedMap) Any(keys ...interface{}) bool {
+	if p == nil || len(p.keys) == 0 {
+		return false
+	}
+	if len(keys) == 0 {
+		return true
+	}
+	for i := 0; i < len(keys); i++ {
+		if _, ok := p.m[ToString(keys[i])]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// AnyS tests if this Map contains any of the given Slice's elements as keys.
+func (p *OrderedMap) AnyS(keys interface{}) bool {
+	if p == nil || len(p.keys) == 0 {
+		return false
+	}
+	for _, key := range ToStrs(keys) {
+		if _, ok := p.m[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// AnyW tests if this Map contains any key-value pairs that match the lambda selector.
+func (p *OrderedMap) AnyW(sel func(k, v O) bool) bool {
+	return p.CountW(sel) != 0
+}
+
+// Clear modifies this Map to clear out all key-value pairs and returns a reference to this Map.
+func (p *OrderedMap) Clear() IMap {
+	if p == nil {
+		p = NewOrderedMapV()
+	} else if len(p.keys) > 0 {
+		*p = *NewOrderedMapV()
+	}
+	return p
+}
+
+// Copy returns a new Map with the indicated key-value pairs copied from this Map or all if not given.
+// The key order of this Map is kept when copying all keys otherwise the given key order is used.
+func (p *OrderedMap) Copy(keys ...interface{}) (new IMap) {
+	val := NewOrderedMapV()
+	if p == nil || len(p.keys) == 0 {
+		return val
+	}
+
+	// Copy target keys or all keys
+	ks := ToStrs(keys)
+	if len(ks) == 0 {
+		ks = p.keys
+	}
+	for _, k := range ks {
+		val.Set(k, p.m[k])
+	}
+	return val
+}
+
+// CountW counts the number of key-value pairs in this Map that match the lambda selector.
+func (p *OrderedMap) CountW(sel func(k, v O) bool) (cnt int) {
+	if p == nil || len(p.keys) == 0 {
+		return
+	}
+	for _, k := range p.keys {
+		if sel(k, p.m[k]) {
+			cnt++
+		}
+	}
+	return
+}
+
+// Delete modifies this Map to delete the indicated key-value pair and returns the value from the Map.
+func (p *OrderedMap) Delete(key interface{}) (val *Object) {
+	val = &Object{}
+	if p == nil {
+		return
+	}
+	k := ToString(key)
+	if v, ok := p.m[k]; ok {
+		val.o = v
+		delete(p.m, k)
+		for i := range p.keys {
+			if p.keys[i] == k {
+				p.keys = append(p.keys[:i], p.keys[i+1:]...)
+				break
+			}
+		}
+	}
+	return
+}
+
+// DeleteM modifies this Map to delete the indicated key-value pair and returns a reference to this Map rather than the key-value pair.
+func (p *OrderedMap) DeleteM(key interface{}) IMap {
+	if p == nil {
+		return p
+	}
+	p.Delete(key)
+	return p
+}
+
+// DeleteS modifies this Map to delete the indicated key-value pairs and returns the values from the Map as a Slice.
+func (p *OrderedMap) DeleteS(keys interface{}) (vals ISlice) {
+	vals = NewInterSliceV()
+	if p == nil || len(p.keys) == 0 {
+		return
+	}
+	for _, k := range ToStrs(keys) {
+		if v, ok := p.m[k]; ok {
+			vals.Append(v)
+			p.Delete(k)
+		}
+	}
+	return
+}
+
+// DeleteW modifies this Map to delete the key-value pairs that match the lambda selector and returns a reference to this Map.
+func (p *OrderedMap) DeleteW(sel func(k, v O) bool) IMap {
+	if p == nil {
+		return p
+	}
+	keys := []string{}
+	for _, k := range p.keys {
+		if sel(k, p.m[k]) {
+			delete(p.m, k)
+		} else {
+			keys = append(keys, k)
+		}
+	}
+	p.keys = keys
+	return p
+}
+
+// Each calls the given lambda once for each key-value pair in this Map in insertion order,
+// passing in the key and value as parameters; Returns a reference to this Map.
+func (p *OrderedMap) Each(action func(k, v O)) IMap {
+	if p == nil {
+		return p
+	}
+	for _, k := range append([]string{}, p.keys...) {
+		action(k, p.m[k])
+	}
+	return p
+}
+
+// EachE calls the given lambda once for each key-value pair in this Map in insertion order,
+// passing in the key and value as parameters; Returns a reference to this Map and any error from the lambda.
+func (p *OrderedMap) EachE(action func(k, v O) error) (IMap, error) {
+	var err error
+	if p == nil {
+		return p, err
+	}
+	for _, k := range append([]string{}, p.keys...) {
+		if err = action(k, p.m[k]); err != nil {
+			return p, err
+		}
+	}
+	return p, err
+}
+
+// EachR calls the given lambda once for each key-value pair in this Map in reverse insertion order,
+// passing in the key and value as parameters; Returns a reference to this Map.
+func (p *OrderedMap) EachR(action func(k, v O)) IMap {
+	if p == nil {
+		return p
+	}
+	keys := append([]string{}, p.keys...)
+	for i := len(keys) - 1; i >= 0; i-- {
+		action(keys[i], p.m[keys[i]])
+	}
+	return p
+}
+
+// EachRE calls the given lambda once for each key-value pair in this Map in reverse insertion order,
+// passing in the key and value as parameters; Returns a reference to this Map and any error from the lambda.
+func (p *OrderedMap) EachRE(action func(k, v O) error) (IMap, error) {
+	var err error
+	if p == nil {
+		return p, err
+	}
+	keys := append([]string{}, p.keys...)
+	for i := len(keys) - 1; i >= 0; i-- {
+		if err = action(keys[i], p.m[keys[i]]); err != nil {
+			return p, err
+		}
+	}
+	return p, err
+}
+
+// Empty tests if this Map is empty.
+func (p *OrderedMap) Empty() bool {
+	if p == nil || len(p.keys) == 0 {
+		return true
+	}
+	return false
+}
+
+// Exists checks if the given key exists in this Map.
+func (p *OrderedMap) Exists(key interface{}) bool {
+	if p == nil {
+		return false
+	}
+	_, ok := p.m[ToString(key)]
+	return ok
+}
+
+// G returns the underlying data structure as a Go type with nested *OrderedMap values converted
+// to map[string]interface{}. The key order is lost in the conversion.
+func (p *OrderedMap) G() map[string]interface{} {
+	return p.ToStringMapG()
+}
+
+// Generic returns true if the underlying implementation uses reflection
+func (p *OrderedMap) Generic() bool {
+	return false
+}
+
+// Get returns the value at the given key location. Returns empty *Object if not found.
+func (p *OrderedMap) Get(key interface{}) (val *Object) {
+	val = &Object{}
+	if p == nil {
+		return
+	}
+	if v, ok := p.m[ToString(key)]; ok {
+		val.o = v
+	}
+	return
+}
+
+// Inject sets the value for the given key location, using jq type selectors. Returns a reference to this Map.
+func (p *OrderedMap) Inject(key string, val interface{}) IMap {
+	m, _ := p.InjectE(key, val)
+	return m
+}
+
+// InjectE sets the value for the given key location, using jq type selectors. Missing maps are
+// created as *OrderedMap and appended to their parent. Returns a reference to this Map.
+func (p *OrderedMap) InjectE(key string, val interface{}) (m IMap, err error) {
+	if p == nil {
+		p = NewOrderedMapV()
+	}
+	m = p
+
+	// Process keys from left to right
+	var keys *StringSlice
+	if keys, err = KeysFromSelector(key); err != nil {
+		return
+	}
+
+	// Inject at root as no keys were given
+	if !keys.Any() {
+		x, e := ToOrderedMapE(val)
+		if e != nil {
+			err = errors.Errorf("invalid selector for the type of value given, '%T'", val)
+			m = nil
+			return
+		}
+		for _, k := range x.keys {
+			p.Set(k, x.m[k])
+		}
+		return
+	}
+
+	// Inject at given selector location
+	obj := interface{}(p)
+	for ko := keys.Shift(); !ko.Nil(); ko = keys.Shift() {
+		key := ko.A()
+
+		// All array selector is handled on previous loop
+		if key == "[]" {
+			break
+		}
+		drill := keys.Any() && keys.First().A() != "[]"
+
+		switch x := obj.(type) {
+
+		// Identifier Index: .foo, .foo.bar
+		case *OrderedMap:
+			if !drill {
+				x.Set(key, val)
+			} else if v, ok := x.m[key]; ok && orderedCont(v) {
+				obj = v
+			} else {
+				obj = NewOrderedMapV()
+				x.Set(key, obj)
+			}
+		case map[string]interface{}, *StringMap:
+			y := ToStringMap(x)
+			if !drill {
+				y.Set(key, val)
+			} else if v, ok := (*y)[key]; ok && orderedCont(v) {
+				obj = v
+			} else {
+				obj = NewOrderedMapV()
+				y.Set(key, obj)
+			}
+
+		// Array Index/Iterator: .[2], .[-1], .[], .[key==val]
+		case []interface{}:
+			var i int
+			if i, err = orderedIndex(x, key); err != nil {
+				m = nil
+				return
+			}
+			if i != -1 {
+				if keys.Any() {
+					obj = x[i]
+				} else {
+					x[i] = val
+				}
+			}
+		}
+	}
+	return
+}
+
+// Iter returns an Iterator over the key-value pairs of this Map in insertion order. Element will be a Pair.
+func (p *OrderedMap) Iter() (it Iterator) {
+	return newMapIter(p)
+}
+
+// Keys returns all the keys in this Map as a ISlice of the key type in insertion order.
+func (p *OrderedMap) Keys() ISlice {
+	keys := NewStringSliceV()
+	if p != nil {
+		*keys = append(*keys, p.keys...)
+	}
+	return keys
+}
+
+// Len returns the number of elements in this Map.
+func (p *OrderedMap) Len() int {
+	if p == nil {
+		return 0
+	}
+	return len(p.keys)
+}
+
+// M is an alias to ToStringMap
+func (p *OrderedMap) M() (m *StringMap) {
+	return p.ToStringMap()
+}
+
+// MG is an alias ToStringMapG
+func (p *OrderedMap) MG() (m map[string]interface{}) {
+	return p.ToStringMapG()
+}
+
+// MarshalJSON implements the json.Marshaler interface writing out the keys in order
+func (p *OrderedMap) MarshalJSON() (data []byte, err error) {
+	buf := &bytes.Buffer{}
+	buf.WriteByte('{')
+	if p != nil {
+		for i, k := range p.keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			var b []byte
+			if b, err = gojson.Marshal(k); err != nil {
+				return
+			}
+			buf.Write(b)
+			buf.WriteByte(':')
+			if b, err = gojson.Marshal(p.m[k]); err != nil {
+				err = errors.Wrapf(err, "failed to marshal value for key %s", k)
+				return
+			}
+			buf.Write(b)
+		}
+	}
+	buf.WriteByte('}')
+	data = buf.Bytes()
+	return
+}
+
+// MarshalYAML implements the yaml.Marshaler interface for yaml.MarshalOrdered writing out the keys in order
+func (p *OrderedMap) MarshalYAML() (interface{}, error) {
+	items := yaml.MapSlice{}
+	if p != nil {
+		for _, k := range p.keys {
+			items = append(items, yaml.MapItem{Key: k, Value: p.m[k]})
+		}
+	}
+	return items, nil
+}
+
+// Merge modifies this Map by overriding its values at location with the given map where they both exist and returns a reference to this Map.
+// Existing keys keep their position while new keys are appended in the order of the given map, converting all maps into *OrderedMap instances.
+func (p *OrderedMap) Merge(m IMap, location ...string) IMap {
+	p2 := p
+
+	// 1. Handle location if given
+	if len(location) > 0 {
+		if p2 == nil {
+			p2 = NewOrderedMapV()
+		}
+		if keys, err := KeysFromSelector(location[0]); err == nil {
+			for _, key := range keys.G() {
+				v, ok := p2.m[key]
+				x, e := ToOrderedMapE(v)
+				if !ok || e != nil || !x.Any() {
+					x = NewOrderedMapV()
+				}
+				p2.Set(key, x)
+				p2 = x
+			}
+		}
+	}
+
+	// 2. Merge at location
+	x, err := ToOrderedMapE(m)
+	switch {
+	case p2 == nil && (err != nil || m == nil):
+		return NewOrderedMapV()
+	case p2 == nil:
+		return x
+	case err != nil || m == nil:
+		return p2
+	}
+
+	for _, k := range x.keys {
+		bv := orderedValue(x.m[k])
+		if bc, ok := bv.(*OrderedMap); ok {
+			if av, exists := p2.m[k]; exists && orderedMap(av) {
+				// a and b both contain the key and are both submaps so recurse
+				p2.Set(k, ToOrderedMap(av).Merge(bc))
+				continue
+			}
+		}
+
+		// a doesn't have the key or isn't a map so just set b's value
+		p2.Set(k, bv)
+	}
+
+	if p == nil {
+		return p2
+	}
+	return p
+}
+
+// Nil tests if this Map is nil.
+func (p *OrderedMap) Nil() bool {
+	if p == nil {
+		return true
+	}
+	return false
+}
+
+// O returns the underlying data structure as a map[string]interface{} of the top level values
+// as is for interoperability with packages that don't understand the *OrderedMap type.
+func (p *OrderedMap) O() interface{} {
+	m := map[string]interface{}{}
+	if p != nil {
+		for k, v := range p.m {
+			m[k] = v
+		}
+	}
+	return m
+}
+
+// Query returns the value at the given key location, using a jq type selectors. Returns empty *Object if not found.
+// see dot notation from https://stedolan.github.io/jq/manual/#Basicfilters with some caveats
+func (p *OrderedMap) Query(key string) (val *Object) {
+	val, _ = p.QueryE(key)
+	return val
+}
+
+// QueryE returns the value at the given key location, using a jq type selectors. Returns empty *Object if not found.
+// see dot notation from https://stedolan.github.io/jq/manual/#Basicfilters with some caveats
+//
+// Simple key and index selectors return nested maps as *OrderedMap while full jq expressions are
+// evaluated with the jq language which returns nested maps as map[string]interface{}.
+func (p *OrderedMap) QueryE(key string) (val *Object, err error) {
+	if p == nil || len(p.keys) == 0 {
+		err = errors.Errorf("failed to query empty map")
+		return
+	}
+
+	// Hand off full jq expressions to the jq engine
+	if jqSelector(key) {
+		var results []interface{}
+		if results, err = jq.Eval(key, p); err != nil {
+			return
+		}
+		val = &Object{}
+		switch len(results) {
+		case 0:
+		case 1:
+			val.o = results[0]
+		default:
+			val.o = results
+		}
+		return
+	}
+
+	// Default object is self for identity case: .
+	val = &Object{o: p}
+
+	// Process keys from left to right
+	var keys *StringSlice
+	if keys, err = KeysFromSelector(key); err != nil {
+		return
+	}
+	for ko := keys.Shift(); !ko.Nil(); ko = keys.Shift() {
+		key := ko.A()
+
+		switch x := val.o.(type) {
+
+		// Identifier Index: .foo, .foo.bar
+		case *OrderedMap:
+			val.o = x.m[key]
+		case map[string]interface{}, *StringMap:
+			val.o = (*ToStringMap(x))[key]
+
+		// Array Index/Iterator: .[2], .[-1], .[], .[key==val]
+		case []interface{}:
+			var i int
+			if i, err = orderedIndex(x, key); err != nil {
+				val.o = nil
+				return
+			}
+			if i != -1 {
+				val.o = x[i]
+			}
+
+		// Scalar values can't be indexed into
+		default:
+			val.o = nil
+			return
+		}
+	}
+	return
+}
+
+// QueryS returns all values produced by the given jq expression as a Slice of the results.
+// Returns an empty Slice if nothing is found. see https://stedolan.github.io/jq/manual
+func (p *OrderedMap) QueryS(key string) (vals ISlice) {
+	vals, _ = p.QuerySE(key)
+	return
+}
+
+// QuerySE returns all values produced by the given jq expression as a Slice of the results.
+// Returns an empty Slice if nothing is found. Simple key and index selectors are supported
+// as with QueryE and will return at most a single value. see https://stedolan.github.io/jq/manual
+func (p *OrderedMap) QuerySE(key string) (vals ISlice, err error) {
+	slice := NewInterSliceV()
+	vals = slice
+	if p == nil || len(p.keys) == 0 {
+		err = errors.Errorf("failed to query empty map")
+		return
+	}
+
+	// Simple selectors resolve to at most a single value
+	if !jqSelector(key) {
+		var val *Object
+		if val, err = p.QueryE(key); err == nil && !val.Nil() {
+			slice.Append(val.o)
+		}
+		return
+	}
+
+	var results []interface{}
+	if results, err = jq.Eval(key, p); err != nil {
+		return
+	}
+	*slice = append(*slice, results...)
+	return
+}
+
+// Remove modifies this Map to delete the given key location, using jq type selectors
+// and returns a reference to this Map rather than the deleted value.
+// see dot notation from https://stedolan.github.io/jq/manual/#Basicfilters with some caveats
+func (p *OrderedMap) Remove(key string) IMap {
+	_, _ = p.RemoveE(key)
+	return p
+}
+
+// RemoveE modifies this Map to delete the given key location, using jq type selectors
+// and returns a reference to this Map rather than the deleted value. The remaining keys
+// keep their order. see dot notation from https://stedolan.github.io/jq/manual/#Basicfilters
+func (p *OrderedMap) RemoveE(key string) (m IMap, err error) {
+	if p == nil {
+		p = NewOrderedMapV()
+	}
+	m = p
+
+	var keys *StringSlice
+	if keys, err = KeysFromSelector(key); err != nil {
+		return
+	}
+	if _, err = removeOrdered(p, keys.G()); err != nil {
+		m = nil
+	}
+	return
+}
+
+// Reverse returns a new Map with the key-value pairs in reverse insertion order.
+func (p *OrderedMap) Reverse() (new IMap) {
+	new = p.Copy()
+	new.(*OrderedMap).ReverseM()
+	return
+}
+
+// ReverseM modifies this Map reversing the key order and returns a reference to this Map.
+func (p *OrderedMap) ReverseM() IMap {
+	if p == nil {
+		return p
+	}
+	for i, j := 0, len(p.keys)-1; i < j; i, j = i+1, j-1 {
+		p.keys[i], p.keys[j] = p.keys[j], p.keys[i]
+	}
+	return p
+}
+
+// Select returns a new Map with the key-value pairs that match the lambda selector keeping their order.
+func (p *OrderedMap) Select(sel func(k, v O) bool) (new IMap) {
+	val := NewOrderedMapV()
+	if p == nil {
+		return val
+	}
+	for _, k := range p.keys {
+		if sel(k, p.m[k]) {
+			val.Set(k, p.m[k])
+		}
+	}
+	return val
+}
+
+// Set the value for the given key to the given val. Returns true if the key did not yet exists in this Map.
+// New keys are appended to the end while existing keys keep their position.
+func (p *OrderedMap) Set(key, val interface{}) (new bool) {
+	if p == nil {
+		return
+	}
+	if p.m == nil {
+		p.m = map[string]interface{}{}
+	}
+	k := ToString(key)
+	if _, ok := p.m[k]; !ok {
+		new = true
+		p.keys = append(p.keys, k)
+	}
+	p.m[k] = val
+	return
+}
+
+// SetM the value for the given key to the given val creating map if necessary. Returns a reference to this Map.
+func (p *OrderedMap) SetM(key, val interface{}) IMap {
+	if p == nil {
+		p = NewOrderedMapV()
+	}
+	p.Set(key, val)
+	return p
+}
+
+// Single reports true if there is only one key-value pair in this Map.
+func (p *OrderedMap) Single() bool {
+	return p.Len() == 1
+}
+
+// Sort returns a new Map with the key-value pairs in sorted key order.
+func (p *OrderedMap) Sort() (new IMap) {
+	new = p.Copy()
+	new.(*OrderedMap).SortM()
+	return
+}
+
+// SortM modifies this Map sorting the keys and returns a reference to this Map.
+func (p *OrderedMap) SortM() IMap {
+	if p == nil {
+		return p
+	}
+	sort.Strings(p.keys)
+	return p
+}
+
+// String returns a string representation of this Map in key order, implements the Stringer interface
+func (p *OrderedMap) String() string {
+	builder := strings.Builder{}
+	builder.WriteString("&map[")
+	if p != nil {
+		for i, k := range p.keys {
+			if i > 0 {
+				builder.WriteString(" ")
+			}
+			builder.WriteString(fmt.Sprintf("%v:%v", k, p.m[k]))
+		}
+	}
+	builder.WriteString("]")
+	return builder.String()
+}
+
+// ToStringMap converts the map to a *StringMap converting nested *OrderedMap values as well.
+// The key order is lost in the conversion.
+func (p *OrderedMap) ToStringMap() (m *StringMap) {
+	new := StringMap(p.ToStringMapG())
+	return &new
+}
+
+// ToStringMapG converts the map to a Golang map[string]interface{} converting nested *OrderedMap
+// values as well. The key order is lost in the conversion.
+func (p *OrderedMap) ToStringMapG() (m map[string]interface{}) {
+	return unorderedValue(p).(map[string]interface{})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface keeping the keys in document order
+func (p *OrderedMap) UnmarshalJSON(data []byte) (err error) {
+	decoder := gojson.NewDecoder(bytes.NewReader(data))
+	var val interface{}
+	if val, err = decodeOrderedJSON(decoder); err != nil {
+		err = errors.Wrap(err, "failed to unmarshal json into OrderedMap")
+		return
+	}
+	switch x := val.(type) {
+	case nil:
+	case *OrderedMap:
+		*p = *x
+	default:
+		err = errors.Errorf("failed to unmarshal json type %T into OrderedMap", x)
+	}
+	return
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for yaml.UnmarshalOrdered keeping the keys in document order
+func (p *OrderedMap) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	items := yaml.MapSlice{}
+	if err = unmarshal(&items); err != nil {
+		return
+	}
+	*p = *orderedValue(items).(*OrderedMap)
+	return
+}
+
+// Values returns all the values in this Map as a ISlice in insertion order.
+func (p *OrderedMap) Values() ISlice {
+	vals := NewInterSliceV()
+	if p != nil {
+		for _, k := range p.keys {
+			*vals = append(*vals, p.m[k])
+		}
+	}
+	return vals
+}
+
+// YAML converts the Map into a YAML string keeping the key order
+func (p *OrderedMap) YAML() (data string) {
+	data, _ = p.YAMLE()
+	return
+}
+
+// YAMLE converts the Map into a YAML string keeping the key order
+func (p *OrderedMap) YAMLE() (data string, err error) {
+	if p == nil {
+		p = NewOrderedMapV()
+	}
+	var _data []byte
+	if _data, err = yaml.MarshalOrdered(p); err != nil {
+		err = errors.Wrapf(err, "failed to marshal OrderedMap")
+		return
+	}
+	data = string(_data)
+	return
+}
+
+// WriteJSON calls json.WriteJSON on the *OrderedMap to write it out to disk keeping the key order.
+func (p *OrderedMap) WriteJSON(filename string) (err error) {
+	return json.WriteJSON(filename, p)
+}
+
+// WriteYAML converts the *OrderedMap into a YAML string keeping the key order then writes it out to disk.
+func (p *OrderedMap) WriteYAML(filename string) (err error) {
+	var data string
+	if data, err = p.YAMLE(); err != nil {
+		return
+	}
+	if err = sys.WriteString(filename, data); err != nil {
+		err = errors.Wrapf(err, "failed to write out yaml data to file %s", filename)
+	}
+	return
+}
+
+// decodeOrderedJSON decodes the next JSON value from the given decoder converting objects into
+// *OrderedMap instances that keep their key order.
+func decodeOrderedJSON(decoder *gojson.Decoder) (val interface{}, err error) {
+	var token gojson.Token
+	if token, err = decoder.Token(); err != nil {
+		return
+	}
+
+	switch token {
+	case gojson.Delim('{'):
+		m := NewOrderedMapV()
+		for decoder.More() {
+			var key, elem interface{}
+			if key, err = decoder.Token(); err != nil {
+				return
+			}
+			if elem, err = decodeOrderedJSON(decoder); err != nil {
+				return
+			}
+			m.Set(key, elem)
+		}
+		val = m
+	case gojson.Delim('['):
+		s := []interface{}{}
+		for decoder.More() {
+			var elem interface{}
+			if elem, err = decodeOrderedJSON(decoder); err != nil {
+				return
+			}
+			s = append(s, elem)
+		}
+		val = s
+	default:
+		return token, nil
+	}
+
+	// Consume the closing delimiter
+	_, err = decoder.Token()
+	return
+}
+
+// orderedCont checks if the given value is a container that can be drilled into
+func orderedCont(obj interface{}) bool {
+	switch obj.(type) {
+	case *OrderedMap, map[string]interface{}, *StringMap, []interface{}:
+		return true
+	}
+	return false
+}
+
+// orderedMap checks if the given value is a map type that can be converted to an *OrderedMap
+func orderedMap(obj interface{}) bool {
+	switch obj.(type) {
+	case *OrderedMap, map[string]interface{}, *StringMap, yaml.MapSlice:
+		return true
+	}
+	return false
+}
+
+// orderedIndex resolves the given array selector e.g. [2], [-1], [], [key==val] for the given
+// slice returning -1 for the all selector or when no element matched the key value selector.
+func orderedIndex(x []interface{}, selector string) (i int, err error) {
+	var k, v string
+	if i, k, v, err = IdxFromSelector(selector, len(x)); err != nil {
+		return
+	}
+
+	// Select by key==value, e.g. .[k==v]
+	if k != "" && v != "" {
+		i = -1
+		for j := range x {
+			if hit := ToStringMap(x[j]).Get(k); !hit.Nil() && hit.A() == v {
+				i = j
+				break
+			}
+		}
+	}
+	return
+}
+
+// orderedValue converts all maps in the given value into *OrderedMap instances recursively.
+// Maps without an order of their own e.g. map[string]interface{} get their keys sorted.
+func orderedValue(obj interface{}) interface{} {
+	switch x := obj.(type) {
+	case *OrderedMap:
+		return x
+	case yaml.MapSlice:
+		m := NewOrderedMapV()
+		for _, item := range x {
+			m.Set(item.Key, orderedValue(item.Value))
+		}
+		return m
+	case map[string]interface{}, *StringMap, map[interface{}]interface{}:
+		y := ToStringMap(x)
+		keys := make([]string, 0, len(*y))
+		for k := range *y {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		m := NewOrderedMapV()
+		for _, k := range keys {
+			m.Set(k, orderedValue((*y)[k]))
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(x))
+		for i := range x {
+			s[i] = orderedValue(x[i])
+		}
+		return s
+	}
+	return obj
+}
+
+// unorderedValue converts all *OrderedMap instances in the given value into map[string]interface{}
+// recursively copying any maps along the way and leaving all other values as is.
+func unorderedValue(obj interface{}) interface{} {
+	switch x := obj.(type) {
+	case *OrderedMap:
+		m := map[string]interface{}{}
+		if x != nil {
+			for k, v := range x.m {
+				m[k] = unorderedValue(v)
+			}
+		}
+		return m
+	case map[string]interface{}:
+		m := map[string]interface{}{}
+		for k, v := range x {
+			m[k] = unorderedValue(v)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(x))
+		for i := range x {
+			s[i] = unorderedValue(x[i])
+		}
+		return s
+	}
+	return obj
+}
+
+// removeOrdered removes the value at the location of the given keys from the given container
+// returning the possibly new container.
+func removeOrdered(obj interface{}, keys []string) (result interface{}, err error) {
+	result = obj
+	if len(keys) == 0 {
+		return
+	}
+
+	switch x := obj.(type) {
+
+	// Identifier Index: .foo, .foo.bar
+	case *OrderedMap:
+		if v, ok := x.m[keys[0]]; ok {
+			if len(keys) == 1 {
+				x.Delete(keys[0])
+			} else if v, err = removeOrdered(v, keys[1:]); err == nil {
+				x.m[keys[0]] = v
+			}
+		}
+	case map[string]interface{}, *StringMap:
+		m := ToStringMap(x)
+		if v, ok := (*m)[keys[0]]; ok {
+			if len(keys) == 1 {
+				m.Delete(keys[0])
+			} else if v, err = removeOrdered(v, keys[1:]); err == nil {
+				(*m)[keys[0]] = v
+			}
+		}
+
+	// Array Index/Iterator: .[2], .[-1], .[], .[key==val]
+	case []interface{}:
+		var i int
+		if i, err = orderedIndex(x, keys[0]); err != nil {
+			return
+		}
+		switch {
+		case i == -1 && keys[0] != "[]":
+			// no element matched the key value selector
+		case i == -1 && len(keys) == 1:
+			result = []interface{}{}
+		case i == -1:
+			for j := range x {
+				if x[j], err = removeOrdered(x[j], keys[1:]); err != nil {
+					return
+				}
+			}
+		case len(keys) == 1:
+			result = append(x[:i:i], x[i+1:]...)
+		default:
+			x[i], err = removeOrdered(x[i], keys[1:])
+		}
+	}
+	return
+}
@@ -0,0 +1,618 @@
+package n
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"testing"
+
+	"github.com/phR0ze/n/pkg/sys"
+	"github.com/stretchr/testify/assert"
+)
+
+// NewOrderedMap
+//--------------------------------------------------------------------------------------------------
+func ExampleNewOrderedMap() {
+	m := NewOrderedMap("b: 1\na: 2\nc: {z: 1, x: 2}\n")
+	fmt.Println(m)
+	// Output: &map[b:1 a:2 c:&map[z:1 x:2]]
+}
+
+func TestNewOrderedMap(t *testing.T) {
+
+	// yaml and json strings keep their order
+	{
+		m := NewOrderedMap("b: 1\na: [1, {d: 1, c: 2}]\n")
+		assert.Equal(t, []string{"b", "a"}, m.Keys().ToStrs())
+		assert.Equal(t, "&map[b:1 a:[1 &map[d:1 c:2]]]", m.String())
+
+		m = NewOrderedMap([]byte(`{"z": 1, "y": {"x": 1, "w": 2}}`))
+		assert.Equal(t, "&map[z:1 y:&map[x:1 w:2]]", m.String())
+	}
+
+	// unordered maps get their keys sorted
+	{
+		m := NewOrderedMap(map[string]interface{}{"b": 1, "a": map[string]interface{}{"d": 1, "c": 2}})
+		assert.Equal(t, "&map[a:&map[c:2 d:1] b:1]", m.String())
+	}
+
+	// *OrderedMap is returned as is
+	{
+		m := NewOrderedMapV("a", 1)
+		assert.Equal(t, m, NewOrderedMap(m))
+	}
+
+	// invalid
+	{
+		m, err := ToOrderedMapE(1)
+		assert.Equal(t, NewOrderedMapV(), m)
+		assert.Equal(t, "unable to convert type *int to an OrderedMap", err.Error())
+
+		_, err = ToOrderedMapE("[1]")
+		assert.Contains(t, err.Error(), "failed to unmarshal bytes into OrderedMap")
+	}
+}
+
+// NewOrderedMapV
+//--------------------------------------------------------------------------------------------------
+func ExampleNewOrderedMapV() {
+	m := NewOrderedMapV("b", 1, "a", 2)
+	fmt.Println(m)
+	// Output: &map[b:1 a:2]
+}
+
+func TestNewOrderedMapV(t *testing.T) {
+	assert.Equal(t, "&map[]", NewOrderedMapV().String())
+	assert.Equal(t, "&map[b:1 a:2 c:<nil>]", NewOrderedMapV("b", 1, "a", 2, "c").String())
+	assert.Equal(t, "&map[1:2]", NewOrderedMapV(1, 2).String())
+}
+
+// Any
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_Any(t *testing.T) {
+	assert.False(t, (*OrderedMap)(nil).Any())
+	assert.False(t, NewOrderedMapV().Any())
+	assert.True(t, NewOrderedMapV("a", 1).Any())
+	assert.True(t, NewOrderedMapV("a", 1, "b", 2).Any("c", "b"))
+	assert.False(t, NewOrderedMapV("a", 1, "b", 2).Any("c"))
+}
+
+// AnyS
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_AnyS(t *testing.T) {
+	assert.False(t, (*OrderedMap)(nil).AnyS([]string{"a"}))
+	assert.True(t, NewOrderedMapV("a", 1, "b", 2).AnyS([]string{"c", "b"}))
+	assert.False(t, NewOrderedMapV("a", 1, "b", 2).AnyS([]string{"c"}))
+}
+
+// AnyW
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_AnyW(t *testing.T) {
+	assert.False(t, (*OrderedMap)(nil).AnyW(func(k, v O) bool { return true }))
+	assert.True(t, NewOrderedMapV("a", 1, "b", 2).AnyW(func(k, v O) bool { return v == 2 }))
+	assert.False(t, NewOrderedMapV("a", 1, "b", 2).AnyW(func(k, v O) bool { return v == 3 }))
+}
+
+// Clear
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_Clear(t *testing.T) {
+	assert.Equal(t, NewOrderedMapV(), (*OrderedMap)(nil).Clear())
+
+	m := NewOrderedMapV("a", 1, "b", 2)
+	assert.Equal(t, NewOrderedMapV(), m.Clear())
+	assert.Equal(t, NewOrderedMapV(), m)
+	m.Set("c", 1)
+	assert.Equal(t, []string{"c"}, m.Keys().ToStrs())
+}
+
+// Copy
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_Copy(t *testing.T) {
+	assert.Equal(t, NewOrderedMapV(), (*OrderedMap)(nil).Copy())
+
+	m := NewOrderedMapV("b", 1, "a", 2, "c", 3)
+	cp := m.Copy()
+	assert.Equal(t, m, cp)
+	cp.Set("b", 5)
+	assert.Equal(t, 1, m.Get("b").O())
+
+	assert.Equal(t, NewOrderedMapV("c", 3, "b", 1), m.Copy("c", "b"))
+}
+
+// CountW
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_CountW(t *testing.T) {
+	assert.Equal(t, 0, (*OrderedMap)(nil).CountW(func(k, v O) bool { return true }))
+	assert.Equal(t, 2, NewOrderedMapV("a", 1, "b", 2, "c", 3).CountW(func(k, v O) bool { return v.(int) > 1 }))
+}
+
+// Delete
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_Delete(t *testing.T) {
+	assert.Equal(t, &Object{}, (*OrderedMap)(nil).Delete("a"))
+
+	m := NewOrderedMapV("b", 1, "a", 2, "c", 3)
+	assert.Equal(t, 2, m.Delete("a").O())
+	assert.Equal(t, &Object{}, m.Delete("a"))
+	assert.Equal(t, "&map[b:1 c:3]", m.String())
+
+	// new keys are appended after a delete
+	m.Set("a", 4)
+	assert.Equal(t, "&map[b:1 c:3 a:4]", m.DeleteM("x").(*OrderedMap).String())
+	assert.Equal(t, "&map[c:3 a:4]", m.DeleteM("b").(*OrderedMap).String())
+}
+
+// DeleteS
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_DeleteS(t *testing.T) {
+	assert.Equal(t, NewInterSliceV(), (*OrderedMap)(nil).DeleteS([]string{"a"}))
+
+	m := NewOrderedMapV("b", 1, "a", 2, "c", 3)
+	assert.Equal(t, NewInterSliceV(3, 1), m.DeleteS([]string{"c", "x", "b"}))
+	assert.Equal(t, "&map[a:2]", m.String())
+}
+
+// DeleteW
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_DeleteW(t *testing.T) {
+	assert.Equal(t, (*OrderedMap)(nil), (*OrderedMap)(nil).DeleteW(func(k, v O) bool { return true }))
+
+	m := NewOrderedMapV("d", 1, "b", 2, "a", 3, "c", 4)
+	assert.Equal(t, m, m.DeleteW(func(k, v O) bool { return v.(int)%2 == 0 }))
+	assert.Equal(t, "&map[d:1 a:3]", m.String())
+	m.Set("b", 5)
+	assert.Equal(t, []string{"d", "a", "b"}, m.Keys().ToStrs())
+}
+
+// Each
+//--------------------------------------------------------------------------------------------------
+func ExampleOrderedMap_Each() {
+	m := NewOrderedMapV("b", 1, "a", 2)
+	m.Each(func(k, v O) {
+		fmt.Printf("%v:%v\n", k, v)
+	})
+	// Output: b:1
+	// a:2
+}
+
+func TestOrderedMap_Each(t *testing.T) {
+	(*OrderedMap)(nil).Each(func(k, v O) { assert.Fail(t, "shouldn't be called") })
+
+	// insertion order and deleting while iterating
+	{
+		keys := []string{}
+		m := NewOrderedMapV("c", 1, "a", 2, "b", 3)
+		m.Each(func(k, v O) {
+			keys = append(keys, k.(string))
+			m.Delete(k)
+		})
+		assert.Equal(t, []string{"c", "a", "b"}, keys)
+		assert.Equal(t, 0, m.Len())
+	}
+
+	// reverse
+	{
+		keys := []string{}
+		NewOrderedMapV("c", 1, "a", 2, "b", 3).EachR(func(k, v O) { keys = append(keys, k.(string)) })
+		assert.Equal(t, []string{"b", "a", "c"}, keys)
+	}
+
+	// errors
+	{
+		keys := []string{}
+		m := NewOrderedMapV("c", 1, "a", 2, "b", 3)
+		_, err := m.EachE(func(k, v O) error {
+			keys = append(keys, k.(string))
+			return Break
+		})
+		assert.Equal(t, Break, err)
+		_, err = m.EachRE(func(k, v O) error {
+			keys = append(keys, k.(string))
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"c", "b", "a", "c"}, keys)
+	}
+}
+
+// Empty
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_Empty(t *testing.T) {
+	assert.True(t, (*OrderedMap)(nil).Empty())
+	assert.True(t, NewOrderedMapV().Empty())
+	assert.False(t, NewOrderedMapV("a", 1).Empty())
+	assert.True(t, (*OrderedMap)(nil).Nil())
+	assert.False(t, NewOrderedMapV().Nil())
+}
+
+// Exists
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_Exists(t *testing.T) {
+	assert.False(t, (*OrderedMap)(nil).Exists("a"))
+	assert.True(t, NewOrderedMapV("a", nil).Exists("a"))
+	assert.False(t, NewOrderedMapV("a", nil).Exists("b"))
+}
+
+// Get
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_Get(t *testing.T) {
+	assert.Equal(t, &Object{}, (*OrderedMap)(nil).Get("a"))
+	assert.Equal(t, &Object{}, NewOrderedMapV().Get("a"))
+	assert.Equal(t, 1, NewOrderedMapV("a", 1).Get("a").O())
+}
+
+// Inject
+//--------------------------------------------------------------------------------------------------
+func ExampleOrderedMap_Inject() {
+	m := NewOrderedMap("z: 1\nw: {b: 1}\n")
+	m.Inject("w.a", 2)
+	m.Inject("x.c", 3)
+	fmt.Println(m)
+	// Output: &map[z:1 w:&map[b:1 a:2] x:&map[c:3]]
+}
+
+func TestOrderedMap_Inject(t *testing.T) {
+
+	// nil
+	{
+		assert.Equal(t, NewOrderedMapV("a", 1), (*OrderedMap)(nil).Inject("a", 1))
+	}
+
+	// root
+	{
+		m := NewOrderedMapV("b", 1)
+		m.Inject(".", "c: 1\na: 2\n")
+		assert.Equal(t, "&map[b:1 c:1 a:2]", m.String())
+
+		_, err := m.InjectE(".", 1)
+		assert.Equal(t, "invalid selector for the type of value given, 'int'", err.Error())
+	}
+
+	// replace non map values and create missing maps
+	{
+		m := NewOrderedMap("a: 1\nb: 2\n")
+		m.Inject("a.c.d", 1)
+		assert.Equal(t, "&map[a:&map[c:&map[d:1]] b:2]", m.String())
+	}
+
+	// arrays
+	{
+		m := NewOrderedMap("a: [{name: one, v: 1}, {name: two, v: 2}]\n")
+		m.Inject("a.[1].v", 3)
+		m.Inject("a.[name==one].v", 4)
+		m.Inject("a.[0]", []interface{}{1})
+		assert.Equal(t, "&map[a:[[1] &map[name:two v:3]]]", m.String())
+
+		_, err := m.InjectE("a.[5]", 1)
+		assert.Equal(t, "invalid array index 5", err.Error())
+	}
+
+	// plain maps within the ordered map
+	{
+		m := NewOrderedMapV("a", map[string]interface{}{"b": 1})
+		m.Inject("a.c.d", 2)
+		assert.Equal(t, map[string]interface{}{"b": 1, "c": map[string]interface{}{"d": 2}}, m.G()["a"])
+	}
+}
+
+// Iter
+//--------------------------------------------------------------------------------------------------
+func ExampleOrderedMap_Iter() {
+	it := NewOrderedMapV("b", 2, "a", 1).Iter()
+	for it.Next() {
+		fmt.Println(it.Index(), it.Value())
+	}
+	// Output:
+	// 0 {b 2}
+	// 1 {a 1}
+}
+
+func TestOrderedMap_Iter(t *testing.T) {
+	assert.False(t, (*OrderedMap)(nil).Iter().Next())
+	assert.False(t, NewOrderedMapV().Iter().Next())
+
+	it := NewOrderedMapV("b", 2, "a", 1).Iter()
+	assert.True(t, it.Next())
+	assert.Equal(t, 0, it.Index())
+	assert.Equal(t, Pair{Key: "b", Val: 2}, it.Value())
+	assert.True(t, it.Next())
+	assert.Equal(t, 1, it.Index())
+	assert.Equal(t, Pair{Key: "a", Val: 1}, it.Value())
+	assert.False(t, it.Next())
+	assert.Nil(t, it.Err())
+}
+
+// Keys
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_Keys(t *testing.T) {
+	assert.Equal(t, NewStringSliceV(), (*OrderedMap)(nil).Keys())
+
+	m := NewOrderedMapV("c", 1, "a", 2, "b", 3)
+	assert.Equal(t, NewStringSliceV("c", "a", "b"), m.Keys())
+
+	// keys are a copy
+	m.Keys().(*StringSlice).Set(0, "x")
+	assert.Equal(t, NewStringSliceV("c", "a", "b"), m.Keys())
+}
+
+// Len
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_Len(t *testing.T) {
+	assert.Equal(t, 0, (*OrderedMap)(nil).Len())
+	assert.Equal(t, 2, NewOrderedMapV("a", 1, "b", 2).Len())
+}
+
+// MarshalJSON
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_MarshalJSON(t *testing.T) {
+
+	// round trip
+	{
+		data := `{"z":1,"a":{"y":[1,{"c":"x","b":null}],"x":true},"m":"foo"}`
+		m := NewOrderedMapV()
+		assert.Nil(t, json.Unmarshal([]byte(data), m))
+		assert.Equal(t, []string{"z", "a", "m"}, m.Keys().ToStrs())
+		result, err := json.Marshal(m)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"z":1,"a":{"y":[1,{"c":"x","b":null}],"x":true},"m":"foo"}`, string(result))
+	}
+
+	// nested within other types
+	{
+		result, err := json.Marshal(map[string]interface{}{"a": NewOrderedMapV("c", 1, "b", 2)})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"a":{"c":1,"b":2}}`, string(result))
+	}
+
+	// null and invalid
+	{
+		m := NewOrderedMapV("a", 1)
+		assert.Nil(t, json.Unmarshal([]byte("null"), m))
+		assert.Equal(t, NewOrderedMapV("a", 1), m)
+		assert.Equal(t, "failed to unmarshal json type string into OrderedMap", json.Unmarshal([]byte(`"a"`), m).Error())
+		assert.NotNil(t, json.Unmarshal([]byte(`{"a": }`), m))
+
+		_, err := json.Marshal(NewOrderedMapV("a", make(chan int)))
+		assert.Contains(t, err.Error(), "failed to marshal value for key a")
+	}
+}
+
+// Merge
+//--------------------------------------------------------------------------------------------------
+func ExampleOrderedMap_Merge() {
+	m := NewOrderedMap("b: 1\na: {d: 1, c: 1}\n")
+	m.Merge(NewOrderedMap("e: 2\na: {f: 2, c: 2}\n"))
+	fmt.Println(m)
+	// Output: &map[b:1 a:&map[d:1 c:2 f:2] e:2]
+}
+
+func TestOrderedMap_Merge(t *testing.T) {
+
+	// nil and empty
+	{
+		assert.Equal(t, NewOrderedMapV(), (*OrderedMap)(nil).Merge(nil))
+		assert.Equal(t, NewOrderedMapV("a", 1), (*OrderedMap)(nil).Merge(NewOrderedMapV("a", 1)))
+		assert.Equal(t, NewOrderedMapV("a", 1), NewOrderedMapV("a", 1).Merge(nil))
+	}
+
+	// maps replace non maps and unordered maps are sorted
+	{
+		m := NewOrderedMapV("b", 1, "a", 2)
+		m.Merge(NewStringMapV(map[string]interface{}{"b": map[string]interface{}{"z": 1, "y": 2}, "c": 3}))
+		assert.Equal(t, "&map[b:&map[y:2 z:1] a:2 c:3]", m.String())
+	}
+
+	// location
+	{
+		m := NewOrderedMapV("b", 1)
+		m.Merge(NewOrderedMapV("d", 1), "a.c")
+		m.Merge(NewOrderedMapV("e", 1), "a.c")
+		assert.Equal(t, "&map[b:1 a:&map[c:&map[d:1 e:1]]]", m.String())
+	}
+}
+
+// Query
+//--------------------------------------------------------------------------------------------------
+func ExampleOrderedMap_Query() {
+	m := NewOrderedMap("servers:\n  - {name: one, port: 80}\n  - {name: two, port: 443}\n")
+	fmt.Println(m.Query("servers.[name==two].port"))
+	// Output: 443
+}
+
+func TestOrderedMap_Query(t *testing.T) {
+	m := NewOrderedMap("a: {c: 1, b: [1, {d: 2}]}\ne: foo\n")
+
+	// empty
+	{
+		_, err := (*OrderedMap)(nil).QueryE("a")
+		assert.Equal(t, "failed to query empty map", err.Error())
+		assert.Nil(t, NewOrderedMapV().Query("a"))
+	}
+
+	// simple selectors
+	{
+		assert.Equal(t, m, m.Query(".").O())
+		assert.Equal(t, "&map[c:1 b:[1 &map[d:2]]]", m.Query("a").ToOrderedMap().String())
+		assert.Equal(t, 2, m.Query("a.b.[1].d").O())
+		assert.Equal(t, 1, m.Query("a.b.[-2]").O())
+		assert.Equal(t, nil, m.Query("a.x").O())
+		assert.Equal(t, nil, m.Query("e.x").O())
+
+		_, err := m.QueryE("a.b.[5]")
+		assert.Equal(t, "invalid array index 5", err.Error())
+	}
+
+	// jq expressions
+	{
+		assert.Equal(t, []interface{}{1, 2}, m.Query("[.a.c, .a.b[1].d]").O())
+		assert.Equal(t, []interface{}{"b", "c"}, m.Query(".a | keys").O())
+		assert.Equal(t, []interface{}{1, 2}, m.QueryS(".a.b[] | if type == \"object\" then .d else . end").O())
+		assert.Equal(t, []interface{}{"foo"}, m.QueryS("e").O())
+		assert.Equal(t, []interface{}{}, NewOrderedMapV().QueryS("e").O())
+	}
+}
+
+// Remove
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_Remove(t *testing.T) {
+
+	// nil
+	{
+		m, err := (*OrderedMap)(nil).RemoveE("a")
+		assert.Nil(t, err)
+		assert.Equal(t, NewOrderedMapV(), m)
+	}
+
+	// keys keep their order
+	{
+		m := NewOrderedMap("c: 1\nb: {z: 1, w: 2, x: 3}\na: 3\n")
+		m.Remove("b.w")
+		m.Remove("c")
+		m.Remove("bogus.foo")
+		assert.Equal(t, "&map[b:&map[z:1 x:3] a:3]", m.String())
+	}
+
+	// arrays
+	{
+		m := NewOrderedMap("a: [{name: one, v: 1}, {name: two, v: 2}, {name: three, v: 3}]\n")
+		m.Remove("a.[name==two]")
+		m.Remove("a.[name==bogus]")
+		m.Remove("a.[0].v")
+		assert.Equal(t, "&map[a:[&map[name:one] &map[name:three v:3]]]", m.String())
+		m.Remove("a.[].name")
+		assert.Equal(t, "&map[a:[&map[] &map[v:3]]]", m.String())
+		m.Remove("a.[]")
+		assert.Equal(t, "&map[a:[]]", m.String())
+
+		_, err := m.RemoveE("a.[1]")
+		assert.Equal(t, "invalid array index 1", err.Error())
+	}
+}
+
+// Reverse
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_Reverse(t *testing.T) {
+	assert.Equal(t, NewOrderedMapV(), (*OrderedMap)(nil).Reverse())
+	assert.Equal(t, (*OrderedMap)(nil), (*OrderedMap)(nil).ReverseM())
+
+	m := NewOrderedMapV("d", 1, "b", 2, "a", 3)
+	assert.Equal(t, NewOrderedMapV("a", 3, "b", 2, "d", 1), m.Reverse())
+	assert.Equal(t, []string{"d", "b", "a"}, m.Keys().O())
+	assert.Equal(t, NewOrderedMapV("a", 3, "b", 2, "d", 1), m.ReverseM())
+	assert.Equal(t, []string{"a", "b", "d"}, m.Keys().O())
+}
+
+// Select
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_Select(t *testing.T) {
+	assert.Equal(t, NewOrderedMapV(), (*OrderedMap)(nil).Select(func(k, v O) bool { return true }))
+
+	m := NewOrderedMapV("d", 1, "b", 2, "a", 3, "c", 4)
+	assert.Equal(t, NewOrderedMapV("d", 1, "a", 3), m.Select(func(k, v O) bool { return v.(int)%2 == 1 }))
+	assert.Equal(t, 4, m.Len())
+	assert.True(t, NewOrderedMapV("a", 1).Single())
+	assert.False(t, m.Single())
+}
+
+// Set
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_Set(t *testing.T) {
+	assert.False(t, (*OrderedMap)(nil).Set("a", 1))
+	assert.Equal(t, NewOrderedMapV("a", 1), (*OrderedMap)(nil).SetM("a", 1))
+
+	m := NewOrderedMapV()
+	assert.True(t, m.Set("b", 1))
+	assert.True(t, m.Set("a", 2))
+	assert.False(t, m.Set("b", 3))
+	assert.Equal(t, "&map[b:3 a:2]", m.String())
+
+	// zero value is usable
+	var x OrderedMap
+	x.Set("a", 1)
+	assert.Equal(t, "&map[a:1]", x.String())
+}
+
+// Sort
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_Sort(t *testing.T) {
+	assert.Equal(t, NewOrderedMapV(), (*OrderedMap)(nil).Sort())
+	assert.Equal(t, (*OrderedMap)(nil), (*OrderedMap)(nil).SortM())
+
+	m := NewOrderedMapV("d", 1, "b", 2, "a", 3)
+	assert.Equal(t, NewOrderedMapV("a", 3, "b", 2, "d", 1), m.Sort())
+	assert.Equal(t, []string{"d", "b", "a"}, m.Keys().O())
+	assert.Equal(t, NewOrderedMapV("a", 3, "b", 2, "d", 1), m.SortM())
+	assert.Equal(t, []string{"a", "b", "d"}, m.Keys().O())
+	assert.Equal(t, "&map[d:1 b:2 a:3]", m.Sort().Reverse().String())
+}
+
+// ToStringMap
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_ToStringMap(t *testing.T) {
+	m := NewOrderedMap("b: 1\na: [{c: 2}]\n")
+	expected := map[string]interface{}{"b": 1, "a": []interface{}{map[string]interface{}{"c": 2}}}
+	assert.Equal(t, expected, m.G())
+	assert.Equal(t, expected, m.MG())
+	assert.Equal(t, NewStringMapV(expected), m.M())
+	assert.Equal(t, NewStringMapV(expected), ToStringMap(m))
+	assert.Equal(t, map[string]interface{}{}, (*OrderedMap)(nil).ToStringMapG())
+}
+
+// Values
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_Values(t *testing.T) {
+	assert.Equal(t, NewInterSliceV(), (*OrderedMap)(nil).Values())
+	assert.Equal(t, NewInterSliceV(3, 1, 2), NewOrderedMapV("c", 3, "a", 1, "b", 2).Values())
+}
+
+// YAML
+//--------------------------------------------------------------------------------------------------
+func ExampleOrderedMap_YAML() {
+	m := NewOrderedMap("name: foo\nversion: 1\ndeps: {zlib: 1.2, acl: 2.0}\n")
+	fmt.Print(m.YAML())
+	// Output:
+	// name: foo
+	// version: 1
+	// deps:
+	//   zlib: 1.2
+	//   acl: 2
+}
+
+func TestOrderedMap_YAML(t *testing.T) {
+	assert.Equal(t, "{}\n", (*OrderedMap)(nil).YAML())
+
+	data := "z: 1\na:\n- c: 1\n  b: 2\nm: foo\n"
+	m := NewOrderedMap(data)
+	assert.Equal(t, data, m.YAML())
+
+	_, err := NewOrderedMapV("a", func() {}).YAMLE()
+	assert.NotNil(t, err)
+}
+
+// WriteJSON
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_WriteJSON(t *testing.T) {
+	clearTmpDir()
+	filename := path.Join(tmpDir, "ordered.json")
+
+	m := NewOrderedMapV("b", 1, "a", NewOrderedMapV("d", 1, "c", 2))
+	assert.Nil(t, m.WriteJSON(filename))
+	data, err := sys.ReadString(filename)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\n  \"b\": 1,\n  \"a\": {\n    \"d\": 1,\n    \"c\": 2\n  }\n}", data)
+	assert.Equal(t, "&map[b:1 a:&map[d:1 c:2]]", LoadOrderedJSON(filename).String())
+}
+
+// WriteYAML
+//--------------------------------------------------------------------------------------------------
+func TestOrderedMap_WriteYAML(t *testing.T) {
+	clearTmpDir()
+	filename := path.Join(tmpDir, "ordered.yaml")
+
+	m := NewOrderedMapV("b", 1, "a", NewOrderedMapV("d", 1, "c", 2))
+	assert.Nil(t, m.WriteYAML(filename))
+	data, err := sys.ReadString(filename)
+	assert.Nil(t, err)
+	assert.Equal(t, "b: 1\na:\n  d: 1\n  c: 2\n", data)
+	assert.Equal(t, m, LoadOrderedYAML(filename))
+}
@@ -0,0 +1,618 @@
+package n
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/phR0ze/n/pkg/enc/json"
+	"github.com/phR0ze/n/pkg/enc/yaml"
+	"github.com/pkg/errors"
+)
+
+// RefMap implements the Map interface providing a generic way to work with map types
+// including convenience methods on par with rapid development languages. This type
+// incurs the typical 10x reflection overhead costs. For high performance use the Map
+// implementation matching the type your working with or implement a new type that satisfies
+// the Map interface. Iteration is done in sorted key order.
+type RefMap struct {
+	v *reflect.Value
+}
+
+// NewRefMap uses reflection to encapsulate the given Go map type inside a new *RefMap.
+// Expects a Go map type or a pointer to one and will create an empty map[interface{}]interface{}
+// backed *RefMap if nothing valid is given. Keys and values are converted to the map's types
+// using the To conversion functions for the basic kinds e.g. a "1" key for a map[int]string.
+func NewRefMap(obj interface{}) (new *RefMap) {
+	if x, ok := obj.(*RefMap); ok && x != nil {
+		return x
+	}
+	new = &RefMap{}
+	v := reflect.Indirect(reflect.ValueOf(obj))
+	switch {
+	case v.Kind() != reflect.Map:
+		v = reflect.ValueOf(map[interface{}]interface{}{})
+	case v.IsNil():
+		v = reflect.MakeMap(v.Type())
+	}
+	new.v = &v
+	return
+}
+
+// Any tests if this Map is not empty or optionally if it contains any of the given variadic keys.
+func (p *RefMap) Any(keys ...interface{}) bool {
+	if p.Len() == 0 {
+		return false
+	}
+	if len(keys) == 0 {
+		return true
+	}
+	for i := 0; i < len(keys); i++ {
+		if k, err := refMapValue(keys[i], p.v.Type().Key()); err == nil {
+			if p.v.MapIndex(k).IsValid() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AnyS tests if this Map contains any of the given Slice's elements as keys.
+func (p *RefMap) AnyS(keys interface{}) bool {
+	if p.Len() == 0 {
+		return false
+	}
+	x := ToInterSlice(keys)
+	if x.Len() == 0 {
+		return false
+	}
+	return p.Any(*x...)
+}
+
+// AnyW tests if this Map contains any key-value pairs that match the lambda selector.
+func (p *RefMap) AnyW(sel func(k, v O) bool) bool {
+	return p.CountW(sel) != 0
+}
+
+// Clear modifies this Map to clear out all key-value pairs and returns a reference to this Map.
+func (p *RefMap) Clear() IMap {
+	if p.Nil() {
+		if p == nil {
+			p = NewRefMap(nil)
+		} else {
+			*p = *NewRefMap(nil)
+		}
+	} else if p.Len() > 0 {
+		*p.v = reflect.MakeMap(p.v.Type())
+	}
+	return p
+}
+
+// Copy returns a new Map with the indicated key-value pairs copied from this Map or all if not given.
+func (p *RefMap) Copy(keys ...interface{}) (new IMap) {
+	if p.Nil() {
+		return NewRefMap(nil)
+	}
+	v := reflect.MakeMap(p.v.Type())
+	val := &RefMap{v: &v}
+
+	// Copy target keys or all keys
+	if len(keys) == 0 {
+		for _, k := range p.v.MapKeys() {
+			v.SetMapIndex(k, p.v.MapIndex(k))
+		}
+	} else {
+		for i := range keys {
+			if k, err := refMapValue(keys[i], p.v.Type().Key()); err == nil {
+				if x := p.v.MapIndex(k); x.IsValid() {
+					v.SetMapIndex(k, x)
+				}
+			}
+		}
+	}
+	return val
+}
+
+// CountW counts the number of key-value pairs in this Map that match the lambda selector.
+func (p *RefMap) CountW(sel func(k, v O) bool) (cnt int) {
+	if p.Len() == 0 {
+		return
+	}
+	for _, k := range p.v.MapKeys() {
+		if sel(k.Interface(), p.v.MapIndex(k).Interface()) {
+			cnt++
+		}
+	}
+	return
+}
+
+// Delete modifies this Map to delete the indicated key-value pair and returns the value from the Map.
+func (p *RefMap) Delete(key interface{}) (val *Object) {
+	val = &Object{}
+	if p.Nil() {
+		return
+	}
+	if k, err := refMapValue(key, p.v.Type().Key()); err == nil {
+		if x := p.v.MapIndex(k); x.IsValid() {
+			val.o = x.Interface()
+			p.v.SetMapIndex(k, reflect.Value{})
+		}
+	}
+	return
+}
+
+// DeleteM modifies this Map to delete the indicated key-value pair and returns a reference to this Map rather than the key-value pair.
+func (p *RefMap) DeleteM(key interface{}) IMap {
+	if p == nil {
+		return p
+	}
+	p.Delete(key)
+	return p
+}
+
+// DeleteS modifies this Map to delete the indicated key-value pairs and returns the values from the Map as a Slice.
+func (p *RefMap) DeleteS(keys interface{}) (vals ISlice) {
+	vals = NewInterSliceV()
+	if p.Len() == 0 {
+		return
+	}
+	for _, key := range *ToInterSlice(keys) {
+		if k, err := refMapValue(key, p.v.Type().Key()); err == nil {
+			if x := p.v.MapIndex(k); x.IsValid() {
+				vals.Append(x.Interface())
+				p.v.SetMapIndex(k, reflect.Value{})
+			}
+		}
+	}
+	return
+}
+
+// DeleteW modifies this Map to delete the key-value pairs that match the lambda selector and returns a reference to this Map.
+func (p *RefMap) DeleteW(sel func(k, v O) bool) IMap {
+	if p.Nil() {
+		return p
+	}
+	for _, k := range p.v.MapKeys() {
+		if sel(k.Interface(), p.v.MapIndex(k).Interface()) {
+			p.v.SetMapIndex(k, reflect.Value{})
+		}
+	}
+	return p
+}
+
+// Each calls the given lambda once for each key-value pair in this Map in sorted key order,
+// passing in the key and value as parameters; Returns a reference to this Map.
+func (p *RefMap) Each(action func(k, v O)) IMap {
+	if p.Nil() {
+		return p
+	}
+	for _, k := range refMapKeys(*p.v) {
+		action(k.Interface(), p.v.MapIndex(k).Interface())
+	}
+	return p
+}
+
+// EachE calls the given lambda once for each key-value pair in this Map in sorted key order,
+// passing in the key and value as parameters; Returns a reference to this Map and any error from the lambda.
+func (p *RefMap) EachE(action func(k, v O) error) (IMap, error) {
+	var err error
+	if p.Nil() {
+		return p, err
+	}
+	for _, k := range refMapKeys(*p.v) {
+		if err = action(k.Interface(), p.v.MapIndex(k).Interface()); err != nil {
+			return p, err
+		}
+	}
+	return p, err
+}
+
+// EachR calls the given lambda once for each key-value pair in this Map in reverse sorted key order,
+// passing in the key and value as parameters; Returns a reference to this Map.
+func (p *RefMap) EachR(action func(k, v O)) IMap {
+	if p.Nil() {
+		return p
+	}
+	keys := refMapKeys(*p.v)
+	for i := len(keys) - 1; i >= 0; i-- {
+		action(keys[i].Interface(), p.v.MapIndex(keys[i]).Interface())
+	}
+	return p
+}
+
+// EachRE calls the given lambda once for each key-value pair in this Map in reverse sorted key order,
+// passing in the key and value as parameters; Returns a reference to this Map and any error from the lambda.
+func (p *RefMap) EachRE(action func(k, v O) error) (IMap, error) {
+	var err error
+	if p.Nil() {
+		return p, err
+	}
+	keys := refMapKeys(*p.v)
+	for i := len(keys) - 1; i >= 0; i-- {
+		if err = action(keys[i].Interface(), p.v.MapIndex(keys[i]).Interface()); err != nil {
+			return p, err
+		}
+	}
+	return p, err
+}
+
+// Empty tests if this Map is empty.
+func (p *RefMap) Empty() bool {
+	return p.Len() == 0
+}
+
+// Exists checks if the given key exists in this Map.
+func (p *RefMap) Exists(key interface{}) bool {
+	if p.Nil() {
+		return false
+	}
+	if k, err := refMapValue(key, p.v.Type().Key()); err == nil {
+		return p.v.MapIndex(k).IsValid()
+	}
+	return false
+}
+
+// Generic returns true if the underlying implementation uses reflection
+func (p *RefMap) Generic() bool {
+	return true
+}
+
+// Get returns the value at the given key location. Returns empty *Object if not found.
+func (p *RefMap) Get(key interface{}) (val *Object) {
+	val = &Object{}
+	if p.Nil() {
+		return
+	}
+	if k, err := refMapValue(key, p.v.Type().Key()); err == nil {
+		if x := p.v.MapIndex(k); x.IsValid() {
+			val.o = x.Interface()
+		}
+	}
+	return
+}
+
+// Inject sets the value for the given key location, using jq type selectors. Returns a reference to this Map.
+// Only a single key is supported as nested values are not navigated by this Map.
+func (p *RefMap) Inject(key string, val interface{}) IMap {
+	m, _ := p.InjectE(key, val)
+	return m
+}
+
+// InjectE sets the value for the given key location, using jq type selectors. Returns a reference to this Map.
+// Only a single key is supported as nested values are not navigated by this Map.
+func (p *RefMap) InjectE(key string, val interface{}) (m IMap, err error) {
+	if p.Nil() {
+		p = NewRefMap(nil)
+	}
+	m = p
+
+	var k string
+	if k, err = flatKeyFromSelector(key); err != nil {
+		return
+	}
+	var kv, vv reflect.Value
+	if kv, err = refMapValue(k, p.v.Type().Key()); err != nil {
+		return
+	}
+	if vv, err = refMapValue(val, p.v.Type().Elem()); err != nil {
+		return
+	}
+	p.v.SetMapIndex(kv, vv)
+	return
+}
+
+// Iter returns an Iterator over the key-value pairs of this Map in key order. Element will be a Pair.
+func (p *RefMap) Iter() (it Iterator) {
+	return newMapIter(p)
+}
+
+// Keys returns all the keys in this Map as a ISlice of the key type in sorted order. Interface
+// keys are returned as an *InterSlice as they may hold values of different types.
+func (p *RefMap) Keys() ISlice {
+	if p.Nil() {
+		return NewRefSliceV()
+	}
+	keys := reflect.MakeSlice(reflect.SliceOf(p.v.Type().Key()), 0, p.v.Len())
+	for _, k := range refMapKeys(*p.v) {
+		keys = reflect.Append(keys, k)
+	}
+	return refMapSlice(keys)
+}
+
+// Len returns the number of elements in this Map.
+func (p *RefMap) Len() int {
+	if p.Nil() {
+		return 0
+	}
+	return p.v.Len()
+}
+
+// M is an alias to ToStringMap
+func (p *RefMap) M() (m *StringMap) {
+	return p.ToStringMap()
+}
+
+// MG is an alias ToStringMapG
+func (p *RefMap) MG() (m map[string]interface{}) {
+	return p.ToStringMapG()
+}
+
+// Merge modifies this Map by overriding its values with the given map where they both exist and returns a
+// reference to this Map. Keys and values that can't be converted to this Map's types are skipped. Only the
+// root location is supported as nested values are not navigated by this Map.
+func (p *RefMap) Merge(m IMap, location ...string) IMap {
+	if p.Nil() {
+		p = NewRefMap(nil)
+	}
+	if len(location) > 0 && location[0] != "" && location[0] != "." {
+		return p
+	}
+	x, err := ToStringMapE(m)
+	if err != nil {
+		return p
+	}
+	for key, val := range *x {
+		if k, err := refMapValue(key, p.v.Type().Key()); err == nil {
+			if v, err := refMapValue(val, p.v.Type().Elem()); err == nil {
+				p.v.SetMapIndex(k, v)
+			}
+		}
+	}
+	return p
+}
+
+// Nil tests if this Map is nil.
+func (p *RefMap) Nil() bool {
+	if p == nil || p.v == nil {
+		return true
+	}
+	return false
+}
+
+// O returns the underlying data structure as is
+func (p *RefMap) O() interface{} {
+	if p.Nil() {
+		return map[interface{}]interface{}{}
+	}
+	return p.v.Interface()
+}
+
+// Query returns the value at the given key location, using jq type selectors. Returns empty *Object if not found.
+func (p *RefMap) Query(key string) (val *Object) {
+	val, _ = p.QueryE(key)
+	return
+}
+
+// QueryE returns the value at the given key location, using jq type selectors. Returns empty *Object if not found.
+func (p *RefMap) QueryE(key string) (val *Object, err error) {
+	return p.ToStringMap().QueryE(key)
+}
+
+// QueryS returns all values produced by the given jq expression as a Slice of the results.
+func (p *RefMap) QueryS(key string) (vals ISlice) {
+	vals, _ = p.QuerySE(key)
+	return
+}
+
+// QuerySE returns all values produced by the given jq expression as a Slice of the results.
+func (p *RefMap) QuerySE(key string) (vals ISlice, err error) {
+	return p.ToStringMap().QuerySE(key)
+}
+
+// Remove modifies this map to remove the value at the given key location, using jq type selectors. Returns a reference to this Map
+func (p *RefMap) Remove(key string) IMap {
+	m, _ := p.RemoveE(key)
+	return m
+}
+
+// RemoveE modifies this map to remove the value at the given key location, using jq type selectors. Returns a reference to this Map
+func (p *RefMap) RemoveE(key string) (m IMap, err error) {
+	if p.Nil() {
+		return p, err
+	}
+	m = p
+
+	var k string
+	if k, err = flatKeyFromSelector(key); err != nil {
+		return
+	}
+	p.Delete(k)
+	return
+}
+
+// Reverse returns a new Map with the key-value pairs in reverse sorted key order. As this Map type
+// has no key order of its own the result is an *OrderedMap with the keys converted to strings.
+func (p *RefMap) Reverse() (new IMap) {
+	return reverseMap(p)
+}
+
+// Select returns a new Map with the key-value pairs that match the lambda selector.
+func (p *RefMap) Select(sel func(k, v O) bool) (new IMap) {
+	if p.Nil() {
+		return NewRefMap(nil)
+	}
+	v := reflect.MakeMap(p.v.Type())
+	for _, k := range p.v.MapKeys() {
+		if x := p.v.MapIndex(k); sel(k.Interface(), x.Interface()) {
+			v.SetMapIndex(k, x)
+		}
+	}
+	return &RefMap{v: &v}
+}
+
+// Set the value for the given key to the given val. Returns true if the key did not yet exists in this Map.
+func (p *RefMap) Set(key, val interface{}) (new bool) {
+	if p.Nil() {
+		return
+	}
+	k, err := refMapValue(key, p.v.Type().Key())
+	if err != nil {
+		return
+	}
+	v, err := refMapValue(val, p.v.Type().Elem())
+	if err != nil {
+		return
+	}
+	new = !p.v.MapIndex(k).IsValid()
+	p.v.SetMapIndex(k, v)
+	return
+}
+
+// SetM the value for the given key to the given val creating map if necessary. Returns a reference to this Map.
+func (p *RefMap) SetM(key, val interface{}) IMap {
+	if p.Nil() {
+		p = NewRefMap(nil)
+	}
+	p.Set(key, val)
+	return p
+}
+
+// Single reports true if there is only one key-value pair in this Map.
+func (p *RefMap) Single() bool {
+	return p.Len() == 1
+}
+
+// Sort returns a new Map with the key-value pairs in sorted key order. As this Map type always
+// iterates in sorted key order this is simply a copy of all key-value pairs.
+func (p *RefMap) Sort() (new IMap) {
+	return p.Copy()
+}
+
+// String returns a string representation of this Map in sorted key order, implements the Stringer interface
+func (p *RefMap) String() string {
+	return fmt.Sprintf("&%v", p.O())
+}
+
+// ToStringMap converts the map to a *StringMap
+func (p *RefMap) ToStringMap() (m *StringMap) {
+	return ToStringMap(p.O())
+}
+
+// ToStringMapG converts the map to a Golang map[string]interface{}
+func (p *RefMap) ToStringMapG() (m map[string]interface{}) {
+	return p.ToStringMap().G()
+}
+
+// Values returns all the values in this Map as a ISlice in sorted key order. Interface values are
+// returned as an *InterSlice as they may hold values of different types.
+func (p *RefMap) Values() ISlice {
+	if p.Nil() {
+		return NewRefSliceV()
+	}
+	vals := reflect.MakeSlice(reflect.SliceOf(p.v.Type().Elem()), 0, p.v.Len())
+	for _, k := range refMapKeys(*p.v) {
+		vals = reflect.Append(vals, p.v.MapIndex(k))
+	}
+	return refMapSlice(vals)
+}
+
+// YAML converts the Map into a YAML string
+func (p *RefMap) YAML() (data string) {
+	data, _ = p.YAMLE()
+	return
+}
+
+// YAMLE converts the Map into a YAML string
+func (p *RefMap) YAMLE() (data string, err error) {
+	var _data []byte
+	if _data, err = yaml.Marshal(p.ToStringMapG()); err != nil {
+		err = errors.Wrapf(err, "failed to marshal map[string]interface{}")
+		return
+	}
+	data = string(_data)
+	return
+}
+
+// WriteJSON converts the Map into a map[string]interface{} then calls
+// json.WriteJSON on it to write it out to disk.
+func (p *RefMap) WriteJSON(filename string) (err error) {
+	return json.WriteJSON(filename, p.ToStringMapG())
+}
+
+// WriteYAML converts the Map into a map[string]interface{} then calls
+// yaml.WriteYAML on it to write it out to disk.
+func (p *RefMap) WriteYAML(filename string) (err error) {
+	return yaml.WriteYAML(filename, p.ToStringMapG())
+}
+
+// refMapKeys returns the keys of the given map value in sorted order
+func refMapKeys(v reflect.Value) (keys []reflect.Value) {
+	keys = v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool { return refMapLess(keys[i], keys[j]) })
+	return
+}
+
+// refMapLess returns true if the map key a should sort before b. Keys of the basic kinds
+// are compared by value while all others are compared by their string representation.
+func refMapLess(a, b reflect.Value) bool {
+	if a.Kind() == reflect.Interface {
+		a = a.Elem()
+	}
+	if b.Kind() == reflect.Interface {
+		b = b.Elem()
+	}
+	if a.Kind() == b.Kind() {
+		switch a.Kind() {
+		case reflect.Bool:
+			return !a.Bool() && b.Bool()
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return a.Int() < b.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return a.Uint() < b.Uint()
+		case reflect.Float32, reflect.Float64:
+			return a.Float() < b.Float()
+		case reflect.String:
+			return a.String() < b.String()
+		}
+	}
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
+
+// refMapSlice wraps the given slice value as a *RefSlice or as an *InterSlice when the element type
+// is an interface as a RefSlice would convert all elements to the type of the first.
+func refMapSlice(v reflect.Value) ISlice {
+	if v.Type().Elem().Kind() == reflect.Interface {
+		vals := make([]interface{}, v.Len())
+		for i := range vals {
+			vals[i] = v.Index(i).Interface()
+		}
+		return NewInterSliceV(vals...)
+	}
+	return NewRefSlice(v.Interface())
+}
+
+// refMapValue converts the given value into a reflect.Value of the given type. Assignable values
+// are used as is while the basic kinds are converted using the To conversion functions.
+func refMapValue(obj interface{}, typ reflect.Type) (val reflect.Value, err error) {
+	if obj == nil {
+		switch typ.Kind() {
+		case reflect.Interface, reflect.Ptr, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+			val = reflect.Zero(typ)
+			return
+		}
+	} else if x := reflect.ValueOf(obj); x.Type().AssignableTo(typ) {
+		val = x
+		return
+	}
+
+	var o interface{}
+	switch typ.Kind() {
+	case reflect.Bool:
+		o, err = ToBoolE(obj)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		o, err = ToInt64E(obj)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		o, err = ToUint64E(obj)
+	case reflect.Float32, reflect.Float64:
+		o, err = ToFloat64E(obj)
+	case reflect.String:
+		o = ToString(obj)
+	default:
+		err = errors.Errorf("can't use type '%T' as '%v'", obj, typ)
+	}
+	if err != nil {
+		return
+	}
+	val = reflect.ValueOf(o).Convert(typ)
+	return
+}
@@ -0,0 +1,437 @@
+package n
+
+import (
+	"fmt"
+	"path"
+	"testing"
+
+	"github.com/phR0ze/n/pkg/sys"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// NewRefMap
+//--------------------------------------------------------------------------------------------------
+func ExampleNewRefMap() {
+	m := NewRefMap(map[int]string{2: "two", 1: "one"})
+	fmt.Println(m)
+	// Output: &map[1:one 2:two]
+}
+
+func TestNewRefMap(t *testing.T) {
+
+	// map and pointer to map share the underlying map
+	{
+		x := map[int]string{1: "one"}
+		NewRefMap(x).Set(2, "two")
+		NewRefMap(&x).Set(3, "three")
+		assert.Equal(t, map[int]string{1: "one", 2: "two", 3: "three"}, x)
+	}
+
+	// nil map of a type keeps the type
+	{
+		var x map[int]string
+		m := NewRefMap(x)
+		assert.Equal(t, true, m.Set("1", "one"))
+		assert.Equal(t, map[int]string{1: "one"}, m.O())
+	}
+
+	// invalid or nothing
+	{
+		assert.Equal(t, map[interface{}]interface{}{}, NewRefMap(nil).O())
+		assert.Equal(t, map[interface{}]interface{}{}, NewRefMap(1).O())
+		assert.Equal(t, map[interface{}]interface{}{}, (*RefMap)(nil).O())
+	}
+
+	// RefMap
+	{
+		m := NewRefMap(map[int]string{})
+		assert.Equal(t, m, NewRefMap(m))
+	}
+}
+
+// Any
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_Any(t *testing.T) {
+	assert.Equal(t, false, (*RefMap)(nil).Any())
+	assert.Equal(t, false, NewRefMap(map[int]string{}).Any())
+
+	m := NewRefMap(map[int]string{1: "one", 2: "two"})
+	assert.Equal(t, true, m.Any())
+	assert.Equal(t, true, m.Any(3, 2))
+	assert.Equal(t, true, m.Any("1"))
+	assert.Equal(t, true, m.Any(int8(1)))
+	assert.Equal(t, false, m.Any(3, "bogus"))
+
+	assert.Equal(t, true, m.AnyS([]int{3, 1}))
+	assert.Equal(t, false, m.AnyS([]int{3}))
+	assert.Equal(t, true, m.AnyW(func(k, v O) bool { return v == "two" }))
+	assert.Equal(t, false, m.AnyW(func(k, v O) bool { return v == "three" }))
+}
+
+// Clear
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_Clear(t *testing.T) {
+	assert.Equal(t, map[interface{}]interface{}{}, (*RefMap)(nil).Clear().O())
+
+	m := NewRefMap(map[int]string{1: "one", 2: "two"})
+	assert.Equal(t, map[int]string{}, m.Clear().O())
+	assert.Equal(t, 0, m.Len())
+	assert.Equal(t, true, m.Set(1, "one"))
+}
+
+// Copy
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_Copy(t *testing.T) {
+	assert.Equal(t, map[interface{}]interface{}{}, (*RefMap)(nil).Copy().O())
+
+	m := NewRefMap(map[int]string{1: "one", 2: "two", 3: "three"})
+	cp := m.Copy()
+	assert.Equal(t, m.O(), cp.O())
+	cp.Set(1, "uno")
+	assert.Equal(t, "one", m.Get(1).O())
+
+	assert.Equal(t, map[int]string{1: "one", 3: "three"}, m.Copy(3, 1, 4, "bogus").O())
+}
+
+// CountW
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_CountW(t *testing.T) {
+	assert.Equal(t, 0, (*RefMap)(nil).CountW(func(k, v O) bool { return true }))
+
+	m := NewRefMap(map[int]string{1: "one", 2: "two", 3: "three"})
+	assert.Equal(t, 2, m.CountW(func(k, v O) bool { return k.(int) > 1 }))
+}
+
+// Delete
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_Delete(t *testing.T) {
+	assert.Equal(t, &Object{}, (*RefMap)(nil).Delete(1))
+	assert.Equal(t, (*RefMap)(nil), (*RefMap)(nil).DeleteM(1))
+
+	m := NewRefMap(map[int]string{1: "one", 2: "two", 3: "three"})
+	assert.Equal(t, "one", m.Delete(1).O())
+	assert.Equal(t, &Object{}, m.Delete(1))
+	assert.Equal(t, &Object{}, m.Delete("bogus"))
+	assert.Equal(t, map[int]string{3: "three"}, m.DeleteM("2").O())
+}
+
+// DeleteS
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_DeleteS(t *testing.T) {
+	assert.Equal(t, NewInterSliceV(), (*RefMap)(nil).DeleteS([]int{1}))
+
+	m := NewRefMap(map[int]string{1: "one", 2: "two", 3: "three"})
+	assert.Equal(t, NewInterSliceV("three", "one"), m.DeleteS([]int{3, 4, 1}))
+	assert.Equal(t, map[int]string{2: "two"}, m.O())
+}
+
+// DeleteW
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_DeleteW(t *testing.T) {
+	assert.Equal(t, (*RefMap)(nil), (*RefMap)(nil).DeleteW(func(k, v O) bool { return true }))
+
+	m := NewRefMap(map[int]string{1: "one", 2: "two", 3: "three"})
+	assert.Equal(t, map[int]string{2: "two"}, m.DeleteW(func(k, v O) bool { return k != 2 }).O())
+}
+
+// Each
+//--------------------------------------------------------------------------------------------------
+func ExampleRefMap_Each() {
+	m := NewRefMap(map[int]string{2: "two", 1: "one"})
+	m.Each(func(k, v O) {
+		fmt.Printf("%v:%v\n", k, v)
+	})
+	// Output: 1:one
+	// 2:two
+}
+
+func TestRefMap_Each(t *testing.T) {
+	(*RefMap)(nil).Each(func(k, v O) { assert.Fail(t, "shouldn't be called") })
+	(*RefMap)(nil).EachR(func(k, v O) { assert.Fail(t, "shouldn't be called") })
+
+	m := NewRefMap(map[int]string{10: "ten", 2: "two", -1: "minus one"})
+
+	// sorted key order
+	{
+		keys := []int{}
+		assert.Equal(t, m, m.Each(func(k, v O) { keys = append(keys, k.(int)) }))
+		assert.Equal(t, []int{-1, 2, 10}, keys)
+	}
+
+	// reverse sorted key order
+	{
+		keys := []int{}
+		assert.Equal(t, m, m.EachR(func(k, v O) { keys = append(keys, k.(int)) }))
+		assert.Equal(t, []int{10, 2, -1}, keys)
+	}
+
+	// stop on the first error
+	{
+		keys := []int{}
+		_, err := m.EachE(func(k, v O) error {
+			keys = append(keys, k.(int))
+			if v == "two" {
+				return errors.New("failed")
+			}
+			return nil
+		})
+		assert.Equal(t, "failed", err.Error())
+		assert.Equal(t, []int{-1, 2}, keys)
+
+		keys = []int{}
+		_, err = m.EachRE(func(k, v O) error {
+			keys = append(keys, k.(int))
+			return Break
+		})
+		assert.Equal(t, Break, err)
+		assert.Equal(t, []int{10}, keys)
+	}
+
+	// mixed key types in an interface map
+	{
+		keys := []interface{}{}
+		NewRefMap(map[interface{}]bool{"b": true, 2: true, "a": true, 1: true}).Each(func(k, v O) {
+			keys = append(keys, k)
+		})
+		assert.Equal(t, []interface{}{1, 2, "a", "b"}, keys)
+	}
+
+	// other key types
+	{
+		keys := []interface{}{}
+		NewRefMap(map[float32]int{1.5: 1, -2.5: 2}).Each(func(k, v O) { keys = append(keys, k) })
+		NewRefMap(map[uint]int{3: 1, 1: 2}).Each(func(k, v O) { keys = append(keys, k) })
+		NewRefMap(map[bool]int{true: 1, false: 2}).Each(func(k, v O) { keys = append(keys, k) })
+		assert.Equal(t, []interface{}{float32(-2.5), float32(1.5), uint(1), uint(3), false, true}, keys)
+	}
+}
+
+// Empty
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_Empty(t *testing.T) {
+	assert.Equal(t, true, (*RefMap)(nil).Empty())
+	assert.Equal(t, true, NewRefMap(map[int]string{}).Empty())
+	assert.Equal(t, false, NewRefMap(map[int]string{1: "one"}).Empty())
+	assert.Equal(t, true, (*RefMap)(nil).Nil())
+	assert.Equal(t, true, (&RefMap{}).Nil())
+	assert.Equal(t, false, NewRefMap(nil).Nil())
+}
+
+// Exists
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_Exists(t *testing.T) {
+	assert.Equal(t, false, (*RefMap)(nil).Exists(1))
+
+	m := NewRefMap(map[int]*Object{1: nil})
+	assert.Equal(t, true, m.Exists(1))
+	assert.Equal(t, true, m.Exists("1"))
+	assert.Equal(t, false, m.Exists(2))
+	assert.Equal(t, false, m.Exists("bogus"))
+}
+
+// Get
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_Get(t *testing.T) {
+	assert.Equal(t, &Object{}, (*RefMap)(nil).Get(1))
+
+	m := NewRefMap(map[uint8]float32{1: 1.5})
+	assert.Equal(t, float32(1.5), m.Get(1).O())
+	assert.Equal(t, float32(1.5), m.Get("1").O())
+	assert.Equal(t, &Object{}, m.Get(2))
+	assert.Equal(t, true, m.Generic())
+}
+
+// Inject
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_Inject(t *testing.T) {
+	assert.Equal(t, map[interface{}]interface{}{"a": 1}, (*RefMap)(nil).Inject("a", 1).O())
+
+	m := NewRefMap(map[int]float64{1: 1.5})
+	assert.Equal(t, map[int]float64{1: 2, 2: 3.5}, m.Inject("1", 2).Inject(".2", "3.5").O())
+
+	_, err := m.InjectE("1.2", 1)
+	assert.Equal(t, "invalid key selector 1.2, only a single key is supported", err.Error())
+	_, err = m.InjectE("bogus", 1)
+	assert.NotNil(t, err)
+	_, err = m.InjectE("1", "bogus")
+	assert.NotNil(t, err)
+	_, err = NewRefMap(map[int][]int{}).InjectE("1", "bogus")
+	assert.Equal(t, "can't use type 'string' as '[]int'", err.Error())
+}
+
+// Iter
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_Iter(t *testing.T) {
+	assert.False(t, (*RefMap)(nil).Iter().Next())
+	assert.False(t, NewRefMap(map[int]string{}).Iter().Next())
+
+	it := NewRefMap(map[int]string{2: "b", 1: "a"}).Iter()
+	assert.True(t, it.Next())
+	assert.Equal(t, 0, it.Index())
+	assert.Equal(t, Pair{Key: 1, Val: "a"}, it.Value())
+	assert.True(t, it.Next())
+	assert.Equal(t, 1, it.Index())
+	assert.Equal(t, Pair{Key: 2, Val: "b"}, it.Value())
+	assert.False(t, it.Next())
+	assert.Nil(t, it.Err())
+}
+
+// Keys
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_Keys(t *testing.T) {
+	assert.Equal(t, NewRefSliceV(), (*RefMap)(nil).Keys())
+	assert.Equal(t, []int{}, NewRefMap(map[int]string{}).Keys().O())
+	assert.Equal(t, []int{1, 2, 3}, NewRefMap(map[int]string{3: "three", 1: "one", 2: "two"}).Keys().O())
+
+	// interface keys of mixed types
+	assert.Equal(t, NewInterSliceV(1, "b"), NewRefMap(map[interface{}]interface{}{1: "a", "b": 2}).Keys())
+	assert.Equal(t, NewInterSliceV(), NewRefMap(map[interface{}]string{}).Keys())
+}
+
+// Len
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_Len(t *testing.T) {
+	assert.Equal(t, 0, (*RefMap)(nil).Len())
+	assert.Equal(t, 2, NewRefMap(map[int]string{1: "one", 2: "two"}).Len())
+	assert.Equal(t, true, NewRefMap(map[int]string{1: "one"}).Single())
+	assert.Equal(t, false, NewRefMap(map[int]string{}).Single())
+}
+
+// M
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_M(t *testing.T) {
+	assert.Equal(t, NewStringMapV(), (*RefMap)(nil).M())
+
+	m := NewRefMap(map[int]string{1: "one", 2: "two"})
+	assert.Equal(t, NewStringMapV(map[string]interface{}{"1": "one", "2": "two"}), m.M())
+	assert.Equal(t, map[string]interface{}{"1": "one", "2": "two"}, m.MG())
+	assert.Equal(t, m.MG(), ToStringMap(m).G())
+}
+
+// Merge
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_Merge(t *testing.T) {
+	assert.Equal(t, map[interface{}]interface{}{"1": "one"}, (*RefMap)(nil).Merge(NewStringMapV(map[string]interface{}{"1": "one"})).O())
+
+	m := NewRefMap(map[int]string{1: "one", 2: "two"})
+	m.Merge(NewStringMapV(map[string]interface{}{"2": "dos", "3": "three", "bogus": "bogus"}))
+	assert.Equal(t, map[int]string{1: "one", 2: "dos", 3: "three"}, m.O())
+	m.Merge(NewRefMap(map[int]string{1: "uno"}), "1")
+	assert.Equal(t, "one", m.Get(1).O())
+	m.Merge(nil)
+	assert.Equal(t, 3, m.Len())
+}
+
+// Query
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_Query(t *testing.T) {
+	m := NewRefMap(map[int]interface{}{1: "one", 2: map[string]interface{}{"a": "two"}})
+	assert.Equal(t, "one", m.Query("1").O())
+	assert.Equal(t, "two", m.Query("2.a").O())
+	assert.Equal(t, nil, m.Query("3").O())
+	assert.Equal(t, []interface{}{"one", "two"}, m.QueryS(`."1", ."2".a`).O())
+}
+
+// Remove
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_Remove(t *testing.T) {
+	assert.Equal(t, (*RefMap)(nil), (*RefMap)(nil).Remove("1"))
+
+	m := NewRefMap(map[int]string{1: "one", 2: "two"})
+	assert.Equal(t, map[int]string{2: "two"}, m.Remove("1").Remove("3").O())
+
+	_, err := m.RemoveE("2.1")
+	assert.Equal(t, "invalid key selector 2.1, only a single key is supported", err.Error())
+}
+
+// Reverse
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_Reverse(t *testing.T) {
+	assert.Equal(t, NewOrderedMapV(), (*RefMap)(nil).Reverse())
+
+	m := NewRefMap(map[int]string{2: "two", 1: "one", 10: "ten"})
+	assert.Equal(t, NewOrderedMapV("10", "ten", "2", "two", "1", "one"), m.Reverse())
+}
+
+// Select
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_Select(t *testing.T) {
+	assert.Equal(t, map[interface{}]interface{}{}, (*RefMap)(nil).Select(func(k, v O) bool { return true }).O())
+
+	m := NewRefMap(map[int]string{1: "one", 2: "two", 3: "three"})
+	assert.Equal(t, map[int]string{1: "one", 3: "three"}, m.Select(func(k, v O) bool { return k != 2 }).O())
+	assert.Equal(t, 3, m.Len())
+}
+
+// Set
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_Set(t *testing.T) {
+	assert.Equal(t, false, (*RefMap)(nil).Set(1, "one"))
+	assert.Equal(t, map[interface{}]interface{}{1: "one"}, (*RefMap)(nil).SetM(1, "one").O())
+
+	m := NewRefMap(map[int]*Object{})
+	assert.Equal(t, true, m.Set(1, nil))
+	assert.Equal(t, false, m.Set(1, &Object{o: 1}))
+	assert.Equal(t, &Object{o: 1}, m.Get(1).O())
+	assert.Equal(t, false, m.Set("bogus", nil))
+	assert.Equal(t, false, m.Set(2, "bogus"))
+	assert.Equal(t, 1, m.Len())
+}
+
+// Sort
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_Sort(t *testing.T) {
+	assert.Equal(t, map[interface{}]interface{}{}, (*RefMap)(nil).Sort().O())
+
+	m := NewRefMap(map[int]string{2: "two", 1: "one"})
+	assert.Equal(t, map[int]string{1: "one", 2: "two"}, m.Sort().O())
+	assert.Equal(t, []int{1, 2}, m.Sort().Keys().O())
+}
+
+// String
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_String(t *testing.T) {
+	assert.Equal(t, "&map[]", (*RefMap)(nil).String())
+	assert.Equal(t, "&map[1:one 2:two]", NewRefMap(map[int]string{2: "two", 1: "one"}).String())
+}
+
+// Values
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_Values(t *testing.T) {
+	assert.Equal(t, NewRefSliceV(), (*RefMap)(nil).Values())
+	assert.Equal(t, []string{"one", "two", "three"}, NewRefMap(map[int]string{3: "three", 1: "one", 2: "two"}).Values().O())
+
+	// interface values of mixed types
+	assert.Equal(t, NewInterSliceV("a", 2), NewRefMap(map[interface{}]interface{}{1: "a", "b": 2}).Values())
+	assert.Equal(t, NewInterSliceV(1.5, nil), NewRefMap(map[int]interface{}{1: 1.5, 2: nil}).Values())
+}
+
+// YAML
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_YAML(t *testing.T) {
+	assert.Equal(t, "\"1\": one\n\"2\": two\n", NewRefMap(map[int]string{2: "two", 1: "one"}).YAML())
+	assert.Equal(t, "{}\n", (*RefMap)(nil).YAML())
+}
+
+// WriteJSON
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_WriteJSON(t *testing.T) {
+	clearTmpDir()
+	filename := path.Join(tmpDir, "map.json")
+	m := NewRefMap(map[int]string{1: "one"})
+	assert.Nil(t, m.WriteJSON(filename))
+	assert.Equal(t, m.M(), LoadJSON(filename))
+}
+
+// WriteYAML
+//--------------------------------------------------------------------------------------------------
+func TestRefMap_WriteYAML(t *testing.T) {
+	clearTmpDir()
+	filename := path.Join(tmpDir, "map.yaml")
+	m := NewRefMap(map[int]string{1: "one"})
+	assert.Nil(t, m.WriteYAML(filename))
+	data, err := sys.ReadString(filename)
+	assert.Nil(t, err)
+	assert.Equal(t, "\"1\": one\n", data)
+}
@@ -1,13 +1,392 @@
 package n
 
 import (
+	"fmt"
+	"path"
 	"testing"
 
+	"github.com/phR0ze/n/pkg/sys"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
+// NewRuneMapBool
+//--------------------------------------------------------------------------------------------------
+func ExampleNewRuneMapBool() {
+	m := NewRuneMapBool(map[rune]bool{'b': true, 'a': false})
+	fmt.Println(m)
+	// Output: &map[a:false b:true]
+}
+
+func TestNewRuneMapBool(t *testing.T) {
+	assert.Equal(t, &RuneMapBool{}, NewRuneMapBool())
+	assert.Equal(t, &RuneMapBool{'a': true}, NewRuneMapBool(map[rune]bool{'a': true}))
+}
+
+// Any
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_Any(t *testing.T) {
+	assert.Equal(t, false, (*RuneMapBool)(nil).Any())
+	assert.Equal(t, false, NewRuneMapBool().Any())
+
+	m := NewRuneMapBool(map[rune]bool{'a': true, 'b': false})
+	assert.Equal(t, true, m.Any())
+	assert.Equal(t, true, m.Any('c', 'b'))
+	assert.Equal(t, true, m.Any("a"))
+	assert.Equal(t, false, m.Any('c'))
+	assert.Equal(t, false, m.Any("ab"))
+
+	assert.Equal(t, true, m.AnyS([]rune{'c', 'a'}))
+	assert.Equal(t, false, m.AnyS([]rune{'c'}))
+	assert.Equal(t, false, m.AnyS(nil))
+	assert.Equal(t, true, m.AnyW(func(k, v O) bool { return k == 'b' && v == false }))
+	assert.Equal(t, false, m.AnyW(func(k, v O) bool { return k == 'c' }))
+}
+
+// Clear
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_Clear(t *testing.T) {
+	assert.Equal(t, NewRuneMapBool(), (*RuneMapBool)(nil).Clear())
+
+	m := NewRuneMapBool(map[rune]bool{'a': true, 'b': false})
+	assert.Equal(t, NewRuneMapBool(), m.Clear())
+	assert.Equal(t, NewRuneMapBool(), m)
+}
+
+// Copy
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_Copy(t *testing.T) {
+	assert.Equal(t, NewRuneMapBool(), (*RuneMapBool)(nil).Copy())
+
+	m := NewRuneMapBool(map[rune]bool{'a': true, 'b': false})
+	cp := m.Copy()
+	assert.Equal(t, m, cp)
+	cp.Set('a', false)
+	assert.Equal(t, true, m.Get('a').O())
+
+	assert.Equal(t, NewRuneMapBool(map[rune]bool{'b': false}), m.Copy('b', 'c', "ab"))
+}
+
+// CountW
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_CountW(t *testing.T) {
+	assert.Equal(t, 0, (*RuneMapBool)(nil).CountW(func(k, v O) bool { return true }))
+
+	m := NewRuneMapBool(map[rune]bool{'a': true, 'b': false, 'c': true})
+	assert.Equal(t, 2, m.CountW(func(k, v O) bool { return v.(bool) }))
+	assert.Equal(t, 3, m.CountW(func(k, v O) bool { return true }))
+}
+
+// Delete
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_Delete(t *testing.T) {
+	assert.Equal(t, &Object{}, (*RuneMapBool)(nil).Delete('a'))
+	assert.Equal(t, (*RuneMapBool)(nil), (*RuneMapBool)(nil).DeleteM('a'))
+
+	m := NewRuneMapBool(map[rune]bool{'a': true, 'b': false, 'c': true})
+	assert.Equal(t, true, m.Delete('a').O())
+	assert.Equal(t, &Object{}, m.Delete('a'))
+	assert.Equal(t, &Object{}, m.Delete("ab"))
+	assert.Equal(t, NewRuneMapBool(map[rune]bool{'c': true}), m.DeleteM('b'))
+}
+
+// DeleteS
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_DeleteS(t *testing.T) {
+	assert.Equal(t, NewInterSliceV(), (*RuneMapBool)(nil).DeleteS([]rune{'a'}))
+
+	m := NewRuneMapBool(map[rune]bool{'a': true, 'b': false, 'c': true})
+	assert.Equal(t, NewInterSliceV(false, true), m.DeleteS([]rune{'b', 'a'}))
+	assert.Equal(t, NewRuneMapBool(map[rune]bool{'c': true}), m)
+}
+
+// DeleteW
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_DeleteW(t *testing.T) {
+	assert.Equal(t, (*RuneMapBool)(nil), (*RuneMapBool)(nil).DeleteW(func(k, v O) bool { return true }))
+
+	m := NewRuneMapBool(map[rune]bool{'a': true, 'b': false, 'c': true})
+	assert.Equal(t, NewRuneMapBool(map[rune]bool{'b': false}), m.DeleteW(func(k, v O) bool { return v.(bool) }))
+}
+
+// Each
+//--------------------------------------------------------------------------------------------------
+func ExampleRuneMapBool_Each() {
+	m := NewRuneMapBool(map[rune]bool{'b': true, 'a': false})
+	m.Each(func(k, v O) {
+		fmt.Printf("%c:%v\n", k, v)
+	})
+	// Output: a:false
+	// b:true
+}
+
+func TestRuneMapBool_Each(t *testing.T) {
+	(*RuneMapBool)(nil).Each(func(k, v O) { assert.Fail(t, "shouldn't be called") })
+	(*RuneMapBool)(nil).EachR(func(k, v O) { assert.Fail(t, "shouldn't be called") })
+
+	m := NewRuneMapBool(map[rune]bool{'c': true, 'a': true, 'b': false})
+
+	// sorted key order
+	{
+		keys := []rune{}
+		assert.Equal(t, m, m.Each(func(k, v O) { keys = append(keys, k.(rune)) }))
+		assert.Equal(t, []rune{'a', 'b', 'c'}, keys)
+	}
+
+	// reverse sorted key order
+	{
+		keys := []rune{}
+		assert.Equal(t, m, m.EachR(func(k, v O) { keys = append(keys, k.(rune)) }))
+		assert.Equal(t, []rune{'c', 'b', 'a'}, keys)
+	}
+
+	// stop on the first error
+	{
+		keys := []rune{}
+		_, err := m.EachE(func(k, v O) error {
+			keys = append(keys, k.(rune))
+			if !v.(bool) {
+				return errors.New("failed")
+			}
+			return nil
+		})
+		assert.Equal(t, "failed", err.Error())
+		assert.Equal(t, []rune{'a', 'b'}, keys)
+
+		keys = []rune{}
+		_, err = m.EachRE(func(k, v O) error {
+			keys = append(keys, k.(rune))
+			return Break
+		})
+		assert.Equal(t, Break, err)
+		assert.Equal(t, []rune{'c'}, keys)
+
+		_, err = (*RuneMapBool)(nil).EachE(func(k, v O) error { return Break })
+		assert.Nil(t, err)
+		_, err = (*RuneMapBool)(nil).EachRE(func(k, v O) error { return Break })
+		assert.Nil(t, err)
+	}
+}
+
+// Empty
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_Empty(t *testing.T) {
+	assert.Equal(t, true, (*RuneMapBool)(nil).Empty())
+	assert.Equal(t, true, NewRuneMapBool().Empty())
+	assert.Equal(t, false, NewRuneMapBool(map[rune]bool{'a': true}).Empty())
+}
+
+// Exists
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_Exists(t *testing.T) {
+	assert.Equal(t, false, (*RuneMapBool)(nil).Exists('a'))
+
+	m := NewRuneMapBool(map[rune]bool{'a': false})
+	assert.Equal(t, true, m.Exists('a'))
+	assert.Equal(t, false, m.Exists('b'))
+	assert.Equal(t, false, m.Exists("ab"))
+}
+
+// Get
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_Get(t *testing.T) {
+	assert.Equal(t, &Object{}, (*RuneMapBool)(nil).Get('a'))
+
+	m := NewRuneMapBool(map[rune]bool{'a': false})
+	assert.Equal(t, false, m.Get('a').O())
+	assert.Equal(t, false, m.Get("a").O())
+	assert.Equal(t, &Object{}, m.Get('b'))
+	assert.Equal(t, false, m.Generic())
+}
+
+// Inject
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_Inject(t *testing.T) {
+	assert.Equal(t, NewRuneMapBool(map[rune]bool{'a': true}), (*RuneMapBool)(nil).Inject("a", true))
+
+	m := NewRuneMapBool(map[rune]bool{'a': false})
+	assert.Equal(t, NewRuneMapBool(map[rune]bool{'a': true, 'b': true}), m.Inject("a", true).Inject(".b", "true"))
+
+	_, err := m.InjectE("a.b", true)
+	assert.Equal(t, "invalid key selector a.b, only a single key is supported", err.Error())
+	_, err = m.InjectE("a", "bogus")
+	assert.Contains(t, err.Error(), "failed to inject value for key "+"a")
+}
+
+// Iter
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_Iter(t *testing.T) {
+	assert.False(t, (*RuneMapBool)(nil).Iter().Next())
+	assert.False(t, NewRuneMapBool().Iter().Next())
+
+	it := NewRuneMapBool(map[rune]bool{'b': false, 'a': true}).Iter()
+	assert.True(t, it.Next())
+	assert.Equal(t, 0, it.Index())
+	assert.Equal(t, Pair{Key: 'a', Val: true}, it.Value())
+	assert.True(t, it.Next())
+	assert.Equal(t, 1, it.Index())
+	assert.Equal(t, Pair{Key: 'b', Val: false}, it.Value())
+	assert.False(t, it.Next())
+	assert.Nil(t, it.Err())
+}
+
+// Keys
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_Keys(t *testing.T) {
+	assert.Equal(t, []rune{}, (*RuneMapBool)(nil).Keys().O())
+	assert.Equal(t, []rune{}, NewRuneMapBool().Keys().O())
+	assert.Equal(t, []rune{'a', 'b', 'c'}, NewRuneMapBool(map[rune]bool{'c': true, 'a': true, 'b': false}).Keys().O())
+}
+
+// Len
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_Len(t *testing.T) {
+	assert.Equal(t, 0, (*RuneMapBool)(nil).Len())
+	assert.Equal(t, 2, NewRuneMapBool(map[rune]bool{'a': true, 'b': false}).Len())
+	assert.Equal(t, true, NewRuneMapBool(map[rune]bool{'a': true}).Single())
+	assert.Equal(t, false, NewRuneMapBool().Single())
+}
+
+// M
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_M(t *testing.T) {
+	assert.Equal(t, NewStringMapV(), (*RuneMapBool)(nil).M())
+
+	m := NewRuneMapBool(map[rune]bool{'a': true, 'b': false})
+	assert.Equal(t, NewStringMapV(map[string]interface{}{"a": true, "b": false}), m.M())
+	assert.Equal(t, map[string]interface{}{"a": true, "b": false}, m.MG())
+	assert.Equal(t, m.MG(), ToStringMap(m).G())
+}
+
+// Merge
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_Merge(t *testing.T) {
+	assert.Equal(t, NewRuneMapBool(map[rune]bool{'a': true}), (*RuneMapBool)(nil).Merge(NewRuneMapBool(map[rune]bool{'a': true})))
+
+	m := NewRuneMapBool(map[rune]bool{'a': true, 'b': false})
+	m.Merge(NewStringMapV(map[string]interface{}{"b": true, "c": "false", "a": "bogus"}))
+	assert.Equal(t, NewRuneMapBool(map[rune]bool{'a': true, 'b': true, 'c': false}), m)
+	m.Merge(NewRuneMapBool(map[rune]bool{'a': false}), "a")
+	assert.Equal(t, true, m.Get('a').O())
+	m.Merge(nil)
+	assert.Equal(t, 3, m.Len())
+}
+
+// O
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_O(t *testing.T) {
+	assert.Equal(t, map[rune]bool{}, (*RuneMapBool)(nil).O())
+	assert.Equal(t, map[rune]bool{'a': true}, NewRuneMapBool(map[rune]bool{'a': true}).O())
+	assert.Equal(t, true, (*RuneMapBool)(nil).Nil())
+	assert.Equal(t, false, NewRuneMapBool().Nil())
+}
+
+// Query
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_Query(t *testing.T) {
+	m := NewRuneMapBool(map[rune]bool{'a': true, 'b': false})
+	assert.Equal(t, true, m.Query("a").O())
+	assert.Equal(t, false, m.Query(`"b"`).O())
+	assert.Equal(t, nil, m.Query("c").O())
+	assert.Equal(t, []interface{}{true, false}, m.QueryS(`."a", ."b"`).O())
+}
+
+// Remove
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_Remove(t *testing.T) {
+	assert.Equal(t, (*RuneMapBool)(nil), (*RuneMapBool)(nil).Remove("a"))
+
+	m := NewRuneMapBool(map[rune]bool{'a': true, 'b': false})
+	assert.Equal(t, NewRuneMapBool(map[rune]bool{'b': false}), m.Remove("a").Remove("c"))
+
+	_, err := m.RemoveE("b.a")
+	assert.Equal(t, "invalid key selector b.a, only a single key is supported", err.Error())
+}
+
+// Reverse
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_Reverse(t *testing.T) {
+	assert.Equal(t, NewOrderedMapV(), (*RuneMapBool)(nil).Reverse())
+
+	m := NewRuneMapBool(map[rune]bool{'b': true, 'a': false})
+	assert.Equal(t, NewOrderedMapV("b", true, "a", false), m.Reverse())
+}
+
+// Select
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_Select(t *testing.T) {
+	assert.Equal(t, NewRuneMapBool(), (*RuneMapBool)(nil).Select(func(k, v O) bool { return true }))
+
+	m := NewRuneMapBool(map[rune]bool{'a': true, 'b': false, 'c': true})
+	assert.Equal(t, NewRuneMapBool(map[rune]bool{'a': true, 'c': true}), m.Select(func(k, v O) bool { return v.(bool) }))
+	assert.Equal(t, 3, m.Len())
+}
+
+// Set
+//--------------------------------------------------------------------------------------------------
 func TestRuneMapBool_Set(t *testing.T) {
+	assert.Equal(t, false, (*RuneMapBool)(nil).Set('a', true))
+	assert.Equal(t, NewRuneMapBool(map[rune]bool{'a': true}), (*RuneMapBool)(nil).SetM('a', true))
+
 	m := NewRuneMapBool()
 	assert.Equal(t, true, m.Set('a', true))
-	assert.Equal(t, false, m.Set('a', true))
+	assert.Equal(t, false, m.Set('a', false))
+	assert.Equal(t, false, (*m)['a'])
+	assert.Equal(t, false, m.Set("ab", true))
+	assert.Equal(t, false, m.Set('b', "bogus"))
+	assert.Equal(t, 1, m.Len())
+}
+
+// Sort
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_Sort(t *testing.T) {
+	assert.Equal(t, NewRuneMapBool(), (*RuneMapBool)(nil).Sort())
+
+	m := NewRuneMapBool(map[rune]bool{'b': true, 'a': false})
+	assert.Equal(t, m, m.Sort())
+	assert.Equal(t, []rune{'a', 'b'}, m.Sort().Keys().O())
+}
+
+// String
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_String(t *testing.T) {
+	assert.Equal(t, "&map[]", (*RuneMapBool)(nil).String())
+	assert.Equal(t, "&map[a:true b:false]", NewRuneMapBool(map[rune]bool{'b': false, 'a': true}).String())
+}
+
+// Values
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_Values(t *testing.T) {
+	assert.Equal(t, []bool{}, (*RuneMapBool)(nil).Values().O())
+	assert.Equal(t, []bool{true, false, true}, NewRuneMapBool(map[rune]bool{'c': true, 'a': true, 'b': false}).Values().O())
+}
+
+// YAML
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_YAML(t *testing.T) {
+	m := NewRuneMapBool(map[rune]bool{'b': false, 'a': true})
+	assert.Equal(t, "a: true\nb: false\n", m.YAML())
+	assert.Equal(t, "{}\n", (*RuneMapBool)(nil).YAML())
+}
+
+// WriteJSON
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_WriteJSON(t *testing.T) {
+	clearTmpDir()
+	filename := path.Join(tmpDir, "map.json")
+	m := NewRuneMapBool(map[rune]bool{'a': true})
+	assert.Nil(t, m.WriteJSON(filename))
+	assert.Equal(t, m.M(), LoadJSON(filename))
+}
+
+// WriteYAML
+//--------------------------------------------------------------------------------------------------
+func TestRuneMapBool_WriteYAML(t *testing.T) {
+	clearTmpDir()
+	filename := path.Join(tmpDir, "map.yaml")
+	m := NewRuneMapBool(map[rune]bool{'a': true})
+	assert.Nil(t, m.WriteYAML(filename))
+	data, err := sys.ReadString(filename)
+	assert.Nil(t, err)
+	assert.Equal(t, "a: true\n", data)
 }
@@ -1,8 +1,15 @@
 package n
 
 import (
+	"fmt"
+	"reflect"
+	"sort"
+
 	"github.com/phR0ze/n/pkg/enc/json"
 	"github.com/phR0ze/n/pkg/enc/yaml"
+	"github.com/phR0ze/n/pkg/jq"
+	"github.com/phR0ze/n/pkg/patch"
+	"github.com/phR0ze/n/pkg/schema"
 	"github.com/pkg/errors"
 )
 
@@ -55,6 +62,57 @@ func (p *StringMap) Any(keys ...interface{}) bool {
 	return false
 }
 
+// AnyS tests if this Map contains any of the given Slice's elements as keys.
+func (p *StringMap) AnyS(keys interface{}) bool {
+	if p == nil || len(*p) == 0 {
+		return false
+	}
+	for _, key := range ToStrs(keys) {
+		if _, ok := (*p)[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// AnyW tests if this Map contains any key-value pairs that match the lambda selector.
+func (p *StringMap) AnyW(sel func(k, v O) bool) bool {
+	return p.CountW(sel) != 0
+}
+
+// Apply modifies this Map by applying the given RFC 6902 JSON Patch and returns a reference to this Map.
+// The patch may be a patch.Patch, a JSON string or []byte or a slice of operation maps.
+func (p *StringMap) Apply(jsonPatch interface{}) IMap {
+	m, _ := p.ApplyE(jsonPatch)
+	return m
+}
+
+// ApplyE modifies this Map by applying the given RFC 6902 JSON Patch and returns a reference to this Map.
+// The patch is applied atomically i.e. if any operation fails this Map is left untouched.
+// The patch may be a patch.Patch, a JSON string or []byte or a slice of operation maps.
+func (p *StringMap) ApplyE(jsonPatch interface{}) (m IMap, err error) {
+	if p == nil {
+		p = NewStringMapV()
+	}
+	m = p
+
+	var ops patch.Patch
+	if ops, err = patch.Parse(jsonPatch); err != nil {
+		return
+	}
+	var result interface{}
+	if result, err = ops.Apply(p.G()); err != nil {
+		return
+	}
+	x, ok := result.(map[string]interface{})
+	if !ok {
+		err = errors.Errorf("failed to apply patch, result is %T not a map", result)
+		return
+	}
+	*p = StringMap(x)
+	return
+}
+
 // Clear modifies this Map to clear out all key-value pairs and returns a reference to this Map.
 func (p *StringMap) Clear() IMap {
 	if p == nil {
@@ -86,6 +144,39 @@ func (p *StringMap) Copy(keys ...interface{}) (new IMap) {
 	return val
 }
 
+// CountW counts the number of key-value pairs in this Map that match the lambda selector.
+func (p *StringMap) CountW(sel func(k, v O) bool) (cnt int) {
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	for k, v := range *p {
+		if sel(k, v) {
+			cnt++
+		}
+	}
+	return
+}
+
+// Decode populates the given struct pointer from this Map using the n, yaml or json field tags in
+// that order of precedence to find the keys, falling back on a case insensitive match of the field
+// name. Values are converted using the To conversion functions e.g. ToDurationE, ToTimeE and
+// fields with a default tag e.g. `default:"8080"` are set from it when the key is missing and the
+// field is still zero. Keys without matching fields are ignored. All fields that failed to decode
+// are reported in the returned schema.Errors with their jq style paths.
+func (p *StringMap) Decode(obj interface{}) (err error) {
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return errors.Errorf("Decode requires a non nil pointer not %T", obj)
+	}
+
+	var errs schema.Errors
+	decodeValue(&errs, ".", p.G(), v.Elem())
+	if len(errs) > 0 {
+		err = errs
+	}
+	return
+}
+
 // Delete modifies this Map to delete the indicated key-value pair and returns the value from the Map.
 func (p *StringMap) Delete(key interface{}) (val *Object) {
 	val = &Object{}
@@ -110,6 +201,39 @@ func (p *StringMap) DeleteM(key interface{}) IMap {
 	return p
 }
 
+// DeleteS modifies this Map to delete the indicated key-value pairs and returns the values from the Map as a Slice.
+func (p *StringMap) DeleteS(keys interface{}) (vals ISlice) {
+	vals = NewInterSliceV()
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	for _, k := range ToStrs(keys) {
+		if v, ok := (*p)[k]; ok {
+			vals.Append(v)
+			delete(*p, k)
+		}
+	}
+	return
+}
+
+// DeleteW modifies this Map to delete the key-value pairs that match the lambda selector and returns a reference to this Map.
+func (p *StringMap) DeleteW(sel func(k, v O) bool) IMap {
+	if p == nil {
+		return p
+	}
+	for k, v := range *p {
+		if sel(k, v) {
+			delete(*p, k)
+		}
+	}
+	return p
+}
+
+// Diff returns the RFC 6902 JSON Patch that when applied to this Map will produce the given map.
+func (p *StringMap) Diff(m IMap) (ops patch.Patch) {
+	return patch.Diff(p.G(), ToStringMap(m).G())
+}
+
 // Dump convert the StringMap into a pretty printed yaml string
 func (p *StringMap) Dump() (pretty string) {
 	if yml, err := yaml.Marshal(p.G()); err == nil {
@@ -118,6 +242,70 @@ func (p *StringMap) Dump() (pretty string) {
 	return
 }
 
+// Each calls the given lambda once for each key-value pair in this Map in sorted key order,
+// passing in the key and value as parameters; Returns a reference to this Map.
+func (p *StringMap) Each(action func(k, v O)) IMap {
+	if p == nil {
+		return p
+	}
+	for _, k := range stringMapKeys(*p) {
+		action(k, (*p)[k])
+	}
+	return p
+}
+
+// EachE calls the given lambda once for each key-value pair in this Map in sorted key order,
+// passing in the key and value as parameters; Returns a reference to this Map and any error from the lambda.
+func (p *StringMap) EachE(action func(k, v O) error) (IMap, error) {
+	var err error
+	if p == nil {
+		return p, err
+	}
+	for _, k := range stringMapKeys(*p) {
+		if err = action(k, (*p)[k]); err != nil {
+			return p, err
+		}
+	}
+	return p, err
+}
+
+// EachR calls the given lambda once for each key-value pair in this Map in reverse sorted key order,
+// passing in the key and value as parameters; Returns a reference to this Map.
+func (p *StringMap) EachR(action func(k, v O)) IMap {
+	if p == nil {
+		return p
+	}
+	keys := stringMapKeys(*p)
+	for i := len(keys) - 1; i >= 0; i-- {
+		action(keys[i], (*p)[keys[i]])
+	}
+	return p
+}
+
+// EachRE calls the given lambda once for each key-value pair in this Map in reverse sorted key order,
+// passing in the key and value as parameters; Returns a reference to this Map and any error from the lambda.
+func (p *StringMap) EachRE(action func(k, v O) error) (IMap, error) {
+	var err error
+	if p == nil {
+		return p, err
+	}
+	keys := stringMapKeys(*p)
+	for i := len(keys) - 1; i >= 0; i-- {
+		if err = action(keys[i], (*p)[keys[i]]); err != nil {
+			return p, err
+		}
+	}
+	return p, err
+}
+
+// Empty tests if this Map is empty.
+func (p *StringMap) Empty() bool {
+	if p == nil || len(*p) == 0 {
+		return true
+	}
+	return false
+}
+
 // Exists checks if the given key exists in this Map.
 func (p *StringMap) Exists(key interface{}) bool {
 	if p == nil {
@@ -267,13 +455,16 @@ func (p *StringMap) InjectE(key string, val interface{}) (m IMap, err error) {
 	return
 }
 
-// Keys returns all the keys in this Map as a ISlice of the key type.
+// Iter returns an Iterator over the key-value pairs of this Map in key order. Element will be a Pair.
+func (p *StringMap) Iter() (it Iterator) {
+	return newMapIter(p)
+}
+
+// Keys returns all the keys in this Map as a ISlice of the key type in sorted order.
 func (p *StringMap) Keys() ISlice {
 	keys := NewStringSliceV()
 	if p != nil {
-		for key := range *p {
-			*keys = append(*keys, key)
-		}
+		*keys = append(*keys, stringMapKeys(*p)...)
 	}
 	return keys
 }
@@ -426,6 +617,43 @@ func (p *StringMap) MergeG(m IMap, location ...string) map[string]interface{} {
 	return p.G()
 }
 
+// MergeDiff returns the RFC 7386 JSON Merge Patch that when applied to this Map will produce the given map.
+func (p *StringMap) MergeDiff(m IMap) (new *StringMap) {
+	return ToStringMap(patch.MergeDiff(p.G(), ToStringMap(m).G()))
+}
+
+// MergePatch modifies this Map by applying the given RFC 7386 JSON Merge Patch and returns a reference to this Map.
+// Unlike Merge, null values in the patch remove the key from this Map and arrays are replaced whole.
+func (p *StringMap) MergePatch(mergePatch interface{}) IMap {
+	m, _ := p.MergePatchE(mergePatch)
+	return m
+}
+
+// MergePatchE modifies this Map by applying the given RFC 7386 JSON Merge Patch and returns a reference to this Map.
+// Unlike Merge, null values in the patch remove the key from this Map and arrays are replaced whole.
+func (p *StringMap) MergePatchE(mergePatch interface{}) (m IMap, err error) {
+	if p == nil {
+		p = NewStringMapV()
+	}
+	m = p
+
+	var x *StringMap
+	if x, err = ToStringMapE(mergePatch); err != nil {
+		err = errors.Wrap(err, "failed to convert merge patch into a StringMap")
+		return
+	}
+	*p = StringMap(patch.MergePatch(p.G(), x.G()).(map[string]interface{}))
+	return
+}
+
+// Nil tests if this Map is nil.
+func (p *StringMap) Nil() bool {
+	if p == nil {
+		return true
+	}
+	return false
+}
+
 // O returns the underlying data structure as is.
 func (p *StringMap) O() interface{} {
 	if p == nil {
@@ -443,12 +671,33 @@ func (p *StringMap) Query(key string) (val *Object) {
 
 // QueryE returns the value at the given key location, using a jq type selectors. Returns empty *Object if not found.
 // see dot notation from https://stedolan.github.io/jq/manual/#Basicfilters with some caveats
+//
+// Expressions beyond simple key and index selectors e.g. pipes, select(), slices, recursive
+// descent or object construction are evaluated with the full jq language. A single result is
+// returned as is while an expression that fans out returns all results as a []interface{}.
 func (p *StringMap) QueryE(key string) (val *Object, err error) {
 	if p == nil || len(*p) == 0 {
 		err = errors.Errorf("failed to query empty map")
 		return
 	}
 
+	// Hand off full jq expressions to the jq engine
+	if jqSelector(key) {
+		var results []interface{}
+		if results, err = jq.Eval(key, p); err != nil {
+			return
+		}
+		val = &Object{}
+		switch len(results) {
+		case 0:
+		case 1:
+			val.o = results[0]
+		default:
+			val.o = results
+		}
+		return
+	}
+
 	// Default object is self for identity case: .
 	val = &Object{o: p}
 	if p == nil {
@@ -505,6 +754,41 @@ func (p *StringMap) QueryE(key string) (val *Object, err error) {
 	return
 }
 
+// QueryS returns all values produced by the given jq expression as a Slice of the results.
+// Returns an empty Slice if nothing is found. see https://stedolan.github.io/jq/manual
+func (p *StringMap) QueryS(key string) (vals ISlice) {
+	vals, _ = p.QuerySE(key)
+	return
+}
+
+// QuerySE returns all values produced by the given jq expression as a Slice of the results.
+// Returns an empty Slice if nothing is found. Simple key and index selectors are supported
+// as with QueryE and will return at most a single value. see https://stedolan.github.io/jq/manual
+func (p *StringMap) QuerySE(key string) (vals ISlice, err error) {
+	slice := NewInterSliceV()
+	vals = slice
+	if p == nil || len(*p) == 0 {
+		err = errors.Errorf("failed to query empty map")
+		return
+	}
+
+	// Simple selectors resolve to at most a single value
+	if !jqSelector(key) {
+		var val *Object
+		if val, err = p.QueryE(key); err == nil && !val.Nil() {
+			slice.Append(val.o)
+		}
+		return
+	}
+
+	var results []interface{}
+	if results, err = jq.Eval(key, p); err != nil {
+		return
+	}
+	*slice = append(*slice, results...)
+	return
+}
+
 // Remove modifies this Map to delete the given key location, using jq type selectors
 // and returns a reference to this Map rather than the deleted value.
 // see dot notation from https://stedolan.github.io/jq/manual/#Basicfilters with some caveats
@@ -618,6 +902,26 @@ func (p *StringMap) RemoveE(key string) (m IMap, err error) {
 	return
 }
 
+// Reverse returns a new Map with the key-value pairs in reverse sorted key order. As this Map type
+// has no key order of its own the result is an *OrderedMap with the keys converted to strings.
+func (p *StringMap) Reverse() (new IMap) {
+	return reverseMap(p)
+}
+
+// Select returns a new Map with the key-value pairs that match the lambda selector.
+func (p *StringMap) Select(sel func(k, v O) bool) (new IMap) {
+	val := NewStringMapV()
+	if p == nil {
+		return val
+	}
+	for k, v := range *p {
+		if sel(k, v) {
+			(*val)[k] = v
+		}
+	}
+	return val
+}
+
 // Set the value for the given key to the given val. Returns true if the key did not yet exists in this Map.
 func (p *StringMap) Set(key, val interface{}) (new bool) {
 	if p == nil {
@@ -640,6 +944,22 @@ func (p *StringMap) SetM(key, val interface{}) IMap {
 	return p
 }
 
+// Single reports true if there is only one key-value pair in this Map.
+func (p *StringMap) Single() bool {
+	return p.Len() == 1
+}
+
+// Sort returns a new Map with the key-value pairs in sorted key order. As this Map type always
+// iterates in sorted key order this is simply a copy of all key-value pairs.
+func (p *StringMap) Sort() (new IMap) {
+	return p.Copy()
+}
+
+// String returns a string representation of this Map in sorted key order, implements the Stringer interface
+func (p *StringMap) String() string {
+	return fmt.Sprintf("&%v", p.G())
+}
+
 // ToStringMap converts the map to a *StringMap
 func (p *StringMap) ToStringMap() (m *StringMap) {
 	return ToStringMap(p)
@@ -650,6 +970,31 @@ func (p *StringMap) ToStringMapG() (m map[string]interface{}) {
 	return p.O().(map[string]interface{})
 }
 
+// Validate checks this Map against the given JSON Schema returning all validation errors found with
+// jq style paths matching the Query selector syntax. The schema may be given as a JSON or YAML string
+// or []byte, a map or a *schema.Schema. Returns an error only if the schema itself is invalid.
+func (p *StringMap) Validate(s interface{}) (errs schema.Errors, err error) {
+	compiled, ok := s.(*schema.Schema)
+	if !ok {
+		if compiled, err = schema.Compile(s); err != nil {
+			return
+		}
+	}
+	errs = compiled.Validate(p.G())
+	return
+}
+
+// Values returns all the values in this Map as a ISlice in sorted key order.
+func (p *StringMap) Values() ISlice {
+	vals := NewInterSliceV()
+	if p != nil {
+		for _, k := range stringMapKeys(*p) {
+			*vals = append(*vals, (*p)[k])
+		}
+	}
+	return vals
+}
+
 // YAML converts the Map into a YAML string
 func (p *StringMap) YAML() (data string) {
 	_data, err := yaml.Marshal(p.G())
@@ -682,3 +1027,13 @@ func (p *StringMap) WriteJSON(filename string) (err error) {
 func (p *StringMap) WriteYAML(filename string) (err error) {
 	return yaml.WriteYAML(filename, p.G())
 }
+
+// stringMapKeys returns the keys of the given map in sorted order
+func stringMapKeys(m map[string]interface{}) (keys []string) {
+	keys = make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return
+}
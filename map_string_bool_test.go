@@ -1,13 +1,392 @@
 package n
 
 import (
+	"fmt"
+	"path"
 	"testing"
 
+	"github.com/phR0ze/n/pkg/sys"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
+// NewStringMapBool
+//--------------------------------------------------------------------------------------------------
+func ExampleNewStringMapBool() {
+	m := NewStringMapBool(map[string]bool{"b": true, "a": false})
+	fmt.Println(m)
+	// Output: &map[a:false b:true]
+}
+
+func TestNewStringMapBool(t *testing.T) {
+	assert.Equal(t, &StringMapBool{}, NewStringMapBool())
+	assert.Equal(t, &StringMapBool{"a": true}, NewStringMapBool(map[string]bool{"a": true}))
+}
+
+// Any
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_Any(t *testing.T) {
+	assert.Equal(t, false, (*StringMapBool)(nil).Any())
+	assert.Equal(t, false, NewStringMapBool().Any())
+
+	m := NewStringMapBool(map[string]bool{"a": true, "b": false})
+	assert.Equal(t, true, m.Any())
+	assert.Equal(t, true, m.Any("c", "b"))
+	assert.Equal(t, true, m.Any("a"))
+	assert.Equal(t, false, m.Any("c"))
+	assert.Equal(t, false, m.Any(nil))
+
+	assert.Equal(t, true, m.AnyS([]string{"c", "a"}))
+	assert.Equal(t, false, m.AnyS([]string{"c"}))
+	assert.Equal(t, false, m.AnyS(nil))
+	assert.Equal(t, true, m.AnyW(func(k, v O) bool { return k == "b" && v == false }))
+	assert.Equal(t, false, m.AnyW(func(k, v O) bool { return k == "c" }))
+}
+
+// Clear
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_Clear(t *testing.T) {
+	assert.Equal(t, NewStringMapBool(), (*StringMapBool)(nil).Clear())
+
+	m := NewStringMapBool(map[string]bool{"a": true, "b": false})
+	assert.Equal(t, NewStringMapBool(), m.Clear())
+	assert.Equal(t, NewStringMapBool(), m)
+}
+
+// Copy
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_Copy(t *testing.T) {
+	assert.Equal(t, NewStringMapBool(), (*StringMapBool)(nil).Copy())
+
+	m := NewStringMapBool(map[string]bool{"a": true, "b": false})
+	cp := m.Copy()
+	assert.Equal(t, m, cp)
+	cp.Set("a", false)
+	assert.Equal(t, true, m.Get("a").O())
+
+	assert.Equal(t, NewStringMapBool(map[string]bool{"b": false}), m.Copy("b", "c", nil))
+}
+
+// CountW
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_CountW(t *testing.T) {
+	assert.Equal(t, 0, (*StringMapBool)(nil).CountW(func(k, v O) bool { return true }))
+
+	m := NewStringMapBool(map[string]bool{"a": true, "b": false, "c": true})
+	assert.Equal(t, 2, m.CountW(func(k, v O) bool { return v.(bool) }))
+	assert.Equal(t, 3, m.CountW(func(k, v O) bool { return true }))
+}
+
+// Delete
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_Delete(t *testing.T) {
+	assert.Equal(t, &Object{}, (*StringMapBool)(nil).Delete("a"))
+	assert.Equal(t, (*StringMapBool)(nil), (*StringMapBool)(nil).DeleteM("a"))
+
+	m := NewStringMapBool(map[string]bool{"a": true, "b": false, "c": true})
+	assert.Equal(t, true, m.Delete("a").O())
+	assert.Equal(t, &Object{}, m.Delete("a"))
+	assert.Equal(t, &Object{}, m.Delete(nil))
+	assert.Equal(t, NewStringMapBool(map[string]bool{"c": true}), m.DeleteM("b"))
+}
+
+// DeleteS
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_DeleteS(t *testing.T) {
+	assert.Equal(t, NewInterSliceV(), (*StringMapBool)(nil).DeleteS([]string{"a"}))
+
+	m := NewStringMapBool(map[string]bool{"a": true, "b": false, "c": true})
+	assert.Equal(t, NewInterSliceV(false, true), m.DeleteS([]string{"b", "a"}))
+	assert.Equal(t, NewStringMapBool(map[string]bool{"c": true}), m)
+}
+
+// DeleteW
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_DeleteW(t *testing.T) {
+	assert.Equal(t, (*StringMapBool)(nil), (*StringMapBool)(nil).DeleteW(func(k, v O) bool { return true }))
+
+	m := NewStringMapBool(map[string]bool{"a": true, "b": false, "c": true})
+	assert.Equal(t, NewStringMapBool(map[string]bool{"b": false}), m.DeleteW(func(k, v O) bool { return v.(bool) }))
+}
+
+// Each
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMapBool_Each() {
+	m := NewStringMapBool(map[string]bool{"b": true, "a": false})
+	m.Each(func(k, v O) {
+		fmt.Printf("%v:%v\n", k, v)
+	})
+	// Output: a:false
+	// b:true
+}
+
+func TestStringMapBool_Each(t *testing.T) {
+	(*StringMapBool)(nil).Each(func(k, v O) { assert.Fail(t, "shouldn't be called") })
+	(*StringMapBool)(nil).EachR(func(k, v O) { assert.Fail(t, "shouldn't be called") })
+
+	m := NewStringMapBool(map[string]bool{"c": true, "a": true, "b": false})
+
+	// sorted key order
+	{
+		keys := []string{}
+		assert.Equal(t, m, m.Each(func(k, v O) { keys = append(keys, k.(string)) }))
+		assert.Equal(t, []string{"a", "b", "c"}, keys)
+	}
+
+	// reverse sorted key order
+	{
+		keys := []string{}
+		assert.Equal(t, m, m.EachR(func(k, v O) { keys = append(keys, k.(string)) }))
+		assert.Equal(t, []string{"c", "b", "a"}, keys)
+	}
+
+	// stop on the first error
+	{
+		keys := []string{}
+		_, err := m.EachE(func(k, v O) error {
+			keys = append(keys, k.(string))
+			if !v.(bool) {
+				return errors.New("failed")
+			}
+			return nil
+		})
+		assert.Equal(t, "failed", err.Error())
+		assert.Equal(t, []string{"a", "b"}, keys)
+
+		keys = []string{}
+		_, err = m.EachRE(func(k, v O) error {
+			keys = append(keys, k.(string))
+			return Break
+		})
+		assert.Equal(t, Break, err)
+		assert.Equal(t, []string{"c"}, keys)
+
+		_, err = (*StringMapBool)(nil).EachE(func(k, v O) error { return Break })
+		assert.Nil(t, err)
+		_, err = (*StringMapBool)(nil).EachRE(func(k, v O) error { return Break })
+		assert.Nil(t, err)
+	}
+}
+
+// Empty
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_Empty(t *testing.T) {
+	assert.Equal(t, true, (*StringMapBool)(nil).Empty())
+	assert.Equal(t, true, NewStringMapBool().Empty())
+	assert.Equal(t, false, NewStringMapBool(map[string]bool{"a": true}).Empty())
+}
+
+// Exists
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_Exists(t *testing.T) {
+	assert.Equal(t, false, (*StringMapBool)(nil).Exists("a"))
+
+	m := NewStringMapBool(map[string]bool{"a": false})
+	assert.Equal(t, true, m.Exists("a"))
+	assert.Equal(t, false, m.Exists("b"))
+	assert.Equal(t, false, m.Exists(nil))
+}
+
+// Get
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_Get(t *testing.T) {
+	assert.Equal(t, &Object{}, (*StringMapBool)(nil).Get("a"))
+
+	m := NewStringMapBool(map[string]bool{"a": false})
+	assert.Equal(t, false, m.Get("a").O())
+	assert.Equal(t, false, m.Get("a").O())
+	assert.Equal(t, &Object{}, m.Get("b"))
+	assert.Equal(t, false, m.Generic())
+}
+
+// Inject
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_Inject(t *testing.T) {
+	assert.Equal(t, NewStringMapBool(map[string]bool{"a": true}), (*StringMapBool)(nil).Inject("a", true))
+
+	m := NewStringMapBool(map[string]bool{"a": false})
+	assert.Equal(t, NewStringMapBool(map[string]bool{"a": true, "b": true}), m.Inject("a", true).Inject(".b", "true"))
+
+	_, err := m.InjectE("a.b", true)
+	assert.Equal(t, "invalid key selector a.b, only a single key is supported", err.Error())
+	_, err = m.InjectE("a", "bogus")
+	assert.Contains(t, err.Error(), "failed to inject value for key "+"a")
+}
+
+// Iter
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_Iter(t *testing.T) {
+	assert.False(t, (*StringMapBool)(nil).Iter().Next())
+	assert.False(t, NewStringMapBool().Iter().Next())
+
+	it := NewStringMapBool(map[string]bool{"b": false, "a": true}).Iter()
+	assert.True(t, it.Next())
+	assert.Equal(t, 0, it.Index())
+	assert.Equal(t, Pair{Key: "a", Val: true}, it.Value())
+	assert.True(t, it.Next())
+	assert.Equal(t, 1, it.Index())
+	assert.Equal(t, Pair{Key: "b", Val: false}, it.Value())
+	assert.False(t, it.Next())
+	assert.Nil(t, it.Err())
+}
+
+// Keys
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_Keys(t *testing.T) {
+	assert.Equal(t, []string{}, (*StringMapBool)(nil).Keys().O())
+	assert.Equal(t, []string{}, NewStringMapBool().Keys().O())
+	assert.Equal(t, []string{"a", "b", "c"}, NewStringMapBool(map[string]bool{"c": true, "a": true, "b": false}).Keys().O())
+}
+
+// Len
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_Len(t *testing.T) {
+	assert.Equal(t, 0, (*StringMapBool)(nil).Len())
+	assert.Equal(t, 2, NewStringMapBool(map[string]bool{"a": true, "b": false}).Len())
+	assert.Equal(t, true, NewStringMapBool(map[string]bool{"a": true}).Single())
+	assert.Equal(t, false, NewStringMapBool().Single())
+}
+
+// M
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_M(t *testing.T) {
+	assert.Equal(t, NewStringMapV(), (*StringMapBool)(nil).M())
+
+	m := NewStringMapBool(map[string]bool{"a": true, "b": false})
+	assert.Equal(t, NewStringMapV(map[string]interface{}{"a": true, "b": false}), m.M())
+	assert.Equal(t, map[string]interface{}{"a": true, "b": false}, m.MG())
+	assert.Equal(t, m.MG(), ToStringMap(m).G())
+}
+
+// Merge
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_Merge(t *testing.T) {
+	assert.Equal(t, NewStringMapBool(map[string]bool{"a": true}), (*StringMapBool)(nil).Merge(NewStringMapBool(map[string]bool{"a": true})))
+
+	m := NewStringMapBool(map[string]bool{"a": true, "b": false})
+	m.Merge(NewStringMapV(map[string]interface{}{"b": true, "c": "false", "a": "bogus"}))
+	assert.Equal(t, NewStringMapBool(map[string]bool{"a": true, "b": true, "c": false}), m)
+	m.Merge(NewStringMapBool(map[string]bool{"a": false}), "a")
+	assert.Equal(t, true, m.Get("a").O())
+	m.Merge(nil)
+	assert.Equal(t, 3, m.Len())
+}
+
+// O
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_O(t *testing.T) {
+	assert.Equal(t, map[string]bool{}, (*StringMapBool)(nil).O())
+	assert.Equal(t, map[string]bool{"a": true}, NewStringMapBool(map[string]bool{"a": true}).O())
+	assert.Equal(t, true, (*StringMapBool)(nil).Nil())
+	assert.Equal(t, false, NewStringMapBool().Nil())
+}
+
+// Query
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_Query(t *testing.T) {
+	m := NewStringMapBool(map[string]bool{"a": true, "b": false})
+	assert.Equal(t, true, m.Query("a").O())
+	assert.Equal(t, false, m.Query(`"b"`).O())
+	assert.Equal(t, nil, m.Query("c").O())
+	assert.Equal(t, []interface{}{true, false}, m.QueryS(`."a", ."b"`).O())
+}
+
+// Remove
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_Remove(t *testing.T) {
+	assert.Equal(t, (*StringMapBool)(nil), (*StringMapBool)(nil).Remove("a"))
+
+	m := NewStringMapBool(map[string]bool{"a": true, "b": false})
+	assert.Equal(t, NewStringMapBool(map[string]bool{"b": false}), m.Remove("a").Remove("c"))
+
+	_, err := m.RemoveE("b.a")
+	assert.Equal(t, "invalid key selector b.a, only a single key is supported", err.Error())
+}
+
+// Reverse
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_Reverse(t *testing.T) {
+	assert.Equal(t, NewOrderedMapV(), (*StringMapBool)(nil).Reverse())
+
+	m := NewStringMapBool(map[string]bool{"b": true, "a": false, "c": true})
+	assert.Equal(t, NewOrderedMapV("c", true, "b", true, "a", false), m.Reverse())
+}
+
+// Select
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_Select(t *testing.T) {
+	assert.Equal(t, NewStringMapBool(), (*StringMapBool)(nil).Select(func(k, v O) bool { return true }))
+
+	m := NewStringMapBool(map[string]bool{"a": true, "b": false, "c": true})
+	assert.Equal(t, NewStringMapBool(map[string]bool{"a": true, "c": true}), m.Select(func(k, v O) bool { return v.(bool) }))
+	assert.Equal(t, 3, m.Len())
+}
+
+// Set
+//--------------------------------------------------------------------------------------------------
 func TestStringMapBool_Set(t *testing.T) {
+	assert.Equal(t, false, (*StringMapBool)(nil).Set("a", true))
+	assert.Equal(t, NewStringMapBool(map[string]bool{"a": true}), (*StringMapBool)(nil).SetM("a", true))
+
 	m := NewStringMapBool()
-	assert.Equal(t, true, m.Set("test", true))
-	assert.Equal(t, false, m.Set("test", true))
+	assert.Equal(t, true, m.Set("a", true))
+	assert.Equal(t, false, m.Set("a", false))
+	assert.Equal(t, false, (*m)["a"])
+	assert.Equal(t, false, m.Set(nil, true))
+	assert.Equal(t, false, m.Set("b", "bogus"))
+	assert.Equal(t, 1, m.Len())
+}
+
+// Sort
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_Sort(t *testing.T) {
+	assert.Equal(t, NewStringMapBool(), (*StringMapBool)(nil).Sort())
+
+	m := NewStringMapBool(map[string]bool{"b": true, "a": false})
+	assert.Equal(t, m, m.Sort())
+	assert.Equal(t, []string{"a", "b"}, m.Sort().Keys().O())
+}
+
+// String
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_String(t *testing.T) {
+	assert.Equal(t, "&map[]", (*StringMapBool)(nil).String())
+	assert.Equal(t, "&map[a:true b:false]", NewStringMapBool(map[string]bool{"b": false, "a": true}).String())
+}
+
+// Values
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_Values(t *testing.T) {
+	assert.Equal(t, []bool{}, (*StringMapBool)(nil).Values().O())
+	assert.Equal(t, []bool{true, false, true}, NewStringMapBool(map[string]bool{"c": true, "a": true, "b": false}).Values().O())
+}
+
+// YAML
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_YAML(t *testing.T) {
+	m := NewStringMapBool(map[string]bool{"b": false, "a": true})
+	assert.Equal(t, "a: true\nb: false\n", m.YAML())
+	assert.Equal(t, "{}\n", (*StringMapBool)(nil).YAML())
+}
+
+// WriteJSON
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_WriteJSON(t *testing.T) {
+	clearTmpDir()
+	filename := path.Join(tmpDir, "map.json")
+	m := NewStringMapBool(map[string]bool{"a": true})
+	assert.Nil(t, m.WriteJSON(filename))
+	assert.Equal(t, m.M(), LoadJSON(filename))
+}
+
+// WriteYAML
+//--------------------------------------------------------------------------------------------------
+func TestStringMapBool_WriteYAML(t *testing.T) {
+	clearTmpDir()
+	filename := path.Join(tmpDir, "map.yaml")
+	m := NewStringMapBool(map[string]bool{"a": true})
+	assert.Nil(t, m.WriteYAML(filename))
+	data, err := sys.ReadString(filename)
+	assert.Nil(t, err)
+	assert.Equal(t, "a: true\n", data)
 }
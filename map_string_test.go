@@ -2,8 +2,14 @@ package n
 
 import (
 	"fmt"
+	"net"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/phR0ze/n/pkg/patch"
+	"github.com/phR0ze/n/pkg/schema"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -113,6 +119,92 @@ func TestStringMap_Any(t *testing.T) {
 	}
 }
 
+// AnyS
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMap_AnyS() {
+	m := NewStringMapV(map[string]interface{}{"k": "v"})
+	fmt.Println(m.AnyS([]string{"x", "k"}))
+	// Output: true
+}
+
+func TestStringMap_AnyS(t *testing.T) {
+	assert.Equal(t, false, (*StringMap)(nil).AnyS([]string{"1"}))
+	assert.Equal(t, false, NewStringMapV().AnyS([]string{"1"}))
+	m := NewStringMapV(map[string]interface{}{"1": "one", "2": "two"})
+	assert.Equal(t, true, m.AnyS([]string{"3", "2"}))
+	assert.Equal(t, true, m.AnyS(NewStringSliceV("1")))
+	assert.Equal(t, false, m.AnyS([]string{"3"}))
+	assert.Equal(t, false, m.AnyS(nil))
+}
+
+// AnyW
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMap_AnyW() {
+	m := NewStringMapV(map[string]interface{}{"k": "v"})
+	fmt.Println(m.AnyW(func(k, v O) bool { return v == "v" }))
+	// Output: true
+}
+
+func TestStringMap_AnyW(t *testing.T) {
+	assert.Equal(t, false, (*StringMap)(nil).AnyW(func(k, v O) bool { return true }))
+	m := NewStringMapV(map[string]interface{}{"1": "one", "2": "two"})
+	assert.Equal(t, true, m.AnyW(func(k, v O) bool { return k == "2" }))
+	assert.Equal(t, false, m.AnyW(func(k, v O) bool { return v == "three" }))
+}
+
+// Apply
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMap_Apply() {
+	m := NewStringMapV(map[string]interface{}{"foo": "bar"})
+	fmt.Println(m.Apply(`[{"op": "add", "path": "/baz", "value": "qux"}]`))
+	// Output: &map[baz:qux foo:bar]
+}
+
+func TestStringMap_Apply(t *testing.T) {
+
+	// nil
+	{
+		m, err := (*StringMap)(nil).ApplyE(`[{"op": "add", "path": "/foo", "value": 1}]`)
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"foo": float64(1)}, m.O())
+	}
+
+	// all operations
+	{
+		m := ToStringMap("foo:\n  bar: 1\n  baz: [1, 2]\nqux: true\n")
+		ops := patch.Patch{
+			{Op: patch.Test, Path: "/qux", Value: true},
+			{Op: patch.Replace, Path: "/foo/bar", Value: 2},
+			{Op: patch.Add, Path: "/foo/baz/-", Value: 3},
+			{Op: patch.Remove, Path: "/foo/baz/0"},
+			{Op: patch.Copy, From: "/foo/baz", Path: "/copy"},
+			{Op: patch.Move, From: "/qux", Path: "/moved"},
+		}
+		assert.Equal(t, m, m.Apply(ops))
+		assert.Equal(t, map[string]interface{}{
+			"foo":   map[string]interface{}{"bar": 2, "baz": []interface{}{float64(2), 3}},
+			"copy":  []interface{}{float64(2), 3},
+			"moved": true,
+		}, m.G())
+		assert.Equal(t, 2, m.Query("foo.bar").O())
+	}
+
+	// failures leave the map untouched
+	{
+		m := NewStringMapV(map[string]interface{}{"foo": "bar"})
+		_, err := m.ApplyE(`[{"op": "remove", "path": "/foo"}, {"op": "test", "path": "/foo", "value": "bar"}]`)
+		assert.Equal(t, "failed to apply operation 1 test /foo: key \"foo\" does not exist", err.Error())
+		assert.Equal(t, map[string]interface{}{"foo": "bar"}, m.G())
+
+		_, err = m.ApplyE(`[{"op": "replace", "path": "", "value": [1]}]`)
+		assert.Equal(t, "failed to apply patch, result is []interface {} not a map", err.Error())
+		assert.Equal(t, map[string]interface{}{"foo": "bar"}, m.G())
+
+		_, err = m.ApplyE(`{"op": "remove"}`)
+		assert.NotNil(t, err)
+	}
+}
+
 // Clear
 //--------------------------------------------------------------------------------------------------
 func ExampleStringMap_Clear() {
@@ -178,6 +270,155 @@ func TestStringMap_Copy(t *testing.T) {
 	}
 }
 
+// CountW
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMap_CountW() {
+	m := NewStringMapV(map[string]interface{}{"1": 1, "2": 2, "3": 3})
+	fmt.Println(m.CountW(func(k, v O) bool { return v.(int) > 1 }))
+	// Output: 2
+}
+
+func TestStringMap_CountW(t *testing.T) {
+	assert.Equal(t, 0, (*StringMap)(nil).CountW(func(k, v O) bool { return true }))
+	m := NewStringMapV(map[string]interface{}{"1": 1, "2": 2, "3": 3})
+	assert.Equal(t, 3, m.CountW(func(k, v O) bool { return true }))
+	assert.Equal(t, 1, m.CountW(func(k, v O) bool { return k == "1" }))
+	assert.Equal(t, 0, m.CountW(func(k, v O) bool { return false }))
+}
+
+// Decode
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMap_Decode() {
+	type Server struct {
+		Host    string        `yaml:"host"`
+		Port    int           `yaml:"port" default:"8080"`
+		Timeout time.Duration `yaml:"timeout"`
+	}
+	server := Server{}
+	ToStringMap("host: localhost\ntimeout: 5s\n").Decode(&server)
+	fmt.Println(server)
+	// Output: {localhost 8080 5s}
+}
+
+func TestStringMap_Decode(t *testing.T) {
+
+	// invalid target
+	{
+		assert.Equal(t, "Decode requires a non nil pointer not n.bindConfig", NewStringMapV().Decode(bindConfig{}).Error())
+		assert.Equal(t, "Decode requires a non nil pointer not *n.bindConfig", NewStringMapV().Decode((*bindConfig)(nil)).Error())
+	}
+
+	// nil map applies defaults
+	{
+		cfg := bindConfig{}
+		assert.Nil(t, (*StringMap)(nil).Decode(&cfg))
+		assert.Equal(t, bindConfig{Tags: []string{"a", "b"}}, cfg)
+	}
+
+	// yaml document
+	{
+		m := ToStringMap(`
+version: 3
+name: foo
+ignored: bar
+debug: "true"
+untagged: untagged
+servers:
+  - host: one
+    port: 80
+    timeout: 1m
+    ip: 10.0.0.1
+  - host: two
+    timeout: 500
+primary:
+  host: three
+labels:
+  env: prod
+limits:
+  cpu: 1.5
+  mem: 2
+tags: [x]
+created: 2020-01-02T03:04:05Z
+extra:
+  nested: {a: 1}
+unknown: 1
+`)
+		cfg := bindConfig{Skipped: "keep"}
+		assert.Nil(t, m.Decode(&cfg))
+		assert.Equal(t, bindConfig{
+			bindBase: bindBase{Version: 3},
+			Name:     "foo",
+			Debug:    true,
+			Servers: []bindServer{
+				{Host: "one", Port: 80, Timeout: time.Minute, IP: net.ParseIP("10.0.0.1")},
+				{Host: "two", Port: 8080, Timeout: 500},
+			},
+			Primary:  &bindServer{Host: "three", Port: 8080, Timeout: 30 * time.Second},
+			Labels:   map[string]string{"env": "prod"},
+			Limits:   map[string]float64{"cpu": 1.5, "mem": 2},
+			Tags:     []string{"x"},
+			Created:  time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+			Extra:    map[string]interface{}{"nested": map[string]interface{}{"a": float64(1)}},
+			Skipped:  "keep",
+			Untagged: "untagged",
+		}, cfg)
+	}
+
+	// nested *StringMap values and existing values
+	{
+		m := NewStringMapV().SetM("name", "foo").SetM("primary", NewStringMapV().SetM("port", 90))
+		cfg := bindConfig{Debug: true, Primary: &bindServer{Host: "keep"}}
+		assert.Nil(t, ToStringMap(m).Decode(&cfg))
+		assert.Equal(t, true, cfg.Debug)
+		assert.Equal(t, &bindServer{Host: "keep", Port: 90, Timeout: 30 * time.Second}, cfg.Primary)
+	}
+
+	// other target types
+	{
+		var m map[string]int
+		assert.Nil(t, ToStringMap("a: 1\nb: 2\n").Decode(&m))
+		assert.Equal(t, map[string]int{"a": 1, "b": 2}, m)
+
+		var ports map[int][2]uint8
+		assert.Nil(t, ToStringMap("80: [1, 2]\n").Decode(&ports))
+		assert.Equal(t, map[int][2]uint8{80: {1, 2}}, ports)
+	}
+
+	// aggregated errors
+	{
+		m := ToStringMap(`
+version: one
+name: [foo]
+servers:
+  - port: 99999999999999999999
+    timeout: soon
+  - ip: bogus
+primary: 1
+limits:
+  cpu: "-1"
+  mem: 2
+created: yesterday
+`)
+		var cfg struct {
+			bindConfig `yaml:",inline"`
+			Limits     map[string]uint8 `yaml:"limits"`
+		}
+		err := m.Decode(&cfg)
+		assert.Equal(t, []string{
+			`.version: failed to convert string to int64: strconv.ParseInt: parsing "one": invalid syntax`,
+			".name: failed to convert type []interface {} to string",
+			".servers.[0].port: value 1e+20 overflows int",
+			`.servers.[0].timeout: failed to convert string to time.Duration: time: invalid duration "soon"`,
+			".servers.[1].ip: invalid IP address: bogus",
+			".primary: failed to convert type float64 to n.bindServer",
+			".created: failed to parse time yesterday",
+			".limits.cpu: value -1 overflows uint8",
+		}, strings.Split(err.Error(), "\n"))
+		assert.Equal(t, 8, len(err.(schema.Errors)))
+		assert.Equal(t, map[string]uint8{"mem": 2}, cfg.Limits)
+	}
+}
+
 // Delete
 //--------------------------------------------------------------------------------------------------
 func ExampleStringMap_Delete() {
@@ -239,6 +480,74 @@ func TestStringMap_DeleteM(t *testing.T) {
 	assert.Equal(t, 0, m.DeleteM("3").Len())
 }
 
+// DeleteS
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMap_DeleteS() {
+	m := NewStringMapV(map[string]interface{}{"1": "one", "2": "two", "3": "three"})
+	fmt.Println(m.DeleteS([]string{"1", "3"}))
+	fmt.Println(m)
+	// Output: [one three]
+	// &map[2:two]
+}
+
+func TestStringMap_DeleteS(t *testing.T) {
+	assert.Equal(t, NewInterSliceV(), (*StringMap)(nil).DeleteS([]string{"1"}))
+
+	m := NewStringMapV(map[string]interface{}{"1": "one", "2": "two", "3": "three"})
+	assert.Equal(t, NewInterSliceV("two"), m.DeleteS([]string{"2", "4"}))
+	assert.Equal(t, NewInterSliceV(), m.DeleteS([]string{"2"}))
+	assert.Equal(t, NewInterSliceV("one", "three"), m.DeleteS(NewStringSliceV("1", "3")))
+	assert.Equal(t, NewStringMapV(), m)
+}
+
+// DeleteW
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMap_DeleteW() {
+	m := NewStringMapV(map[string]interface{}{"1": 1, "2": 2, "3": 3})
+	fmt.Println(m.DeleteW(func(k, v O) bool { return v.(int) > 1 }))
+	// Output: &map[1:1]
+}
+
+func TestStringMap_DeleteW(t *testing.T) {
+	assert.Equal(t, (*StringMap)(nil), (*StringMap)(nil).DeleteW(func(k, v O) bool { return true }))
+
+	m := NewStringMapV(map[string]interface{}{"1": 1, "2": 2, "3": 3})
+	assert.Equal(t, m, m.DeleteW(func(k, v O) bool { return false }))
+	assert.Equal(t, 3, m.Len())
+	m.DeleteW(func(k, v O) bool { return k == "2" })
+	assert.Equal(t, NewStringMapV(map[string]interface{}{"1": 1, "3": 3}), m)
+	m.DeleteW(func(k, v O) bool { return true })
+	assert.Equal(t, NewStringMapV(), m)
+}
+
+// Diff
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMap_Diff() {
+	a := ToStringMap("foo: 1\nbar: [1]\n")
+	b := ToStringMap("foo: 2\nbar: [1, 2]\n")
+	fmt.Println(a.Diff(b))
+	// Output: [{"op":"add","path":"/bar/-","value":2},{"op":"replace","path":"/foo","value":2}]
+}
+
+func TestStringMap_Diff(t *testing.T) {
+
+	// nil and empty
+	{
+		assert.Equal(t, patch.Patch{}, (*StringMap)(nil).Diff(NewStringMapV()))
+		assert.Equal(t, patch.Patch{{Op: patch.Add, Path: "/foo", Value: 1}}, (*StringMap)(nil).Diff(NewStringMapV().SetM("foo", 1)))
+		assert.Equal(t, patch.Patch{{Op: patch.Remove, Path: "/foo"}}, NewStringMapV(map[string]interface{}{"foo": 1}).Diff(nil))
+	}
+
+	// round trip
+	{
+		a := ToStringMap("foo:\n  bar: 1\n  baz: [1, 2, 3]\nqux: 1\n")
+		b := ToStringMap("foo:\n  bar: 2\n  baz: [1, 4]\n  new: {a: b}\n")
+		ops := a.Diff(b)
+		assert.Equal(t, 5, len(ops))
+		assert.Equal(t, b.G(), ToStringMap(a.Apply(ops)).G())
+	}
+}
+
 // Dump
 //--------------------------------------------------------------------------------------------------
 func ExampleStringMap_Dump() {
@@ -264,6 +573,140 @@ func TestStringMap_Dump(t *testing.T) {
 	}
 }
 
+// Each
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMap_Each() {
+	m := NewStringMapV(map[string]interface{}{"b": 2, "a": 1})
+	m.Each(func(k, v O) {
+		fmt.Printf("%v:%v\n", k, v)
+	})
+	// Output: a:1
+	// b:2
+}
+
+func TestStringMap_Each(t *testing.T) {
+
+	// nil or empty
+	{
+		var m *StringMap
+		m.Each(func(k, v O) { assert.Fail(t, "shouldn't be called") })
+		NewStringMapV().Each(func(k, v O) { assert.Fail(t, "shouldn't be called") })
+	}
+
+	// sorted key order
+	{
+		keys, vals := []string{}, []interface{}{}
+		m := NewStringMapV(map[string]interface{}{"c": 3, "a": 1, "b": 2})
+		assert.Equal(t, m, m.Each(func(k, v O) {
+			keys = append(keys, k.(string))
+			vals = append(vals, v)
+		}))
+		assert.Equal(t, []string{"a", "b", "c"}, keys)
+		assert.Equal(t, []interface{}{1, 2, 3}, vals)
+	}
+}
+
+// EachE
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMap_EachE() {
+	m := NewStringMapV(map[string]interface{}{"b": 2, "a": 1})
+	m.EachE(func(k, v O) error {
+		fmt.Printf("%v:%v\n", k, v)
+		return Break
+	})
+	// Output: a:1
+}
+
+func TestStringMap_EachE(t *testing.T) {
+
+	// nil or empty
+	{
+		var m *StringMap
+		_, err := m.EachE(func(k, v O) error { return errors.New("shouldn't be called") })
+		assert.Nil(t, err)
+	}
+
+	// stop on the first error
+	{
+		keys := []string{}
+		m := NewStringMapV(map[string]interface{}{"c": 3, "a": 1, "b": 2})
+		result, err := m.EachE(func(k, v O) error {
+			keys = append(keys, k.(string))
+			if k == "b" {
+				return errors.New("failed")
+			}
+			return nil
+		})
+		assert.Equal(t, m, result)
+		assert.Equal(t, "failed", err.Error())
+		assert.Equal(t, []string{"a", "b"}, keys)
+	}
+}
+
+// EachR
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMap_EachR() {
+	m := NewStringMapV(map[string]interface{}{"b": 2, "a": 1})
+	m.EachR(func(k, v O) {
+		fmt.Printf("%v:%v\n", k, v)
+	})
+	// Output: b:2
+	// a:1
+}
+
+func TestStringMap_EachR(t *testing.T) {
+	var m *StringMap
+	m.EachR(func(k, v O) { assert.Fail(t, "shouldn't be called") })
+
+	keys := []string{}
+	m = NewStringMapV(map[string]interface{}{"c": 3, "a": 1, "b": 2})
+	assert.Equal(t, m, m.EachR(func(k, v O) { keys = append(keys, k.(string)) }))
+	assert.Equal(t, []string{"c", "b", "a"}, keys)
+}
+
+// EachRE
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMap_EachRE() {
+	m := NewStringMapV(map[string]interface{}{"b": 2, "a": 1})
+	m.EachRE(func(k, v O) error {
+		fmt.Printf("%v:%v\n", k, v)
+		return Break
+	})
+	// Output: b:2
+}
+
+func TestStringMap_EachRE(t *testing.T) {
+	var m *StringMap
+	_, err := m.EachRE(func(k, v O) error { return errors.New("shouldn't be called") })
+	assert.Nil(t, err)
+
+	keys := []string{}
+	m = NewStringMapV(map[string]interface{}{"c": 3, "a": 1, "b": 2})
+	result, err := m.EachRE(func(k, v O) error {
+		keys = append(keys, k.(string))
+		if k == "b" {
+			return errors.New("failed")
+		}
+		return nil
+	})
+	assert.Equal(t, m, result)
+	assert.Equal(t, "failed", err.Error())
+	assert.Equal(t, []string{"c", "b"}, keys)
+}
+
+// Empty
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMap_Empty() {
+	fmt.Println(NewStringMapV().Empty())
+	// Output: true
+}
+
+func TestStringMap_Empty(t *testing.T) {
+	assert.Equal(t, true, (*StringMap)(nil).Empty())
+	assert.Equal(t, true, NewStringMapV().Empty())
+	assert.Equal(t, false, NewStringMapV().SetM("1", "one").(*StringMap).Empty())
+}
+
 // Exists
 //--------------------------------------------------------------------------------------------------
 func ExampleStringMap_Exists() {
@@ -583,6 +1026,33 @@ func TestStringMap_Inject(t *testing.T) {
 	}
 }
 
+// Iter
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMap_Iter() {
+	it := NewStringMapV(map[string]interface{}{"b": 2, "a": 1}).Iter()
+	for it.Next() {
+		fmt.Println(it.Index(), it.Value())
+	}
+	// Output:
+	// 0 {a 1}
+	// 1 {b 2}
+}
+
+func TestStringMap_Iter(t *testing.T) {
+	assert.False(t, (*StringMap)(nil).Iter().Next())
+	assert.False(t, NewStringMapV().Iter().Next())
+
+	it := NewStringMapV(map[string]interface{}{"b": 2, "a": 1}).Iter()
+	assert.True(t, it.Next())
+	assert.Equal(t, 0, it.Index())
+	assert.Equal(t, Pair{Key: "a", Val: 1}, it.Value())
+	assert.True(t, it.Next())
+	assert.Equal(t, 1, it.Index())
+	assert.Equal(t, Pair{Key: "b", Val: 2}, it.Value())
+	assert.False(t, it.Next())
+	assert.Nil(t, it.Err())
+}
+
 // Keys
 //--------------------------------------------------------------------------------------------------
 func ExampleStringMap_Keys() {
@@ -1121,6 +1591,76 @@ func TestStringMap_MergeG(t *testing.T) {
 	}
 }
 
+// MergeDiff
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMap_MergeDiff() {
+	a := ToStringMap("foo: 1\nbar: 1\n")
+	b := ToStringMap("foo: 2\n")
+	fmt.Println(a.MergeDiff(b))
+	// Output: &map[bar:<nil> foo:2]
+}
+
+func TestStringMap_MergeDiff(t *testing.T) {
+	a := ToStringMap("foo:\n  bar: 1\n  baz: [1, 2, 3]\nqux: 1\n")
+	b := ToStringMap("foo:\n  bar: 2\n  baz: [1, 4]\n  new: {a: b}\n")
+	diff := a.MergeDiff(b)
+	assert.Equal(t, map[string]interface{}{
+		"foo": map[string]interface{}{"bar": float64(2), "baz": []interface{}{float64(1), float64(4)},
+			"new": map[string]interface{}{"a": "b"}},
+		"qux": nil,
+	}, diff.G())
+	assert.Equal(t, b.G(), ToStringMap(a.MergePatch(diff)).G())
+}
+
+// MergePatch
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMap_MergePatch() {
+	m := ToStringMap("foo: 1\nbar: 1\n")
+	fmt.Println(m.MergePatch(`{"foo": 2, "bar": null}`))
+	// Output: &map[foo:2]
+}
+
+func TestStringMap_MergePatch(t *testing.T) {
+
+	// nil
+	{
+		m, err := (*StringMap)(nil).MergePatchE(map[string]interface{}{"foo": 1})
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"foo": 1}, m.O())
+	}
+
+	// nested maps are merged, nulls removed and arrays replaced
+	{
+		m := ToStringMap("foo:\n  bar: 1\n  baz: [1, 2]\n  del: 1\nqux: true\n")
+		assert.Equal(t, m, m.MergePatch("foo:\n  baz: [3]\n  del: null\n  new: 1\n"))
+		assert.Equal(t, map[string]interface{}{
+			"foo": map[string]interface{}{"bar": float64(1), "baz": []interface{}{float64(3)}, "new": float64(1)},
+			"qux": true,
+		}, m.G())
+	}
+
+	// invalid patch
+	{
+		m := NewStringMapV(map[string]interface{}{"foo": 1})
+		_, err := m.MergePatchE("foo: [")
+		assert.NotNil(t, err)
+		assert.Equal(t, map[string]interface{}{"foo": 1}, m.O())
+	}
+}
+
+// Nil
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMap_Nil() {
+	var m *StringMap
+	fmt.Println(m.Nil())
+	// Output: true
+}
+
+func TestStringMap_Nil(t *testing.T) {
+	assert.Equal(t, true, (*StringMap)(nil).Nil())
+	assert.Equal(t, false, NewStringMapV().Nil())
+}
+
 // O
 //--------------------------------------------------------------------------------------------------
 func ExampleStringMap_O() {
@@ -1234,6 +1774,109 @@ func TestStringMap_Query(t *testing.T) {
 		assert.Equal(t, []int{1, 2}, ToStringMap("foo: \n  - 1\n  - 2").Query("foo").ToIntSliceG())
 		assert.Equal(t, &IntSlice{1, 2}, ToStringMap("foo: \n  - 1\n  - 2").Query("foo").ToIntSlice())
 	}
+
+	// jq expressions
+	{
+		yml := `servers:
+  - name: web
+    port: 80
+    enabled: true
+  - name: db
+    port: 5432
+    enabled: false
+tags: [a, b, c, d, e]
+`
+		m := NewStringMap(yml)
+
+		// Array Slice: .[2:5]
+		assert.Equal(t, []string{"c", "d", "e"}, m.Query(`.tags[2:5]`).ToStrs())
+
+		// Pipes and select with a single result
+		assert.Equal(t, "db", m.Query(`.servers[] | select(.port > 100 and (.enabled | not)) | .name`).ToString())
+
+		// Fan out returns all results
+		assert.Equal(t, []string{"web", "db"}, m.Query(`.servers[].name`).ToStrs())
+
+		// Builtins
+		assert.Equal(t, 2, m.Query(`keys | length`).ToInt())
+		assert.Equal(t, []string{"servers", "tags"}, m.Query(`. | keys`).ToStrs())
+		assert.Equal(t, []int{81, 5433}, m.Query(`.servers | map(.port + 1)`).ToIntSliceG())
+
+		// Object construction
+		assert.Equal(t, map[string]interface{}{"web": float64(80)}, m.Query(`.servers[0] | {(.name): .port}`).ToStringMapG())
+
+		// Nothing found
+		assert.True(t, m.Query(`.servers[] | select(.port == 1)`).Nil())
+
+		// Invalid expression
+		_, err := m.QueryE(`.servers[] |`)
+		assert.NotNil(t, err)
+	}
+
+	// keys with punctuation are still simple key selectors
+	{
+		m := NewStringMapV(map[string]interface{}{"a": map[string]interface{}{
+			"c:d": 1, "x=y": 2, "p$q": 3, "e!": 4, "f(1)": 5,
+		}})
+		assert.Equal(t, 1, m.Query("a.c:d").O())
+		assert.Equal(t, 2, m.Query("a.x=y").O())
+		assert.Equal(t, 3, m.Query("a.p$q").O())
+		assert.Equal(t, 4, m.Query("a.e!").O())
+		assert.Equal(t, 5, m.Query("a.f(1)").O())
+		assert.Equal(t, []interface{}{5}, m.QueryS("a.f(1)").O())
+
+		_, err := m.QueryE("a.f(1)")
+		assert.Nil(t, err)
+	}
+}
+
+// QueryS
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMap_QueryS() {
+	m := ToStringMap("items:\n  - name: foo\n  - name: bar\n")
+	fmt.Println(m.QueryS(`.items[].name`))
+	// Output: [foo bar]
+}
+
+func TestStringMap_QueryS(t *testing.T) {
+	yml := `items:
+  - name: foo
+    val: 1
+  - name: bar
+    val: 2
+  - name: baz
+    val: 3
+`
+	m := NewStringMap(yml)
+
+	// empty map
+	{
+		slice, err := NewStringMapV().QuerySE(`.`)
+		assert.Equal(t, 0, slice.Len())
+		assert.Equal(t, "failed to query empty map", err.Error())
+	}
+
+	// simple selectors
+	{
+		assert.Equal(t, NewInterSliceV("foo"), m.QueryS(`items.[0].name`))
+		assert.Equal(t, NewInterSliceV("bar"), m.QueryS(`items.[name==bar].name`))
+		assert.Equal(t, NewInterSliceV(), m.QueryS(`items.[5].name`))
+	}
+
+	// fan out
+	{
+		assert.Equal(t, NewInterSliceV("foo", "bar", "baz"), m.QueryS(`.items[].name`))
+		assert.Equal(t, NewInterSliceV("bar", "baz"), m.QueryS(`.items[] | select(.val >= 2) | .name`))
+		assert.Equal(t, 2, m.QueryS(`.items[] | select(.val >= 2)`).At(0).Query("val").ToInt())
+		assert.Equal(t, NewInterSliceV(), m.QueryS(`.items[] | select(.val > 5)`))
+	}
+
+	// invalid
+	{
+		slice, err := m.QuerySE(`.items[`)
+		assert.Equal(t, 0, slice.Len())
+		assert.NotNil(t, err)
+	}
 }
 
 // Remove
@@ -1396,6 +2039,37 @@ func TestStringMap_Remove(t *testing.T) {
 	assert.Equal(t, &StringMap{"one": map[string]interface{}{}}, NewStringMapV(map[string]interface{}{"one": map[string]interface{}{"two.three": "foo"}}).Remove(`one."two.three"`))
 }
 
+// Reverse
+//--------------------------------------------------------------------------------------------------
+func TestStringMap_Reverse(t *testing.T) {
+	assert.Equal(t, NewOrderedMapV(), (*StringMap)(nil).Reverse())
+
+	m := NewStringMapV(map[string]interface{}{"b": 2, "a": 1, "c": 3})
+	assert.Equal(t, NewOrderedMapV("c", 3, "b", 2, "a", 1), m.Reverse())
+	assert.Equal(t, []string{"a", "b", "c"}, m.Keys().O())
+}
+
+// Select
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMap_Select() {
+	m := NewStringMapV(map[string]interface{}{"1": 1, "2": 2, "3": 3})
+	fmt.Println(m.Select(func(k, v O) bool { return v.(int) > 1 }))
+	// Output: &map[2:2 3:3]
+}
+
+func TestStringMap_Select(t *testing.T) {
+	assert.Equal(t, NewStringMapV(), (*StringMap)(nil).Select(func(k, v O) bool { return true }))
+
+	m := NewStringMapV(map[string]interface{}{"1": 1, "2": 2, "3": 3})
+	new := m.Select(func(k, v O) bool { return k != "2" })
+	assert.Equal(t, NewStringMapV(map[string]interface{}{"1": 1, "3": 3}), new)
+	assert.Equal(t, 3, m.Len())
+
+	// new map is independent
+	new.Set("4", 4)
+	assert.Equal(t, false, m.Exists("4"))
+}
+
 // Set
 //--------------------------------------------------------------------------------------------------
 func ExampleStringMap_Set() {
@@ -1446,6 +2120,127 @@ func TestStringMap_SetM(t *testing.T) {
 	}
 }
 
+// Single
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMap_Single() {
+	fmt.Println(NewStringMapV().SetM("1", "one").(*StringMap).Single())
+	// Output: true
+}
+
+func TestStringMap_Single(t *testing.T) {
+	assert.Equal(t, false, (*StringMap)(nil).Single())
+	assert.Equal(t, false, NewStringMapV().Single())
+	assert.Equal(t, true, NewStringMapV(map[string]interface{}{"1": 1}).Single())
+	assert.Equal(t, false, NewStringMapV(map[string]interface{}{"1": 1, "2": 2}).Single())
+}
+
+// Sort
+//--------------------------------------------------------------------------------------------------
+func TestStringMap_Sort(t *testing.T) {
+	assert.Equal(t, NewStringMapV(), (*StringMap)(nil).Sort())
+
+	m := NewStringMapV(map[string]interface{}{"b": 2, "a": 1})
+	sorted := m.Sort()
+	assert.Equal(t, m, sorted)
+	sorted.Set("c", 3)
+	assert.Equal(t, 2, m.Len())
+}
+
+// String
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMap_String() {
+	fmt.Println(NewStringMapV(map[string]interface{}{"b": 2, "a": 1}).String())
+	// Output: &map[a:1 b:2]
+}
+
+func TestStringMap_String(t *testing.T) {
+	assert.Equal(t, "&map[]", (*StringMap)(nil).String())
+	assert.Equal(t, "&map[]", NewStringMapV().String())
+	m := NewStringMapV(map[string]interface{}{"b": 2, "a": NewStringMapV(map[string]interface{}{"c": 1})})
+	assert.Equal(t, "&map[a:&map[c:1] b:2]", m.String())
+	assert.Equal(t, m.String(), fmt.Sprint(m))
+}
+
+// Validate
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMap_Validate() {
+	m := ToStringMap("servers:\n  - host: localhost\n    port: http\n")
+	errs, _ := m.Validate(`{"properties": {"servers": {"items": {"properties": {"port": {"type": "integer"}}}}}}`)
+	fmt.Println(errs)
+	// Output: .servers.[0].port: expected type integer but got string
+}
+
+func TestStringMap_Validate(t *testing.T) {
+	s := "type: object\nrequired: [name]\nproperties:\n  name: {type: string}\n  port: {type: integer, maximum: 100}\n"
+
+	// nil
+	{
+		errs, err := (*StringMap)(nil).Validate(s)
+		assert.Nil(t, err)
+		assert.Equal(t, `.: missing required property "name"`, errs.Error())
+	}
+
+	// valid
+	{
+		errs, err := ToStringMap("name: foo\nport: 80\n").Validate(s)
+		assert.Nil(t, err)
+		assert.Nil(t, errs)
+	}
+
+	// invalid using a string, *StringMap or *schema.Schema
+	{
+		m := NewStringMapV(map[string]interface{}{"port": 200})
+		expected := ".: missing required property \"name\"\n.port: expected value at most 100 but got 200"
+
+		errs, err := m.Validate(s)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, errs.Error())
+
+		errs, err = m.Validate(ToStringMap(s))
+		assert.Nil(t, err)
+		assert.Equal(t, expected, errs.Error())
+
+		compiled, err := schema.Compile(s)
+		assert.Nil(t, err)
+		errs, err = m.Validate(compiled)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(errs))
+		assert.Equal(t, ".port", errs[1].Path)
+		assert.Equal(t, 200, m.Query(errs[1].Path).O())
+	}
+
+	// paths can be used to query the invalid values
+	{
+		m := ToStringMap("name: foo\nlog level: [trace]\n")
+		errs, err := m.Validate(`{"properties": {"log level": {"items": {"enum": ["info"]}}}}`)
+		assert.Nil(t, err)
+		assert.Equal(t, `."log level".[0]`, errs[0].Path)
+		assert.Equal(t, "trace", m.Query(errs[0].Path).O())
+	}
+
+	// invalid schema
+	{
+		errs, err := NewStringMapV().Validate(`{"type": "bogus"}`)
+		assert.Nil(t, errs)
+		assert.Equal(t, "failed to compile schema: invalid type bogus at #", err.Error())
+	}
+}
+
+// Values
+//--------------------------------------------------------------------------------------------------
+func ExampleStringMap_Values() {
+	m := NewStringMapV(map[string]interface{}{"b": 2, "a": 1})
+	fmt.Println(m.Values())
+	// Output: [1 2]
+}
+
+func TestStringMap_Values(t *testing.T) {
+	assert.Equal(t, NewInterSliceV(), (*StringMap)(nil).Values())
+	assert.Equal(t, NewInterSliceV(), NewStringMapV().Values())
+	m := NewStringMapV(map[string]interface{}{"3": "three", "1": "one", "2": "two"})
+	assert.Equal(t, NewInterSliceV("one", "two", "three"), m.Values())
+}
+
 // WriteJSON
 //--------------------------------------------------------------------------------------------------
 func TestWriteJSON(t *testing.T) {
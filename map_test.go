@@ -37,6 +37,31 @@ func TestMap(t *testing.T) {
 		m := NewStringMapV()
 		m.Set("k", "v")
 		assert.Equal(t, m, Map(m))
+		assert.Equal(t, NewStringMapV(), Map(nil))
+	}
+
+	// OrderedMap
+	{
+		m := NewOrderedMapV("b", 1, "a", 2)
+		assert.Equal(t, m, Map(m))
+	}
+
+	// StringMapBool, IntMapBool, FloatMapBool, RuneMapBool
+	{
+		m := NewStringMapBool(map[string]bool{"a": true})
+		assert.Equal(t, m, Map(m))
+		assert.Equal(t, NewIntMapBool(map[int]bool{1: true}), Map(map[int]bool{1: true}))
+		assert.Equal(t, NewFloatMapBool(map[float64]bool{1.5: true}), Map(&map[float64]bool{1.5: true}))
+		assert.Equal(t, NewRuneMapBool(map[rune]bool{'a': true}), Map(map[rune]bool{'a': true}))
+	}
+
+	// RefMap
+	{
+		m := Map(map[int]string{2: "two", 1: "one"})
+		assert.True(t, m.Generic())
+		assert.Equal(t, map[int]string{1: "one", 2: "two"}, m.O())
+		assert.Equal(t, []int{1, 2}, m.Keys().O())
+		assert.Equal(t, "&map[1:one 2:two]", m.String())
 	}
 }
 
@@ -377,6 +402,23 @@ func TestStringMap_IdxFromSelector(t *testing.T) {
 	}
 }
 
+// jqSelector
+//--------------------------------------------------------------------------------------------------
+func TestStringMap_jqSelector(t *testing.T) {
+
+	// simple key and index selectors
+	for _, x := range []string{"", ".", "foo", ".foo.bar", `."foo.bar"`, "foo.[2]", "foo.[-1]", "foo.[]",
+		"foo.[k==v]", "a.c:d", "a.x=y", "a.p$q", "a.e!", "a.f(1)", "a.map", "keys"} {
+		assert.False(t, jqSelector(x), x)
+	}
+
+	// full jq expressions
+	for _, x := range []string{".a | keys", "..", ".a, .b", ".a[]", ".a[0]", ".tags[2:5]", "[.a]", "{a: .b}",
+		"(.a)", "if . then 1 else 2 end", ".a + 1", ".a == 1", "select(.a)", ".a[] | select(.b)", "map(.a)"} {
+		assert.True(t, jqSelector(x), x)
+	}
+}
+
 // KeysFromSelector
 //--------------------------------------------------------------------------------------------------
 func TestStringMap_KeysFromSelector(t *testing.T) {
@@ -420,6 +462,10 @@ func TestStringMap_KeysFromSelector(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, &StringSlice{"foo1", "bar.foo2"}, keys)
 
+	keys, err = KeysFromSelector(`foo1."bar foo2"`)
+	assert.Nil(t, err)
+	assert.Equal(t, &StringSlice{"foo1", "bar foo2"}, keys)
+
 	// Array Index: .[], .[0], .[-1]
 	keys, err = KeysFromSelector("foo.[]")
 	assert.Nil(t, err)
@@ -55,10 +55,13 @@
 // • IntSlice
 // • InterSlice
 // • Object
+// • OrderedMap
+// • Query
 // • RefSlice
 // • Str
 // • StringSlice
 // • StringMap
+// • YAMLDoc
 package n
 
 import (
@@ -176,6 +179,55 @@ func LoadJSONE(filepath string) (m *StringMap, err error) {
 	return
 }
 
+// LoadOrderedJSON reads in a json file and converts it to an *OrderedMap keeping the key order
+func LoadOrderedJSON(filepath string) (m *OrderedMap) {
+	m, _ = LoadOrderedJSONE(filepath)
+	return m
+}
+
+// LoadOrderedJSONE reads in a json file and converts it to an *OrderedMap keeping the key order
+func LoadOrderedJSONE(filepath string) (m *OrderedMap, err error) {
+	m = NewOrderedMapV()
+
+	// Read in the json file
+	var data []byte
+	if data, err = ioutil.ReadFile(filepath); err != nil {
+		err = errors.Wrapf(err, "failed to read in the json file %s", filepath)
+		return
+	}
+
+	// Unmarshal the json into an *OrderedMap
+	if err = json.Unmarshal(data, m); err != nil {
+		err = errors.Wrapf(err, "failed to unmarshal json file %s into an *OrderedMap", filepath)
+		return
+	}
+
+	return
+}
+
+// LoadOrderedYAML reads in a yaml file and converts it to an *OrderedMap keeping the key order
+func LoadOrderedYAML(filepath string) (m *OrderedMap) {
+	m, _ = LoadOrderedYAMLE(filepath)
+	return m
+}
+
+// LoadOrderedYAMLE reads in a yaml file and converts it to an *OrderedMap keeping the key order
+func LoadOrderedYAMLE(filepath string) (m *OrderedMap, err error) {
+	m = NewOrderedMapV()
+
+	// Read in the yaml file
+	var data []byte
+	if data, err = ioutil.ReadFile(filepath); err != nil {
+		err = errors.Wrapf(err, "failed to read in the yaml file %s", filepath)
+		return
+	}
+
+	// Unmarshal the yaml into an *OrderedMap
+	m, err = ToOrderedMapE(data)
+
+	return
+}
+
 // LoadYAML reads in a yaml file and converts it to a *StringMap
 func LoadYAML(filepath string) (m *StringMap) {
 	m, _ = LoadYAMLE(filepath)
@@ -198,3 +250,28 @@ func LoadYAMLE(filepath string) (m *StringMap, err error) {
 
 	return
 }
+
+// LoadYAMLDoc reads in a yaml file as a *YAMLDoc for round trip editing preserving comments
+func LoadYAMLDoc(filepath string) (doc *YAMLDoc) {
+	doc, _ = LoadYAMLDocE(filepath)
+	return doc
+}
+
+// LoadYAMLDocE reads in a yaml file as a *YAMLDoc for round trip editing preserving comments
+func LoadYAMLDocE(filepath string) (doc *YAMLDoc, err error) {
+	doc = NewYAMLDocV()
+
+	// Read in the yaml file
+	var data []byte
+	if data, err = ioutil.ReadFile(filepath); err != nil {
+		err = errors.Wrapf(err, "failed to read in the yaml file %s", filepath)
+		return
+	}
+
+	// Parse the yaml into a *YAMLDoc
+	if doc, err = NewYAMLDocE(data); err != nil {
+		err = errors.Wrapf(err, "failed to load yaml file %s", filepath)
+	}
+
+	return
+}
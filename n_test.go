@@ -1,7 +1,9 @@
 package n
 
 import (
+	"encoding/json"
 	"fmt"
+	"path"
 	"testing"
 
 	"github.com/phR0ze/n/pkg/sys"
@@ -145,6 +147,46 @@ func TestLoadJSONE(t *testing.T) {
 	}
 }
 
+func TestLoadOrderedJSONE(t *testing.T) {
+	clearTmpDir()
+
+	// Load json file keeping key order
+	{
+		sys.WriteBytes(tmpFile, []byte("{\n  \"b\": 1,\n  \"a\": {\"d\": [1, {\"z\": 1, \"y\": 2}], \"c\": null}\n}"))
+		m, err := LoadOrderedJSONE(tmpFile)
+		assert.Nil(t, err)
+		data, err := json.Marshal(m)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"b":1,"a":{"d":[1,{"z":1,"y":2}],"c":null}}`, string(data))
+		assert.Equal(t, m, LoadOrderedJSON(tmpFile))
+	}
+
+	// Invalid
+	{
+		sys.WriteBytes(tmpFile, []byte("[1]"))
+		_, err := LoadOrderedJSONE(tmpFile)
+		assert.Contains(t, err.Error(), "failed to unmarshal json type []interface {} into OrderedMap")
+		_, err = LoadOrderedJSONE(path.Join(tmpDir, "bogus"))
+		assert.Contains(t, err.Error(), "failed to read in the json file")
+	}
+}
+
+func TestLoadOrderedYAMLE(t *testing.T) {
+	clearTmpDir()
+
+	// Load yaml file keeping key order
+	{
+		data := "foo:\n  bar: 1\n  baz: [1, 2]\nabc: true\n"
+		sys.WriteBytes(tmpFile, []byte(data))
+		m, err := LoadOrderedYAMLE(tmpFile)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"foo", "abc"}, m.Keys().ToStrs())
+		assert.Equal(t, []string{"bar", "baz"}, m.Query("foo").ToOrderedMap().Keys().ToStrs())
+		assert.Equal(t, "foo:\n  bar: 1\n  baz:\n  - 1\n  - 2\nabc: true\n", m.YAML())
+		assert.Equal(t, m, LoadOrderedYAML(tmpFile))
+	}
+}
+
 func TestLoadYAML(t *testing.T) {
 	clearTmpDir()
 
@@ -84,6 +84,22 @@ func (p *Object) ToBoolE() (bool, error) {
 	return ToBoolE(p.o)
 }
 
+// ToBoolSlice converts an interface to a *BoolSlice type.
+func (p *Object) ToBoolSlice() *BoolSlice {
+	if p == nil {
+		return NewBoolSliceV()
+	}
+	return ToBoolSlice(p.o)
+}
+
+// ToBoolSliceE converts an interface to a *BoolSlice type.
+func (p *Object) ToBoolSliceE() (*BoolSlice, error) {
+	if p == nil {
+		return NewBoolSliceV(), nil
+	}
+	return ToBoolSliceE(p.o)
+}
+
 // Char
 //--------------------------------------------------------------------------------------------------
 
@@ -167,6 +183,38 @@ func (p *Object) ToDurationE() (time.Duration, error) {
 	return ToDurationE(p.o)
 }
 
+// ToDurationSlice converts an interface to a *DurationSlice type.
+func (p *Object) ToDurationSlice() *DurationSlice {
+	if p == nil {
+		return NewDurationSliceV()
+	}
+	return ToDurationSlice(p.o)
+}
+
+// ToDurationSliceE converts an interface to a *DurationSlice type.
+func (p *Object) ToDurationSliceE() (*DurationSlice, error) {
+	if p == nil {
+		return NewDurationSliceV(), nil
+	}
+	return ToDurationSliceE(p.o)
+}
+
+// ToTimeSlice converts an interface to a *TimeSlice type.
+func (p *Object) ToTimeSlice() *TimeSlice {
+	if p == nil {
+		return NewTimeSliceV()
+	}
+	return ToTimeSlice(p.o)
+}
+
+// ToTimeSliceE converts an interface to a *TimeSlice type.
+func (p *Object) ToTimeSliceE() (*TimeSlice, error) {
+	if p == nil {
+		return NewTimeSliceV(), nil
+	}
+	return ToTimeSliceE(p.o)
+}
+
 // Float related
 //--------------------------------------------------------------------------------------------------
 
@@ -324,6 +372,22 @@ func (p *Object) ToUint8E() (uint8, error) {
 	return ToUint8E(p.o)
 }
 
+// ToByteSlice converts an interface to a *ByteSlice type.
+func (p *Object) ToByteSlice() *ByteSlice {
+	if p == nil {
+		return NewByteSliceV()
+	}
+	return ToByteSlice(p.o)
+}
+
+// ToByteSliceE converts an interface to a *ByteSlice type.
+func (p *Object) ToByteSliceE() (*ByteSlice, error) {
+	if p == nil {
+		return NewByteSliceV(), nil
+	}
+	return ToByteSliceE(p.o)
+}
+
 // ToUint16 converts an interface to a uint16 type.
 func (p *Object) ToUint16() uint16 {
 	if p == nil {
@@ -405,6 +469,22 @@ func (p *Object) ToStringE() (string, error) {
 // Map related
 //--------------------------------------------------------------------------------------------------
 
+// ToOrderedMap converts an interface to an *OrderedMap type.
+func (p *Object) ToOrderedMap() *OrderedMap {
+	if p == nil {
+		return NewOrderedMapV()
+	}
+	return ToOrderedMap(p.o)
+}
+
+// ToOrderedMapE converts an interface to an *OrderedMap type.
+func (p *Object) ToOrderedMapE() (*OrderedMap, error) {
+	if p == nil {
+		return NewOrderedMapV(), nil
+	}
+	return ToOrderedMapE(p.o)
+}
+
 // ToStringMap converts an interface to a *StringMap type.
 func (p *Object) ToStringMap() *StringMap {
 	if p == nil {
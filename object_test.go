@@ -35,6 +35,24 @@ func TestObject_ToBool(t *testing.T) {
 	}
 }
 
+func TestObject_ToBoolSlice(t *testing.T) {
+	assert.Equal(t, NewBoolSliceV(), (*Object)(nil).ToBoolSlice())
+
+	// w/out error
+	{
+		o := &Object{[]bool{true}}
+		assert.Equal(t, NewBoolSliceV(true), o.ToBoolSlice())
+	}
+
+	// w/error
+	{
+		o := &Object{[]bool{true}}
+		obj, e := o.ToBoolSliceE()
+		assert.Nil(t, e)
+		assert.Equal(t, NewBoolSliceV(true), obj)
+	}
+}
+
 func TestObject_ToTime(t *testing.T) {
 
 	// w/out error
@@ -69,6 +87,42 @@ func TestObject_ToDuration(t *testing.T) {
 	}
 }
 
+func TestObject_ToDurationSlice(t *testing.T) {
+	assert.Equal(t, NewDurationSliceV(), (*Object)(nil).ToDurationSlice())
+
+	// w/out error
+	{
+		o := &Object{[]time.Duration{time.Second}}
+		assert.Equal(t, NewDurationSliceV(time.Second), o.ToDurationSlice())
+	}
+
+	// w/error
+	{
+		o := &Object{[]time.Duration{time.Second}}
+		obj, e := o.ToDurationSliceE()
+		assert.Nil(t, e)
+		assert.Equal(t, NewDurationSliceV(time.Second), obj)
+	}
+}
+
+func TestObject_ToTimeSlice(t *testing.T) {
+	assert.Equal(t, NewTimeSliceV(), (*Object)(nil).ToTimeSlice())
+
+	// w/out error
+	{
+		o := &Object{[]time.Time{time.Time{}}}
+		assert.Equal(t, NewTimeSliceV(time.Time{}), o.ToTimeSlice())
+	}
+
+	// w/error
+	{
+		o := &Object{[]time.Time{time.Time{}}}
+		obj, e := o.ToTimeSliceE()
+		assert.Nil(t, e)
+		assert.Equal(t, NewTimeSliceV(time.Time{}), obj)
+	}
+}
+
 func TestObject_ToFloat32(t *testing.T) {
 
 	// w/out error
@@ -222,6 +276,24 @@ func TestObject_ToUint8(t *testing.T) {
 	}
 }
 
+func TestObject_ToByteSlice(t *testing.T) {
+	assert.Equal(t, NewByteSliceV(), (*Object)(nil).ToByteSlice())
+
+	// w/out error
+	{
+		o := &Object{[]byte{1}}
+		assert.Equal(t, NewByteSliceV(byte(1)), o.ToByteSlice())
+	}
+
+	// w/error
+	{
+		o := &Object{[]byte{1}}
+		obj, e := o.ToByteSliceE()
+		assert.Nil(t, e)
+		assert.Equal(t, NewByteSliceV(byte(1)), obj)
+	}
+}
+
 func TestObject_ToUint16(t *testing.T) {
 
 	// w/out error
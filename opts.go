@@ -0,0 +1,22 @@
+package n
+
+import (
+	"github.com/phR0ze/n/pkg/opt"
+)
+
+// CoerceOpt creates a new coerce option with the given value. When true numeric values of
+// different types are compared by value e.g. int 1 and float64 1 from JSON are equal.
+// -------------------------------------------------------------------------------------------------
+func CoerceOpt(val bool) *opt.Opt {
+	return &opt.Opt{Key: "coerce", Val: val}
+}
+
+// get the coerce option from the options slice defaulting to false
+func getCoerceOpt(opts []*opt.Opt) (result bool) {
+	if o := opt.Get(opts, "coerce"); o != nil {
+		if val, ok := o.Val.(bool); ok {
+			result = val
+		}
+	}
+	return
+}
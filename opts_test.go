@@ -0,0 +1,16 @@
+package n
+
+import (
+	"testing"
+
+	"github.com/phR0ze/n/pkg/opt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoerceOpt(t *testing.T) {
+	opts := []*opt.Opt{}
+	assert.False(t, getCoerceOpt(opts))
+	assert.True(t, getCoerceOpt(append(opts, CoerceOpt(true))))
+	assert.False(t, getCoerceOpt(append(opts, CoerceOpt(false))))
+	assert.False(t, getCoerceOpt(append(opts, &opt.Opt{Key: "coerce", Val: "true"})))
+}
@@ -8,13 +8,32 @@ import (
 	"github.com/ghodss/yaml"
 	"github.com/phR0ze/n/pkg/sys"
 	"github.com/pkg/errors"
+	yaml2 "gopkg.in/yaml.v2"
 )
 
+// MapItem is a key value pair of a MapSlice
+type MapItem = yaml2.MapItem
+
+// MapSlice is an ordered list of key value pairs preserving the order of a YAML mapping
+type MapSlice = yaml2.MapSlice
+
 // Marshal wraps the ghodss/yaml.Marshal
 func Marshal(o interface{}) ([]byte, error) {
 	return yaml.Marshal(o)
 }
 
+// MarshalOrdered marshals the given object directly with gopkg.in/yaml.v2 rather than going
+// through JSON like Marshal does which allows ordered types e.g. MapSlice to keep their order.
+// Unsupported types e.g. funcs are returned as errors rather than panicking.
+func MarshalOrdered(o interface{}) (data []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("failed to marshal object %T: %v", o, r)
+		}
+	}()
+	return yaml2.Marshal(o)
+}
+
 // ReadYAML reads the target file and returns a map[string]interface{} data
 // structure representing the yaml read in.
 func ReadYAML(filepath string) (obj map[string]interface{}, err error) {
@@ -42,6 +61,12 @@ func Unmarshal(y []byte, o interface{}) error {
 	return yaml.Unmarshal(y, o)
 }
 
+// UnmarshalOrdered unmarshals the given yaml directly with gopkg.in/yaml.v2 rather than going
+// through JSON like Unmarshal does which allows ordered types e.g. MapSlice to keep their order.
+func UnmarshalOrdered(y []byte, o interface{}) error {
+	return yaml2.Unmarshal(y, o)
+}
+
 // WriteYAML converts the given obj interface{} into yaml then writes to disk
 // with default permissions. Expects obj to be a structure that github.com/ghodss/yaml understands
 func WriteYAML(filepath string, obj interface{}, perms ...uint32) (err error) {
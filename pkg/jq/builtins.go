@@ -0,0 +1,744 @@
+package jq
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// call: builtin, builtin(arg1; arg2)
+type call struct {
+	name string
+	args []node
+}
+
+// builtin implements a jq builtin function given its unevaluated arguments
+type builtin func(e *env, input interface{}, args []node) ([]interface{}, error)
+
+// builtins maps the builtin name and arity e.g. map/1 to its implementation
+var builtins map[string]builtin
+
+func init() {
+	builtins = map[string]builtin{
+		"add/0":            fnAdd,
+		"all/0":            fnAll,
+		"all/1":            fnAll,
+		"any/0":            fnAny,
+		"any/1":            fnAny,
+		"ascii_downcase/0": strFn(strings.ToLower),
+		"ascii_upcase/0":   strFn(strings.ToUpper),
+		"contains/1":       fnContains,
+		"empty/0":          fnEmpty,
+		"endswith/1":       strTest(strings.HasSuffix),
+		"error/1":          fnError,
+		"first/0":          fnFirst,
+		"first/1":          fnFirst,
+		"flatten/0":        fnFlatten,
+		"flatten/1":        fnFlatten,
+		"floor/0":          numFn(math.Floor),
+		"from_entries/0":   fnFromEntries,
+		"group_by/1":       fnGroupBy,
+		"has/1":            fnHas,
+		"join/1":           fnJoin,
+		"keys/0":           fnKeys,
+		"keys_unsorted/0":  fnKeys,
+		"last/0":           fnLast,
+		"last/1":           fnLast,
+		"length/0":         fnLength,
+		"limit/2":          fnLimit,
+		"map/1":            fnMap,
+		"map_values/1":     fnMapValues,
+		"max/0":            fnMax,
+		"max_by/1":         fnMaxBy,
+		"min/0":            fnMin,
+		"min_by/1":         fnMinBy,
+		"not/0":            fnNot,
+		"range/1":          fnRange,
+		"range/2":          fnRange,
+		"recurse/0":        fnRecurse,
+		"recurse/1":        fnRecurse,
+		"reverse/0":        fnReverse,
+		"select/1":         fnSelect,
+		"sort/0":           fnSort,
+		"sort_by/1":        fnSortBy,
+		"split/1":          fnSplit,
+		"sqrt/0":           numFn(math.Sqrt),
+		"startswith/1":     strTest(strings.HasPrefix),
+		"test/1":           fnTest,
+		"to_entries/0":     fnToEntries,
+		"tonumber/0":       fnToNumber,
+		"tostring/0":       fnToString,
+		"type/0":           fnType,
+		"unique/0":         fnUnique,
+		"unique_by/1":      fnUniqueBy,
+		"values/0":         fnValues,
+		"with_entries/1":   fnWithEntries,
+	}
+}
+
+func (n *call) eval(e *env, input interface{}) ([]interface{}, error) {
+	fn, ok := builtins[n.name+"/"+strconv.Itoa(len(n.args))]
+	if !ok {
+		return nil, errors.Errorf("%s/%d is not defined", n.name, len(n.args))
+	}
+	return fn(e, input, n.args)
+}
+
+// one evaluates the given argument expecting exactly one result
+func one(e *env, input interface{}, arg node) (val interface{}, err error) {
+	var vals []interface{}
+	if vals, err = arg.eval(e, input); err != nil {
+		return
+	}
+	if len(vals) != 1 {
+		err = errors.Errorf("expected a single value but got %d", len(vals))
+		return
+	}
+	val = vals[0]
+	return
+}
+
+// array evaluates the input as an array
+func asArray(input interface{}) (arr []interface{}, err error) {
+	var ok bool
+	if arr, ok = normalize(input).([]interface{}); !ok {
+		err = errors.Errorf("%s is not an array", typeName(input))
+	}
+	return
+}
+
+// mapArray applies the given expression to each array element
+func mapArray(e *env, arr []interface{}, f node) (results [][]interface{}, err error) {
+	results = make([][]interface{}, len(arr))
+	for i := range arr {
+		if results[i], err = f.eval(e, arr[i]); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func fnAdd(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	var vals []interface{}
+	if vals, err = values(input); err != nil {
+		return
+	}
+	var sum interface{}
+	for _, val := range vals {
+		if sum, err = operate("+", sum, val); err != nil {
+			return
+		}
+	}
+	results = []interface{}{sum}
+	return
+}
+
+func fnAll(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	return anyAll(e, input, args, true)
+}
+
+func fnAny(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	return anyAll(e, input, args, false)
+}
+
+func anyAll(e *env, input interface{}, args []node, all bool) (results []interface{}, err error) {
+	var vals []interface{}
+	if vals, err = values(input); err != nil {
+		return
+	}
+	for _, val := range vals {
+		if len(args) > 0 {
+			if val, err = one(e, val, args[0]); err != nil {
+				return
+			}
+		}
+		if truthy(val) != all {
+			return []interface{}{!all}, nil
+		}
+	}
+	return []interface{}{all}, nil
+}
+
+func fnContains(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	var val interface{}
+	if val, err = one(e, input, args[0]); err != nil {
+		return
+	}
+	return []interface{}{contains(input, val)}, nil
+}
+
+func contains(a, b interface{}) bool {
+	a, b = normalize(a), normalize(b)
+	switch x := a.(type) {
+	case string:
+		if y, ok := b.(string); ok {
+			return strings.Contains(x, y)
+		}
+	case []interface{}:
+		if y, ok := b.([]interface{}); ok {
+			for i := range y {
+				found := false
+				for j := range x {
+					if contains(x[j], y[i]) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return false
+				}
+			}
+			return true
+		}
+	case map[string]interface{}:
+		if y, ok := b.(map[string]interface{}); ok {
+			for k, v := range y {
+				if xv, exists := x[k]; !exists || !contains(xv, v) {
+					return false
+				}
+			}
+			return true
+		}
+	}
+	return compare(a, b) == 0
+}
+
+func fnEmpty(e *env, input interface{}, args []node) ([]interface{}, error) {
+	return nil, nil
+}
+
+func fnError(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	var msg interface{}
+	if msg, err = one(e, input, args[0]); err != nil {
+		return
+	}
+	err = errors.Errorf("%v", msg)
+	return
+}
+
+func fnFirst(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	if len(args) == 0 {
+		return (&index{&identity{}, &literal{0}}).eval(e, input)
+	}
+	var vals []interface{}
+	if vals, err = args[0].eval(e, input); err != nil || len(vals) == 0 {
+		return
+	}
+	return vals[:1], nil
+}
+
+func fnLast(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	if len(args) == 0 {
+		return (&index{&identity{}, &literal{-1}}).eval(e, input)
+	}
+	var vals []interface{}
+	if vals, err = args[0].eval(e, input); err != nil || len(vals) == 0 {
+		return
+	}
+	return vals[len(vals)-1:], nil
+}
+
+func fnFlatten(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	var arr []interface{}
+	if arr, err = asArray(input); err != nil {
+		return
+	}
+	if len(args) == 0 {
+		return []interface{}{flatten(arr, -1)}, nil
+	}
+	var depths []interface{}
+	if depths, err = args[0].eval(e, input); err != nil {
+		return
+	}
+	for _, depth := range depths {
+		d, ok := toNumber(depth)
+		if !ok || d < 0 {
+			err = errors.Errorf("flatten depth must not be negative")
+			return
+		}
+		results = append(results, flatten(arr, int(d)))
+	}
+	return
+}
+
+// flatten expands nested arrays up to the given depth or all the way if negative
+func flatten(arr []interface{}, depth int) []interface{} {
+	result := []interface{}{}
+	for _, x := range arr {
+		if y, ok := normalize(x).([]interface{}); ok && depth != 0 {
+			result = append(result, flatten(y, depth-1)...)
+		} else {
+			result = append(result, x)
+		}
+	}
+	return result
+}
+
+func fnFromEntries(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	var arr []interface{}
+	if arr, err = asArray(input); err != nil {
+		return
+	}
+	m := map[string]interface{}{}
+	for _, x := range arr {
+		entry, ok := normalize(x).(map[string]interface{})
+		if !ok {
+			err = errors.Errorf("entries must be objects not %s", typeName(x))
+			return
+		}
+		key := entry["key"]
+		if key == nil {
+			key = entry["name"]
+		}
+		m[toString(key)] = entry["value"]
+	}
+	return []interface{}{m}, nil
+}
+
+func fnGroupBy(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	var arr []interface{}
+	if arr, err = asArray(input); err != nil {
+		return
+	}
+	var keys [][]interface{}
+	if keys, err = mapArray(e, arr, args[0]); err != nil {
+		return
+	}
+	idx := sortedIndices(keys)
+	groups := []interface{}{}
+	for i := range idx {
+		if i == 0 || compare(keys[idx[i]], keys[idx[i-1]]) != 0 {
+			groups = append(groups, []interface{}{})
+		}
+		last := len(groups) - 1
+		groups[last] = append(groups[last].([]interface{}), arr[idx[i]])
+	}
+	return []interface{}{groups}, nil
+}
+
+func fnHas(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	var key interface{}
+	if key, err = one(e, input, args[0]); err != nil {
+		return
+	}
+	switch x := normalize(input).(type) {
+	case map[string]interface{}:
+		if k, ok := key.(string); ok {
+			_, exists := x[k]
+			return []interface{}{exists}, nil
+		}
+	case []interface{}:
+		if i, ok := toNumber(key); ok {
+			return []interface{}{i >= 0 && int(i) < len(x)}, nil
+		}
+	}
+	err = errors.Errorf("cannot check whether %s has a %s key", typeName(input), typeName(key))
+	return
+}
+
+func fnJoin(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	var sep interface{}
+	if sep, err = one(e, input, args[0]); err != nil {
+		return
+	}
+	var vals []interface{}
+	if vals, err = values(input); err != nil {
+		return
+	}
+	strs := make([]string, len(vals))
+	for i := range vals {
+		if vals[i] != nil {
+			strs[i] = toString(vals[i])
+		}
+	}
+	return []interface{}{strings.Join(strs, toString(sep))}, nil
+}
+
+func fnKeys(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	switch x := normalize(input).(type) {
+	case map[string]interface{}:
+		return []interface{}{toInterfaces(sortedKeys(x))}, nil
+	case []interface{}:
+		keys := make([]interface{}, len(x))
+		for i := range x {
+			keys[i] = i
+		}
+		return []interface{}{keys}, nil
+	}
+	err = errors.Errorf("%s has no keys", typeName(input))
+	return
+}
+
+func fnLength(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	var l interface{}
+	if l, err = length(input); err != nil {
+		return
+	}
+	return []interface{}{l}, nil
+}
+
+func fnLimit(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	var n interface{}
+	if n, err = one(e, input, args[0]); err != nil {
+		return
+	}
+	var vals []interface{}
+	if vals, err = args[1].eval(e, input); err != nil {
+		return
+	}
+	l, _ := toNumber(n)
+	if int(l) < len(vals) {
+		vals = vals[:int(math.Max(l, 0))]
+	}
+	return vals, nil
+}
+
+func fnMap(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	return (&array{&pipe{&iterate{&identity{}}, args[0]}}).eval(e, input)
+}
+
+func fnMapValues(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	switch x := normalize(input).(type) {
+	case map[string]interface{}:
+		m := map[string]interface{}{}
+		for k, v := range x {
+			var vals []interface{}
+			if vals, err = args[0].eval(e, v); err != nil {
+				return
+			}
+			if len(vals) > 0 {
+				m[k] = vals[0]
+			}
+		}
+		return []interface{}{m}, nil
+	case []interface{}:
+		arr := []interface{}{}
+		for _, v := range x {
+			var vals []interface{}
+			if vals, err = args[0].eval(e, v); err != nil {
+				return
+			}
+			if len(vals) > 0 {
+				arr = append(arr, vals[0])
+			}
+		}
+		return []interface{}{arr}, nil
+	}
+	err = errors.Errorf("cannot iterate over %s", typeName(input))
+	return
+}
+
+func fnMax(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	return minMax(e, input, nil, 1)
+}
+
+func fnMaxBy(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	return minMax(e, input, args[0], 1)
+}
+
+func fnMin(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	return minMax(e, input, nil, -1)
+}
+
+func fnMinBy(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	return minMax(e, input, args[0], -1)
+}
+
+func minMax(e *env, input interface{}, f node, want int) (results []interface{}, err error) {
+	var arr []interface{}
+	if arr, err = asArray(input); err != nil {
+		return
+	}
+	if len(arr) == 0 {
+		return []interface{}{nil}, nil
+	}
+	if f == nil {
+		f = &identity{}
+	}
+	var keys [][]interface{}
+	if keys, err = mapArray(e, arr, f); err != nil {
+		return
+	}
+	best := 0
+	for i := 1; i < len(arr); i++ {
+		if c := compare(keys[i], keys[best]); c == want || (c == 0 && want > 0) {
+			best = i
+		}
+	}
+	return []interface{}{arr[best]}, nil
+}
+
+func fnNot(e *env, input interface{}, args []node) ([]interface{}, error) {
+	return []interface{}{!truthy(input)}, nil
+}
+
+func fnRange(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	var from, to interface{} = 0, nil
+	if len(args) == 1 {
+		if to, err = one(e, input, args[0]); err != nil {
+			return
+		}
+	} else {
+		if from, err = one(e, input, args[0]); err != nil {
+			return
+		}
+		if to, err = one(e, input, args[1]); err != nil {
+			return
+		}
+	}
+	f, okf := toNumber(from)
+	t, okt := toNumber(to)
+	if !okf || !okt {
+		err = errors.Errorf("range bounds must be numbers")
+		return
+	}
+	for i := f; i < t; i++ {
+		results = append(results, fromNumber(i))
+	}
+	return
+}
+
+func fnRecurse(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	results = append(results, input)
+	var children []interface{}
+	if len(args) == 0 {
+		switch normalize(input).(type) {
+		case []interface{}, map[string]interface{}:
+			children, _ = values(input)
+		}
+	} else if children, err = args[0].eval(e, input); err != nil {
+		return
+	}
+	for _, child := range children {
+		var x []interface{}
+		if x, err = fnRecurse(e, child, args); err != nil {
+			return
+		}
+		results = append(results, x...)
+	}
+	return
+}
+
+func fnReverse(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	if s, ok := input.(string); ok {
+		runes := []rune(s)
+		for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+			runes[i], runes[j] = runes[j], runes[i]
+		}
+		return []interface{}{string(runes)}, nil
+	}
+	if input == nil {
+		return []interface{}{[]interface{}{}}, nil
+	}
+	var arr []interface{}
+	if arr, err = asArray(input); err != nil {
+		return
+	}
+	reversed := make([]interface{}, len(arr))
+	for i := range arr {
+		reversed[len(arr)-1-i] = arr[i]
+	}
+	return []interface{}{reversed}, nil
+}
+
+func fnSelect(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	var conds []interface{}
+	if conds, err = args[0].eval(e, input); err != nil {
+		return
+	}
+	for _, cond := range conds {
+		if truthy(cond) {
+			results = append(results, input)
+		}
+	}
+	return
+}
+
+func fnSort(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	return fnSortBy(e, input, []node{&identity{}})
+}
+
+func fnSortBy(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	var arr []interface{}
+	if arr, err = asArray(input); err != nil {
+		return
+	}
+	var keys [][]interface{}
+	if keys, err = mapArray(e, arr, args[0]); err != nil {
+		return
+	}
+	sorted := []interface{}{}
+	for _, i := range sortedIndices(keys) {
+		sorted = append(sorted, arr[i])
+	}
+	return []interface{}{sorted}, nil
+}
+
+// sortedIndices returns the indices of the given keys in stable sorted order
+func sortedIndices(keys [][]interface{}) []int {
+	idx := make([]int, len(keys))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return compare(keys[idx[i]], keys[idx[j]]) < 0
+	})
+	return idx
+}
+
+func fnSplit(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	var sep interface{}
+	if sep, err = one(e, input, args[0]); err != nil {
+		return
+	}
+	return operateAll("/", input, sep)
+}
+
+func operateAll(op string, left, right interface{}) (results []interface{}, err error) {
+	var val interface{}
+	if val, err = operate(op, left, right); err != nil {
+		return
+	}
+	return []interface{}{val}, nil
+}
+
+func fnTest(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	var pattern interface{}
+	if pattern, err = one(e, input, args[0]); err != nil {
+		return
+	}
+	var re *regexp.Regexp
+	if re, err = regexp.Compile(toString(pattern)); err != nil {
+		return
+	}
+	s, ok := input.(string)
+	if !ok {
+		err = errors.Errorf("%s cannot be matched, as it is not a string", typeName(input))
+		return
+	}
+	return []interface{}{re.MatchString(s)}, nil
+}
+
+func fnToEntries(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	m, ok := normalize(input).(map[string]interface{})
+	if !ok {
+		err = errors.Errorf("%s has no keys", typeName(input))
+		return
+	}
+	entries := []interface{}{}
+	for _, k := range sortedKeys(m) {
+		entries = append(entries, map[string]interface{}{"key": k, "value": m[k]})
+	}
+	return []interface{}{entries}, nil
+}
+
+func fnToNumber(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	if _, ok := toNumber(input); ok {
+		return []interface{}{input}, nil
+	}
+	if s, ok := input.(string); ok {
+		var val interface{}
+		if val, err = parseNumber(s); err == nil {
+			return []interface{}{val}, nil
+		}
+	}
+	err = errors.Errorf("%s cannot be parsed as a number", typeName(input))
+	return
+}
+
+func fnToString(e *env, input interface{}, args []node) ([]interface{}, error) {
+	return []interface{}{toString(input)}, nil
+}
+
+func fnType(e *env, input interface{}, args []node) ([]interface{}, error) {
+	return []interface{}{typeName(input)}, nil
+}
+
+func fnUnique(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	return fnUniqueBy(e, input, []node{&identity{}})
+}
+
+func fnUniqueBy(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	var arr []interface{}
+	if arr, err = asArray(input); err != nil {
+		return
+	}
+	var keys [][]interface{}
+	if keys, err = mapArray(e, arr, args[0]); err != nil {
+		return
+	}
+	idx := sortedIndices(keys)
+	uniq := []interface{}{}
+	for i := range idx {
+		if i == 0 || compare(keys[idx[i]], keys[idx[i-1]]) != 0 {
+			uniq = append(uniq, arr[idx[i]])
+		}
+	}
+	return []interface{}{uniq}, nil
+}
+
+func fnValues(e *env, input interface{}, args []node) ([]interface{}, error) {
+	if input == nil {
+		return nil, nil
+	}
+	return []interface{}{input}, nil
+}
+
+func fnWithEntries(e *env, input interface{}, args []node) (results []interface{}, err error) {
+	return (&pipe{&call{name: "to_entries"}, &pipe{&call{name: "map", args: args}, &call{name: "from_entries"}}}).eval(e, input)
+}
+
+// strFn wraps a string transformation as a builtin
+func strFn(fn func(string) string) builtin {
+	return func(e *env, input interface{}, args []node) ([]interface{}, error) {
+		s, ok := input.(string)
+		if !ok {
+			return nil, errors.Errorf("%s is not a string", typeName(input))
+		}
+		return []interface{}{fn(s)}, nil
+	}
+}
+
+// strTest wraps a string predicate taking a single argument as a builtin
+func strTest(fn func(string, string) bool) builtin {
+	return func(e *env, input interface{}, args []node) (results []interface{}, err error) {
+		var arg interface{}
+		if arg, err = one(e, input, args[0]); err != nil {
+			return
+		}
+		s, ok := input.(string)
+		a, oka := arg.(string)
+		if !ok || !oka {
+			return nil, errors.Errorf("%s and %s must both be strings", typeName(input), typeName(arg))
+		}
+		return []interface{}{fn(s, a)}, nil
+	}
+}
+
+// numFn wraps a numeric transformation as a builtin
+func numFn(fn func(float64) float64) builtin {
+	return func(e *env, input interface{}, args []node) ([]interface{}, error) {
+		f, ok := toNumber(input)
+		if !ok {
+			return nil, errors.Errorf("%s is not a number", typeName(input))
+		}
+		return []interface{}{fromNumber(fn(f))}, nil
+	}
+}
+
+// toString converts the given value to a string with non strings being JSON encoded
+func toString(val interface{}) string {
+	val = normalize(val)
+	if s, ok := val.(string); ok {
+		return s
+	}
+	data, err := marshal(val)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
@@ -0,0 +1,818 @@
+package jq
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// env tracks variable bindings during evaluation
+type env struct {
+	name   string
+	val    interface{}
+	parent *env
+}
+
+func (e *env) lookup(name string) (val interface{}, ok bool) {
+	for x := e; x != nil; x = x.parent {
+		if x.name == name {
+			return x.val, true
+		}
+	}
+	return nil, false
+}
+
+// node is an element of the parsed expression tree
+type node interface {
+	eval(e *env, input interface{}) ([]interface{}, error)
+}
+
+// identity: .
+type identity struct{}
+
+func (n *identity) eval(e *env, input interface{}) ([]interface{}, error) {
+	return []interface{}{input}, nil
+}
+
+// literal: "str", 1, true, false, null
+type literal struct {
+	val interface{}
+}
+
+func (n *literal) eval(e *env, input interface{}) ([]interface{}, error) {
+	return []interface{}{n.val}, nil
+}
+
+// format: "text \(expr) text" producing a string for every combination of the expression outputs
+type format struct {
+	parts []node
+}
+
+func (n *format) eval(e *env, input interface{}) (results []interface{}, err error) {
+	strs := []string{""}
+	for _, part := range n.parts {
+		var vals []interface{}
+		if vals, err = part.eval(e, input); err != nil {
+			return
+		}
+		next := make([]string, 0, len(strs)*len(vals))
+		for _, str := range strs {
+			for _, val := range vals {
+				next = append(next, str+toString(val))
+			}
+		}
+		strs = next
+	}
+	for _, str := range strs {
+		results = append(results, str)
+	}
+	return
+}
+
+// variable: $name
+type variable struct {
+	name string
+}
+
+func (n *variable) eval(e *env, input interface{}) ([]interface{}, error) {
+	val, ok := e.lookup(n.name)
+	if !ok {
+		return nil, errors.Errorf("%s is not defined", n.name)
+	}
+	return []interface{}{val}, nil
+}
+
+// pipe: left | right
+type pipe struct {
+	left, right node
+}
+
+func (n *pipe) eval(e *env, input interface{}) (results []interface{}, err error) {
+	var lefts []interface{}
+	if lefts, err = n.left.eval(e, input); err != nil {
+		return
+	}
+	for _, left := range lefts {
+		var rights []interface{}
+		if rights, err = n.right.eval(e, left); err != nil {
+			return
+		}
+		results = append(results, rights...)
+	}
+	return
+}
+
+// comma: left, right
+type comma struct {
+	left, right node
+}
+
+func (n *comma) eval(e *env, input interface{}) (results []interface{}, err error) {
+	var lefts, rights []interface{}
+	if lefts, err = n.left.eval(e, input); err != nil {
+		return
+	}
+	if rights, err = n.right.eval(e, input); err != nil {
+		return
+	}
+	results = append(lefts, rights...)
+	return
+}
+
+// alternative: left // right
+type alternative struct {
+	left, right node
+}
+
+func (n *alternative) eval(e *env, input interface{}) (results []interface{}, err error) {
+	lefts, _ := n.left.eval(e, input)
+	for _, left := range lefts {
+		if truthy(left) {
+			results = append(results, left)
+		}
+	}
+	if len(results) == 0 {
+		results, err = n.right.eval(e, input)
+	}
+	return
+}
+
+// optional: expr?
+type optional struct {
+	body node
+}
+
+func (n *optional) eval(e *env, input interface{}) ([]interface{}, error) {
+	results, _ := n.body.eval(e, input)
+	return results, nil
+}
+
+// binding: source as $name | body
+type binding struct {
+	source node
+	name   string
+	body   node
+}
+
+func (n *binding) eval(e *env, input interface{}) (results []interface{}, err error) {
+	var vals []interface{}
+	if vals, err = n.source.eval(e, input); err != nil {
+		return
+	}
+	for _, val := range vals {
+		var x []interface{}
+		if x, err = n.body.eval(&env{n.name, val, e}, input); err != nil {
+			return
+		}
+		results = append(results, x...)
+	}
+	return
+}
+
+// conditional: if cond then a elif cond then b else c end
+type conditional struct {
+	cond, then, otherwise node
+}
+
+func (n *conditional) eval(e *env, input interface{}) (results []interface{}, err error) {
+	var conds []interface{}
+	if conds, err = n.cond.eval(e, input); err != nil {
+		return
+	}
+	for _, cond := range conds {
+		var x []interface{}
+		switch {
+		case truthy(cond):
+			x, err = n.then.eval(e, input)
+		case n.otherwise != nil:
+			x, err = n.otherwise.eval(e, input)
+		default:
+			x = []interface{}{input}
+		}
+		if err != nil {
+			return
+		}
+		results = append(results, x...)
+	}
+	return
+}
+
+// index: .foo, .[2], .["foo"], .[.key]
+type index struct {
+	target, key node
+}
+
+func (n *index) eval(e *env, input interface{}) (results []interface{}, err error) {
+	var targets, keys []interface{}
+	if targets, err = n.target.eval(e, input); err != nil {
+		return
+	}
+	if keys, err = n.key.eval(e, input); err != nil {
+		return
+	}
+	for _, target := range targets {
+		for _, key := range keys {
+			var val interface{}
+			if val, err = indexValue(target, key); err != nil {
+				return
+			}
+			results = append(results, val)
+		}
+	}
+	return
+}
+
+// slice: .[2:5], .[:2], .[-2:]
+type slice struct {
+	target, from, to node
+}
+
+func (n *slice) eval(e *env, input interface{}) (results []interface{}, err error) {
+	var targets []interface{}
+	if targets, err = n.target.eval(e, input); err != nil {
+		return
+	}
+	froms, tos := []interface{}{nil}, []interface{}{nil}
+	if n.from != nil {
+		if froms, err = n.from.eval(e, input); err != nil {
+			return
+		}
+	}
+	if n.to != nil {
+		if tos, err = n.to.eval(e, input); err != nil {
+			return
+		}
+	}
+	for _, target := range targets {
+		for _, from := range froms {
+			for _, to := range tos {
+				var val interface{}
+				if val, err = sliceValue(target, from, to); err != nil {
+					return
+				}
+				results = append(results, val)
+			}
+		}
+	}
+	return
+}
+
+// iterate: .[]
+type iterate struct {
+	target node
+}
+
+func (n *iterate) eval(e *env, input interface{}) (results []interface{}, err error) {
+	var targets []interface{}
+	if targets, err = n.target.eval(e, input); err != nil {
+		return
+	}
+	for _, target := range targets {
+		var vals []interface{}
+		if vals, err = values(target); err != nil {
+			return
+		}
+		results = append(results, vals...)
+	}
+	return
+}
+
+// array: [body]
+type array struct {
+	body node
+}
+
+func (n *array) eval(e *env, input interface{}) (results []interface{}, err error) {
+	arr := []interface{}{}
+	if n.body != nil {
+		var vals []interface{}
+		if vals, err = n.body.eval(e, input); err != nil {
+			return
+		}
+		arr = append(arr, vals...)
+	}
+	results = []interface{}{arr}
+	return
+}
+
+// object: {a: .b, "c": .d, (.e): .f, g}
+type object struct {
+	entries []entry
+}
+
+type entry struct {
+	key, val node
+}
+
+func (n *object) eval(e *env, input interface{}) (results []interface{}, err error) {
+	results = []interface{}{map[string]interface{}{}}
+
+	// Each entry that fans out multiplies the objects produced
+	for _, entry := range n.entries {
+		var keys, vals []interface{}
+		if keys, err = entry.key.eval(e, input); err != nil {
+			return
+		}
+		if vals, err = entry.val.eval(e, input); err != nil {
+			return
+		}
+		var next []interface{}
+		for _, result := range results {
+			for _, key := range keys {
+				k, ok := key.(string)
+				if !ok {
+					err = errors.Errorf("object keys must be strings not %s", typeName(key))
+					return
+				}
+				for _, val := range vals {
+					obj := map[string]interface{}{}
+					for x, y := range result.(map[string]interface{}) {
+						obj[x] = y
+					}
+					obj[k] = val
+					next = append(next, obj)
+				}
+			}
+		}
+		results = next
+	}
+	return
+}
+
+// binary: left op right
+type binary struct {
+	op          string
+	left, right node
+}
+
+func (n *binary) eval(e *env, input interface{}) (results []interface{}, err error) {
+	var lefts []interface{}
+	if lefts, err = n.left.eval(e, input); err != nil {
+		return
+	}
+
+	// Short circuit boolean logic
+	if n.op == "and" || n.op == "or" {
+		for _, left := range lefts {
+			if n.op == "and" && !truthy(left) || n.op == "or" && truthy(left) {
+				results = append(results, n.op == "or")
+				continue
+			}
+			var rights []interface{}
+			if rights, err = n.right.eval(e, input); err != nil {
+				return
+			}
+			for _, right := range rights {
+				results = append(results, truthy(right))
+			}
+		}
+		return
+	}
+
+	var rights []interface{}
+	if rights, err = n.right.eval(e, input); err != nil {
+		return
+	}
+	for _, right := range rights {
+		for _, left := range lefts {
+			var val interface{}
+			if val, err = operate(n.op, left, right); err != nil {
+				return
+			}
+			results = append(results, val)
+		}
+	}
+	return
+}
+
+// Value helpers
+//--------------------------------------------------------------------------------------------------
+
+// normalize unwraps values that expose their underlying data via an O() method and converts
+// Go maps with string keys and Go slices into the generic types jq operates on.
+func normalize(val interface{}) interface{} {
+	switch x := val.(type) {
+	case nil, bool, string, map[string]interface{}, []interface{}:
+		return x
+	case interface{ O() interface{} }:
+		return normalize(x.O())
+	}
+
+	v := reflect.ValueOf(val)
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		return normalize(v.Elem().Interface())
+	case reflect.Map:
+		if v.Type().Key().Kind() == reflect.String {
+			m := map[string]interface{}{}
+			for _, k := range v.MapKeys() {
+				m[k.String()] = v.MapIndex(k).Interface()
+			}
+			return m
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			s := make([]interface{}, v.Len())
+			for i := range s {
+				s[i] = v.Index(i).Interface()
+			}
+			return s
+		}
+		return string(v.Bytes())
+	}
+	return val
+}
+
+// toNumber returns the float64 value of any Go numeric type
+func toNumber(val interface{}) (float64, bool) {
+	v := reflect.ValueOf(val)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
+
+// fromNumber returns an int if the value is integral else the float64
+func fromNumber(val float64) interface{} {
+	if val == math.Trunc(val) && math.Abs(val) < 1<<53 {
+		return int(val)
+	}
+	return val
+}
+
+// typeName returns the jq type name for the given value
+func typeName(val interface{}) string {
+	val = normalize(val)
+	switch val.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	}
+	if _, ok := toNumber(val); ok {
+		return "number"
+	}
+	return fmt.Sprintf("%T", val)
+}
+
+// truthy returns false only for null and false
+func truthy(val interface{}) bool {
+	switch x := normalize(val).(type) {
+	case nil:
+		return false
+	case bool:
+		return x
+	}
+	return true
+}
+
+// sortedKeys returns the keys of the given map in sorted order
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// values returns the elements of an array or the values of an object in key order
+func values(val interface{}) (vals []interface{}, err error) {
+	switch x := normalize(val).(type) {
+	case []interface{}:
+		vals = x
+	case map[string]interface{}:
+		for _, k := range sortedKeys(x) {
+			vals = append(vals, x[k])
+		}
+	default:
+		err = errors.Errorf("cannot iterate over %s", typeName(val))
+	}
+	return
+}
+
+// indexValue returns the value of the target at the given key or index
+func indexValue(target, key interface{}) (val interface{}, err error) {
+	switch x := normalize(target).(type) {
+	case nil:
+		return
+	case map[string]interface{}:
+		if k, ok := key.(string); ok {
+			val = x[k]
+			return
+		}
+	case []interface{}:
+		if i, ok := toNumber(key); ok {
+			idx := int(math.Floor(i))
+			if idx < 0 {
+				idx += len(x)
+			}
+			if idx >= 0 && idx < len(x) {
+				val = x[idx]
+			}
+			return
+		}
+	}
+	err = errors.Errorf("cannot index %s with %s", typeName(target), typeName(key))
+	return
+}
+
+// sliceValue returns the given range of an array or string
+func sliceValue(target, from, to interface{}) (val interface{}, err error) {
+	bounds := func(l int) (i, j int, err error) {
+		i, j = 0, l
+		if from != nil {
+			f, ok := toNumber(from)
+			if !ok {
+				return 0, 0, errors.Errorf("slice indices must be numbers")
+			}
+			i = int(math.Floor(f))
+		}
+		if to != nil {
+			t, ok := toNumber(to)
+			if !ok {
+				return 0, 0, errors.Errorf("slice indices must be numbers")
+			}
+			j = int(math.Ceil(t))
+		}
+		if i < 0 {
+			i += l
+		}
+		if j < 0 {
+			j += l
+		}
+		if i < 0 {
+			i = 0
+		}
+		if j > l {
+			j = l
+		}
+		if j < i {
+			j = i
+		}
+		return
+	}
+
+	var i, j int
+	switch x := normalize(target).(type) {
+	case nil:
+	case []interface{}:
+		if i, j, err = bounds(len(x)); err == nil {
+			val = append([]interface{}{}, x[i:j]...)
+		}
+	case string:
+		runes := []rune(x)
+		if i, j, err = bounds(len(runes)); err == nil {
+			val = string(runes[i:j])
+		}
+	default:
+		err = errors.Errorf("cannot slice %s", typeName(target))
+	}
+	return
+}
+
+// typeOrder gives the jq sort order of the types
+func typeOrder(val interface{}) int {
+	switch x := val.(type) {
+	case nil:
+		return 0
+	case bool:
+		if !x {
+			return 1
+		}
+		return 2
+	case string:
+		return 4
+	case []interface{}:
+		return 5
+	case map[string]interface{}:
+		return 6
+	}
+	return 3
+}
+
+// compare returns -1, 0, 1 if a is less than, equal to or greater than b using jq ordering
+func compare(a, b interface{}) int {
+	a, b = normalize(a), normalize(b)
+	ta, tb := typeOrder(a), typeOrder(b)
+	if ta != tb {
+		if ta < tb {
+			return -1
+		}
+		return 1
+	}
+	switch x := a.(type) {
+	case string:
+		return strings.Compare(x, b.(string))
+	case []interface{}:
+		y := b.([]interface{})
+		for i := 0; i < len(x) && i < len(y); i++ {
+			if c := compare(x[i], y[i]); c != 0 {
+				return c
+			}
+		}
+		return compareInts(len(x), len(y))
+	case map[string]interface{}:
+		y := b.(map[string]interface{})
+		xk, yk := sortedKeys(x), sortedKeys(y)
+		if c := compare(toInterfaces(xk), toInterfaces(yk)); c != 0 {
+			return c
+		}
+		for _, k := range xk {
+			if c := compare(x[k], y[k]); c != 0 {
+				return c
+			}
+		}
+		return 0
+	case nil, bool:
+		return 0
+	}
+	fa, _ := toNumber(a)
+	fb, _ := toNumber(b)
+	switch {
+	case fa < fb:
+		return -1
+	case fa > fb:
+		return 1
+	}
+	return 0
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func toInterfaces(strs []string) []interface{} {
+	result := make([]interface{}, len(strs))
+	for i := range strs {
+		result[i] = strs[i]
+	}
+	return result
+}
+
+// operate applies the given arithmetic or comparison operator
+func operate(op string, left, right interface{}) (val interface{}, err error) {
+	switch op {
+	case "==":
+		return compare(left, right) == 0, nil
+	case "!=":
+		return compare(left, right) != 0, nil
+	case "<":
+		return compare(left, right) < 0, nil
+	case "<=":
+		return compare(left, right) <= 0, nil
+	case ">":
+		return compare(left, right) > 0, nil
+	case ">=":
+		return compare(left, right) >= 0, nil
+	}
+
+	l, r := normalize(left), normalize(right)
+	ln, lnum := toNumber(l)
+	rn, rnum := toNumber(r)
+	if lnum && rnum {
+		switch op {
+		case "+":
+			return fromNumber(ln + rn), nil
+		case "-":
+			return fromNumber(ln - rn), nil
+		case "*":
+			return fromNumber(ln * rn), nil
+		case "/":
+			if rn == 0 {
+				return nil, errors.Errorf("cannot divide by zero")
+			}
+			return fromNumber(ln / rn), nil
+		case "%":
+			if int(rn) == 0 {
+				return nil, errors.Errorf("cannot divide by zero")
+			}
+			return int(ln) % int(rn), nil
+		}
+	}
+
+	switch op {
+	case "+":
+		switch {
+		case l == nil:
+			return right, nil
+		case r == nil:
+			return left, nil
+		}
+		switch x := l.(type) {
+		case string:
+			if y, ok := r.(string); ok {
+				return x + y, nil
+			}
+		case []interface{}:
+			if y, ok := r.([]interface{}); ok {
+				return append(append([]interface{}{}, x...), y...), nil
+			}
+		case map[string]interface{}:
+			if y, ok := r.(map[string]interface{}); ok {
+				m := map[string]interface{}{}
+				for k, v := range x {
+					m[k] = v
+				}
+				for k, v := range y {
+					m[k] = v
+				}
+				return m, nil
+			}
+		}
+	case "-":
+		if x, ok := l.([]interface{}); ok {
+			if y, ok := r.([]interface{}); ok {
+				result := []interface{}{}
+				for i := range x {
+					found := false
+					for j := range y {
+						if compare(x[i], y[j]) == 0 {
+							found = true
+							break
+						}
+					}
+					if !found {
+						result = append(result, x[i])
+					}
+				}
+				return result, nil
+			}
+		}
+	case "*":
+		if x, ok := l.(map[string]interface{}); ok {
+			if y, ok := r.(map[string]interface{}); ok {
+				return deepMerge(x, y), nil
+			}
+		}
+	case "/":
+		if x, ok := l.(string); ok {
+			if y, ok := r.(string); ok {
+				return toInterfaces(strings.Split(x, y)), nil
+			}
+		}
+	}
+	err = errors.Errorf("%s and %s cannot be operated on with %s", typeName(left), typeName(right), op)
+	return
+}
+
+// deepMerge recursively merges b into a copy of a
+func deepMerge(a, b map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	for k, v := range a {
+		result[k] = v
+	}
+	for k, v := range b {
+		if x, ok := normalize(result[k]).(map[string]interface{}); ok {
+			if y, ok := normalize(v).(map[string]interface{}); ok {
+				result[k] = deepMerge(x, y)
+				continue
+			}
+		}
+		result[k] = v
+	}
+	return result
+}
+
+// length returns the jq length of the given value
+func length(val interface{}) (interface{}, error) {
+	switch x := normalize(val).(type) {
+	case nil:
+		return 0, nil
+	case string:
+		return utf8.RuneCountInString(x), nil
+	case []interface{}:
+		return len(x), nil
+	case map[string]interface{}:
+		return len(x), nil
+	}
+	if f, ok := toNumber(val); ok {
+		return fromNumber(math.Abs(f)), nil
+	}
+	return nil, errors.Errorf("%s has no length", typeName(val))
+}
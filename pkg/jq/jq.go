@@ -0,0 +1,80 @@
+// Package jq provides a jq expression language implementation for querying generic Go
+// data structures i.e. map[string]interface{} and []interface{} as produced by unmarshalling
+// JSON or YAML.
+//
+// Supported syntax
+//
+// • Identity, recursive descent and field access: ., .., .foo, ."foo", .["foo"], .foo?
+//
+// • Array index, slice and iteration: .[2], .[-1], .[2:5], .[:2], .[]
+//
+// • Pipes, comma and parenthesis: .a | .b, .a, .b, (.a, .b) | .c
+//
+// • Literals, array and object construction: "str", 1, true, null, [.[] | .a], {a: .b, c}
+//
+// • Strings with JSON escapes and interpolation: "tab\t\u00e9", "\(.name) v\(.version)"
+//
+// • Arithmetic, comparison, boolean and alternative operators: + - * / % == != < <= > >= and or //
+//
+// • Conditionals and variable binding: if . then 1 elif . then 2 else 3 end, .a as $x | $x
+//
+// • Builtins: add, all, any, ascii_downcase, ascii_upcase, contains, empty, endswith, error,
+// first, flatten, flatten(depth), floor, from_entries, group_by, has, join, keys, keys_unsorted,
+// last, length, limit, map, map_values, max, max_by, min, min_by, not, range, recurse, reverse,
+// select, sort, sort_by, split, sqrt, startswith, test, to_entries, tonumber, tostring, type,
+// unique, unique_by, values, with_entries
+//
+// Object keys are always iterated in sorted order as Go maps have no insertion order. Values
+// exposing their underlying data via an O() method e.g. *n.StringMap are unwrapped automatically.
+package jq
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// Query is a compiled jq expression that may be evaluated against many inputs
+type Query struct {
+	expr string
+	root node
+}
+
+// Compile parses the given jq expression into a reusable *Query
+func Compile(expr string) (q *Query, err error) {
+	q = &Query{expr: expr}
+	if q.root, err = parse(expr); err != nil {
+		err = errors.Wrapf(err, "failed to compile jq expression %q", expr)
+		q = nil
+	}
+	return
+}
+
+// Eval compiles the given jq expression and evaluates it against the given input returning
+// all results produced.
+func Eval(expr string, input interface{}) (results []interface{}, err error) {
+	var q *Query
+	if q, err = Compile(expr); err != nil {
+		return
+	}
+	return q.Eval(input)
+}
+
+// Eval evaluates the compiled expression against the given input returning all results produced.
+func (q *Query) Eval(input interface{}) (results []interface{}, err error) {
+	if results, err = q.root.eval(nil, input); err != nil {
+		err = errors.Wrapf(err, "failed to evaluate jq expression %q", q.expr)
+		results = nil
+	}
+	return
+}
+
+// String returns the expression the Query was compiled from
+func (q *Query) String() string {
+	return q.expr
+}
+
+// marshal JSON encodes the given value
+func marshal(val interface{}) ([]byte, error) {
+	return json.Marshal(val)
+}
@@ -0,0 +1,260 @@
+package jq
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testData = `{
+	"name": "app",
+	"version": 2,
+	"tags": ["a", "b", "c", "d", "e"],
+	"servers": [
+		{"name": "web", "port": 80, "enabled": true},
+		{"name": "db", "port": 5432, "enabled": false},
+		{"name": "cache", "port": 6379, "enabled": true}
+	],
+	"nested": {"one": {"two": {"three": 3}}}
+}`
+
+func load(t *testing.T) (data map[string]interface{}) {
+	assert.Nil(t, json.Unmarshal([]byte(testData), &data))
+	return
+}
+
+func eval(t *testing.T, expr string, input interface{}) []interface{} {
+	results, err := Eval(expr, input)
+	assert.Nil(t, err, expr)
+	return results
+}
+
+func TestCompile(t *testing.T) {
+
+	// invalid expressions
+	{
+		for _, expr := range []string{`.[`, `.a |`, `{a:`, `"foo`, `if . then 1`, `.a as x | .`, `@`,
+			`"\q"`, `"\u12"`, `"\u12zz"`, `"\(.a"`, `"\(.a |)"`, `"\()"`} {
+			q, err := Compile(expr)
+			assert.Nil(t, q, expr)
+			assert.NotNil(t, err, expr)
+		}
+	}
+
+	// reuse compiled query
+	{
+		q, err := Compile(".a")
+		assert.Nil(t, err)
+		assert.Equal(t, ".a", q.String())
+		results, _ := q.Eval(map[string]interface{}{"a": 1})
+		assert.Equal(t, []interface{}{1}, results)
+		results, _ = q.Eval(map[string]interface{}{"a": 2})
+		assert.Equal(t, []interface{}{2}, results)
+	}
+}
+
+func TestEval_Paths(t *testing.T) {
+	data := load(t)
+
+	// identity
+	assert.Equal(t, []interface{}{data}, eval(t, ``, data))
+	assert.Equal(t, []interface{}{data}, eval(t, `.`, data))
+
+	// fields
+	assert.Equal(t, []interface{}{"app"}, eval(t, `.name`, data))
+	assert.Equal(t, []interface{}{"app"}, eval(t, `."name"`, data))
+	assert.Equal(t, []interface{}{"app"}, eval(t, `.["name"]`, data))
+	assert.Equal(t, []interface{}{float64(3)}, eval(t, `.nested.one.two.three`, data))
+	assert.Equal(t, []interface{}{nil}, eval(t, `.missing.field`, data))
+
+	// index
+	assert.Equal(t, []interface{}{"a"}, eval(t, `.tags[0]`, data))
+	assert.Equal(t, []interface{}{"e"}, eval(t, `.tags[-1]`, data))
+	assert.Equal(t, []interface{}{"e"}, eval(t, `.tags.[-1]`, data))
+	assert.Equal(t, []interface{}{nil}, eval(t, `.tags[10]`, data))
+
+	// slices
+	assert.Equal(t, []interface{}{[]interface{}{"c", "d", "e"}}, eval(t, `.tags[2:5]`, data))
+	assert.Equal(t, []interface{}{[]interface{}{"a", "b"}}, eval(t, `.tags[:2]`, data))
+	assert.Equal(t, []interface{}{[]interface{}{"d", "e"}}, eval(t, `.tags[-2:]`, data))
+	assert.Equal(t, []interface{}{"pp"}, eval(t, `.name[1:]`, data))
+
+	// iterate
+	assert.Equal(t, []interface{}{"a", "b", "c", "d", "e"}, eval(t, `.tags[]`, data))
+	assert.Equal(t, []interface{}{"web", "db", "cache"}, eval(t, `.servers[].name`, data))
+	assert.Equal(t, []interface{}{float64(1), float64(2)}, eval(t, `.[]`, map[string]interface{}{"b": 2.0, "a": 1.0}))
+
+	// errors and optional
+	{
+		_, err := Eval(`.name.foo`, data)
+		assert.NotNil(t, err)
+		_, err = Eval(`.name[]`, data)
+		assert.NotNil(t, err)
+		assert.Equal(t, []interface{}(nil), eval(t, `.name.foo?`, data))
+	}
+
+	// recursive descent
+	{
+		results := eval(t, `.. | .three? // empty`, data)
+		assert.Equal(t, []interface{}{float64(3)}, results)
+		assert.Equal(t, 4, len(eval(t, `..`, map[string]interface{}{"a": []interface{}{1, 2}})))
+	}
+}
+
+func TestEval_Pipes(t *testing.T) {
+	data := load(t)
+	assert.Equal(t, []interface{}{float64(3)}, eval(t, `.nested | .one | .two.three`, data))
+	assert.Equal(t, []interface{}{"app", float64(2)}, eval(t, `.name, .version`, data))
+	assert.Equal(t, []interface{}{"web", float64(80)}, eval(t, `.servers[0] | .name, .port`, data))
+	assert.Equal(t, []interface{}{"app"}, eval(t, `(.name, .version) | select(type == "string")`, data))
+}
+
+func TestEval_Select(t *testing.T) {
+	data := load(t)
+	assert.Equal(t, []interface{}{"web", "cache"}, eval(t, `.servers[] | select(.enabled) | .name`, data))
+	assert.Equal(t, []interface{}{"db"}, eval(t, `.servers[] | select(.enabled | not) | .name`, data))
+	assert.Equal(t, []interface{}{"db", "cache"}, eval(t, `.servers[] | select(.port > 100) | .name`, data))
+	assert.Equal(t, []interface{}{"cache"}, eval(t, `.servers[] | select(.port >= 1000 and .enabled) | .name`, data))
+	assert.Equal(t, []interface{}{"web", "db"}, eval(t, `.servers[] | select(.name == "web" or .name == "db") | .name`, data))
+	assert.Equal(t, []interface{}{"web"}, eval(t, `.servers[] | select(.name != "db" and .port < 1000) | .name`, data))
+	assert.Equal(t, []interface{}{"db"}, eval(t, `.servers[] | select(.name | startswith("d")) | .name`, data))
+	assert.Equal(t, []interface{}{"cache"}, eval(t, `.servers[] | select(.name | test("^c.*e$")) | .name`, data))
+}
+
+func TestEval_Operators(t *testing.T) {
+	assert.Equal(t, []interface{}{3}, eval(t, `1 + 2`, nil))
+	assert.Equal(t, []interface{}{7}, eval(t, `1 + 2 * 3`, nil))
+	assert.Equal(t, []interface{}{9}, eval(t, `(1 + 2) * 3`, nil))
+	assert.Equal(t, []interface{}{-1}, eval(t, `-1`, nil))
+	assert.Equal(t, []interface{}{2.5}, eval(t, `5 / 2`, nil))
+	assert.Equal(t, []interface{}{1}, eval(t, `5 % 2`, nil))
+	assert.Equal(t, []interface{}{"ab"}, eval(t, `"a" + "b"`, nil))
+	assert.Equal(t, []interface{}{[]interface{}{1, 2}}, eval(t, `[1] + [2]`, nil))
+	assert.Equal(t, []interface{}{[]interface{}{1}}, eval(t, `[1, 2] - [2]`, nil))
+	assert.Equal(t, []interface{}{map[string]interface{}{"a": 1, "b": 2}}, eval(t, `{a: 1} + {b: 2}`, nil))
+	assert.Equal(t, []interface{}{map[string]interface{}{"a": map[string]interface{}{"b": 1, "c": 2}}},
+		eval(t, `{a: {b: 1}} * {a: {c: 2}}`, nil))
+	assert.Equal(t, []interface{}{[]interface{}{"a", "b"}}, eval(t, `"a,b" / ","`, nil))
+	assert.Equal(t, []interface{}{"default"}, eval(t, `.missing // "default"`, map[string]interface{}{}))
+	assert.Equal(t, []interface{}{true}, eval(t, `1 == 1.0`, nil))
+	assert.Equal(t, []interface{}{true}, eval(t, `null < false and false < 1 and 1 < "a" and "a" < [] and [] < {}`, nil))
+	assert.Equal(t, []interface{}{11, 12, 21, 22}, eval(t, `(1, 2) + (10, 20)`, nil))
+
+	_, err := Eval(`1 / 0`, nil)
+	assert.NotNil(t, err)
+	_, err = Eval(`"a" - 1`, nil)
+	assert.NotNil(t, err)
+}
+
+func TestEval_Construction(t *testing.T) {
+	data := load(t)
+
+	// arrays
+	assert.Equal(t, []interface{}{[]interface{}{}}, eval(t, `[]`, data))
+	assert.Equal(t, []interface{}{[]interface{}{"web", "db", "cache"}}, eval(t, `[.servers[].name]`, data))
+
+	// objects
+	assert.Equal(t, []interface{}{map[string]interface{}{"n": "app", "v": float64(2)}}, eval(t, `{n: .name, "v": .version}`, data))
+	assert.Equal(t, []interface{}{map[string]interface{}{"name": "app"}}, eval(t, `{name}`, data))
+	assert.Equal(t, []interface{}{map[string]interface{}{"app": float64(2)}}, eval(t, `{(.name): .version}`, data))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "web"},
+		map[string]interface{}{"name": "db"},
+		map[string]interface{}{"name": "cache"},
+	}, eval(t, `{name: .servers[].name}`, data))
+
+	// strings
+	assert.Equal(t, []interface{}{"\"\\/\b\f\n\r\t"}, eval(t, `"\"\\\/\b\f\n\r\t"`, nil))
+	assert.Equal(t, []interface{}{"é😀"}, eval(t, `"\u00e9\ud83d\ude00"`, nil))
+	assert.Equal(t, []interface{}{"app v2"}, eval(t, `"\(.name) v\(.version)"`, data))
+	assert.Equal(t, []interface{}{"web:80", "db:5432"}, eval(t, `.servers[:2][] | "\(.name):\(.port)"`, data))
+	assert.Equal(t, []interface{}{"a1", "a2"}, eval(t, `"a\(1, 2)"`, nil))
+	assert.Equal(t, []interface{}{`[1] {"a":null} x)`}, eval(t, `"\([1]) \({a: null}) \("x)")"`, nil))
+	assert.Equal(t, []interface{}{"<in>"}, eval(t, `"<\("\("in")")>"`, nil))
+	assert.Equal(t, []interface{}{float64(2)}, eval(t, `."\("ver")sion"`, data))
+	assert.Equal(t, []interface{}{map[string]interface{}{"k_app": 1}}, eval(t, `{"k_\(.name)": 1}`, data))
+
+	// variables and conditionals
+	assert.Equal(t, []interface{}{"app-web", "app-db", "app-cache"}, eval(t, `.name as $app | .servers[] | $app + "-" + .name`, data))
+	assert.Equal(t, []interface{}{"small", "big", "big"}, eval(t, `.servers[] | if .port < 100 then "small" else "big" end`, data))
+	assert.Equal(t, []interface{}{"a", "b", "c"}, eval(t, `.[] | if . == 1 then "a" elif . == 2 then "b" else "c" end`, []interface{}{1, 2, 3}))
+	_, err := Eval(`$missing`, data)
+	assert.NotNil(t, err)
+}
+
+func TestEval_Builtins(t *testing.T) {
+	data := load(t)
+
+	assert.Equal(t, []interface{}{[]interface{}{"name", "nested", "servers", "tags", "version"}}, eval(t, `keys`, data))
+	assert.Equal(t, []interface{}{[]interface{}{0, 1}}, eval(t, `keys`, []interface{}{"a", "b"}))
+	assert.Equal(t, []interface{}{5}, eval(t, `length`, data))
+	assert.Equal(t, []interface{}{5}, eval(t, `.tags | length`, data))
+	assert.Equal(t, []interface{}{3}, eval(t, `.name | length`, data))
+	assert.Equal(t, []interface{}{0}, eval(t, `null | length`, data))
+	assert.Equal(t, []interface{}{[]interface{}{81, 5433, 6380}}, eval(t, `.servers | map(.port + 1)`, data))
+	assert.Equal(t, []interface{}{map[string]interface{}{"a": 2}}, eval(t, `map_values(. + 1)`, map[string]interface{}{"a": 1}))
+	assert.Equal(t, []interface{}{true, false}, eval(t, `has("name"), has("foo")`, data))
+	assert.Equal(t, []interface{}{"object", "string", "number", "array", "boolean", "null"},
+		eval(t, `type, (.name | type), (.version | type), (.tags | type), (true | type), (null | type)`, data))
+	assert.Equal(t, []interface{}{11891}, eval(t, `[.servers[].port] | add`, data))
+	assert.Equal(t, []interface{}{"a-b-c-d-e"}, eval(t, `.tags | join("-")`, data))
+	assert.Equal(t, []interface{}{true, false}, eval(t, `(.servers | any(.enabled)), (.servers | all(.enabled))`, data))
+	assert.Equal(t, []interface{}{"a", "e"}, eval(t, `(.tags | first), (.tags | last)`, data))
+	assert.Equal(t, []interface{}{"a"}, eval(t, `first(.tags[])`, data))
+	assert.Equal(t, []interface{}{[]interface{}{"a", "b"}}, eval(t, `[limit(2; .tags[])]`, data))
+	assert.Equal(t, []interface{}{[]interface{}{1, 2, 3}}, eval(t, `[3, 1, 2, 1] | unique`, nil))
+	assert.Equal(t, []interface{}{[]interface{}{1, 1, 2, 3}}, eval(t, `[3, 1, 2, 1] | sort`, nil))
+	assert.Equal(t, []interface{}{[]interface{}{3, 2, 1}}, eval(t, `[1, 2, 3] | reverse`, nil))
+	assert.Equal(t, []interface{}{"cba"}, eval(t, `"abc" | reverse`, nil))
+	assert.Equal(t, []interface{}{[]interface{}{"cache", "db", "web"}}, eval(t, `.servers | sort_by(.name) | map(.name)`, data))
+	assert.Equal(t, []interface{}{[]interface{}{1, 2}}, eval(t, `.servers | group_by(.enabled) | map(length)`, data))
+	assert.Equal(t, []interface{}{"web", "cache"}, eval(t, `(.servers | min_by(.port) | .name), (.servers | max_by(.port) | .name)`, data))
+	assert.Equal(t, []interface{}{1, 3}, eval(t, `([3, 1, 2] | min), ([3, 1, 2] | max)`, nil))
+	assert.Equal(t, []interface{}{[]interface{}{0, 1, 2}}, eval(t, `[range(3)]`, nil))
+	assert.Equal(t, []interface{}{[]interface{}{1, 2}}, eval(t, `[range(1; 3)]`, nil))
+	assert.Equal(t, []interface{}{[]interface{}{1, 2, 3}}, eval(t, `[1, [2, [3]]] | flatten`, nil))
+	assert.Equal(t, []interface{}{[]interface{}{1, 2, []interface{}{3}}}, eval(t, `[1, [2, [3]]] | flatten(1)`, nil))
+	assert.Equal(t, []interface{}{[]interface{}{1, []interface{}{2}}}, eval(t, `[1, [2]] | flatten(0)`, nil))
+	assert.Equal(t, []interface{}{[]interface{}{map[string]interface{}{"key": "a", "value": 1}}}, eval(t, `to_entries`, map[string]interface{}{"a": 1}))
+	assert.Equal(t, []interface{}{map[string]interface{}{"a": 1}}, eval(t, `to_entries | from_entries`, map[string]interface{}{"a": 1}))
+	assert.Equal(t, []interface{}{map[string]interface{}{"x_a": 1}}, eval(t, `with_entries({key: ("x_" + .key), value})`, map[string]interface{}{"a": 1}))
+	assert.Equal(t, []interface{}{"1", float64(2), 3}, eval(t, `(1 | tostring), ("2.0" | tonumber), ("3" | tonumber)`, nil))
+	assert.Equal(t, []interface{}{`{"a":1}`}, eval(t, `tostring`, map[string]interface{}{"a": 1}))
+	assert.Equal(t, []interface{}{true, true}, eval(t, `([1, 2] | contains([1])), ({a: "foo"} | contains({a: "o"}))`, nil))
+	assert.Equal(t, []interface{}{"ABC", "abc"}, eval(t, `("abc" | ascii_upcase), ("ABC" | ascii_downcase)`, nil))
+	assert.Equal(t, []interface{}{1, 2}, eval(t, `.[] | values`, []interface{}{1, nil, 2}))
+	assert.Equal(t, []interface{}{4, 3}, eval(t, `floor, (9 | sqrt)`, 4.5))
+
+	_, err := Eval(`[1] | flatten(-1)`, nil)
+	assert.Contains(t, err.Error(), "flatten depth must not be negative")
+	_, err = Eval(`foo`, data)
+	assert.NotNil(t, err)
+	_, err = Eval(`error("boom")`, data)
+	assert.Equal(t, `failed to evaluate jq expression "error(\"boom\")": boom`, err.Error())
+}
+
+type wrapped struct {
+	m map[string]interface{}
+}
+
+func (w *wrapped) O() interface{} {
+	return w.m
+}
+
+func TestEval_Normalize(t *testing.T) {
+
+	// unwrap O() types
+	{
+		data := map[string]interface{}{"a": &wrapped{map[string]interface{}{"b": 1}}}
+		assert.Equal(t, []interface{}{1}, eval(t, `.a.b`, data))
+	}
+
+	// typed Go maps and slices
+	{
+		data := map[string]interface{}{"a": map[string]string{"b": "c"}, "d": []string{"e", "f"}}
+		assert.Equal(t, []interface{}{"c"}, eval(t, `.a.b`, data))
+		assert.Equal(t, []interface{}{"f"}, eval(t, `.d[1]`, data))
+		assert.Equal(t, []interface{}{2}, eval(t, `.d | length`, data))
+	}
+}
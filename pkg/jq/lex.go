@@ -0,0 +1,289 @@
+package jq
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+
+	"github.com/pkg/errors"
+)
+
+// tokenType is an enumeration of the lexical tokens in a jq expression
+type tokenType int
+
+const (
+	tokEOF tokenType = iota
+	tokDot
+	tokRecurse
+	tokIdent
+	tokField
+	tokString
+	tokNumber
+	tokOp
+	tokLParen
+	tokRParen
+	tokLBracket
+	tokRBracket
+	tokLBrace
+	tokRBrace
+	tokPipe
+	tokComma
+	tokColon
+	tokSemicolon
+	tokQuestion
+	tokFormat // literal text of an interpolated string preceding an interpolation
+)
+
+// token is a single lexical element of a jq expression
+type token struct {
+	typ tokenType
+	val string
+	pos int
+}
+
+// lex splits the given jq expression into tokens
+func lex(expr string) (tokens []token, err error) {
+	runes := []rune(expr)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		start := i
+		switch {
+
+		// Skip whitespace
+		case unicode.IsSpace(r):
+			i++
+			continue
+
+		// Comments run until the end of the line
+		case r == '#':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+			continue
+
+		// Identity, recursive descent or field access e.g. ., .., .foo
+		case r == '.':
+			i++
+			switch {
+			case i < len(runes) && runes[i] == '.':
+				i++
+				tokens = append(tokens, token{tokRecurse, "..", start})
+			case i < len(runes) && isIdentStart(runes[i]):
+				j := i
+				for i < len(runes) && isIdentPart(runes[i]) {
+					i++
+				}
+				tokens = append(tokens, token{tokField, string(runes[j:i]), start})
+			case i < len(runes) && unicode.IsDigit(runes[i]):
+				j := i - 1
+				for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == 'e' || runes[i] == 'E') {
+					i++
+				}
+				tokens = append(tokens, token{tokNumber, string(runes[j:i]), start})
+			default:
+				tokens = append(tokens, token{tokDot, ".", start})
+			}
+
+		// String literals
+		case r == '"':
+			var toks []token
+			if toks, i, err = lexString(runes, i); err != nil {
+				return
+			}
+			tokens = append(tokens, toks...)
+
+		// Number literals
+		case unicode.IsDigit(r):
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.' || runes[i] == 'e' || runes[i] == 'E') {
+				i++
+			}
+			tokens = append(tokens, token{tokNumber, string(runes[start:i]), start})
+
+		// Identifiers, keywords and builtins
+		case isIdentStart(r) || r == '$':
+			i++
+			for i < len(runes) && isIdentPart(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, token{tokIdent, string(runes[start:i]), start})
+
+		// Two character operators
+		case i+1 < len(runes) && strings.Contains("== != <= >= //", string(runes[i:i+2])):
+			i += 2
+			tokens = append(tokens, token{tokOp, string(runes[start:i]), start})
+
+		// Single character tokens
+		default:
+			i++
+			var typ tokenType
+			switch r {
+			case '(':
+				typ = tokLParen
+			case ')':
+				typ = tokRParen
+			case '[':
+				typ = tokLBracket
+			case ']':
+				typ = tokRBracket
+			case '{':
+				typ = tokLBrace
+			case '}':
+				typ = tokRBrace
+			case '|':
+				typ = tokPipe
+			case ',':
+				typ = tokComma
+			case ':':
+				typ = tokColon
+			case ';':
+				typ = tokSemicolon
+			case '?':
+				typ = tokQuestion
+			case '<', '>', '+', '-', '*', '/', '%':
+				typ = tokOp
+			default:
+				err = errors.Errorf("unexpected character %q at position %d", r, start)
+				return
+			}
+			tokens = append(tokens, token{typ, string(r), start})
+		}
+	}
+	tokens = append(tokens, token{tokEOF, "", len(runes)})
+	return
+}
+
+func isIdentStart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
+
+func isIdentPart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// parseNumber converts a number token into a float64 or int as appropriate
+func parseNumber(val string) (interface{}, error) {
+	if i, err := strconv.Atoi(val); err == nil {
+		return i, nil
+	}
+	f, err := strconv.ParseFloat(val, 64)
+	if err != nil {
+		return nil, errors.Errorf("invalid number %s", val)
+	}
+	return f, nil
+}
+
+// lexString lexes the string literal starting at the given quote returning its tokens and the index
+// following the closing quote. Supports the JSON escapes and \(...) interpolation which is lexed as
+// a tokFormat of the preceding text followed by the interpolated expression's tokens and a closing
+// tokRParen with the text following the last interpolation ending in a tokString.
+func lexString(runes []rune, start int) (tokens []token, i int, err error) {
+	var builder strings.Builder
+	pos := start
+	for i = start + 1; i < len(runes); i++ {
+		switch r := runes[i]; {
+		case r == '"':
+			tokens = append(tokens, token{tokString, builder.String(), pos})
+			i++
+			return
+		case r != '\\':
+			builder.WriteRune(r)
+			continue
+		case i+1 >= len(runes):
+			continue
+		}
+
+		i++
+		switch runes[i] {
+		case '"', '\\', '/':
+			builder.WriteRune(runes[i])
+		case 'b':
+			builder.WriteRune('\b')
+		case 'f':
+			builder.WriteRune('\f')
+		case 'n':
+			builder.WriteRune('\n')
+		case 'r':
+			builder.WriteRune('\r')
+		case 't':
+			builder.WriteRune('\t')
+		case 'u':
+			var r rune
+			if r, i, err = lexUnicode(runes, i); err != nil {
+				return
+			}
+			builder.WriteRune(r)
+		case '(':
+			j := i
+			if i, err = lexInterpolation(runes, i); err != nil {
+				return
+			}
+			var inner []token
+			if inner, err = lex(string(runes[j+1 : i])); err != nil {
+				return
+			}
+			tokens = append(tokens, token{tokFormat, builder.String(), pos})
+			for _, tok := range inner[:len(inner)-1] {
+				tok.pos += j + 1
+				tokens = append(tokens, tok)
+			}
+			tokens = append(tokens, token{tokRParen, ")", i})
+			builder.Reset()
+			pos = i
+		default:
+			err = errors.Errorf("invalid escape \\%c in string at position %d", runes[i], i-1)
+			return
+		}
+	}
+	err = errors.Errorf("unterminated string at position %d", start)
+	return
+}
+
+// lexUnicode decodes the \uXXXX escape whose u is at the given index combining surrogate pairs
+// returning the rune and the index of its last hex digit.
+func lexUnicode(runes []rune, i int) (r rune, end int, err error) {
+	hex := func(j int) (rune, bool) {
+		if j+4 >= len(runes) {
+			return 0, false
+		}
+		x, e := strconv.ParseUint(string(runes[j+1:j+5]), 16, 32)
+		return rune(x), e == nil
+	}
+	var ok bool
+	if r, ok = hex(i); !ok {
+		err = errors.Errorf("invalid unicode escape in string at position %d", i-1)
+		return
+	}
+	end = i + 4
+	if utf16.IsSurrogate(r) && end+2 < len(runes) && runes[end+1] == '\\' && runes[end+2] == 'u' {
+		if low, ok := hex(end + 2); ok {
+			if x := utf16.DecodeRune(r, low); x != unicode.ReplacementChar {
+				r, end = x, end+6
+			}
+		}
+	}
+	return
+}
+
+// lexInterpolation finds the closing parenthesis of the interpolation whose opening parenthesis is
+// at the given index skipping over nested parenthesis and strings.
+func lexInterpolation(runes []rune, start int) (end int, err error) {
+	depth := 0
+	for end = start; end < len(runes); end++ {
+		switch runes[end] {
+		case '(':
+			depth++
+		case ')':
+			if depth--; depth == 0 {
+				return
+			}
+		case '"':
+			if _, end, err = lexString(runes, end); err != nil {
+				return
+			}
+			end--
+		}
+	}
+	err = errors.Errorf("unterminated string interpolation at position %d", start-1)
+	return
+}
@@ -0,0 +1,501 @@
+package jq
+
+import (
+	"github.com/pkg/errors"
+)
+
+// parser builds an expression tree from the lexical tokens of a jq expression
+type parser struct {
+	tokens []token
+	i      int
+}
+
+// parse converts the given jq expression into an expression tree
+func parse(expr string) (n node, err error) {
+	var tokens []token
+	if tokens, err = lex(expr); err != nil {
+		return
+	}
+	p := &parser{tokens: tokens}
+
+	// Empty expression is treated as identity
+	if p.peek().typ == tokEOF {
+		n = &identity{}
+		return
+	}
+	if n, err = p.parsePipe(); err != nil {
+		return
+	}
+	if tok := p.peek(); tok.typ != tokEOF {
+		err = errors.Errorf("unexpected token %q at position %d", tok.val, tok.pos)
+	}
+	return
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.i]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.i]
+	if tok.typ != tokEOF {
+		p.i++
+	}
+	return tok
+}
+
+func (p *parser) expect(typ tokenType, val string) (err error) {
+	if tok := p.next(); tok.typ != typ {
+		err = errors.Errorf("expected %q but found %q at position %d", val, tok.val, tok.pos)
+	}
+	return
+}
+
+func (p *parser) isIdent(val string) bool {
+	tok := p.peek()
+	return tok.typ == tokIdent && tok.val == val
+}
+
+func (p *parser) isOp(vals ...string) bool {
+	tok := p.peek()
+	if tok.typ != tokOp {
+		return false
+	}
+	for _, val := range vals {
+		if tok.val == val {
+			return true
+		}
+	}
+	return false
+}
+
+// pipe: comma ('|' pipe)?
+func (p *parser) parsePipe() (n node, err error) {
+	if n, err = p.parseComma(); err != nil {
+		return
+	}
+	if p.peek().typ == tokPipe {
+		p.next()
+		var right node
+		if right, err = p.parsePipe(); err != nil {
+			return
+		}
+		n = &pipe{n, right}
+	}
+	return
+}
+
+// comma: alternative (',' alternative)*
+func (p *parser) parseComma() (n node, err error) {
+	if n, err = p.parseAlt(); err != nil {
+		return
+	}
+	for p.peek().typ == tokComma {
+		p.next()
+		var right node
+		if right, err = p.parseAlt(); err != nil {
+			return
+		}
+		n = &comma{n, right}
+	}
+	return
+}
+
+// alternative: or ('//' alternative)?
+func (p *parser) parseAlt() (n node, err error) {
+	if n, err = p.parseOr(); err != nil {
+		return
+	}
+	if p.isOp("//") {
+		p.next()
+		var right node
+		if right, err = p.parseAlt(); err != nil {
+			return
+		}
+		n = &alternative{n, right}
+	}
+	return
+}
+
+// or: and ('or' and)*
+func (p *parser) parseOr() (n node, err error) {
+	if n, err = p.parseAnd(); err != nil {
+		return
+	}
+	for p.isIdent("or") {
+		p.next()
+		var right node
+		if right, err = p.parseAnd(); err != nil {
+			return
+		}
+		n = &binary{"or", n, right}
+	}
+	return
+}
+
+// and: compare ('and' compare)*
+func (p *parser) parseAnd() (n node, err error) {
+	if n, err = p.parseCompare(); err != nil {
+		return
+	}
+	for p.isIdent("and") {
+		p.next()
+		var right node
+		if right, err = p.parseCompare(); err != nil {
+			return
+		}
+		n = &binary{"and", n, right}
+	}
+	return
+}
+
+// compare: additive (op additive)?
+func (p *parser) parseCompare() (n node, err error) {
+	if n, err = p.parseAdditive(); err != nil {
+		return
+	}
+	if p.isOp("==", "!=", "<", "<=", ">", ">=") {
+		op := p.next().val
+		var right node
+		if right, err = p.parseAdditive(); err != nil {
+			return
+		}
+		n = &binary{op, n, right}
+	}
+	return
+}
+
+// additive: multiplicative (('+'|'-') multiplicative)*
+func (p *parser) parseAdditive() (n node, err error) {
+	if n, err = p.parseMultiplicative(); err != nil {
+		return
+	}
+	for p.isOp("+", "-") {
+		op := p.next().val
+		var right node
+		if right, err = p.parseMultiplicative(); err != nil {
+			return
+		}
+		n = &binary{op, n, right}
+	}
+	return
+}
+
+// multiplicative: unary (('*'|'/'|'%') unary)*
+func (p *parser) parseMultiplicative() (n node, err error) {
+	if n, err = p.parseUnary(); err != nil {
+		return
+	}
+	for p.isOp("*", "/", "%") {
+		op := p.next().val
+		var right node
+		if right, err = p.parseUnary(); err != nil {
+			return
+		}
+		n = &binary{op, n, right}
+	}
+	return
+}
+
+// unary: '-' unary | postfix
+func (p *parser) parseUnary() (n node, err error) {
+	if p.isOp("-") {
+		p.next()
+		var operand node
+		if operand, err = p.parseUnary(); err != nil {
+			return
+		}
+		n = &binary{"-", &literal{0}, operand}
+		return
+	}
+	return p.parsePostfix()
+}
+
+// postfix: primary suffix* ('as' $var '|' pipe)?
+func (p *parser) parsePostfix() (n node, err error) {
+	if n, err = p.parsePrimary(); err != nil {
+		return
+	}
+	for {
+		tok := p.peek()
+		switch {
+		case tok.typ == tokField:
+			p.next()
+			n = &index{target: n, key: &literal{tok.val}}
+		case tok.typ == tokDot && (p.tokens[p.i+1].typ == tokString || p.tokens[p.i+1].typ == tokFormat):
+			p.next()
+			var key node
+			if key, err = p.parseString(p.next()); err != nil {
+				return
+			}
+			n = &index{target: n, key: key}
+		case tok.typ == tokDot && p.tokens[p.i+1].typ == tokLBracket:
+			p.next()
+			if n, err = p.parseBracket(n); err != nil {
+				return
+			}
+		case tok.typ == tokLBracket:
+			if n, err = p.parseBracket(n); err != nil {
+				return
+			}
+		case tok.typ == tokQuestion:
+			p.next()
+			n = &optional{n}
+		case p.isIdent("as"):
+			p.next()
+			v := p.next()
+			if v.typ != tokIdent || v.val[0] != '$' {
+				err = errors.Errorf("expected variable but found %q at position %d", v.val, v.pos)
+				return
+			}
+			if err = p.expect(tokPipe, "|"); err != nil {
+				return
+			}
+			var body node
+			if body, err = p.parsePipe(); err != nil {
+				return
+			}
+			n = &binding{source: n, name: v.val, body: body}
+			return
+		default:
+			return
+		}
+	}
+}
+
+// bracket suffix: '[' ']' | '[' pipe ']' | '[' pipe? ':' pipe? ']'
+func (p *parser) parseBracket(target node) (n node, err error) {
+	if err = p.expect(tokLBracket, "["); err != nil {
+		return
+	}
+
+	// Iterator e.g. .[]
+	if p.peek().typ == tokRBracket {
+		p.next()
+		n = &iterate{target: target}
+		return
+	}
+
+	// Slice with no start e.g. .[:2]
+	var from, to node
+	if p.peek().typ != tokColon {
+		if from, err = p.parsePipe(); err != nil {
+			return
+		}
+	}
+
+	// Slice e.g. .[2:5], .[2:]
+	if p.peek().typ == tokColon {
+		p.next()
+		if p.peek().typ != tokRBracket {
+			if to, err = p.parsePipe(); err != nil {
+				return
+			}
+		}
+		if err = p.expect(tokRBracket, "]"); err != nil {
+			return
+		}
+		n = &slice{target: target, from: from, to: to}
+		return
+	}
+
+	if err = p.expect(tokRBracket, "]"); err != nil {
+		return
+	}
+	n = &index{target: target, key: from}
+	return
+}
+
+// primary: '.' | '..' | .field | literal | '(' pipe ')' | '[' pipe? ']' | '{' ... '}' | $var | if | call
+func (p *parser) parsePrimary() (n node, err error) {
+	tok := p.next()
+	switch tok.typ {
+	case tokDot:
+		n = &identity{}
+		switch p.peek().typ {
+		case tokString, tokFormat:
+			var key node
+			if key, err = p.parseString(p.next()); err != nil {
+				return
+			}
+			n = &index{target: n, key: key}
+		case tokLBracket:
+			n, err = p.parseBracket(n)
+		}
+	case tokRecurse:
+		n = &call{name: "recurse"}
+	case tokField:
+		n = &index{target: &identity{}, key: &literal{tok.val}}
+	case tokString, tokFormat:
+		n, err = p.parseString(tok)
+	case tokNumber:
+		var val interface{}
+		if val, err = parseNumber(tok.val); err != nil {
+			return
+		}
+		n = &literal{val}
+	case tokLParen:
+		if n, err = p.parsePipe(); err != nil {
+			return
+		}
+		err = p.expect(tokRParen, ")")
+	case tokLBracket:
+		arr := &array{}
+		if p.peek().typ != tokRBracket {
+			if arr.body, err = p.parsePipe(); err != nil {
+				return
+			}
+		}
+		n = arr
+		err = p.expect(tokRBracket, "]")
+	case tokLBrace:
+		n, err = p.parseObject()
+	case tokIdent:
+		switch {
+		case tok.val[0] == '$':
+			n = &variable{tok.val}
+		case tok.val == "true":
+			n = &literal{true}
+		case tok.val == "false":
+			n = &literal{false}
+		case tok.val == "null":
+			n = &literal{nil}
+		case tok.val == "if":
+			n, err = p.parseIf()
+		default:
+			c := &call{name: tok.val}
+			if p.peek().typ == tokLParen {
+				p.next()
+				for {
+					var arg node
+					if arg, err = p.parsePipe(); err != nil {
+						return
+					}
+					c.args = append(c.args, arg)
+					if p.peek().typ != tokSemicolon {
+						break
+					}
+					p.next()
+				}
+				if err = p.expect(tokRParen, ")"); err != nil {
+					return
+				}
+			}
+			n = c
+		}
+	default:
+		err = errors.Errorf("unexpected token %q at position %d", tok.val, tok.pos)
+	}
+	return
+}
+
+// string: tokString | (tokFormat pipe ')')+ tokString
+func (p *parser) parseString(tok token) (n node, err error) {
+	if tok.typ == tokString {
+		n = &literal{tok.val}
+		return
+	}
+	f := &format{}
+	for tok.typ == tokFormat {
+		var expr node
+		if expr, err = p.parsePipe(); err != nil {
+			return
+		}
+		if err = p.expect(tokRParen, ")"); err != nil {
+			return
+		}
+		f.parts = append(f.parts, &literal{tok.val}, expr)
+		tok = p.next()
+	}
+	f.parts = append(f.parts, &literal{tok.val})
+	n = f
+	return
+}
+
+// object: '{' (key (':' value)?)* '}'
+func (p *parser) parseObject() (n node, err error) {
+	obj := &object{}
+	for p.peek().typ != tokRBrace {
+		var e entry
+		tok := p.next()
+		switch tok.typ {
+		case tokIdent:
+			if tok.val[0] == '$' {
+				e.key = &literal{tok.val[1:]}
+				e.val = &variable{tok.val}
+			} else {
+				e.key = &literal{tok.val}
+			}
+		case tokString, tokFormat:
+			if e.key, err = p.parseString(tok); err != nil {
+				return
+			}
+		case tokNumber:
+			e.key = &literal{tok.val}
+		case tokLParen:
+			if e.key, err = p.parsePipe(); err != nil {
+				return
+			}
+			if err = p.expect(tokRParen, ")"); err != nil {
+				return
+			}
+		default:
+			err = errors.Errorf("invalid object key %q at position %d", tok.val, tok.pos)
+			return
+		}
+
+		// Value given or shorthand e.g. {a} == {a: .a}
+		if p.peek().typ == tokColon {
+			p.next()
+			if e.val, err = p.parseAlt(); err != nil {
+				return
+			}
+		} else if e.val == nil {
+			e.val = &index{target: &identity{}, key: e.key}
+		}
+		obj.entries = append(obj.entries, e)
+
+		if p.peek().typ != tokComma {
+			break
+		}
+		p.next()
+	}
+	n = obj
+	err = p.expect(tokRBrace, "}")
+	return
+}
+
+// if: 'if' pipe 'then' pipe ('elif' pipe 'then' pipe)* ('else' pipe)? 'end'
+func (p *parser) parseIf() (n node, err error) {
+	c := &conditional{}
+	if c.cond, err = p.parsePipe(); err != nil {
+		return
+	}
+	if !p.isIdent("then") {
+		err = errors.Errorf("expected 'then' at position %d", p.peek().pos)
+		return
+	}
+	p.next()
+	if c.then, err = p.parsePipe(); err != nil {
+		return
+	}
+	switch {
+	case p.isIdent("elif"):
+		p.next()
+		c.otherwise, err = p.parseIf()
+		n = c
+		return
+	case p.isIdent("else"):
+		p.next()
+		if c.otherwise, err = p.parsePipe(); err != nil {
+			return
+		}
+	}
+	if !p.isIdent("end") {
+		err = errors.Errorf("expected 'end' at position %d", p.peek().pos)
+		return
+	}
+	p.next()
+	n = c
+	return
+}
@@ -0,0 +1,586 @@
+// Package patch provides RFC 6902 JSON Patch and RFC 7386 JSON Merge Patch support for generic
+// Go data structures i.e. map[string]interface{} and []interface{} as produced by unmarshalling
+// JSON or YAML.
+//
+// Values exposing their underlying data via an O() method e.g. *n.StringMap are unwrapped
+// automatically and typed Go maps with string keys and Go slices are treated as objects and
+// arrays respectively. Numbers are compared by value such that int(1) == float64(1).
+package patch
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Op is a JSON Patch operation type
+type Op string
+
+const (
+	// Add inserts a value into an object or array
+	Add Op = "add"
+
+	// Remove deletes a value from an object or array
+	Remove Op = "remove"
+
+	// Replace replaces the value at the target location
+	Replace Op = "replace"
+
+	// Move removes the value at the from location and adds it to the target location
+	Move Op = "move"
+
+	// Copy copies the value at the from location to the target location
+	Copy Op = "copy"
+
+	// Test tests that the value at the target location is equal to the given value
+	Test Op = "test"
+)
+
+// Operation is a single RFC 6902 JSON Patch operation
+type Operation struct {
+	Op    Op          // operation type to perform
+	Path  string      // JSON Pointer to the target location
+	From  string      // JSON Pointer to the source location for move and copy
+	Value interface{} // value to add, replace or test
+}
+
+// Patch is an RFC 6902 JSON Patch i.e. an ordered list of operations
+type Patch []Operation
+
+// Parse the given JSON or a []interface{} of operation maps into a Patch
+func Parse(obj interface{}) (patch Patch, err error) {
+	switch x := obj.(type) {
+	case Patch:
+		return x, nil
+	case *Patch:
+		return *x, nil
+	case []Operation:
+		return Patch(x), nil
+	case string:
+		err = json.Unmarshal([]byte(x), &patch)
+	case []byte:
+		err = json.Unmarshal(x, &patch)
+	default:
+		var data []byte
+		if data, err = json.Marshal(normalize(obj)); err != nil {
+			err = errors.Wrapf(err, "failed to marshal patch %T", obj)
+			return
+		}
+		err = json.Unmarshal(data, &patch)
+	}
+	if err != nil {
+		err = errors.Wrap(err, "failed to parse patch")
+	}
+	return
+}
+
+// MarshalJSON implements the json.Marshaler interface only including the fields each
+// operation type requires.
+func (o Operation) MarshalJSON() ([]byte, error) {
+	m := map[string]interface{}{"op": o.Op, "path": o.Path}
+	switch o.Op {
+	case Add, Replace, Test:
+		m["value"] = normalize(o.Value)
+	case Move, Copy:
+		m["from"] = o.From
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface validating the operation
+func (o *Operation) UnmarshalJSON(data []byte) (err error) {
+	var m map[string]json.RawMessage
+	if err = json.Unmarshal(data, &m); err != nil {
+		return
+	}
+	var op string
+	if err = json.Unmarshal(m["op"], &op); err != nil {
+		return errors.Errorf("operation is missing a valid 'op' member")
+	}
+	o.Op = Op(op)
+	if _, ok := m["path"]; !ok {
+		return errors.Errorf("%s operation is missing the 'path' member", op)
+	}
+	if err = json.Unmarshal(m["path"], &o.Path); err != nil {
+		return
+	}
+	switch o.Op {
+	case Add, Replace, Test:
+		raw, ok := m["value"]
+		if !ok {
+			return errors.Errorf("%s operation is missing the 'value' member", op)
+		}
+		err = json.Unmarshal(raw, &o.Value)
+	case Move, Copy:
+		raw, ok := m["from"]
+		if !ok {
+			return errors.Errorf("%s operation is missing the 'from' member", op)
+		}
+		err = json.Unmarshal(raw, &o.From)
+	case Remove:
+	default:
+		err = errors.Errorf("invalid operation %q", op)
+	}
+	return
+}
+
+// JSON returns the patch as a JSON string
+func (p Patch) JSON() string {
+	if len(p) == 0 {
+		return "[]"
+	}
+	data, _ := json.Marshal(p)
+	return string(data)
+}
+
+// String returns the patch as a JSON string, implements the Stringer interface
+func (p Patch) String() string {
+	return p.JSON()
+}
+
+// Apply the patch to a copy of the given document returning the result. The operations are
+// applied in order and the first failure aborts the whole patch leaving the given document
+// untouched.
+func (p Patch) Apply(doc interface{}) (result interface{}, err error) {
+	result = DeepCopy(doc)
+	for i, op := range p {
+		if result, err = op.apply(result); err != nil {
+			err = errors.Wrapf(err, "failed to apply operation %d %s %s", i, op.Op, op.Path)
+			result = nil
+			return
+		}
+	}
+	return
+}
+
+// apply this operation to the given document
+func (o Operation) apply(doc interface{}) (result interface{}, err error) {
+	var path, from []string
+	if path, err = ParsePointer(o.Path); err != nil {
+		return
+	}
+
+	switch o.Op {
+	case Add:
+		return add(doc, path, DeepCopy(o.Value))
+	case Remove:
+		result, _, err = remove(doc, path)
+		return
+	case Replace:
+		if _, err = get(doc, path); err != nil || len(path) == 0 {
+			return DeepCopy(o.Value), err
+		}
+		if result, _, err = remove(doc, path); err != nil {
+			return
+		}
+		return add(result, path, DeepCopy(o.Value))
+	case Move:
+		if from, err = ParsePointer(o.From); err != nil {
+			return
+		}
+		if o.From == o.Path {
+			return doc, nil
+		}
+		if strings.HasPrefix(o.Path, o.From+"/") {
+			err = errors.Errorf("cannot move a value into one of its children")
+			return
+		}
+		var val interface{}
+		if result, val, err = remove(doc, from); err != nil {
+			return
+		}
+		return add(result, path, val)
+	case Copy:
+		if from, err = ParsePointer(o.From); err != nil {
+			return
+		}
+		var val interface{}
+		if val, err = get(doc, from); err != nil {
+			return
+		}
+		return add(doc, path, DeepCopy(val))
+	case Test:
+		var val interface{}
+		if val, err = get(doc, path); err != nil {
+			return
+		}
+		if !Equal(val, o.Value) {
+			err = errors.Errorf("test failed")
+			return
+		}
+		return doc, nil
+	}
+	err = errors.Errorf("invalid operation %q", o.Op)
+	return
+}
+
+// Diff returns a Patch that when applied to a will produce b. Objects are compared key by key
+// in sorted key order and arrays index by index with trailing elements added or removed.
+func Diff(a, b interface{}) (patch Patch) {
+	patch = Patch{}
+	diff(&patch, "", normalize(a), normalize(b))
+	return
+}
+
+func diff(patch *Patch, path string, a, b interface{}) {
+	if Equal(a, b) {
+		return
+	}
+
+	switch x := a.(type) {
+	case map[string]interface{}:
+		if y, ok := b.(map[string]interface{}); ok {
+			for _, k := range sortedKeys(x) {
+				if _, exists := y[k]; !exists {
+					*patch = append(*patch, Operation{Op: Remove, Path: path + "/" + EscapeToken(k)})
+				}
+			}
+			for _, k := range sortedKeys(y) {
+				if _, exists := x[k]; !exists {
+					*patch = append(*patch, Operation{Op: Add, Path: path + "/" + EscapeToken(k), Value: y[k]})
+				} else {
+					diff(patch, path+"/"+EscapeToken(k), normalize(x[k]), normalize(y[k]))
+				}
+			}
+			return
+		}
+	case []interface{}:
+		if y, ok := b.([]interface{}); ok {
+			common := len(x)
+			if len(y) < common {
+				common = len(y)
+			}
+			for i := 0; i < common; i++ {
+				diff(patch, path+"/"+strconv.Itoa(i), normalize(x[i]), normalize(y[i]))
+			}
+			for i := len(x) - 1; i >= common; i-- {
+				*patch = append(*patch, Operation{Op: Remove, Path: path + "/" + strconv.Itoa(i)})
+			}
+			for i := common; i < len(y); i++ {
+				*patch = append(*patch, Operation{Op: Add, Path: path + "/-", Value: y[i]})
+			}
+			return
+		}
+	}
+	*patch = append(*patch, Operation{Op: Replace, Path: path, Value: b})
+}
+
+// MergePatch applies the given RFC 7386 merge patch to a copy of the given document returning
+// the result. Objects in the patch are merged recursively, null values remove the key and
+// any other value including arrays replace the target value.
+func MergePatch(doc, patch interface{}) interface{} {
+	patch = normalize(patch)
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return DeepCopy(patch)
+	}
+	target, ok := normalize(doc).(map[string]interface{})
+	if !ok {
+		target = map[string]interface{}{}
+	} else {
+		target = DeepCopy(target).(map[string]interface{})
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(target, k)
+		} else {
+			target[k] = MergePatch(target[k], v)
+		}
+	}
+	return target
+}
+
+// MergeDiff returns an RFC 7386 merge patch that when applied to a will produce b. Note that
+// merge patches are unable to express setting a value to null.
+func MergeDiff(a, b interface{}) interface{} {
+	x, okx := normalize(a).(map[string]interface{})
+	y, oky := normalize(b).(map[string]interface{})
+	if !okx || !oky {
+		return DeepCopy(b)
+	}
+	result := map[string]interface{}{}
+	for k := range x {
+		if _, exists := y[k]; !exists {
+			result[k] = nil
+		}
+	}
+	for k, v := range y {
+		if xv, exists := x[k]; !exists {
+			result[k] = DeepCopy(v)
+		} else if !Equal(xv, v) {
+			result[k] = MergeDiff(xv, v)
+		}
+	}
+	return result
+}
+
+// Document helpers
+//--------------------------------------------------------------------------------------------------
+
+// ParsePointer splits the given RFC 6901 JSON Pointer into its unescaped reference tokens
+func ParsePointer(pointer string) (tokens []string, err error) {
+	if pointer == "" {
+		return
+	}
+	if pointer[0] != '/' {
+		err = errors.Errorf("invalid JSON pointer %q must start with /", pointer)
+		return
+	}
+	for _, token := range strings.Split(pointer[1:], "/") {
+		tokens = append(tokens, UnescapeToken(token))
+	}
+	return
+}
+
+// EscapeToken escapes the given JSON Pointer reference token
+func EscapeToken(token string) string {
+	return strings.Replace(strings.Replace(token, "~", "~0", -1), "/", "~1", -1)
+}
+
+// UnescapeToken unescapes the given JSON Pointer reference token
+func UnescapeToken(token string) string {
+	return strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+}
+
+// arrayIndex parses the given token as an index into an array of the given length. The end
+// of array token '-' is allowed only when end is true.
+func arrayIndex(token string, l int, end bool) (i int, err error) {
+	if token == "-" && end {
+		return l, nil
+	}
+	if i, err = strconv.Atoi(token); err != nil || i < 0 || (token != "0" && token[0] == '0') {
+		err = errors.Errorf("invalid array index %q", token)
+		return
+	}
+	max := l - 1
+	if end {
+		max = l
+	}
+	if i > max {
+		err = errors.Errorf("array index %d out of bounds", i)
+	}
+	return
+}
+
+// get returns the value at the given location
+func get(doc interface{}, tokens []string) (val interface{}, err error) {
+	val = doc
+	for _, token := range tokens {
+		switch x := val.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if val, ok = x[token]; !ok {
+				err = errors.Errorf("key %q does not exist", token)
+				return
+			}
+		case []interface{}:
+			var i int
+			if i, err = arrayIndex(token, len(x), false); err != nil {
+				return
+			}
+			val = x[i]
+		default:
+			err = errors.Errorf("cannot reference %q in a scalar value", token)
+			return
+		}
+	}
+	return
+}
+
+// add inserts the given value at the given location returning the possibly new document
+func add(doc interface{}, tokens []string, val interface{}) (result interface{}, err error) {
+	if len(tokens) == 0 {
+		return val, nil
+	}
+	token, last := tokens[0], len(tokens) == 1
+	switch x := doc.(type) {
+	case map[string]interface{}:
+		if last {
+			x[token] = val
+			return x, nil
+		}
+		child, ok := x[token]
+		if !ok {
+			err = errors.Errorf("key %q does not exist", token)
+			return
+		}
+		x[token], err = add(child, tokens[1:], val)
+		return x, err
+	case []interface{}:
+		var i int
+		if i, err = arrayIndex(token, len(x), last); err != nil {
+			return
+		}
+		if last {
+			x = append(x, nil)
+			copy(x[i+1:], x[i:])
+			x[i] = val
+			return x, nil
+		}
+		x[i], err = add(x[i], tokens[1:], val)
+		return x, err
+	}
+	err = errors.Errorf("cannot add %q to a scalar value", token)
+	return
+}
+
+// remove deletes the value at the given location returning the possibly new document and
+// the value that was removed
+func remove(doc interface{}, tokens []string) (result, val interface{}, err error) {
+	if len(tokens) == 0 {
+		err = errors.Errorf("cannot remove the document root")
+		return
+	}
+	token, last := tokens[0], len(tokens) == 1
+	switch x := doc.(type) {
+	case map[string]interface{}:
+		child, ok := x[token]
+		if !ok {
+			err = errors.Errorf("key %q does not exist", token)
+			return
+		}
+		if last {
+			delete(x, token)
+			return x, child, nil
+		}
+		x[token], val, err = remove(child, tokens[1:])
+		return x, val, err
+	case []interface{}:
+		var i int
+		if i, err = arrayIndex(token, len(x), false); err != nil {
+			return
+		}
+		if last {
+			val = x[i]
+			return append(x[:i], x[i+1:]...), val, nil
+		}
+		x[i], val, err = remove(x[i], tokens[1:])
+		return x, val, err
+	}
+	err = errors.Errorf("cannot remove %q from a scalar value", token)
+	return
+}
+
+// Value helpers
+//--------------------------------------------------------------------------------------------------
+
+// normalize unwraps values that expose their underlying data via an O() method and converts
+// Go maps with string keys and Go slices into map[string]interface{} and []interface{}.
+func normalize(val interface{}) interface{} {
+	switch x := val.(type) {
+	case nil, bool, string, map[string]interface{}, []interface{}:
+		return x
+	case interface{ O() interface{} }:
+		return normalize(x.O())
+	}
+
+	v := reflect.ValueOf(val)
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		return normalize(v.Elem().Interface())
+	case reflect.Map:
+		if v.Type().Key().Kind() == reflect.String {
+			m := map[string]interface{}{}
+			for _, k := range v.MapKeys() {
+				m[k.String()] = v.MapIndex(k).Interface()
+			}
+			return m
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			s := make([]interface{}, v.Len())
+			for i := range s {
+				s[i] = v.Index(i).Interface()
+			}
+			return s
+		}
+	}
+	return val
+}
+
+// DeepCopy returns a deep copy of the given value with all nested maps and slices converted
+// to map[string]interface{} and []interface{}.
+func DeepCopy(val interface{}) interface{} {
+	switch x := normalize(val).(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(x))
+		for k, v := range x {
+			m[k] = DeepCopy(v)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(x))
+		for i := range x {
+			s[i] = DeepCopy(x[i])
+		}
+		return s
+	default:
+		return x
+	}
+}
+
+// Equal tests if the given values are deeply equal treating all numeric types by value
+func Equal(a, b interface{}) bool {
+	a, b = normalize(a), normalize(b)
+	switch x := a.(type) {
+	case map[string]interface{}:
+		y, ok := b.(map[string]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for k, v := range x {
+			if yv, exists := y[k]; !exists || !Equal(v, yv) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		y, ok := b.([]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !Equal(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	if fa, ok := toNumber(a); ok {
+		fb, ok := toNumber(b)
+		return ok && (fa == fb || math.IsNaN(fa) && math.IsNaN(fb))
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// toNumber returns the float64 value of any Go numeric type
+func toNumber(val interface{}) (float64, bool) {
+	v := reflect.ValueOf(val)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
+
+// sortedKeys returns the keys of the given map in sorted order
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
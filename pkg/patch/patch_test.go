@@ -0,0 +1,275 @@
+package patch
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func doc(t *testing.T, data string) (val interface{}) {
+	assert.Nil(t, json.Unmarshal([]byte(data), &val))
+	return
+}
+
+func apply(t *testing.T, target, ops string) (interface{}, error) {
+	p, err := Parse(ops)
+	assert.Nil(t, err)
+	return p.Apply(doc(t, target))
+}
+
+// Apply
+//--------------------------------------------------------------------------------------------------
+func TestPatch_Apply(t *testing.T) {
+
+	// RFC 6902 Appendix A examples
+	{
+		result, err := apply(t, `{"foo":"bar"}`, `[{"op":"add","path":"/baz","value":"qux"}]`)
+		assert.Nil(t, err)
+		assert.Equal(t, doc(t, `{"baz":"qux","foo":"bar"}`), result)
+	}
+	{
+		result, err := apply(t, `{"foo":["bar","baz"]}`, `[{"op":"add","path":"/foo/1","value":"qux"}]`)
+		assert.Nil(t, err)
+		assert.Equal(t, doc(t, `{"foo":["bar","qux","baz"]}`), result)
+	}
+	{
+		result, err := apply(t, `{"baz":"qux","foo":"bar"}`, `[{"op":"remove","path":"/baz"}]`)
+		assert.Nil(t, err)
+		assert.Equal(t, doc(t, `{"foo":"bar"}`), result)
+	}
+	{
+		result, err := apply(t, `{"foo":["bar","qux","baz"]}`, `[{"op":"remove","path":"/foo/1"}]`)
+		assert.Nil(t, err)
+		assert.Equal(t, doc(t, `{"foo":["bar","baz"]}`), result)
+	}
+	{
+		result, err := apply(t, `{"baz":"qux","foo":"bar"}`, `[{"op":"replace","path":"/baz","value":"boo"}]`)
+		assert.Nil(t, err)
+		assert.Equal(t, doc(t, `{"baz":"boo","foo":"bar"}`), result)
+	}
+	{
+		result, err := apply(t, `{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`,
+			`[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`)
+		assert.Nil(t, err)
+		assert.Equal(t, doc(t, `{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`), result)
+	}
+	{
+		result, err := apply(t, `{"foo":["all","grass","cows","eat"]}`, `[{"op":"move","from":"/foo/1","path":"/foo/3"}]`)
+		assert.Nil(t, err)
+		assert.Equal(t, doc(t, `{"foo":["all","cows","eat","grass"]}`), result)
+	}
+	{
+		result, err := apply(t, `{"baz":"qux","foo":["a",2,"c"]}`,
+			`[{"op":"test","path":"/baz","value":"qux"},{"op":"test","path":"/foo/1","value":2}]`)
+		assert.Nil(t, err)
+		assert.Equal(t, doc(t, `{"baz":"qux","foo":["a",2,"c"]}`), result)
+	}
+	{
+		_, err := apply(t, `{"baz":"qux"}`, `[{"op":"test","path":"/baz","value":"bar"}]`)
+		assert.Equal(t, "failed to apply operation 0 test /baz: test failed", err.Error())
+	}
+	{
+		result, err := apply(t, `{"foo":"bar"}`, `[{"op":"add","path":"/child","value":{"grandchild":{}}}]`)
+		assert.Nil(t, err)
+		assert.Equal(t, doc(t, `{"foo":"bar","child":{"grandchild":{}}}`), result)
+	}
+	{
+		_, err := apply(t, `{"foo":"bar"}`, `[{"op":"add","path":"/baz/bat","value":"qux"}]`)
+		assert.Equal(t, "failed to apply operation 0 add /baz/bat: key \"baz\" does not exist", err.Error())
+	}
+	{
+		result, err := apply(t, `{"/":9,"~1":10}`, `[{"op":"test","path":"/~01","value":10}]`)
+		assert.Nil(t, err)
+		assert.Equal(t, doc(t, `{"/":9,"~1":10}`), result)
+	}
+	{
+		result, err := apply(t, `{"foo":["bar"]}`, `[{"op":"add","path":"/foo/-","value":["abc","def"]}]`)
+		assert.Nil(t, err)
+		assert.Equal(t, doc(t, `{"foo":["bar",["abc","def"]]}`), result)
+	}
+
+	// copy
+	{
+		result, err := apply(t, `{"foo":{"bar":1}}`, `[{"op":"copy","from":"/foo","path":"/baz"}]`)
+		assert.Nil(t, err)
+		assert.Equal(t, doc(t, `{"foo":{"bar":1},"baz":{"bar":1}}`), result)
+	}
+
+	// replace root
+	{
+		result, err := apply(t, `{"foo":1}`, `[{"op":"replace","path":"","value":[1]}]`)
+		assert.Nil(t, err)
+		assert.Equal(t, doc(t, `[1]`), result)
+	}
+
+	// errors leave the original untouched
+	{
+		target := doc(t, `{"foo":[1,2]}`)
+		p, _ := Parse(`[{"op":"remove","path":"/foo/0"},{"op":"remove","path":"/bar"}]`)
+		result, err := p.Apply(target)
+		assert.Nil(t, result)
+		assert.Equal(t, "failed to apply operation 1 remove /bar: key \"bar\" does not exist", err.Error())
+		assert.Equal(t, doc(t, `{"foo":[1,2]}`), target)
+	}
+	{
+		_, err := apply(t, `{"foo":[1,2]}`, `[{"op":"add","path":"/foo/3","value":1}]`)
+		assert.Equal(t, "failed to apply operation 0 add /foo/3: array index 3 out of bounds", err.Error())
+	}
+	{
+		_, err := apply(t, `{"foo":[1,2]}`, `[{"op":"remove","path":"/foo/01"}]`)
+		assert.Equal(t, "failed to apply operation 0 remove /foo/01: invalid array index \"01\"", err.Error())
+	}
+	{
+		_, err := apply(t, `{"foo":{"bar":1}}`, `[{"op":"move","from":"/foo","path":"/foo/bar"}]`)
+		assert.Equal(t, "failed to apply operation 0 move /foo/bar: cannot move a value into one of its children", err.Error())
+	}
+	{
+		_, err := apply(t, `{}`, `[{"op":"add","path":"foo","value":1}]`)
+		assert.Equal(t, "failed to apply operation 0 add foo: invalid JSON pointer \"foo\" must start with /", err.Error())
+	}
+}
+
+// Parse
+//--------------------------------------------------------------------------------------------------
+func TestParse(t *testing.T) {
+
+	// various input types
+	{
+		expected := Patch{{Op: Add, Path: "/a", Value: float64(1)}}
+		p, err := Parse(`[{"op":"add","path":"/a","value":1}]`)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, p)
+
+		p, err = Parse([]byte(`[{"op":"add","path":"/a","value":1}]`))
+		assert.Nil(t, err)
+		assert.Equal(t, expected, p)
+
+		p, err = Parse([]interface{}{map[string]interface{}{"op": "add", "path": "/a", "value": 1}})
+		assert.Nil(t, err)
+		assert.Equal(t, expected, p)
+
+		p, err = Parse(expected)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, p)
+	}
+
+	// null values are retained
+	{
+		p, err := Parse(`[{"op":"add","path":"/a","value":null}]`)
+		assert.Nil(t, err)
+		assert.Equal(t, Patch{{Op: Add, Path: "/a"}}, p)
+		assert.Equal(t, `[{"op":"add","path":"/a","value":null}]`, p.String())
+	}
+
+	// invalid operations
+	{
+		_, err := Parse(`[{"op":"bogus","path":"/a"}]`)
+		assert.Equal(t, "failed to parse patch: invalid operation \"bogus\"", err.Error())
+
+		_, err = Parse(`[{"op":"add","path":"/a"}]`)
+		assert.Equal(t, "failed to parse patch: add operation is missing the 'value' member", err.Error())
+
+		_, err = Parse(`[{"op":"move","path":"/a"}]`)
+		assert.Equal(t, "failed to parse patch: move operation is missing the 'from' member", err.Error())
+
+		_, err = Parse(`[{"op":"remove"}]`)
+		assert.Equal(t, "failed to parse patch: remove operation is missing the 'path' member", err.Error())
+	}
+}
+
+// Diff
+//--------------------------------------------------------------------------------------------------
+func TestDiff(t *testing.T) {
+
+	// no changes
+	{
+		p := Diff(doc(t, `{"a":1,"b":[1,2]}`), map[string]interface{}{"a": 1, "b": []int{1, 2}})
+		assert.Equal(t, "[]", p.JSON())
+	}
+
+	// object changes
+	{
+		a := doc(t, `{"a":1,"b":{"c":"d","e":"f"},"x~/y":1}`)
+		b := doc(t, `{"a":2,"b":{"c":"d","g":"h"},"n":null}`)
+		p := Diff(a, b)
+		assert.Equal(t, `[{"op":"remove","path":"/x~0~1y"},{"op":"replace","path":"/a","value":2},`+
+			`{"op":"remove","path":"/b/e"},{"op":"add","path":"/b/g","value":"h"},{"op":"add","path":"/n","value":null}]`, p.JSON())
+		result, err := p.Apply(a)
+		assert.Nil(t, err)
+		assert.Equal(t, b, result)
+	}
+
+	// array changes
+	{
+		a := doc(t, `{"a":[1,2,3,4]}`)
+		b := doc(t, `{"a":[1,5]}`)
+		p := Diff(a, b)
+		assert.Equal(t, `[{"op":"replace","path":"/a/1","value":5},{"op":"remove","path":"/a/3"},{"op":"remove","path":"/a/2"}]`, p.JSON())
+		result, err := p.Apply(a)
+		assert.Nil(t, err)
+		assert.Equal(t, b, result)
+
+		p = Diff(b, a)
+		result, err = p.Apply(b)
+		assert.Nil(t, err)
+		assert.Equal(t, a, result)
+	}
+
+	// type changes
+	{
+		p := Diff(doc(t, `{"a":[1]}`), doc(t, `{"a":{"b":1}}`))
+		assert.Equal(t, `[{"op":"replace","path":"/a","value":{"b":1}}]`, p.JSON())
+	}
+}
+
+// MergePatch
+//--------------------------------------------------------------------------------------------------
+func TestMergePatch(t *testing.T) {
+
+	// RFC 7386 Appendix A examples
+	tests := [][3]string{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"a":"foo"}`, `"bar"`, `"bar"`},
+		{`{"e":null}`, `{"a":1}`, `{"e":null,"a":1}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+	for _, test := range tests {
+		target := doc(t, test[0])
+		assert.Equal(t, doc(t, test[2]), MergePatch(target, doc(t, test[1])), test[1])
+		assert.Equal(t, doc(t, test[0]), target)
+	}
+}
+
+// MergeDiff
+//--------------------------------------------------------------------------------------------------
+func TestMergeDiff(t *testing.T) {
+	a := doc(t, `{"a":1,"b":{"c":"d","e":"f"},"g":[1,2]}`)
+	b := doc(t, `{"a":1,"b":{"c":"x"},"g":[1],"h":true}`)
+	p := MergeDiff(a, b)
+	assert.Equal(t, doc(t, `{"b":{"c":"x","e":null},"g":[1],"h":true}`), p)
+	assert.Equal(t, b, MergePatch(a, p))
+}
+
+// Equal
+//--------------------------------------------------------------------------------------------------
+func TestEqual(t *testing.T) {
+	assert.True(t, Equal(1, float64(1)))
+	assert.True(t, Equal(map[string]int{"a": 1}, map[string]interface{}{"a": 1.0}))
+	assert.True(t, Equal([]string{"a"}, []interface{}{"a"}))
+	assert.False(t, Equal([]string{"a"}, []interface{}{"a", "b"}))
+	assert.False(t, Equal("1", 1))
+	assert.False(t, Equal(nil, map[string]interface{}{}))
+	assert.True(t, Equal(nil, nil))
+}
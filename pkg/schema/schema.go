@@ -0,0 +1,465 @@
+// Package schema provides JSON Schema validation for generic Go data structures i.e.
+// map[string]interface{} and []interface{} as produced by unmarshalling JSON or YAML.
+//
+// A subset of draft-07 is supported: type, enum, const, required, properties,
+// additionalProperties, minProperties, maxProperties, items, minItems, maxItems, pattern,
+// minLength, maxLength, minimum, maximum, exclusiveMinimum, exclusiveMaximum and $ref to
+// locations within the same schema document e.g. #/definitions/foo. Boolean schemas are
+// supported as well. Unknown keywords are ignored.
+//
+// Validation errors are reported with jq style paths matching the n Query selector syntax
+// e.g. .servers.[0].port
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/phR0ze/n/pkg/enc/yaml"
+	"github.com/phR0ze/n/pkg/patch"
+	"github.com/pkg/errors"
+)
+
+// Schema is a compiled JSON Schema that may be used to validate many documents
+type Schema struct {
+	root     interface{}               // schema document
+	mutex    sync.Mutex                // guards patterns for concurrent validation
+	patterns map[string]*regexp.Regexp // compiled pattern keywords
+}
+
+// Error is a single validation error
+type Error struct {
+	Path    string // jq style path to the invalid value e.g. .foo.[0]
+	Message string // description of the validation failure
+}
+
+// Error returns the path and message, implements the error interface
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// Errors is a list of validation errors that is itself an error
+type Errors []*Error
+
+// Error returns all validation errors one per line, implements the error interface
+func (e Errors) Error() string {
+	msgs := make([]string, len(e))
+	for i := range e {
+		msgs[i] = e[i].Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Compile the given schema into a reusable *Schema. The schema may be given as a JSON or YAML
+// string or []byte, a map[string]interface{} or any value exposing its data via an O() method.
+func Compile(obj interface{}) (s *Schema, err error) {
+	s = &Schema{patterns: map[string]*regexp.Regexp{}}
+
+	switch x := obj.(type) {
+	case string:
+		err = unmarshal([]byte(x), &s.root)
+	case []byte:
+		err = unmarshal(x, &s.root)
+	default:
+		s.root = patch.DeepCopy(obj)
+	}
+	if err == nil {
+		err = s.compile(s.root, "#")
+	}
+	if err != nil {
+		err = errors.Wrap(err, "failed to compile schema")
+		s = nil
+	}
+	return
+}
+
+// Validate compiles the given schema and validates the given document against it. Returns a
+// compile error if the schema is invalid else the list of validation errors if any.
+func Validate(schema, doc interface{}) (errs Errors, err error) {
+	var s *Schema
+	if s, err = Compile(schema); err != nil {
+		return
+	}
+	errs = s.Validate(doc)
+	return
+}
+
+// Validate the given document against this schema returning all validation errors found in
+// document order or nil if the document is valid.
+func (s *Schema) Validate(doc interface{}) (errs Errors) {
+	if s == nil {
+		return
+	}
+	s.validate(&errs, s.root, patch.DeepCopy(doc), ".", 0)
+	return
+}
+
+// compile checks the schema for structural issues, resolves references and compiles patterns
+func (s *Schema) compile(schema interface{}, loc string) (err error) {
+	switch x := schema.(type) {
+	case bool:
+		return
+	case map[string]interface{}:
+		if ref, ok := x["$ref"]; ok {
+			if _, err = s.resolve(ref); err != nil {
+				return errors.Wrapf(err, "invalid $ref at %s", loc)
+			}
+		}
+		if pattern, ok := x["pattern"]; ok {
+			str, ok := pattern.(string)
+			if !ok {
+				return errors.Errorf("pattern at %s must be a string", loc)
+			}
+			if _, err = s.pattern(str); err != nil {
+				return errors.Wrapf(err, "invalid pattern at %s", loc)
+			}
+		}
+		if typ, ok := x["type"]; ok {
+			for _, t := range types(typ) {
+				switch t {
+				case "null", "boolean", "object", "array", "number", "integer", "string":
+				default:
+					return errors.Errorf("invalid type %v at %s", typ, loc)
+				}
+			}
+		}
+		if required, ok := x["required"]; ok {
+			if _, ok := required.([]interface{}); !ok {
+				return errors.Errorf("required at %s must be an array", loc)
+			}
+		}
+		for _, key := range []string{"properties", "definitions", "$defs"} {
+			if props, ok := x[key]; ok {
+				m, ok := props.(map[string]interface{})
+				if !ok {
+					return errors.Errorf("%s at %s must be an object", key, loc)
+				}
+				for k, v := range m {
+					if err = s.compile(v, loc+"/"+key+"/"+patch.EscapeToken(k)); err != nil {
+						return
+					}
+				}
+			}
+		}
+		if items, ok := x["items"]; ok {
+			if list, ok := items.([]interface{}); ok {
+				for i := range list {
+					if err = s.compile(list[i], fmt.Sprintf("%s/items/%d", loc, i)); err != nil {
+						return
+					}
+				}
+			} else if err = s.compile(items, loc+"/items"); err != nil {
+				return
+			}
+		}
+		if additional, ok := x["additionalProperties"]; ok {
+			if err = s.compile(additional, loc+"/additionalProperties"); err != nil {
+				return
+			}
+		}
+		return
+	}
+	return errors.Errorf("schema at %s must be an object or boolean", loc)
+}
+
+// pattern returns the compiled regular expression for the given pattern keyword compiling and
+// caching it on first use as patterns may be reached via $ref from anywhere in the document.
+func (s *Schema) pattern(str string) (exp *regexp.Regexp, err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if exp = s.patterns[str]; exp == nil {
+		if exp, err = regexp.Compile(str); err != nil {
+			return
+		}
+		s.patterns[str] = exp
+	}
+	return
+}
+
+// resolve the given $ref within the schema document
+func (s *Schema) resolve(ref interface{}) (schema interface{}, err error) {
+	str, ok := ref.(string)
+	if !ok || !strings.HasPrefix(str, "#") {
+		err = errors.Errorf("only references within the schema document are supported, %v", ref)
+		return
+	}
+	var tokens []string
+	if tokens, err = patch.ParsePointer(str[1:]); err != nil {
+		return
+	}
+	schema = s.root
+	for _, token := range tokens {
+		m, ok := schema.(map[string]interface{})
+		if !ok {
+			err = errors.Errorf("failed to resolve %s", str)
+			return
+		}
+		if schema, ok = m[token]; !ok {
+			err = errors.Errorf("failed to resolve %s", str)
+			return
+		}
+	}
+	return
+}
+
+// validate the given value against the given schema appending any errors found
+func (s *Schema) validate(errs *Errors, schema, val interface{}, path string, depth int) {
+	fail := func(path, format string, args ...interface{}) {
+		*errs = append(*errs, &Error{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	var x map[string]interface{}
+	switch y := schema.(type) {
+	case bool:
+		if !y {
+			fail(path, "value is not allowed")
+		}
+		return
+	case map[string]interface{}:
+		x = y
+	default:
+		return
+	}
+
+	// $ref overrides all sibling keywords in draft-07
+	if ref, ok := x["$ref"]; ok {
+		if depth > 100 {
+			fail(path, "maximum $ref depth exceeded")
+			return
+		}
+		if target, err := s.resolve(ref); err == nil {
+			s.validate(errs, target, val, path, depth+1)
+		}
+		return
+	}
+
+	// Generic keywords
+	if typ, ok := x["type"]; ok {
+		expected := types(typ)
+		actual := typeOf(val)
+		match := false
+		for _, t := range expected {
+			if t == actual || (t == "number" && actual == "integer") {
+				match = true
+			}
+		}
+		if !match {
+			fail(path, "expected type %s but got %s", strings.Join(expected, " or "), actual)
+			return
+		}
+	}
+	if enum, ok := x["enum"].([]interface{}); ok {
+		match := false
+		for _, v := range enum {
+			if patch.Equal(v, val) {
+				match = true
+				break
+			}
+		}
+		if !match {
+			fail(path, "value %s is not one of %s", jsonString(val), jsonString(enum))
+		}
+	}
+	if c, ok := x["const"]; ok && !patch.Equal(c, val) {
+		fail(path, "value %s does not equal %s", jsonString(val), jsonString(c))
+	}
+
+	switch v := val.(type) {
+
+	// Object keywords
+	case map[string]interface{}:
+		if required, ok := x["required"].([]interface{}); ok {
+			for _, r := range required {
+				if _, exists := v[fmt.Sprint(r)]; !exists {
+					fail(path, "missing required property %q", fmt.Sprint(r))
+				}
+			}
+		}
+		if min, ok := number(x["minProperties"]); ok && float64(len(v)) < min {
+			fail(path, "expected at least %v properties but got %d", min, len(v))
+		}
+		if max, ok := number(x["maxProperties"]); ok && float64(len(v)) > max {
+			fail(path, "expected at most %v properties but got %d", max, len(v))
+		}
+		props, _ := x["properties"].(map[string]interface{})
+		additional, hasAdditional := x["additionalProperties"]
+		for _, k := range sortedKeys(v) {
+			if prop, ok := props[k]; ok {
+				s.validate(errs, prop, v[k], childKey(path, k), depth)
+			} else if hasAdditional {
+				if allowed, ok := additional.(bool); ok && !allowed {
+					fail(childKey(path, k), "additional property is not allowed")
+				} else {
+					s.validate(errs, additional, v[k], childKey(path, k), depth)
+				}
+			}
+		}
+
+	// Array keywords
+	case []interface{}:
+		if min, ok := number(x["minItems"]); ok && float64(len(v)) < min {
+			fail(path, "expected at least %v items but got %d", min, len(v))
+		}
+		if max, ok := number(x["maxItems"]); ok && float64(len(v)) > max {
+			fail(path, "expected at most %v items but got %d", max, len(v))
+		}
+		if items, ok := x["items"]; ok {
+			if list, ok := items.([]interface{}); ok {
+				for i := 0; i < len(list) && i < len(v); i++ {
+					s.validate(errs, list[i], v[i], childIndex(path, i), depth)
+				}
+			} else {
+				for i := range v {
+					s.validate(errs, items, v[i], childIndex(path, i), depth)
+				}
+			}
+		}
+
+	// String keywords
+	case string:
+		l := utf8.RuneCountInString(v)
+		if min, ok := number(x["minLength"]); ok && float64(l) < min {
+			fail(path, "expected length at least %v but got %d", min, l)
+		}
+		if max, ok := number(x["maxLength"]); ok && float64(l) > max {
+			fail(path, "expected length at most %v but got %d", max, l)
+		}
+		if pattern, ok := x["pattern"].(string); ok {
+			if exp, err := s.pattern(pattern); err != nil {
+				fail(path, "invalid pattern %q: %v", pattern, err)
+			} else if !exp.MatchString(v) {
+				fail(path, "value %q does not match pattern %q", v, pattern)
+			}
+		}
+
+	// Numeric keywords
+	default:
+		n, ok := number(v)
+		if !ok {
+			break
+		}
+		if min, ok := number(x["minimum"]); ok && n < min {
+			fail(path, "expected value at least %v but got %v", min, n)
+		}
+		if max, ok := number(x["maximum"]); ok && n > max {
+			fail(path, "expected value at most %v but got %v", max, n)
+		}
+		if min, ok := number(x["exclusiveMinimum"]); ok && n <= min {
+			fail(path, "expected value greater than %v but got %v", min, n)
+		}
+		if max, ok := number(x["exclusiveMaximum"]); ok && n >= max {
+			fail(path, "expected value less than %v but got %v", max, n)
+		}
+	}
+}
+
+// Helpers
+//--------------------------------------------------------------------------------------------------
+
+// identExp matches keys that can be used in a path without quoting
+var identExp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+// childKey returns the path to the given key of the object at path
+func childKey(path, key string) string {
+	if path == "." {
+		path = ""
+	}
+	if identExp.MatchString(key) {
+		return path + "." + key
+	}
+	return fmt.Sprintf("%s.%q", path, key)
+}
+
+// childIndex returns the path to the given index of the array at path
+func childIndex(path string, i int) string {
+	if path == "." {
+		path = ""
+	}
+	return fmt.Sprintf("%s.[%d]", path, i)
+}
+
+// types returns the type keyword as a list of type names
+func types(typ interface{}) (names []string) {
+	switch x := typ.(type) {
+	case string:
+		names = append(names, x)
+	case []interface{}:
+		for _, t := range x {
+			names = append(names, fmt.Sprint(t))
+		}
+	default:
+		names = append(names, fmt.Sprint(x))
+	}
+	return
+}
+
+// typeOf returns the JSON Schema type name of the given value
+func typeOf(val interface{}) string {
+	switch val.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	}
+	if n, ok := number(val); ok {
+		if n == math.Trunc(n) && !math.IsInf(n, 0) {
+			return "integer"
+		}
+		return "number"
+	}
+	return reflect.TypeOf(val).String()
+}
+
+// number returns the float64 value of any Go numeric type
+func number(val interface{}) (float64, bool) {
+	v := reflect.ValueOf(val)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
+
+// jsonString returns the given value as a JSON string for error messages
+func jsonString(val interface{}) string {
+	data, err := json.Marshal(val)
+	if err != nil {
+		return fmt.Sprint(val)
+	}
+	return string(data)
+}
+
+// sortedKeys returns the keys of the given map in sorted order
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// unmarshal the given JSON or YAML data
+func unmarshal(data []byte, obj *interface{}) (err error) {
+	if err = yaml.Unmarshal(data, obj); err != nil {
+		err = errors.Wrap(err, "failed to unmarshal schema")
+	}
+	return
+}
@@ -0,0 +1,245 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testSchema = `
+$schema: http://json-schema.org/draft-07/schema#
+type: object
+required: [name, servers]
+additionalProperties: false
+properties:
+  name:
+    type: string
+    minLength: 1
+    maxLength: 8
+  version:
+    type: [string, integer]
+    pattern: ^v\d+$
+  log level:
+    enum: [debug, info, error]
+  servers:
+    type: array
+    minItems: 1
+    maxItems: 2
+    items:
+      $ref: '#/definitions/server'
+definitions:
+  server:
+    type: object
+    required: [host]
+    properties:
+      host:
+        type: string
+      port:
+        type: integer
+        minimum: 1
+        exclusiveMaximum: 65536
+    additionalProperties:
+      type: boolean
+`
+
+func messages(errs Errors) (msgs []string) {
+	for _, err := range errs {
+		msgs = append(msgs, err.Error())
+	}
+	return
+}
+
+// Compile
+//--------------------------------------------------------------------------------------------------
+func TestCompile(t *testing.T) {
+
+	// valid schemas
+	{
+		s, err := Compile(testSchema)
+		assert.Nil(t, err)
+		assert.NotNil(t, s)
+
+		s, err = Compile([]byte(`{"type": "string"}`))
+		assert.Nil(t, err)
+		assert.NotNil(t, s)
+
+		s, err = Compile(map[string]interface{}{"type": "string"})
+		assert.Nil(t, err)
+		assert.NotNil(t, s)
+
+		s, err = Compile(true)
+		assert.Nil(t, err)
+		assert.NotNil(t, s)
+	}
+
+	// invalid schemas
+	{
+		_, err := Compile(`{"type": "str"}`)
+		assert.Equal(t, "failed to compile schema: invalid type str at #", err.Error())
+
+		_, err = Compile(`{"properties": {"a": {"pattern": "(" }}}`)
+		assert.Equal(t, "failed to compile schema: invalid pattern at #/properties/a: error parsing regexp: missing closing ): `(`", err.Error())
+
+		_, err = Compile(`{"$defs": {"a": {"pattern": "(" }}}`)
+		assert.Equal(t, "failed to compile schema: invalid pattern at #/$defs/a: error parsing regexp: missing closing ): `(`", err.Error())
+
+		_, err = Compile(`{"items": {"$ref": "#/definitions/missing"}}`)
+		assert.Equal(t, "failed to compile schema: invalid $ref at #/items: failed to resolve #/definitions/missing", err.Error())
+
+		_, err = Compile(`{"$ref": "http://example.com/schema"}`)
+		assert.Equal(t, "failed to compile schema: invalid $ref at #: only references within the schema document are supported, http://example.com/schema", err.Error())
+
+		_, err = Compile(`{"required": "a"}`)
+		assert.Equal(t, "failed to compile schema: required at # must be an array", err.Error())
+
+		_, err = Compile(`[1]`)
+		assert.Equal(t, "failed to compile schema: schema at # must be an object or boolean", err.Error())
+
+		_, err = Compile(`{"a": [}`)
+		assert.NotNil(t, err)
+	}
+}
+
+// Validate
+//--------------------------------------------------------------------------------------------------
+func TestSchema_Validate(t *testing.T) {
+	s, err := Compile(testSchema)
+	assert.Nil(t, err)
+
+	// nil
+	{
+		assert.Nil(t, (*Schema)(nil).Validate(map[string]interface{}{}))
+	}
+
+	// valid
+	{
+		errs := s.Validate(map[string]interface{}{
+			"name":      "foo",
+			"version":   "v1",
+			"log level": "info",
+			"servers": []interface{}{
+				map[string]interface{}{"host": "localhost", "port": 80, "tls": true},
+				map[string]interface{}{"host": "example.com"},
+			},
+		})
+		assert.Nil(t, errs)
+	}
+
+	// integer type accepts integral floats as produced by JSON unmarshalling
+	{
+		errs := s.Validate(map[string]interface{}{"name": "foo", "version": float64(1),
+			"servers": []interface{}{map[string]interface{}{"host": "a", "port": float64(80)}}})
+		assert.Nil(t, errs)
+	}
+
+	// invalid
+	{
+		errs := s.Validate(map[string]interface{}{
+			"name":      "",
+			"version":   1.5,
+			"log level": "trace",
+			"extra":     1,
+			"servers": []interface{}{
+				map[string]interface{}{"port": 0, "tls": "yes"},
+				map[string]interface{}{"host": "example.com", "port": 65536},
+				"bogus",
+			},
+		})
+		assert.Equal(t, []string{
+			".extra: additional property is not allowed",
+			`."log level": value "trace" is not one of ["debug","info","error"]`,
+			".name: expected length at least 1 but got 0",
+			".servers: expected at most 2 items but got 3",
+			`.servers.[0]: missing required property "host"`,
+			".servers.[0].port: expected value at least 1 but got 0",
+			".servers.[0].tls: expected type boolean but got string",
+			".servers.[1].port: expected value less than 65536 but got 65536",
+			".servers.[2]: expected type object but got string",
+			".version: expected type string or integer but got number",
+		}, messages(errs))
+	}
+
+	// missing required properties are reported on the parent
+	{
+		errs := s.Validate(map[string]interface{}{"version": "1"})
+		assert.Equal(t, []string{
+			`.: missing required property "name"`,
+			`.: missing required property "servers"`,
+			`.version: value "1" does not match pattern "^v\\d+$"`,
+		}, messages(errs))
+		assert.Equal(t, ".: missing required property \"name\"\n.: missing required property \"servers\"\n"+
+			".version: value \"1\" does not match pattern \"^v\\\\d+$\"", errs.Error())
+	}
+
+	// root type
+	{
+		errs := s.Validate([]interface{}{1})
+		assert.Equal(t, []string{".: expected type object but got array"}, messages(errs))
+	}
+}
+
+func TestValidate(t *testing.T) {
+
+	// tuple items, const and boolean schemas
+	{
+		schema := `{"items": [{"const": 1}, false, true], "maxLength": 1}`
+		errs, err := Validate(schema, []interface{}{2, "a", "b"})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{".[0]: value 2 does not equal 1", ".[1]: value is not allowed"}, messages(errs))
+	}
+
+	// recursive references
+	{
+		schema := `{"definitions": {"node": {"type": "object", "properties": {"children": {"type": "array", "items": {"$ref": "#/definitions/node"}}, "name": {"type": "string"}}}}, "$ref": "#/definitions/node"}`
+		doc := map[string]interface{}{"name": "a", "children": []interface{}{
+			map[string]interface{}{"name": "b", "children": []interface{}{map[string]interface{}{"name": 1}}},
+		}}
+		errs, err := Validate(schema, doc)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{".children.[0].children.[0].name: expected type string but got integer"}, messages(errs))
+	}
+
+	// patterns reached via $ref into $defs
+	{
+		errs, err := Validate(`{"$ref": "#/$defs/x", "$defs": {"x": {"type": "string", "pattern": "^a"}}}`, "b")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{`.: value "b" does not match pattern "^a"`}, messages(errs))
+
+		errs, err = Validate(`{"$ref": "#/$defs/x", "$defs": {"x": {"type": "string", "pattern": "^a"}}}`, "a")
+		assert.Nil(t, err)
+		assert.Nil(t, errs)
+	}
+
+	// patterns reached via $ref outside the known keywords are compiled on use
+	{
+		errs, err := Validate(`{"$ref": "#/other/x", "other": {"x": {"pattern": "^a"}}}`, "b")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{`.: value "b" does not match pattern "^a"`}, messages(errs))
+
+		errs, err = Validate(`{"$ref": "#/other/x", "other": {"x": {"pattern": "("}}}`, "b")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{".: invalid pattern \"(\": error parsing regexp: missing closing ): `(`"}, messages(errs))
+	}
+
+	// reference cycles are detected
+	{
+		errs, err := Validate(`{"definitions": {"a": {"$ref": "#/definitions/a"}}, "$ref": "#/definitions/a"}`, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{".: maximum $ref depth exceeded"}, messages(errs))
+	}
+
+	// min/max properties and numbers
+	{
+		errs, err := Validate(`{"minProperties": 2, "additionalProperties": {"maximum": 5, "exclusiveMinimum": 0}}`,
+			map[string]interface{}{"a": 0})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{".: expected at least 2 properties but got 1", ".a: expected value greater than 0 but got 0"}, messages(errs))
+	}
+
+	// compile errors
+	{
+		errs, err := Validate(`{"type": 1}`, 1)
+		assert.Nil(t, errs)
+		assert.Equal(t, "failed to compile schema: invalid type 1 at #", err.Error())
+	}
+}
@@ -340,6 +340,31 @@ func CopyFile(src, dst string, opts ...*opt.Opt) (result string, err error) {
 	return
 }
 
+// EachLine calls the given lambda once for each line read from the given reader without loading
+// the full contents into memory. Lines may be of any length and have their trailing \n or \r\n
+// removed. Reading stops with the lambda's error if one is returned.
+func EachLine(reader io.Reader, action func(line string) error) (err error) {
+	r := bufio.NewReader(reader)
+	for {
+		var line string
+		line, err = r.ReadString('\n')
+		if err != nil && err != io.EOF {
+			err = errors.Wrap(err, "failed reading lines")
+			return
+		}
+
+		// Final line without a trailing new line is still a line
+		eof := err == io.EOF
+		if err = nil; eof && line == "" {
+			return
+		}
+		line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+		if err = action(line); err != nil || eof {
+			return
+		}
+	}
+}
+
 // Exists return true if the given path exists
 func Exists(src string) bool {
 	if target, err := Abs(src); err == nil {
@@ -465,10 +490,10 @@ func ReadLines(filepath string) (result []string, err error) {
 
 // ReadLinesP returns a new slice of string representing lines
 func ReadLinesP(reader io.Reader) (result []string) {
-	scanner := bufio.NewScanner(reader)
-	for scanner.Scan() {
-		result = append(result, scanner.Text())
-	}
+	EachLine(reader, func(line string) error {
+		result = append(result, line)
+		return nil
+	})
 	return
 }
 
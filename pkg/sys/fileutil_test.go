@@ -823,6 +823,75 @@ func TestCopyFile(t *testing.T) {
 	}
 }
 
+func TestEachLine(t *testing.T) {
+
+	// empty string
+	{
+		var lines []string
+		assert.Nil(t, EachLine(strings.NewReader(""), func(line string) error {
+			lines = append(lines, line)
+			return nil
+		}))
+		assert.Equal(t, ([]string)(nil), lines)
+	}
+
+	// stop on lambda error
+	{
+		var lines []string
+		err := EachLine(strings.NewReader("1\n2\n3\n"), func(line string) error {
+			if line == "2" {
+				return fmt.Errorf("stop")
+			}
+			lines = append(lines, line)
+			return nil
+		})
+		assert.Equal(t, "stop", err.Error())
+		assert.Equal(t, []string{"1"}, lines)
+	}
+
+	// reader failure
+	{
+		var lines []string
+		err := EachLine(iotest.TimeoutReader(strings.NewReader("1\n2")), func(line string) error {
+			lines = append(lines, line)
+			return nil
+		})
+		assert.Equal(t, "failed reading lines: timeout", err.Error())
+		assert.Equal(t, []string{"1"}, lines)
+	}
+
+	// lines longer than the default bufio.Scanner limit
+	{
+		var lines []string
+		long := strings.Repeat("a", 1024*1024)
+		assert.Nil(t, EachLine(strings.NewReader(long+"\n2\n"+long), func(line string) error {
+			lines = append(lines, line)
+			return nil
+		}))
+		assert.Equal(t, []string{long, "2", long}, lines)
+	}
+
+	// carriage returns are trimmed
+	{
+		var lines []string
+		assert.Nil(t, EachLine(strings.NewReader("1\r\n2\r\n"), func(line string) error {
+			lines = append(lines, line)
+			return nil
+		}))
+		assert.Equal(t, []string{"1", "2"}, lines)
+	}
+
+	// happy
+	{
+		var lines []string
+		assert.Nil(t, EachLine(strings.NewReader("1\n2\n3"), func(line string) error {
+			lines = append(lines, line)
+			return nil
+		}))
+		assert.Equal(t, []string{"1", "2", "3"}, lines)
+	}
+}
+
 func TestExists(t *testing.T) {
 	resetTest()
 
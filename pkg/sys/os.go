@@ -74,12 +74,19 @@ func ExecExists(target string) (ok bool) {
 	return
 }
 
-// ExecOut executes the given command and returns the output as a string.
+// ExecOut executes the given command and returns the output as a string. The command is split
+// into args with Shlex so quoted args containing spaces are passed through intact, however no
+// shell is involved so expansions, redirects and pipes are not supported. Use ShellQuote to
+// safely format args into the command.
 func ExecOut(str string, a ...interface{}) (out string, err error) {
 
 	// Parse command
 	cmd := fmt.Sprintf(str, a...)
-	pieces := SplitCmd(cmd)
+	var pieces []string
+	if pieces, err = Shlex(cmd); err != nil {
+		err = errors.Wrap(err, "failed to parse system command")
+		return
+	}
 	if len(pieces) == 0 {
 		err = errors.Errorf("invalid empty command")
 		return
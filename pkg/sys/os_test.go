@@ -46,6 +46,24 @@ func TestExecOut(t *testing.T) {
 		expected := "agent\nmech\nnet.go\nnet_test.go\n"
 		assert.Equal(t, expected, result)
 	}
+
+	// Quoted args with spaces are passed intact
+	{
+		result, err := ExecOut(`echo 'a  b' "c  d" e\ \ f`)
+		assert.Nil(t, err)
+		assert.Equal(t, "a  b c  d e  f\n", result)
+
+		result, err = ExecOut("echo %s", ShellQuote("it's  here"))
+		assert.Nil(t, err)
+		assert.Equal(t, "it's  here\n", result)
+	}
+
+	// Invalid quotes
+	{
+		result, err := ExecOut(`echo 'foo`)
+		assert.Equal(t, "", result)
+		assert.Equal(t, "failed to parse system command: unterminated single quote in command: echo 'foo", err.Error())
+	}
 }
 
 func TestExecPath(t *testing.T) {
@@ -110,6 +128,24 @@ func TestShell(t *testing.T) {
 		expected := "agent\nmech\nnet.go\nnet_test.go\n"
 		assert.Equal(t, expected, result)
 	}
+
+	// Quoted args with spaces are passed intact
+	{
+		result, err := ExecOut(`echo 'a  b' "c  d" e\ \ f`)
+		assert.Nil(t, err)
+		assert.Equal(t, "a  b c  d e  f\n", result)
+
+		result, err = ExecOut("echo %s", ShellQuote("it's  here"))
+		assert.Nil(t, err)
+		assert.Equal(t, "it's  here\n", result)
+	}
+
+	// Invalid quotes
+	{
+		result, err := ExecOut(`echo 'foo`)
+		assert.Equal(t, "", result)
+		assert.Equal(t, "failed to parse system command: unterminated single quote in command: echo 'foo", err.Error())
+	}
 }
 
 func resetTest() {
@@ -2,12 +2,120 @@ package sys
 
 import (
 	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	gRXSplitCmd   = regexp.MustCompile(`'.+'|".+"|\S+`)
+	gRXShellQuote = regexp.MustCompile(`[^\w@%+=:,./-]`)
 )
 
-var gRXSplitCmd = regexp.MustCompile(`'.+'|".+"|\S+`)
+// ShellJoin quotes each of the given args as needed with ShellQuote and joins them with spaces
+// into a single command line that Shlex will split back into the same args.
+func ShellJoin(args ...string) string {
+	quoted := make([]string, len(args))
+	for i := range args {
+		quoted[i] = ShellQuote(args[i])
+	}
+	return strings.Join(quoted, " ")
+}
+
+// ShellQuote quotes the given arg so that a POSIX shell will treat it as a single word. Args
+// made up of only safe characters are returned as is, otherwise the arg is wrapped in single
+// quotes with any single quotes in it escaped as '"'"'.
+func ShellQuote(arg string) string {
+	if arg == "" {
+		return "''"
+	}
+	if !gRXShellQuote.MatchString(arg) {
+		return arg
+	}
+	return "'" + strings.Replace(arg, "'", `'"'"'`, -1) + "'"
+}
+
+// Shlex splits the given cmd into args using POSIX shell word splitting rules without any
+// expansions. Args are separated by unquoted spaces, tabs or newlines. Single quotes preserve
+// everything up to the next single quote, double quotes preserve everything except for the
+// backslash escapes \$, \`, \", \\ and line continuations, and an unquoted backslash escapes
+// the next character. A # at the start of an arg comments out the rest of the line. Quotes are
+// removed such that empty quotes result in an empty arg. Unterminated quotes or a trailing
+// backslash return an error.
+func Shlex(cmd string) (args []string, err error) {
+	args = []string{}
+	runes := []rune(cmd)
+
+	var word []rune
+	inWord := false
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+
+		// Word separators
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				args = append(args, string(word))
+				word, inWord = nil, false
+			}
+
+		// Comments run to the end of the line
+		case r == '#' && !inWord:
+			for i+1 < len(runes) && runes[i+1] != '\n' {
+				i++
+			}
+
+		// Escape the next character or continue the line
+		case r == '\\':
+			if i++; i >= len(runes) {
+				err = errors.Errorf("trailing backslash in command: %s", cmd)
+				return
+			}
+			if runes[i] != '\n' {
+				word, inWord = append(word, runes[i]), true
+			}
+
+		// Single quotes preserve everything
+		case r == '\'':
+			end := i + 1
+			for end < len(runes) && runes[end] != '\'' {
+				end++
+			}
+			if end >= len(runes) {
+				err = errors.Errorf("unterminated single quote in command: %s", cmd)
+				return
+			}
+			word, inWord = append(word, runes[i+1:end]...), true
+			i = end
+
+		// Double quotes preserve everything except a few backslash escapes
+		case r == '"':
+			inWord = true
+			for i++; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) && strings.ContainsRune("$`\"\\\n", runes[i+1]) {
+					if i++; runes[i] == '\n' {
+						continue
+					}
+				}
+				word = append(word, runes[i])
+			}
+			if i >= len(runes) {
+				err = errors.Errorf("unterminated double quote in command: %s", cmd)
+				return
+			}
+
+		default:
+			word, inWord = append(word, r), true
+		}
+	}
+	if inWord {
+		args = append(args, string(word))
+	}
+	return
+}
 
-// SplitCmd splits this cmd into substrings around spaces taking into account bash like
-// double and single quotes. Unmatched quotes throw and error and empty quotes are removed.
+// SplitCmd splits this cmd into substrings around spaces keeping single or double quoted
+// substrings intact including their quotes. See Shlex for POSIX shell compliant splitting.
 func SplitCmd(cmd string) (slice []string) {
 	return gRXSplitCmd.FindAllString(cmd, -1)
 }
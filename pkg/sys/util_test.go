@@ -6,6 +6,153 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func TestShellJoin(t *testing.T) {
+	// empty
+	{
+		assert.Equal(t, "", ShellJoin())
+	}
+
+	// quoted as needed
+	{
+		assert.Equal(t, `ls -la '' 'my file' 'it'"'"'s'`, ShellJoin("ls", "-la", "", "my file", "it's"))
+	}
+
+	// round trip through Shlex
+	{
+		args := []string{"echo", "a b", `"quoted"`, `back\slash`, "$HOME", "#hash", "tab\tnew\nline", "it's"}
+		result, err := Shlex(ShellJoin(args...))
+		assert.Nil(t, err)
+		assert.Equal(t, args, result)
+	}
+}
+
+func TestShellQuote(t *testing.T) {
+	// empty
+	{
+		assert.Equal(t, "''", ShellQuote(""))
+	}
+
+	// safe characters are left as is
+	{
+		assert.Equal(t, "foo", ShellQuote("foo"))
+		assert.Equal(t, "--opt=a,b:c@d%e+f", ShellQuote("--opt=a,b:c@d%e+f"))
+		assert.Equal(t, "../path/to_file.go", ShellQuote("../path/to_file.go"))
+	}
+
+	// unsafe characters are quoted
+	{
+		assert.Equal(t, "'foo bar'", ShellQuote("foo bar"))
+		assert.Equal(t, "'$HOME'", ShellQuote("$HOME"))
+		assert.Equal(t, `'a"b'`, ShellQuote(`a"b`))
+		assert.Equal(t, `'a\b'`, ShellQuote(`a\b`))
+		assert.Equal(t, "'*'", ShellQuote("*"))
+		assert.Equal(t, "'~'", ShellQuote("~"))
+	}
+
+	// single quotes are escaped
+	{
+		assert.Equal(t, `'it'"'"'s'`, ShellQuote("it's"))
+		assert.Equal(t, `''"'"''`, ShellQuote("'"))
+	}
+}
+
+func TestShlex(t *testing.T) {
+	// empty
+	{
+		result, err := Shlex("")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{}, result)
+
+		result, err = Shlex(" \t\n ")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{}, result)
+	}
+
+	// whitespace separated
+	{
+		result, err := Shlex(" arg1  arg2\targ3\narg4 ")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"arg1", "arg2", "arg3", "arg4"}, result)
+	}
+
+	// single quotes
+	{
+		result, err := Shlex(`bash -c 'ls -la ${DIR}' '  foo' 'a"b' 'a\b'`)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"bash", "-c", "ls -la ${DIR}", "  foo", `a"b`, `a\b`}, result)
+	}
+
+	// double quotes
+	{
+		result, err := Shlex(`"  hello world  " "a'b" "\$FOO \"x\" \\ \n \` + "`" + `"`)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"  hello world  ", "a'b", `$FOO "x" \ \n ` + "`"}, result)
+	}
+
+	// adjacent quotes and words are joined
+	{
+		result, err := Shlex(`foo"bar"'baz' a'b'"c"d`)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"foobarbaz", "abcd"}, result)
+
+		result, err = Shlex(`"it's" 'say "hi"' 'it'\''s'`)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"it's", `say "hi"`, "it's"}, result)
+	}
+
+	// empty quotes
+	{
+		result, err := Shlex(`a '' "" b`)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"a", "", "", "b"}, result)
+
+		result, err = Shlex(`a""b`)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"ab"}, result)
+	}
+
+	// backslash escapes
+	{
+		result, err := Shlex(`my\ file \'a\' \"b\" \\ \#c`)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"my file", "'a'", `"b"`, `\`, "#c"}, result)
+	}
+
+	// line continuations
+	{
+		result, err := Shlex("foo \\\n  bar ba\\\nz \"a\\\nb\"")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"foo", "bar", "baz", "ab"}, result)
+	}
+
+	// comments
+	{
+		result, err := Shlex("foo # comment 'unterminated\nbar a#b '#c' #")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"foo", "bar", "a#b", "#c"}, result)
+	}
+
+	// unicode
+	{
+		result, err := Shlex(`echo "héllo wörld" 日本\ 語`)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"echo", "héllo wörld", "日本 語"}, result)
+	}
+
+	// errors
+	{
+		result, err := Shlex(`echo 'foo`)
+		assert.Equal(t, []string{"echo"}, result)
+		assert.Equal(t, "unterminated single quote in command: echo 'foo", err.Error())
+
+		_, err = Shlex(`echo "foo\"`)
+		assert.Equal(t, `unterminated double quote in command: echo "foo\"`, err.Error())
+
+		_, err = Shlex(`echo foo\`)
+		assert.Equal(t, `trailing backslash in command: echo foo\`, err.Error())
+	}
+}
+
 func TestSplitCmd(t *testing.T) {
 	{
 		cmd := ` arg1 arg2 '   hello    world' "  another hello world   " `
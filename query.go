@@ -0,0 +1,447 @@
+package n
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Query provides deferred execution over a source of elements reminiscent of C#'s Enumerable
+// type. Non-terminal methods e.g. Where, Select, Skip, Take, Distinct, OrderBy and GroupBy simply
+// compose a new iterator closure over the previous one without allocating intermediate slices.
+// Nothing is executed until a terminal method e.g. ToSlice, First, Count or Each is called, at
+// which point each element flows through the full chain one at a time.
+//
+// OrderBy and GroupBy are necessarily buffering operations and will consume their source fully
+// the first time they are iterated.
+type Query struct {
+	iter func() func() (O, bool) // creates a new closure iterator over the source
+}
+
+// Q is an alias to NewQuery
+func Q(obj interface{}) *Query {
+	return NewQuery(obj)
+}
+
+// NewQuery creates a new *Query from the given source. Supports any ISlice, any IMap in which
+// case the keys are iterated over, an Iterator or a channel of any type which will be consumed as
+// iterated, or any type that can be converted to an ISlice via the Slice function.
+func NewQuery(obj interface{}) *Query {
+	switch x := obj.(type) {
+	case nil:
+		return &Query{iter: emptyIter}
+	case *Query:
+		return x
+	case Iterator:
+		return &Query{iter: func() func() (O, bool) {
+			return func() (O, bool) {
+				if !x.Next() {
+					return nil, false
+				}
+				return x.Value(), true
+			}
+		}}
+	case ISlice:
+		return newSliceQuery(x)
+	case IMap:
+		return newSliceQuery(x.Keys())
+	}
+
+	// Channels are consumed as they are iterated
+	if v := reflect.ValueOf(obj); v.Kind() == reflect.Chan {
+		return &Query{iter: func() func() (O, bool) {
+			return func() (O, bool) {
+				x, ok := v.Recv()
+				if !ok {
+					return nil, false
+				}
+				return x.Interface(), true
+			}
+		}}
+	}
+
+	return newSliceQuery(Slice(obj))
+}
+
+// newSliceQuery creates a new *Query iterating over the given slice by index
+func newSliceQuery(slice ISlice) *Query {
+	return &Query{iter: func() func() (O, bool) {
+		i := 0
+		return func() (O, bool) {
+			if i >= slice.Len() {
+				return nil, false
+			}
+			elem := slice.At(i).O()
+			i++
+			return elem, true
+		}
+	}}
+}
+
+// emptyIter is a closure iterator that never yields anything
+func emptyIter() func() (O, bool) {
+	return func() (O, bool) { return nil, false }
+}
+
+// Group is the element type yielded by Query.GroupBy
+type Group struct {
+	Key   O      // the key value shared by all elements in the group
+	Items ISlice // the elements that share the key in source order
+}
+
+// Deferred methods
+//--------------------------------------------------------------------------------------------------
+
+// Distinct returns a new Query that yields only the first occurrence of each element.
+// Elements that aren't comparable e.g. maps and slices are compared by their string value.
+func (p *Query) Distinct() *Query {
+	return &Query{iter: func() func() (O, bool) {
+		next := p.source()
+		seen := map[interface{}]bool{}
+		return func() (O, bool) {
+			for x, ok := next(); ok; x, ok = next() {
+				key := hashKey(x)
+				if !seen[key] {
+					seen[key] = true
+					return x, true
+				}
+			}
+			return nil, false
+		}
+	}}
+}
+
+// GroupBy returns a new Query that yields a *Group for each distinct key returned by the given
+// key lambda in the order the key was first encountered. The source is consumed fully the
+// first time the Query is iterated.
+func (p *Query) GroupBy(key func(O) O) *Query {
+	return &Query{iter: func() func() (O, bool) {
+		var groups []*Group
+		index := map[interface{}]*Group{}
+		next := p.source()
+		for x, ok := next(); ok; x, ok = next() {
+			k := key(x)
+			group, exists := index[hashKey(k)]
+			if !exists {
+				group = &Group{Key: k, Items: Slice([]interface{}{x})}
+				index[hashKey(k)] = group
+				groups = append(groups, group)
+				continue
+			}
+			group.Items.Append(x)
+		}
+		i := 0
+		return func() (O, bool) {
+			if i >= len(groups) {
+				return nil, false
+			}
+			i++
+			return groups[i-1], true
+		}
+	}}
+}
+
+// OrderBy returns a new Query that yields the elements sorted in ascending order by the key
+// returned from the given lambda. The sort is stable so that elements with equal keys retain
+// their source order. The source is consumed fully the first time the Query is iterated.
+func (p *Query) OrderBy(key func(O) O) *Query {
+	return p.orderBy(key, false)
+}
+
+// OrderByR returns a new Query that yields the elements sorted in descending order by the key
+// returned from the given lambda. The sort is stable so that elements with equal keys retain
+// their source order. The source is consumed fully the first time the Query is iterated.
+func (p *Query) OrderByR(key func(O) O) *Query {
+	return p.orderBy(key, true)
+}
+
+func (p *Query) orderBy(key func(O) O, reverse bool) *Query {
+	return &Query{iter: func() func() (O, bool) {
+		var elems, keys []interface{}
+		next := p.source()
+		for x, ok := next(); ok; x, ok = next() {
+			elems = append(elems, x)
+			keys = append(keys, key(x))
+		}
+		idx := Range(0, len(elems)-1)
+		sort.SliceStable(idx, func(i, j int) bool {
+			if reverse {
+				return lessO(keys[idx[j]], keys[idx[i]])
+			}
+			return lessO(keys[idx[i]], keys[idx[j]])
+		})
+		i := 0
+		return func() (O, bool) {
+			if i >= len(idx) {
+				return nil, false
+			}
+			i++
+			return elems[idx[i-1]], true
+		}
+	}}
+}
+
+// Select returns a new Query that yields the elements modified by the given lambda.
+func (p *Query) Select(mod func(O) O) *Query {
+	return &Query{iter: func() func() (O, bool) {
+		next := p.source()
+		return func() (O, bool) {
+			if x, ok := next(); ok {
+				return mod(x), true
+			}
+			return nil, false
+		}
+	}}
+}
+
+// Skip returns a new Query that bypasses the first n elements and yields the remaining.
+func (p *Query) Skip(n int) *Query {
+	return &Query{iter: func() func() (O, bool) {
+		next := p.source()
+		skipped := 0
+		return func() (O, bool) {
+			for ; skipped < n; skipped++ {
+				if _, ok := next(); !ok {
+					return nil, false
+				}
+			}
+			return next()
+		}
+	}}
+}
+
+// SkipW returns a new Query that bypasses elements as long as the lambda selector returns
+// true and then yields the remaining elements.
+func (p *Query) SkipW(sel func(O) bool) *Query {
+	return &Query{iter: func() func() (O, bool) {
+		next := p.source()
+		skipping := true
+		return func() (O, bool) {
+			for x, ok := next(); ok; x, ok = next() {
+				if skipping && sel(x) {
+					continue
+				}
+				skipping = false
+				return x, true
+			}
+			return nil, false
+		}
+	}}
+}
+
+// Take returns a new Query that yields only the first n elements. The source is not iterated
+// beyond the n'th element.
+func (p *Query) Take(n int) *Query {
+	return &Query{iter: func() func() (O, bool) {
+		next := p.source()
+		taken := 0
+		return func() (O, bool) {
+			if taken >= n {
+				return nil, false
+			}
+			taken++
+			return next()
+		}
+	}}
+}
+
+// TakeW returns a new Query that yields elements as long as the lambda selector returns true.
+func (p *Query) TakeW(sel func(O) bool) *Query {
+	return &Query{iter: func() func() (O, bool) {
+		next := p.source()
+		done := false
+		return func() (O, bool) {
+			if done {
+				return nil, false
+			}
+			if x, ok := next(); ok && sel(x) {
+				return x, true
+			}
+			done = true
+			return nil, false
+		}
+	}}
+}
+
+// Where returns a new Query that yields only the elements that match the lambda selector.
+func (p *Query) Where(sel func(O) bool) *Query {
+	return &Query{iter: func() func() (O, bool) {
+		next := p.source()
+		return func() (O, bool) {
+			for x, ok := next(); ok; x, ok = next() {
+				if sel(x) {
+					return x, true
+				}
+			}
+			return nil, false
+		}
+	}}
+}
+
+// Terminal methods
+//--------------------------------------------------------------------------------------------------
+
+// Any executes the Query and tests if it yields any elements or optionally if any yielded
+// element matches the lambda selector. Iteration stops at the first match.
+func (p *Query) Any(sel ...func(O) bool) bool {
+	next := p.source()
+	for x, ok := next(); ok; x, ok = next() {
+		if len(sel) == 0 || sel[0](x) {
+			return true
+		}
+	}
+	return false
+}
+
+// Count executes the Query and returns the number of elements yielded.
+func (p *Query) Count() (cnt int) {
+	next := p.source()
+	for _, ok := next(); ok; _, ok = next() {
+		cnt++
+	}
+	return
+}
+
+// Each executes the Query calling the given lambda once for each element yielded.
+func (p *Query) Each(action func(O)) {
+	next := p.source()
+	for x, ok := next(); ok; x, ok = next() {
+		action(x)
+	}
+}
+
+// EachE executes the Query calling the given lambda once for each element yielded. Iteration
+// stops at the first error returned from the lambda which is then returned. Returning the
+// Break error from the lambda will stop iteration without returning an error.
+func (p *Query) EachE(action func(O) error) (err error) {
+	next := p.source()
+	for x, ok := next(); ok; x, ok = next() {
+		if err = action(x); err != nil {
+			if err == Break {
+				err = nil
+			}
+			return
+		}
+	}
+	return
+}
+
+// First executes the Query only as far as the first element and returns it as an Object.
+// Object.Nil() == true will be returned when nothing is yielded.
+func (p *Query) First() (elem *Object) {
+	elem = &Object{}
+	if x, ok := p.source()(); ok {
+		elem.o = x
+	}
+	return
+}
+
+// Last executes the Query and returns the last element yielded as an Object.
+// Object.Nil() == true will be returned when nothing is yielded.
+func (p *Query) Last() (elem *Object) {
+	elem = &Object{}
+	next := p.source()
+	for x, ok := next(); ok; x, ok = next() {
+		elem.o = x
+	}
+	return
+}
+
+// ToSlice executes the Query and returns the yielded elements as a new Slice of the type
+// matching the first element yielded using the same rules as Slice.
+func (p *Query) ToSlice() (slice ISlice) {
+	next := p.source()
+	for x, ok := next(); ok; x, ok = next() {
+		if slice == nil {
+			slice = Slice([]interface{}{x})
+		} else {
+			slice.Append(x)
+		}
+	}
+	if slice == nil {
+		slice = NewInterSliceV()
+	}
+	return
+}
+
+// ToInterSlice executes the Query and returns the yielded elements as a new *InterSlice
+func (p *Query) ToInterSlice() (slice *InterSlice) {
+	slice = NewInterSliceV()
+	next := p.source()
+	for x, ok := next(); ok; x, ok = next() {
+		*slice = append(*slice, x)
+	}
+	return
+}
+
+// source returns a new closure iterator for this Query handling nil gracefully
+func (p *Query) source() func() (O, bool) {
+	if p == nil || p.iter == nil {
+		return emptyIter()
+	}
+	return p.iter()
+}
+
+// Helpers
+//--------------------------------------------------------------------------------------------------
+
+// hashKey returns a value usable as a Go map key to identify the given element. Comparable
+// types are used directly while others e.g. maps and slices fall back on their string value.
+func hashKey(obj interface{}) interface{} {
+	if obj == nil {
+		return nil
+	}
+	if reflect.TypeOf(obj).Comparable() {
+		return obj
+	}
+	return fmt.Sprintf("%T:%v", obj, obj)
+}
+
+// lessO returns true if a is less than b. Numeric types are compared numerically regardless of
+// their concrete type, strings, Str and time.Time naturally and everything else by string value.
+// Nil is considered less than everything else.
+func lessO(a, b interface{}) bool {
+	switch {
+	case a == nil:
+		return b != nil
+	case b == nil:
+		return false
+	}
+
+	switch x := a.(type) {
+	case string:
+		if y, ok := b.(string); ok {
+			return x < y
+		}
+	case *Str:
+		return x.A() < ToString(b)
+	case bool:
+		if y, ok := b.(bool); ok {
+			return !x && y
+		}
+	case time.Time:
+		if y, ok := b.(time.Time); ok {
+			return x.Before(y)
+		}
+	case time.Duration:
+		if y, ok := b.(time.Duration); ok {
+			return x < y
+		}
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		if isNumeric(b) {
+			return ToFloat64(a) < ToFloat64(b)
+		}
+	}
+	return strings.Compare(ToString(a), ToString(b)) < 0
+}
+
+// isNumeric returns true if the given object is a Go numeric type
+func isNumeric(obj interface{}) bool {
+	switch obj.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return true
+	}
+	return false
+}
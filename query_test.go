@@ -0,0 +1,349 @@
+package n
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// NewQuery
+//--------------------------------------------------------------------------------------------------
+func BenchmarkQuery_Chain_Slice(t *testing.B) {
+	NewIntSlice(Range(0, nines6)).Select(func(x O) bool {
+		return ExB(x.(int)%2 == 0)
+	}).Map(func(x O) O {
+		return x.(int) + 1
+	}).FirstN(10)
+}
+
+func BenchmarkQuery_Chain_Query(t *testing.B) {
+	NewQuery(NewIntSlice(Range(0, nines6))).Where(func(x O) bool {
+		return ExB(x.(int)%2 == 0)
+	}).Select(func(x O) O {
+		return x.(int) + 1
+	}).Take(10).ToSlice()
+}
+
+func ExampleNewQuery() {
+	q := NewQuery(NewIntSliceV(1, 2, 3, 4, 5)).Where(func(x O) bool {
+		return ExB(x.(int)%2 != 0)
+	})
+	fmt.Println(q.ToSlice())
+	// Output: [1 3 5]
+}
+
+func TestQuery_NewQuery(t *testing.T) {
+
+	// nil
+	{
+		var q *Query
+		assert.Equal(t, 0, q.Count())
+		assert.Equal(t, 0, NewQuery(nil).Count())
+		assert.Equal(t, NewInterSliceV(), NewQuery(nil).ToSlice())
+	}
+
+	// ISlice
+	{
+		assert.Equal(t, NewStringSliceV("1", "2"), NewQuery(NewStringSliceV("1", "2")).ToSlice())
+	}
+
+	// Go slice
+	{
+		assert.Equal(t, NewIntSliceV(1, 2), NewQuery([]int{1, 2}).ToSlice())
+	}
+
+	// slice elements are not flattened
+	{
+		slice := NewQuery([]interface{}{[]int{1, 2}, []int{3}}).ToSlice()
+		assert.Equal(t, 2, slice.Len())
+		assert.Equal(t, []int{1, 2}, slice.At(0).O())
+		assert.Equal(t, []int{3}, slice.At(1).O())
+	}
+
+	// IMap keys
+	{
+		q := NewQuery(NewStringMapV(map[string]interface{}{"1": "one", "2": "two"}))
+		assert.Equal(t, NewStringSliceV("1", "2"), q.ToSlice().Sort())
+	}
+
+	// channel
+	{
+		ch := make(chan int, 3)
+		ch <- 1
+		ch <- 2
+		ch <- 3
+		close(ch)
+		assert.Equal(t, NewIntSliceV(2, 4, 6), NewQuery(ch).Select(func(x O) O { return x.(int) * 2 }).ToSlice())
+	}
+
+	// iterator is consumed as iterated
+	{
+		it := NewIntSliceV(1, 2, 3).Iter()
+		assert.Equal(t, NewIntSliceV(2, 4, 6), NewQuery(it).Select(func(x O) O { return x.(int) * 2 }).ToSlice())
+		assert.False(t, it.Next())
+
+		q := NewQuery(NewOrderedMapV("b", 2, "a", 1).Iter()).Select(func(x O) O { return x.(Pair).Key })
+		assert.Equal(t, NewStringSliceV("b", "a"), q.ToSlice())
+	}
+
+	// Query is returned as is
+	{
+		q := NewQuery([]int{1})
+		assert.Equal(t, q, NewQuery(q))
+	}
+}
+
+func TestQuery_Deferred(t *testing.T) {
+
+	// Nothing is executed until a terminal call
+	{
+		calls := 0
+		q := NewQuery([]int{1, 2, 3}).Select(func(x O) O {
+			calls++
+			return x
+		})
+		assert.Equal(t, 0, calls)
+		q.Count()
+		assert.Equal(t, 3, calls)
+	}
+
+	// Queries can be executed multiple times
+	{
+		q := NewQuery([]int{1, 2, 3}).Where(func(x O) bool { return x.(int) > 1 })
+		assert.Equal(t, 2, q.Count())
+		assert.Equal(t, NewIntSliceV(2, 3), q.ToSlice())
+	}
+
+	// Source changes are seen by the query
+	{
+		slice := NewIntSliceV(1, 2)
+		q := NewQuery(slice)
+		slice.Append(3)
+		assert.Equal(t, 3, q.Count())
+	}
+}
+
+// Distinct
+//--------------------------------------------------------------------------------------------------
+func ExampleQuery_Distinct() {
+	fmt.Println(NewQuery([]int{1, 2, 2, 3, 1}).Distinct().ToSlice())
+	// Output: [1 2 3]
+}
+
+func TestQuery_Distinct(t *testing.T) {
+	assert.Equal(t, NewIntSliceV(1, 2, 3), NewQuery([]int{1, 2, 2, 3, 1}).Distinct().ToSlice())
+	assert.Equal(t, NewStringSliceV("1", "2"), NewQuery([]string{"1", "1", "2"}).Distinct().ToSlice())
+
+	// non comparable types
+	{
+		q := NewQuery([]map[string]interface{}{{"a": "1"}, {"a": "1"}, {"a": "2"}}).Distinct()
+		assert.Equal(t, 2, q.Count())
+	}
+}
+
+// GroupBy
+//--------------------------------------------------------------------------------------------------
+func ExampleQuery_GroupBy() {
+	NewQuery([]int{1, 2, 3, 4, 5}).GroupBy(func(x O) O {
+		return x.(int) % 2
+	}).Each(func(x O) {
+		group := x.(*Group)
+		fmt.Println(group.Key, group.Items)
+	})
+	// Output:
+	// 1 [1 3 5]
+	// 0 [2 4]
+}
+
+func TestQuery_GroupBy(t *testing.T) {
+
+	// empty
+	{
+		assert.Equal(t, 0, NewQuery([]int{}).GroupBy(func(x O) O { return x }).Count())
+	}
+
+	// MapSlice rows by field
+	{
+		rows := NewMapSliceV(
+			map[string]interface{}{"name": "1", "group": "a"},
+			map[string]interface{}{"name": "2", "group": "b"},
+			map[string]interface{}{"name": "3", "group": "a"},
+		)
+		groups := NewQuery(rows).GroupBy(func(x O) O {
+			return ToStringMap(x).Get("group").A()
+		}).ToInterSlice()
+		assert.Equal(t, 2, groups.Len())
+		assert.Equal(t, "a", groups.At(0).O().(*Group).Key)
+		assert.Equal(t, 2, groups.At(0).O().(*Group).Items.Len())
+		assert.Equal(t, "b", groups.At(1).O().(*Group).Key)
+		assert.Equal(t, 1, groups.At(1).O().(*Group).Items.Len())
+	}
+
+	// slice elements are not flattened
+	{
+		groups := NewQuery([]interface{}{[]int{1, 2}, []int{3}}).GroupBy(func(x O) O {
+			return "all"
+		}).ToInterSlice()
+		assert.Equal(t, 1, groups.Len())
+		items := groups.At(0).O().(*Group).Items
+		assert.Equal(t, 2, items.Len())
+		assert.Equal(t, []int{1, 2}, items.At(0).O())
+		assert.Equal(t, []int{3}, items.At(1).O())
+	}
+}
+
+// OrderBy
+//--------------------------------------------------------------------------------------------------
+func ExampleQuery_OrderBy() {
+	fmt.Println(NewQuery([]int{3, 1, 2}).OrderBy(func(x O) O { return x }).ToSlice())
+	// Output: [1 2 3]
+}
+
+func TestQuery_OrderBy(t *testing.T) {
+
+	// ints
+	{
+		q := NewQuery([]int{3, 1, 2})
+		assert.Equal(t, NewIntSliceV(1, 2, 3), q.OrderBy(func(x O) O { return x }).ToSlice())
+		assert.Equal(t, NewIntSliceV(3, 2, 1), q.OrderByR(func(x O) O { return x }).ToSlice())
+	}
+
+	// strings
+	{
+		q := NewQuery([]string{"b", "c", "a"})
+		assert.Equal(t, NewStringSliceV("a", "b", "c"), q.OrderBy(func(x O) O { return x }).ToSlice())
+	}
+
+	// stable by key
+	{
+		rows := NewMapSliceV(
+			map[string]interface{}{"name": "1", "age": 30},
+			map[string]interface{}{"name": "2", "age": 20},
+			map[string]interface{}{"name": "3", "age": 30},
+		)
+		names := NewQuery(rows).OrderBy(func(x O) O {
+			return ToStringMap(x).Get("age").O()
+		}).Select(func(x O) O {
+			return ToStringMap(x).Get("name").A()
+		}).ToSlice()
+		assert.Equal(t, NewStringSliceV("2", "1", "3"), names)
+	}
+}
+
+// Select
+//--------------------------------------------------------------------------------------------------
+func ExampleQuery_Select() {
+	fmt.Println(NewQuery([]int{1, 2, 3}).Select(func(x O) O { return x.(int) * 2 }).ToSlice())
+	// Output: [2 4 6]
+}
+
+func TestQuery_Select(t *testing.T) {
+	assert.Equal(t, NewStringSliceV("1", "2"), NewQuery([]int{1, 2}).Select(func(x O) O {
+		return ToString(x)
+	}).ToSlice())
+}
+
+// Skip
+//--------------------------------------------------------------------------------------------------
+func ExampleQuery_Skip() {
+	fmt.Println(NewQuery([]int{1, 2, 3}).Skip(1).ToSlice())
+	// Output: [2 3]
+}
+
+func TestQuery_Skip(t *testing.T) {
+	assert.Equal(t, NewIntSliceV(1, 2, 3), NewQuery([]int{1, 2, 3}).Skip(0).ToSlice())
+	assert.Equal(t, NewIntSliceV(3), NewQuery([]int{1, 2, 3}).Skip(2).ToSlice())
+	assert.Equal(t, 0, NewQuery([]int{1, 2, 3}).Skip(5).Count())
+	assert.Equal(t, NewIntSliceV(3, 1), NewQuery([]int{1, 2, 3, 1}).SkipW(func(x O) bool {
+		return x.(int) < 3
+	}).ToSlice())
+}
+
+// Take
+//--------------------------------------------------------------------------------------------------
+func ExampleQuery_Take() {
+	fmt.Println(NewQuery([]int{1, 2, 3}).Take(2).ToSlice())
+	// Output: [1 2]
+}
+
+func TestQuery_Take(t *testing.T) {
+	assert.Equal(t, 0, NewQuery([]int{1, 2, 3}).Take(0).Count())
+	assert.Equal(t, NewIntSliceV(1, 2, 3), NewQuery([]int{1, 2, 3}).Take(5).ToSlice())
+	assert.Equal(t, NewIntSliceV(1, 2), NewQuery([]int{1, 2, 3, 1}).TakeW(func(x O) bool {
+		return x.(int) < 3
+	}).ToSlice())
+
+	// Source is not iterated past what is taken
+	{
+		calls := 0
+		NewQuery(Range(0, 99)).Select(func(x O) O {
+			calls++
+			return x
+		}).Take(3).Count()
+		assert.Equal(t, 3, calls)
+	}
+
+	// Skip and take for paging
+	{
+		assert.Equal(t, NewIntSliceV(4, 5, 6), NewQuery(Range(1, 10)).Skip(3).Take(3).ToSlice())
+	}
+}
+
+// Where
+//--------------------------------------------------------------------------------------------------
+func ExampleQuery_Where() {
+	fmt.Println(NewQuery([]string{"a", "b", "c"}).Where(func(x O) bool { return x.(string) != "b" }).ToSlice())
+	// Output: [a c]
+}
+
+func TestQuery_Where(t *testing.T) {
+	assert.Equal(t, 0, NewQuery([]int{1, 2}).Where(func(x O) bool { return false }).Count())
+	assert.Equal(t, NewIntSliceV(2), NewQuery([]int{1, 2}).Where(func(x O) bool { return x.(int) == 2 }).ToSlice())
+}
+
+// Terminal
+//--------------------------------------------------------------------------------------------------
+func TestQuery_Terminal(t *testing.T) {
+	q := NewQuery([]int{1, 2, 3})
+
+	// Any
+	assert.True(t, q.Any())
+	assert.True(t, q.Any(func(x O) bool { return x.(int) == 3 }))
+	assert.False(t, q.Any(func(x O) bool { return x.(int) == 4 }))
+	assert.False(t, NewQuery([]int{}).Any())
+
+	// Count
+	assert.Equal(t, 3, q.Count())
+
+	// Each
+	{
+		sum := 0
+		q.Each(func(x O) { sum += x.(int) })
+		assert.Equal(t, 6, sum)
+	}
+
+	// EachE
+	{
+		sum := 0
+		err := q.EachE(func(x O) error {
+			if x.(int) == 3 {
+				return Break
+			}
+			sum += x.(int)
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 3, sum)
+		assert.Equal(t, "failed", q.EachE(func(x O) error { return fmt.Errorf("failed") }).Error())
+	}
+
+	// First/Last
+	assert.Equal(t, 1, q.First().O())
+	assert.Equal(t, 3, q.Last().O())
+	assert.True(t, NewQuery([]int{}).First().Nil())
+	assert.True(t, NewQuery([]int{}).Last().Nil())
+
+	// ToInterSlice
+	assert.Equal(t, NewInterSliceV(1, 2, 3), q.ToInterSlice())
+}
@@ -0,0 +1,52 @@
+package n
+
+import (
+	"regexp"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// regexCacheSize is the maximum number of compiled expressions kept in the regex cache
+const regexCacheSize = 1000
+
+// regexCache holds compiled regular expressions keyed by their expression string
+var regexCache = struct {
+	sync.RWMutex
+	m map[string]*regexp.Regexp
+}{m: map[string]*regexp.Regexp{}}
+
+// CompileRegex compiles the given regular expression caching the result such that subsequent calls
+// with the same expression return the same *regexp.Regexp without recompiling it. The cache is
+// cleared once it holds regexCacheSize expressions to bound its memory use.
+func CompileRegex(exp string) (rx *regexp.Regexp, err error) {
+	regexCache.RLock()
+	rx = regexCache.m[exp]
+	regexCache.RUnlock()
+	if rx != nil {
+		return
+	}
+
+	if rx, err = regexp.Compile(exp); err != nil {
+		err = errors.Wrapf(err, "failed compiling regex '%s'", exp)
+		return
+	}
+	regexCache.Lock()
+	if len(regexCache.m) >= regexCacheSize {
+		regexCache.m = map[string]*regexp.Regexp{}
+	}
+	regexCache.m[exp] = rx
+	regexCache.Unlock()
+	return
+}
+
+// toRegex converts the given expression into a compiled regular expression using the regex cache
+func toRegex(exp interface{}) (rx *regexp.Regexp, err error) {
+	if x, ok := exp.(*regexp.Regexp); ok {
+		if x == nil {
+			err = errors.Errorf("regex can not be nil")
+		}
+		return x, err
+	}
+	return CompileRegex(ToString(exp))
+}
@@ -0,0 +1,46 @@
+package n
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// CompileRegex
+//--------------------------------------------------------------------------------------------------
+func ExampleCompileRegex() {
+	rx, err := CompileRegex(`v(\d+)\.(\d+)`)
+	fmt.Println(rx.FindStringSubmatch("go v1.13")[1:], err)
+	// Output: [1 13] <nil>
+}
+
+func TestCompileRegex(t *testing.T) {
+
+	// cached
+	{
+		rx1, err := CompileRegex(`^foo\d+$`)
+		assert.Nil(t, err)
+		rx2, err := CompileRegex(`^foo\d+$`)
+		assert.Nil(t, err)
+		assert.True(t, rx1 == rx2)
+		assert.True(t, rx1.MatchString("foo12"))
+	}
+
+	// invalid
+	{
+		rx, err := CompileRegex(`foo(`)
+		assert.Nil(t, rx)
+		assert.Equal(t, "failed compiling regex 'foo(': error parsing regexp: missing closing ): `foo(`", err.Error())
+	}
+
+	// cache is bounded
+	{
+		for i := 0; i < regexCacheSize+10; i++ {
+			CompileRegex(fmt.Sprintf("bound%d", i))
+		}
+		regexCache.RLock()
+		assert.True(t, len(regexCache.m) <= regexCacheSize)
+		regexCache.RUnlock()
+	}
+}
@@ -0,0 +1,403 @@
+package n
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+)
+
+// Set provides a generic unordered collection of uniq elements with set algebra operations.
+// Sets of only int, float64, rune or string elements are backed by the IntMapBool, FloatMapBool,
+// RuneMapBool and StringMapBool types respectively while all other element types and sets of
+// mixed types are tracked by hash. Elements of different types are never equal, Chars excepted
+// which are treated as runes. Iteration is done in sorted element order.
+type Set struct {
+	m   IMap                   // backing *MapBool type for homogeneous int, float64, rune and string elements
+	typ reflect.Type           // element type of the backing *MapBool type
+	h   map[string]interface{} // hashed elements for all other element types
+}
+
+// NewSet creates a new Set from the given Slice, Go slice or array or single element. The
+// backing type of the Set is determined by the element type. Passing in a Set simply returns it.
+func NewSet(obj interface{}) (new *Set) {
+	if x, ok := obj.(*Set); ok {
+		if x == nil {
+			return &Set{}
+		}
+		return x
+	}
+	new = &Set{}
+	if obj == nil {
+		return
+	}
+
+	switch x := obj.(type) {
+	case *Str:
+		if x != nil {
+			for _, r := range *x {
+				new.Add(r)
+			}
+		}
+	case ISlice:
+		if !x.Nil() {
+			x.Each(func(o O) { new.Add(o) })
+		}
+	default:
+		v := reflect.ValueOf(obj)
+		if v.Kind() == reflect.Ptr && (v.Elem().Kind() == reflect.Slice || v.Elem().Kind() == reflect.Array) {
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			new.Add(obj)
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			new.Add(v.Index(i).Interface())
+		}
+	}
+	return
+}
+
+// NewSetV creates a new Set from the given variadic elements.
+func NewSetV(elems ...interface{}) (new *Set) {
+	new = &Set{}
+	for i := range elems {
+		new.Add(elems[i])
+	}
+	return
+}
+
+// Add the given element to this Set returning true if it didn't already exist.
+func (p *Set) Add(elem interface{}) (new bool) {
+	if p == nil {
+		return
+	}
+	elem = setElem(elem)
+	if p.m == nil && p.h == nil {
+		p.init(reflect.TypeOf(elem))
+	}
+	if p.m != nil {
+		if reflect.TypeOf(elem) == p.typ {
+			return p.m.Set(elem, true)
+		}
+		p.hash()
+	}
+	key := setHash(elem)
+	if _, ok := p.h[key]; !ok {
+		p.h[key] = elem
+		new = true
+	}
+	return
+}
+
+// AddV modifies this Set to add the given variadic elements and returns a reference to this Set.
+func (p *Set) AddV(elems ...interface{}) *Set {
+	if p == nil {
+		return p
+	}
+	for i := range elems {
+		p.Add(elems[i])
+	}
+	return p
+}
+
+// Clear modifies this Set to clear out all elements and returns a reference to this Set.
+// The backing type of the Set is retained.
+func (p *Set) Clear() *Set {
+	if p == nil {
+		return p
+	}
+	switch p.m.(type) {
+	case *IntMapBool:
+		p.m = NewIntMapBool()
+	case *FloatMapBool:
+		p.m = NewFloatMapBool()
+	case *RuneMapBool:
+		p.m = NewRuneMapBool()
+	case *StringMapBool:
+		p.m = NewStringMapBool()
+	default:
+		if p.h != nil {
+			p.h = map[string]interface{}{}
+		}
+	}
+	return p
+}
+
+// Contains checks if the given element exists in this Set.
+func (p *Set) Contains(elem interface{}) bool {
+	if p == nil {
+		return false
+	}
+	elem = setElem(elem)
+	if p.m != nil {
+		return reflect.TypeOf(elem) == p.typ && p.m.Exists(elem)
+	}
+	_, ok := p.h[setHash(elem)]
+	return ok
+}
+
+// Copy returns a new Set with the same elements and backing type as this Set.
+func (p *Set) Copy() (new *Set) {
+	new = &Set{}
+	if p == nil {
+		return
+	}
+	if p.m != nil {
+		new.m, new.typ = p.m.Copy(), p.typ
+	} else if p.h != nil {
+		new.h = make(map[string]interface{}, len(p.h))
+		for k, v := range p.h {
+			new.h[k] = v
+		}
+	}
+	return
+}
+
+// Difference returns a new Set with the elements of this Set that are not in the given Set.
+// Supports Set, *Set, ISlice, Go slices and arrays
+func (p *Set) Difference(other interface{}) (new *Set) {
+	new = p.Copy().Clear()
+	o := NewSet(other)
+	p.Each(func(x O) {
+		if !o.Contains(x) {
+			new.Add(x)
+		}
+	})
+	return
+}
+
+// Disjoint checks if this Set has no elements in common with the given Set.
+// Supports Set, *Set, ISlice, Go slices and arrays
+func (p *Set) Disjoint(other interface{}) bool {
+	o := NewSet(other)
+	for _, x := range p.values() {
+		if o.Contains(x) {
+			return false
+		}
+	}
+	return true
+}
+
+// Each calls the given lambda once for each element in this Set in sorted order.
+func (p *Set) Each(action func(O)) *Set {
+	for _, x := range p.values() {
+		action(x)
+	}
+	return p
+}
+
+// Empty tests if this Set is nil or has no elements.
+func (p *Set) Empty() bool {
+	return p.Len() == 0
+}
+
+// Equal checks if this Set contains exactly the same elements as the given Set.
+// Supports Set, *Set, ISlice, Go slices and arrays
+func (p *Set) Equal(other interface{}) bool {
+	o := NewSet(other)
+	return p.Len() == o.Len() && p.IsSubset(o)
+}
+
+// Intersect returns a new Set with the elements of this Set that are also in the given Set.
+// Supports Set, *Set, ISlice, Go slices and arrays
+func (p *Set) Intersect(other interface{}) (new *Set) {
+	new = p.Copy().Clear()
+	o := NewSet(other)
+	p.Each(func(x O) {
+		if o.Contains(x) {
+			new.Add(x)
+		}
+	})
+	return
+}
+
+// IsSubset checks if all elements of this Set are in the given Set.
+// Supports Set, *Set, ISlice, Go slices and arrays
+func (p *Set) IsSubset(other interface{}) bool {
+	o := NewSet(other)
+	for _, x := range p.values() {
+		if !o.Contains(x) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset checks if all elements of the given Set are in this Set.
+// Supports Set, *Set, ISlice, Go slices and arrays
+func (p *Set) IsSuperset(other interface{}) bool {
+	return NewSet(other).IsSubset(p)
+}
+
+// Len returns the number of elements in this Set.
+func (p *Set) Len() int {
+	if p == nil {
+		return 0
+	}
+	if p.m != nil {
+		return p.m.Len()
+	}
+	return len(p.h)
+}
+
+// Nil tests if this Set is nil
+func (p *Set) Nil() bool {
+	return p == nil
+}
+
+// Remove the given element from this Set returning true if it existed.
+func (p *Set) Remove(elem interface{}) (ok bool) {
+	if p == nil {
+		return
+	}
+	elem = setElem(elem)
+	if p.m != nil {
+		if ok = reflect.TypeOf(elem) == p.typ && p.m.Exists(elem); ok {
+			p.m.Delete(elem)
+		}
+		return
+	}
+	key := setHash(elem)
+	if _, ok = p.h[key]; ok {
+		delete(p.h, key)
+	}
+	return
+}
+
+// String returns a string representation of this Set, implements the Stringer interface
+func (p *Set) String() string {
+	return p.Values().String()
+}
+
+// SymmetricDifference returns a new Set with the elements that are in either this Set or the
+// given Set but not in both.
+// Supports Set, *Set, ISlice, Go slices and arrays
+func (p *Set) SymmetricDifference(other interface{}) (new *Set) {
+	o := NewSet(other)
+	new = p.Difference(o)
+	o.Each(func(x O) {
+		if !p.Contains(x) {
+			new.Add(x)
+		}
+	})
+	return
+}
+
+// Union returns a new Set with the elements of both this Set and the given Set.
+// Supports Set, *Set, ISlice, Go slices and arrays
+func (p *Set) Union(other interface{}) (new *Set) {
+	new = p.Copy()
+	NewSet(other).Each(func(x O) {
+		new.Add(x)
+	})
+	return
+}
+
+// Values returns the elements of this Set as a Slice in sorted order. Sets of a single element
+// type retain their type e.g. int64 elements are returned as a *RefSlice of []int64 while sets of
+// mixed types are returned as an *InterSlice.
+func (p *Set) Values() (vals ISlice) {
+	if p != nil && p.m != nil {
+		return p.m.Keys()
+	}
+	x := p.values()
+	if len(x) == 0 {
+		return NewInterSliceV()
+	}
+	typ := reflect.TypeOf(x[0])
+	for i := range x {
+		if typ == nil || reflect.TypeOf(x[i]) != typ {
+			return NewInterSlice(x)
+		}
+	}
+	v := reflect.MakeSlice(reflect.SliceOf(typ), 0, len(x))
+	for i := range x {
+		v = reflect.Append(v, reflect.ValueOf(x[i]))
+	}
+
+	// Slice converts some types e.g. int64 to int so fallback on a RefSlice to retain the type
+	if vals = Slice(v.Interface()); reflect.TypeOf(vals.O()) != v.Type() {
+		vals = NewRefSlice(v.Interface())
+	}
+	return
+}
+
+// hash modifies this Set to move the elements of the backing *MapBool type into the hashed
+// elements so that elements of other types can be added.
+func (p *Set) hash() {
+	vals := p.values()
+	p.m, p.typ = nil, nil
+	p.h = make(map[string]interface{}, len(vals))
+	for i := range vals {
+		p.h[setHash(vals[i])] = vals[i]
+	}
+}
+
+// init sets the backing type of this Set based on the given element type. Only the exact types
+// int, float64, rune and string are backed by a *MapBool type as all others e.g. int64 or
+// time.Duration would lose their type in the conversion.
+func (p *Set) init(typ reflect.Type) {
+	switch typ {
+	case reflect.TypeOf(0):
+		p.m = NewIntMapBool()
+	case reflect.TypeOf(rune(0)):
+		p.m = NewRuneMapBool()
+	case reflect.TypeOf(float64(0)):
+		p.m = NewFloatMapBool()
+	case reflect.TypeOf(""):
+		p.m = NewStringMapBool()
+	default:
+		p.h = map[string]interface{}{}
+		return
+	}
+	p.typ = typ
+}
+
+// values returns the elements of this Set in sorted order as Go values
+func (p *Set) values() (vals []interface{}) {
+	if p == nil {
+		return
+	}
+	if p.m != nil {
+		keys := p.m.Keys()
+		vals = make([]interface{}, 0, keys.Len())
+		keys.Each(func(x O) { vals = append(vals, x) })
+		return
+	}
+	keys := make([]string, 0, len(p.h))
+	for k := range p.h {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		vals = append(vals, p.h[k])
+	}
+	sort.SliceStable(vals, func(i, j int) bool {
+		return compareValues(reflect.ValueOf(vals[i]), reflect.ValueOf(vals[j])) < 0
+	})
+	return
+}
+
+// setElem normalizes the given element so that Chars and runes are the same element
+func setElem(elem interface{}) interface{} {
+	switch x := elem.(type) {
+	case Char:
+		return rune(x)
+	case *Char:
+		if x != nil {
+			return rune(*x)
+		}
+	}
+	return elem
+}
+
+// setHash returns a hash key for the given element based on its type and value. Times are
+// normalized so that equal times in different locations hash the same.
+func setHash(elem interface{}) string {
+	if x, ok := elem.(time.Time); ok {
+		elem = x.Round(0).UTC()
+	}
+	return fmt.Sprintf("%T:%#v", elem, elem)
+}
@@ -0,0 +1,339 @@
+package n
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// NewSet
+//--------------------------------------------------------------------------------------------------
+func ExampleNewSet() {
+	set := NewSet([]string{"b", "a", "b"})
+	fmt.Println(set)
+	// Output: [a b]
+}
+
+func TestNewSet(t *testing.T) {
+	assert.Equal(t, 0, NewSet(nil).Len())
+	assert.Equal(t, 0, NewSet((*Set)(nil)).Len())
+	assert.Equal(t, 0, NewSet((*IntSlice)(nil)).Len())
+
+	// backing types
+	assert.IsType(t, &IntMapBool{}, NewSet([]int{1, 2}).m)
+	assert.NotNil(t, NewSet([]uint8{1, 2}).h)
+	assert.NotNil(t, NewSet([]time.Duration{time.Second}).h)
+	assert.NotNil(t, NewSet([]interface{}{1, "a"}).h)
+	assert.IsType(t, &FloatMapBool{}, NewSet(NewFloatSliceV(1.5)).m)
+	assert.IsType(t, &RuneMapBool{}, NewSet(NewStrV("abc")).m)
+	assert.IsType(t, &RuneMapBool{}, NewSet([]rune{'a'}).m)
+	assert.IsType(t, &StringMapBool{}, NewSet(&[]string{"a"}).m)
+	assert.IsType(t, &StringMapBool{}, NewSet("abc").m)
+	assert.NotNil(t, NewSet(NewInterSliceV(1, "1")).h)
+	assert.NotNil(t, NewSet([]Integer{{1}}).h)
+
+	// uniq elements
+	assert.Equal(t, []int{1, 2, 3}, NewSet([3]int{3, 1, 3}).AddV(2).Values().O())
+	assert.Equal(t, []rune{'a', 'b', 'c'}, NewSet(NewStrV("abca")).Values().O())
+	assert.Equal(t, 2, NewSet(NewInterSliceV(1, "1", 1)).Len())
+	assert.Equal(t, 1, NewSet(NewMapSliceV(map[string]interface{}{"a": 1}, map[string]interface{}{"a": 1})).Len())
+
+	// element types are retained
+	assert.Equal(t, []int64{1, 2}, NewSet([]int64{2, 1, 2}).Values().O())
+	assert.Equal(t, []uint8{1, 2}, NewSet([]uint8{2, 1}).Values().O())
+	assert.Equal(t, []float32{1.5}, NewSet([]float32{1.5}).Values().O())
+	assert.Equal(t, []time.Duration{time.Millisecond, time.Second}, NewSet([]time.Duration{time.Second, time.Millisecond}).Values().O())
+
+	// sets are passed through
+	set := NewSetV(1)
+	assert.Equal(t, set, NewSet(set))
+}
+
+// NewSetV
+//--------------------------------------------------------------------------------------------------
+func TestNewSetV(t *testing.T) {
+	assert.Equal(t, 0, NewSetV().Len())
+	assert.Equal(t, []int{1, 2}, NewSetV(2, 1, 2).Values().O())
+	assert.Equal(t, []interface{}{1, "2"}, NewSetV("2", 1).Values().O())
+	assert.Equal(t, []Integer{{1}, {2}}, NewSetV(Integer{2}, Integer{1}).Values().O())
+
+	// mixed types
+	set := NewSetV(1, "a", "b", 2.5)
+	assert.Equal(t, 4, set.Len())
+	assert.True(t, set.Contains(1))
+	assert.True(t, set.Contains("a"))
+	assert.True(t, set.Contains(2.5))
+	assert.False(t, set.Contains("1"))
+	assert.Equal(t, []interface{}{1, 2.5, "a", "b"}, set.Values().O())
+}
+
+// Add
+//--------------------------------------------------------------------------------------------------
+func TestSet_Add(t *testing.T) {
+	assert.False(t, (*Set)(nil).Add(1))
+
+	set := NewSetV()
+	assert.True(t, set.Add(1))
+	assert.False(t, set.Add(1))
+	assert.Equal(t, []int{1}, set.Values().O())
+
+	// adding other types falls back on hashing
+	assert.True(t, set.Add("1"))
+	assert.False(t, set.Add("1"))
+	assert.True(t, set.Add(int64(2)))
+	assert.False(t, set.Add(1))
+	assert.Nil(t, set.m)
+	assert.Equal(t, []interface{}{1, "1", int64(2)}, set.Values().O())
+
+	set = NewSetV(Integer{1})
+	assert.True(t, set.Add(Integer{2}))
+	assert.False(t, set.Add(Integer{2}))
+	assert.True(t, set.Add(&Integer{2}))
+	assert.Equal(t, 3, set.Len())
+}
+
+// AddV
+//--------------------------------------------------------------------------------------------------
+func TestSet_AddV(t *testing.T) {
+	assert.Equal(t, (*Set)(nil), (*Set)(nil).AddV(1))
+
+	set := NewSetV()
+	assert.Equal(t, set, set.AddV("b", "a", "b"))
+	assert.Equal(t, []string{"a", "b"}, set.Values().O())
+}
+
+// Clear
+//--------------------------------------------------------------------------------------------------
+func TestSet_Clear(t *testing.T) {
+	assert.Equal(t, (*Set)(nil), (*Set)(nil).Clear())
+
+	set := NewSetV(1, 2)
+	assert.Equal(t, 0, set.Clear().Len())
+	assert.IsType(t, &IntMapBool{}, set.m)
+
+	set = NewSetV(Integer{1})
+	assert.Equal(t, 0, set.Clear().Len())
+	assert.NotNil(t, set.h)
+}
+
+// Contains
+//--------------------------------------------------------------------------------------------------
+func TestSet_Contains(t *testing.T) {
+	assert.False(t, (*Set)(nil).Contains(1))
+	assert.False(t, NewSetV().Contains(1))
+
+	set := NewSetV(1, 2)
+	assert.True(t, set.Contains(1))
+	assert.False(t, set.Contains("2"))
+	assert.False(t, set.Contains(int64(2)))
+	assert.False(t, set.Contains(3))
+	assert.False(t, set.Contains("a"))
+
+	set = NewSet(NewStrV("ab"))
+	assert.True(t, set.Contains('a'))
+	assert.True(t, set.Contains(NewChar("b")))
+	assert.False(t, set.Contains("ab"))
+
+	set = NewSetV(map[string]interface{}{"a": 1, "b": 2})
+	assert.True(t, set.Contains(map[string]interface{}{"b": 2, "a": 1}))
+	assert.False(t, set.Contains(map[string]interface{}{"a": 1}))
+}
+
+// Copy
+//--------------------------------------------------------------------------------------------------
+func TestSet_Copy(t *testing.T) {
+	assert.Equal(t, 0, (*Set)(nil).Copy().Len())
+
+	set := NewSetV(1, 2)
+	cp := set.Copy()
+	cp.Add(3)
+	assert.Equal(t, []int{1, 2}, set.Values().O())
+	assert.Equal(t, []int{1, 2, 3}, cp.Values().O())
+
+	set = NewSetV(Integer{1})
+	cp = set.Copy()
+	cp.Add(Integer{2})
+	assert.Equal(t, 1, set.Len())
+	assert.Equal(t, 2, cp.Len())
+}
+
+// Difference
+//--------------------------------------------------------------------------------------------------
+func ExampleSet_Difference() {
+	installed := NewSetV("vim", "git", "curl")
+	fmt.Println(installed.Difference([]string{"git", "zsh"}))
+	// Output: [curl vim]
+}
+
+func TestSet_Difference(t *testing.T) {
+	assert.Equal(t, 0, (*Set)(nil).Difference([]int{1}).Len())
+
+	set := NewSetV(1, 2, 3)
+	assert.Equal(t, []int{1, 3}, set.Difference(NewSetV(2, 4)).Values().O())
+	assert.Equal(t, []int{1, 2, 3}, set.Difference(nil).Values().O())
+	assert.Equal(t, []int{}, set.Difference(NewIntSliceV(1, 2, 3)).Values().O())
+	assert.Equal(t, 3, set.Len())
+}
+
+// Disjoint
+//--------------------------------------------------------------------------------------------------
+func TestSet_Disjoint(t *testing.T) {
+	assert.True(t, (*Set)(nil).Disjoint([]int{1}))
+	assert.True(t, NewSetV(1, 2).Disjoint(nil))
+	assert.True(t, NewSetV(1, 2).Disjoint([]int{3, 4}))
+	assert.False(t, NewSetV(1, 2).Disjoint(NewSetV(2, 3)))
+}
+
+// Each
+//--------------------------------------------------------------------------------------------------
+func TestSet_Each(t *testing.T) {
+	var results []interface{}
+	(*Set)(nil).Each(func(x O) { results = append(results, x) })
+	assert.Len(t, results, 0)
+
+	NewSetV("c", "a", "b").Each(func(x O) { results = append(results, x) })
+	assert.Equal(t, []interface{}{"a", "b", "c"}, results)
+}
+
+// Empty
+//--------------------------------------------------------------------------------------------------
+func TestSet_Empty(t *testing.T) {
+	assert.True(t, (*Set)(nil).Empty())
+	assert.True(t, NewSetV().Empty())
+	assert.False(t, NewSetV(1).Empty())
+}
+
+// Equal
+//--------------------------------------------------------------------------------------------------
+func TestSet_Equal(t *testing.T) {
+	assert.True(t, (*Set)(nil).Equal(nil))
+	assert.True(t, NewSetV(1, 2).Equal([]int{2, 1, 2}))
+	assert.False(t, NewSetV(1, 2).Equal([]int{1}))
+	assert.False(t, NewSetV(1, 2).Equal([]int{1, 3}))
+}
+
+// Intersect
+//--------------------------------------------------------------------------------------------------
+func ExampleSet_Intersect() {
+	installed := NewSetV("vim", "git", "curl")
+	fmt.Println(installed.Intersect([]string{"git", "zsh", "vim"}))
+	// Output: [git vim]
+}
+
+func TestSet_Intersect(t *testing.T) {
+	assert.Equal(t, 0, (*Set)(nil).Intersect([]int{1}).Len())
+
+	set := NewSetV(1, 2, 3)
+	assert.Equal(t, []int{2}, set.Intersect(NewSetV(2, 4)).Values().O())
+	assert.Equal(t, []int{}, set.Intersect(nil).Values().O())
+	assert.Equal(t, []int{1, 3}, set.Intersect(NewIntSliceV(3, 1)).Values().O())
+	assert.Equal(t, 3, set.Len())
+}
+
+// IsSubset
+//--------------------------------------------------------------------------------------------------
+func TestSet_IsSubset(t *testing.T) {
+	assert.True(t, (*Set)(nil).IsSubset(nil))
+	assert.True(t, (*Set)(nil).IsSubset([]int{1}))
+	assert.True(t, NewSetV(1, 2).IsSubset([]int{1, 2, 3}))
+	assert.True(t, NewSetV(1, 2).IsSubset(NewSetV(1, 2)))
+	assert.False(t, NewSetV(1, 4).IsSubset([]int{1, 2, 3}))
+	assert.False(t, NewSetV(1).IsSubset(nil))
+}
+
+// IsSuperset
+//--------------------------------------------------------------------------------------------------
+func TestSet_IsSuperset(t *testing.T) {
+	assert.True(t, (*Set)(nil).IsSuperset(nil))
+	assert.False(t, (*Set)(nil).IsSuperset([]int{1}))
+	assert.True(t, NewSetV(1, 2, 3).IsSuperset([]int{1, 2}))
+	assert.False(t, NewSetV(1, 2, 3).IsSuperset([]int{1, 4}))
+}
+
+// Len
+//--------------------------------------------------------------------------------------------------
+func TestSet_Len(t *testing.T) {
+	assert.Equal(t, 0, (*Set)(nil).Len())
+	assert.Equal(t, 0, NewSetV().Len())
+	assert.Equal(t, 2, NewSetV(1, 2, 1).Len())
+	assert.Equal(t, 2, NewSetV(Integer{1}, Integer{2}, Integer{1}).Len())
+}
+
+// Nil
+//--------------------------------------------------------------------------------------------------
+func TestSet_Nil(t *testing.T) {
+	assert.True(t, (*Set)(nil).Nil())
+	assert.False(t, NewSetV().Nil())
+}
+
+// Remove
+//--------------------------------------------------------------------------------------------------
+func TestSet_Remove(t *testing.T) {
+	assert.False(t, (*Set)(nil).Remove(1))
+	assert.False(t, NewSetV().Remove(1))
+
+	set := NewSetV(1, 2)
+	assert.True(t, set.Remove(1))
+	assert.False(t, set.Remove(1))
+	assert.Equal(t, []int{2}, set.Values().O())
+
+	set = NewSetV(Integer{1}, Integer{2})
+	assert.True(t, set.Remove(Integer{1}))
+	assert.False(t, set.Remove(Integer{1}))
+	assert.Equal(t, []Integer{{2}}, set.Values().O())
+}
+
+// String
+//--------------------------------------------------------------------------------------------------
+func TestSet_String(t *testing.T) {
+	assert.Equal(t, "[]", (*Set)(nil).String())
+	assert.Equal(t, "[1 2]", NewSetV(2, 1).String())
+	assert.Equal(t, "[a b]", NewSetV("b", "a").String())
+}
+
+// SymmetricDifference
+//--------------------------------------------------------------------------------------------------
+func ExampleSet_SymmetricDifference() {
+	installed := NewSetV("vim", "git", "curl")
+	fmt.Println(installed.SymmetricDifference([]string{"git", "zsh"}))
+	// Output: [curl vim zsh]
+}
+
+func TestSet_SymmetricDifference(t *testing.T) {
+	assert.Equal(t, []int{1, 2}, (*Set)(nil).SymmetricDifference([]int{1, 2}).Values().O())
+
+	set := NewSetV(1, 2, 3)
+	assert.Equal(t, []int{1, 4}, set.SymmetricDifference(NewSetV(2, 3, 4)).Values().O())
+	assert.Equal(t, []int{1, 2, 3}, set.SymmetricDifference(nil).Values().O())
+	assert.Equal(t, []int{}, set.SymmetricDifference([]int{3, 2, 1}).Values().O())
+	assert.Equal(t, 3, set.Len())
+}
+
+// Union
+//--------------------------------------------------------------------------------------------------
+func ExampleSet_Union() {
+	installed := NewSetV("vim", "git")
+	fmt.Println(installed.Union([]string{"git", "zsh"}))
+	// Output: [git vim zsh]
+}
+
+func TestSet_Union(t *testing.T) {
+	assert.Equal(t, []int{1, 2}, (*Set)(nil).Union([]int{2, 1}).Values().O())
+
+	set := NewSetV(1, 2)
+	assert.Equal(t, []int{1, 2, 3}, set.Union(NewSetV(2, 3)).Values().O())
+	assert.Equal(t, []int{1, 2}, set.Union(nil).Values().O())
+	assert.Equal(t, 2, set.Len())
+}
+
+// Values
+//--------------------------------------------------------------------------------------------------
+func TestSet_Values(t *testing.T) {
+	assert.Equal(t, []interface{}{}, (*Set)(nil).Values().O())
+	assert.Equal(t, []float64{1.5, 2.5}, NewSetV(2.5, 1.5).Values().O())
+	assert.Equal(t, []string{"a", "b"}, NewSet(&StringSlice{"b", "a"}).Values().O())
+	assert.Equal(t, []map[string]interface{}{{"a": 1}, {"b": 2}},
+		NewSetV(map[string]interface{}{"b": 2}, map[string]interface{}{"a": 1}).Values().O())
+}
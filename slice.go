@@ -1,6 +1,14 @@
 package n
 
 import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
 	"github.com/pkg/errors"
 )
 
@@ -9,83 +17,103 @@ import (
 // instance being operated on.  'new Slice' refers to a copy of the slice based on a new
 // underlying Array.
 type ISlice interface {
-	A() string                                         // A is an alias to String for brevity
-	All(elems ...interface{}) bool                     // All tests if this Slice is not empty or optionally if it contains all of the given variadic elements.
-	AllS(slice interface{}) bool                       // AnyS tests if this Slice contains all of the given Slice's elements.
-	Any(elems ...interface{}) bool                     // Any tests if this Slice is not empty or optionally if it contains any of the given variadic elements.
-	AnyS(slice interface{}) bool                       // AnyS tests if this Slice contains any of the given Slice's elements.
-	AnyW(sel func(O) bool) bool                        // AnyW tests if this Slice contains any that match the lambda selector.
-	Append(elem interface{}) ISlice                    // Append an element to the end of this Slice and returns a reference to this Slice.
-	AppendV(elems ...interface{}) ISlice               // AppendV appends the variadic elements to the end of this Slice and returns a reference to this Slice.
-	At(i int) (elem *Object)                           // At returns the element at the given index location. Allows for negative notation.
-	Clear() ISlice                                     // Clear modifies this Slice to clear out all elements and returns a reference to this Slice.
-	Concat(slice interface{}) (new ISlice)             // Concat returns a new Slice by appending the given Slice to this Slice using variadic expansion.
-	ConcatM(slice interface{}) ISlice                  // ConcatM modifies this Slice by appending the given Slice using variadic expansion and returns a reference to this Slice.
-	Copy(indices ...int) (new ISlice)                  // Copy returns a new Slice with the indicated range of elements copied from this Slice.
-	Count(elem interface{}) (cnt int)                  // Count the number of elements in this Slice equal to the given element.
-	CountW(sel func(O) bool) (cnt int)                 // CountW counts the number of elements in this Slice that match the lambda selector.
-	Drop(indices ...int) ISlice                        // Drop modifies this Slice to delete the indicated range of elements and returns a referece to this Slice.
-	DropAt(i int) ISlice                               // DropAt modifies this Slice to delete the element at the given index location. Allows for negative notation.
-	DropFirst() ISlice                                 // DropFirst modifies this Slice to delete the first element and returns a reference to this Slice.
-	DropFirstN(n int) ISlice                           // DropFirstN modifies this Slice to delete the first n elements and returns a reference to this Slice.
-	DropLast() ISlice                                  // DropLast modifies this Slice to delete the last element and returns a reference to this Slice.
-	DropLastN(n int) ISlice                            // DropLastN modifies thi Slice to delete the last n elements and returns a reference to this Slice.
-	DropW(sel func(O) bool) ISlice                     // DropW modifies this Slice to delete the elements that match the lambda selector and returns a reference to this Slice.
-	Each(action func(O)) ISlice                        // Each calls the given lambda once for each element in this Slice, passing in that element
-	EachE(action func(O) error) (ISlice, error)        // EachE calls the given lambda once for each element in this Slice, passing in that element
-	EachI(action func(int, O)) ISlice                  // EachI calls the given lambda once for each element in this Slice, passing in the index and element
-	EachIE(action func(int, O) error) (ISlice, error)  // EachIE calls the given lambda once for each element in this Slice, passing in the index and element
-	EachR(action func(O)) ISlice                       // EachR calls the given lambda once for each element in this Slice in reverse, passing in that element
-	EachRE(action func(O) error) (ISlice, error)       // EachRE calls the given lambda once for each element in this Slice in reverse, passing in that element
-	EachRI(action func(int, O)) ISlice                 // EachRI calls the given lambda once for each element in this Slice in reverse, passing in that element
-	EachRIE(action func(int, O) error) (ISlice, error) // EachRIE calls the given lambda once for each element in this Slice in reverse, passing in that element
-	Empty() bool                                       // Empty tests if this Slice is empty.
-	First() (elem *Object)                             // First returns the first element in this Slice as Object.
-	FirstN(n int) ISlice                               // FirstN returns the first n elements in this slice as a Slice reference to the original.
-	InterSlice() bool                                  // Generic returns true if the underlying implementation uses reflection
-	Index(elem interface{}) (loc int)                  // Index returns the index of the first element in this Slice where element == elem
-	Insert(i int, elem interface{}) ISlice             // Insert modifies this Slice to insert the given element(s) before the element with the given index.
-	Join(separator ...string) (str *Object)            // Join converts each element into a string then joins them together using the given separator or comma by default.
-	Last() (elem *Object)                              // Last returns the last element in this Slice as an Object.
-	LastN(n int) ISlice                                // LastN returns the last n elements in this Slice as a Slice reference to the original.
-	Len() int                                          // Len returns the number of elements in this Slice.
-	Less(i, j int) bool                                // Less returns true if the element indexed by i is less than the element indexed by j.
-	Nil() bool                                         // Nil tests if this Slice is nil.
-	Map(mod func(O) O) ISlice                          // Map creates a new slice with the modified elements from the lambda.
-	O() interface{}                                    // O returns the underlying data structure as is.
-	Pair() (first, second *Object)                     // Pair simply returns the first and second Slice elements as Objects.
-	Pop() (elem *Object)                               // Pop modifies this Slice to remove the last element and returns the removed element as an Object.
-	PopN(n int) (new ISlice)                           // PopN modifies this Slice to remove the last n elements and returns the removed elements as a new Slice.
-	Prepend(elem interface{}) ISlice                   // Prepend modifies this Slice to add the given element at the begining and returns a reference to this Slice.
-	RefSlice() bool                                    // RefSlice returns true if the underlying implementation is a RefSlice
-	Reverse() (new ISlice)                             // Reverse returns a new Slice with the order of the elements reversed.
-	ReverseM() ISlice                                  // ReverseM modifies this Slice reversing the order of the elements and returns a reference to this Slice.
-	S() (slice *StringSlice)                           // S is an alias to ToStringSlice
-	Select(sel func(O) bool) (new ISlice)              // Select creates a new slice with the elements that match the lambda selector.
-	Set(i int, elems interface{}) ISlice               // Set the element(s) at the given index location to the given element(s). Allows for negative notation.
-	SetE(i int, elems interface{}) (ISlice, error)     // SetE the element(s) at the given index location to the given element(s). Allows for negative notation.
-	Shift() (elem *Object)                             // Shift modifies this Slice to remove the first element and returns the removed element as an Object.
-	ShiftN(n int) (new ISlice)                         // ShiftN modifies this Slice to remove the first n elements and returns the removed elements as a new Slice.
-	Single() bool                                      // Single reports true if there is only one element in this Slice.
-	Slice(indices ...int) ISlice                       // Slice returns a range of elements from this Slice as a Slice reference to the original. Allows for negative notation.
-	Sort() (new ISlice)                                // Sort returns a new Slice with sorted elements.
-	SortM() ISlice                                     // SortM modifies this Slice sorting the elements and returns a reference to this Slice.
-	SortReverse() (new ISlice)                         // SortReverse returns a new Slice sorting the elements in reverse.
-	SortReverseM() ISlice                              // SortReverseM modifies this Slice sorting the elements in reverse and returns a reference to this Slice.
-	String() string                                    // String returns a string representation of this Slice, implements the Stringer interface
-	Swap(i, j int)                                     // Swap modifies this Slice swapping the indicated elements.
-	Take(indices ...int) (new ISlice)                  // Take modifies this Slice removing the indicated range of elements from this Slice and returning them as a new Slice.
-	TakeAt(i int) (elem *Object)                       // TakeAt modifies this Slice removing the elemement at the given index location and returns the removed element as an Object.
-	TakeW(sel func(O) bool) (new ISlice)               // TakeW modifies this Slice removing the elements that match the lambda selector and returns them as a new Slice.
-	ToInts() (slice []int)                             // ToInts converts the given slice into a native []int type
-	ToIntSlice() (slice *IntSlice)                     // ToIntSlice converts the given slice into a *IntSlice
-	ToInterSlice() (slice []interface{})               // ToInterSlice converts the given slice to a generic []interface{} slice
-	ToStrs() (slice []string)                          // ToStrs converts the underlying slice into a []string slice
-	ToStringSlice() (slice *StringSlice)               // ToStringSlice converts the underlying slice into a *StringSlice
-	Union(slice interface{}) (new ISlice)              // Union returns a new Slice by joining uniq elements from this Slice with uniq elements from the given Slice while preserving order.
-	UnionM(slice interface{}) ISlice                   // UnionM modifies this Slice by joining uniq elements from this Slice with uniq elements from the given Slice while preserving order.
-	Uniq() (new ISlice)                                // Uniq returns a new Slice with all non uniq elements removed while preserving element order.
-	UniqM() ISlice                                     // UniqM modifies this Slice to remove all non uniq elements while preserving element order.
+	A() string                                                                                                    // A is an alias to String for brevity
+	All(elems ...interface{}) bool                                                                                // All tests if this Slice is not empty or optionally if it contains all of the given variadic elements.
+	AllS(slice interface{}) bool                                                                                  // AnyS tests if this Slice contains all of the given Slice's elements.
+	Any(elems ...interface{}) bool                                                                                // Any tests if this Slice is not empty or optionally if it contains any of the given variadic elements.
+	AnyS(slice interface{}) bool                                                                                  // AnyS tests if this Slice contains any of the given Slice's elements.
+	AnyW(sel func(O) bool) bool                                                                                   // AnyW tests if this Slice contains any that match the lambda selector.
+	Append(elem interface{}) ISlice                                                                               // Append an element to the end of this Slice and returns a reference to this Slice.
+	AppendV(elems ...interface{}) ISlice                                                                          // AppendV appends the variadic elements to the end of this Slice and returns a reference to this Slice.
+	At(i int) (elem *Object)                                                                                      // At returns the element at the given index location. Allows for negative notation.
+	Chunk(n int) (chunks ISlice)                                                                                  // Chunk creates a new slice of Slices each holding n elements copied from this Slice in order.
+	Clear() ISlice                                                                                                // Clear modifies this Slice to clear out all elements and returns a reference to this Slice.
+	Concat(slice interface{}) (new ISlice)                                                                        // Concat returns a new Slice by appending the given Slice to this Slice using variadic expansion.
+	ConcatM(slice interface{}) ISlice                                                                             // ConcatM modifies this Slice by appending the given Slice using variadic expansion and returns a reference to this Slice.
+	Copy(indices ...int) (new ISlice)                                                                             // Copy returns a new Slice with the indicated range of elements copied from this Slice.
+	Count(elem interface{}) (cnt int)                                                                             // Count the number of elements in this Slice equal to the given element.
+	CountW(sel func(O) bool) (cnt int)                                                                            // CountW counts the number of elements in this Slice that match the lambda selector.
+	Difference(slice interface{}) (new ISlice)                                                                    // Difference returns a new Slice with the uniq elements of this Slice that are not in the given Slice while preserving order.
+	Disjoint(slice interface{}) bool                                                                              // Disjoint checks if this Slice has no elements in common with the given Slice.
+	Drop(indices ...int) ISlice                                                                                   // Drop modifies this Slice to delete the indicated range of elements and returns a referece to this Slice.
+	DropAt(i int) ISlice                                                                                          // DropAt modifies this Slice to delete the element at the given index location. Allows for negative notation.
+	DropFirst() ISlice                                                                                            // DropFirst modifies this Slice to delete the first element and returns a reference to this Slice.
+	DropFirstN(n int) ISlice                                                                                      // DropFirstN modifies this Slice to delete the first n elements and returns a reference to this Slice.
+	DropLast() ISlice                                                                                             // DropLast modifies this Slice to delete the last element and returns a reference to this Slice.
+	DropLastN(n int) ISlice                                                                                       // DropLastN modifies thi Slice to delete the last n elements and returns a reference to this Slice.
+	DropW(sel func(O) bool) ISlice                                                                                // DropW modifies this Slice to delete the elements that match the lambda selector and returns a reference to this Slice.
+	Each(action func(O)) ISlice                                                                                   // Each calls the given lambda once for each element in this Slice, passing in that element
+	EachE(action func(O) error) (ISlice, error)                                                                   // EachE calls the given lambda once for each element in this Slice, passing in that element
+	EachI(action func(int, O)) ISlice                                                                             // EachI calls the given lambda once for each element in this Slice, passing in the index and element
+	EachIE(action func(int, O) error) (ISlice, error)                                                             // EachIE calls the given lambda once for each element in this Slice, passing in the index and element
+	EachP(ctx context.Context, workers int, action func(context.Context, O) error) (ISlice, error)                // EachP calls the given lambda once for each element in this Slice in parallel using a bounded pool of workers.
+	EachR(action func(O)) ISlice                                                                                  // EachR calls the given lambda once for each element in this Slice in reverse, passing in that element
+	EachRE(action func(O) error) (ISlice, error)                                                                  // EachRE calls the given lambda once for each element in this Slice in reverse, passing in that element
+	EachRI(action func(int, O)) ISlice                                                                            // EachRI calls the given lambda once for each element in this Slice in reverse, passing in that element
+	EachRIE(action func(int, O) error) (ISlice, error)                                                            // EachRIE calls the given lambda once for each element in this Slice in reverse, passing in that element
+	Empty() bool                                                                                                  // Empty tests if this Slice is empty.
+	FlatMap(mod func(O) O) ISlice                                                                                 // FlatMap creates a new slice with the modified elements from the lambda expanding any slice results one level.
+	Flatten() (new ISlice)                                                                                        // Flatten returns a new Slice with any nested slice elements expanded one level into it.
+	First() (elem *Object)                                                                                        // First returns the first element in this Slice as Object.
+	FirstN(n int) ISlice                                                                                          // FirstN returns the first n elements in this slice as a Slice reference to the original.
+	GroupBy(key func(O) O) (groups IMap)                                                                          // GroupBy creates a new map of Slices with the elements of this Slice grouped by the lambda key.
+	InterSlice() bool                                                                                             // Generic returns true if the underlying implementation uses reflection
+	Index(elem interface{}) (loc int)                                                                             // Index returns the index of the first element in this Slice where element == elem
+	Insert(i int, elem interface{}) ISlice                                                                        // Insert modifies this Slice to insert the given element(s) before the element with the given index.
+	Intersect(slice interface{}) (new ISlice)                                                                     // Intersect returns a new Slice with the uniq elements of this Slice that are also in the given Slice while preserving order.
+	Iter() (it Iterator)                                                                                          // Iter returns an Iterator over the elements of this Slice in order.
+	IsSubset(slice interface{}) bool                                                                              // IsSubset checks if all elements of this Slice are in the given Slice.
+	Join(separator ...string) (str *Object)                                                                       // Join converts each element into a string then joins them together using the given separator or comma by default.
+	Last() (elem *Object)                                                                                         // Last returns the last element in this Slice as an Object.
+	LastN(n int) ISlice                                                                                           // LastN returns the last n elements in this Slice as a Slice reference to the original.
+	Len() int                                                                                                     // Len returns the number of elements in this Slice.
+	Less(i, j int) bool                                                                                           // Less returns true if the element indexed by i is less than the element indexed by j.
+	Nil() bool                                                                                                    // Nil tests if this Slice is nil.
+	Map(mod func(O) O) ISlice                                                                                     // Map creates a new slice with the modified elements from the lambda.
+	MapP(ctx context.Context, workers int, mod func(context.Context, O) (O, error)) (ISlice, error)               // MapP creates a new slice with the modified elements from the lambda in parallel using a bounded pool of workers.
+	O() interface{}                                                                                               // O returns the underlying data structure as is.
+	Pair() (first, second *Object)                                                                                // Pair simply returns the first and second Slice elements as Objects.
+	Partition(sel func(O) bool) (match, rest ISlice)                                                              // Partition creates two new slices with the elements that match the lambda selector and those that don't.
+	Pop() (elem *Object)                                                                                          // Pop modifies this Slice to remove the last element and returns the removed element as an Object.
+	PopN(n int) (new ISlice)                                                                                      // PopN modifies this Slice to remove the last n elements and returns the removed elements as a new Slice.
+	Prepend(elem interface{}) ISlice                                                                              // Prepend modifies this Slice to add the given element at the begining and returns a reference to this Slice.
+	RefSlice() bool                                                                                               // RefSlice returns true if the underlying implementation is a RefSlice
+	Reverse() (new ISlice)                                                                                        // Reverse returns a new Slice with the order of the elements reversed.
+	ReverseM() ISlice                                                                                             // ReverseM modifies this Slice reversing the order of the elements and returns a reference to this Slice.
+	S() (slice *StringSlice)                                                                                      // S is an alias to ToStringSlice
+	Select(sel func(O) bool) (new ISlice)                                                                         // Select creates a new slice with the elements that match the lambda selector.
+	SelectP(ctx context.Context, workers int, sel func(context.Context, O) (bool, error)) (new ISlice, err error) // SelectP creates a new slice with the elements that match the lambda selector in parallel using a bounded pool of workers.
+	Set(i int, elems interface{}) ISlice                                                                          // Set the element(s) at the given index location to the given element(s). Allows for negative notation.
+	SetE(i int, elems interface{}) (ISlice, error)                                                                // SetE the element(s) at the given index location to the given element(s). Allows for negative notation.
+	Shift() (elem *Object)                                                                                        // Shift modifies this Slice to remove the first element and returns the removed element as an Object.
+	ShiftN(n int) (new ISlice)                                                                                    // ShiftN modifies this Slice to remove the first n elements and returns the removed elements as a new Slice.
+	Single() bool                                                                                                 // Single reports true if there is only one element in this Slice.
+	Slice(indices ...int) ISlice                                                                                  // Slice returns a range of elements from this Slice as a Slice reference to the original. Allows for negative notation.
+	Sort() (new ISlice)                                                                                           // Sort returns a new Slice with sorted elements.
+	SortBy(less func(a, b O) bool) (new ISlice)                                                                   // SortBy returns a new Slice with the elements sorted using the given less lambda.
+	SortByM(less func(a, b O) bool) ISlice                                                                        // SortByM modifies this Slice sorting the elements using the given less lambda and returns a reference to this Slice.
+	SortM() ISlice                                                                                                // SortM modifies this Slice sorting the elements and returns a reference to this Slice.
+	SortReverse() (new ISlice)                                                                                    // SortReverse returns a new Slice sorting the elements in reverse.
+	SortReverseM() ISlice                                                                                         // SortReverseM modifies this Slice sorting the elements in reverse and returns a reference to this Slice.
+	SortStable(less func(a, b O) bool) (new ISlice)                                                               // SortStable returns a new Slice with the elements sorted using the given less lambda keeping equal elements in order.
+	SortStableM(less func(a, b O) bool) ISlice                                                                    // SortStableM modifies this Slice sorting the elements using the given less lambda keeping equal elements in order.
+	String() string                                                                                               // String returns a string representation of this Slice, implements the Stringer interface
+	SymmetricDifference(slice interface{}) (new ISlice)                                                           // SymmetricDifference returns a new Slice with the uniq elements in either this Slice or the given Slice but not in both while preserving order.
+	Swap(i, j int)                                                                                                // Swap modifies this Slice swapping the indicated elements.
+	Take(indices ...int) (new ISlice)                                                                             // Take modifies this Slice removing the indicated range of elements from this Slice and returning them as a new Slice.
+	TakeAt(i int) (elem *Object)                                                                                  // TakeAt modifies this Slice removing the elemement at the given index location and returns the removed element as an Object.
+	TakeW(sel func(O) bool) (new ISlice)                                                                          // TakeW modifies this Slice removing the elements that match the lambda selector and returns them as a new Slice.
+	ToInts() (slice []int)                                                                                        // ToInts converts the given slice into a native []int type
+	ToIntSlice() (slice *IntSlice)                                                                                // ToIntSlice converts the given slice into a *IntSlice
+	ToInterSlice() (slice []interface{})                                                                          // ToInterSlice converts the given slice to a generic []interface{} slice
+	ToStrs() (slice []string)                                                                                     // ToStrs converts the underlying slice into a []string slice
+	ToStringSlice() (slice *StringSlice)                                                                          // ToStringSlice converts the underlying slice into a *StringSlice
+	Union(slice interface{}) (new ISlice)                                                                         // Union returns a new Slice by joining uniq elements from this Slice with uniq elements from the given Slice while preserving order.
+	UnionM(slice interface{}) ISlice                                                                              // UnionM modifies this Slice by joining uniq elements from this Slice with uniq elements from the given Slice while preserving order.
+	Uniq() (new ISlice)                                                                                           // Uniq returns a new Slice with all non uniq elements removed while preserving element order.
+	UniqM() ISlice                                                                                                // UniqM modifies this Slice to remove all non uniq elements while preserving element order.
+	Window(n, step int) (windows ISlice)                                                                          // Window creates a new slice of Slices each holding n consecutive elements starting at every step elements.
+	Zip(slice interface{}) (new ISlice)                                                                           // Zip creates a new slice of pairs combining each element of this Slice with the element at the same index in the given Slice.
 }
 
 // Slice provides a generic way to work with Slice types. It does this by wrapping Go types
@@ -93,7 +121,7 @@ type ISlice interface {
 // of Slice methods available. Non optimized types will fall back on reflection to generically
 // handle the type incurring the full 10x reflection processing overhead.
 //
-// Optimized: []int, []string, StrSlice
+// Optimized: []bool, []int, []string, []time.Duration, []time.Time, StrSlice
 func Slice(obj interface{}) (new ISlice) {
 	ref := Reference(obj)
 	switch o := ref.(type) {
@@ -106,6 +134,16 @@ func Slice(obj interface{}) (new ISlice) {
 			item := Reference((*x)[0])
 			switch item.(type) {
 
+			// BoolSlice
+			// ---------------------------------------------------------------------------------------------
+			case *bool:
+				new = ToBoolSlice(*x)
+
+			// DurationSlice
+			// ---------------------------------------------------------------------------------------------
+			case time.Duration, *time.Duration:
+				new = ToDurationSlice(*x)
+
 			// FloatSlice
 			// ---------------------------------------------------------------------------------------------
 			case *float32, *float64:
@@ -126,6 +164,11 @@ func Slice(obj interface{}) (new ISlice) {
 			case *map[string]interface{}, *map[string]string:
 				new = ToMapSlice(*x)
 
+			// TimeSlice
+			// ---------------------------------------------------------------------------------------------
+			case time.Time, *time.Time:
+				new = ToTimeSlice(*x)
+
 			// RefSlice
 			// ---------------------------------------------------------------------------------------------
 			default:
@@ -136,6 +179,16 @@ func Slice(obj interface{}) (new ISlice) {
 			return
 		}
 
+	// BoolSlice
+	// ---------------------------------------------------------------------------------------------
+	case *bool, *[]bool, *[]*bool:
+		new = ToBoolSlice(o)
+
+	// DurationSlice
+	// ---------------------------------------------------------------------------------------------
+	case time.Duration, *time.Duration, []time.Duration, *[]time.Duration:
+		new = ToDurationSlice(o)
+
 	// FloatSlice
 	// ---------------------------------------------------------------------------------------------
 	case *float32, *float64, *[]float32, *[]float64, *[]*float32, *[]*float64:
@@ -165,6 +218,11 @@ func Slice(obj interface{}) (new ISlice) {
 	case *MapSlice, *map[string]interface{}, *map[string]string, *[]map[string]interface{}, *[]map[string]string:
 		new = ToMapSlice(o)
 
+	// TimeSlice
+	// ---------------------------------------------------------------------------------------------
+	case time.Time, *time.Time, []time.Time, *[]time.Time:
+		new = ToTimeSlice(o)
+
 	// RefSlice
 	// ---------------------------------------------------------------------------------------------
 	default:
@@ -178,13 +236,23 @@ func Slice(obj interface{}) (new ISlice) {
 // on reflection to generically handle the type incurring the full 10x reflection processing
 // overhead. In the case where nothing is given a new *RefSlice will be returned.
 //
-// Optimized: []int, []string, Str
+// Optimized: []bool, []int, []string, []time.Duration, []time.Time, Str
 func NewSliceV(elems ...interface{}) (new ISlice) {
 	if len(elems) == 0 {
 		new = NewRefSliceV(elems...)
 	} else {
 		switch Reference(elems[0]).(type) {
 
+		// BoolSlice
+		// -----------------------------------------------------------------------------------------
+		case *bool:
+			new, _ = ToBoolSliceE(elems)
+
+		// DurationSlice
+		// -----------------------------------------------------------------------------------------
+		case time.Duration, *time.Duration:
+			new, _ = ToDurationSliceE(elems)
+
 		// FloatSlice
 		// ---------------------------------------------------------------------------------------------
 		case *float32, *float64, *[]float32, *[]float64, *[]*float32, *[]*float64:
@@ -205,6 +273,11 @@ func NewSliceV(elems ...interface{}) (new ISlice) {
 		case *Char, *rune, *byte:
 			new = ToStr(elems)
 
+		// TimeSlice
+		// -----------------------------------------------------------------------------------------
+		case time.Time, *time.Time:
+			new, _ = ToTimeSliceE(elems)
+
 		// RefSlice
 		// -----------------------------------------------------------------------------------------
 		default:
@@ -214,6 +287,261 @@ func NewSliceV(elems ...interface{}) (new ISlice) {
 	return
 }
 
+// eachP calls the given lambda once for each index in the range [0, n) fanning the calls out to
+// the given number of workers or runtime.NumCPU() workers if less than 1. The first error from the
+// lambda or the given context cancels the context passed to the lambda, skips the indices not yet
+// started and is returned once all running lambdas have completed.
+func eachP(ctx context.Context, n, workers int, action func(context.Context, int) error) (err error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err = ctx.Err(); err != nil || n == 0 {
+		return
+	}
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+	if workers > n {
+		workers = n
+	}
+	wctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Record the first error and cancel the remaining work
+	var once sync.Once
+	fail := func(e error) {
+		once.Do(func() {
+			err = e
+			cancel()
+		})
+	}
+
+	// Start the workers
+	var wg sync.WaitGroup
+	indices := make(chan int)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				if wctx.Err() != nil {
+					continue
+				}
+				if e := action(wctx, i); e != nil {
+					fail(e)
+				}
+			}
+		}()
+	}
+
+	// Feed the workers until done or cancelled
+	for i := 0; i < n && wctx.Err() == nil; i++ {
+		select {
+		case indices <- i:
+		case <-wctx.Done():
+		}
+	}
+	close(indices)
+	wg.Wait()
+
+	// Cancellation of the given context is reported if the lambdas didn't fail first
+	if e := ctx.Err(); e != nil {
+		fail(e)
+	}
+	return
+}
+
+// sortKey is a single key of a multi-key sort
+type sortKey struct {
+	name string // name of the map key or struct field
+	desc bool   // sort in descending order
+}
+
+// sortKeysLess creates a less lambda comparing elements by the values of the given keys in order
+// where a key prefixed with a '-' sorts in descending order. Keys resolve to map values or struct
+// fields, dereferencing pointers along the way, and missing values sort first. Struct fields are
+// resolved the same way Decode does i.e. by tag name then falling back on a case insensitive match.
+func sortKeysLess(keys []string) func(a, b O) bool {
+	x := make([]sortKey, len(keys))
+	for i := range keys {
+		x[i] = sortKey{name: strings.TrimPrefix(keys[i], "-"), desc: strings.HasPrefix(keys[i], "-")}
+	}
+	fields := map[reflect.Type][]bindField{}
+	return func(a, b O) bool {
+		for i := range x {
+			cmp := compareValues(sortKeyValue(a, x[i].name, fields), sortKeyValue(b, x[i].name, fields))
+			if cmp != 0 {
+				return (cmp < 0) != x[i].desc
+			}
+		}
+		return false
+	}
+}
+
+// sortKeyValue returns the value of the given map key or struct field from the given element or
+// an invalid value if it doesn't exist. The bindable fields of struct types are cached in fields.
+func sortKeyValue(elem interface{}, key string, fields map[reflect.Type][]bindField) (val reflect.Value) {
+	v := indirectValue(reflect.ValueOf(elem))
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() == reflect.String {
+			val = v.MapIndex(reflect.ValueOf(key).Convert(v.Type().Key()))
+		}
+	case reflect.Struct:
+		typ := v.Type()
+		if _, ok := fields[typ]; !ok {
+			fields[typ] = bindFields(typ)
+		}
+		val = sortFieldValue(v, fields[typ], key)
+	}
+	return indirectValue(val)
+}
+
+// sortFieldValue returns the value of the struct field bound to the given key preferring an exact
+// match of the key name over a case insensitive one. Falls back on the exact field name to allow
+// sorting by unexported fields or returns an invalid value if there is no such field.
+func sortFieldValue(v reflect.Value, fields []bindField, key string) (val reflect.Value) {
+	for _, exact := range []bool{true, false} {
+		for i := range fields {
+			if (exact && fields[i].name == key) || (!exact && strings.EqualFold(fields[i].name, key)) {
+				if x, ok := fieldByIndexE(v, fields[i].index); ok {
+					val = x
+				}
+				return
+			}
+		}
+	}
+	return v.FieldByName(key)
+}
+
+// indirectValue unwraps interfaces and dereferences pointers returning an invalid value for nils
+func indirectValue(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// compareValues returns -1, 0 or 1 if a is less than, equal to or greater than b. Invalid values
+// sort first, numbers are compared by value regardless of their kind, times chronologically and
+// all others by their string representation.
+func compareValues(a, b reflect.Value) int {
+	switch {
+	case !a.IsValid() && !b.IsValid():
+		return 0
+	case !a.IsValid():
+		return -1
+	case !b.IsValid():
+		return 1
+	}
+
+	// Numbers of different kinds are compared as floats
+	ka, kb := valueKind(a), valueKind(b)
+	switch {
+	case ka == reflect.Int && kb == reflect.Int:
+		return compareOrdered(a.Int() < b.Int(), a.Int() > b.Int())
+	case ka == reflect.Uint && kb == reflect.Uint:
+		return compareOrdered(a.Uint() < b.Uint(), a.Uint() > b.Uint())
+	case ka != reflect.Invalid && kb != reflect.Invalid:
+		x, y := valueFloat(a), valueFloat(b)
+		return compareOrdered(x < y, x > y)
+	}
+
+	if a.Kind() == b.Kind() {
+		switch a.Kind() {
+		case reflect.Bool:
+			return compareOrdered(!a.Bool() && b.Bool(), a.Bool() && !b.Bool())
+		case reflect.String:
+			return strings.Compare(a.String(), b.String())
+		}
+		if a.Type() == timeType && b.Type() == timeType && a.CanInterface() && b.CanInterface() {
+			x, y := a.Interface().(time.Time), b.Interface().(time.Time)
+			return compareOrdered(x.Before(y), x.After(y))
+		}
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+// compareOrdered converts the given less and greater results into -1, 0 or 1
+func compareOrdered(less, greater bool) int {
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	}
+	return 0
+}
+
+// valueFloat returns the given numeric value as a float64
+func valueFloat(v reflect.Value) float64 {
+	switch valueKind(v) {
+	case reflect.Int:
+		return float64(v.Int())
+	case reflect.Uint:
+		return float64(v.Uint())
+	}
+	return v.Float()
+}
+
+// valueKind groups the numeric kinds of the given value into reflect.Int, reflect.Uint or
+// reflect.Float64 and returns reflect.Invalid for all other kinds.
+func valueKind(v reflect.Value) reflect.Kind {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.Int
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return reflect.Uint
+	case reflect.Float32, reflect.Float64:
+		return reflect.Float64
+	}
+	return reflect.Invalid
+}
+
+// flatAppend appends the given object to the given values expanding it one level if it is a slice
+func flatAppend(vals []interface{}, obj interface{}) []interface{} {
+	if x, ok := obj.(ISlice); ok {
+		obj = x.O()
+	}
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return append(vals, obj)
+	}
+	for i := 0; i < v.Len(); i++ {
+		vals = append(vals, v.Index(i).Interface())
+	}
+	return vals
+}
+
+// newSliceOf creates a new Slice from the given values when they all share the same non slice type
+// or an *InterSlice of the values otherwise. Values of the given empty Slice's element type are
+// returned as that Slice type. Returns the given empty Slice if there are no values.
+func newSliceOf(vals []interface{}, empty ISlice) (new ISlice) {
+	if len(vals) == 0 {
+		return empty
+	}
+	typ := reflect.TypeOf(vals[0])
+	for i := range vals {
+		if typ == nil || reflect.TypeOf(vals[i]) != typ {
+			return NewInterSlice(vals)
+		}
+	}
+	if _, ok := vals[0].(ISlice); ok || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array {
+		return NewInterSlice(vals)
+	}
+	v := reflect.MakeSlice(reflect.SliceOf(typ), 0, len(vals))
+	for i := range vals {
+		v = reflect.Append(v, reflect.ValueOf(vals[i]))
+	}
+	if reflect.TypeOf(empty.O()) == v.Type() {
+		return empty.Concat(v.Interface())
+	}
+	return Slice(v.Interface())
+}
+
 // simply pass positive values through and convert negative to positive
 func abs(i int) (abs int) {
 	if i < 0 {
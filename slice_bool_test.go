@@ -0,0 +1,2034 @@
+// Code generated by "nubgen -type=bool,byte,time.Duration,time.Time -test"; DO NOT EDIT.
+
+package n
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// NewBoolSlice
+//--------------------------------------------------------------------------------------------------
+func ExampleNewBoolSlice() {
+	slice := NewBoolSlice([]bool{false, true})
+	fmt.Println(slice)
+	// Output: [false true]
+}
+
+func TestBoolSlice_NewBoolSlice(t *testing.T) {
+
+	// array
+	{
+		var array [2]bool
+		array[0] = false
+		array[1] = true
+		assert.Equal(t, []bool{false, true}, NewBoolSlice(array).O())
+		assert.Equal(t, []bool{false, true}, NewBoolSlice(array[:]).O())
+	}
+
+	// empty
+	{
+		assert.Equal(t, []bool{}, NewBoolSlice(nil).O())
+		assert.Equal(t, []bool{}, NewBoolSlice([]bool{}).O())
+	}
+
+	// conversion
+	{
+		assert.Equal(t, []bool{false}, NewBoolSlice(false).O())
+		assert.Equal(t, []bool{false}, NewBoolSlice("false").O())
+		assert.Equal(t, []bool{false, true}, NewBoolSlice([]interface{}{"false", true}).O())
+		assert.Equal(t, []bool{}, NewBoolSlice("foo").O())
+	}
+}
+
+// NewBoolSliceV
+//--------------------------------------------------------------------------------------------------
+func ExampleNewBoolSliceV() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice)
+	// Output: [false true]
+}
+
+func TestBoolSlice_NewBoolSliceV(t *testing.T) {
+	assert.Equal(t, []bool{}, NewBoolSliceV().O())
+	assert.Equal(t, []bool{false}, NewBoolSliceV(false).O())
+	assert.Equal(t, []bool{false, true}, NewBoolSliceV(false, true).O())
+}
+
+// A
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_A() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.A())
+	// Output: [false true]
+}
+
+func TestBoolSlice_A(t *testing.T) {
+	assert.Equal(t, "[]", (*BoolSlice)(nil).A())
+	assert.Equal(t, "[false true]", NewBoolSliceV(false, true).A())
+}
+
+// All
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_All() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.All(false, true))
+	// Output: true
+}
+
+func TestBoolSlice_All(t *testing.T) {
+	var slice *BoolSlice
+	assert.False(t, slice.All())
+	assert.False(t, NewBoolSliceV().All(false))
+	assert.True(t, NewBoolSliceV(false).All())
+	assert.True(t, NewBoolSliceV(false, true).All(false, true))
+	assert.False(t, NewBoolSliceV(false).All(false, true))
+	assert.False(t, NewBoolSliceV(false).All("foo"))
+}
+
+// AllS
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_AllS() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.AllS([]bool{false, true}))
+	// Output: true
+}
+
+func TestBoolSlice_AllS(t *testing.T) {
+	var slice *BoolSlice
+	assert.False(t, slice.AllS([]bool{false}))
+	assert.False(t, NewBoolSliceV().AllS([]bool{false}))
+	assert.True(t, NewBoolSliceV(false, true).AllS([]bool{false, true}))
+	assert.True(t, NewBoolSliceV(false, true).AllS(NewBoolSliceV(true)))
+	assert.False(t, NewBoolSliceV(false).AllS([]bool{false, true}))
+	assert.True(t, NewBoolSliceV(false).AllS(nil))
+}
+
+// Any
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Any() {
+	slice := NewBoolSliceV(false)
+	fmt.Println(slice.Any(false, true))
+	// Output: true
+}
+
+func TestBoolSlice_Any(t *testing.T) {
+	var slice *BoolSlice
+	assert.False(t, slice.Any())
+	assert.False(t, NewBoolSliceV().Any(false))
+	assert.True(t, NewBoolSliceV(false).Any())
+	assert.True(t, NewBoolSliceV(false).Any(false, true))
+	assert.False(t, NewBoolSliceV(false).Any(true))
+	assert.False(t, NewBoolSliceV(false).Any("foo"))
+}
+
+// AnyS
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_AnyS() {
+	slice := NewBoolSliceV(false)
+	fmt.Println(slice.AnyS([]bool{false, true}))
+	// Output: true
+}
+
+func TestBoolSlice_AnyS(t *testing.T) {
+	var slice *BoolSlice
+	assert.False(t, slice.AnyS([]bool{false}))
+	assert.False(t, NewBoolSliceV().AnyS([]bool{false}))
+	assert.True(t, NewBoolSliceV(false).AnyS([]bool{false, true}))
+	assert.True(t, NewBoolSliceV(false, true).AnyS(NewBoolSliceV(true)))
+	assert.False(t, NewBoolSliceV(false).AnyS([]bool{true}))
+	assert.False(t, NewBoolSliceV(false).AnyS(nil))
+}
+
+// AnyW
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_AnyW() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.AnyW(func(x O) bool {
+		return x.(bool) == true
+	}))
+	// Output: true
+}
+
+func TestBoolSlice_AnyW(t *testing.T) {
+	var slice *BoolSlice
+	assert.False(t, slice.AnyW(func(x O) bool { return true }))
+	assert.False(t, NewBoolSliceV().AnyW(func(x O) bool { return true }))
+	assert.True(t, NewBoolSliceV(false, true).AnyW(func(x O) bool { return x.(bool) == true }))
+	assert.False(t, NewBoolSliceV(false).AnyW(func(x O) bool { return x.(bool) == true }))
+}
+
+// Append
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Append() {
+	slice := NewBoolSliceV(false)
+	fmt.Println(slice.Append(true))
+	// Output: [false true]
+}
+
+func TestBoolSlice_Append(t *testing.T) {
+
+	// nil
+	{
+		var slice *BoolSlice
+		assert.Equal(t, NewBoolSliceV(false), slice.Append(false))
+		assert.Equal(t, (*BoolSlice)(nil), slice)
+	}
+
+	// modifies in place
+	{
+		slice := NewBoolSliceV(false)
+		assert.Equal(t, NewBoolSliceV(false, true), slice.Append(true))
+		assert.Equal(t, NewBoolSliceV(false, true), slice)
+	}
+
+	// conversion
+	{
+		assert.Equal(t, NewBoolSliceV(false, true), NewBoolSliceV(false).Append("true"))
+		assert.Equal(t, NewBoolSliceV(false), NewBoolSliceV(false).Append("foo"))
+	}
+}
+
+// AppendV
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_AppendV() {
+	slice := NewBoolSliceV(false)
+	fmt.Println(slice.AppendV(true, false))
+	// Output: [false true false]
+}
+
+func TestBoolSlice_AppendV(t *testing.T) {
+
+	// nil
+	{
+		var slice *BoolSlice
+		assert.Equal(t, NewBoolSliceV(false, true), slice.AppendV(false, true))
+		assert.Equal(t, (*BoolSlice)(nil), slice)
+	}
+
+	// modifies in place
+	{
+		slice := NewBoolSliceV(false)
+		assert.Equal(t, NewBoolSliceV(false, true, false), slice.AppendV(true, "false"))
+		assert.Equal(t, NewBoolSliceV(false, true, false), slice)
+	}
+}
+
+// At
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_At() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.At(-1).O())
+	// Output: true
+}
+
+func TestBoolSlice_At(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, Obj(nil), slice.At(0))
+	assert.Equal(t, Obj(nil), NewBoolSliceV().At(0))
+	assert.Equal(t, Obj(false), NewBoolSliceV(false, true).At(0))
+	assert.Equal(t, Obj(true), NewBoolSliceV(false, true).At(1))
+	assert.Equal(t, Obj(true), NewBoolSliceV(false, true).At(-1))
+	assert.Equal(t, Obj(false), NewBoolSliceV(false, true).At(-2))
+	assert.Equal(t, Obj(nil), NewBoolSliceV(false, true).At(2))
+	assert.Equal(t, Obj(nil), NewBoolSliceV(false, true).At(-3))
+}
+
+// BinarySearch
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_BinarySearch() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.BinarySearch(true))
+	// Output: 1 true
+}
+
+func TestBoolSlice_BinarySearch(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *BoolSlice
+		i, found := slice.BinarySearch(false)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+		i, found = NewBoolSliceV().BinarySearch(false)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// found
+	{
+		i, found := NewBoolSliceV(false, true).BinarySearch(false)
+		assert.Equal(t, 0, i)
+		assert.True(t, found)
+		i, found = NewBoolSliceV(false, true).BinarySearch("true")
+		assert.Equal(t, 1, i)
+		assert.True(t, found)
+	}
+
+	// not found returns the insertion point
+	{
+		i, found := NewBoolSliceV(false).BinarySearch(true)
+		assert.Equal(t, 1, i)
+		assert.False(t, found)
+		i, found = NewBoolSliceV(true).BinarySearch(false)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// invalid element
+	{
+		i, found := NewBoolSliceV(false).BinarySearch("foo")
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+}
+
+// Chunk
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Chunk() {
+	slice := NewBoolSliceV(false, true, false)
+	slice.Chunk(2).Each(func(x O) {
+		fmt.Print(x)
+	})
+	// Output: [false true][false]
+}
+
+func TestBoolSlice_Chunk(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *BoolSlice
+		assert.Equal(t, 0, slice.Chunk(2).Len())
+		assert.Equal(t, 0, NewBoolSliceV().Chunk(2).Len())
+		assert.Equal(t, 0, NewBoolSliceV(false).Chunk(0).Len())
+	}
+
+	// remainder in the last chunk
+	{
+		slice := NewBoolSliceV(false, true, false)
+		chunks := slice.Chunk(2)
+		assert.Equal(t, 2, chunks.Len())
+		assert.Equal(t, []bool{false, true}, chunks.At(0).O().(ISlice).O())
+		assert.Equal(t, []bool{false}, chunks.At(1).O().(ISlice).O())
+
+		// chunks are copies
+		chunks.At(0).O().(ISlice).Set(0, true)
+		assert.Equal(t, []bool{false, true, false}, slice.O())
+	}
+}
+
+// Clear
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Clear() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.Clear())
+	// Output: []
+}
+
+func TestBoolSlice_Clear(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, NewBoolSliceV(), slice.Clear())
+	assert.Equal(t, (*BoolSlice)(nil), slice)
+
+	slice = NewBoolSliceV(false, true)
+	assert.Equal(t, NewBoolSliceV(), slice.Clear())
+	assert.Equal(t, NewBoolSliceV(), slice)
+}
+
+// Concat
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Concat() {
+	slice := NewBoolSliceV(false)
+	fmt.Println(slice.Concat([]bool{true}))
+	// Output: [false true]
+}
+
+func TestBoolSlice_Concat(t *testing.T) {
+
+	// nil
+	{
+		var slice *BoolSlice
+		assert.Equal(t, NewBoolSliceV(false), slice.Concat([]bool{false}))
+		assert.Equal(t, (*BoolSlice)(nil), slice)
+	}
+
+	// new slice
+	{
+		slice := NewBoolSliceV(false)
+		concat := slice.Concat(NewBoolSliceV(true))
+		assert.Equal(t, NewBoolSliceV(false, true), concat)
+		assert.Equal(t, NewBoolSliceV(false), slice)
+	}
+
+	// conversion
+	{
+		assert.Equal(t, NewBoolSliceV(false, true), NewBoolSliceV(false).Concat([]interface{}{"true"}))
+		assert.Equal(t, NewBoolSliceV(false), NewBoolSliceV(false).Concat(nil))
+	}
+}
+
+// ConcatM
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_ConcatM() {
+	slice := NewBoolSliceV(false)
+	fmt.Println(slice.ConcatM([]bool{true}))
+	// Output: [false true]
+}
+
+func TestBoolSlice_ConcatM(t *testing.T) {
+
+	// nil
+	{
+		var slice *BoolSlice
+		assert.Equal(t, NewBoolSliceV(false), slice.ConcatM([]bool{false}))
+		assert.Equal(t, (*BoolSlice)(nil), slice)
+	}
+
+	// modifies in place
+	{
+		slice := NewBoolSliceV(false)
+		assert.Equal(t, NewBoolSliceV(false, true), slice.ConcatM(NewBoolSliceV(true)))
+		assert.Equal(t, NewBoolSliceV(false, true), slice)
+	}
+}
+
+// Copy
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Copy() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.Copy())
+	// Output: [false true]
+}
+
+func TestBoolSlice_Copy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *BoolSlice
+		assert.Equal(t, NewBoolSliceV(), slice.Copy())
+		assert.Equal(t, NewBoolSliceV(), NewBoolSliceV().Copy(0, -1))
+	}
+
+	// copy is independent
+	{
+		slice := NewBoolSliceV(false, true)
+		copy := slice.Copy()
+		copy.Set(0, true)
+		assert.Equal(t, NewBoolSliceV(false, true), slice)
+		assert.Equal(t, NewBoolSliceV(true, true), copy)
+	}
+
+	// ranges
+	{
+		slice := NewBoolSliceV(false, true, false)
+		assert.Equal(t, NewBoolSliceV(false, true, false), slice.Copy(0, -1))
+		assert.Equal(t, NewBoolSliceV(true, false), slice.Copy(1, -1))
+		assert.Equal(t, NewBoolSliceV(false, true), slice.Copy(0, 1))
+		assert.Equal(t, NewBoolSliceV(true), slice.Copy(-2, -2))
+		assert.Equal(t, NewBoolSliceV(), slice.Copy(2, 1))
+	}
+}
+
+// Count
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Count() {
+	slice := NewBoolSliceV(false, true, false)
+	fmt.Println(slice.Count(false))
+	// Output: 2
+}
+
+func TestBoolSlice_Count(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, 0, slice.Count(false))
+	assert.Equal(t, 0, NewBoolSliceV().Count(false))
+	assert.Equal(t, 2, NewBoolSliceV(false, true, false).Count(false))
+	assert.Equal(t, 1, NewBoolSliceV(false, true, false).Count(true))
+	assert.Equal(t, 0, NewBoolSliceV(false).Count("foo"))
+}
+
+// CountW
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_CountW() {
+	slice := NewBoolSliceV(false, true, false)
+	fmt.Println(slice.CountW(func(x O) bool {
+		return x.(bool) == true
+	}))
+	// Output: 1
+}
+
+func TestBoolSlice_CountW(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, 0, slice.CountW(func(x O) bool { return true }))
+	assert.Equal(t, 0, NewBoolSliceV().CountW(func(x O) bool { return true }))
+	assert.Equal(t, 3, NewBoolSliceV(false, true, false).CountW(func(x O) bool { return true }))
+	assert.Equal(t, 2, NewBoolSliceV(false, true, false).CountW(func(x O) bool { return x.(bool) == false }))
+}
+
+// Difference
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Difference() {
+	slice := NewBoolSliceV(false, true, false)
+	fmt.Println(slice.Difference([]bool{true}))
+	// Output: [false]
+}
+
+func TestBoolSlice_Difference(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, []bool{}, slice.Difference([]bool{false}).O())
+	assert.Equal(t, []bool{false, true}, NewBoolSliceV(false, true, false).Difference(nil).O())
+	assert.Equal(t, []bool{true}, NewBoolSliceV(false, true, false).Difference(NewBoolSliceV(false)).O())
+	assert.Equal(t, []bool{}, NewBoolSliceV(false, true).Difference([]bool{true, false}).O())
+}
+
+// Disjoint
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Disjoint() {
+	slice := NewBoolSliceV(false, false)
+	fmt.Println(slice.Disjoint([]bool{true}))
+	// Output: true
+}
+
+func TestBoolSlice_Disjoint(t *testing.T) {
+	var slice *BoolSlice
+	assert.True(t, slice.Disjoint([]bool{false}))
+	assert.True(t, NewBoolSliceV(false).Disjoint(nil))
+	assert.True(t, NewBoolSliceV(false).Disjoint(NewBoolSliceV(true)))
+	assert.False(t, NewBoolSliceV(false, true).Disjoint([]bool{true}))
+}
+
+// Drop
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Drop() {
+	slice := NewBoolSliceV(false, true, false)
+	fmt.Println(slice.Drop(0, 1))
+	// Output: [false]
+}
+
+func TestBoolSlice_Drop(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *BoolSlice
+		assert.Equal(t, (*BoolSlice)(nil), slice.Drop(0, 1))
+		assert.Equal(t, NewBoolSliceV(), NewBoolSliceV().Drop(0, 1))
+	}
+
+	// drop all
+	{
+		slice := NewBoolSliceV(false, true, false)
+		assert.Equal(t, NewBoolSliceV(), slice.Drop())
+		assert.Equal(t, NewBoolSliceV(), slice)
+	}
+
+	// ranges
+	{
+		assert.Equal(t, NewBoolSliceV(false), NewBoolSliceV(false, true, false).Drop(1, -1))
+		assert.Equal(t, NewBoolSliceV(false, false), NewBoolSliceV(false, true, false).Drop(1, 1))
+		assert.Equal(t, NewBoolSliceV(false, true), NewBoolSliceV(false, true, false).Drop(-1, -1))
+		assert.Equal(t, NewBoolSliceV(false, true, false), NewBoolSliceV(false, true, false).Drop(2, 1))
+	}
+}
+
+// DropAt
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_DropAt() {
+	slice := NewBoolSliceV(false, true, false)
+	fmt.Println(slice.DropAt(1))
+	// Output: [false false]
+}
+
+func TestBoolSlice_DropAt(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, (*BoolSlice)(nil), slice.DropAt(0))
+	assert.Equal(t, NewBoolSliceV(), NewBoolSliceV().DropAt(0))
+	assert.Equal(t, NewBoolSliceV(true), NewBoolSliceV(false, true).DropAt(0))
+	assert.Equal(t, NewBoolSliceV(false), NewBoolSliceV(false, true).DropAt(-1))
+	assert.Equal(t, NewBoolSliceV(false, true), NewBoolSliceV(false, true).DropAt(2))
+}
+
+// DropFirst
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_DropFirst() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.DropFirst())
+	// Output: [true]
+}
+
+func TestBoolSlice_DropFirst(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, (*BoolSlice)(nil), slice.DropFirst())
+	assert.Equal(t, NewBoolSliceV(), NewBoolSliceV().DropFirst())
+	assert.Equal(t, NewBoolSliceV(true), NewBoolSliceV(false, true).DropFirst())
+}
+
+// DropFirstN
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_DropFirstN() {
+	slice := NewBoolSliceV(false, true, false)
+	fmt.Println(slice.DropFirstN(2))
+	// Output: [false]
+}
+
+func TestBoolSlice_DropFirstN(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, (*BoolSlice)(nil), slice.DropFirstN(1))
+	assert.Equal(t, NewBoolSliceV(false, true), NewBoolSliceV(false, true).DropFirstN(0))
+	assert.Equal(t, NewBoolSliceV(true), NewBoolSliceV(false, true).DropFirstN(1))
+	assert.Equal(t, NewBoolSliceV(), NewBoolSliceV(false, true).DropFirstN(5))
+}
+
+// DropLast
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_DropLast() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.DropLast())
+	// Output: [false]
+}
+
+func TestBoolSlice_DropLast(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, (*BoolSlice)(nil), slice.DropLast())
+	assert.Equal(t, NewBoolSliceV(), NewBoolSliceV().DropLast())
+	assert.Equal(t, NewBoolSliceV(false), NewBoolSliceV(false, true).DropLast())
+}
+
+// DropLastN
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_DropLastN() {
+	slice := NewBoolSliceV(false, true, false)
+	fmt.Println(slice.DropLastN(2))
+	// Output: [false]
+}
+
+func TestBoolSlice_DropLastN(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, (*BoolSlice)(nil), slice.DropLastN(1))
+	assert.Equal(t, NewBoolSliceV(false, true), NewBoolSliceV(false, true).DropLastN(0))
+	assert.Equal(t, NewBoolSliceV(false), NewBoolSliceV(false, true).DropLastN(1))
+	assert.Equal(t, NewBoolSliceV(), NewBoolSliceV(false, true).DropLastN(5))
+}
+
+// DropW
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_DropW() {
+	slice := NewBoolSliceV(false, true, false)
+	fmt.Println(slice.DropW(func(x O) bool {
+		return x.(bool) == false
+	}))
+	// Output: [true]
+}
+
+func TestBoolSlice_DropW(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, (*BoolSlice)(nil), slice.DropW(func(x O) bool { return true }))
+	assert.Equal(t, NewBoolSliceV(), NewBoolSliceV(false, true).DropW(func(x O) bool { return true }))
+	assert.Equal(t, NewBoolSliceV(false, false), NewBoolSliceV(false, true, false).DropW(func(x O) bool {
+		return x.(bool) == true
+	}))
+}
+
+// Each
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Each() {
+	NewBoolSliceV(false, true).Each(func(x O) {
+		fmt.Print(fmt.Sprintf("%t", x.(bool)), ";")
+	})
+	// Output: false;true;
+}
+
+func TestBoolSlice_Each(t *testing.T) {
+	var slice *BoolSlice
+	slice.Each(func(x O) { assert.Fail(t, "should not be called") })
+
+	var results []bool
+	NewBoolSliceV(false, true).Each(func(x O) {
+		results = append(results, x.(bool))
+	})
+	assert.Equal(t, []bool{false, true}, results)
+}
+
+// EachE
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_EachE() {
+	NewBoolSliceV(false, true).EachE(func(x O) error {
+		fmt.Print(fmt.Sprintf("%t", x.(bool)), ";")
+		return nil
+	})
+	// Output: false;true;
+}
+
+func TestBoolSlice_EachE(t *testing.T) {
+
+	// nil
+	{
+		var slice *BoolSlice
+		_, err := slice.EachE(func(x O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []bool
+		_, err := NewBoolSliceV(false, true).EachE(func(x O) error {
+			if x.(bool) == true {
+				return Break
+			}
+			results = append(results, x.(bool))
+			return nil
+		})
+		assert.Equal(t, Break, err)
+		assert.Equal(t, []bool{false}, results)
+	}
+}
+
+// EachI
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_EachI() {
+	NewBoolSliceV(false, true).EachI(func(i int, x O) {
+		fmt.Print(i, ":", fmt.Sprintf("%t", x.(bool)), ";")
+	})
+	// Output: 0:false;1:true;
+}
+
+func TestBoolSlice_EachI(t *testing.T) {
+	var slice *BoolSlice
+	slice.EachI(func(i int, x O) { assert.Fail(t, "should not be called") })
+
+	var results []int
+	NewBoolSliceV(false, true).EachI(func(i int, x O) {
+		results = append(results, i)
+	})
+	assert.Equal(t, []int{0, 1}, results)
+}
+
+// EachIE
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_EachIE() {
+	NewBoolSliceV(false, true).EachIE(func(i int, x O) error {
+		fmt.Print(i, ":", fmt.Sprintf("%t", x.(bool)), ";")
+		return nil
+	})
+	// Output: 0:false;1:true;
+}
+
+func TestBoolSlice_EachIE(t *testing.T) {
+
+	// nil
+	{
+		var slice *BoolSlice
+		_, err := slice.EachIE(func(i int, x O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []int
+		_, err := NewBoolSliceV(false, true, false).EachIE(func(i int, x O) error {
+			if i == 1 {
+				return Break
+			}
+			results = append(results, i)
+			return nil
+		})
+		assert.Equal(t, Break, err)
+		assert.Equal(t, []int{0}, results)
+	}
+}
+
+// EachP
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_EachP() {
+	slice, err := NewBoolSliceV(false, true).EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+		return nil
+	})
+	fmt.Println(slice, err)
+	// Output: [false true] <nil>
+}
+
+func TestBoolSlice_EachP(t *testing.T) {
+
+	// nil
+	{
+		var slice *BoolSlice
+		_, err := slice.EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+			return nil
+		})
+		assert.Nil(t, err)
+	}
+
+	// first error is returned
+	{
+		_, err := NewBoolSliceV(false, true).EachP(context.Background(), 0, func(ctx context.Context, x O) error {
+			if x.(bool) == true {
+				return errors.New("failed")
+			}
+			return nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+
+	// cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := NewBoolSliceV(false, true).EachP(ctx, 2, func(ctx context.Context, x O) error {
+			return nil
+		})
+		assert.Equal(t, context.Canceled, err)
+	}
+}
+
+// EachR
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_EachR() {
+	NewBoolSliceV(false, true).EachR(func(x O) {
+		fmt.Print(fmt.Sprintf("%t", x.(bool)), ";")
+	})
+	// Output: true;false;
+}
+
+func TestBoolSlice_EachR(t *testing.T) {
+	var slice *BoolSlice
+	slice.EachR(func(x O) { assert.Fail(t, "should not be called") })
+
+	var results []bool
+	NewBoolSliceV(false, true).EachR(func(x O) {
+		results = append(results, x.(bool))
+	})
+	assert.Equal(t, []bool{true, false}, results)
+}
+
+// EachRE
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_EachRE() {
+	NewBoolSliceV(false, true).EachRE(func(x O) error {
+		fmt.Print(fmt.Sprintf("%t", x.(bool)), ";")
+		return nil
+	})
+	// Output: true;false;
+}
+
+func TestBoolSlice_EachRE(t *testing.T) {
+
+	// nil
+	{
+		var slice *BoolSlice
+		_, err := slice.EachRE(func(x O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []bool
+		_, err := NewBoolSliceV(false, true).EachRE(func(x O) error {
+			if x.(bool) == false {
+				return Break
+			}
+			results = append(results, x.(bool))
+			return nil
+		})
+		assert.Equal(t, Break, err)
+		assert.Equal(t, []bool{true}, results)
+	}
+}
+
+// EachRI
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_EachRI() {
+	NewBoolSliceV(false, true).EachRI(func(i int, x O) {
+		fmt.Print(i, ":", fmt.Sprintf("%t", x.(bool)), ";")
+	})
+	// Output: 1:true;0:false;
+}
+
+func TestBoolSlice_EachRI(t *testing.T) {
+	var slice *BoolSlice
+	slice.EachRI(func(i int, x O) { assert.Fail(t, "should not be called") })
+
+	var results []int
+	NewBoolSliceV(false, true).EachRI(func(i int, x O) {
+		results = append(results, i)
+	})
+	assert.Equal(t, []int{1, 0}, results)
+}
+
+// EachRIE
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_EachRIE() {
+	NewBoolSliceV(false, true).EachRIE(func(i int, x O) error {
+		fmt.Print(i, ":", fmt.Sprintf("%t", x.(bool)), ";")
+		return nil
+	})
+	// Output: 1:true;0:false;
+}
+
+func TestBoolSlice_EachRIE(t *testing.T) {
+
+	// nil
+	{
+		var slice *BoolSlice
+		_, err := slice.EachRIE(func(i int, x O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []int
+		_, err := NewBoolSliceV(false, true, false).EachRIE(func(i int, x O) error {
+			if i == 1 {
+				return Break
+			}
+			results = append(results, i)
+			return nil
+		})
+		assert.Equal(t, Break, err)
+		assert.Equal(t, []int{2}, results)
+	}
+}
+
+// Empty
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Empty() {
+	fmt.Println(NewBoolSliceV().Empty())
+	// Output: true
+}
+
+func TestBoolSlice_Empty(t *testing.T) {
+	assert.True(t, (*BoolSlice)(nil).Empty())
+	assert.True(t, NewBoolSliceV().Empty())
+	assert.False(t, NewBoolSliceV(false).Empty())
+}
+
+// First
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_First() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.First().O())
+	// Output: false
+}
+
+func TestBoolSlice_First(t *testing.T) {
+	assert.Equal(t, Obj(nil), (*BoolSlice)(nil).First())
+	assert.Equal(t, Obj(nil), NewBoolSliceV().First())
+	assert.Equal(t, Obj(false), NewBoolSliceV(false, true).First())
+}
+
+// FirstN
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_FirstN() {
+	slice := NewBoolSliceV(false, true, false)
+	fmt.Println(slice.FirstN(2))
+	// Output: [false true]
+}
+
+func TestBoolSlice_FirstN(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, NewBoolSliceV(), slice.FirstN(1))
+	assert.Equal(t, NewBoolSliceV(), NewBoolSliceV(false).FirstN(0))
+	assert.Equal(t, NewBoolSliceV(false), NewBoolSliceV(false, true).FirstN(1))
+	assert.Equal(t, NewBoolSliceV(false, true), NewBoolSliceV(false, true).FirstN(5))
+
+	// reference to the original
+	{
+		slice := NewBoolSliceV(false, true)
+		slice.FirstN(1).Set(0, true)
+		assert.Equal(t, NewBoolSliceV(true, true), slice)
+	}
+}
+
+// FlatMap
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_FlatMap() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.FlatMap(func(x O) O {
+		return []interface{}{x, x}
+	}))
+	// Output: [false false true true]
+}
+
+func TestBoolSlice_FlatMap(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, 0, slice.FlatMap(func(x O) O { return x }).Len())
+	assert.Equal(t, 0, NewBoolSliceV().FlatMap(func(x O) O { return x }).Len())
+	assert.Equal(t, NewStringSliceV("false", "false", "true", "true"), NewBoolSliceV(false, true).FlatMap(func(x O) O {
+		return []string{fmt.Sprintf("%t", x.(bool)), fmt.Sprintf("%t", x.(bool))}
+	}))
+}
+
+// Flatten
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Flatten() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.Flatten())
+	// Output: [false true]
+}
+
+func TestBoolSlice_Flatten(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, 0, slice.Flatten().Len())
+
+	// already flat so it is a copy
+	{
+		slice := NewBoolSliceV(false, true)
+		new := slice.Flatten()
+		assert.Equal(t, NewBoolSliceV(false, true), new)
+		new.Set(0, true)
+		assert.Equal(t, NewBoolSliceV(false, true), slice)
+	}
+}
+
+// G
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_G() {
+	fmt.Println(len(NewBoolSliceV(false, true).G()))
+	// Output: 2
+}
+
+func TestBoolSlice_G(t *testing.T) {
+	assert.Equal(t, []bool{}, (*BoolSlice)(nil).G())
+	assert.Equal(t, []bool{}, NewBoolSliceV().G())
+	assert.Equal(t, []bool{false, true}, NewBoolSliceV(false, true).G())
+}
+
+// GroupBy
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_GroupBy() {
+	slice := NewBoolSliceV(false, true, false)
+	groups := slice.GroupBy(func(x O) O {
+		return x.(bool) == false
+	})
+	fmt.Println(groups.Get(true).O(), groups.Get(false).O())
+	// Output: [false false] [true]
+}
+
+func TestBoolSlice_GroupBy(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, 0, slice.GroupBy(func(x O) O { return x }).Len())
+	assert.Equal(t, 0, NewBoolSliceV().GroupBy(func(x O) O { return x }).Len())
+
+	// groups keep first seen order
+	{
+		groups := NewBoolSliceV(true, false, true).GroupBy(func(x O) O {
+			return x.(bool) == false
+		})
+		assert.Equal(t, []string{"false", "true"}, groups.Keys().O())
+		assert.Equal(t, []bool{true, true}, groups.Get(false).O().(ISlice).O())
+		assert.Equal(t, []bool{false}, groups.Get(true).O().(ISlice).O())
+	}
+}
+
+// Index
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Index() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.Index(true))
+	// Output: 1
+}
+
+func TestBoolSlice_Index(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, -1, slice.Index(false))
+	assert.Equal(t, -1, NewBoolSliceV().Index(false))
+	assert.Equal(t, 0, NewBoolSliceV(false, true, false).Index(false))
+	assert.Equal(t, 1, NewBoolSliceV(false, true).Index("true"))
+	assert.Equal(t, -1, NewBoolSliceV(false).Index(true))
+	assert.Equal(t, -1, NewBoolSliceV(false).Index("foo"))
+}
+
+// Insert
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Insert() {
+	slice := NewBoolSliceV(false, false)
+	fmt.Println(slice.Insert(1, true))
+	// Output: [false true false]
+}
+
+func TestBoolSlice_Insert(t *testing.T) {
+
+	// nil
+	{
+		var slice *BoolSlice
+		assert.Equal(t, NewBoolSliceV(false), slice.Insert(0, false))
+		assert.Equal(t, (*BoolSlice)(nil), slice)
+	}
+
+	// positions
+	{
+		assert.Equal(t, NewBoolSliceV(true, false), NewBoolSliceV(false).Insert(0, true))
+		assert.Equal(t, NewBoolSliceV(false, true), NewBoolSliceV(false).Insert(-1, true))
+		assert.Equal(t, NewBoolSliceV(false, true, true, false), NewBoolSliceV(false, false).Insert(1, []bool{true, true}))
+		assert.Equal(t, NewBoolSliceV(false), NewBoolSliceV(false).Insert(5, true))
+	}
+
+	// conversion
+	{
+		assert.Equal(t, NewBoolSliceV(false, true, false), NewBoolSliceV(false, false).Insert(1, "true"))
+		assert.Equal(t, NewBoolSliceV(false, false), NewBoolSliceV(false, false).Insert(1, "foo"))
+	}
+}
+
+// InterSlice
+//--------------------------------------------------------------------------------------------------
+func TestBoolSlice_InterSlice(t *testing.T) {
+	assert.False(t, (*BoolSlice)(nil).InterSlice())
+	assert.False(t, NewBoolSliceV(false).InterSlice())
+}
+
+// Intersect
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Intersect() {
+	slice := NewBoolSliceV(false, true, false)
+	fmt.Println(slice.Intersect([]bool{false}))
+	// Output: [false]
+}
+
+func TestBoolSlice_Intersect(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, []bool{}, slice.Intersect([]bool{false}).O())
+	assert.Equal(t, []bool{}, NewBoolSliceV(false).Intersect(nil).O())
+	assert.Equal(t, []bool{true, false}, NewBoolSliceV(true, false, true).Intersect(NewBoolSliceV(false, true)).O())
+	assert.Equal(t, []bool{}, NewBoolSliceV(false, false).Intersect([]bool{true}).O())
+}
+
+// Iter
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Iter() {
+	it := NewBoolSliceV(false, true).Iter()
+	for it.Next() {
+		fmt.Println(it.Index(), it.Value())
+	}
+	// Output:
+	// 0 false
+	// 1 true
+}
+
+func TestBoolSlice_Iter(t *testing.T) {
+	assert.False(t, (*BoolSlice)(nil).Iter().Next())
+	assert.False(t, NewBoolSliceV().Iter().Next())
+
+	it := NewBoolSliceV(false, true).Iter()
+	assert.Equal(t, -1, it.Index())
+	assert.True(t, it.Next())
+	assert.Equal(t, 0, it.Index())
+	assert.Equal(t, Obj(false).O(), it.Value())
+	assert.True(t, it.Next())
+	assert.Equal(t, 1, it.Index())
+	assert.Equal(t, Obj(true).O(), it.Value())
+	assert.False(t, it.Next())
+	assert.Nil(t, it.Value())
+	assert.Nil(t, it.Err())
+}
+
+// IsSubset
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_IsSubset() {
+	slice := NewBoolSliceV(false, false)
+	fmt.Println(slice.IsSubset([]bool{false, true}))
+	// Output: true
+}
+
+func TestBoolSlice_IsSubset(t *testing.T) {
+	var slice *BoolSlice
+	assert.True(t, slice.IsSubset([]bool{false}))
+	assert.True(t, NewBoolSliceV(true).IsSubset(NewBoolSliceV(false, true)))
+	assert.False(t, NewBoolSliceV(false, true).IsSubset([]bool{true}))
+	assert.False(t, NewBoolSliceV(false).IsSubset(nil))
+}
+
+// Join
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Join() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.Join())
+	// Output: false,true
+}
+
+func TestBoolSlice_Join(t *testing.T) {
+	assert.Equal(t, Obj(""), (*BoolSlice)(nil).Join())
+	assert.Equal(t, Obj(""), NewBoolSliceV().Join())
+	assert.Equal(t, Obj("false"), NewBoolSliceV(false).Join())
+	assert.Equal(t, Obj("false.true"), NewBoolSliceV(false, true).Join("."))
+}
+
+// Last
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Last() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.Last().O())
+	// Output: true
+}
+
+func TestBoolSlice_Last(t *testing.T) {
+	assert.Equal(t, Obj(nil), (*BoolSlice)(nil).Last())
+	assert.Equal(t, Obj(nil), NewBoolSliceV().Last())
+	assert.Equal(t, Obj(true), NewBoolSliceV(false, true).Last())
+}
+
+// LastN
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_LastN() {
+	slice := NewBoolSliceV(false, true, false)
+	fmt.Println(slice.LastN(2))
+	// Output: [true false]
+}
+
+func TestBoolSlice_LastN(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, NewBoolSliceV(), slice.LastN(1))
+	assert.Equal(t, NewBoolSliceV(), NewBoolSliceV(false).LastN(0))
+	assert.Equal(t, NewBoolSliceV(true), NewBoolSliceV(false, true).LastN(1))
+	assert.Equal(t, NewBoolSliceV(false, true), NewBoolSliceV(false, true).LastN(5))
+}
+
+// Len
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Len() {
+	fmt.Println(NewBoolSliceV(false, true).Len())
+	// Output: 2
+}
+
+func TestBoolSlice_Len(t *testing.T) {
+	assert.Equal(t, 0, (*BoolSlice)(nil).Len())
+	assert.Equal(t, 0, NewBoolSliceV().Len())
+	assert.Equal(t, 2, NewBoolSliceV(false, true).Len())
+}
+
+// Less
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Less() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.Less(0, 1))
+	// Output: true
+}
+
+func TestBoolSlice_Less(t *testing.T) {
+	assert.False(t, (*BoolSlice)(nil).Less(0, 1))
+	assert.False(t, NewBoolSliceV(false).Less(0, 1))
+	assert.True(t, NewBoolSliceV(false, true).Less(0, 1))
+	assert.False(t, NewBoolSliceV(false, true).Less(1, 0))
+	assert.False(t, NewBoolSliceV(false, false).Less(0, 1))
+	assert.False(t, NewBoolSliceV(false, true).Less(0, 2))
+}
+
+// Map
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Map() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.Map(func(x O) O {
+		return true
+	}))
+	// Output: [true true]
+}
+
+func TestBoolSlice_Map(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, NewBoolSliceV(), slice.Map(func(x O) O { return x }))
+	assert.Equal(t, 2, NewBoolSliceV(false, true).Map(func(x O) O { return x }).Len())
+
+	// type change
+	{
+		assert.Equal(t, NewStringSliceV("false", "true"), NewBoolSliceV(false, true).Map(func(x O) O {
+			return fmt.Sprintf("%t", x.(bool))
+		}))
+	}
+}
+
+// MapP
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_MapP() {
+	slice, _ := NewBoolSliceV(false, true).MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+		return x, nil
+	})
+	fmt.Println(slice)
+	// Output: [false true]
+}
+
+func TestBoolSlice_MapP(t *testing.T) {
+
+	// nil
+	{
+		var slice *BoolSlice
+		new, err := slice.MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+			return x, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// first error is returned
+	{
+		_, err := NewBoolSliceV(false, true).MapP(context.Background(), 0, func(ctx context.Context, x O) (O, error) {
+			if x.(bool) == true {
+				return nil, errors.New("failed")
+			}
+			return x, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+}
+
+// Nil
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Nil() {
+	var slice *BoolSlice
+	fmt.Println(slice.Nil())
+	// Output: true
+}
+
+func TestBoolSlice_Nil(t *testing.T) {
+	assert.True(t, (*BoolSlice)(nil).Nil())
+	assert.False(t, NewBoolSliceV().Nil())
+	assert.False(t, NewBoolSliceV(false).Nil())
+}
+
+// O
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_O() {
+	fmt.Println(len(NewBoolSliceV(false, true).O().([]bool)))
+	// Output: 2
+}
+
+func TestBoolSlice_O(t *testing.T) {
+	assert.Equal(t, []bool{}, (*BoolSlice)(nil).O())
+	assert.Equal(t, []bool{}, NewBoolSliceV().O())
+	assert.Equal(t, []bool{false, true}, NewBoolSliceV(false, true).O())
+}
+
+// Pair
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Pair() {
+	slice := NewBoolSliceV(false, true)
+	first, second := slice.Pair()
+	fmt.Println(first.O(), second.O())
+	// Output: false true
+}
+
+func TestBoolSlice_Pair(t *testing.T) {
+
+	// nil
+	{
+		first, second := (*BoolSlice)(nil).Pair()
+		assert.Equal(t, Obj(nil), first)
+		assert.Equal(t, Obj(nil), second)
+	}
+
+	// one value
+	{
+		first, second := NewBoolSliceV(false).Pair()
+		assert.Equal(t, Obj(false), first)
+		assert.Equal(t, Obj(nil), second)
+	}
+
+	// two values
+	{
+		first, second := NewBoolSliceV(false, true).Pair()
+		assert.Equal(t, Obj(false), first)
+		assert.Equal(t, Obj(true), second)
+	}
+}
+
+// Partition
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Partition() {
+	slice := NewBoolSliceV(false, true, false)
+	match, rest := slice.Partition(func(x O) bool {
+		return x.(bool) == false
+	})
+	fmt.Println(match, rest)
+	// Output: [false false] [true]
+}
+
+func TestBoolSlice_Partition(t *testing.T) {
+
+	// nil
+	{
+		var slice *BoolSlice
+		match, rest := slice.Partition(func(x O) bool { return true })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+	}
+
+	// all match
+	{
+		match, rest := NewBoolSliceV(false, true).Partition(func(x O) bool { return true })
+		assert.Equal(t, NewBoolSliceV(false, true), match)
+		assert.Equal(t, NewBoolSliceV(), rest)
+	}
+}
+
+// Pop
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Pop() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.Pop().O())
+	// Output: true
+}
+
+func TestBoolSlice_Pop(t *testing.T) {
+	assert.Equal(t, Obj(nil), (*BoolSlice)(nil).Pop())
+	assert.Equal(t, Obj(nil), NewBoolSliceV().Pop())
+
+	slice := NewBoolSliceV(false, true)
+	assert.Equal(t, Obj(true), slice.Pop())
+	assert.Equal(t, NewBoolSliceV(false), slice)
+}
+
+// PopN
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_PopN() {
+	slice := NewBoolSliceV(false, true, false)
+	fmt.Println(slice.PopN(2))
+	// Output: [true false]
+}
+
+func TestBoolSlice_PopN(t *testing.T) {
+	assert.Equal(t, NewBoolSliceV(), (*BoolSlice)(nil).PopN(1))
+	assert.Equal(t, NewBoolSliceV(), NewBoolSliceV(false).PopN(0))
+
+	slice := NewBoolSliceV(false, true, false)
+	assert.Equal(t, NewBoolSliceV(true, false), slice.PopN(2))
+	assert.Equal(t, NewBoolSliceV(false), slice)
+	assert.Equal(t, NewBoolSliceV(false), slice.PopN(5))
+	assert.Equal(t, NewBoolSliceV(), slice)
+}
+
+// Prepend
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Prepend() {
+	slice := NewBoolSliceV(true)
+	fmt.Println(slice.Prepend(false))
+	// Output: [false true]
+}
+
+func TestBoolSlice_Prepend(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, NewBoolSliceV(false), slice.Prepend(false))
+	assert.Equal(t, NewBoolSliceV(true, false), NewBoolSliceV(false).Prepend("true"))
+}
+
+// RefSlice
+//--------------------------------------------------------------------------------------------------
+func TestBoolSlice_RefSlice(t *testing.T) {
+	assert.False(t, (*BoolSlice)(nil).RefSlice())
+	assert.False(t, NewBoolSliceV(false).RefSlice())
+}
+
+// Reverse
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Reverse() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.Reverse())
+	// Output: [true false]
+}
+
+func TestBoolSlice_Reverse(t *testing.T) {
+	assert.Equal(t, NewBoolSliceV(), (*BoolSlice)(nil).Reverse())
+
+	slice := NewBoolSliceV(false, true)
+	assert.Equal(t, NewBoolSliceV(true, false), slice.Reverse())
+	assert.Equal(t, NewBoolSliceV(false, true), slice)
+}
+
+// ReverseM
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_ReverseM() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.ReverseM())
+	// Output: [true false]
+}
+
+func TestBoolSlice_ReverseM(t *testing.T) {
+	assert.Equal(t, (*BoolSlice)(nil), (*BoolSlice)(nil).ReverseM())
+
+	slice := NewBoolSliceV(false, true)
+	assert.Equal(t, NewBoolSliceV(true, false), slice.ReverseM())
+	assert.Equal(t, NewBoolSliceV(true, false), slice)
+}
+
+// S
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_S() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.S())
+	// Output: [false true]
+}
+
+func TestBoolSlice_S(t *testing.T) {
+	assert.Equal(t, NewStringSliceV(), (*BoolSlice)(nil).S())
+	assert.Equal(t, NewStringSliceV("false", "true"), NewBoolSliceV(false, true).S())
+}
+
+// Select
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Select() {
+	slice := NewBoolSliceV(false, true, false)
+	fmt.Println(slice.Select(func(x O) bool {
+		return x.(bool) == false
+	}))
+	// Output: [false false]
+}
+
+func TestBoolSlice_Select(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, NewBoolSliceV(), slice.Select(func(x O) bool { return true }))
+	assert.Equal(t, NewBoolSliceV(), NewBoolSliceV(false).Select(func(x O) bool { return false }))
+	assert.Equal(t, NewBoolSliceV(true), NewBoolSliceV(false, true, false).Select(func(x O) bool {
+		return x.(bool) == true
+	}))
+}
+
+// SelectP
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_SelectP() {
+	slice, _ := NewBoolSliceV(false, true, false).SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+		return x.(bool) == false, nil
+	})
+	fmt.Println(slice)
+	// Output: [false false]
+}
+
+func TestBoolSlice_SelectP(t *testing.T) {
+
+	// nil
+	{
+		var slice *BoolSlice
+		new, err := slice.SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+			return true, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// first error is returned
+	{
+		_, err := NewBoolSliceV(false, true).SelectP(context.Background(), 0, func(ctx context.Context, x O) (bool, error) {
+			if x.(bool) == true {
+				return false, errors.New("failed")
+			}
+			return true, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+}
+
+// Set
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Set() {
+	slice := NewBoolSliceV(false, false)
+	fmt.Println(slice.Set(0, true))
+	// Output: [true false]
+}
+
+func TestBoolSlice_Set(t *testing.T) {
+	assert.Equal(t, (*BoolSlice)(nil), (*BoolSlice)(nil).Set(0, false))
+	assert.Equal(t, NewBoolSliceV(false, true), NewBoolSliceV(false, false).Set(-1, true))
+	assert.Equal(t, NewBoolSliceV(true, true), NewBoolSliceV(false, false).Set(0, []bool{true, true}))
+	assert.Equal(t, NewBoolSliceV(false, false), NewBoolSliceV(false, false).Set(5, true))
+}
+
+// SetE
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_SetE() {
+	slice := NewBoolSliceV(false, false)
+	fmt.Println(slice.SetE(1, true))
+	// Output: [false true] <nil>
+}
+
+func TestBoolSlice_SetE(t *testing.T) {
+
+	// nil
+	{
+		slice, err := (*BoolSlice)(nil).SetE(0, false)
+		assert.Nil(t, err)
+		assert.Equal(t, (*BoolSlice)(nil), slice)
+	}
+
+	// conversion
+	{
+		slice, err := NewBoolSliceV(false, false).SetE(0, "true")
+		assert.Nil(t, err)
+		assert.Equal(t, NewBoolSliceV(true, false), slice)
+	}
+
+	// out of bounds
+	{
+		slice, err := NewBoolSliceV(false).SetE(2, true)
+		assert.Equal(t, "slice assignment is out of bounds", err.Error())
+		assert.Equal(t, NewBoolSliceV(false), slice)
+	}
+}
+
+// Shift
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Shift() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.Shift().O())
+	// Output: false
+}
+
+func TestBoolSlice_Shift(t *testing.T) {
+	assert.Equal(t, Obj(nil), (*BoolSlice)(nil).Shift())
+	assert.Equal(t, Obj(nil), NewBoolSliceV().Shift())
+
+	slice := NewBoolSliceV(false, true)
+	assert.Equal(t, Obj(false), slice.Shift())
+	assert.Equal(t, NewBoolSliceV(true), slice)
+}
+
+// ShiftN
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_ShiftN() {
+	slice := NewBoolSliceV(false, true, false)
+	fmt.Println(slice.ShiftN(2))
+	// Output: [false true]
+}
+
+func TestBoolSlice_ShiftN(t *testing.T) {
+	assert.Equal(t, NewBoolSliceV(), (*BoolSlice)(nil).ShiftN(1))
+	assert.Equal(t, NewBoolSliceV(), NewBoolSliceV(false).ShiftN(0))
+
+	slice := NewBoolSliceV(false, true, false)
+	assert.Equal(t, NewBoolSliceV(false, true), slice.ShiftN(2))
+	assert.Equal(t, NewBoolSliceV(false), slice)
+	assert.Equal(t, NewBoolSliceV(false), slice.ShiftN(5))
+	assert.Equal(t, NewBoolSliceV(), slice)
+}
+
+// Single
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Single() {
+	fmt.Println(NewBoolSliceV(false).Single())
+	// Output: true
+}
+
+func TestBoolSlice_Single(t *testing.T) {
+	assert.False(t, (*BoolSlice)(nil).Single())
+	assert.False(t, NewBoolSliceV().Single())
+	assert.True(t, NewBoolSliceV(false).Single())
+	assert.False(t, NewBoolSliceV(false, true).Single())
+}
+
+// Slice
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Slice() {
+	slice := NewBoolSliceV(false, true, false)
+	fmt.Println(slice.Slice(1, -1))
+	// Output: [true false]
+}
+
+func TestBoolSlice_Slice(t *testing.T) {
+	assert.Equal(t, NewBoolSliceV(), (*BoolSlice)(nil).Slice(0, -1))
+	assert.Equal(t, NewBoolSliceV(), NewBoolSliceV().Slice(0, -1))
+
+	// reference to the original
+	{
+		slice := NewBoolSliceV(false, true, false)
+		assert.Equal(t, NewBoolSliceV(false, true, false), slice.Slice())
+		assert.Equal(t, NewBoolSliceV(false, true), slice.Slice(0, 1))
+		assert.Equal(t, NewBoolSliceV(), slice.Slice(2, 1))
+		slice.Slice(-1, -1).Set(0, true)
+		assert.Equal(t, NewBoolSliceV(false, true, true), slice)
+	}
+}
+
+// Sort
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Sort() {
+	slice := NewBoolSliceV(true, false)
+	fmt.Println(slice.Sort())
+	// Output: [false true]
+}
+
+func TestBoolSlice_Sort(t *testing.T) {
+	assert.Equal(t, NewBoolSliceV(), (*BoolSlice)(nil).Sort())
+
+	slice := NewBoolSliceV(true, false, true, false)
+	assert.Equal(t, NewBoolSliceV(false, false, true, true), slice.Sort())
+	assert.Equal(t, NewBoolSliceV(true, false, true, false), slice)
+}
+
+// SortBy
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_SortBy() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.SortBy(func(a, b O) bool {
+		return !b.(bool) && a.(bool)
+	}))
+	// Output: [true false]
+}
+
+func TestBoolSlice_SortBy(t *testing.T) {
+	assert.Equal(t, NewBoolSliceV(), (*BoolSlice)(nil).SortBy(func(a, b O) bool { return false }))
+
+	slice := NewBoolSliceV(false, true, false)
+	assert.Equal(t, NewBoolSliceV(true, false, false), slice.SortBy(func(a, b O) bool {
+		return !b.(bool) && a.(bool)
+	}))
+	assert.Equal(t, NewBoolSliceV(false, true, false), slice)
+}
+
+// SortByM
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_SortByM() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.SortByM(func(a, b O) bool {
+		return !b.(bool) && a.(bool)
+	}))
+	// Output: [true false]
+}
+
+func TestBoolSlice_SortByM(t *testing.T) {
+	assert.Equal(t, (*BoolSlice)(nil), (*BoolSlice)(nil).SortByM(func(a, b O) bool { return false }))
+
+	slice := NewBoolSliceV(false, true, false)
+	assert.Equal(t, NewBoolSliceV(true, false, false), slice.SortByM(func(a, b O) bool {
+		return !b.(bool) && a.(bool)
+	}))
+	assert.Equal(t, NewBoolSliceV(true, false, false), slice)
+}
+
+// SortM
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_SortM() {
+	slice := NewBoolSliceV(true, false)
+	fmt.Println(slice.SortM())
+	// Output: [false true]
+}
+
+func TestBoolSlice_SortM(t *testing.T) {
+	assert.Equal(t, (*BoolSlice)(nil), (*BoolSlice)(nil).SortM())
+
+	slice := NewBoolSliceV(true, false, true)
+	assert.Equal(t, NewBoolSliceV(false, true, true), slice.SortM())
+	assert.Equal(t, NewBoolSliceV(false, true, true), slice)
+}
+
+// SortReverse
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_SortReverse() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.SortReverse())
+	// Output: [true false]
+}
+
+func TestBoolSlice_SortReverse(t *testing.T) {
+	assert.Equal(t, NewBoolSliceV(), (*BoolSlice)(nil).SortReverse())
+
+	slice := NewBoolSliceV(false, true, false)
+	assert.Equal(t, NewBoolSliceV(true, false, false), slice.SortReverse())
+	assert.Equal(t, NewBoolSliceV(false, true, false), slice)
+}
+
+// SortReverseM
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_SortReverseM() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.SortReverseM())
+	// Output: [true false]
+}
+
+func TestBoolSlice_SortReverseM(t *testing.T) {
+	assert.Equal(t, (*BoolSlice)(nil), (*BoolSlice)(nil).SortReverseM())
+
+	slice := NewBoolSliceV(false, true, false)
+	assert.Equal(t, NewBoolSliceV(true, false, false), slice.SortReverseM())
+	assert.Equal(t, NewBoolSliceV(true, false, false), slice)
+}
+
+// SortStable
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_SortStable() {
+	slice := NewBoolSliceV(true, false)
+	fmt.Println(slice.SortStable(func(a, b O) bool {
+		return !a.(bool) && b.(bool)
+	}))
+	// Output: [false true]
+}
+
+func TestBoolSlice_SortStable(t *testing.T) {
+	assert.Equal(t, NewBoolSliceV(), (*BoolSlice)(nil).SortStable(func(a, b O) bool { return false }))
+
+	slice := NewBoolSliceV(true, false, true)
+	assert.Equal(t, NewBoolSliceV(false, true, true), slice.SortStable(func(a, b O) bool {
+		return !a.(bool) && b.(bool)
+	}))
+	assert.Equal(t, NewBoolSliceV(true, false, true), slice)
+}
+
+// SortStableM
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_SortStableM() {
+	slice := NewBoolSliceV(true, false)
+	fmt.Println(slice.SortStableM(func(a, b O) bool {
+		return !a.(bool) && b.(bool)
+	}))
+	// Output: [false true]
+}
+
+func TestBoolSlice_SortStableM(t *testing.T) {
+	assert.Equal(t, (*BoolSlice)(nil), (*BoolSlice)(nil).SortStableM(func(a, b O) bool { return false }))
+
+	slice := NewBoolSliceV(true, false, true)
+	assert.Equal(t, NewBoolSliceV(false, true, true), slice.SortStableM(func(a, b O) bool {
+		return !a.(bool) && b.(bool)
+	}))
+	assert.Equal(t, NewBoolSliceV(false, true, true), slice)
+}
+
+// String
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_String() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.String())
+	// Output: [false true]
+}
+
+func TestBoolSlice_String(t *testing.T) {
+	assert.Equal(t, "[]", (*BoolSlice)(nil).String())
+	assert.Equal(t, "[]", NewBoolSliceV().String())
+	assert.Equal(t, "[false true]", NewBoolSliceV(false, true).String())
+}
+
+// Swap
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Swap() {
+	slice := NewBoolSliceV(false, true)
+	slice.Swap(0, 1)
+	fmt.Println(slice)
+	// Output: [true false]
+}
+
+func TestBoolSlice_Swap(t *testing.T) {
+	var slice *BoolSlice
+	slice.Swap(0, 1)
+	assert.Equal(t, (*BoolSlice)(nil), slice)
+
+	slice = NewBoolSliceV(false, true)
+	slice.Swap(0, 2)
+	assert.Equal(t, NewBoolSliceV(false, true), slice)
+	slice.Swap(1, 0)
+	assert.Equal(t, NewBoolSliceV(true, false), slice)
+}
+
+// SymmetricDifference
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_SymmetricDifference() {
+	slice := NewBoolSliceV(false, false)
+	fmt.Println(slice.SymmetricDifference([]bool{true}))
+	// Output: [false true]
+}
+
+func TestBoolSlice_SymmetricDifference(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, []bool{false}, slice.SymmetricDifference([]bool{false, false}).O())
+	assert.Equal(t, []bool{false}, NewBoolSliceV(false).SymmetricDifference(nil).O())
+	assert.Equal(t, []bool{}, NewBoolSliceV(false, true).SymmetricDifference(NewBoolSliceV(true, false)).O())
+	assert.Equal(t, []bool{true}, NewBoolSliceV(false).SymmetricDifference([]bool{false, true}).O())
+}
+
+// Take
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Take() {
+	slice := NewBoolSliceV(false, true, false)
+	fmt.Println(slice.Take(0, 1))
+	// Output: [false true]
+}
+
+func TestBoolSlice_Take(t *testing.T) {
+	assert.Equal(t, NewBoolSliceV(), (*BoolSlice)(nil).Take(0, 1))
+
+	slice := NewBoolSliceV(false, true, false)
+	assert.Equal(t, NewBoolSliceV(true, false), slice.Take(1, -1))
+	assert.Equal(t, NewBoolSliceV(false), slice)
+	assert.Equal(t, NewBoolSliceV(false), slice.Take())
+	assert.Equal(t, NewBoolSliceV(), slice)
+}
+
+// TakeAt
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_TakeAt() {
+	slice := NewBoolSliceV(false, true, false)
+	fmt.Println(slice.TakeAt(1).O())
+	// Output: true
+}
+
+func TestBoolSlice_TakeAt(t *testing.T) {
+	assert.Equal(t, Obj(nil), (*BoolSlice)(nil).TakeAt(0))
+
+	slice := NewBoolSliceV(false, true)
+	assert.Equal(t, Obj(nil), slice.TakeAt(2))
+	assert.Equal(t, Obj(true), slice.TakeAt(-1))
+	assert.Equal(t, NewBoolSliceV(false), slice)
+}
+
+// TakeW
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_TakeW() {
+	slice := NewBoolSliceV(false, true, false)
+	fmt.Println(slice.TakeW(func(x O) bool {
+		return x.(bool) == false
+	}))
+	// Output: [false false]
+}
+
+func TestBoolSlice_TakeW(t *testing.T) {
+	assert.Equal(t, NewBoolSliceV(), (*BoolSlice)(nil).TakeW(func(x O) bool { return true }))
+
+	slice := NewBoolSliceV(false, true, false)
+	assert.Equal(t, NewBoolSliceV(true), slice.TakeW(func(x O) bool {
+		return x.(bool) == true
+	}))
+	assert.Equal(t, NewBoolSliceV(false, false), slice)
+}
+
+// ToStringSlice
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_ToStringSlice() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.ToStringSlice())
+	// Output: [false true]
+}
+
+func TestBoolSlice_ToStringSlice(t *testing.T) {
+	assert.Equal(t, NewStringSliceV(), (*BoolSlice)(nil).ToStringSlice())
+	assert.Equal(t, NewStringSliceV("false", "true"), NewBoolSliceV(false, true).ToStringSlice())
+}
+
+// ToStrs
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_ToStrs() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(slice.ToStrs())
+	// Output: [false true]
+}
+
+func TestBoolSlice_ToStrs(t *testing.T) {
+	assert.Equal(t, []string{}, (*BoolSlice)(nil).ToStrs())
+	assert.Equal(t, []string{"false", "true"}, NewBoolSliceV(false, true).ToStrs())
+}
+
+// ToInterSlice
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_ToInterSlice() {
+	slice := NewBoolSliceV(false, true)
+	fmt.Println(len(slice.ToInterSlice()))
+	// Output: 2
+}
+
+func TestBoolSlice_ToInterSlice(t *testing.T) {
+	assert.Equal(t, []interface{}{}, (*BoolSlice)(nil).ToInterSlice())
+	assert.Equal(t, []interface{}{false, true}, NewBoolSliceV(false, true).ToInterSlice())
+}
+
+// Union
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Union() {
+	slice := NewBoolSliceV(false, false)
+	fmt.Println(slice.Union([]bool{true, false}))
+	// Output: [false true]
+}
+
+func TestBoolSlice_Union(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, NewBoolSliceV(false), slice.Union([]bool{false, false}))
+
+	slice = NewBoolSliceV(true, true)
+	assert.Equal(t, NewBoolSliceV(true, false), slice.Union(NewBoolSliceV(false)))
+	assert.Equal(t, NewBoolSliceV(true, true), slice)
+}
+
+// UnionM
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_UnionM() {
+	slice := NewBoolSliceV(false, false)
+	fmt.Println(slice.UnionM([]bool{true, false}))
+	// Output: [false true]
+}
+
+func TestBoolSlice_UnionM(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, NewBoolSliceV(false), slice.UnionM([]bool{false, false}))
+	assert.Equal(t, (*BoolSlice)(nil), slice)
+
+	slice = NewBoolSliceV(true, true)
+	assert.Equal(t, NewBoolSliceV(true, false), slice.UnionM(NewBoolSliceV(false)))
+	assert.Equal(t, NewBoolSliceV(true, false), slice)
+}
+
+// Uniq
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Uniq() {
+	slice := NewBoolSliceV(false, true, false)
+	fmt.Println(slice.Uniq())
+	// Output: [false true]
+}
+
+func TestBoolSlice_Uniq(t *testing.T) {
+	assert.Equal(t, NewBoolSliceV(), (*BoolSlice)(nil).Uniq())
+
+	slice := NewBoolSliceV(true, false, true, false)
+	assert.Equal(t, NewBoolSliceV(true, false), slice.Uniq())
+	assert.Equal(t, NewBoolSliceV(true, false, true, false), slice)
+}
+
+// UniqM
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_UniqM() {
+	slice := NewBoolSliceV(false, true, false)
+	fmt.Println(slice.UniqM())
+	// Output: [false true]
+}
+
+func TestBoolSlice_UniqM(t *testing.T) {
+	assert.Equal(t, (*BoolSlice)(nil), (*BoolSlice)(nil).UniqM())
+
+	slice := NewBoolSliceV(true, false, true, false)
+	assert.Equal(t, NewBoolSliceV(true, false), slice.UniqM())
+	assert.Equal(t, NewBoolSliceV(true, false), slice)
+}
+
+// Window
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Window() {
+	slice := NewBoolSliceV(false, true, false)
+	slice.Window(2, 1).Each(func(x O) {
+		fmt.Print(x)
+	})
+	// Output: [false true][true false]
+}
+
+func TestBoolSlice_Window(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, 0, slice.Window(2, 1).Len())
+	assert.Equal(t, 0, NewBoolSliceV(false, true).Window(0, 1).Len())
+	assert.Equal(t, 0, NewBoolSliceV(false, true).Window(3, 1).Len())
+
+	windows := NewBoolSliceV(false, true, false).Window(2, 1)
+	assert.Equal(t, 2, windows.Len())
+	assert.Equal(t, []bool{false, true}, windows.At(0).O().(ISlice).O())
+	assert.Equal(t, []bool{true, false}, windows.At(1).O().(ISlice).O())
+}
+
+// Zip
+//--------------------------------------------------------------------------------------------------
+func ExampleBoolSlice_Zip() {
+	slice := NewBoolSliceV(false, true)
+	pair := slice.Zip([]string{"a", "b"}).At(1).O().(ISlice)
+	fmt.Println(pair.At(0).O(), pair.At(1).O())
+	// Output: true b
+}
+
+func TestBoolSlice_Zip(t *testing.T) {
+	var slice *BoolSlice
+	assert.Equal(t, 0, slice.Zip([]string{"a"}).Len())
+	assert.Equal(t, 0, NewBoolSliceV(false).Zip(nil).Len())
+
+	pairs := NewBoolSliceV(false, true, false).Zip([]string{"a", "b"})
+	assert.Equal(t, 2, pairs.Len())
+	assert.Equal(t, []interface{}{false, "a"}, pairs.At(0).O().(ISlice).O())
+	assert.Equal(t, []interface{}{true, "b"}, pairs.At(1).O().(ISlice).O())
+}
@@ -0,0 +1,2034 @@
+// Code generated by "nubgen -type=bool,byte,time.Duration,time.Time -test"; DO NOT EDIT.
+
+package n
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// NewByteSlice
+//--------------------------------------------------------------------------------------------------
+func ExampleNewByteSlice() {
+	slice := NewByteSlice([]byte{byte(1), byte(2)})
+	fmt.Println(slice)
+	// Output: [1 2]
+}
+
+func TestByteSlice_NewByteSlice(t *testing.T) {
+
+	// array
+	{
+		var array [2]byte
+		array[0] = byte(1)
+		array[1] = byte(2)
+		assert.Equal(t, []byte{byte(1), byte(2)}, NewByteSlice(array).O())
+		assert.Equal(t, []byte{byte(1), byte(2)}, NewByteSlice(array[:]).O())
+	}
+
+	// empty
+	{
+		assert.Equal(t, []byte{}, NewByteSlice(nil).O())
+		assert.Equal(t, []byte{}, NewByteSlice([]byte{}).O())
+	}
+
+	// conversion
+	{
+		assert.Equal(t, []byte{byte(1)}, NewByteSlice(byte(1)).O())
+		assert.Equal(t, []byte{byte(1)}, NewByteSlice("1").O())
+		assert.Equal(t, []byte{byte(1), byte(2)}, NewByteSlice([]interface{}{"1", byte(2)}).O())
+		assert.Equal(t, []byte{}, NewByteSlice("foo").O())
+	}
+}
+
+// NewByteSliceV
+//--------------------------------------------------------------------------------------------------
+func ExampleNewByteSliceV() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice)
+	// Output: [1 2]
+}
+
+func TestByteSlice_NewByteSliceV(t *testing.T) {
+	assert.Equal(t, []byte{}, NewByteSliceV().O())
+	assert.Equal(t, []byte{byte(1)}, NewByteSliceV(byte(1)).O())
+	assert.Equal(t, []byte{byte(1), byte(2)}, NewByteSliceV(byte(1), byte(2)).O())
+}
+
+// A
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_A() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.A())
+	// Output: [1 2]
+}
+
+func TestByteSlice_A(t *testing.T) {
+	assert.Equal(t, "[]", (*ByteSlice)(nil).A())
+	assert.Equal(t, "[1 2]", NewByteSliceV(byte(1), byte(2)).A())
+}
+
+// All
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_All() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.All(byte(1), byte(2)))
+	// Output: true
+}
+
+func TestByteSlice_All(t *testing.T) {
+	var slice *ByteSlice
+	assert.False(t, slice.All())
+	assert.False(t, NewByteSliceV().All(byte(1)))
+	assert.True(t, NewByteSliceV(byte(1)).All())
+	assert.True(t, NewByteSliceV(byte(1), byte(2)).All(byte(1), byte(2)))
+	assert.False(t, NewByteSliceV(byte(1)).All(byte(1), byte(2)))
+	assert.False(t, NewByteSliceV(byte(1)).All("foo"))
+}
+
+// AllS
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_AllS() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.AllS([]byte{byte(1), byte(2)}))
+	// Output: true
+}
+
+func TestByteSlice_AllS(t *testing.T) {
+	var slice *ByteSlice
+	assert.False(t, slice.AllS([]byte{byte(1)}))
+	assert.False(t, NewByteSliceV().AllS([]byte{byte(1)}))
+	assert.True(t, NewByteSliceV(byte(1), byte(2)).AllS([]byte{byte(1), byte(2)}))
+	assert.True(t, NewByteSliceV(byte(1), byte(2)).AllS(NewByteSliceV(byte(2))))
+	assert.False(t, NewByteSliceV(byte(1)).AllS([]byte{byte(1), byte(2)}))
+	assert.True(t, NewByteSliceV(byte(1)).AllS(nil))
+}
+
+// Any
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Any() {
+	slice := NewByteSliceV(byte(1))
+	fmt.Println(slice.Any(byte(1), byte(2)))
+	// Output: true
+}
+
+func TestByteSlice_Any(t *testing.T) {
+	var slice *ByteSlice
+	assert.False(t, slice.Any())
+	assert.False(t, NewByteSliceV().Any(byte(1)))
+	assert.True(t, NewByteSliceV(byte(1)).Any())
+	assert.True(t, NewByteSliceV(byte(1)).Any(byte(1), byte(2)))
+	assert.False(t, NewByteSliceV(byte(1)).Any(byte(2)))
+	assert.False(t, NewByteSliceV(byte(1)).Any("foo"))
+}
+
+// AnyS
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_AnyS() {
+	slice := NewByteSliceV(byte(1))
+	fmt.Println(slice.AnyS([]byte{byte(1), byte(2)}))
+	// Output: true
+}
+
+func TestByteSlice_AnyS(t *testing.T) {
+	var slice *ByteSlice
+	assert.False(t, slice.AnyS([]byte{byte(1)}))
+	assert.False(t, NewByteSliceV().AnyS([]byte{byte(1)}))
+	assert.True(t, NewByteSliceV(byte(1)).AnyS([]byte{byte(1), byte(2)}))
+	assert.True(t, NewByteSliceV(byte(1), byte(2)).AnyS(NewByteSliceV(byte(2))))
+	assert.False(t, NewByteSliceV(byte(1)).AnyS([]byte{byte(2)}))
+	assert.False(t, NewByteSliceV(byte(1)).AnyS(nil))
+}
+
+// AnyW
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_AnyW() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.AnyW(func(x O) bool {
+		return x.(byte) == byte(2)
+	}))
+	// Output: true
+}
+
+func TestByteSlice_AnyW(t *testing.T) {
+	var slice *ByteSlice
+	assert.False(t, slice.AnyW(func(x O) bool { return true }))
+	assert.False(t, NewByteSliceV().AnyW(func(x O) bool { return true }))
+	assert.True(t, NewByteSliceV(byte(1), byte(2)).AnyW(func(x O) bool { return x.(byte) == byte(2) }))
+	assert.False(t, NewByteSliceV(byte(1)).AnyW(func(x O) bool { return x.(byte) == byte(2) }))
+}
+
+// Append
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Append() {
+	slice := NewByteSliceV(byte(1))
+	fmt.Println(slice.Append(byte(2)))
+	// Output: [1 2]
+}
+
+func TestByteSlice_Append(t *testing.T) {
+
+	// nil
+	{
+		var slice *ByteSlice
+		assert.Equal(t, NewByteSliceV(byte(1)), slice.Append(byte(1)))
+		assert.Equal(t, (*ByteSlice)(nil), slice)
+	}
+
+	// modifies in place
+	{
+		slice := NewByteSliceV(byte(1))
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2)), slice.Append(byte(2)))
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2)), slice)
+	}
+
+	// conversion
+	{
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2)), NewByteSliceV(byte(1)).Append("2"))
+		assert.Equal(t, NewByteSliceV(byte(1)), NewByteSliceV(byte(1)).Append("foo"))
+	}
+}
+
+// AppendV
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_AppendV() {
+	slice := NewByteSliceV(byte(1))
+	fmt.Println(slice.AppendV(byte(2), byte(1)))
+	// Output: [1 2 1]
+}
+
+func TestByteSlice_AppendV(t *testing.T) {
+
+	// nil
+	{
+		var slice *ByteSlice
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2)), slice.AppendV(byte(1), byte(2)))
+		assert.Equal(t, (*ByteSlice)(nil), slice)
+	}
+
+	// modifies in place
+	{
+		slice := NewByteSliceV(byte(1))
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2), byte(1)), slice.AppendV(byte(2), "1"))
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2), byte(1)), slice)
+	}
+}
+
+// At
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_At() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.At(-1).O())
+	// Output: 2
+}
+
+func TestByteSlice_At(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, Obj(nil), slice.At(0))
+	assert.Equal(t, Obj(nil), NewByteSliceV().At(0))
+	assert.Equal(t, Obj(byte(1)), NewByteSliceV(byte(1), byte(2)).At(0))
+	assert.Equal(t, Obj(byte(2)), NewByteSliceV(byte(1), byte(2)).At(1))
+	assert.Equal(t, Obj(byte(2)), NewByteSliceV(byte(1), byte(2)).At(-1))
+	assert.Equal(t, Obj(byte(1)), NewByteSliceV(byte(1), byte(2)).At(-2))
+	assert.Equal(t, Obj(nil), NewByteSliceV(byte(1), byte(2)).At(2))
+	assert.Equal(t, Obj(nil), NewByteSliceV(byte(1), byte(2)).At(-3))
+}
+
+// BinarySearch
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_BinarySearch() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.BinarySearch(byte(2)))
+	// Output: 1 true
+}
+
+func TestByteSlice_BinarySearch(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *ByteSlice
+		i, found := slice.BinarySearch(byte(1))
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+		i, found = NewByteSliceV().BinarySearch(byte(1))
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// found
+	{
+		i, found := NewByteSliceV(byte(1), byte(2)).BinarySearch(byte(1))
+		assert.Equal(t, 0, i)
+		assert.True(t, found)
+		i, found = NewByteSliceV(byte(1), byte(2)).BinarySearch("2")
+		assert.Equal(t, 1, i)
+		assert.True(t, found)
+	}
+
+	// not found returns the insertion point
+	{
+		i, found := NewByteSliceV(byte(1)).BinarySearch(byte(2))
+		assert.Equal(t, 1, i)
+		assert.False(t, found)
+		i, found = NewByteSliceV(byte(2)).BinarySearch(byte(1))
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// invalid element
+	{
+		i, found := NewByteSliceV(byte(1)).BinarySearch("foo")
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+}
+
+// Chunk
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Chunk() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	slice.Chunk(2).Each(func(x O) {
+		fmt.Print(x)
+	})
+	// Output: [1 2][1]
+}
+
+func TestByteSlice_Chunk(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *ByteSlice
+		assert.Equal(t, 0, slice.Chunk(2).Len())
+		assert.Equal(t, 0, NewByteSliceV().Chunk(2).Len())
+		assert.Equal(t, 0, NewByteSliceV(byte(1)).Chunk(0).Len())
+	}
+
+	// remainder in the last chunk
+	{
+		slice := NewByteSliceV(byte(1), byte(2), byte(1))
+		chunks := slice.Chunk(2)
+		assert.Equal(t, 2, chunks.Len())
+		assert.Equal(t, []byte{byte(1), byte(2)}, chunks.At(0).O().(ISlice).O())
+		assert.Equal(t, []byte{byte(1)}, chunks.At(1).O().(ISlice).O())
+
+		// chunks are copies
+		chunks.At(0).O().(ISlice).Set(0, byte(2))
+		assert.Equal(t, []byte{byte(1), byte(2), byte(1)}, slice.O())
+	}
+}
+
+// Clear
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Clear() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.Clear())
+	// Output: []
+}
+
+func TestByteSlice_Clear(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, NewByteSliceV(), slice.Clear())
+	assert.Equal(t, (*ByteSlice)(nil), slice)
+
+	slice = NewByteSliceV(byte(1), byte(2))
+	assert.Equal(t, NewByteSliceV(), slice.Clear())
+	assert.Equal(t, NewByteSliceV(), slice)
+}
+
+// Concat
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Concat() {
+	slice := NewByteSliceV(byte(1))
+	fmt.Println(slice.Concat([]byte{byte(2)}))
+	// Output: [1 2]
+}
+
+func TestByteSlice_Concat(t *testing.T) {
+
+	// nil
+	{
+		var slice *ByteSlice
+		assert.Equal(t, NewByteSliceV(byte(1)), slice.Concat([]byte{byte(1)}))
+		assert.Equal(t, (*ByteSlice)(nil), slice)
+	}
+
+	// new slice
+	{
+		slice := NewByteSliceV(byte(1))
+		concat := slice.Concat(NewByteSliceV(byte(2)))
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2)), concat)
+		assert.Equal(t, NewByteSliceV(byte(1)), slice)
+	}
+
+	// conversion
+	{
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2)), NewByteSliceV(byte(1)).Concat([]interface{}{"2"}))
+		assert.Equal(t, NewByteSliceV(byte(1)), NewByteSliceV(byte(1)).Concat(nil))
+	}
+}
+
+// ConcatM
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_ConcatM() {
+	slice := NewByteSliceV(byte(1))
+	fmt.Println(slice.ConcatM([]byte{byte(2)}))
+	// Output: [1 2]
+}
+
+func TestByteSlice_ConcatM(t *testing.T) {
+
+	// nil
+	{
+		var slice *ByteSlice
+		assert.Equal(t, NewByteSliceV(byte(1)), slice.ConcatM([]byte{byte(1)}))
+		assert.Equal(t, (*ByteSlice)(nil), slice)
+	}
+
+	// modifies in place
+	{
+		slice := NewByteSliceV(byte(1))
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2)), slice.ConcatM(NewByteSliceV(byte(2))))
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2)), slice)
+	}
+}
+
+// Copy
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Copy() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.Copy())
+	// Output: [1 2]
+}
+
+func TestByteSlice_Copy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *ByteSlice
+		assert.Equal(t, NewByteSliceV(), slice.Copy())
+		assert.Equal(t, NewByteSliceV(), NewByteSliceV().Copy(0, -1))
+	}
+
+	// copy is independent
+	{
+		slice := NewByteSliceV(byte(1), byte(2))
+		copy := slice.Copy()
+		copy.Set(0, byte(2))
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2)), slice)
+		assert.Equal(t, NewByteSliceV(byte(2), byte(2)), copy)
+	}
+
+	// ranges
+	{
+		slice := NewByteSliceV(byte(1), byte(2), byte(1))
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2), byte(1)), slice.Copy(0, -1))
+		assert.Equal(t, NewByteSliceV(byte(2), byte(1)), slice.Copy(1, -1))
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2)), slice.Copy(0, 1))
+		assert.Equal(t, NewByteSliceV(byte(2)), slice.Copy(-2, -2))
+		assert.Equal(t, NewByteSliceV(), slice.Copy(2, 1))
+	}
+}
+
+// Count
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Count() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	fmt.Println(slice.Count(byte(1)))
+	// Output: 2
+}
+
+func TestByteSlice_Count(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, 0, slice.Count(byte(1)))
+	assert.Equal(t, 0, NewByteSliceV().Count(byte(1)))
+	assert.Equal(t, 2, NewByteSliceV(byte(1), byte(2), byte(1)).Count(byte(1)))
+	assert.Equal(t, 1, NewByteSliceV(byte(1), byte(2), byte(1)).Count(byte(2)))
+	assert.Equal(t, 0, NewByteSliceV(byte(1)).Count("foo"))
+}
+
+// CountW
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_CountW() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	fmt.Println(slice.CountW(func(x O) bool {
+		return x.(byte) == byte(2)
+	}))
+	// Output: 1
+}
+
+func TestByteSlice_CountW(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, 0, slice.CountW(func(x O) bool { return true }))
+	assert.Equal(t, 0, NewByteSliceV().CountW(func(x O) bool { return true }))
+	assert.Equal(t, 3, NewByteSliceV(byte(1), byte(2), byte(1)).CountW(func(x O) bool { return true }))
+	assert.Equal(t, 2, NewByteSliceV(byte(1), byte(2), byte(1)).CountW(func(x O) bool { return x.(byte) == byte(1) }))
+}
+
+// Difference
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Difference() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	fmt.Println(slice.Difference([]byte{byte(2)}))
+	// Output: [1]
+}
+
+func TestByteSlice_Difference(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, []byte{}, slice.Difference([]byte{byte(1)}).O())
+	assert.Equal(t, []byte{byte(1), byte(2)}, NewByteSliceV(byte(1), byte(2), byte(1)).Difference(nil).O())
+	assert.Equal(t, []byte{byte(2)}, NewByteSliceV(byte(1), byte(2), byte(1)).Difference(NewByteSliceV(byte(1))).O())
+	assert.Equal(t, []byte{}, NewByteSliceV(byte(1), byte(2)).Difference([]byte{byte(2), byte(1)}).O())
+}
+
+// Disjoint
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Disjoint() {
+	slice := NewByteSliceV(byte(1), byte(1))
+	fmt.Println(slice.Disjoint([]byte{byte(2)}))
+	// Output: true
+}
+
+func TestByteSlice_Disjoint(t *testing.T) {
+	var slice *ByteSlice
+	assert.True(t, slice.Disjoint([]byte{byte(1)}))
+	assert.True(t, NewByteSliceV(byte(1)).Disjoint(nil))
+	assert.True(t, NewByteSliceV(byte(1)).Disjoint(NewByteSliceV(byte(2))))
+	assert.False(t, NewByteSliceV(byte(1), byte(2)).Disjoint([]byte{byte(2)}))
+}
+
+// Drop
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Drop() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	fmt.Println(slice.Drop(0, 1))
+	// Output: [1]
+}
+
+func TestByteSlice_Drop(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *ByteSlice
+		assert.Equal(t, (*ByteSlice)(nil), slice.Drop(0, 1))
+		assert.Equal(t, NewByteSliceV(), NewByteSliceV().Drop(0, 1))
+	}
+
+	// drop all
+	{
+		slice := NewByteSliceV(byte(1), byte(2), byte(1))
+		assert.Equal(t, NewByteSliceV(), slice.Drop())
+		assert.Equal(t, NewByteSliceV(), slice)
+	}
+
+	// ranges
+	{
+		assert.Equal(t, NewByteSliceV(byte(1)), NewByteSliceV(byte(1), byte(2), byte(1)).Drop(1, -1))
+		assert.Equal(t, NewByteSliceV(byte(1), byte(1)), NewByteSliceV(byte(1), byte(2), byte(1)).Drop(1, 1))
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2)), NewByteSliceV(byte(1), byte(2), byte(1)).Drop(-1, -1))
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2), byte(1)), NewByteSliceV(byte(1), byte(2), byte(1)).Drop(2, 1))
+	}
+}
+
+// DropAt
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_DropAt() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	fmt.Println(slice.DropAt(1))
+	// Output: [1 1]
+}
+
+func TestByteSlice_DropAt(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, (*ByteSlice)(nil), slice.DropAt(0))
+	assert.Equal(t, NewByteSliceV(), NewByteSliceV().DropAt(0))
+	assert.Equal(t, NewByteSliceV(byte(2)), NewByteSliceV(byte(1), byte(2)).DropAt(0))
+	assert.Equal(t, NewByteSliceV(byte(1)), NewByteSliceV(byte(1), byte(2)).DropAt(-1))
+	assert.Equal(t, NewByteSliceV(byte(1), byte(2)), NewByteSliceV(byte(1), byte(2)).DropAt(2))
+}
+
+// DropFirst
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_DropFirst() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.DropFirst())
+	// Output: [2]
+}
+
+func TestByteSlice_DropFirst(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, (*ByteSlice)(nil), slice.DropFirst())
+	assert.Equal(t, NewByteSliceV(), NewByteSliceV().DropFirst())
+	assert.Equal(t, NewByteSliceV(byte(2)), NewByteSliceV(byte(1), byte(2)).DropFirst())
+}
+
+// DropFirstN
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_DropFirstN() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	fmt.Println(slice.DropFirstN(2))
+	// Output: [1]
+}
+
+func TestByteSlice_DropFirstN(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, (*ByteSlice)(nil), slice.DropFirstN(1))
+	assert.Equal(t, NewByteSliceV(byte(1), byte(2)), NewByteSliceV(byte(1), byte(2)).DropFirstN(0))
+	assert.Equal(t, NewByteSliceV(byte(2)), NewByteSliceV(byte(1), byte(2)).DropFirstN(1))
+	assert.Equal(t, NewByteSliceV(), NewByteSliceV(byte(1), byte(2)).DropFirstN(5))
+}
+
+// DropLast
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_DropLast() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.DropLast())
+	// Output: [1]
+}
+
+func TestByteSlice_DropLast(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, (*ByteSlice)(nil), slice.DropLast())
+	assert.Equal(t, NewByteSliceV(), NewByteSliceV().DropLast())
+	assert.Equal(t, NewByteSliceV(byte(1)), NewByteSliceV(byte(1), byte(2)).DropLast())
+}
+
+// DropLastN
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_DropLastN() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	fmt.Println(slice.DropLastN(2))
+	// Output: [1]
+}
+
+func TestByteSlice_DropLastN(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, (*ByteSlice)(nil), slice.DropLastN(1))
+	assert.Equal(t, NewByteSliceV(byte(1), byte(2)), NewByteSliceV(byte(1), byte(2)).DropLastN(0))
+	assert.Equal(t, NewByteSliceV(byte(1)), NewByteSliceV(byte(1), byte(2)).DropLastN(1))
+	assert.Equal(t, NewByteSliceV(), NewByteSliceV(byte(1), byte(2)).DropLastN(5))
+}
+
+// DropW
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_DropW() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	fmt.Println(slice.DropW(func(x O) bool {
+		return x.(byte) == byte(1)
+	}))
+	// Output: [2]
+}
+
+func TestByteSlice_DropW(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, (*ByteSlice)(nil), slice.DropW(func(x O) bool { return true }))
+	assert.Equal(t, NewByteSliceV(), NewByteSliceV(byte(1), byte(2)).DropW(func(x O) bool { return true }))
+	assert.Equal(t, NewByteSliceV(byte(1), byte(1)), NewByteSliceV(byte(1), byte(2), byte(1)).DropW(func(x O) bool {
+		return x.(byte) == byte(2)
+	}))
+}
+
+// Each
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Each() {
+	NewByteSliceV(byte(1), byte(2)).Each(func(x O) {
+		fmt.Print(fmt.Sprintf("%d", x.(byte)), ";")
+	})
+	// Output: 1;2;
+}
+
+func TestByteSlice_Each(t *testing.T) {
+	var slice *ByteSlice
+	slice.Each(func(x O) { assert.Fail(t, "should not be called") })
+
+	var results []byte
+	NewByteSliceV(byte(1), byte(2)).Each(func(x O) {
+		results = append(results, x.(byte))
+	})
+	assert.Equal(t, []byte{byte(1), byte(2)}, results)
+}
+
+// EachE
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_EachE() {
+	NewByteSliceV(byte(1), byte(2)).EachE(func(x O) error {
+		fmt.Print(fmt.Sprintf("%d", x.(byte)), ";")
+		return nil
+	})
+	// Output: 1;2;
+}
+
+func TestByteSlice_EachE(t *testing.T) {
+
+	// nil
+	{
+		var slice *ByteSlice
+		_, err := slice.EachE(func(x O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []byte
+		_, err := NewByteSliceV(byte(1), byte(2)).EachE(func(x O) error {
+			if x.(byte) == byte(2) {
+				return Break
+			}
+			results = append(results, x.(byte))
+			return nil
+		})
+		assert.Equal(t, Break, err)
+		assert.Equal(t, []byte{byte(1)}, results)
+	}
+}
+
+// EachI
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_EachI() {
+	NewByteSliceV(byte(1), byte(2)).EachI(func(i int, x O) {
+		fmt.Print(i, ":", fmt.Sprintf("%d", x.(byte)), ";")
+	})
+	// Output: 0:1;1:2;
+}
+
+func TestByteSlice_EachI(t *testing.T) {
+	var slice *ByteSlice
+	slice.EachI(func(i int, x O) { assert.Fail(t, "should not be called") })
+
+	var results []int
+	NewByteSliceV(byte(1), byte(2)).EachI(func(i int, x O) {
+		results = append(results, i)
+	})
+	assert.Equal(t, []int{0, 1}, results)
+}
+
+// EachIE
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_EachIE() {
+	NewByteSliceV(byte(1), byte(2)).EachIE(func(i int, x O) error {
+		fmt.Print(i, ":", fmt.Sprintf("%d", x.(byte)), ";")
+		return nil
+	})
+	// Output: 0:1;1:2;
+}
+
+func TestByteSlice_EachIE(t *testing.T) {
+
+	// nil
+	{
+		var slice *ByteSlice
+		_, err := slice.EachIE(func(i int, x O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []int
+		_, err := NewByteSliceV(byte(1), byte(2), byte(1)).EachIE(func(i int, x O) error {
+			if i == 1 {
+				return Break
+			}
+			results = append(results, i)
+			return nil
+		})
+		assert.Equal(t, Break, err)
+		assert.Equal(t, []int{0}, results)
+	}
+}
+
+// EachP
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_EachP() {
+	slice, err := NewByteSliceV(byte(1), byte(2)).EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+		return nil
+	})
+	fmt.Println(slice, err)
+	// Output: [1 2] <nil>
+}
+
+func TestByteSlice_EachP(t *testing.T) {
+
+	// nil
+	{
+		var slice *ByteSlice
+		_, err := slice.EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+			return nil
+		})
+		assert.Nil(t, err)
+	}
+
+	// first error is returned
+	{
+		_, err := NewByteSliceV(byte(1), byte(2)).EachP(context.Background(), 0, func(ctx context.Context, x O) error {
+			if x.(byte) == byte(2) {
+				return errors.New("failed")
+			}
+			return nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+
+	// cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := NewByteSliceV(byte(1), byte(2)).EachP(ctx, 2, func(ctx context.Context, x O) error {
+			return nil
+		})
+		assert.Equal(t, context.Canceled, err)
+	}
+}
+
+// EachR
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_EachR() {
+	NewByteSliceV(byte(1), byte(2)).EachR(func(x O) {
+		fmt.Print(fmt.Sprintf("%d", x.(byte)), ";")
+	})
+	// Output: 2;1;
+}
+
+func TestByteSlice_EachR(t *testing.T) {
+	var slice *ByteSlice
+	slice.EachR(func(x O) { assert.Fail(t, "should not be called") })
+
+	var results []byte
+	NewByteSliceV(byte(1), byte(2)).EachR(func(x O) {
+		results = append(results, x.(byte))
+	})
+	assert.Equal(t, []byte{byte(2), byte(1)}, results)
+}
+
+// EachRE
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_EachRE() {
+	NewByteSliceV(byte(1), byte(2)).EachRE(func(x O) error {
+		fmt.Print(fmt.Sprintf("%d", x.(byte)), ";")
+		return nil
+	})
+	// Output: 2;1;
+}
+
+func TestByteSlice_EachRE(t *testing.T) {
+
+	// nil
+	{
+		var slice *ByteSlice
+		_, err := slice.EachRE(func(x O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []byte
+		_, err := NewByteSliceV(byte(1), byte(2)).EachRE(func(x O) error {
+			if x.(byte) == byte(1) {
+				return Break
+			}
+			results = append(results, x.(byte))
+			return nil
+		})
+		assert.Equal(t, Break, err)
+		assert.Equal(t, []byte{byte(2)}, results)
+	}
+}
+
+// EachRI
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_EachRI() {
+	NewByteSliceV(byte(1), byte(2)).EachRI(func(i int, x O) {
+		fmt.Print(i, ":", fmt.Sprintf("%d", x.(byte)), ";")
+	})
+	// Output: 1:2;0:1;
+}
+
+func TestByteSlice_EachRI(t *testing.T) {
+	var slice *ByteSlice
+	slice.EachRI(func(i int, x O) { assert.Fail(t, "should not be called") })
+
+	var results []int
+	NewByteSliceV(byte(1), byte(2)).EachRI(func(i int, x O) {
+		results = append(results, i)
+	})
+	assert.Equal(t, []int{1, 0}, results)
+}
+
+// EachRIE
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_EachRIE() {
+	NewByteSliceV(byte(1), byte(2)).EachRIE(func(i int, x O) error {
+		fmt.Print(i, ":", fmt.Sprintf("%d", x.(byte)), ";")
+		return nil
+	})
+	// Output: 1:2;0:1;
+}
+
+func TestByteSlice_EachRIE(t *testing.T) {
+
+	// nil
+	{
+		var slice *ByteSlice
+		_, err := slice.EachRIE(func(i int, x O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []int
+		_, err := NewByteSliceV(byte(1), byte(2), byte(1)).EachRIE(func(i int, x O) error {
+			if i == 1 {
+				return Break
+			}
+			results = append(results, i)
+			return nil
+		})
+		assert.Equal(t, Break, err)
+		assert.Equal(t, []int{2}, results)
+	}
+}
+
+// Empty
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Empty() {
+	fmt.Println(NewByteSliceV().Empty())
+	// Output: true
+}
+
+func TestByteSlice_Empty(t *testing.T) {
+	assert.True(t, (*ByteSlice)(nil).Empty())
+	assert.True(t, NewByteSliceV().Empty())
+	assert.False(t, NewByteSliceV(byte(1)).Empty())
+}
+
+// First
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_First() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.First().O())
+	// Output: 1
+}
+
+func TestByteSlice_First(t *testing.T) {
+	assert.Equal(t, Obj(nil), (*ByteSlice)(nil).First())
+	assert.Equal(t, Obj(nil), NewByteSliceV().First())
+	assert.Equal(t, Obj(byte(1)), NewByteSliceV(byte(1), byte(2)).First())
+}
+
+// FirstN
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_FirstN() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	fmt.Println(slice.FirstN(2))
+	// Output: [1 2]
+}
+
+func TestByteSlice_FirstN(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, NewByteSliceV(), slice.FirstN(1))
+	assert.Equal(t, NewByteSliceV(), NewByteSliceV(byte(1)).FirstN(0))
+	assert.Equal(t, NewByteSliceV(byte(1)), NewByteSliceV(byte(1), byte(2)).FirstN(1))
+	assert.Equal(t, NewByteSliceV(byte(1), byte(2)), NewByteSliceV(byte(1), byte(2)).FirstN(5))
+
+	// reference to the original
+	{
+		slice := NewByteSliceV(byte(1), byte(2))
+		slice.FirstN(1).Set(0, byte(2))
+		assert.Equal(t, NewByteSliceV(byte(2), byte(2)), slice)
+	}
+}
+
+// FlatMap
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_FlatMap() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.FlatMap(func(x O) O {
+		return []interface{}{x, x}
+	}))
+	// Output: [1 1 2 2]
+}
+
+func TestByteSlice_FlatMap(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, 0, slice.FlatMap(func(x O) O { return x }).Len())
+	assert.Equal(t, 0, NewByteSliceV().FlatMap(func(x O) O { return x }).Len())
+	assert.Equal(t, NewStringSliceV("1", "1", "2", "2"), NewByteSliceV(byte(1), byte(2)).FlatMap(func(x O) O {
+		return []string{fmt.Sprintf("%d", x.(byte)), fmt.Sprintf("%d", x.(byte))}
+	}))
+}
+
+// Flatten
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Flatten() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.Flatten())
+	// Output: [1 2]
+}
+
+func TestByteSlice_Flatten(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, 0, slice.Flatten().Len())
+
+	// already flat so it is a copy
+	{
+		slice := NewByteSliceV(byte(1), byte(2))
+		new := slice.Flatten()
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2)), new)
+		new.Set(0, byte(2))
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2)), slice)
+	}
+}
+
+// G
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_G() {
+	fmt.Println(len(NewByteSliceV(byte(1), byte(2)).G()))
+	// Output: 2
+}
+
+func TestByteSlice_G(t *testing.T) {
+	assert.Equal(t, []byte{}, (*ByteSlice)(nil).G())
+	assert.Equal(t, []byte{}, NewByteSliceV().G())
+	assert.Equal(t, []byte{byte(1), byte(2)}, NewByteSliceV(byte(1), byte(2)).G())
+}
+
+// GroupBy
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_GroupBy() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	groups := slice.GroupBy(func(x O) O {
+		return x.(byte) == byte(1)
+	})
+	fmt.Println(groups.Get(true).O(), groups.Get(false).O())
+	// Output: [1 1] [2]
+}
+
+func TestByteSlice_GroupBy(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, 0, slice.GroupBy(func(x O) O { return x }).Len())
+	assert.Equal(t, 0, NewByteSliceV().GroupBy(func(x O) O { return x }).Len())
+
+	// groups keep first seen order
+	{
+		groups := NewByteSliceV(byte(2), byte(1), byte(2)).GroupBy(func(x O) O {
+			return x.(byte) == byte(1)
+		})
+		assert.Equal(t, []string{"false", "true"}, groups.Keys().O())
+		assert.Equal(t, []byte{byte(2), byte(2)}, groups.Get(false).O().(ISlice).O())
+		assert.Equal(t, []byte{byte(1)}, groups.Get(true).O().(ISlice).O())
+	}
+}
+
+// Index
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Index() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.Index(byte(2)))
+	// Output: 1
+}
+
+func TestByteSlice_Index(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, -1, slice.Index(byte(1)))
+	assert.Equal(t, -1, NewByteSliceV().Index(byte(1)))
+	assert.Equal(t, 0, NewByteSliceV(byte(1), byte(2), byte(1)).Index(byte(1)))
+	assert.Equal(t, 1, NewByteSliceV(byte(1), byte(2)).Index("2"))
+	assert.Equal(t, -1, NewByteSliceV(byte(1)).Index(byte(2)))
+	assert.Equal(t, -1, NewByteSliceV(byte(1)).Index("foo"))
+}
+
+// Insert
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Insert() {
+	slice := NewByteSliceV(byte(1), byte(1))
+	fmt.Println(slice.Insert(1, byte(2)))
+	// Output: [1 2 1]
+}
+
+func TestByteSlice_Insert(t *testing.T) {
+
+	// nil
+	{
+		var slice *ByteSlice
+		assert.Equal(t, NewByteSliceV(byte(1)), slice.Insert(0, byte(1)))
+		assert.Equal(t, (*ByteSlice)(nil), slice)
+	}
+
+	// positions
+	{
+		assert.Equal(t, NewByteSliceV(byte(2), byte(1)), NewByteSliceV(byte(1)).Insert(0, byte(2)))
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2)), NewByteSliceV(byte(1)).Insert(-1, byte(2)))
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2), byte(2), byte(1)), NewByteSliceV(byte(1), byte(1)).Insert(1, []byte{byte(2), byte(2)}))
+		assert.Equal(t, NewByteSliceV(byte(1)), NewByteSliceV(byte(1)).Insert(5, byte(2)))
+	}
+
+	// conversion
+	{
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2), byte(1)), NewByteSliceV(byte(1), byte(1)).Insert(1, "2"))
+		assert.Equal(t, NewByteSliceV(byte(1), byte(1)), NewByteSliceV(byte(1), byte(1)).Insert(1, "foo"))
+	}
+}
+
+// InterSlice
+//--------------------------------------------------------------------------------------------------
+func TestByteSlice_InterSlice(t *testing.T) {
+	assert.False(t, (*ByteSlice)(nil).InterSlice())
+	assert.False(t, NewByteSliceV(byte(1)).InterSlice())
+}
+
+// Intersect
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Intersect() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	fmt.Println(slice.Intersect([]byte{byte(1)}))
+	// Output: [1]
+}
+
+func TestByteSlice_Intersect(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, []byte{}, slice.Intersect([]byte{byte(1)}).O())
+	assert.Equal(t, []byte{}, NewByteSliceV(byte(1)).Intersect(nil).O())
+	assert.Equal(t, []byte{byte(2), byte(1)}, NewByteSliceV(byte(2), byte(1), byte(2)).Intersect(NewByteSliceV(byte(1), byte(2))).O())
+	assert.Equal(t, []byte{}, NewByteSliceV(byte(1), byte(1)).Intersect([]byte{byte(2)}).O())
+}
+
+// Iter
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Iter() {
+	it := NewByteSliceV(byte(1), byte(2)).Iter()
+	for it.Next() {
+		fmt.Println(it.Index(), it.Value())
+	}
+	// Output:
+	// 0 1
+	// 1 2
+}
+
+func TestByteSlice_Iter(t *testing.T) {
+	assert.False(t, (*ByteSlice)(nil).Iter().Next())
+	assert.False(t, NewByteSliceV().Iter().Next())
+
+	it := NewByteSliceV(byte(1), byte(2)).Iter()
+	assert.Equal(t, -1, it.Index())
+	assert.True(t, it.Next())
+	assert.Equal(t, 0, it.Index())
+	assert.Equal(t, Obj(byte(1)).O(), it.Value())
+	assert.True(t, it.Next())
+	assert.Equal(t, 1, it.Index())
+	assert.Equal(t, Obj(byte(2)).O(), it.Value())
+	assert.False(t, it.Next())
+	assert.Nil(t, it.Value())
+	assert.Nil(t, it.Err())
+}
+
+// IsSubset
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_IsSubset() {
+	slice := NewByteSliceV(byte(1), byte(1))
+	fmt.Println(slice.IsSubset([]byte{byte(1), byte(2)}))
+	// Output: true
+}
+
+func TestByteSlice_IsSubset(t *testing.T) {
+	var slice *ByteSlice
+	assert.True(t, slice.IsSubset([]byte{byte(1)}))
+	assert.True(t, NewByteSliceV(byte(2)).IsSubset(NewByteSliceV(byte(1), byte(2))))
+	assert.False(t, NewByteSliceV(byte(1), byte(2)).IsSubset([]byte{byte(2)}))
+	assert.False(t, NewByteSliceV(byte(1)).IsSubset(nil))
+}
+
+// Join
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Join() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.Join())
+	// Output: 1,2
+}
+
+func TestByteSlice_Join(t *testing.T) {
+	assert.Equal(t, Obj(""), (*ByteSlice)(nil).Join())
+	assert.Equal(t, Obj(""), NewByteSliceV().Join())
+	assert.Equal(t, Obj("1"), NewByteSliceV(byte(1)).Join())
+	assert.Equal(t, Obj("1.2"), NewByteSliceV(byte(1), byte(2)).Join("."))
+}
+
+// Last
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Last() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.Last().O())
+	// Output: 2
+}
+
+func TestByteSlice_Last(t *testing.T) {
+	assert.Equal(t, Obj(nil), (*ByteSlice)(nil).Last())
+	assert.Equal(t, Obj(nil), NewByteSliceV().Last())
+	assert.Equal(t, Obj(byte(2)), NewByteSliceV(byte(1), byte(2)).Last())
+}
+
+// LastN
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_LastN() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	fmt.Println(slice.LastN(2))
+	// Output: [2 1]
+}
+
+func TestByteSlice_LastN(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, NewByteSliceV(), slice.LastN(1))
+	assert.Equal(t, NewByteSliceV(), NewByteSliceV(byte(1)).LastN(0))
+	assert.Equal(t, NewByteSliceV(byte(2)), NewByteSliceV(byte(1), byte(2)).LastN(1))
+	assert.Equal(t, NewByteSliceV(byte(1), byte(2)), NewByteSliceV(byte(1), byte(2)).LastN(5))
+}
+
+// Len
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Len() {
+	fmt.Println(NewByteSliceV(byte(1), byte(2)).Len())
+	// Output: 2
+}
+
+func TestByteSlice_Len(t *testing.T) {
+	assert.Equal(t, 0, (*ByteSlice)(nil).Len())
+	assert.Equal(t, 0, NewByteSliceV().Len())
+	assert.Equal(t, 2, NewByteSliceV(byte(1), byte(2)).Len())
+}
+
+// Less
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Less() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.Less(0, 1))
+	// Output: true
+}
+
+func TestByteSlice_Less(t *testing.T) {
+	assert.False(t, (*ByteSlice)(nil).Less(0, 1))
+	assert.False(t, NewByteSliceV(byte(1)).Less(0, 1))
+	assert.True(t, NewByteSliceV(byte(1), byte(2)).Less(0, 1))
+	assert.False(t, NewByteSliceV(byte(1), byte(2)).Less(1, 0))
+	assert.False(t, NewByteSliceV(byte(1), byte(1)).Less(0, 1))
+	assert.False(t, NewByteSliceV(byte(1), byte(2)).Less(0, 2))
+}
+
+// Map
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Map() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.Map(func(x O) O {
+		return byte(2)
+	}))
+	// Output: [2 2]
+}
+
+func TestByteSlice_Map(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, NewByteSliceV(), slice.Map(func(x O) O { return x }))
+	assert.Equal(t, 2, NewByteSliceV(byte(1), byte(2)).Map(func(x O) O { return x }).Len())
+
+	// type change
+	{
+		assert.Equal(t, NewStringSliceV("1", "2"), NewByteSliceV(byte(1), byte(2)).Map(func(x O) O {
+			return fmt.Sprintf("%d", x.(byte))
+		}))
+	}
+}
+
+// MapP
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_MapP() {
+	slice, _ := NewByteSliceV(byte(1), byte(2)).MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+		return x, nil
+	})
+	fmt.Println(slice)
+	// Output: [1 2]
+}
+
+func TestByteSlice_MapP(t *testing.T) {
+
+	// nil
+	{
+		var slice *ByteSlice
+		new, err := slice.MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+			return x, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// first error is returned
+	{
+		_, err := NewByteSliceV(byte(1), byte(2)).MapP(context.Background(), 0, func(ctx context.Context, x O) (O, error) {
+			if x.(byte) == byte(2) {
+				return nil, errors.New("failed")
+			}
+			return x, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+}
+
+// Nil
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Nil() {
+	var slice *ByteSlice
+	fmt.Println(slice.Nil())
+	// Output: true
+}
+
+func TestByteSlice_Nil(t *testing.T) {
+	assert.True(t, (*ByteSlice)(nil).Nil())
+	assert.False(t, NewByteSliceV().Nil())
+	assert.False(t, NewByteSliceV(byte(1)).Nil())
+}
+
+// O
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_O() {
+	fmt.Println(len(NewByteSliceV(byte(1), byte(2)).O().([]byte)))
+	// Output: 2
+}
+
+func TestByteSlice_O(t *testing.T) {
+	assert.Equal(t, []byte{}, (*ByteSlice)(nil).O())
+	assert.Equal(t, []byte{}, NewByteSliceV().O())
+	assert.Equal(t, []byte{byte(1), byte(2)}, NewByteSliceV(byte(1), byte(2)).O())
+}
+
+// Pair
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Pair() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	first, second := slice.Pair()
+	fmt.Println(first.O(), second.O())
+	// Output: 1 2
+}
+
+func TestByteSlice_Pair(t *testing.T) {
+
+	// nil
+	{
+		first, second := (*ByteSlice)(nil).Pair()
+		assert.Equal(t, Obj(nil), first)
+		assert.Equal(t, Obj(nil), second)
+	}
+
+	// one value
+	{
+		first, second := NewByteSliceV(byte(1)).Pair()
+		assert.Equal(t, Obj(byte(1)), first)
+		assert.Equal(t, Obj(nil), second)
+	}
+
+	// two values
+	{
+		first, second := NewByteSliceV(byte(1), byte(2)).Pair()
+		assert.Equal(t, Obj(byte(1)), first)
+		assert.Equal(t, Obj(byte(2)), second)
+	}
+}
+
+// Partition
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Partition() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	match, rest := slice.Partition(func(x O) bool {
+		return x.(byte) == byte(1)
+	})
+	fmt.Println(match, rest)
+	// Output: [1 1] [2]
+}
+
+func TestByteSlice_Partition(t *testing.T) {
+
+	// nil
+	{
+		var slice *ByteSlice
+		match, rest := slice.Partition(func(x O) bool { return true })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+	}
+
+	// all match
+	{
+		match, rest := NewByteSliceV(byte(1), byte(2)).Partition(func(x O) bool { return true })
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2)), match)
+		assert.Equal(t, NewByteSliceV(), rest)
+	}
+}
+
+// Pop
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Pop() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.Pop().O())
+	// Output: 2
+}
+
+func TestByteSlice_Pop(t *testing.T) {
+	assert.Equal(t, Obj(nil), (*ByteSlice)(nil).Pop())
+	assert.Equal(t, Obj(nil), NewByteSliceV().Pop())
+
+	slice := NewByteSliceV(byte(1), byte(2))
+	assert.Equal(t, Obj(byte(2)), slice.Pop())
+	assert.Equal(t, NewByteSliceV(byte(1)), slice)
+}
+
+// PopN
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_PopN() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	fmt.Println(slice.PopN(2))
+	// Output: [2 1]
+}
+
+func TestByteSlice_PopN(t *testing.T) {
+	assert.Equal(t, NewByteSliceV(), (*ByteSlice)(nil).PopN(1))
+	assert.Equal(t, NewByteSliceV(), NewByteSliceV(byte(1)).PopN(0))
+
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	assert.Equal(t, NewByteSliceV(byte(2), byte(1)), slice.PopN(2))
+	assert.Equal(t, NewByteSliceV(byte(1)), slice)
+	assert.Equal(t, NewByteSliceV(byte(1)), slice.PopN(5))
+	assert.Equal(t, NewByteSliceV(), slice)
+}
+
+// Prepend
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Prepend() {
+	slice := NewByteSliceV(byte(2))
+	fmt.Println(slice.Prepend(byte(1)))
+	// Output: [1 2]
+}
+
+func TestByteSlice_Prepend(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, NewByteSliceV(byte(1)), slice.Prepend(byte(1)))
+	assert.Equal(t, NewByteSliceV(byte(2), byte(1)), NewByteSliceV(byte(1)).Prepend("2"))
+}
+
+// RefSlice
+//--------------------------------------------------------------------------------------------------
+func TestByteSlice_RefSlice(t *testing.T) {
+	assert.False(t, (*ByteSlice)(nil).RefSlice())
+	assert.False(t, NewByteSliceV(byte(1)).RefSlice())
+}
+
+// Reverse
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Reverse() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.Reverse())
+	// Output: [2 1]
+}
+
+func TestByteSlice_Reverse(t *testing.T) {
+	assert.Equal(t, NewByteSliceV(), (*ByteSlice)(nil).Reverse())
+
+	slice := NewByteSliceV(byte(1), byte(2))
+	assert.Equal(t, NewByteSliceV(byte(2), byte(1)), slice.Reverse())
+	assert.Equal(t, NewByteSliceV(byte(1), byte(2)), slice)
+}
+
+// ReverseM
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_ReverseM() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.ReverseM())
+	// Output: [2 1]
+}
+
+func TestByteSlice_ReverseM(t *testing.T) {
+	assert.Equal(t, (*ByteSlice)(nil), (*ByteSlice)(nil).ReverseM())
+
+	slice := NewByteSliceV(byte(1), byte(2))
+	assert.Equal(t, NewByteSliceV(byte(2), byte(1)), slice.ReverseM())
+	assert.Equal(t, NewByteSliceV(byte(2), byte(1)), slice)
+}
+
+// S
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_S() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.S())
+	// Output: [1 2]
+}
+
+func TestByteSlice_S(t *testing.T) {
+	assert.Equal(t, NewStringSliceV(), (*ByteSlice)(nil).S())
+	assert.Equal(t, NewStringSliceV("1", "2"), NewByteSliceV(byte(1), byte(2)).S())
+}
+
+// Select
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Select() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	fmt.Println(slice.Select(func(x O) bool {
+		return x.(byte) == byte(1)
+	}))
+	// Output: [1 1]
+}
+
+func TestByteSlice_Select(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, NewByteSliceV(), slice.Select(func(x O) bool { return true }))
+	assert.Equal(t, NewByteSliceV(), NewByteSliceV(byte(1)).Select(func(x O) bool { return false }))
+	assert.Equal(t, NewByteSliceV(byte(2)), NewByteSliceV(byte(1), byte(2), byte(1)).Select(func(x O) bool {
+		return x.(byte) == byte(2)
+	}))
+}
+
+// SelectP
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_SelectP() {
+	slice, _ := NewByteSliceV(byte(1), byte(2), byte(1)).SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+		return x.(byte) == byte(1), nil
+	})
+	fmt.Println(slice)
+	// Output: [1 1]
+}
+
+func TestByteSlice_SelectP(t *testing.T) {
+
+	// nil
+	{
+		var slice *ByteSlice
+		new, err := slice.SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+			return true, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// first error is returned
+	{
+		_, err := NewByteSliceV(byte(1), byte(2)).SelectP(context.Background(), 0, func(ctx context.Context, x O) (bool, error) {
+			if x.(byte) == byte(2) {
+				return false, errors.New("failed")
+			}
+			return true, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+}
+
+// Set
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Set() {
+	slice := NewByteSliceV(byte(1), byte(1))
+	fmt.Println(slice.Set(0, byte(2)))
+	// Output: [2 1]
+}
+
+func TestByteSlice_Set(t *testing.T) {
+	assert.Equal(t, (*ByteSlice)(nil), (*ByteSlice)(nil).Set(0, byte(1)))
+	assert.Equal(t, NewByteSliceV(byte(1), byte(2)), NewByteSliceV(byte(1), byte(1)).Set(-1, byte(2)))
+	assert.Equal(t, NewByteSliceV(byte(2), byte(2)), NewByteSliceV(byte(1), byte(1)).Set(0, []byte{byte(2), byte(2)}))
+	assert.Equal(t, NewByteSliceV(byte(1), byte(1)), NewByteSliceV(byte(1), byte(1)).Set(5, byte(2)))
+}
+
+// SetE
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_SetE() {
+	slice := NewByteSliceV(byte(1), byte(1))
+	fmt.Println(slice.SetE(1, byte(2)))
+	// Output: [1 2] <nil>
+}
+
+func TestByteSlice_SetE(t *testing.T) {
+
+	// nil
+	{
+		slice, err := (*ByteSlice)(nil).SetE(0, byte(1))
+		assert.Nil(t, err)
+		assert.Equal(t, (*ByteSlice)(nil), slice)
+	}
+
+	// conversion
+	{
+		slice, err := NewByteSliceV(byte(1), byte(1)).SetE(0, "2")
+		assert.Nil(t, err)
+		assert.Equal(t, NewByteSliceV(byte(2), byte(1)), slice)
+	}
+
+	// out of bounds
+	{
+		slice, err := NewByteSliceV(byte(1)).SetE(2, byte(2))
+		assert.Equal(t, "slice assignment is out of bounds", err.Error())
+		assert.Equal(t, NewByteSliceV(byte(1)), slice)
+	}
+}
+
+// Shift
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Shift() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.Shift().O())
+	// Output: 1
+}
+
+func TestByteSlice_Shift(t *testing.T) {
+	assert.Equal(t, Obj(nil), (*ByteSlice)(nil).Shift())
+	assert.Equal(t, Obj(nil), NewByteSliceV().Shift())
+
+	slice := NewByteSliceV(byte(1), byte(2))
+	assert.Equal(t, Obj(byte(1)), slice.Shift())
+	assert.Equal(t, NewByteSliceV(byte(2)), slice)
+}
+
+// ShiftN
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_ShiftN() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	fmt.Println(slice.ShiftN(2))
+	// Output: [1 2]
+}
+
+func TestByteSlice_ShiftN(t *testing.T) {
+	assert.Equal(t, NewByteSliceV(), (*ByteSlice)(nil).ShiftN(1))
+	assert.Equal(t, NewByteSliceV(), NewByteSliceV(byte(1)).ShiftN(0))
+
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	assert.Equal(t, NewByteSliceV(byte(1), byte(2)), slice.ShiftN(2))
+	assert.Equal(t, NewByteSliceV(byte(1)), slice)
+	assert.Equal(t, NewByteSliceV(byte(1)), slice.ShiftN(5))
+	assert.Equal(t, NewByteSliceV(), slice)
+}
+
+// Single
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Single() {
+	fmt.Println(NewByteSliceV(byte(1)).Single())
+	// Output: true
+}
+
+func TestByteSlice_Single(t *testing.T) {
+	assert.False(t, (*ByteSlice)(nil).Single())
+	assert.False(t, NewByteSliceV().Single())
+	assert.True(t, NewByteSliceV(byte(1)).Single())
+	assert.False(t, NewByteSliceV(byte(1), byte(2)).Single())
+}
+
+// Slice
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Slice() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	fmt.Println(slice.Slice(1, -1))
+	// Output: [2 1]
+}
+
+func TestByteSlice_Slice(t *testing.T) {
+	assert.Equal(t, NewByteSliceV(), (*ByteSlice)(nil).Slice(0, -1))
+	assert.Equal(t, NewByteSliceV(), NewByteSliceV().Slice(0, -1))
+
+	// reference to the original
+	{
+		slice := NewByteSliceV(byte(1), byte(2), byte(1))
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2), byte(1)), slice.Slice())
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2)), slice.Slice(0, 1))
+		assert.Equal(t, NewByteSliceV(), slice.Slice(2, 1))
+		slice.Slice(-1, -1).Set(0, byte(2))
+		assert.Equal(t, NewByteSliceV(byte(1), byte(2), byte(2)), slice)
+	}
+}
+
+// Sort
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Sort() {
+	slice := NewByteSliceV(byte(2), byte(1))
+	fmt.Println(slice.Sort())
+	// Output: [1 2]
+}
+
+func TestByteSlice_Sort(t *testing.T) {
+	assert.Equal(t, NewByteSliceV(), (*ByteSlice)(nil).Sort())
+
+	slice := NewByteSliceV(byte(2), byte(1), byte(2), byte(1))
+	assert.Equal(t, NewByteSliceV(byte(1), byte(1), byte(2), byte(2)), slice.Sort())
+	assert.Equal(t, NewByteSliceV(byte(2), byte(1), byte(2), byte(1)), slice)
+}
+
+// SortBy
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_SortBy() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.SortBy(func(a, b O) bool {
+		return b.(byte) < a.(byte)
+	}))
+	// Output: [2 1]
+}
+
+func TestByteSlice_SortBy(t *testing.T) {
+	assert.Equal(t, NewByteSliceV(), (*ByteSlice)(nil).SortBy(func(a, b O) bool { return false }))
+
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	assert.Equal(t, NewByteSliceV(byte(2), byte(1), byte(1)), slice.SortBy(func(a, b O) bool {
+		return b.(byte) < a.(byte)
+	}))
+	assert.Equal(t, NewByteSliceV(byte(1), byte(2), byte(1)), slice)
+}
+
+// SortByM
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_SortByM() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.SortByM(func(a, b O) bool {
+		return b.(byte) < a.(byte)
+	}))
+	// Output: [2 1]
+}
+
+func TestByteSlice_SortByM(t *testing.T) {
+	assert.Equal(t, (*ByteSlice)(nil), (*ByteSlice)(nil).SortByM(func(a, b O) bool { return false }))
+
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	assert.Equal(t, NewByteSliceV(byte(2), byte(1), byte(1)), slice.SortByM(func(a, b O) bool {
+		return b.(byte) < a.(byte)
+	}))
+	assert.Equal(t, NewByteSliceV(byte(2), byte(1), byte(1)), slice)
+}
+
+// SortM
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_SortM() {
+	slice := NewByteSliceV(byte(2), byte(1))
+	fmt.Println(slice.SortM())
+	// Output: [1 2]
+}
+
+func TestByteSlice_SortM(t *testing.T) {
+	assert.Equal(t, (*ByteSlice)(nil), (*ByteSlice)(nil).SortM())
+
+	slice := NewByteSliceV(byte(2), byte(1), byte(2))
+	assert.Equal(t, NewByteSliceV(byte(1), byte(2), byte(2)), slice.SortM())
+	assert.Equal(t, NewByteSliceV(byte(1), byte(2), byte(2)), slice)
+}
+
+// SortReverse
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_SortReverse() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.SortReverse())
+	// Output: [2 1]
+}
+
+func TestByteSlice_SortReverse(t *testing.T) {
+	assert.Equal(t, NewByteSliceV(), (*ByteSlice)(nil).SortReverse())
+
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	assert.Equal(t, NewByteSliceV(byte(2), byte(1), byte(1)), slice.SortReverse())
+	assert.Equal(t, NewByteSliceV(byte(1), byte(2), byte(1)), slice)
+}
+
+// SortReverseM
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_SortReverseM() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.SortReverseM())
+	// Output: [2 1]
+}
+
+func TestByteSlice_SortReverseM(t *testing.T) {
+	assert.Equal(t, (*ByteSlice)(nil), (*ByteSlice)(nil).SortReverseM())
+
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	assert.Equal(t, NewByteSliceV(byte(2), byte(1), byte(1)), slice.SortReverseM())
+	assert.Equal(t, NewByteSliceV(byte(2), byte(1), byte(1)), slice)
+}
+
+// SortStable
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_SortStable() {
+	slice := NewByteSliceV(byte(2), byte(1))
+	fmt.Println(slice.SortStable(func(a, b O) bool {
+		return a.(byte) < b.(byte)
+	}))
+	// Output: [1 2]
+}
+
+func TestByteSlice_SortStable(t *testing.T) {
+	assert.Equal(t, NewByteSliceV(), (*ByteSlice)(nil).SortStable(func(a, b O) bool { return false }))
+
+	slice := NewByteSliceV(byte(2), byte(1), byte(2))
+	assert.Equal(t, NewByteSliceV(byte(1), byte(2), byte(2)), slice.SortStable(func(a, b O) bool {
+		return a.(byte) < b.(byte)
+	}))
+	assert.Equal(t, NewByteSliceV(byte(2), byte(1), byte(2)), slice)
+}
+
+// SortStableM
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_SortStableM() {
+	slice := NewByteSliceV(byte(2), byte(1))
+	fmt.Println(slice.SortStableM(func(a, b O) bool {
+		return a.(byte) < b.(byte)
+	}))
+	// Output: [1 2]
+}
+
+func TestByteSlice_SortStableM(t *testing.T) {
+	assert.Equal(t, (*ByteSlice)(nil), (*ByteSlice)(nil).SortStableM(func(a, b O) bool { return false }))
+
+	slice := NewByteSliceV(byte(2), byte(1), byte(2))
+	assert.Equal(t, NewByteSliceV(byte(1), byte(2), byte(2)), slice.SortStableM(func(a, b O) bool {
+		return a.(byte) < b.(byte)
+	}))
+	assert.Equal(t, NewByteSliceV(byte(1), byte(2), byte(2)), slice)
+}
+
+// String
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_String() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.String())
+	// Output: [1 2]
+}
+
+func TestByteSlice_String(t *testing.T) {
+	assert.Equal(t, "[]", (*ByteSlice)(nil).String())
+	assert.Equal(t, "[]", NewByteSliceV().String())
+	assert.Equal(t, "[1 2]", NewByteSliceV(byte(1), byte(2)).String())
+}
+
+// Swap
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Swap() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	slice.Swap(0, 1)
+	fmt.Println(slice)
+	// Output: [2 1]
+}
+
+func TestByteSlice_Swap(t *testing.T) {
+	var slice *ByteSlice
+	slice.Swap(0, 1)
+	assert.Equal(t, (*ByteSlice)(nil), slice)
+
+	slice = NewByteSliceV(byte(1), byte(2))
+	slice.Swap(0, 2)
+	assert.Equal(t, NewByteSliceV(byte(1), byte(2)), slice)
+	slice.Swap(1, 0)
+	assert.Equal(t, NewByteSliceV(byte(2), byte(1)), slice)
+}
+
+// SymmetricDifference
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_SymmetricDifference() {
+	slice := NewByteSliceV(byte(1), byte(1))
+	fmt.Println(slice.SymmetricDifference([]byte{byte(2)}))
+	// Output: [1 2]
+}
+
+func TestByteSlice_SymmetricDifference(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, []byte{byte(1)}, slice.SymmetricDifference([]byte{byte(1), byte(1)}).O())
+	assert.Equal(t, []byte{byte(1)}, NewByteSliceV(byte(1)).SymmetricDifference(nil).O())
+	assert.Equal(t, []byte{}, NewByteSliceV(byte(1), byte(2)).SymmetricDifference(NewByteSliceV(byte(2), byte(1))).O())
+	assert.Equal(t, []byte{byte(2)}, NewByteSliceV(byte(1)).SymmetricDifference([]byte{byte(1), byte(2)}).O())
+}
+
+// Take
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Take() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	fmt.Println(slice.Take(0, 1))
+	// Output: [1 2]
+}
+
+func TestByteSlice_Take(t *testing.T) {
+	assert.Equal(t, NewByteSliceV(), (*ByteSlice)(nil).Take(0, 1))
+
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	assert.Equal(t, NewByteSliceV(byte(2), byte(1)), slice.Take(1, -1))
+	assert.Equal(t, NewByteSliceV(byte(1)), slice)
+	assert.Equal(t, NewByteSliceV(byte(1)), slice.Take())
+	assert.Equal(t, NewByteSliceV(), slice)
+}
+
+// TakeAt
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_TakeAt() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	fmt.Println(slice.TakeAt(1).O())
+	// Output: 2
+}
+
+func TestByteSlice_TakeAt(t *testing.T) {
+	assert.Equal(t, Obj(nil), (*ByteSlice)(nil).TakeAt(0))
+
+	slice := NewByteSliceV(byte(1), byte(2))
+	assert.Equal(t, Obj(nil), slice.TakeAt(2))
+	assert.Equal(t, Obj(byte(2)), slice.TakeAt(-1))
+	assert.Equal(t, NewByteSliceV(byte(1)), slice)
+}
+
+// TakeW
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_TakeW() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	fmt.Println(slice.TakeW(func(x O) bool {
+		return x.(byte) == byte(1)
+	}))
+	// Output: [1 1]
+}
+
+func TestByteSlice_TakeW(t *testing.T) {
+	assert.Equal(t, NewByteSliceV(), (*ByteSlice)(nil).TakeW(func(x O) bool { return true }))
+
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	assert.Equal(t, NewByteSliceV(byte(2)), slice.TakeW(func(x O) bool {
+		return x.(byte) == byte(2)
+	}))
+	assert.Equal(t, NewByteSliceV(byte(1), byte(1)), slice)
+}
+
+// ToStringSlice
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_ToStringSlice() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.ToStringSlice())
+	// Output: [1 2]
+}
+
+func TestByteSlice_ToStringSlice(t *testing.T) {
+	assert.Equal(t, NewStringSliceV(), (*ByteSlice)(nil).ToStringSlice())
+	assert.Equal(t, NewStringSliceV("1", "2"), NewByteSliceV(byte(1), byte(2)).ToStringSlice())
+}
+
+// ToStrs
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_ToStrs() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(slice.ToStrs())
+	// Output: [1 2]
+}
+
+func TestByteSlice_ToStrs(t *testing.T) {
+	assert.Equal(t, []string{}, (*ByteSlice)(nil).ToStrs())
+	assert.Equal(t, []string{"1", "2"}, NewByteSliceV(byte(1), byte(2)).ToStrs())
+}
+
+// ToInterSlice
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_ToInterSlice() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	fmt.Println(len(slice.ToInterSlice()))
+	// Output: 2
+}
+
+func TestByteSlice_ToInterSlice(t *testing.T) {
+	assert.Equal(t, []interface{}{}, (*ByteSlice)(nil).ToInterSlice())
+	assert.Equal(t, []interface{}{byte(1), byte(2)}, NewByteSliceV(byte(1), byte(2)).ToInterSlice())
+}
+
+// Union
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Union() {
+	slice := NewByteSliceV(byte(1), byte(1))
+	fmt.Println(slice.Union([]byte{byte(2), byte(1)}))
+	// Output: [1 2]
+}
+
+func TestByteSlice_Union(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, NewByteSliceV(byte(1)), slice.Union([]byte{byte(1), byte(1)}))
+
+	slice = NewByteSliceV(byte(2), byte(2))
+	assert.Equal(t, NewByteSliceV(byte(2), byte(1)), slice.Union(NewByteSliceV(byte(1))))
+	assert.Equal(t, NewByteSliceV(byte(2), byte(2)), slice)
+}
+
+// UnionM
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_UnionM() {
+	slice := NewByteSliceV(byte(1), byte(1))
+	fmt.Println(slice.UnionM([]byte{byte(2), byte(1)}))
+	// Output: [1 2]
+}
+
+func TestByteSlice_UnionM(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, NewByteSliceV(byte(1)), slice.UnionM([]byte{byte(1), byte(1)}))
+	assert.Equal(t, (*ByteSlice)(nil), slice)
+
+	slice = NewByteSliceV(byte(2), byte(2))
+	assert.Equal(t, NewByteSliceV(byte(2), byte(1)), slice.UnionM(NewByteSliceV(byte(1))))
+	assert.Equal(t, NewByteSliceV(byte(2), byte(1)), slice)
+}
+
+// Uniq
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Uniq() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	fmt.Println(slice.Uniq())
+	// Output: [1 2]
+}
+
+func TestByteSlice_Uniq(t *testing.T) {
+	assert.Equal(t, NewByteSliceV(), (*ByteSlice)(nil).Uniq())
+
+	slice := NewByteSliceV(byte(2), byte(1), byte(2), byte(1))
+	assert.Equal(t, NewByteSliceV(byte(2), byte(1)), slice.Uniq())
+	assert.Equal(t, NewByteSliceV(byte(2), byte(1), byte(2), byte(1)), slice)
+}
+
+// UniqM
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_UniqM() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	fmt.Println(slice.UniqM())
+	// Output: [1 2]
+}
+
+func TestByteSlice_UniqM(t *testing.T) {
+	assert.Equal(t, (*ByteSlice)(nil), (*ByteSlice)(nil).UniqM())
+
+	slice := NewByteSliceV(byte(2), byte(1), byte(2), byte(1))
+	assert.Equal(t, NewByteSliceV(byte(2), byte(1)), slice.UniqM())
+	assert.Equal(t, NewByteSliceV(byte(2), byte(1)), slice)
+}
+
+// Window
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Window() {
+	slice := NewByteSliceV(byte(1), byte(2), byte(1))
+	slice.Window(2, 1).Each(func(x O) {
+		fmt.Print(x)
+	})
+	// Output: [1 2][2 1]
+}
+
+func TestByteSlice_Window(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, 0, slice.Window(2, 1).Len())
+	assert.Equal(t, 0, NewByteSliceV(byte(1), byte(2)).Window(0, 1).Len())
+	assert.Equal(t, 0, NewByteSliceV(byte(1), byte(2)).Window(3, 1).Len())
+
+	windows := NewByteSliceV(byte(1), byte(2), byte(1)).Window(2, 1)
+	assert.Equal(t, 2, windows.Len())
+	assert.Equal(t, []byte{byte(1), byte(2)}, windows.At(0).O().(ISlice).O())
+	assert.Equal(t, []byte{byte(2), byte(1)}, windows.At(1).O().(ISlice).O())
+}
+
+// Zip
+//--------------------------------------------------------------------------------------------------
+func ExampleByteSlice_Zip() {
+	slice := NewByteSliceV(byte(1), byte(2))
+	pair := slice.Zip([]string{"a", "b"}).At(1).O().(ISlice)
+	fmt.Println(pair.At(0).O(), pair.At(1).O())
+	// Output: 2 b
+}
+
+func TestByteSlice_Zip(t *testing.T) {
+	var slice *ByteSlice
+	assert.Equal(t, 0, slice.Zip([]string{"a"}).Len())
+	assert.Equal(t, 0, NewByteSliceV(byte(1)).Zip(nil).Len())
+
+	pairs := NewByteSliceV(byte(1), byte(2), byte(1)).Zip([]string{"a", "b"})
+	assert.Equal(t, 2, pairs.Len())
+	assert.Equal(t, []interface{}{byte(1), "a"}, pairs.At(0).O().(ISlice).O())
+	assert.Equal(t, []interface{}{byte(2), "b"}, pairs.At(1).O().(ISlice).O())
+}
@@ -0,0 +1,1183 @@
+// Code generated by "nubgen -type=bool,byte,time.Duration,time.Time -test"; DO NOT EDIT.
+
+package n
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DurationSlice implements the Slice interface providing a generic way to work with slice types
+// including convenience methods on par with rapid development languages.
+type DurationSlice []time.Duration
+
+// NewDurationSlice creates a new *DurationSlice
+func NewDurationSlice(slice interface{}) *DurationSlice {
+	return ToDurationSlice(slice)
+}
+
+// NewDurationSliceV creates a new *DurationSlice from the given variadic elements. Always returns
+// at least a reference to an empty DurationSlice.
+func NewDurationSliceV(elems ...interface{}) *DurationSlice {
+	return ToDurationSlice(elems)
+}
+
+// A is an alias to String for brevity
+func (p *DurationSlice) A() string {
+	return p.String()
+}
+
+// All tests if this Slice is not empty or optionally if it contains
+// all of the given variadic elements. Incompatible types will return false.
+// Supports all possible time.Duration conversions
+func (p *DurationSlice) All(elems ...interface{}) bool {
+	if p == nil || len(*p) == 0 {
+		return false
+	}
+
+	// Not looking for anything
+	if len(elems) == 0 {
+		return true
+	}
+
+	// Looking for something specific returns false if incompatible type
+	return p.AllS(elems)
+}
+
+// AllS tests if this Slice contains all of the given Slice's elements.
+// Incompatible types will return false.
+// Supports DurationSlice, *DurationSlice, []time.Duration or *[]time.Duration
+func (p *DurationSlice) AllS(slice interface{}) bool {
+	if p == nil || len(*p) == 0 {
+		return false
+	}
+	if elems, err := ToDurationSliceE(slice); err == nil {
+		for i := range *elems {
+			found := false
+			for j := range *p {
+				if (*p)[j] == (*elems)[i] {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	} else {
+		return false
+	}
+	return true
+}
+
+// Any tests if this Slice is not empty or optionally if it contains
+// any of the given variadic elements. Incompatible types will return false.
+// Supports all possible time.Duration conversions
+func (p *DurationSlice) Any(elems ...interface{}) bool {
+	if p == nil || len(*p) == 0 {
+		return false
+	}
+
+	// Not looking for anything
+	if len(elems) == 0 {
+		return true
+	}
+
+	// Looking for something specific returns false if incompatible type
+	return p.AnyS(elems)
+}
+
+// AnyS tests if this Slice contains any of the given Slice's elements.
+// Incompatible types will return false.
+// Supports DurationSlice, *DurationSlice, []time.Duration or *[]time.Duration
+func (p *DurationSlice) AnyS(slice interface{}) bool {
+	if p == nil || len(*p) == 0 {
+		return false
+	}
+	if elems, err := ToDurationSliceE(slice); err == nil {
+		for i := range *elems {
+			for j := range *p {
+				if (*p)[j] == (*elems)[i] {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// AnyW tests if this Slice contains any that match the lambda selector.
+func (p *DurationSlice) AnyW(sel func(O) bool) bool {
+	return p.CountW(sel) != 0
+}
+
+// Append an element to the end of this Slice and returns a reference to this Slice.
+func (p *DurationSlice) Append(elem interface{}) ISlice {
+	if p == nil {
+		p = NewDurationSliceV()
+	}
+	if x, err := ToDurationE(elem); err == nil {
+		*p = append(*p, x)
+	}
+	return p
+}
+
+// AppendV appends the variadic elements to the end of this Slice and returns a reference to this Slice.
+func (p *DurationSlice) AppendV(elems ...interface{}) ISlice {
+	if p == nil {
+		p = NewDurationSliceV()
+	}
+	for _, elem := range elems {
+		if x, err := ToDurationE(elem); err == nil {
+			*p = append(*p, x)
+		}
+	}
+	return p
+}
+
+// At returns the element at the given index location. Allows for negative notation.
+func (p *DurationSlice) At(i int) (elem *Object) {
+	elem = &Object{}
+	if p == nil {
+		return
+	}
+	if i = absIndex(len(*p), i); i == -1 {
+		return
+	}
+	elem.o = (*p)[i]
+	return
+}
+
+// BinarySearch searches this already sorted Slice for the given element returning its index and
+// true if found or the index it would be inserted at and false if not.
+func (p *DurationSlice) BinarySearch(elem interface{}) (i int, found bool) {
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	x, err := ToDurationE(elem)
+	if err != nil {
+		return
+	}
+	i = sort.Search(len(*p), func(j int) bool { return !((*p)[j] < x) })
+	found = i < len(*p) && (*p)[i] == x
+	return
+}
+
+// Chunk creates a new slice of Slices each holding n elements copied from this Slice in order.
+// The last chunk will hold the remaining elements if the length isn't evenly divisible by n.
+// An empty slice is returned if n is less than 1.
+func (p *DurationSlice) Chunk(n int) (chunks ISlice) {
+	x := []interface{}{}
+	if p == nil || len(*p) == 0 || n < 1 {
+		return NewInterSliceV(x...)
+	}
+	for i := 0; i < len(*p); i += n {
+		j := i + n
+		if j > len(*p) {
+			j = len(*p)
+		}
+		x = append(x, p.Copy(i, j-1))
+	}
+	return NewInterSliceV(x...)
+}
+
+// Clear modifies this Slice to clear out all elements and returns a reference to this Slice.
+func (p *DurationSlice) Clear() ISlice {
+	if p == nil {
+		p = NewDurationSliceV()
+	} else {
+		p.Drop()
+	}
+	return p
+}
+
+// Concat returns a new Slice by appending the given Slice to this Slice using variadic expansion.
+// Supports DurationSlice, *DurationSlice, []time.Duration or *[]time.Duration
+func (p *DurationSlice) Concat(slice interface{}) (new ISlice) {
+	return p.Copy().ConcatM(slice)
+}
+
+// ConcatM modifies this Slice by appending the given Slice using variadic expansion and returns a reference to this Slice.
+// Supports DurationSlice, *DurationSlice, []time.Duration or *[]time.Duration
+func (p *DurationSlice) ConcatM(slice interface{}) ISlice {
+	if p == nil {
+		p = NewDurationSliceV()
+	}
+	if elems, err := ToDurationSliceE(slice); err == nil {
+		*p = append(*p, *elems...)
+	}
+	return p
+}
+
+// Copy returns a new Slice with the indicated range of elements copied from this Slice.
+// Expects nothing, in which case everything is copied, or two indices i and j, in which
+// case positive and negative notation is supported and uses an inclusive behavior such
+// that Slice(0, -1) includes index -1 as opposed to Go's exclusive behavior. Out of
+// bounds indices will be moved within bounds.
+//
+// An empty Slice is returned if indicies are mutually exclusive or nothing can be returned.
+func (p *DurationSlice) Copy(indices ...int) (new ISlice) {
+	if p == nil || len(*p) == 0 {
+		return NewDurationSliceV()
+	}
+
+	// Handle index manipulation
+	i, j, err := absIndices(len(*p), indices...)
+	if err != nil {
+		return NewDurationSliceV()
+	}
+
+	// Copy elements over to new Slice
+	x := make([]time.Duration, j-i, j-i)
+	copy(x, (*p)[i:j])
+	return NewDurationSlice(x)
+}
+
+// Count the number of elements in this Slice equal to the given element.
+func (p *DurationSlice) Count(elem interface{}) (cnt int) {
+	if y, ok := elem.(time.Duration); ok {
+		cnt = p.CountW(func(x O) bool { return ExB(x.(time.Duration) == y) })
+	}
+	return
+}
+
+// CountW counts the number of elements in this Slice that match the lambda selector.
+func (p *DurationSlice) CountW(sel func(O) bool) (cnt int) {
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	for i := range *p {
+		if sel((*p)[i]) {
+			cnt++
+		}
+	}
+	return
+}
+
+// Difference returns a new Slice with the uniq elements of this Slice that are not in the given Slice
+// while preserving order.
+// Supports DurationSlice, *DurationSlice, []time.Duration or *[]time.Duration
+func (p *DurationSlice) Difference(slice interface{}) (new ISlice) {
+	other := ToDurationSlice(slice)
+	set, uniq := NewSet(other), NewSet(p)
+	return p.Select(func(x O) bool { return !set.Contains(x) && uniq.Remove(x) })
+}
+
+// Disjoint checks if this Slice has no elements in common with the given Slice.
+// Supports DurationSlice, *DurationSlice, []time.Duration or *[]time.Duration
+func (p *DurationSlice) Disjoint(slice interface{}) bool {
+	other := ToDurationSlice(slice)
+	return NewSet(p).Disjoint(other)
+}
+
+// Drop modifies this Slice to delete the indicated range of elements and returns a referece to this Slice.
+// Expects nothing, in which case everything is dropped, or two indices i and j, in which case positive and
+// negative notation is supported and uses an inclusive behavior such that DropAt(0, -1) includes index -1
+// as opposed to Go's exclusive behavior. Out of bounds indices will be moved within bounds.
+func (p *DurationSlice) Drop(indices ...int) ISlice {
+	if p == nil || len(*p) == 0 {
+		return p
+	}
+
+	// Handle index manipulation
+	i, j, err := absIndices(len(*p), indices...)
+	if err != nil {
+		return p
+	}
+
+	// Execute
+	n := j - i
+	if i+n < len(*p) {
+		*p = append((*p)[:i], (*p)[i+n:]...)
+	} else {
+		*p = (*p)[:i]
+	}
+	return p
+}
+
+// DropAt modifies this Slice to delete the element at the given index location. Allows for negative notation.
+// Returns a reference to this Slice.
+func (p *DurationSlice) DropAt(i int) ISlice {
+	return p.Drop(i, i)
+}
+
+// DropFirst modifies this Slice to delete the first element and returns a reference to this Slice.
+func (p *DurationSlice) DropFirst() ISlice {
+	return p.Drop(0, 0)
+}
+
+// DropFirstN modifies this Slice to delete the first n elements and returns a reference to this Slice.
+func (p *DurationSlice) DropFirstN(n int) ISlice {
+	if n == 0 {
+		return p
+	}
+	return p.Drop(0, abs(n)-1)
+}
+
+// DropLast modifies this Slice to delete the last element and returns a reference to this Slice.
+func (p *DurationSlice) DropLast() ISlice {
+	return p.Drop(-1, -1)
+}
+
+// DropLastN modifies thi Slice to delete the last n elements and returns a reference to this Slice.
+func (p *DurationSlice) DropLastN(n int) ISlice {
+	if n == 0 {
+		return p
+	}
+	return p.Drop(absNeg(n), -1)
+}
+
+// DropW modifies this Slice to delete the elements that match the lambda selector and returns a reference to this Slice.
+// The slice is updated instantly when lambda expression is evaluated not after DropW completes.
+func (p *DurationSlice) DropW(sel func(O) bool) ISlice {
+	if p == nil || len(*p) == 0 {
+		return p
+	}
+	l := len(*p)
+	for i := 0; i < l; i++ {
+		if sel((*p)[i]) {
+			p.DropAt(i)
+			l--
+			i--
+		}
+	}
+	return p
+}
+
+// Each calls the given lambda once for each element in this Slice, passing in that element
+// as a parameter. Returns a reference to this Slice
+func (p *DurationSlice) Each(action func(O)) ISlice {
+	if p == nil {
+		return p
+	}
+	for i := range *p {
+		action((*p)[i])
+	}
+	return p
+}
+
+// EachE calls the given lambda once for each element in this Slice, passing in that element
+// as a parameter. Returns a reference to this Slice and any error from the lambda.
+func (p *DurationSlice) EachE(action func(O) error) (ISlice, error) {
+	var err error
+	if p == nil {
+		return p, err
+	}
+	for i := range *p {
+		if err = action((*p)[i]); err != nil {
+			return p, err
+		}
+	}
+	return p, err
+}
+
+// EachI calls the given lambda once for each element in this Slice, passing in the index and element
+// as a parameter. Returns a reference to this Slice
+func (p *DurationSlice) EachI(action func(int, O)) ISlice {
+	if p == nil {
+		return p
+	}
+	for i := range *p {
+		action(i, (*p)[i])
+	}
+	return p
+}
+
+// EachIE calls the given lambda once for each element in this Slice, passing in the index and element
+// as a parameter. Returns a reference to this Slice and any error from the lambda.
+func (p *DurationSlice) EachIE(action func(int, O) error) (ISlice, error) {
+	var err error
+	if p == nil {
+		return p, err
+	}
+	for i := range *p {
+		if err = action(i, (*p)[i]); err != nil {
+			return p, err
+		}
+	}
+	return p, err
+}
+
+// EachP calls the given lambda once for each element in this Slice, passing in the context and
+// element as parameters, fanning the calls out to the given number of workers or runtime.NumCPU()
+// workers if less than 1. The first error from the lambda or the given context cancels
+// the context passed to the lambda and skips the remaining elements. Returns a reference to this
+// Slice and the first error.
+func (p *DurationSlice) EachP(ctx context.Context, workers int, action func(context.Context, O) error) (ISlice, error) {
+	if p == nil {
+		return p, nil
+	}
+	err := eachP(ctx, len(*p), workers, func(ctx context.Context, i int) error {
+		return action(ctx, (*p)[i])
+	})
+	return p, err
+}
+
+// EachR calls the given lambda once for each element in this Slice in reverse, passing in that element
+// as a parameter. Returns a reference to this Slice
+func (p *DurationSlice) EachR(action func(O)) ISlice {
+	if p == nil {
+		return p
+	}
+	for i := len(*p) - 1; i >= 0; i-- {
+		action((*p)[i])
+	}
+	return p
+}
+
+// EachRE calls the given lambda once for each element in this Slice in reverse, passing in that element
+// as a parameter. Returns a reference to this Slice and any error from the lambda.
+func (p *DurationSlice) EachRE(action func(O) error) (ISlice, error) {
+	var err error
+	if p == nil {
+		return p, err
+	}
+	for i := len(*p) - 1; i >= 0; i-- {
+		if err = action((*p)[i]); err != nil {
+			return p, err
+		}
+	}
+	return p, err
+}
+
+// EachRI calls the given lambda once for each element in this Slice in reverse, passing in that element
+// as a parameter. Returns a reference to this Slice
+func (p *DurationSlice) EachRI(action func(int, O)) ISlice {
+	if p == nil {
+		return p
+	}
+	for i := len(*p) - 1; i >= 0; i-- {
+		action(i, (*p)[i])
+	}
+	return p
+}
+
+// EachRIE calls the given lambda once for each element in this Slice in reverse, passing in that element
+// as a parameter. Returns a reference to this Slice and any error from the lambda.
+func (p *DurationSlice) EachRIE(action func(int, O) error) (ISlice, error) {
+	var err error
+	if p == nil {
+		return p, err
+	}
+	for i := len(*p) - 1; i >= 0; i-- {
+		if err = action(i, (*p)[i]); err != nil {
+			return p, err
+		}
+	}
+	return p, err
+}
+
+// Empty tests if this Slice is empty.
+func (p *DurationSlice) Empty() bool {
+	if p == nil || len(*p) == 0 {
+		return true
+	}
+	return false
+}
+
+// First returns the first element in this Slice as Object.
+// Object.Nil() == true will be returned when there are no elements in the slice.
+func (p *DurationSlice) First() (elem *Object) {
+	return p.At(0)
+}
+
+// FirstN returns the first n elements in this slice as a Slice reference to the original.
+// Best effort is used such that as many as can be will be returned up until the request is satisfied.
+func (p *DurationSlice) FirstN(n int) ISlice {
+	if n == 0 {
+		return NewDurationSliceV()
+	}
+	return p.Slice(0, abs(n)-1)
+}
+
+// FlatMap creates a new slice with the modified elements from the lambda expanding any slice
+// results one level into the new slice.
+func (p *DurationSlice) FlatMap(mod func(O) O) ISlice {
+	if p == nil || len(*p) == 0 {
+		return NewDurationSliceV()
+	}
+	x := []interface{}{}
+	for i := range *p {
+		x = flatAppend(x, mod((*p)[i]))
+	}
+	return newSliceOf(x, NewDurationSliceV())
+}
+
+// Flatten returns a new Slice with any nested slice elements expanded one level into it.
+// The elements of this Slice can't be slices so it is the same as Copy.
+func (p *DurationSlice) Flatten() (new ISlice) {
+	return p.Copy()
+}
+
+// G returns the underlying data structure as a builtin Go type
+func (p *DurationSlice) G() []time.Duration {
+	return p.O().([]time.Duration)
+}
+
+// GroupBy creates a new map of Slices with the elements of this Slice grouped by the key the
+// lambda returns for them. Keys are converted to strings and kept in the order they are
+// first seen while the elements keep their order in each group.
+func (p *DurationSlice) GroupBy(key func(O) O) (groups IMap) {
+	m := NewOrderedMapV()
+	if p == nil || len(*p) == 0 {
+		return m
+	}
+	for i := range *p {
+		k := ToString(key((*p)[i]))
+		if group, ok := m.m[k]; ok {
+			*group.(*DurationSlice) = append(*group.(*DurationSlice), (*p)[i])
+		} else {
+			m.Set(k, &DurationSlice{(*p)[i]})
+		}
+	}
+	return m
+}
+
+// Index returns the index of the first element in this Slice where element == elem
+// Returns a -1 if the element was not not found.
+func (p *DurationSlice) Index(elem interface{}) (loc int) {
+	loc = -1
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	if x, err := ToDurationE(elem); err == nil {
+		for i := range *p {
+			if (*p)[i] == x {
+				return i
+			}
+		}
+	}
+	return
+}
+
+// Insert modifies this Slice to insert the given elements before the element(s) with the given index.
+// Negative indices count backwards from the end of the slice, where -1 is the last element. If a
+// negative index is used, the given element will be inserted after that element, so using an index
+// of -1 will insert the element at the end of the slice. If a Slice is given all elements will be
+// inserted starting from the beging until the end. Slice is returned for chaining. Invalid
+// index locations will not change the slice.
+func (p *DurationSlice) Insert(i int, obj interface{}) ISlice {
+	if p == nil || len(*p) == 0 {
+		return p.ConcatM(obj)
+	}
+
+	// Insert the item before j if pos and after j if neg
+	j := i
+	if j = absIndex(len(*p), j); j == -1 {
+		return p
+	}
+	if i < 0 {
+		j++
+	}
+	if elems, err := ToDurationSliceE(obj); err == nil {
+		if j == 0 {
+			*p = append(*elems, *p...)
+		} else if j < len(*p) {
+			*p = append(*p, *elems...)           // ensures enough space exists
+			copy((*p)[j+len(*elems):], (*p)[j:]) // shifts right elements drop added
+			copy((*p)[j:], *elems)               // set new in locations vacated
+		} else {
+			*p = append(*p, *elems...)
+		}
+	}
+	return p
+}
+
+// InterSlice returns true if the underlying implementation is a RefSlice
+func (p *DurationSlice) InterSlice() bool {
+	return false
+}
+
+// Intersect returns a new Slice with the uniq elements of this Slice that are also in the given Slice
+// while preserving order.
+// Supports DurationSlice, *DurationSlice, []time.Duration or *[]time.Duration
+func (p *DurationSlice) Intersect(slice interface{}) (new ISlice) {
+	other := ToDurationSlice(slice)
+	set, uniq := NewSet(other), NewSet(p)
+	return p.Select(func(x O) bool { return set.Contains(x) && uniq.Remove(x) })
+}
+
+// Iter returns an Iterator over the elements of this Slice in order.
+func (p *DurationSlice) Iter() (it Iterator) {
+	i := 0
+	return NewIterator(func() (O, bool) {
+		if p == nil || i >= len(*p) {
+			return nil, false
+		}
+		i++
+		return (*p)[i-1], true
+	})
+}
+
+// IsSubset checks if all elements of this Slice are in the given Slice.
+// Supports DurationSlice, *DurationSlice, []time.Duration or *[]time.Duration
+func (p *DurationSlice) IsSubset(slice interface{}) bool {
+	other := ToDurationSlice(slice)
+	return NewSet(p).IsSubset(other)
+}
+
+// Join converts each element into a string then joins them together using the given separator or comma by default.
+func (p *DurationSlice) Join(separator ...string) (str *Object) {
+	if p == nil || len(*p) == 0 {
+		str = &Object{""}
+		return
+	}
+	sep := ","
+	if len(separator) > 0 {
+		sep = separator[0]
+	}
+
+	var builder strings.Builder
+	for i := range *p {
+		builder.WriteString((*p)[i].String())
+		if i+1 < len(*p) {
+			builder.WriteString(sep)
+		}
+	}
+	str = &Object{builder.String()}
+	return
+}
+
+// Last returns the last element in this Slice as an Object.
+// Object.Nil() == true will be returned if there are no elements in the slice.
+func (p *DurationSlice) Last() (elem *Object) {
+	return p.At(-1)
+}
+
+// LastN returns the last n elements in this Slice as a Slice reference to the original.
+// Best effort is used such that as many as can be will be returned up until the request is satisfied.
+func (p *DurationSlice) LastN(n int) ISlice {
+	if n == 0 {
+		return NewDurationSliceV()
+	}
+	return p.Slice(absNeg(n), -1)
+}
+
+// Len returns the number of elements in this Slice
+func (p *DurationSlice) Len() int {
+	if p == nil {
+		return 0
+	}
+	return len(*p)
+}
+
+// Less returns true if the element indexed by i is less than the element indexed by j.
+func (p *DurationSlice) Less(i, j int) bool {
+	if p == nil || len(*p) < 2 || i < 0 || j < 0 || i >= len(*p) || j >= len(*p) {
+		return false
+	}
+	return (*p)[i] < (*p)[j]
+}
+
+// Map creates a new slice with the modified elements from the lambda.
+func (p *DurationSlice) Map(mod func(O) O) ISlice {
+	var slice ISlice
+	if p == nil || len(*p) == 0 {
+		return NewDurationSliceV()
+	}
+	for i := range *p {
+		v := mod((*p)[i])
+		if slice == nil {
+			slice = Slice(v)
+		} else {
+			slice.Append(v)
+		}
+	}
+	return slice
+}
+
+// MapP creates a new slice with the modified elements from the lambda, fanning the calls out to
+// the given number of workers or runtime.NumCPU() workers if less than 1 while preserving the
+// element order. The first error from the lambda or the given context cancels the context passed
+// to the lambda and skips the remaining elements. Returns an empty Slice and the first error on
+// failure.
+func (p *DurationSlice) MapP(ctx context.Context, workers int, mod func(context.Context, O) (O, error)) (ISlice, error) {
+	if p == nil || len(*p) == 0 {
+		return NewDurationSliceV(), nil
+	}
+	results := make([]interface{}, len(*p))
+	err := eachP(ctx, len(results), workers, func(ctx context.Context, i int) (err error) {
+		results[i], err = mod(ctx, (*p)[i])
+		return
+	})
+	if err != nil {
+		return NewDurationSliceV(), err
+	}
+	slice := Slice(results[0])
+	for i := 1; i < len(results); i++ {
+		slice.Append(results[i])
+	}
+	return slice, nil
+}
+
+// Nil tests if this Slice is nil
+func (p *DurationSlice) Nil() bool {
+	if p == nil {
+		return true
+	}
+	return false
+}
+
+// O returns the underlying data structure as is
+func (p *DurationSlice) O() interface{} {
+	if p == nil {
+		return []time.Duration{}
+	}
+	return []time.Duration(*p)
+}
+
+// Pair simply returns the first and second Slice elements as Objects
+func (p *DurationSlice) Pair() (first, second *Object) {
+	first, second = &Object{}, &Object{}
+	if p == nil {
+		return
+	}
+	if len(*p) > 0 {
+		first = p.At(0)
+	}
+	if len(*p) > 1 {
+		second = p.At(1)
+	}
+	return
+}
+
+// Partition creates two new slices the first with the elements that match the lambda selector
+// and the second with the elements that don't while preserving element order.
+func (p *DurationSlice) Partition(sel func(O) bool) (match, rest ISlice) {
+	x, y := NewDurationSliceV(), NewDurationSliceV()
+	if p == nil || len(*p) == 0 {
+		return x, y
+	}
+	for i := range *p {
+		if sel((*p)[i]) {
+			*x = append(*x, (*p)[i])
+		} else {
+			*y = append(*y, (*p)[i])
+		}
+	}
+	return x, y
+}
+
+// Pop modifies this Slice to remove the last element and returns the removed element as an Object.
+func (p *DurationSlice) Pop() (elem *Object) {
+	elem = p.Last()
+	p.DropLast()
+	return
+}
+
+// PopN modifies this Slice to remove the last n elements and returns the removed elements as a new Slice.
+func (p *DurationSlice) PopN(n int) (new ISlice) {
+	if n == 0 {
+		return NewDurationSliceV()
+	}
+	new = p.Copy(absNeg(n), -1)
+	p.DropLastN(n)
+	return
+}
+
+// Prepend modifies this Slice to add the given element at the begining and returns a reference to this Slice.
+func (p *DurationSlice) Prepend(elem interface{}) ISlice {
+	return p.Insert(0, elem)
+}
+
+// RefSlice returns true if the underlying implementation is a RefSlice
+func (p *DurationSlice) RefSlice() bool {
+	return false
+}
+
+// Reverse returns a new Slice with the order of the elements reversed.
+func (p *DurationSlice) Reverse() (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().ReverseM()
+}
+
+// ReverseM modifies this Slice reversing the order of the elements and returns a reference to this Slice.
+func (p *DurationSlice) ReverseM() ISlice {
+	if p == nil || len(*p) == 0 {
+		return p
+	}
+	for i, j := 0, len(*p)-1; i < j; i, j = i+1, j-1 {
+		p.Swap(i, j)
+	}
+	return p
+}
+
+// S is an alias to ToStringSlice
+func (p *DurationSlice) S() (slice *StringSlice) {
+	return p.ToStringSlice()
+}
+
+// Select creates a new slice with the elements that match the lambda selector.
+func (p *DurationSlice) Select(sel func(O) bool) (new ISlice) {
+	slice := NewDurationSliceV()
+	if p == nil || len(*p) == 0 {
+		return slice
+	}
+	for i := range *p {
+		if sel((*p)[i]) {
+			*slice = append(*slice, (*p)[i])
+		}
+	}
+	return slice
+}
+
+// SelectP creates a new slice with the elements that match the lambda selector, fanning the calls
+// out to the given number of workers or runtime.NumCPU() workers if less than 1 while preserving
+// the element order. The first error from the lambda or the given context cancels the
+// context passed to the lambda and skips the remaining elements. Returns an empty Slice and the
+// first error on failure.
+func (p *DurationSlice) SelectP(ctx context.Context, workers int, sel func(context.Context, O) (bool, error)) (new ISlice, err error) {
+	slice := NewDurationSliceV()
+	if p == nil || len(*p) == 0 {
+		return slice, nil
+	}
+	hits := make([]bool, len(*p))
+	if err = eachP(ctx, len(hits), workers, func(ctx context.Context, i int) (err error) {
+		hits[i], err = sel(ctx, (*p)[i])
+		return
+	}); err != nil {
+		return slice, err
+	}
+	for i := range hits {
+		if hits[i] {
+			*slice = append(*slice, (*p)[i])
+		}
+	}
+	return slice, nil
+}
+
+// Set the element(s) at the given index location to the given element(s). Allows for negative notation.
+// Returns a reference to this Slice and swallows any errors.
+func (p *DurationSlice) Set(i int, elems interface{}) ISlice {
+	slice, _ := p.SetE(i, elems)
+	return slice
+}
+
+// SetE the element(s) at the given index location to the given element(s). Allows for negative notation.
+// Returns a referenc to this Slice and an error if out of bounds or elem is the wrong type.
+func (p *DurationSlice) SetE(i int, elems interface{}) (ISlice, error) {
+	var err error
+	if p == nil {
+		return p, err
+	}
+	if i = absIndex(len(*p), i); i == -1 {
+		err = errors.Errorf("slice assignment is out of bounds")
+		return p, err
+	}
+
+	// Account for length of elems
+	if x, err := ToDurationSliceE(elems); err == nil {
+		if len(*x) > 0 {
+			copy((*p)[i:], *x)
+		}
+	} else {
+		err = errors.Wrapf(err, "can't set type '%T' in '%T'", elems, p)
+	}
+	return p, err
+}
+
+// Shift modifies this Slice to remove the first element and returns the removed element as an Object.
+func (p *DurationSlice) Shift() (elem *Object) {
+	elem = p.First()
+	p.DropFirst()
+	return
+}
+
+// ShiftN modifies this Slice to remove the first n elements and returns the removed elements as a new Slice.
+func (p *DurationSlice) ShiftN(n int) (new ISlice) {
+	if n == 0 {
+		return NewDurationSliceV()
+	}
+	new = p.Copy(0, abs(n)-1)
+	p.DropFirstN(n)
+	return
+}
+
+// Single reports true if there is only one element in this Slice.
+func (p *DurationSlice) Single() bool {
+	return p.Len() == 1
+}
+
+// Slice returns a range of elements from this Slice as a Slice reference to the original. Allows for negative notation.
+// Expects nothing, in which case everything is included, or two indices i and j, in which case an inclusive behavior
+// is used such that Slice(0, -1) includes index -1 as opposed to Go's exclusive behavior. Out of bounds indices will
+// be moved within bounds.
+//
+// An empty Slice is returned if indicies are mutually exclusive or nothing can be returned.
+func (p *DurationSlice) Slice(indices ...int) ISlice {
+	if p == nil || len(*p) == 0 {
+		return NewDurationSliceV()
+	}
+
+	// Handle index manipulation
+	i, j, err := absIndices(len(*p), indices...)
+	if err != nil {
+		return NewDurationSliceV()
+	}
+
+	slice := DurationSlice((*p)[i:j])
+	return &slice
+}
+
+// Sort returns a new Slice with sorted elements.
+func (p *DurationSlice) Sort() (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortM()
+}
+
+// SortBy returns a new Slice with the elements sorted using the given less lambda.
+func (p *DurationSlice) SortBy(less func(a, b O) bool) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortByM(less)
+}
+
+// SortByM modifies this Slice sorting the elements using the given less lambda and returns a
+// reference to this Slice.
+func (p *DurationSlice) SortByM(less func(a, b O) bool) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sort.Slice(*p, func(i, j int) bool {
+		return less((*p)[i], (*p)[j])
+	})
+	return p
+}
+
+// SortM modifies this Slice sorting the elements and returns a reference to this Slice.
+func (p *DurationSlice) SortM() ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sort.Sort(p)
+	return p
+}
+
+// SortReverse returns a new Slice sorting the elements in reverse.
+func (p *DurationSlice) SortReverse() (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortReverseM()
+}
+
+// SortReverseM modifies this Slice sorting the elements in reverse and returns a reference to this Slice.
+func (p *DurationSlice) SortReverseM() ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sort.Sort(sort.Reverse(p))
+	return p
+}
+
+// SortStable returns a new Slice with the elements sorted using the given less lambda while
+// keeping equal elements in their original order.
+func (p *DurationSlice) SortStable(less func(a, b O) bool) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortStableM(less)
+}
+
+// SortStableM modifies this Slice sorting the elements using the given less lambda while keeping
+// equal elements in their original order and returns a reference to this Slice.
+func (p *DurationSlice) SortStableM(less func(a, b O) bool) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sort.SliceStable(*p, func(i, j int) bool {
+		return less((*p)[i], (*p)[j])
+	})
+	return p
+}
+
+// Returns a string representation of this Slice, implements the Stringer interface
+func (p *DurationSlice) String() string {
+	var builder strings.Builder
+	builder.WriteString("[")
+	if p != nil {
+		for i := range *p {
+			builder.WriteString((*p)[i].String())
+			if i+1 < len(*p) {
+				builder.WriteString(" ")
+			}
+		}
+	}
+	builder.WriteString("]")
+	return builder.String()
+}
+
+// Swap modifies this Slice swapping the indicated elements.
+func (p *DurationSlice) Swap(i, j int) {
+	if p == nil || len(*p) < 2 || i < 0 || j < 0 || i >= len(*p) || j >= len(*p) {
+		return
+	}
+	(*p)[i], (*p)[j] = (*p)[j], (*p)[i]
+}
+
+// SymmetricDifference returns a new Slice with the uniq elements of this Slice that are not in the
+// given Slice followed by the uniq elements of the given Slice that are not in this Slice while
+// preserving order.
+// Supports DurationSlice, *DurationSlice, []time.Duration or *[]time.Duration
+func (p *DurationSlice) SymmetricDifference(slice interface{}) (new ISlice) {
+	other := ToDurationSlice(slice)
+	return p.Difference(other).ConcatM(other.Difference(p))
+}
+
+// Take modifies this Slice removing the indicated range of elements from this Slice and returning them as a new Slice.
+// Expects nothing, in which case everything is taken, or two indices i and j, in which case positive and negative
+// notation is supported and uses an inclusive behavior such that Take(0, -1) includes index -1 as opposed to Go's
+// exclusive behavior. Out of bounds indices will be moved within bounds.
+func (p *DurationSlice) Take(indices ...int) (new ISlice) {
+	new = p.Copy(indices...)
+	p.Drop(indices...)
+	return
+}
+
+// TakeAt modifies this Slice removing the elemement at the given index location and returns the removed element as an Object.
+// Allows for negative notation.
+func (p *DurationSlice) TakeAt(i int) (elem *Object) {
+	elem = p.At(i)
+	p.DropAt(i)
+	return
+}
+
+// TakeW modifies this Slice removing the elements that match the lambda selector and returns them as a new Slice.
+func (p *DurationSlice) TakeW(sel func(O) bool) (new ISlice) {
+	slice := NewDurationSliceV()
+	if p == nil || len(*p) == 0 {
+		return slice
+	}
+	l := len(*p)
+	for i := 0; i < l; i++ {
+		if sel((*p)[i]) {
+			*slice = append(*slice, (*p)[i])
+			p.DropAt(i)
+			l--
+			i--
+		}
+	}
+	return slice
+}
+
+// ToInts converts the underlying slice into a []int
+func (p *DurationSlice) ToInts() (slice []int) {
+	return p.ToIntSlice().G()
+}
+
+// ToIntSlice converts the underlying slice into a *IntSlice
+func (p *DurationSlice) ToIntSlice() (slice *IntSlice) {
+	return ToIntSlice(p.O())
+}
+
+// ToInterSlice converts the given slice to a generic []interface{} slice
+func (p *DurationSlice) ToInterSlice() (slice []interface{}) {
+	return ToInterSlice(p.O()).G()
+}
+
+// ToStringSlice converts the underlying slice into a *StringSlice using the same element
+// formatting as String
+func (p *DurationSlice) ToStringSlice() (slice *StringSlice) {
+	slice = NewStringSliceV()
+	if p == nil {
+		return
+	}
+	for i := range *p {
+		*slice = append(*slice, (*p)[i].String())
+	}
+	return
+}
+
+// ToStrs converts the underlying slice into a []string slice
+func (p *DurationSlice) ToStrs() (slice []string) {
+	return p.ToStringSlice().G()
+}
+
+// Union returns a new Slice by joining uniq elements from this Slice with uniq elements from the given Slice while preserving order.
+// Supports DurationSlice, *DurationSlice, []time.Duration or *[]time.Duration
+func (p *DurationSlice) Union(slice interface{}) (new ISlice) {
+	return p.Copy().UnionM(slice)
+}
+
+// UnionM modifies this Slice by joining uniq elements from this Slice with uniq elements from the given Slice while preserving order.
+// Supports DurationSlice, *DurationSlice, []time.Duration or *[]time.Duration
+func (p *DurationSlice) UnionM(slice interface{}) ISlice {
+	return p.ConcatM(slice).UniqM()
+}
+
+// Uniq returns a new Slice with all non uniq elements removed while preserving element order.
+// Cost for this call vs the UniqM is roughly the same, this one is appending that one dropping.
+func (p *DurationSlice) Uniq() (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	m := map[time.Duration]bool{}
+	slice := NewDurationSliceV()
+	for i := range *p {
+		if k := (*p)[i]; !m[k] {
+			m[k] = true
+			*slice = append(*slice, (*p)[i])
+		}
+	}
+	return slice
+}
+
+// UniqM modifies this Slice to remove all non uniq elements while preserving element order.
+// Cost for this call vs the Uniq is roughly the same, this one is dropping that one appending.
+func (p *DurationSlice) UniqM() ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	m := map[time.Duration]bool{}
+	l := len(*p)
+	for i := 0; i < l; i++ {
+		if k := (*p)[i]; m[k] {
+			p.DropAt(i)
+			l--
+			i--
+		} else {
+			m[k] = true
+		}
+	}
+	return p
+}
+
+// Window creates a new slice of Slices each holding n consecutive elements copied from this Slice
+// starting at every step elements i.e. a sliding window. Only full windows are included and an
+// empty slice is returned if n or step are less than 1.
+func (p *DurationSlice) Window(n, step int) (windows ISlice) {
+	x := []interface{}{}
+	if p == nil || len(*p) == 0 || n < 1 || step < 1 {
+		return NewInterSliceV(x...)
+	}
+	for i := 0; i+n <= len(*p); i += step {
+		x = append(x, p.Copy(i, i+n-1))
+	}
+	return NewInterSliceV(x...)
+}
+
+// Zip creates a new slice of pairs, as InterSlices, combining each element of this Slice with
+// the element at the same index in the given Slice. The result is as long as the shorter Slice.
+func (p *DurationSlice) Zip(slice interface{}) (new ISlice) {
+	x := []interface{}{}
+	other, ok := slice.(ISlice)
+	if !ok {
+		other = Slice(slice)
+	}
+	if p == nil || len(*p) == 0 || other.Len() == 0 {
+		return NewInterSliceV(x...)
+	}
+	for i := 0; i < len(*p) && i < other.Len(); i++ {
+		x = append(x, NewInterSliceV((*p)[i], other.At(i).O()))
+	}
+	return NewInterSliceV(x...)
+}
@@ -0,0 +1,2035 @@
+// Code generated by "nubgen -type=bool,byte,time.Duration,time.Time -test"; DO NOT EDIT.
+
+package n
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// NewDurationSlice
+//--------------------------------------------------------------------------------------------------
+func ExampleNewDurationSlice() {
+	slice := NewDurationSlice([]time.Duration{time.Second, time.Minute})
+	fmt.Println(slice)
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_NewDurationSlice(t *testing.T) {
+
+	// array
+	{
+		var array [2]time.Duration
+		array[0] = time.Second
+		array[1] = time.Minute
+		assert.Equal(t, []time.Duration{time.Second, time.Minute}, NewDurationSlice(array).O())
+		assert.Equal(t, []time.Duration{time.Second, time.Minute}, NewDurationSlice(array[:]).O())
+	}
+
+	// empty
+	{
+		assert.Equal(t, []time.Duration{}, NewDurationSlice(nil).O())
+		assert.Equal(t, []time.Duration{}, NewDurationSlice([]time.Duration{}).O())
+	}
+
+	// conversion
+	{
+		assert.Equal(t, []time.Duration{time.Second}, NewDurationSlice(time.Second).O())
+		assert.Equal(t, []time.Duration{time.Second}, NewDurationSlice("1s").O())
+		assert.Equal(t, []time.Duration{time.Second, time.Minute}, NewDurationSlice([]interface{}{"1s", time.Minute}).O())
+		assert.Equal(t, []time.Duration{}, NewDurationSlice("foo").O())
+	}
+}
+
+// NewDurationSliceV
+//--------------------------------------------------------------------------------------------------
+func ExampleNewDurationSliceV() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice)
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_NewDurationSliceV(t *testing.T) {
+	assert.Equal(t, []time.Duration{}, NewDurationSliceV().O())
+	assert.Equal(t, []time.Duration{time.Second}, NewDurationSliceV(time.Second).O())
+	assert.Equal(t, []time.Duration{time.Second, time.Minute}, NewDurationSliceV(time.Second, time.Minute).O())
+}
+
+// A
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_A() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.A())
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_A(t *testing.T) {
+	assert.Equal(t, "[]", (*DurationSlice)(nil).A())
+	assert.Equal(t, "[1s 1m0s]", NewDurationSliceV(time.Second, time.Minute).A())
+}
+
+// All
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_All() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.All(time.Second, time.Minute))
+	// Output: true
+}
+
+func TestDurationSlice_All(t *testing.T) {
+	var slice *DurationSlice
+	assert.False(t, slice.All())
+	assert.False(t, NewDurationSliceV().All(time.Second))
+	assert.True(t, NewDurationSliceV(time.Second).All())
+	assert.True(t, NewDurationSliceV(time.Second, time.Minute).All(time.Second, time.Minute))
+	assert.False(t, NewDurationSliceV(time.Second).All(time.Second, time.Minute))
+	assert.False(t, NewDurationSliceV(time.Second).All("foo"))
+}
+
+// AllS
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_AllS() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.AllS([]time.Duration{time.Second, time.Minute}))
+	// Output: true
+}
+
+func TestDurationSlice_AllS(t *testing.T) {
+	var slice *DurationSlice
+	assert.False(t, slice.AllS([]time.Duration{time.Second}))
+	assert.False(t, NewDurationSliceV().AllS([]time.Duration{time.Second}))
+	assert.True(t, NewDurationSliceV(time.Second, time.Minute).AllS([]time.Duration{time.Second, time.Minute}))
+	assert.True(t, NewDurationSliceV(time.Second, time.Minute).AllS(NewDurationSliceV(time.Minute)))
+	assert.False(t, NewDurationSliceV(time.Second).AllS([]time.Duration{time.Second, time.Minute}))
+	assert.True(t, NewDurationSliceV(time.Second).AllS(nil))
+}
+
+// Any
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Any() {
+	slice := NewDurationSliceV(time.Second)
+	fmt.Println(slice.Any(time.Second, time.Minute))
+	// Output: true
+}
+
+func TestDurationSlice_Any(t *testing.T) {
+	var slice *DurationSlice
+	assert.False(t, slice.Any())
+	assert.False(t, NewDurationSliceV().Any(time.Second))
+	assert.True(t, NewDurationSliceV(time.Second).Any())
+	assert.True(t, NewDurationSliceV(time.Second).Any(time.Second, time.Minute))
+	assert.False(t, NewDurationSliceV(time.Second).Any(time.Minute))
+	assert.False(t, NewDurationSliceV(time.Second).Any("foo"))
+}
+
+// AnyS
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_AnyS() {
+	slice := NewDurationSliceV(time.Second)
+	fmt.Println(slice.AnyS([]time.Duration{time.Second, time.Minute}))
+	// Output: true
+}
+
+func TestDurationSlice_AnyS(t *testing.T) {
+	var slice *DurationSlice
+	assert.False(t, slice.AnyS([]time.Duration{time.Second}))
+	assert.False(t, NewDurationSliceV().AnyS([]time.Duration{time.Second}))
+	assert.True(t, NewDurationSliceV(time.Second).AnyS([]time.Duration{time.Second, time.Minute}))
+	assert.True(t, NewDurationSliceV(time.Second, time.Minute).AnyS(NewDurationSliceV(time.Minute)))
+	assert.False(t, NewDurationSliceV(time.Second).AnyS([]time.Duration{time.Minute}))
+	assert.False(t, NewDurationSliceV(time.Second).AnyS(nil))
+}
+
+// AnyW
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_AnyW() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.AnyW(func(x O) bool {
+		return x.(time.Duration) == time.Minute
+	}))
+	// Output: true
+}
+
+func TestDurationSlice_AnyW(t *testing.T) {
+	var slice *DurationSlice
+	assert.False(t, slice.AnyW(func(x O) bool { return true }))
+	assert.False(t, NewDurationSliceV().AnyW(func(x O) bool { return true }))
+	assert.True(t, NewDurationSliceV(time.Second, time.Minute).AnyW(func(x O) bool { return x.(time.Duration) == time.Minute }))
+	assert.False(t, NewDurationSliceV(time.Second).AnyW(func(x O) bool { return x.(time.Duration) == time.Minute }))
+}
+
+// Append
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Append() {
+	slice := NewDurationSliceV(time.Second)
+	fmt.Println(slice.Append(time.Minute))
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_Append(t *testing.T) {
+
+	// nil
+	{
+		var slice *DurationSlice
+		assert.Equal(t, NewDurationSliceV(time.Second), slice.Append(time.Second))
+		assert.Equal(t, (*DurationSlice)(nil), slice)
+	}
+
+	// modifies in place
+	{
+		slice := NewDurationSliceV(time.Second)
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), slice.Append(time.Minute))
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), slice)
+	}
+
+	// conversion
+	{
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), NewDurationSliceV(time.Second).Append("1m0s"))
+		assert.Equal(t, NewDurationSliceV(time.Second), NewDurationSliceV(time.Second).Append("foo"))
+	}
+}
+
+// AppendV
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_AppendV() {
+	slice := NewDurationSliceV(time.Second)
+	fmt.Println(slice.AppendV(time.Minute, time.Second))
+	// Output: [1s 1m0s 1s]
+}
+
+func TestDurationSlice_AppendV(t *testing.T) {
+
+	// nil
+	{
+		var slice *DurationSlice
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), slice.AppendV(time.Second, time.Minute))
+		assert.Equal(t, (*DurationSlice)(nil), slice)
+	}
+
+	// modifies in place
+	{
+		slice := NewDurationSliceV(time.Second)
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute, time.Second), slice.AppendV(time.Minute, "1s"))
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute, time.Second), slice)
+	}
+}
+
+// At
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_At() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.At(-1).O())
+	// Output: 1m0s
+}
+
+func TestDurationSlice_At(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, Obj(nil), slice.At(0))
+	assert.Equal(t, Obj(nil), NewDurationSliceV().At(0))
+	assert.Equal(t, Obj(time.Second), NewDurationSliceV(time.Second, time.Minute).At(0))
+	assert.Equal(t, Obj(time.Minute), NewDurationSliceV(time.Second, time.Minute).At(1))
+	assert.Equal(t, Obj(time.Minute), NewDurationSliceV(time.Second, time.Minute).At(-1))
+	assert.Equal(t, Obj(time.Second), NewDurationSliceV(time.Second, time.Minute).At(-2))
+	assert.Equal(t, Obj(nil), NewDurationSliceV(time.Second, time.Minute).At(2))
+	assert.Equal(t, Obj(nil), NewDurationSliceV(time.Second, time.Minute).At(-3))
+}
+
+// BinarySearch
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_BinarySearch() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.BinarySearch(time.Minute))
+	// Output: 1 true
+}
+
+func TestDurationSlice_BinarySearch(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *DurationSlice
+		i, found := slice.BinarySearch(time.Second)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+		i, found = NewDurationSliceV().BinarySearch(time.Second)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// found
+	{
+		i, found := NewDurationSliceV(time.Second, time.Minute).BinarySearch(time.Second)
+		assert.Equal(t, 0, i)
+		assert.True(t, found)
+		i, found = NewDurationSliceV(time.Second, time.Minute).BinarySearch("1m0s")
+		assert.Equal(t, 1, i)
+		assert.True(t, found)
+	}
+
+	// not found returns the insertion point
+	{
+		i, found := NewDurationSliceV(time.Second).BinarySearch(time.Minute)
+		assert.Equal(t, 1, i)
+		assert.False(t, found)
+		i, found = NewDurationSliceV(time.Minute).BinarySearch(time.Second)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// invalid element
+	{
+		i, found := NewDurationSliceV(time.Second).BinarySearch("foo")
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+}
+
+// Chunk
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Chunk() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	slice.Chunk(2).Each(func(x O) {
+		fmt.Print(x)
+	})
+	// Output: [1s 1m0s][1s]
+}
+
+func TestDurationSlice_Chunk(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *DurationSlice
+		assert.Equal(t, 0, slice.Chunk(2).Len())
+		assert.Equal(t, 0, NewDurationSliceV().Chunk(2).Len())
+		assert.Equal(t, 0, NewDurationSliceV(time.Second).Chunk(0).Len())
+	}
+
+	// remainder in the last chunk
+	{
+		slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+		chunks := slice.Chunk(2)
+		assert.Equal(t, 2, chunks.Len())
+		assert.Equal(t, []time.Duration{time.Second, time.Minute}, chunks.At(0).O().(ISlice).O())
+		assert.Equal(t, []time.Duration{time.Second}, chunks.At(1).O().(ISlice).O())
+
+		// chunks are copies
+		chunks.At(0).O().(ISlice).Set(0, time.Minute)
+		assert.Equal(t, []time.Duration{time.Second, time.Minute, time.Second}, slice.O())
+	}
+}
+
+// Clear
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Clear() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.Clear())
+	// Output: []
+}
+
+func TestDurationSlice_Clear(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, NewDurationSliceV(), slice.Clear())
+	assert.Equal(t, (*DurationSlice)(nil), slice)
+
+	slice = NewDurationSliceV(time.Second, time.Minute)
+	assert.Equal(t, NewDurationSliceV(), slice.Clear())
+	assert.Equal(t, NewDurationSliceV(), slice)
+}
+
+// Concat
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Concat() {
+	slice := NewDurationSliceV(time.Second)
+	fmt.Println(slice.Concat([]time.Duration{time.Minute}))
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_Concat(t *testing.T) {
+
+	// nil
+	{
+		var slice *DurationSlice
+		assert.Equal(t, NewDurationSliceV(time.Second), slice.Concat([]time.Duration{time.Second}))
+		assert.Equal(t, (*DurationSlice)(nil), slice)
+	}
+
+	// new slice
+	{
+		slice := NewDurationSliceV(time.Second)
+		concat := slice.Concat(NewDurationSliceV(time.Minute))
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), concat)
+		assert.Equal(t, NewDurationSliceV(time.Second), slice)
+	}
+
+	// conversion
+	{
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), NewDurationSliceV(time.Second).Concat([]interface{}{"1m0s"}))
+		assert.Equal(t, NewDurationSliceV(time.Second), NewDurationSliceV(time.Second).Concat(nil))
+	}
+}
+
+// ConcatM
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_ConcatM() {
+	slice := NewDurationSliceV(time.Second)
+	fmt.Println(slice.ConcatM([]time.Duration{time.Minute}))
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_ConcatM(t *testing.T) {
+
+	// nil
+	{
+		var slice *DurationSlice
+		assert.Equal(t, NewDurationSliceV(time.Second), slice.ConcatM([]time.Duration{time.Second}))
+		assert.Equal(t, (*DurationSlice)(nil), slice)
+	}
+
+	// modifies in place
+	{
+		slice := NewDurationSliceV(time.Second)
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), slice.ConcatM(NewDurationSliceV(time.Minute)))
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), slice)
+	}
+}
+
+// Copy
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Copy() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.Copy())
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_Copy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *DurationSlice
+		assert.Equal(t, NewDurationSliceV(), slice.Copy())
+		assert.Equal(t, NewDurationSliceV(), NewDurationSliceV().Copy(0, -1))
+	}
+
+	// copy is independent
+	{
+		slice := NewDurationSliceV(time.Second, time.Minute)
+		copy := slice.Copy()
+		copy.Set(0, time.Minute)
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), slice)
+		assert.Equal(t, NewDurationSliceV(time.Minute, time.Minute), copy)
+	}
+
+	// ranges
+	{
+		slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute, time.Second), slice.Copy(0, -1))
+		assert.Equal(t, NewDurationSliceV(time.Minute, time.Second), slice.Copy(1, -1))
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), slice.Copy(0, 1))
+		assert.Equal(t, NewDurationSliceV(time.Minute), slice.Copy(-2, -2))
+		assert.Equal(t, NewDurationSliceV(), slice.Copy(2, 1))
+	}
+}
+
+// Count
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Count() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	fmt.Println(slice.Count(time.Second))
+	// Output: 2
+}
+
+func TestDurationSlice_Count(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, 0, slice.Count(time.Second))
+	assert.Equal(t, 0, NewDurationSliceV().Count(time.Second))
+	assert.Equal(t, 2, NewDurationSliceV(time.Second, time.Minute, time.Second).Count(time.Second))
+	assert.Equal(t, 1, NewDurationSliceV(time.Second, time.Minute, time.Second).Count(time.Minute))
+	assert.Equal(t, 0, NewDurationSliceV(time.Second).Count("foo"))
+}
+
+// CountW
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_CountW() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	fmt.Println(slice.CountW(func(x O) bool {
+		return x.(time.Duration) == time.Minute
+	}))
+	// Output: 1
+}
+
+func TestDurationSlice_CountW(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, 0, slice.CountW(func(x O) bool { return true }))
+	assert.Equal(t, 0, NewDurationSliceV().CountW(func(x O) bool { return true }))
+	assert.Equal(t, 3, NewDurationSliceV(time.Second, time.Minute, time.Second).CountW(func(x O) bool { return true }))
+	assert.Equal(t, 2, NewDurationSliceV(time.Second, time.Minute, time.Second).CountW(func(x O) bool { return x.(time.Duration) == time.Second }))
+}
+
+// Difference
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Difference() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	fmt.Println(slice.Difference([]time.Duration{time.Minute}))
+	// Output: [1s]
+}
+
+func TestDurationSlice_Difference(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, []time.Duration{}, slice.Difference([]time.Duration{time.Second}).O())
+	assert.Equal(t, []time.Duration{time.Second, time.Minute}, NewDurationSliceV(time.Second, time.Minute, time.Second).Difference(nil).O())
+	assert.Equal(t, []time.Duration{time.Minute}, NewDurationSliceV(time.Second, time.Minute, time.Second).Difference(NewDurationSliceV(time.Second)).O())
+	assert.Equal(t, []time.Duration{}, NewDurationSliceV(time.Second, time.Minute).Difference([]time.Duration{time.Minute, time.Second}).O())
+}
+
+// Disjoint
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Disjoint() {
+	slice := NewDurationSliceV(time.Second, time.Second)
+	fmt.Println(slice.Disjoint([]time.Duration{time.Minute}))
+	// Output: true
+}
+
+func TestDurationSlice_Disjoint(t *testing.T) {
+	var slice *DurationSlice
+	assert.True(t, slice.Disjoint([]time.Duration{time.Second}))
+	assert.True(t, NewDurationSliceV(time.Second).Disjoint(nil))
+	assert.True(t, NewDurationSliceV(time.Second).Disjoint(NewDurationSliceV(time.Minute)))
+	assert.False(t, NewDurationSliceV(time.Second, time.Minute).Disjoint([]time.Duration{time.Minute}))
+}
+
+// Drop
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Drop() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	fmt.Println(slice.Drop(0, 1))
+	// Output: [1s]
+}
+
+func TestDurationSlice_Drop(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *DurationSlice
+		assert.Equal(t, (*DurationSlice)(nil), slice.Drop(0, 1))
+		assert.Equal(t, NewDurationSliceV(), NewDurationSliceV().Drop(0, 1))
+	}
+
+	// drop all
+	{
+		slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+		assert.Equal(t, NewDurationSliceV(), slice.Drop())
+		assert.Equal(t, NewDurationSliceV(), slice)
+	}
+
+	// ranges
+	{
+		assert.Equal(t, NewDurationSliceV(time.Second), NewDurationSliceV(time.Second, time.Minute, time.Second).Drop(1, -1))
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Second), NewDurationSliceV(time.Second, time.Minute, time.Second).Drop(1, 1))
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), NewDurationSliceV(time.Second, time.Minute, time.Second).Drop(-1, -1))
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute, time.Second), NewDurationSliceV(time.Second, time.Minute, time.Second).Drop(2, 1))
+	}
+}
+
+// DropAt
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_DropAt() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	fmt.Println(slice.DropAt(1))
+	// Output: [1s 1s]
+}
+
+func TestDurationSlice_DropAt(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, (*DurationSlice)(nil), slice.DropAt(0))
+	assert.Equal(t, NewDurationSliceV(), NewDurationSliceV().DropAt(0))
+	assert.Equal(t, NewDurationSliceV(time.Minute), NewDurationSliceV(time.Second, time.Minute).DropAt(0))
+	assert.Equal(t, NewDurationSliceV(time.Second), NewDurationSliceV(time.Second, time.Minute).DropAt(-1))
+	assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), NewDurationSliceV(time.Second, time.Minute).DropAt(2))
+}
+
+// DropFirst
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_DropFirst() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.DropFirst())
+	// Output: [1m0s]
+}
+
+func TestDurationSlice_DropFirst(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, (*DurationSlice)(nil), slice.DropFirst())
+	assert.Equal(t, NewDurationSliceV(), NewDurationSliceV().DropFirst())
+	assert.Equal(t, NewDurationSliceV(time.Minute), NewDurationSliceV(time.Second, time.Minute).DropFirst())
+}
+
+// DropFirstN
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_DropFirstN() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	fmt.Println(slice.DropFirstN(2))
+	// Output: [1s]
+}
+
+func TestDurationSlice_DropFirstN(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, (*DurationSlice)(nil), slice.DropFirstN(1))
+	assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), NewDurationSliceV(time.Second, time.Minute).DropFirstN(0))
+	assert.Equal(t, NewDurationSliceV(time.Minute), NewDurationSliceV(time.Second, time.Minute).DropFirstN(1))
+	assert.Equal(t, NewDurationSliceV(), NewDurationSliceV(time.Second, time.Minute).DropFirstN(5))
+}
+
+// DropLast
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_DropLast() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.DropLast())
+	// Output: [1s]
+}
+
+func TestDurationSlice_DropLast(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, (*DurationSlice)(nil), slice.DropLast())
+	assert.Equal(t, NewDurationSliceV(), NewDurationSliceV().DropLast())
+	assert.Equal(t, NewDurationSliceV(time.Second), NewDurationSliceV(time.Second, time.Minute).DropLast())
+}
+
+// DropLastN
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_DropLastN() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	fmt.Println(slice.DropLastN(2))
+	// Output: [1s]
+}
+
+func TestDurationSlice_DropLastN(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, (*DurationSlice)(nil), slice.DropLastN(1))
+	assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), NewDurationSliceV(time.Second, time.Minute).DropLastN(0))
+	assert.Equal(t, NewDurationSliceV(time.Second), NewDurationSliceV(time.Second, time.Minute).DropLastN(1))
+	assert.Equal(t, NewDurationSliceV(), NewDurationSliceV(time.Second, time.Minute).DropLastN(5))
+}
+
+// DropW
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_DropW() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	fmt.Println(slice.DropW(func(x O) bool {
+		return x.(time.Duration) == time.Second
+	}))
+	// Output: [1m0s]
+}
+
+func TestDurationSlice_DropW(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, (*DurationSlice)(nil), slice.DropW(func(x O) bool { return true }))
+	assert.Equal(t, NewDurationSliceV(), NewDurationSliceV(time.Second, time.Minute).DropW(func(x O) bool { return true }))
+	assert.Equal(t, NewDurationSliceV(time.Second, time.Second), NewDurationSliceV(time.Second, time.Minute, time.Second).DropW(func(x O) bool {
+		return x.(time.Duration) == time.Minute
+	}))
+}
+
+// Each
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Each() {
+	NewDurationSliceV(time.Second, time.Minute).Each(func(x O) {
+		fmt.Print(x.(time.Duration).String(), ";")
+	})
+	// Output: 1s;1m0s;
+}
+
+func TestDurationSlice_Each(t *testing.T) {
+	var slice *DurationSlice
+	slice.Each(func(x O) { assert.Fail(t, "should not be called") })
+
+	var results []time.Duration
+	NewDurationSliceV(time.Second, time.Minute).Each(func(x O) {
+		results = append(results, x.(time.Duration))
+	})
+	assert.Equal(t, []time.Duration{time.Second, time.Minute}, results)
+}
+
+// EachE
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_EachE() {
+	NewDurationSliceV(time.Second, time.Minute).EachE(func(x O) error {
+		fmt.Print(x.(time.Duration).String(), ";")
+		return nil
+	})
+	// Output: 1s;1m0s;
+}
+
+func TestDurationSlice_EachE(t *testing.T) {
+
+	// nil
+	{
+		var slice *DurationSlice
+		_, err := slice.EachE(func(x O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []time.Duration
+		_, err := NewDurationSliceV(time.Second, time.Minute).EachE(func(x O) error {
+			if x.(time.Duration) == time.Minute {
+				return Break
+			}
+			results = append(results, x.(time.Duration))
+			return nil
+		})
+		assert.Equal(t, Break, err)
+		assert.Equal(t, []time.Duration{time.Second}, results)
+	}
+}
+
+// EachI
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_EachI() {
+	NewDurationSliceV(time.Second, time.Minute).EachI(func(i int, x O) {
+		fmt.Print(i, ":", x.(time.Duration).String(), ";")
+	})
+	// Output: 0:1s;1:1m0s;
+}
+
+func TestDurationSlice_EachI(t *testing.T) {
+	var slice *DurationSlice
+	slice.EachI(func(i int, x O) { assert.Fail(t, "should not be called") })
+
+	var results []int
+	NewDurationSliceV(time.Second, time.Minute).EachI(func(i int, x O) {
+		results = append(results, i)
+	})
+	assert.Equal(t, []int{0, 1}, results)
+}
+
+// EachIE
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_EachIE() {
+	NewDurationSliceV(time.Second, time.Minute).EachIE(func(i int, x O) error {
+		fmt.Print(i, ":", x.(time.Duration).String(), ";")
+		return nil
+	})
+	// Output: 0:1s;1:1m0s;
+}
+
+func TestDurationSlice_EachIE(t *testing.T) {
+
+	// nil
+	{
+		var slice *DurationSlice
+		_, err := slice.EachIE(func(i int, x O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []int
+		_, err := NewDurationSliceV(time.Second, time.Minute, time.Second).EachIE(func(i int, x O) error {
+			if i == 1 {
+				return Break
+			}
+			results = append(results, i)
+			return nil
+		})
+		assert.Equal(t, Break, err)
+		assert.Equal(t, []int{0}, results)
+	}
+}
+
+// EachP
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_EachP() {
+	slice, err := NewDurationSliceV(time.Second, time.Minute).EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+		return nil
+	})
+	fmt.Println(slice, err)
+	// Output: [1s 1m0s] <nil>
+}
+
+func TestDurationSlice_EachP(t *testing.T) {
+
+	// nil
+	{
+		var slice *DurationSlice
+		_, err := slice.EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+			return nil
+		})
+		assert.Nil(t, err)
+	}
+
+	// first error is returned
+	{
+		_, err := NewDurationSliceV(time.Second, time.Minute).EachP(context.Background(), 0, func(ctx context.Context, x O) error {
+			if x.(time.Duration) == time.Minute {
+				return errors.New("failed")
+			}
+			return nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+
+	// cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := NewDurationSliceV(time.Second, time.Minute).EachP(ctx, 2, func(ctx context.Context, x O) error {
+			return nil
+		})
+		assert.Equal(t, context.Canceled, err)
+	}
+}
+
+// EachR
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_EachR() {
+	NewDurationSliceV(time.Second, time.Minute).EachR(func(x O) {
+		fmt.Print(x.(time.Duration).String(), ";")
+	})
+	// Output: 1m0s;1s;
+}
+
+func TestDurationSlice_EachR(t *testing.T) {
+	var slice *DurationSlice
+	slice.EachR(func(x O) { assert.Fail(t, "should not be called") })
+
+	var results []time.Duration
+	NewDurationSliceV(time.Second, time.Minute).EachR(func(x O) {
+		results = append(results, x.(time.Duration))
+	})
+	assert.Equal(t, []time.Duration{time.Minute, time.Second}, results)
+}
+
+// EachRE
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_EachRE() {
+	NewDurationSliceV(time.Second, time.Minute).EachRE(func(x O) error {
+		fmt.Print(x.(time.Duration).String(), ";")
+		return nil
+	})
+	// Output: 1m0s;1s;
+}
+
+func TestDurationSlice_EachRE(t *testing.T) {
+
+	// nil
+	{
+		var slice *DurationSlice
+		_, err := slice.EachRE(func(x O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []time.Duration
+		_, err := NewDurationSliceV(time.Second, time.Minute).EachRE(func(x O) error {
+			if x.(time.Duration) == time.Second {
+				return Break
+			}
+			results = append(results, x.(time.Duration))
+			return nil
+		})
+		assert.Equal(t, Break, err)
+		assert.Equal(t, []time.Duration{time.Minute}, results)
+	}
+}
+
+// EachRI
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_EachRI() {
+	NewDurationSliceV(time.Second, time.Minute).EachRI(func(i int, x O) {
+		fmt.Print(i, ":", x.(time.Duration).String(), ";")
+	})
+	// Output: 1:1m0s;0:1s;
+}
+
+func TestDurationSlice_EachRI(t *testing.T) {
+	var slice *DurationSlice
+	slice.EachRI(func(i int, x O) { assert.Fail(t, "should not be called") })
+
+	var results []int
+	NewDurationSliceV(time.Second, time.Minute).EachRI(func(i int, x O) {
+		results = append(results, i)
+	})
+	assert.Equal(t, []int{1, 0}, results)
+}
+
+// EachRIE
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_EachRIE() {
+	NewDurationSliceV(time.Second, time.Minute).EachRIE(func(i int, x O) error {
+		fmt.Print(i, ":", x.(time.Duration).String(), ";")
+		return nil
+	})
+	// Output: 1:1m0s;0:1s;
+}
+
+func TestDurationSlice_EachRIE(t *testing.T) {
+
+	// nil
+	{
+		var slice *DurationSlice
+		_, err := slice.EachRIE(func(i int, x O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []int
+		_, err := NewDurationSliceV(time.Second, time.Minute, time.Second).EachRIE(func(i int, x O) error {
+			if i == 1 {
+				return Break
+			}
+			results = append(results, i)
+			return nil
+		})
+		assert.Equal(t, Break, err)
+		assert.Equal(t, []int{2}, results)
+	}
+}
+
+// Empty
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Empty() {
+	fmt.Println(NewDurationSliceV().Empty())
+	// Output: true
+}
+
+func TestDurationSlice_Empty(t *testing.T) {
+	assert.True(t, (*DurationSlice)(nil).Empty())
+	assert.True(t, NewDurationSliceV().Empty())
+	assert.False(t, NewDurationSliceV(time.Second).Empty())
+}
+
+// First
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_First() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.First().O())
+	// Output: 1s
+}
+
+func TestDurationSlice_First(t *testing.T) {
+	assert.Equal(t, Obj(nil), (*DurationSlice)(nil).First())
+	assert.Equal(t, Obj(nil), NewDurationSliceV().First())
+	assert.Equal(t, Obj(time.Second), NewDurationSliceV(time.Second, time.Minute).First())
+}
+
+// FirstN
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_FirstN() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	fmt.Println(slice.FirstN(2))
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_FirstN(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, NewDurationSliceV(), slice.FirstN(1))
+	assert.Equal(t, NewDurationSliceV(), NewDurationSliceV(time.Second).FirstN(0))
+	assert.Equal(t, NewDurationSliceV(time.Second), NewDurationSliceV(time.Second, time.Minute).FirstN(1))
+	assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), NewDurationSliceV(time.Second, time.Minute).FirstN(5))
+
+	// reference to the original
+	{
+		slice := NewDurationSliceV(time.Second, time.Minute)
+		slice.FirstN(1).Set(0, time.Minute)
+		assert.Equal(t, NewDurationSliceV(time.Minute, time.Minute), slice)
+	}
+}
+
+// FlatMap
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_FlatMap() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.FlatMap(func(x O) O {
+		return []interface{}{x, x}
+	}))
+	// Output: [1s 1s 1m0s 1m0s]
+}
+
+func TestDurationSlice_FlatMap(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, 0, slice.FlatMap(func(x O) O { return x }).Len())
+	assert.Equal(t, 0, NewDurationSliceV().FlatMap(func(x O) O { return x }).Len())
+	assert.Equal(t, NewStringSliceV("1s", "1s", "1m0s", "1m0s"), NewDurationSliceV(time.Second, time.Minute).FlatMap(func(x O) O {
+		return []string{x.(time.Duration).String(), x.(time.Duration).String()}
+	}))
+}
+
+// Flatten
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Flatten() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.Flatten())
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_Flatten(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, 0, slice.Flatten().Len())
+
+	// already flat so it is a copy
+	{
+		slice := NewDurationSliceV(time.Second, time.Minute)
+		new := slice.Flatten()
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), new)
+		new.Set(0, time.Minute)
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), slice)
+	}
+}
+
+// G
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_G() {
+	fmt.Println(len(NewDurationSliceV(time.Second, time.Minute).G()))
+	// Output: 2
+}
+
+func TestDurationSlice_G(t *testing.T) {
+	assert.Equal(t, []time.Duration{}, (*DurationSlice)(nil).G())
+	assert.Equal(t, []time.Duration{}, NewDurationSliceV().G())
+	assert.Equal(t, []time.Duration{time.Second, time.Minute}, NewDurationSliceV(time.Second, time.Minute).G())
+}
+
+// GroupBy
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_GroupBy() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	groups := slice.GroupBy(func(x O) O {
+		return x.(time.Duration) == time.Second
+	})
+	fmt.Println(groups.Get(true).O(), groups.Get(false).O())
+	// Output: [1s 1s] [1m0s]
+}
+
+func TestDurationSlice_GroupBy(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, 0, slice.GroupBy(func(x O) O { return x }).Len())
+	assert.Equal(t, 0, NewDurationSliceV().GroupBy(func(x O) O { return x }).Len())
+
+	// groups keep first seen order
+	{
+		groups := NewDurationSliceV(time.Minute, time.Second, time.Minute).GroupBy(func(x O) O {
+			return x.(time.Duration) == time.Second
+		})
+		assert.Equal(t, []string{"false", "true"}, groups.Keys().O())
+		assert.Equal(t, []time.Duration{time.Minute, time.Minute}, groups.Get(false).O().(ISlice).O())
+		assert.Equal(t, []time.Duration{time.Second}, groups.Get(true).O().(ISlice).O())
+	}
+}
+
+// Index
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Index() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.Index(time.Minute))
+	// Output: 1
+}
+
+func TestDurationSlice_Index(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, -1, slice.Index(time.Second))
+	assert.Equal(t, -1, NewDurationSliceV().Index(time.Second))
+	assert.Equal(t, 0, NewDurationSliceV(time.Second, time.Minute, time.Second).Index(time.Second))
+	assert.Equal(t, 1, NewDurationSliceV(time.Second, time.Minute).Index("1m0s"))
+	assert.Equal(t, -1, NewDurationSliceV(time.Second).Index(time.Minute))
+	assert.Equal(t, -1, NewDurationSliceV(time.Second).Index("foo"))
+}
+
+// Insert
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Insert() {
+	slice := NewDurationSliceV(time.Second, time.Second)
+	fmt.Println(slice.Insert(1, time.Minute))
+	// Output: [1s 1m0s 1s]
+}
+
+func TestDurationSlice_Insert(t *testing.T) {
+
+	// nil
+	{
+		var slice *DurationSlice
+		assert.Equal(t, NewDurationSliceV(time.Second), slice.Insert(0, time.Second))
+		assert.Equal(t, (*DurationSlice)(nil), slice)
+	}
+
+	// positions
+	{
+		assert.Equal(t, NewDurationSliceV(time.Minute, time.Second), NewDurationSliceV(time.Second).Insert(0, time.Minute))
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), NewDurationSliceV(time.Second).Insert(-1, time.Minute))
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute, time.Minute, time.Second), NewDurationSliceV(time.Second, time.Second).Insert(1, []time.Duration{time.Minute, time.Minute}))
+		assert.Equal(t, NewDurationSliceV(time.Second), NewDurationSliceV(time.Second).Insert(5, time.Minute))
+	}
+
+	// conversion
+	{
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute, time.Second), NewDurationSliceV(time.Second, time.Second).Insert(1, "1m0s"))
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Second), NewDurationSliceV(time.Second, time.Second).Insert(1, "foo"))
+	}
+}
+
+// InterSlice
+//--------------------------------------------------------------------------------------------------
+func TestDurationSlice_InterSlice(t *testing.T) {
+	assert.False(t, (*DurationSlice)(nil).InterSlice())
+	assert.False(t, NewDurationSliceV(time.Second).InterSlice())
+}
+
+// Intersect
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Intersect() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	fmt.Println(slice.Intersect([]time.Duration{time.Second}))
+	// Output: [1s]
+}
+
+func TestDurationSlice_Intersect(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, []time.Duration{}, slice.Intersect([]time.Duration{time.Second}).O())
+	assert.Equal(t, []time.Duration{}, NewDurationSliceV(time.Second).Intersect(nil).O())
+	assert.Equal(t, []time.Duration{time.Minute, time.Second}, NewDurationSliceV(time.Minute, time.Second, time.Minute).Intersect(NewDurationSliceV(time.Second, time.Minute)).O())
+	assert.Equal(t, []time.Duration{}, NewDurationSliceV(time.Second, time.Second).Intersect([]time.Duration{time.Minute}).O())
+}
+
+// Iter
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Iter() {
+	it := NewDurationSliceV(time.Second, time.Minute).Iter()
+	for it.Next() {
+		fmt.Println(it.Index(), it.Value())
+	}
+	// Output:
+	// 0 1s
+	// 1 1m0s
+}
+
+func TestDurationSlice_Iter(t *testing.T) {
+	assert.False(t, (*DurationSlice)(nil).Iter().Next())
+	assert.False(t, NewDurationSliceV().Iter().Next())
+
+	it := NewDurationSliceV(time.Second, time.Minute).Iter()
+	assert.Equal(t, -1, it.Index())
+	assert.True(t, it.Next())
+	assert.Equal(t, 0, it.Index())
+	assert.Equal(t, Obj(time.Second).O(), it.Value())
+	assert.True(t, it.Next())
+	assert.Equal(t, 1, it.Index())
+	assert.Equal(t, Obj(time.Minute).O(), it.Value())
+	assert.False(t, it.Next())
+	assert.Nil(t, it.Value())
+	assert.Nil(t, it.Err())
+}
+
+// IsSubset
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_IsSubset() {
+	slice := NewDurationSliceV(time.Second, time.Second)
+	fmt.Println(slice.IsSubset([]time.Duration{time.Second, time.Minute}))
+	// Output: true
+}
+
+func TestDurationSlice_IsSubset(t *testing.T) {
+	var slice *DurationSlice
+	assert.True(t, slice.IsSubset([]time.Duration{time.Second}))
+	assert.True(t, NewDurationSliceV(time.Minute).IsSubset(NewDurationSliceV(time.Second, time.Minute)))
+	assert.False(t, NewDurationSliceV(time.Second, time.Minute).IsSubset([]time.Duration{time.Minute}))
+	assert.False(t, NewDurationSliceV(time.Second).IsSubset(nil))
+}
+
+// Join
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Join() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.Join())
+	// Output: 1s,1m0s
+}
+
+func TestDurationSlice_Join(t *testing.T) {
+	assert.Equal(t, Obj(""), (*DurationSlice)(nil).Join())
+	assert.Equal(t, Obj(""), NewDurationSliceV().Join())
+	assert.Equal(t, Obj("1s"), NewDurationSliceV(time.Second).Join())
+	assert.Equal(t, Obj("1s.1m0s"), NewDurationSliceV(time.Second, time.Minute).Join("."))
+}
+
+// Last
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Last() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.Last().O())
+	// Output: 1m0s
+}
+
+func TestDurationSlice_Last(t *testing.T) {
+	assert.Equal(t, Obj(nil), (*DurationSlice)(nil).Last())
+	assert.Equal(t, Obj(nil), NewDurationSliceV().Last())
+	assert.Equal(t, Obj(time.Minute), NewDurationSliceV(time.Second, time.Minute).Last())
+}
+
+// LastN
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_LastN() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	fmt.Println(slice.LastN(2))
+	// Output: [1m0s 1s]
+}
+
+func TestDurationSlice_LastN(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, NewDurationSliceV(), slice.LastN(1))
+	assert.Equal(t, NewDurationSliceV(), NewDurationSliceV(time.Second).LastN(0))
+	assert.Equal(t, NewDurationSliceV(time.Minute), NewDurationSliceV(time.Second, time.Minute).LastN(1))
+	assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), NewDurationSliceV(time.Second, time.Minute).LastN(5))
+}
+
+// Len
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Len() {
+	fmt.Println(NewDurationSliceV(time.Second, time.Minute).Len())
+	// Output: 2
+}
+
+func TestDurationSlice_Len(t *testing.T) {
+	assert.Equal(t, 0, (*DurationSlice)(nil).Len())
+	assert.Equal(t, 0, NewDurationSliceV().Len())
+	assert.Equal(t, 2, NewDurationSliceV(time.Second, time.Minute).Len())
+}
+
+// Less
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Less() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.Less(0, 1))
+	// Output: true
+}
+
+func TestDurationSlice_Less(t *testing.T) {
+	assert.False(t, (*DurationSlice)(nil).Less(0, 1))
+	assert.False(t, NewDurationSliceV(time.Second).Less(0, 1))
+	assert.True(t, NewDurationSliceV(time.Second, time.Minute).Less(0, 1))
+	assert.False(t, NewDurationSliceV(time.Second, time.Minute).Less(1, 0))
+	assert.False(t, NewDurationSliceV(time.Second, time.Second).Less(0, 1))
+	assert.False(t, NewDurationSliceV(time.Second, time.Minute).Less(0, 2))
+}
+
+// Map
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Map() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.Map(func(x O) O {
+		return time.Minute
+	}))
+	// Output: [1m0s 1m0s]
+}
+
+func TestDurationSlice_Map(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, NewDurationSliceV(), slice.Map(func(x O) O { return x }))
+	assert.Equal(t, 2, NewDurationSliceV(time.Second, time.Minute).Map(func(x O) O { return x }).Len())
+
+	// type change
+	{
+		assert.Equal(t, NewStringSliceV("1s", "1m0s"), NewDurationSliceV(time.Second, time.Minute).Map(func(x O) O {
+			return x.(time.Duration).String()
+		}))
+	}
+}
+
+// MapP
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_MapP() {
+	slice, _ := NewDurationSliceV(time.Second, time.Minute).MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+		return x, nil
+	})
+	fmt.Println(slice)
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_MapP(t *testing.T) {
+
+	// nil
+	{
+		var slice *DurationSlice
+		new, err := slice.MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+			return x, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// first error is returned
+	{
+		_, err := NewDurationSliceV(time.Second, time.Minute).MapP(context.Background(), 0, func(ctx context.Context, x O) (O, error) {
+			if x.(time.Duration) == time.Minute {
+				return nil, errors.New("failed")
+			}
+			return x, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+}
+
+// Nil
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Nil() {
+	var slice *DurationSlice
+	fmt.Println(slice.Nil())
+	// Output: true
+}
+
+func TestDurationSlice_Nil(t *testing.T) {
+	assert.True(t, (*DurationSlice)(nil).Nil())
+	assert.False(t, NewDurationSliceV().Nil())
+	assert.False(t, NewDurationSliceV(time.Second).Nil())
+}
+
+// O
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_O() {
+	fmt.Println(len(NewDurationSliceV(time.Second, time.Minute).O().([]time.Duration)))
+	// Output: 2
+}
+
+func TestDurationSlice_O(t *testing.T) {
+	assert.Equal(t, []time.Duration{}, (*DurationSlice)(nil).O())
+	assert.Equal(t, []time.Duration{}, NewDurationSliceV().O())
+	assert.Equal(t, []time.Duration{time.Second, time.Minute}, NewDurationSliceV(time.Second, time.Minute).O())
+}
+
+// Pair
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Pair() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	first, second := slice.Pair()
+	fmt.Println(first.O(), second.O())
+	// Output: 1s 1m0s
+}
+
+func TestDurationSlice_Pair(t *testing.T) {
+
+	// nil
+	{
+		first, second := (*DurationSlice)(nil).Pair()
+		assert.Equal(t, Obj(nil), first)
+		assert.Equal(t, Obj(nil), second)
+	}
+
+	// one value
+	{
+		first, second := NewDurationSliceV(time.Second).Pair()
+		assert.Equal(t, Obj(time.Second), first)
+		assert.Equal(t, Obj(nil), second)
+	}
+
+	// two values
+	{
+		first, second := NewDurationSliceV(time.Second, time.Minute).Pair()
+		assert.Equal(t, Obj(time.Second), first)
+		assert.Equal(t, Obj(time.Minute), second)
+	}
+}
+
+// Partition
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Partition() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	match, rest := slice.Partition(func(x O) bool {
+		return x.(time.Duration) == time.Second
+	})
+	fmt.Println(match, rest)
+	// Output: [1s 1s] [1m0s]
+}
+
+func TestDurationSlice_Partition(t *testing.T) {
+
+	// nil
+	{
+		var slice *DurationSlice
+		match, rest := slice.Partition(func(x O) bool { return true })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+	}
+
+	// all match
+	{
+		match, rest := NewDurationSliceV(time.Second, time.Minute).Partition(func(x O) bool { return true })
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), match)
+		assert.Equal(t, NewDurationSliceV(), rest)
+	}
+}
+
+// Pop
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Pop() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.Pop().O())
+	// Output: 1m0s
+}
+
+func TestDurationSlice_Pop(t *testing.T) {
+	assert.Equal(t, Obj(nil), (*DurationSlice)(nil).Pop())
+	assert.Equal(t, Obj(nil), NewDurationSliceV().Pop())
+
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	assert.Equal(t, Obj(time.Minute), slice.Pop())
+	assert.Equal(t, NewDurationSliceV(time.Second), slice)
+}
+
+// PopN
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_PopN() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	fmt.Println(slice.PopN(2))
+	// Output: [1m0s 1s]
+}
+
+func TestDurationSlice_PopN(t *testing.T) {
+	assert.Equal(t, NewDurationSliceV(), (*DurationSlice)(nil).PopN(1))
+	assert.Equal(t, NewDurationSliceV(), NewDurationSliceV(time.Second).PopN(0))
+
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Second), slice.PopN(2))
+	assert.Equal(t, NewDurationSliceV(time.Second), slice)
+	assert.Equal(t, NewDurationSliceV(time.Second), slice.PopN(5))
+	assert.Equal(t, NewDurationSliceV(), slice)
+}
+
+// Prepend
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Prepend() {
+	slice := NewDurationSliceV(time.Minute)
+	fmt.Println(slice.Prepend(time.Second))
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_Prepend(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, NewDurationSliceV(time.Second), slice.Prepend(time.Second))
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Second), NewDurationSliceV(time.Second).Prepend("1m0s"))
+}
+
+// RefSlice
+//--------------------------------------------------------------------------------------------------
+func TestDurationSlice_RefSlice(t *testing.T) {
+	assert.False(t, (*DurationSlice)(nil).RefSlice())
+	assert.False(t, NewDurationSliceV(time.Second).RefSlice())
+}
+
+// Reverse
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Reverse() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.Reverse())
+	// Output: [1m0s 1s]
+}
+
+func TestDurationSlice_Reverse(t *testing.T) {
+	assert.Equal(t, NewDurationSliceV(), (*DurationSlice)(nil).Reverse())
+
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Second), slice.Reverse())
+	assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), slice)
+}
+
+// ReverseM
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_ReverseM() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.ReverseM())
+	// Output: [1m0s 1s]
+}
+
+func TestDurationSlice_ReverseM(t *testing.T) {
+	assert.Equal(t, (*DurationSlice)(nil), (*DurationSlice)(nil).ReverseM())
+
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Second), slice.ReverseM())
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Second), slice)
+}
+
+// S
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_S() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.S())
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_S(t *testing.T) {
+	assert.Equal(t, NewStringSliceV(), (*DurationSlice)(nil).S())
+	assert.Equal(t, NewStringSliceV("1s", "1m0s"), NewDurationSliceV(time.Second, time.Minute).S())
+}
+
+// Select
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Select() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	fmt.Println(slice.Select(func(x O) bool {
+		return x.(time.Duration) == time.Second
+	}))
+	// Output: [1s 1s]
+}
+
+func TestDurationSlice_Select(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, NewDurationSliceV(), slice.Select(func(x O) bool { return true }))
+	assert.Equal(t, NewDurationSliceV(), NewDurationSliceV(time.Second).Select(func(x O) bool { return false }))
+	assert.Equal(t, NewDurationSliceV(time.Minute), NewDurationSliceV(time.Second, time.Minute, time.Second).Select(func(x O) bool {
+		return x.(time.Duration) == time.Minute
+	}))
+}
+
+// SelectP
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_SelectP() {
+	slice, _ := NewDurationSliceV(time.Second, time.Minute, time.Second).SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+		return x.(time.Duration) == time.Second, nil
+	})
+	fmt.Println(slice)
+	// Output: [1s 1s]
+}
+
+func TestDurationSlice_SelectP(t *testing.T) {
+
+	// nil
+	{
+		var slice *DurationSlice
+		new, err := slice.SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+			return true, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// first error is returned
+	{
+		_, err := NewDurationSliceV(time.Second, time.Minute).SelectP(context.Background(), 0, func(ctx context.Context, x O) (bool, error) {
+			if x.(time.Duration) == time.Minute {
+				return false, errors.New("failed")
+			}
+			return true, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+}
+
+// Set
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Set() {
+	slice := NewDurationSliceV(time.Second, time.Second)
+	fmt.Println(slice.Set(0, time.Minute))
+	// Output: [1m0s 1s]
+}
+
+func TestDurationSlice_Set(t *testing.T) {
+	assert.Equal(t, (*DurationSlice)(nil), (*DurationSlice)(nil).Set(0, time.Second))
+	assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), NewDurationSliceV(time.Second, time.Second).Set(-1, time.Minute))
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Minute), NewDurationSliceV(time.Second, time.Second).Set(0, []time.Duration{time.Minute, time.Minute}))
+	assert.Equal(t, NewDurationSliceV(time.Second, time.Second), NewDurationSliceV(time.Second, time.Second).Set(5, time.Minute))
+}
+
+// SetE
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_SetE() {
+	slice := NewDurationSliceV(time.Second, time.Second)
+	fmt.Println(slice.SetE(1, time.Minute))
+	// Output: [1s 1m0s] <nil>
+}
+
+func TestDurationSlice_SetE(t *testing.T) {
+
+	// nil
+	{
+		slice, err := (*DurationSlice)(nil).SetE(0, time.Second)
+		assert.Nil(t, err)
+		assert.Equal(t, (*DurationSlice)(nil), slice)
+	}
+
+	// conversion
+	{
+		slice, err := NewDurationSliceV(time.Second, time.Second).SetE(0, "1m0s")
+		assert.Nil(t, err)
+		assert.Equal(t, NewDurationSliceV(time.Minute, time.Second), slice)
+	}
+
+	// out of bounds
+	{
+		slice, err := NewDurationSliceV(time.Second).SetE(2, time.Minute)
+		assert.Equal(t, "slice assignment is out of bounds", err.Error())
+		assert.Equal(t, NewDurationSliceV(time.Second), slice)
+	}
+}
+
+// Shift
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Shift() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.Shift().O())
+	// Output: 1s
+}
+
+func TestDurationSlice_Shift(t *testing.T) {
+	assert.Equal(t, Obj(nil), (*DurationSlice)(nil).Shift())
+	assert.Equal(t, Obj(nil), NewDurationSliceV().Shift())
+
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	assert.Equal(t, Obj(time.Second), slice.Shift())
+	assert.Equal(t, NewDurationSliceV(time.Minute), slice)
+}
+
+// ShiftN
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_ShiftN() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	fmt.Println(slice.ShiftN(2))
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_ShiftN(t *testing.T) {
+	assert.Equal(t, NewDurationSliceV(), (*DurationSlice)(nil).ShiftN(1))
+	assert.Equal(t, NewDurationSliceV(), NewDurationSliceV(time.Second).ShiftN(0))
+
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), slice.ShiftN(2))
+	assert.Equal(t, NewDurationSliceV(time.Second), slice)
+	assert.Equal(t, NewDurationSliceV(time.Second), slice.ShiftN(5))
+	assert.Equal(t, NewDurationSliceV(), slice)
+}
+
+// Single
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Single() {
+	fmt.Println(NewDurationSliceV(time.Second).Single())
+	// Output: true
+}
+
+func TestDurationSlice_Single(t *testing.T) {
+	assert.False(t, (*DurationSlice)(nil).Single())
+	assert.False(t, NewDurationSliceV().Single())
+	assert.True(t, NewDurationSliceV(time.Second).Single())
+	assert.False(t, NewDurationSliceV(time.Second, time.Minute).Single())
+}
+
+// Slice
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Slice() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	fmt.Println(slice.Slice(1, -1))
+	// Output: [1m0s 1s]
+}
+
+func TestDurationSlice_Slice(t *testing.T) {
+	assert.Equal(t, NewDurationSliceV(), (*DurationSlice)(nil).Slice(0, -1))
+	assert.Equal(t, NewDurationSliceV(), NewDurationSliceV().Slice(0, -1))
+
+	// reference to the original
+	{
+		slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute, time.Second), slice.Slice())
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), slice.Slice(0, 1))
+		assert.Equal(t, NewDurationSliceV(), slice.Slice(2, 1))
+		slice.Slice(-1, -1).Set(0, time.Minute)
+		assert.Equal(t, NewDurationSliceV(time.Second, time.Minute, time.Minute), slice)
+	}
+}
+
+// Sort
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Sort() {
+	slice := NewDurationSliceV(time.Minute, time.Second)
+	fmt.Println(slice.Sort())
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_Sort(t *testing.T) {
+	assert.Equal(t, NewDurationSliceV(), (*DurationSlice)(nil).Sort())
+
+	slice := NewDurationSliceV(time.Minute, time.Second, time.Minute, time.Second)
+	assert.Equal(t, NewDurationSliceV(time.Second, time.Second, time.Minute, time.Minute), slice.Sort())
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Second, time.Minute, time.Second), slice)
+}
+
+// SortBy
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_SortBy() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.SortBy(func(a, b O) bool {
+		return b.(time.Duration) < a.(time.Duration)
+	}))
+	// Output: [1m0s 1s]
+}
+
+func TestDurationSlice_SortBy(t *testing.T) {
+	assert.Equal(t, NewDurationSliceV(), (*DurationSlice)(nil).SortBy(func(a, b O) bool { return false }))
+
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Second, time.Second), slice.SortBy(func(a, b O) bool {
+		return b.(time.Duration) < a.(time.Duration)
+	}))
+	assert.Equal(t, NewDurationSliceV(time.Second, time.Minute, time.Second), slice)
+}
+
+// SortByM
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_SortByM() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.SortByM(func(a, b O) bool {
+		return b.(time.Duration) < a.(time.Duration)
+	}))
+	// Output: [1m0s 1s]
+}
+
+func TestDurationSlice_SortByM(t *testing.T) {
+	assert.Equal(t, (*DurationSlice)(nil), (*DurationSlice)(nil).SortByM(func(a, b O) bool { return false }))
+
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Second, time.Second), slice.SortByM(func(a, b O) bool {
+		return b.(time.Duration) < a.(time.Duration)
+	}))
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Second, time.Second), slice)
+}
+
+// SortM
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_SortM() {
+	slice := NewDurationSliceV(time.Minute, time.Second)
+	fmt.Println(slice.SortM())
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_SortM(t *testing.T) {
+	assert.Equal(t, (*DurationSlice)(nil), (*DurationSlice)(nil).SortM())
+
+	slice := NewDurationSliceV(time.Minute, time.Second, time.Minute)
+	assert.Equal(t, NewDurationSliceV(time.Second, time.Minute, time.Minute), slice.SortM())
+	assert.Equal(t, NewDurationSliceV(time.Second, time.Minute, time.Minute), slice)
+}
+
+// SortReverse
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_SortReverse() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.SortReverse())
+	// Output: [1m0s 1s]
+}
+
+func TestDurationSlice_SortReverse(t *testing.T) {
+	assert.Equal(t, NewDurationSliceV(), (*DurationSlice)(nil).SortReverse())
+
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Second, time.Second), slice.SortReverse())
+	assert.Equal(t, NewDurationSliceV(time.Second, time.Minute, time.Second), slice)
+}
+
+// SortReverseM
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_SortReverseM() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.SortReverseM())
+	// Output: [1m0s 1s]
+}
+
+func TestDurationSlice_SortReverseM(t *testing.T) {
+	assert.Equal(t, (*DurationSlice)(nil), (*DurationSlice)(nil).SortReverseM())
+
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Second, time.Second), slice.SortReverseM())
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Second, time.Second), slice)
+}
+
+// SortStable
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_SortStable() {
+	slice := NewDurationSliceV(time.Minute, time.Second)
+	fmt.Println(slice.SortStable(func(a, b O) bool {
+		return a.(time.Duration) < b.(time.Duration)
+	}))
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_SortStable(t *testing.T) {
+	assert.Equal(t, NewDurationSliceV(), (*DurationSlice)(nil).SortStable(func(a, b O) bool { return false }))
+
+	slice := NewDurationSliceV(time.Minute, time.Second, time.Minute)
+	assert.Equal(t, NewDurationSliceV(time.Second, time.Minute, time.Minute), slice.SortStable(func(a, b O) bool {
+		return a.(time.Duration) < b.(time.Duration)
+	}))
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Second, time.Minute), slice)
+}
+
+// SortStableM
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_SortStableM() {
+	slice := NewDurationSliceV(time.Minute, time.Second)
+	fmt.Println(slice.SortStableM(func(a, b O) bool {
+		return a.(time.Duration) < b.(time.Duration)
+	}))
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_SortStableM(t *testing.T) {
+	assert.Equal(t, (*DurationSlice)(nil), (*DurationSlice)(nil).SortStableM(func(a, b O) bool { return false }))
+
+	slice := NewDurationSliceV(time.Minute, time.Second, time.Minute)
+	assert.Equal(t, NewDurationSliceV(time.Second, time.Minute, time.Minute), slice.SortStableM(func(a, b O) bool {
+		return a.(time.Duration) < b.(time.Duration)
+	}))
+	assert.Equal(t, NewDurationSliceV(time.Second, time.Minute, time.Minute), slice)
+}
+
+// String
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_String() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.String())
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_String(t *testing.T) {
+	assert.Equal(t, "[]", (*DurationSlice)(nil).String())
+	assert.Equal(t, "[]", NewDurationSliceV().String())
+	assert.Equal(t, "[1s 1m0s]", NewDurationSliceV(time.Second, time.Minute).String())
+}
+
+// Swap
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Swap() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	slice.Swap(0, 1)
+	fmt.Println(slice)
+	// Output: [1m0s 1s]
+}
+
+func TestDurationSlice_Swap(t *testing.T) {
+	var slice *DurationSlice
+	slice.Swap(0, 1)
+	assert.Equal(t, (*DurationSlice)(nil), slice)
+
+	slice = NewDurationSliceV(time.Second, time.Minute)
+	slice.Swap(0, 2)
+	assert.Equal(t, NewDurationSliceV(time.Second, time.Minute), slice)
+	slice.Swap(1, 0)
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Second), slice)
+}
+
+// SymmetricDifference
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_SymmetricDifference() {
+	slice := NewDurationSliceV(time.Second, time.Second)
+	fmt.Println(slice.SymmetricDifference([]time.Duration{time.Minute}))
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_SymmetricDifference(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, []time.Duration{time.Second}, slice.SymmetricDifference([]time.Duration{time.Second, time.Second}).O())
+	assert.Equal(t, []time.Duration{time.Second}, NewDurationSliceV(time.Second).SymmetricDifference(nil).O())
+	assert.Equal(t, []time.Duration{}, NewDurationSliceV(time.Second, time.Minute).SymmetricDifference(NewDurationSliceV(time.Minute, time.Second)).O())
+	assert.Equal(t, []time.Duration{time.Minute}, NewDurationSliceV(time.Second).SymmetricDifference([]time.Duration{time.Second, time.Minute}).O())
+}
+
+// Take
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Take() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	fmt.Println(slice.Take(0, 1))
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_Take(t *testing.T) {
+	assert.Equal(t, NewDurationSliceV(), (*DurationSlice)(nil).Take(0, 1))
+
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Second), slice.Take(1, -1))
+	assert.Equal(t, NewDurationSliceV(time.Second), slice)
+	assert.Equal(t, NewDurationSliceV(time.Second), slice.Take())
+	assert.Equal(t, NewDurationSliceV(), slice)
+}
+
+// TakeAt
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_TakeAt() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	fmt.Println(slice.TakeAt(1).O())
+	// Output: 1m0s
+}
+
+func TestDurationSlice_TakeAt(t *testing.T) {
+	assert.Equal(t, Obj(nil), (*DurationSlice)(nil).TakeAt(0))
+
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	assert.Equal(t, Obj(nil), slice.TakeAt(2))
+	assert.Equal(t, Obj(time.Minute), slice.TakeAt(-1))
+	assert.Equal(t, NewDurationSliceV(time.Second), slice)
+}
+
+// TakeW
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_TakeW() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	fmt.Println(slice.TakeW(func(x O) bool {
+		return x.(time.Duration) == time.Second
+	}))
+	// Output: [1s 1s]
+}
+
+func TestDurationSlice_TakeW(t *testing.T) {
+	assert.Equal(t, NewDurationSliceV(), (*DurationSlice)(nil).TakeW(func(x O) bool { return true }))
+
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	assert.Equal(t, NewDurationSliceV(time.Minute), slice.TakeW(func(x O) bool {
+		return x.(time.Duration) == time.Minute
+	}))
+	assert.Equal(t, NewDurationSliceV(time.Second, time.Second), slice)
+}
+
+// ToStringSlice
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_ToStringSlice() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.ToStringSlice())
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_ToStringSlice(t *testing.T) {
+	assert.Equal(t, NewStringSliceV(), (*DurationSlice)(nil).ToStringSlice())
+	assert.Equal(t, NewStringSliceV("1s", "1m0s"), NewDurationSliceV(time.Second, time.Minute).ToStringSlice())
+}
+
+// ToStrs
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_ToStrs() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(slice.ToStrs())
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_ToStrs(t *testing.T) {
+	assert.Equal(t, []string{}, (*DurationSlice)(nil).ToStrs())
+	assert.Equal(t, []string{"1s", "1m0s"}, NewDurationSliceV(time.Second, time.Minute).ToStrs())
+}
+
+// ToInterSlice
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_ToInterSlice() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	fmt.Println(len(slice.ToInterSlice()))
+	// Output: 2
+}
+
+func TestDurationSlice_ToInterSlice(t *testing.T) {
+	assert.Equal(t, []interface{}{}, (*DurationSlice)(nil).ToInterSlice())
+	assert.Equal(t, []interface{}{time.Second, time.Minute}, NewDurationSliceV(time.Second, time.Minute).ToInterSlice())
+}
+
+// Union
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Union() {
+	slice := NewDurationSliceV(time.Second, time.Second)
+	fmt.Println(slice.Union([]time.Duration{time.Minute, time.Second}))
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_Union(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, NewDurationSliceV(time.Second), slice.Union([]time.Duration{time.Second, time.Second}))
+
+	slice = NewDurationSliceV(time.Minute, time.Minute)
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Second), slice.Union(NewDurationSliceV(time.Second)))
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Minute), slice)
+}
+
+// UnionM
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_UnionM() {
+	slice := NewDurationSliceV(time.Second, time.Second)
+	fmt.Println(slice.UnionM([]time.Duration{time.Minute, time.Second}))
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_UnionM(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, NewDurationSliceV(time.Second), slice.UnionM([]time.Duration{time.Second, time.Second}))
+	assert.Equal(t, (*DurationSlice)(nil), slice)
+
+	slice = NewDurationSliceV(time.Minute, time.Minute)
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Second), slice.UnionM(NewDurationSliceV(time.Second)))
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Second), slice)
+}
+
+// Uniq
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Uniq() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	fmt.Println(slice.Uniq())
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_Uniq(t *testing.T) {
+	assert.Equal(t, NewDurationSliceV(), (*DurationSlice)(nil).Uniq())
+
+	slice := NewDurationSliceV(time.Minute, time.Second, time.Minute, time.Second)
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Second), slice.Uniq())
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Second, time.Minute, time.Second), slice)
+}
+
+// UniqM
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_UniqM() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	fmt.Println(slice.UniqM())
+	// Output: [1s 1m0s]
+}
+
+func TestDurationSlice_UniqM(t *testing.T) {
+	assert.Equal(t, (*DurationSlice)(nil), (*DurationSlice)(nil).UniqM())
+
+	slice := NewDurationSliceV(time.Minute, time.Second, time.Minute, time.Second)
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Second), slice.UniqM())
+	assert.Equal(t, NewDurationSliceV(time.Minute, time.Second), slice)
+}
+
+// Window
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Window() {
+	slice := NewDurationSliceV(time.Second, time.Minute, time.Second)
+	slice.Window(2, 1).Each(func(x O) {
+		fmt.Print(x)
+	})
+	// Output: [1s 1m0s][1m0s 1s]
+}
+
+func TestDurationSlice_Window(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, 0, slice.Window(2, 1).Len())
+	assert.Equal(t, 0, NewDurationSliceV(time.Second, time.Minute).Window(0, 1).Len())
+	assert.Equal(t, 0, NewDurationSliceV(time.Second, time.Minute).Window(3, 1).Len())
+
+	windows := NewDurationSliceV(time.Second, time.Minute, time.Second).Window(2, 1)
+	assert.Equal(t, 2, windows.Len())
+	assert.Equal(t, []time.Duration{time.Second, time.Minute}, windows.At(0).O().(ISlice).O())
+	assert.Equal(t, []time.Duration{time.Minute, time.Second}, windows.At(1).O().(ISlice).O())
+}
+
+// Zip
+//--------------------------------------------------------------------------------------------------
+func ExampleDurationSlice_Zip() {
+	slice := NewDurationSliceV(time.Second, time.Minute)
+	pair := slice.Zip([]string{"a", "b"}).At(1).O().(ISlice)
+	fmt.Println(pair.At(0).O(), pair.At(1).O())
+	// Output: 1m0s b
+}
+
+func TestDurationSlice_Zip(t *testing.T) {
+	var slice *DurationSlice
+	assert.Equal(t, 0, slice.Zip([]string{"a"}).Len())
+	assert.Equal(t, 0, NewDurationSliceV(time.Second).Zip(nil).Len())
+
+	pairs := NewDurationSliceV(time.Second, time.Minute, time.Second).Zip([]string{"a", "b"})
+	assert.Equal(t, 2, pairs.Len())
+	assert.Equal(t, []interface{}{time.Second, "a"}, pairs.At(0).O().(ISlice).O())
+	assert.Equal(t, []interface{}{time.Minute, "b"}, pairs.At(1).O().(ISlice).O())
+}
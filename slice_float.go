@@ -1,7 +1,9 @@
 package n
 
 import (
+	"context"
 	"fmt"
+	"math"
 	"sort"
 	"strings"
 
@@ -149,6 +151,48 @@ func (p *FloatSlice) At(i int) (elem *Object) {
 	return
 }
 
+// Average returns the arithmetic mean of the elements in this Slice or 0 if empty. The mean is
+// computed incrementally to avoid overflowing on large values. Returns NaN if any element is NaN.
+func (p *FloatSlice) Average() (avg float64) {
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	return floatMean(*p)
+}
+
+// BinarySearch searches this already sorted Slice for the given element returning its index and
+// true if found or the index it would be inserted at and false if not.
+func (p *FloatSlice) BinarySearch(elem interface{}) (i int, found bool) {
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	x, err := ToFloat64E(elem)
+	if err != nil {
+		return
+	}
+	i = sort.SearchFloat64s(*p, x)
+	found = i < len(*p) && (*p)[i] == x
+	return
+}
+
+// Chunk creates a new slice of Slices each holding n elements copied from this Slice in order.
+// The last chunk will hold the remaining elements if the length isn't evenly divisible by n.
+// An empty slice is returned if n is less than 1.
+func (p *FloatSlice) Chunk(n int) (chunks ISlice) {
+	x := []interface{}{}
+	if p == nil || len(*p) == 0 || n < 1 {
+		return NewInterSliceV(x...)
+	}
+	for i := 0; i < len(*p); i += n {
+		j := i + n
+		if j > len(*p) {
+			j = len(*p)
+		}
+		x = append(x, p.Copy(i, j-1))
+	}
+	return NewInterSliceV(x...)
+}
+
 // Clear modifies this Slice to clear out all elements and returns a reference to this Slice.
 func (p *FloatSlice) Clear() ISlice {
 	if p == nil {
@@ -222,6 +266,37 @@ func (p *FloatSlice) CountW(sel func(O) bool) (cnt int) {
 	return
 }
 
+// CumSum creates a new slice with the cumulative sums of the elements in this Slice such that
+// each element is the sum of all elements up to and including it.
+func (p *FloatSlice) CumSum() (new ISlice) {
+	slice := NewFloatSliceV()
+	if p == nil || len(*p) == 0 {
+		return slice
+	}
+	sum := 0.0
+	for i := range *p {
+		sum += (*p)[i]
+		*slice = append(*slice, sum)
+	}
+	return slice
+}
+
+// Difference returns a new Slice with the uniq elements of this Slice that are not in the given Slice
+// while preserving order.
+// Supports FloatSlice, *FloatSlice, []float64 or *[]float64
+func (p *FloatSlice) Difference(slice interface{}) (new ISlice) {
+	other := ToFloatSlice(slice)
+	set, uniq := NewSet(other), NewSet(p)
+	return p.Select(func(x O) bool { return !set.Contains(x) && uniq.Remove(x) })
+}
+
+// Disjoint checks if this Slice has no elements in common with the given Slice.
+// Supports FloatSlice, *FloatSlice, []float64 or *[]float64
+func (p *FloatSlice) Disjoint(slice interface{}) bool {
+	other := ToFloatSlice(slice)
+	return NewSet(p).Disjoint(other)
+}
+
 // Drop modifies this Slice to delete the indicated range of elements and returns a referece to this Slice.
 // Expects nothing, in which case everything is dropped, or two indices i and j, in which case positive and
 // negative notation is supported and uses an inclusive behavior such that DropAt(0, -1) includes index -1
@@ -350,6 +425,21 @@ func (p *FloatSlice) EachIE(action func(int, O) error) (ISlice, error) {
 	return p, err
 }
 
+// EachP calls the given lambda once for each element in this Slice, passing in the context and
+// element as parameters, fanning the calls out to the given number of workers or runtime.NumCPU()
+// workers if less than 1. The first error from the lambda or the given context cancels
+// the context passed to the lambda and skips the remaining elements. Returns a reference to this
+// Slice and the first error.
+func (p *FloatSlice) EachP(ctx context.Context, workers int, action func(context.Context, O) error) (ISlice, error) {
+	if p == nil {
+		return p, nil
+	}
+	err := eachP(ctx, len(*p), workers, func(ctx context.Context, i int) error {
+		return action(ctx, (*p)[i])
+	})
+	return p, err
+}
+
 // EachR calls the given lambda once for each element in this Slice in reverse, passing in that element
 // as a parameter. Returns a reference to this Slice
 func (p *FloatSlice) EachR(action func(O)) ISlice {
@@ -427,11 +517,59 @@ func (p *FloatSlice) FirstN(n int) ISlice {
 	return p.Slice(0, abs(n)-1)
 }
 
+// FlatMap creates a new slice with the modified elements from the lambda expanding any slice
+// results one level into the new slice.
+func (p *FloatSlice) FlatMap(mod func(O) O) ISlice {
+	if p == nil || len(*p) == 0 {
+		return NewFloatSliceV()
+	}
+	x := []interface{}{}
+	for i := range *p {
+		x = flatAppend(x, mod((*p)[i]))
+	}
+	return newSliceOf(x, NewFloatSliceV())
+}
+
+// Flatten returns a new Slice with any nested slice elements expanded one level into it.
+// The elements of this Slice can't be slices so it is the same as Copy.
+func (p *FloatSlice) Flatten() (new ISlice) {
+	return p.Copy()
+}
+
 // G returns the underlying data structure as a builtin Go type
 func (p *FloatSlice) G() []float64 {
 	return p.O().([]float64)
 }
 
+// GroupBy creates a new map of Slices with the elements of this Slice grouped by the key the
+// lambda returns for them. Keys are converted to strings and kept in the order they are
+// first seen while the elements keep their order in each group.
+func (p *FloatSlice) GroupBy(key func(O) O) (groups IMap) {
+	m := NewOrderedMapV()
+	if p == nil || len(*p) == 0 {
+		return m
+	}
+	for i := range *p {
+		k := ToString(key((*p)[i]))
+		if group, ok := m.m[k]; ok {
+			*group.(*FloatSlice) = append(*group.(*FloatSlice), (*p)[i])
+		} else {
+			m.Set(k, &FloatSlice{(*p)[i]})
+		}
+	}
+	return m
+}
+
+// Histogram counts the elements of this Slice into the buckets defined by the given upper bounds
+// e.g. Histogram(10, 100) counts the elements <= 10, those > 10 and <= 100 and those > 100.
+// The bounds are sorted first and the counts returned will always be len(bounds)+1 long.
+func (p *FloatSlice) Histogram(bounds ...float64) (counts *IntSlice) {
+	if p == nil {
+		return floatHistogram(nil, bounds)
+	}
+	return floatHistogram(*p, bounds)
+}
+
 // Index returns the index of the first element in this Slice where element == elem
 // Returns a -1 if the element was not not found.
 func (p *FloatSlice) Index(elem interface{}) (loc int) {
@@ -487,6 +625,34 @@ func (p *FloatSlice) InterSlice() bool {
 	return false
 }
 
+// Intersect returns a new Slice with the uniq elements of this Slice that are also in the given Slice
+// while preserving order.
+// Supports FloatSlice, *FloatSlice, []float64 or *[]float64
+func (p *FloatSlice) Intersect(slice interface{}) (new ISlice) {
+	other := ToFloatSlice(slice)
+	set, uniq := NewSet(other), NewSet(p)
+	return p.Select(func(x O) bool { return set.Contains(x) && uniq.Remove(x) })
+}
+
+// Iter returns an Iterator over the elements of this Slice in order.
+func (p *FloatSlice) Iter() (it Iterator) {
+	i := 0
+	return NewIterator(func() (O, bool) {
+		if p == nil || i >= len(*p) {
+			return nil, false
+		}
+		i++
+		return (*p)[i-1], true
+	})
+}
+
+// IsSubset checks if all elements of this Slice are in the given Slice.
+// Supports FloatSlice, *FloatSlice, []float64 or *[]float64
+func (p *FloatSlice) IsSubset(slice interface{}) bool {
+	other := ToFloatSlice(slice)
+	return NewSet(p).IsSubset(other)
+}
+
 // Join converts each element into a string then joins them together using the given separator or comma by default.
 func (p *FloatSlice) Join(separator ...string) (str *Object) {
 	if p == nil || len(*p) == 0 {
@@ -557,6 +723,70 @@ func (p *FloatSlice) Map(mod func(O) O) ISlice {
 	return slice
 }
 
+// MapP creates a new slice with the modified elements from the lambda, fanning the calls out to
+// the given number of workers or runtime.NumCPU() workers if less than 1 while preserving the
+// element order. The first error from the lambda or the given context cancels the context passed
+// to the lambda and skips the remaining elements. Returns an empty Slice and the first error on
+// failure.
+func (p *FloatSlice) MapP(ctx context.Context, workers int, mod func(context.Context, O) (O, error)) (ISlice, error) {
+	if p == nil || len(*p) == 0 {
+		return NewFloatSliceV(), nil
+	}
+	results := make([]interface{}, len(*p))
+	err := eachP(ctx, len(results), workers, func(ctx context.Context, i int) (err error) {
+		results[i], err = mod(ctx, (*p)[i])
+		return
+	})
+	if err != nil {
+		return NewFloatSliceV(), err
+	}
+	slice := Slice(results[0])
+	for i := 1; i < len(results); i++ {
+		slice.Append(results[i])
+	}
+	return slice, nil
+}
+
+// Max returns the largest element in this Slice or 0 if empty. Returns NaN if any element is NaN.
+func (p *FloatSlice) Max() (max float64) {
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	max = (*p)[0]
+	for i := 1; i < len(*p); i++ {
+		if math.IsNaN(max) {
+			break
+		}
+		if (*p)[i] > max || math.IsNaN((*p)[i]) {
+			max = (*p)[i]
+		}
+	}
+	return
+}
+
+// Median returns the middle value of the elements in this Slice, averaging the two middle values
+// for even lengths, or 0 if empty. Returns NaN if any element is NaN.
+func (p *FloatSlice) Median() float64 {
+	return p.Percentile(50)
+}
+
+// Min returns the smallest element in this Slice or 0 if empty. Returns NaN if any element is NaN.
+func (p *FloatSlice) Min() (min float64) {
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	min = (*p)[0]
+	for i := 1; i < len(*p); i++ {
+		if math.IsNaN(min) {
+			break
+		}
+		if (*p)[i] < min || math.IsNaN((*p)[i]) {
+			min = (*p)[i]
+		}
+	}
+	return
+}
+
 // Nil tests if this Slice is nil
 func (p *FloatSlice) Nil() bool {
 	if p == nil {
@@ -588,6 +818,42 @@ func (p *FloatSlice) Pair() (first, second *Object) {
 	return
 }
 
+// Partition creates two new slices the first with the elements that match the lambda selector
+// and the second with the elements that don't while preserving element order.
+func (p *FloatSlice) Partition(sel func(O) bool) (match, rest ISlice) {
+	x, y := NewFloatSliceV(), NewFloatSliceV()
+	if p == nil || len(*p) == 0 {
+		return x, y
+	}
+	for i := range *p {
+		if sel((*p)[i]) {
+			*x = append(*x, (*p)[i])
+		} else {
+			*y = append(*y, (*p)[i])
+		}
+	}
+	return x, y
+}
+
+// Percentile returns the given percentile, from 0 to 100, of the elements in this Slice using
+// linear interpolation between the closest ranks. Returns 0 if empty or out of range and NaN
+// if any element is NaN.
+func (p *FloatSlice) Percentile(pct float64) (val float64) {
+	val, _ = p.PercentileE(pct)
+	return
+}
+
+// PercentileE returns the given percentile, from 0 to 100, of the elements in this Slice using
+// linear interpolation between the closest ranks. Returns an error if empty or out of range and
+// NaN if any element is NaN.
+func (p *FloatSlice) PercentileE(pct float64) (val float64, err error) {
+	if p == nil || len(*p) == 0 {
+		err = errors.Errorf("slice is empty")
+		return
+	}
+	return floatPercentile(*p, pct)
+}
+
 // Pop modifies this Slice to remove the last element and returns the removed element as an Object.
 func (p *FloatSlice) Pop() (elem *Object) {
 	elem = p.Last()
@@ -653,6 +919,31 @@ func (p *FloatSlice) Select(sel func(O) bool) (new ISlice) {
 	return slice
 }
 
+// SelectP creates a new slice with the elements that match the lambda selector, fanning the calls
+// out to the given number of workers or runtime.NumCPU() workers if less than 1 while preserving
+// the element order. The first error from the lambda or the given context cancels the
+// context passed to the lambda and skips the remaining elements. Returns an empty Slice and the
+// first error on failure.
+func (p *FloatSlice) SelectP(ctx context.Context, workers int, sel func(context.Context, O) (bool, error)) (new ISlice, err error) {
+	slice := NewFloatSliceV()
+	if p == nil || len(*p) == 0 {
+		return slice, nil
+	}
+	hits := make([]bool, len(*p))
+	if err = eachP(ctx, len(hits), workers, func(ctx context.Context, i int) (err error) {
+		hits[i], err = sel(ctx, (*p)[i])
+		return
+	}); err != nil {
+		return slice, err
+	}
+	for i := range hits {
+		if hits[i] {
+			*slice = append(*slice, (*p)[i])
+		}
+	}
+	return slice, nil
+}
+
 // Set the element(s) at the given index location to the given element(s). Allows for negative notation.
 // Returns a reference to this Slice and swallows any errors.
 func (p *FloatSlice) Set(i int, elems interface{}) ISlice {
@@ -736,6 +1027,26 @@ func (p *FloatSlice) Sort() (new ISlice) {
 	return p.Copy().SortM()
 }
 
+// SortBy returns a new Slice with the elements sorted using the given less lambda.
+func (p *FloatSlice) SortBy(less func(a, b O) bool) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortByM(less)
+}
+
+// SortByM modifies this Slice sorting the elements using the given less lambda and returns a
+// reference to this Slice.
+func (p *FloatSlice) SortByM(less func(a, b O) bool) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sort.Slice(*p, func(i, j int) bool {
+		return less((*p)[i], (*p)[j])
+	})
+	return p
+}
+
 // SortM modifies this Slice sorting the elements and returns a reference to this Slice.
 func (p *FloatSlice) SortM() ISlice {
 	if p == nil || len(*p) < 2 {
@@ -762,6 +1073,36 @@ func (p *FloatSlice) SortReverseM() ISlice {
 	return p
 }
 
+// SortStable returns a new Slice with the elements sorted using the given less lambda while
+// keeping equal elements in their original order.
+func (p *FloatSlice) SortStable(less func(a, b O) bool) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortStableM(less)
+}
+
+// SortStableM modifies this Slice sorting the elements using the given less lambda while keeping
+// equal elements in their original order and returns a reference to this Slice.
+func (p *FloatSlice) SortStableM(less func(a, b O) bool) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sort.SliceStable(*p, func(i, j int) bool {
+		return less((*p)[i], (*p)[j])
+	})
+	return p
+}
+
+// StdDev returns the population standard deviation of the elements in this Slice or 0 if empty.
+// Returns NaN if any element is NaN.
+func (p *FloatSlice) StdDev() (dev float64) {
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	return floatStdDev(*p)
+}
+
 // Returns a string representation of this Slice, implements the Stringer interface
 func (p *FloatSlice) String() string {
 	var builder strings.Builder
@@ -778,6 +1119,30 @@ func (p *FloatSlice) String() string {
 	return builder.String()
 }
 
+// Sum returns the sum of the elements in this Slice using compensated summation to limit the
+// rounding errors that build up when adding many values. Returns NaN if any element is NaN.
+func (p *FloatSlice) Sum() (sum float64) {
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	comp := 0.0
+	for i := range *p {
+		x := (*p)[i]
+		t := sum + x
+		if math.IsInf(t, 0) || math.IsNaN(t) {
+			sum, comp = t, 0
+			continue
+		}
+		if math.Abs(sum) >= math.Abs(x) {
+			comp += (sum - t) + x
+		} else {
+			comp += (x - t) + sum
+		}
+		sum = t
+	}
+	return sum + comp
+}
+
 // Swap modifies this Slice swapping the indicated elements.
 func (p *FloatSlice) Swap(i, j int) {
 	if p == nil || len(*p) < 2 || i < 0 || j < 0 || i >= len(*p) || j >= len(*p) {
@@ -786,6 +1151,15 @@ func (p *FloatSlice) Swap(i, j int) {
 	(*p)[i], (*p)[j] = (*p)[j], (*p)[i]
 }
 
+// SymmetricDifference returns a new Slice with the uniq elements of this Slice that are not in the
+// given Slice followed by the uniq elements of the given Slice that are not in this Slice while
+// preserving order.
+// Supports FloatSlice, *FloatSlice, []float64 or *[]float64
+func (p *FloatSlice) SymmetricDifference(slice interface{}) (new ISlice) {
+	other := ToFloatSlice(slice)
+	return p.Difference(other).ConcatM(other.Difference(p))
+}
+
 // Take modifies this Slice removing the indicated range of elements from this Slice and returning them as a new Slice.
 // Expects nothing, in which case everything is taken, or two indices i and j, in which case positive and negative
 // notation is supported and uses an inclusive behavior such that Take(0, -1) includes index -1 as opposed to Go's
@@ -892,3 +1266,105 @@ func (p *FloatSlice) UniqM() ISlice {
 	}
 	return p
 }
+
+// Window creates a new slice of Slices each holding n consecutive elements copied from this Slice
+// starting at every step elements i.e. a sliding window. Only full windows are included and an
+// empty slice is returned if n or step are less than 1.
+func (p *FloatSlice) Window(n, step int) (windows ISlice) {
+	x := []interface{}{}
+	if p == nil || len(*p) == 0 || n < 1 || step < 1 {
+		return NewInterSliceV(x...)
+	}
+	for i := 0; i+n <= len(*p); i += step {
+		x = append(x, p.Copy(i, i+n-1))
+	}
+	return NewInterSliceV(x...)
+}
+
+// Zip creates a new slice of pairs, as InterSlices, combining each element of this Slice with
+// the element at the same index in the given Slice. The result is as long as the shorter Slice.
+func (p *FloatSlice) Zip(slice interface{}) (new ISlice) {
+	x := []interface{}{}
+	other, ok := slice.(ISlice)
+	if !ok {
+		other = Slice(slice)
+	}
+	if p == nil || len(*p) == 0 || other.Len() == 0 {
+		return NewInterSliceV(x...)
+	}
+	for i := 0; i < len(*p) && i < other.Len(); i++ {
+		x = append(x, NewInterSliceV((*p)[i], other.At(i).O()))
+	}
+	return NewInterSliceV(x...)
+}
+
+// floatHistogram counts the given values into the buckets defined by the given upper bounds
+func floatHistogram(vals, bounds []float64) (counts *IntSlice) {
+	sorted := make([]float64, len(bounds))
+	copy(sorted, bounds)
+	sort.Float64s(sorted)
+
+	x := make([]int, len(sorted)+1)
+	for i := range vals {
+		x[sort.SearchFloat64s(sorted, vals[i])]++
+	}
+	return NewIntSlice(x)
+}
+
+// floatMean computes the arithmetic mean of the given non empty values incrementally
+func floatMean(vals []float64) (mean float64) {
+	for i := range vals {
+		mean += (vals[i] - mean) / float64(i+1)
+	}
+	return
+}
+
+// floatPercentile computes the given percentile of the given non empty values using linear
+// interpolation between the closest ranks of a sorted copy of the values. Infinite neighbours
+// are returned as is since interpolating towards them is infinite too, with NaN being returned
+// when interpolating between -Inf and +Inf.
+func floatPercentile(vals []float64, pct float64) (val float64, err error) {
+	if math.IsNaN(pct) || pct < 0 || pct > 100 {
+		err = errors.Errorf("percentile %v is out of range [0, 100]", pct)
+		return
+	}
+	for i := range vals {
+		if math.IsNaN(vals[i]) {
+			return math.NaN(), nil
+		}
+	}
+	sorted := make([]float64, len(vals))
+	copy(sorted, vals)
+	sort.Float64s(sorted)
+
+	rank := pct / 100 * float64(len(sorted)-1)
+	i := int(math.Floor(rank))
+	if i+1 >= len(sorted) {
+		return sorted[len(sorted)-1], nil
+	}
+	frac, lo, hi := rank-float64(i), sorted[i], sorted[i+1]
+	switch {
+	case frac == 0 || lo == hi:
+		return lo, nil
+	case math.IsInf(lo, -1) && math.IsInf(hi, 1):
+		return math.NaN(), nil
+	case math.IsInf(lo, -1):
+		return lo, nil
+	case math.IsInf(hi, 1):
+		return hi, nil
+	}
+	val = lo + frac*(hi-lo)
+	return
+}
+
+// floatStdDev computes the population standard deviation of the given non empty values using
+// Welford's algorithm for numerical stability.
+func floatStdDev(vals []float64) float64 {
+	mean, m2 := 0.0, 0.0
+	for i := range vals {
+		delta := vals[i] - mean
+		mean += delta / float64(i+1)
+		m2 += delta * (vals[i] - mean)
+	}
+	return math.Sqrt(m2 / float64(len(vals)))
+}
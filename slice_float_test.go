@@ -1,9 +1,13 @@
 package n
 
 import (
+	"context"
 	"fmt"
+	"math"
+	"sync/atomic"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -654,6 +658,131 @@ func TestFloatSlice_At(t *testing.T) {
 	}
 }
 
+// Average
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Average() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0)
+	fmt.Println(slice.Average())
+	// Output: 2.5
+}
+
+func TestFloatSlice_Average(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, 0.0, slice.Average())
+		assert.Equal(t, 0.0, NewFloatSliceV().Average())
+	}
+
+	// average
+	{
+		assert.Equal(t, 2.0, NewFloatSliceV(1.0, 2.0, 3.0).Average())
+		assert.Equal(t, -1.5, NewFloatSliceV(-1.0, -2.0).Average())
+	}
+
+	// large values don't overflow
+	{
+		assert.Equal(t, math.MaxFloat64, NewFloatSliceV(math.MaxFloat64, math.MaxFloat64).Average())
+	}
+
+	// NaN is propagated
+	{
+		assert.True(t, math.IsNaN(NewFloatSliceV(1.0, math.NaN(), 3.0).Average()))
+		assert.True(t, math.IsNaN(NewFloatSliceV(math.NaN(), 1.0).Average()))
+	}
+}
+
+// BinarySearch
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_BinarySearch() {
+	slice := NewFloatSliceV(1.0, 3.0, 5.0)
+	fmt.Println(slice.BinarySearch(3.0))
+	// Output: 1 true
+}
+
+func TestFloatSlice_BinarySearch(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		i, found := slice.BinarySearch(1.0)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// found
+	{
+		slice := NewFloatSliceV(1.0, 3.0, 5.0, 7.0)
+		for i, x := range []interface{}{1.0, 3.0, 5.0, 7.0} {
+			j, found := slice.BinarySearch(x)
+			assert.Equal(t, i, j)
+			assert.True(t, found)
+		}
+	}
+
+	// not found gives the insert location
+	{
+		slice := NewFloatSliceV(1.0, 3.0, 5.0, 7.0)
+		i, found := slice.BinarySearch(0.0)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+		i, found = slice.BinarySearch(4.0)
+		assert.Equal(t, 2, i)
+		assert.False(t, found)
+		i, found = slice.BinarySearch(8.0)
+		assert.Equal(t, 4, i)
+		assert.False(t, found)
+	}
+}
+
+// Chunk
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Chunk() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+	slice.Chunk(2).Each(func(x O) {
+		fmt.Print(x)
+	})
+	// Output: [1.000000 2.000000][3.000000 4.000000][5.000000]
+}
+
+func TestFloatSlice_Chunk(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, 0, slice.Chunk(2).Len())
+		assert.Equal(t, 0, NewFloatSliceV().Chunk(2).Len())
+	}
+
+	// invalid size
+	{
+		assert.Equal(t, 0, NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0).Chunk(0).Len())
+		assert.Equal(t, 0, NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0).Chunk(-1).Len())
+	}
+
+	// evenly divisible
+	{
+		chunks := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0).Chunk(5)
+		assert.Equal(t, 1, chunks.Len())
+		assert.Equal(t, []float64{1.0, 2.0, 3.0, 4.0, 5.0}, chunks.At(0).O().(ISlice).O())
+	}
+
+	// remainder in the last chunk
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+		chunks := slice.Chunk(2)
+		assert.Equal(t, 3, chunks.Len())
+		assert.Equal(t, []float64{1.0, 2.0}, chunks.At(0).O().(ISlice).O())
+		assert.Equal(t, []float64{3.0, 4.0}, chunks.At(1).O().(ISlice).O())
+		assert.Equal(t, []float64{5.0}, chunks.At(2).O().(ISlice).O())
+
+		// chunks are copies
+		chunks.At(0).O().(ISlice).Set(0, 9.0)
+		assert.Equal(t, []float64{1.0, 2.0, 3.0, 4.0, 5.0}, slice.O())
+	}
+}
+
 // Clear
 //--------------------------------------------------------------------------------------------------
 
@@ -1046,6 +1175,89 @@ func TestFloatSlice_CountW(t *testing.T) {
 	assert.Equal(t, 1, NewFloatSliceV(1, 2, 3).CountW(func(x O) bool { return ExB(x.(float64) == 4 || x.(float64) == 3) }))
 }
 
+// CumSum
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_CumSum() {
+	slice := NewFloatSliceV(1.5, 2.0, 3.5)
+	fmt.Println(slice.CumSum())
+	// Output: [1.500000 3.500000 7.000000]
+}
+
+func TestFloatSlice_CumSum(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, NewFloatSliceV(), slice.CumSum())
+		assert.Equal(t, NewFloatSliceV(), NewFloatSliceV().CumSum())
+	}
+
+	// running totals
+	{
+		slice := NewFloatSliceV(1.0, -2.5, 3.0)
+		assert.Equal(t, []float64{1.0, -1.5, 1.5}, slice.CumSum().O())
+		assert.Equal(t, []float64{1.0, -2.5, 3.0}, slice.O())
+	}
+}
+
+// Difference
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Difference() {
+	slice := NewFloatSliceV(1.5, 2.5, 3.5, 2.5)
+	fmt.Println(slice.Difference([]float64{2.5, 4.5}).O())
+	// Output: [1.5 3.5]
+}
+
+func TestFloatSlice_Difference(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, []float64{}, slice.Difference([]float64{1.5, 2.5}).O())
+		assert.Equal(t, []float64{1.5, 2.5, 3.5}, NewFloatSliceV(1.5, 2.5, 2.5, 3.5).Difference([]float64{}).O())
+	}
+
+	// uniq elements not in other while preserving order
+	{
+		slice := NewFloatSliceV(3.5, 1.5, 2.5, 1.5, 4.5)
+		assert.Equal(t, []float64{3.5, 1.5}, slice.Difference([]float64{2.5, 4.5, 5.5}).O())
+		assert.Equal(t, []float64{3.5, 1.5, 2.5, 1.5, 4.5}, slice.O())
+	}
+
+	// other is a Slice
+	{
+		assert.Equal(t, []float64{1.5, 3.5}, NewFloatSliceV(1.5, 2.5, 3.5).Difference(NewFloatSliceV(2.5)).O())
+	}
+}
+
+// Disjoint
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Disjoint() {
+	slice := NewFloatSliceV(1.5, 2.5, 3.5, 2.5)
+	fmt.Println(slice.Disjoint([]float64{2.5, 4.5}))
+	// Output: false
+}
+
+func TestFloatSlice_Disjoint(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.True(t, slice.Disjoint([]float64{1.5, 2.5}))
+		assert.True(t, NewFloatSliceV(1.5).Disjoint([]float64{}))
+	}
+
+	// no elements in common
+	{
+		assert.True(t, NewFloatSliceV(1.5, 2.5).Disjoint([]float64{3.5, 4.5}))
+	}
+
+	// one element in common
+	{
+		assert.False(t, NewFloatSliceV(1.5, 2.5).Disjoint(NewFloatSliceV(2.5, 3.5)))
+	}
+}
+
 // Drop
 //--------------------------------------------------------------------------------------------------
 // func BenchmarkFloatSlice_Drop_Go(t *testing.B) {
@@ -1617,6 +1829,63 @@ func TestFloatSlice_EachIE(t *testing.T) {
 	}
 }
 
+// EachP
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_EachP() {
+	var sum int32
+	NewFloatSliceV(1.0, 2.0, 3.0).EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+		atomic.AddInt32(&sum, int32(int(x.(float64))))
+		return nil
+	})
+	fmt.Println(sum)
+	// Output: 6
+}
+
+func TestFloatSlice_EachP(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		_, err := slice.EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+			return nil
+		})
+		assert.Nil(t, err)
+	}
+
+	// Loop through
+	{
+		results := make([]int32, 3)
+		slice, err := NewFloatSliceV(1.0, 2.0, 3.0).EachP(context.Background(), 0, func(ctx context.Context, x O) error {
+			atomic.AddInt32(&results[int(x.(float64))-1], 1)
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []int32{1, 1, 1}, results)
+		assert.Equal(t, NewFloatSliceV(1.0, 2.0, 3.0), slice)
+	}
+
+	// First error is returned
+	{
+		_, err := NewFloatSliceV(1.0, 2.0, 3.0).EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+			if int(x.(float64)) == 2 {
+				return errors.New("failed")
+			}
+			return nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+
+	// Cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := NewFloatSliceV(1.0, 2.0, 3.0).EachP(ctx, 2, func(ctx context.Context, x O) error {
+			return nil
+		})
+		assert.Equal(t, context.Canceled, err)
+	}
+}
+
 // EachR
 //--------------------------------------------------------------------------------------------------
 // func BenchmarkFloatSlice_EachR_Go(t *testing.B) {
@@ -1926,6 +2195,77 @@ func TestFloatSlice_FirstN(t *testing.T) {
 	assert.Equal(t, NewFloatSliceV(1, 2), NewFloatSliceV(1, 2, 3).FirstN(2))
 }
 
+// FlatMap
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_FlatMap() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0)
+	fmt.Println(slice.FlatMap(func(x O) O {
+		return []interface{}{x, x}
+	}))
+	// Output: [1.000000 1.000000 2.000000 2.000000 3.000000 3.000000]
+}
+
+func TestFloatSlice_FlatMap(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, 0, slice.FlatMap(func(x O) O { return x }).Len())
+		assert.Equal(t, 0, NewFloatSliceV().FlatMap(func(x O) O { return x }).Len())
+	}
+
+	// slice results are expanded
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0).FlatMap(func(x O) O {
+			return []interface{}{x, x}
+		})
+		assert.Equal(t, []float64{1.0, 1.0, 2.0, 2.0, 3.0, 3.0}, slice.O())
+	}
+
+	// Slice results are expanded
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0).FlatMap(func(x O) O {
+			return NewIntSliceV(0, int(x.(float64)))
+		})
+		assert.Equal(t, []int{0, 1, 0, 2, 0, 3}, slice.O())
+	}
+
+	// other results are kept as is
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0).FlatMap(func(x O) O {
+			return int(x.(float64)) * 2
+		})
+		assert.Equal(t, []int{2, 4, 6}, slice.O())
+	}
+}
+
+// Flatten
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Flatten() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+	fmt.Println(slice.Flatten())
+	// Output: [1.000000 2.000000 3.000000 4.000000 5.000000]
+}
+
+func TestFloatSlice_Flatten(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, 0, slice.Flatten().Len())
+		assert.Equal(t, 0, NewFloatSliceV().Flatten().Len())
+	}
+
+	// already flat so it is a copy
+	{
+		slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+		new := slice.Flatten()
+		assert.Equal(t, []float64{1.0, 2.0, 3.0, 4.0, 5.0}, new.O())
+		new.Set(0, 9.0)
+		assert.Equal(t, []float64{1.0, 2.0, 3.0, 4.0, 5.0}, slice.O())
+	}
+}
+
 // G
 //--------------------------------------------------------------------------------------------------
 func ExampleFloatSlice_G() {
@@ -1946,6 +2286,75 @@ func ExampleFloatSlice_Generic() {
 	// Output: false
 }
 
+// GroupBy
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_GroupBy() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+	groups := slice.GroupBy(func(x O) O {
+		return int(x.(float64)) % 2
+	})
+	fmt.Println(groups.Get(1).O(), groups.Get(0).O())
+	// Output: [1.000000 3.000000 5.000000] [2.000000 4.000000]
+}
+
+func TestFloatSlice_GroupBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, 0, slice.GroupBy(func(x O) O { return x }).Len())
+		assert.Equal(t, 0, NewFloatSliceV().GroupBy(func(x O) O { return x }).Len())
+	}
+
+	// groups keep first seen order
+	{
+		groups := NewFloatSliceV(5.0, 4.0, 3.0, 2.0, 1.0).GroupBy(func(x O) O {
+			return int(x.(float64)) % 2
+		})
+		assert.Equal(t, []string{"1", "0"}, groups.Keys().O())
+		assert.Equal(t, []float64{4.0, 2.0}, groups.Get(0).O().(ISlice).O())
+		assert.Equal(t, []float64{5.0, 3.0, 1.0}, groups.Get(1).O().(ISlice).O())
+	}
+
+	// single group
+	{
+		groups := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0).GroupBy(func(x O) O {
+			return "all"
+		})
+		assert.Equal(t, 1, groups.Len())
+		assert.Equal(t, []float64{1.0, 2.0, 3.0, 4.0, 5.0}, groups.Get("all").O().(ISlice).O())
+	}
+}
+
+// Histogram
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Histogram() {
+	slice := NewFloatSliceV(0.5, 1.2, 0.8, 25.0, 9.5, 4.0)
+	fmt.Println(slice.Histogram(1, 10))
+	// Output: [2 3 1]
+}
+
+func TestFloatSlice_Histogram(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, []int{0, 0}, slice.Histogram(1).O())
+		assert.Equal(t, []int{0, 0}, NewFloatSliceV().Histogram(1).O())
+	}
+
+	// no bounds counts everything
+	{
+		assert.Equal(t, []int{3}, NewFloatSliceV(1.0, 2.0, 3.0).Histogram().O())
+	}
+
+	// upper bounds are inclusive and sorted first
+	{
+		slice := NewFloatSliceV(0.1, 0.5, 0.50001, 2.5, 2.6, -1.0)
+		assert.Equal(t, []int{3, 2, 1}, slice.Histogram(2.5, 0.5).O())
+	}
+}
+
 // Index
 //--------------------------------------------------------------------------------------------------
 // func BenchmarkFloatSlice_Index_Go(t *testing.B) {
@@ -2120,6 +2529,103 @@ func TestFloatSlice_Insert(t *testing.T) {
 	}
 }
 
+// Intersect
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Intersect() {
+	slice := NewFloatSliceV(1.5, 2.5, 3.5, 2.5)
+	fmt.Println(slice.Intersect([]float64{2.5, 4.5}).O())
+	// Output: [2.5]
+}
+
+func TestFloatSlice_Intersect(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, []float64{}, slice.Intersect([]float64{1.5, 2.5}).O())
+		assert.Equal(t, []float64{}, NewFloatSliceV(1.5, 2.5).Intersect([]float64{}).O())
+	}
+
+	// uniq elements in other while preserving order
+	{
+		slice := NewFloatSliceV(3.5, 1.5, 2.5, 1.5, 4.5)
+		assert.Equal(t, []float64{1.5, 4.5}, slice.Intersect([]float64{4.5, 1.5, 5.5}).O())
+		assert.Equal(t, []float64{3.5, 1.5, 2.5, 1.5, 4.5}, slice.O())
+	}
+
+	// other is a Slice
+	{
+		assert.Equal(t, []float64{2.5, 3.5}, NewFloatSliceV(1.5, 2.5, 3.5).Intersect(NewFloatSliceV(3.5, 2.5)).O())
+	}
+}
+
+// Iter
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Iter() {
+	it := NewFloatSliceV(1.1, 2.2, 3.3).Iter()
+	for it.Next() {
+		fmt.Println(it.Index(), it.Value())
+	}
+	// Output:
+	// 0 1.1
+	// 1 2.2
+	// 2 3.3
+}
+
+func TestFloatSlice_Iter(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.False(t, slice.Iter().Next())
+		assert.False(t, NewFloatSliceV().Iter().Next())
+	}
+
+	// all elements in order
+	{
+		it := NewFloatSliceV(1.1, 2.2, 3.3).Iter()
+		assert.Equal(t, -1, it.Index())
+		assert.Nil(t, it.Value())
+		for i, x := range []interface{}{1.1, 2.2, 3.3} {
+			assert.True(t, it.Next())
+			assert.Equal(t, i, it.Index())
+			assert.Equal(t, x, it.Value())
+		}
+		assert.False(t, it.Next())
+		assert.False(t, it.Next())
+		assert.Nil(t, it.Value())
+		assert.Nil(t, it.Err())
+	}
+}
+
+// IsSubset
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_IsSubset() {
+	slice := NewFloatSliceV(1.5, 2.5, 3.5, 2.5)
+	fmt.Println(slice.IsSubset([]float64{2.5, 4.5}))
+	// Output: false
+}
+
+func TestFloatSlice_IsSubset(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.True(t, slice.IsSubset([]float64{1.5, 2.5}))
+		assert.False(t, NewFloatSliceV(1.5).IsSubset([]float64{}))
+	}
+
+	// all elements in other
+	{
+		assert.True(t, NewFloatSliceV(1.5, 2.5, 2.5).IsSubset([]float64{3.5, 2.5, 1.5}))
+	}
+
+	// not all elements in other
+	{
+		assert.False(t, NewFloatSliceV(1.5, 4.5).IsSubset(NewFloatSliceV(1.5, 2.5)))
+	}
+}
+
 // Join
 //--------------------------------------------------------------------------------------------------
 // func BenchmarkFloatSlice_Join_Go(t *testing.B) {
@@ -2300,10 +2806,165 @@ func TestFloatSlice_Less(t *testing.T) {
 		assert.False(t, slice.Less(1, -2))
 	}
 
-	// valid
-	assert.Equal(t, true, NewFloatSliceV(0, 1, 2).Less(0, 1))
-	assert.Equal(t, false, NewFloatSliceV(0, 1, 2).Less(1, 0))
-	assert.Equal(t, true, NewFloatSliceV(0, 1, 2).Less(1, 2))
+	// valid
+	assert.Equal(t, true, NewFloatSliceV(0, 1, 2).Less(0, 1))
+	assert.Equal(t, false, NewFloatSliceV(0, 1, 2).Less(1, 0))
+	assert.Equal(t, true, NewFloatSliceV(0, 1, 2).Less(1, 2))
+}
+
+// MapP
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_MapP() {
+	slice, _ := NewFloatSliceV(1.0, 2.0, 3.0).MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+		return x.(float64) * 2, nil
+	})
+	fmt.Println(slice)
+	// Output: [2.000000 4.000000 6.000000]
+}
+
+func TestFloatSlice_MapP(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		new, err := slice.MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+			return x, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// Order is preserved
+	{
+		slice, err := NewFloatSliceV(1.0, 2.0, 3.0).MapP(context.Background(), 0, func(ctx context.Context, x O) (O, error) {
+			return x.(float64) * 2, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []float64{2.0, 4.0, 6.0}, slice.O())
+	}
+
+	// First error is returned
+	{
+		slice, err := NewFloatSliceV(1.0, 2.0, 3.0).MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+			if int(x.(float64)) == 2 {
+				return nil, errors.New("failed")
+			}
+			return x, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+		assert.Equal(t, 0, slice.Len())
+	}
+
+	// Cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		slice, err := NewFloatSliceV(1.0, 2.0, 3.0).MapP(ctx, 2, func(ctx context.Context, x O) (O, error) {
+			return x, nil
+		})
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, 0, slice.Len())
+	}
+}
+
+// Max
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Max() {
+	slice := NewFloatSliceV(3.5, 1.0, 2.0)
+	fmt.Println(slice.Max())
+	// Output: 3.5
+}
+
+func TestFloatSlice_Max(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, 0.0, slice.Max())
+		assert.Equal(t, 0.0, NewFloatSliceV().Max())
+	}
+
+	// max
+	{
+		assert.Equal(t, 3.0, NewFloatSliceV(1.0, 3.0, 2.0).Max())
+		assert.Equal(t, -1.5, NewFloatSliceV(-3.0, -1.5, -2.0).Max())
+	}
+
+	// NaN is propagated
+	{
+		assert.True(t, math.IsNaN(NewFloatSliceV(1.0, math.NaN(), 3.0).Max()))
+		assert.True(t, math.IsNaN(NewFloatSliceV(math.NaN(), 1.0).Max()))
+	}
+}
+
+// Median
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Median() {
+	slice := NewFloatSliceV(3.0, 1.0, 4.0, 2.0)
+	fmt.Println(slice.Median())
+	// Output: 2.5
+}
+
+func TestFloatSlice_Median(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, 0.0, slice.Median())
+		assert.Equal(t, 0.0, NewFloatSliceV().Median())
+	}
+
+	// odd and even lengths
+	{
+		assert.Equal(t, 2.0, NewFloatSliceV(3.0, 1.0, 2.0).Median())
+		assert.Equal(t, 2.5, NewFloatSliceV(4.0, 1.0, 3.0, 2.0).Median())
+		assert.Equal(t, 7.5, NewFloatSliceV(7.5).Median())
+	}
+
+	// NaN is propagated
+	{
+		assert.True(t, math.IsNaN(NewFloatSliceV(1.0, math.NaN(), 3.0).Median()))
+		assert.True(t, math.IsNaN(NewFloatSliceV(math.NaN(), 1.0).Median()))
+	}
+
+	// infinite values
+	{
+		assert.Equal(t, 2.0, NewFloatSliceV(1.0, 2.0, math.Inf(1)).Median())
+		assert.Equal(t, math.Inf(1), NewFloatSliceV(1.0, math.Inf(1)).Median())
+		assert.Equal(t, math.Inf(-1), NewFloatSliceV(math.Inf(-1), 1.0).Median())
+		assert.Equal(t, math.Inf(1), NewFloatSliceV(1.0, math.Inf(1), math.Inf(1)).Median())
+		assert.True(t, math.IsNaN(NewFloatSliceV(math.Inf(-1), math.Inf(1)).Median()))
+	}
+}
+
+// Min
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Min() {
+	slice := NewFloatSliceV(3.0, 1.5, 2.0)
+	fmt.Println(slice.Min())
+	// Output: 1.5
+}
+
+func TestFloatSlice_Min(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, 0.0, slice.Min())
+		assert.Equal(t, 0.0, NewFloatSliceV().Min())
+	}
+
+	// min
+	{
+		assert.Equal(t, 1.0, NewFloatSliceV(2.0, 1.0, 3.0).Min())
+		assert.Equal(t, -3.5, NewFloatSliceV(-1.0, -3.5, -2.0).Min())
+	}
+
+	// NaN is propagated
+	{
+		assert.True(t, math.IsNaN(NewFloatSliceV(1.0, math.NaN(), 3.0).Min()))
+		assert.True(t, math.IsNaN(NewFloatSliceV(math.NaN(), 1.0).Min()))
+	}
 }
 
 // Nil
@@ -2375,6 +3036,127 @@ func TestFloatSlice_Pair(t *testing.T) {
 	}
 }
 
+// Partition
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Partition() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+	match, rest := slice.Partition(func(x O) bool {
+		return int(x.(float64))%2 == 1
+	})
+	fmt.Println(match, rest)
+	// Output: [1.000000 3.000000 5.000000] [2.000000 4.000000]
+}
+
+func TestFloatSlice_Partition(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		match, rest := slice.Partition(func(x O) bool { return true })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+		match, rest = NewFloatSliceV().Partition(func(x O) bool { return true })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+	}
+
+	// split in order
+	{
+		match, rest := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0).Partition(func(x O) bool {
+			return int(x.(float64))%2 == 1
+		})
+		assert.Equal(t, []float64{1.0, 3.0, 5.0}, match.O())
+		assert.Equal(t, []float64{2.0, 4.0}, rest.O())
+	}
+
+	// all match
+	{
+		match, rest := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0).Partition(func(x O) bool {
+			return true
+		})
+		assert.Equal(t, []float64{1.0, 2.0, 3.0, 4.0, 5.0}, match.O())
+		assert.Equal(t, 0, rest.Len())
+	}
+}
+
+// Percentile
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Percentile() {
+	slice := NewFloatSliceV(12.0, 15.0, 11.0, 90.0, 13.0)
+	fmt.Println(slice.Percentile(50), slice.Percentile(75))
+	// Output: 13 15
+}
+
+func TestFloatSlice_Percentile(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, 0.0, slice.Percentile(50))
+		assert.Equal(t, 0.0, NewFloatSliceV().Percentile(50))
+	}
+
+	// out of range
+	{
+		assert.Equal(t, 0.0, NewFloatSliceV(1.0, 2.0).Percentile(-1))
+		assert.Equal(t, 0.0, NewFloatSliceV(1.0, 2.0).Percentile(math.NaN()))
+	}
+
+	// interpolated between closest ranks
+	{
+		slice := NewFloatSliceV(4.0, 1.0, 3.0, 2.0)
+		assert.Equal(t, 1.0, slice.Percentile(0))
+		assert.Equal(t, 1.75, slice.Percentile(25))
+		assert.Equal(t, 2.5, slice.Percentile(50))
+		assert.Equal(t, 4.0, slice.Percentile(100))
+		assert.Equal(t, []float64{4.0, 1.0, 3.0, 2.0}, slice.O())
+	}
+
+	// neighbours of infinite values
+	{
+		slice := NewFloatSliceV(math.Inf(-1), 1.0, 2.0, math.Inf(1))
+		assert.Equal(t, math.Inf(-1), slice.Percentile(0))
+		assert.Equal(t, math.Inf(-1), slice.Percentile(10))
+		assert.Equal(t, 1.0, slice.Percentile(100.0/3))
+		assert.Equal(t, 1.5, slice.Percentile(50))
+		assert.Equal(t, math.Inf(1), slice.Percentile(90))
+		assert.Equal(t, math.Inf(1), slice.Percentile(100))
+	}
+}
+
+// PercentileE
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_PercentileE() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0)
+	fmt.Println(slice.PercentileE(50))
+	// Output: 2 <nil>
+}
+
+func TestFloatSlice_PercentileE(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		val, err := slice.PercentileE(50)
+		assert.Equal(t, "slice is empty", err.Error())
+		assert.Equal(t, 0.0, val)
+	}
+
+	// out of range
+	{
+		val, err := NewFloatSliceV(1.0, 2.0).PercentileE(-0.5)
+		assert.Equal(t, "percentile -0.5 is out of range [0, 100]", err.Error())
+		assert.Equal(t, 0.0, val)
+	}
+
+	// valid
+	{
+		val, err := NewFloatSliceV(1.0, 2.0).PercentileE(50)
+		assert.Nil(t, err)
+		assert.Equal(t, 1.5, val)
+	}
+}
+
 // Pop
 //--------------------------------------------------------------------------------------------------
 // func BenchmarkFloatSlice_Pop_Go(t *testing.B) {
@@ -2678,6 +3460,61 @@ func TestFloatSlice_Select(t *testing.T) {
 	// }
 }
 
+// SelectP
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_SelectP() {
+	slice, _ := NewFloatSliceV(1.0, 2.0, 3.0).SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+		return int(x.(float64)) != 2, nil
+	})
+	fmt.Println(slice)
+	// Output: [1.000000 3.000000]
+}
+
+func TestFloatSlice_SelectP(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		new, err := slice.SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+			return true, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// Order is preserved
+	{
+		slice, err := NewFloatSliceV(1.0, 2.0, 3.0).SelectP(context.Background(), 0, func(ctx context.Context, x O) (bool, error) {
+			return int(x.(float64)) != 2, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []float64{1.0, 3.0}, slice.O())
+	}
+
+	// First error is returned
+	{
+		slice, err := NewFloatSliceV(1.0, 2.0, 3.0).SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+			if int(x.(float64)) == 2 {
+				return false, errors.New("failed")
+			}
+			return true, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+		assert.Equal(t, 0, slice.Len())
+	}
+
+	// Cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		slice, err := NewFloatSliceV(1.0, 2.0, 3.0).SelectP(ctx, 2, func(ctx context.Context, x O) (bool, error) {
+			return true, nil
+		})
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, 0, slice.Len())
+	}
+}
+
 // Set
 //--------------------------------------------------------------------------------------------------
 // func BenchmarkFloatSlice_Set_Go(t *testing.B) {
@@ -3124,6 +3961,66 @@ func TestFloatSlice_Sort(t *testing.T) {
 	}
 }
 
+// SortBy
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_SortBy() {
+	slice := NewFloatSliceV(1.0, 3.0, 2.0)
+	fmt.Println(slice.SortBy(func(a, b O) bool {
+		return int(a.(float64)) > int(b.(float64))
+	}))
+	// Output: [3.000000 2.000000 1.000000]
+}
+
+func TestFloatSlice_SortBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, NewFloatSliceV(), slice.SortBy(func(a, b O) bool { return false }))
+		assert.Equal(t, NewFloatSliceV(), NewFloatSliceV().SortBy(func(a, b O) bool { return false }))
+	}
+
+	// descending order in a new slice
+	{
+		slice := NewFloatSliceV(1.0, 3.0, 2.0, 5.0, 4.0)
+		new := slice.SortBy(func(a, b O) bool {
+			return int(a.(float64)) > int(b.(float64))
+		})
+		assert.Equal(t, []float64{5.0, 4.0, 3.0, 2.0, 1.0}, new.O())
+		assert.Equal(t, []float64{1.0, 3.0, 2.0, 5.0, 4.0}, slice.O())
+	}
+}
+
+// SortByM
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_SortByM() {
+	slice := NewFloatSliceV(1.0, 3.0, 2.0)
+	slice.SortByM(func(a, b O) bool {
+		return int(a.(float64)) > int(b.(float64))
+	})
+	fmt.Println(slice)
+	// Output: [3.000000 2.000000 1.000000]
+}
+
+func TestFloatSlice_SortByM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, (*FloatSlice)(nil), slice.SortByM(func(a, b O) bool { return false }))
+		assert.Equal(t, NewFloatSliceV(), NewFloatSliceV().SortByM(func(a, b O) bool { return false }))
+	}
+
+	// descending order in place
+	{
+		slice := NewFloatSliceV(1.0, 3.0, 2.0, 5.0, 4.0)
+		slice.SortByM(func(a, b O) bool {
+			return int(a.(float64)) > int(b.(float64))
+		})
+		assert.Equal(t, []float64{5.0, 4.0, 3.0, 2.0, 1.0}, slice.O())
+	}
+}
+
 // SortM
 //--------------------------------------------------------------------------------------------------
 // func BenchmarkFloatSlice_SortM_Go(t *testing.B) {
@@ -3244,6 +4141,97 @@ func TestFloatSlice_SortReverseM(t *testing.T) {
 	}
 }
 
+// SortStable
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_SortStable() {
+	slice := NewFloatSliceV(4.0, 1.0, 3.0, 2.0, 5.0)
+	fmt.Println(slice.SortStable(func(a, b O) bool {
+		return int(a.(float64))%2 < int(b.(float64))%2
+	}))
+	// Output: [4.000000 2.000000 1.000000 3.000000 5.000000]
+}
+
+func TestFloatSlice_SortStable(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, NewFloatSliceV(), slice.SortStable(func(a, b O) bool { return false }))
+		assert.Equal(t, NewFloatSliceV(), NewFloatSliceV().SortStable(func(a, b O) bool { return false }))
+	}
+
+	// equal elements keep their order
+	{
+		slice := NewFloatSliceV(5.0, 4.0, 1.0, 3.0, 2.0)
+		new := slice.SortStable(func(a, b O) bool {
+			return int(a.(float64))%2 < int(b.(float64))%2
+		})
+		assert.Equal(t, []float64{4.0, 2.0, 5.0, 1.0, 3.0}, new.O())
+		assert.Equal(t, []float64{5.0, 4.0, 1.0, 3.0, 2.0}, slice.O())
+	}
+}
+
+// SortStableM
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_SortStableM() {
+	slice := NewFloatSliceV(4.0, 1.0, 3.0, 2.0, 5.0)
+	slice.SortStableM(func(a, b O) bool {
+		return int(a.(float64))%2 < int(b.(float64))%2
+	})
+	fmt.Println(slice)
+	// Output: [4.000000 2.000000 1.000000 3.000000 5.000000]
+}
+
+func TestFloatSlice_SortStableM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, (*FloatSlice)(nil), slice.SortStableM(func(a, b O) bool { return false }))
+		assert.Equal(t, NewFloatSliceV(), NewFloatSliceV().SortStableM(func(a, b O) bool { return false }))
+	}
+
+	// equal elements keep their order
+	{
+		slice := NewFloatSliceV(5.0, 4.0, 1.0, 3.0, 2.0)
+		slice.SortStableM(func(a, b O) bool {
+			return int(a.(float64))%2 < int(b.(float64))%2
+		})
+		assert.Equal(t, []float64{4.0, 2.0, 5.0, 1.0, 3.0}, slice.O())
+	}
+}
+
+// StdDev
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_StdDev() {
+	slice := NewFloatSliceV(2.0, 4.0, 4.0, 4.0, 5.0, 5.0, 7.0, 9.0)
+	fmt.Println(slice.StdDev())
+	// Output: 2
+}
+
+func TestFloatSlice_StdDev(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, 0.0, slice.StdDev())
+		assert.Equal(t, 0.0, NewFloatSliceV().StdDev())
+	}
+
+	// population standard deviation
+	{
+		assert.Equal(t, 0.0, NewFloatSliceV(5.0).StdDev())
+		assert.Equal(t, 0.5, NewFloatSliceV(1.0, 2.0).StdDev())
+		assert.Equal(t, 2.0, NewFloatSliceV(2.0, 4.0, 4.0, 4.0, 5.0, 5.0, 7.0, 9.0).StdDev())
+	}
+
+	// NaN is propagated
+	{
+		assert.True(t, math.IsNaN(NewFloatSliceV(1.0, math.NaN(), 3.0).StdDev()))
+		assert.True(t, math.IsNaN(NewFloatSliceV(math.NaN(), 1.0).StdDev()))
+	}
+}
+
 // String
 //--------------------------------------------------------------------------------------------------
 // func BenchmarkFloatSlice_String_Go(t *testing.B) {
@@ -3287,6 +4275,53 @@ func TestFloatSlice_String(t *testing.T) {
 	}
 }
 
+// Sum
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Sum() {
+	slice := NewFloatSliceV(1.5, 2.0, 3.5)
+	fmt.Println(slice.Sum())
+	// Output: 7
+}
+
+func TestFloatSlice_Sum(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, 0.0, slice.Sum())
+		assert.Equal(t, 0.0, NewFloatSliceV().Sum())
+	}
+
+	// sum
+	{
+		assert.Equal(t, 6.0, NewFloatSliceV(1.0, 2.0, 3.0).Sum())
+		assert.Equal(t, -2.5, NewFloatSliceV(1.0, -3.5).Sum())
+	}
+
+	// rounding errors are compensated
+	{
+		assert.Equal(t, 2.0, NewFloatSliceV(1.0, 1e100, 1.0, -1e100).Sum())
+		slice := NewFloatSliceV()
+		for i := 0; i < 10; i++ {
+			slice.Append(0.1)
+		}
+		assert.Equal(t, 1.0, slice.Sum())
+	}
+
+	// NaN is propagated
+	{
+		assert.True(t, math.IsNaN(NewFloatSliceV(1.0, math.NaN(), 3.0).Sum()))
+		assert.True(t, math.IsNaN(NewFloatSliceV(math.NaN(), 1.0).Sum()))
+	}
+
+	// infinities
+	{
+		assert.Equal(t, math.Inf(1), NewFloatSliceV(1.0, math.Inf(1), 2.0).Sum())
+		assert.Equal(t, math.Inf(-1), NewFloatSliceV(math.Inf(-1), 1.0).Sum())
+		assert.True(t, math.IsNaN(NewFloatSliceV(math.Inf(1), math.Inf(-1)).Sum()))
+	}
+}
+
 // Swap
 //--------------------------------------------------------------------------------------------------
 // func BenchmarkFloatSlice_Swap_Go(t *testing.B) {
@@ -3345,6 +4380,36 @@ func TestFloatSlice_Swap(t *testing.T) {
 	}
 }
 
+// SymmetricDifference
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_SymmetricDifference() {
+	slice := NewFloatSliceV(1.5, 2.5, 3.5, 2.5)
+	fmt.Println(slice.SymmetricDifference([]float64{2.5, 4.5}).O())
+	// Output: [1.5 3.5 4.5]
+}
+
+func TestFloatSlice_SymmetricDifference(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, []float64{1.5, 2.5}, slice.SymmetricDifference([]float64{1.5, 2.5, 2.5}).O())
+		assert.Equal(t, []float64{1.5, 2.5}, NewFloatSliceV(1.5, 2.5, 2.5).SymmetricDifference([]float64{}).O())
+	}
+
+	// uniq elements from both not in the other while preserving order
+	{
+		slice := NewFloatSliceV(1.5, 2.5, 3.5, 3.5)
+		assert.Equal(t, []float64{1.5, 4.5}, slice.SymmetricDifference([]float64{4.5, 3.5, 2.5, 4.5}).O())
+		assert.Equal(t, []float64{1.5, 2.5, 3.5, 3.5}, slice.O())
+	}
+
+	// other is a Slice with the same elements
+	{
+		assert.Equal(t, []float64{}, NewFloatSliceV(1.5, 2.5).SymmetricDifference(NewFloatSliceV(2.5, 1.5)).O())
+	}
+}
+
 // Take
 //--------------------------------------------------------------------------------------------------
 // func BenchmarkFloatSlice_Take_Go(t *testing.B) {
@@ -3971,3 +5036,82 @@ func TestFloatSlice_UniqM(t *testing.T) {
 		assert.Equal(t, NewFloatSliceV(1, 2, 3, 4), uniq)
 	}
 }
+
+
+// Window
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Window() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0)
+	slice.Window(3, 1).Each(func(x O) {
+		fmt.Print(x)
+	})
+	// Output: [1.000000 2.000000 3.000000][2.000000 3.000000 4.000000][3.000000 4.000000 5.000000]
+}
+
+func TestFloatSlice_Window(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, 0, slice.Window(2, 1).Len())
+		assert.Equal(t, 0, NewFloatSliceV().Window(2, 1).Len())
+	}
+
+	// invalid size or step
+	{
+		assert.Equal(t, 0, NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0).Window(0, 1).Len())
+		assert.Equal(t, 0, NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0).Window(2, 0).Len())
+		assert.Equal(t, 0, NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0).Window(6, 1).Len())
+	}
+
+	// sliding by one
+	{
+		windows := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0).Window(3, 1)
+		assert.Equal(t, 3, windows.Len())
+		assert.Equal(t, []float64{1.0, 2.0, 3.0}, windows.At(0).O().(ISlice).O())
+		assert.Equal(t, []float64{2.0, 3.0, 4.0}, windows.At(1).O().(ISlice).O())
+		assert.Equal(t, []float64{3.0, 4.0, 5.0}, windows.At(2).O().(ISlice).O())
+	}
+
+	// partial windows are dropped
+	{
+		windows := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0).Window(2, 2)
+		assert.Equal(t, 2, windows.Len())
+		assert.Equal(t, []float64{1.0, 2.0}, windows.At(0).O().(ISlice).O())
+		assert.Equal(t, []float64{3.0, 4.0}, windows.At(1).O().(ISlice).O())
+	}
+}
+
+// Zip
+//--------------------------------------------------------------------------------------------------
+func ExampleFloatSlice_Zip() {
+	slice := NewFloatSliceV(1.0, 2.0, 3.0)
+	fmt.Println(slice.Zip([]string{"a", "b", "c"}))
+	// Output: [[1 a] [2 b] [3 c]]
+}
+
+func TestFloatSlice_Zip(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *FloatSlice
+		assert.Equal(t, 0, slice.Zip([]string{"a"}).Len())
+		assert.Equal(t, 0, NewFloatSliceV().Zip([]string{"a"}).Len())
+		assert.Equal(t, 0, NewFloatSliceV(1.0, 2.0, 3.0).Zip(nil).Len())
+	}
+
+	// shorter of the two
+	{
+		pairs := NewFloatSliceV(1.0, 2.0, 3.0, 4.0, 5.0).Zip([]string{"a", "b"})
+		assert.Equal(t, 2, pairs.Len())
+		assert.Equal(t, []interface{}{1.0, "a"}, pairs.At(0).O().(ISlice).O())
+		assert.Equal(t, []interface{}{2.0, "b"}, pairs.At(1).O().(ISlice).O())
+	}
+
+	// Slice given
+	{
+		pairs := NewFloatSliceV(1.0, 2.0, 3.0).Zip(NewIntSliceV(7, 8, 9, 10))
+		assert.Equal(t, 3, pairs.Len())
+		assert.Equal(t, []interface{}{3.0, 9}, pairs.At(2).O().(ISlice).O())
+	}
+}
@@ -0,0 +1,3 @@
+package n
+
+//go:generate go run ./cmd/nubgen -type=bool,byte,time.Duration,time.Time -test
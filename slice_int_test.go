@@ -1,11 +1,14 @@
 package n
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
+	"sync/atomic"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -657,6 +660,126 @@ func TestIntSlice_At(t *testing.T) {
 	}
 }
 
+// Average
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Average() {
+	slice := NewIntSliceV(1, 2, 3, 4)
+	fmt.Println(slice.Average())
+	// Output: 2.5
+}
+
+func TestIntSlice_Average(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, 0.0, slice.Average())
+		assert.Equal(t, 0.0, NewIntSliceV().Average())
+	}
+
+	// average
+	{
+		assert.Equal(t, 2.0, NewIntSliceV(1, 2, 3).Average())
+		assert.Equal(t, -1.5, NewIntSliceV(-1, -2).Average())
+	}
+
+	// large values don't overflow
+	{
+		max := int(^uint(0) >> 1)
+		assert.Equal(t, float64(max), NewIntSliceV(max, max).Average())
+	}
+}
+
+// BinarySearch
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_BinarySearch() {
+	slice := NewIntSliceV(1, 3, 5)
+	fmt.Println(slice.BinarySearch(3))
+	// Output: 1 true
+}
+
+func TestIntSlice_BinarySearch(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		i, found := slice.BinarySearch(1)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// found
+	{
+		slice := NewIntSliceV(1, 3, 5, 7)
+		for i, x := range []interface{}{1, 3, 5, 7} {
+			j, found := slice.BinarySearch(x)
+			assert.Equal(t, i, j)
+			assert.True(t, found)
+		}
+	}
+
+	// not found gives the insert location
+	{
+		slice := NewIntSliceV(1, 3, 5, 7)
+		i, found := slice.BinarySearch(0)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+		i, found = slice.BinarySearch(4)
+		assert.Equal(t, 2, i)
+		assert.False(t, found)
+		i, found = slice.BinarySearch(8)
+		assert.Equal(t, 4, i)
+		assert.False(t, found)
+	}
+}
+
+// Chunk
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Chunk() {
+	slice := NewIntSliceV(1, 2, 3, 4, 5)
+	slice.Chunk(2).Each(func(x O) {
+		fmt.Print(x)
+	})
+	// Output: [1 2][3 4][5]
+}
+
+func TestIntSlice_Chunk(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, 0, slice.Chunk(2).Len())
+		assert.Equal(t, 0, NewIntSliceV().Chunk(2).Len())
+	}
+
+	// invalid size
+	{
+		assert.Equal(t, 0, NewIntSliceV(1, 2, 3, 4, 5).Chunk(0).Len())
+		assert.Equal(t, 0, NewIntSliceV(1, 2, 3, 4, 5).Chunk(-1).Len())
+	}
+
+	// evenly divisible
+	{
+		chunks := NewIntSliceV(1, 2, 3, 4, 5).Chunk(5)
+		assert.Equal(t, 1, chunks.Len())
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, chunks.At(0).O().(ISlice).O())
+	}
+
+	// remainder in the last chunk
+	{
+		slice := NewIntSliceV(1, 2, 3, 4, 5)
+		chunks := slice.Chunk(2)
+		assert.Equal(t, 3, chunks.Len())
+		assert.Equal(t, []int{1, 2}, chunks.At(0).O().(ISlice).O())
+		assert.Equal(t, []int{3, 4}, chunks.At(1).O().(ISlice).O())
+		assert.Equal(t, []int{5}, chunks.At(2).O().(ISlice).O())
+
+		// chunks are copies
+		chunks.At(0).O().(ISlice).Set(0, 9)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, slice.O())
+	}
+}
+
 // Clear
 //--------------------------------------------------------------------------------------------------
 
@@ -1051,6 +1174,129 @@ func TestIntSlice_CountW(t *testing.T) {
 	assert.Equal(t, 1, NewIntSliceV(1, 2, 3).CountW(func(x O) bool { return ExB(x.(int) == 4 || x.(int) == 3) }))
 }
 
+// CumSum
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_CumSum() {
+	slice := NewIntSliceV(1, 2, 3, 4)
+	fmt.Println(slice.CumSum())
+	// Output: [1 3 6 10]
+}
+
+func TestIntSlice_CumSum(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, NewIntSliceV(), slice.CumSum())
+		assert.Equal(t, NewIntSliceV(), NewIntSliceV().CumSum())
+	}
+
+	// running totals
+	{
+		slice := NewIntSliceV(1, -2, 3)
+		assert.Equal(t, []int{1, -1, 2}, slice.CumSum().O())
+		assert.Equal(t, []int{1, -2, 3}, slice.O())
+	}
+
+	// overflow
+	{
+		max := int(^uint(0) >> 1)
+		assert.Equal(t, NewIntSliceV(), NewIntSliceV(max, 1).CumSum())
+	}
+}
+
+// CumSumE
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_CumSumE() {
+	slice := NewIntSliceV(1, 2, 3)
+	fmt.Println(slice.CumSumE())
+	// Output: [1 3 6] <nil>
+}
+
+func TestIntSlice_CumSumE(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		new, err := slice.CumSumE()
+		assert.Nil(t, err)
+		assert.Equal(t, NewIntSliceV(), new)
+	}
+
+	// running totals
+	{
+		new, err := NewIntSliceV(1, 2, 3).CumSumE()
+		assert.Nil(t, err)
+		assert.Equal(t, []int{1, 3, 6}, new.O())
+	}
+
+	// overflow
+	{
+		max := int(^uint(0) >> 1)
+		new, err := NewIntSliceV(1, max, -2).CumSumE()
+		assert.Equal(t, "cumulative sum overflows int at index 1", err.Error())
+		assert.Equal(t, NewIntSliceV(), new)
+	}
+}
+
+// Difference
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Difference() {
+	slice := NewIntSliceV(1, 2, 3, 2)
+	fmt.Println(slice.Difference([]int{2, 4}).O())
+	// Output: [1 3]
+}
+
+func TestIntSlice_Difference(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, []int{}, slice.Difference([]int{1, 2}).O())
+		assert.Equal(t, []int{1, 2, 3}, NewIntSliceV(1, 2, 2, 3).Difference([]int{}).O())
+	}
+
+	// uniq elements not in other while preserving order
+	{
+		slice := NewIntSliceV(3, 1, 2, 1, 4)
+		assert.Equal(t, []int{3, 1}, slice.Difference([]int{2, 4, 5}).O())
+		assert.Equal(t, []int{3, 1, 2, 1, 4}, slice.O())
+	}
+
+	// other is a Slice
+	{
+		assert.Equal(t, []int{1, 3}, NewIntSliceV(1, 2, 3).Difference(NewIntSliceV(2)).O())
+	}
+}
+
+// Disjoint
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Disjoint() {
+	slice := NewIntSliceV(1, 2, 3, 2)
+	fmt.Println(slice.Disjoint([]int{2, 4}))
+	// Output: false
+}
+
+func TestIntSlice_Disjoint(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.True(t, slice.Disjoint([]int{1, 2}))
+		assert.True(t, NewIntSliceV(1).Disjoint([]int{}))
+	}
+
+	// no elements in common
+	{
+		assert.True(t, NewIntSliceV(1, 2).Disjoint([]int{3, 4}))
+	}
+
+	// one element in common
+	{
+		assert.False(t, NewIntSliceV(1, 2).Disjoint(NewIntSliceV(2, 3)))
+	}
+}
+
 // Drop
 //--------------------------------------------------------------------------------------------------
 func BenchmarkIntSlice_Drop_Go(t *testing.B) {
@@ -1622,6 +1868,63 @@ func TestIntSlice_EachIE(t *testing.T) {
 	}
 }
 
+// EachP
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_EachP() {
+	var sum int32
+	NewIntSliceV(1, 2, 3).EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+		atomic.AddInt32(&sum, int32(x.(int)))
+		return nil
+	})
+	fmt.Println(sum)
+	// Output: 6
+}
+
+func TestIntSlice_EachP(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		_, err := slice.EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+			return nil
+		})
+		assert.Nil(t, err)
+	}
+
+	// Loop through
+	{
+		results := make([]int32, 3)
+		slice, err := NewIntSliceV(1, 2, 3).EachP(context.Background(), 0, func(ctx context.Context, x O) error {
+			atomic.AddInt32(&results[x.(int)-1], 1)
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []int32{1, 1, 1}, results)
+		assert.Equal(t, NewIntSliceV(1, 2, 3), slice)
+	}
+
+	// First error is returned
+	{
+		_, err := NewIntSliceV(1, 2, 3).EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+			if x.(int) == 2 {
+				return errors.New("failed")
+			}
+			return nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+
+	// Cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := NewIntSliceV(1, 2, 3).EachP(ctx, 2, func(ctx context.Context, x O) error {
+			return nil
+		})
+		assert.Equal(t, context.Canceled, err)
+	}
+}
+
 // EachR
 //--------------------------------------------------------------------------------------------------
 func BenchmarkIntSlice_EachR_Go(t *testing.B) {
@@ -1931,6 +2234,96 @@ func TestIntSlice_FirstN(t *testing.T) {
 	assert.Equal(t, NewIntSliceV(1, 2), NewIntSliceV(1, 2, 3).FirstN(2))
 }
 
+// FlatMap
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_FlatMap() {
+	slice := NewIntSliceV(1, 2, 3)
+	fmt.Println(slice.FlatMap(func(x O) O {
+		return []interface{}{x, x}
+	}))
+	// Output: [1 1 2 2 3 3]
+}
+
+func TestIntSlice_FlatMap(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, 0, slice.FlatMap(func(x O) O { return x }).Len())
+		assert.Equal(t, 0, NewIntSliceV().FlatMap(func(x O) O { return x }).Len())
+	}
+
+	// slice results are expanded
+	{
+		slice := NewIntSliceV(1, 2, 3).FlatMap(func(x O) O {
+			return []interface{}{x, x}
+		})
+		assert.Equal(t, []int{1, 1, 2, 2, 3, 3}, slice.O())
+	}
+
+	// Slice results are expanded
+	{
+		slice := NewIntSliceV(1, 2, 3).FlatMap(func(x O) O {
+			return NewIntSliceV(0, x.(int))
+		})
+		assert.Equal(t, []int{0, 1, 0, 2, 0, 3}, slice.O())
+	}
+
+	// other results are kept as is
+	{
+		slice := NewIntSliceV(1, 2, 3).FlatMap(func(x O) O {
+			return x.(int) * 2
+		})
+		assert.Equal(t, []int{2, 4, 6}, slice.O())
+	}
+
+	// mixed results are kept
+	{
+		slice := NewIntSliceV(1, 2).FlatMap(func(x O) O {
+			if x.(int) == 1 {
+				return []int{1}
+			}
+			return []string{"x"}
+		})
+		assert.Equal(t, []interface{}{1, "x"}, slice.O())
+	}
+
+	// nested slice results are expanded only one level
+	{
+		slice := NewIntSliceV(1).FlatMap(func(x O) O {
+			return [][]int{{1, 2}, {3}}
+		})
+		assert.Equal(t, []interface{}{[]int{1, 2}, []int{3}}, slice.O())
+	}
+}
+
+// Flatten
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Flatten() {
+	slice := NewIntSliceV(1, 2, 3, 4, 5)
+	fmt.Println(slice.Flatten())
+	// Output: [1 2 3 4 5]
+}
+
+func TestIntSlice_Flatten(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, 0, slice.Flatten().Len())
+		assert.Equal(t, 0, NewIntSliceV().Flatten().Len())
+	}
+
+	// already flat so it is a copy
+	{
+		slice := NewIntSliceV(1, 2, 3, 4, 5)
+		new := slice.Flatten()
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, new.O())
+		new.Set(0, 9)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, slice.O())
+	}
+}
+
 // G
 //--------------------------------------------------------------------------------------------------
 func ExampleIntSlice_G() {
@@ -1951,6 +2344,75 @@ func ExampleIntSlice_Generic() {
 	// Output: false
 }
 
+// GroupBy
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_GroupBy() {
+	slice := NewIntSliceV(1, 2, 3, 4, 5)
+	groups := slice.GroupBy(func(x O) O {
+		return x.(int) % 2
+	})
+	fmt.Println(groups.Get(1).O(), groups.Get(0).O())
+	// Output: [1 3 5] [2 4]
+}
+
+func TestIntSlice_GroupBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, 0, slice.GroupBy(func(x O) O { return x }).Len())
+		assert.Equal(t, 0, NewIntSliceV().GroupBy(func(x O) O { return x }).Len())
+	}
+
+	// groups keep first seen order
+	{
+		groups := NewIntSliceV(5, 4, 3, 2, 1).GroupBy(func(x O) O {
+			return x.(int) % 2
+		})
+		assert.Equal(t, []string{"1", "0"}, groups.Keys().O())
+		assert.Equal(t, []int{4, 2}, groups.Get(0).O().(ISlice).O())
+		assert.Equal(t, []int{5, 3, 1}, groups.Get(1).O().(ISlice).O())
+	}
+
+	// single group
+	{
+		groups := NewIntSliceV(1, 2, 3, 4, 5).GroupBy(func(x O) O {
+			return "all"
+		})
+		assert.Equal(t, 1, groups.Len())
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, groups.Get("all").O().(ISlice).O())
+	}
+}
+
+// Histogram
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Histogram() {
+	slice := NewIntSliceV(5, 12, 8, 250, 95, 40)
+	fmt.Println(slice.Histogram(10, 100))
+	// Output: [2 3 1]
+}
+
+func TestIntSlice_Histogram(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, []int{0, 0}, slice.Histogram(1).O())
+		assert.Equal(t, []int{0, 0}, NewIntSliceV().Histogram(1).O())
+	}
+
+	// no bounds counts everything
+	{
+		assert.Equal(t, []int{3}, NewIntSliceV(1, 2, 3).Histogram().O())
+	}
+
+	// upper bounds are inclusive and sorted first
+	{
+		slice := NewIntSliceV(1, 10, 11, 100, 101, -5)
+		assert.Equal(t, []int{3, 2, 1}, slice.Histogram(100, 10).O())
+	}
+}
+
 // Index
 //--------------------------------------------------------------------------------------------------
 func BenchmarkIntSlice_Index_Go(t *testing.B) {
@@ -2125,6 +2587,103 @@ func TestIntSlice_Insert(t *testing.T) {
 	}
 }
 
+// Intersect
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Intersect() {
+	slice := NewIntSliceV(1, 2, 3, 2)
+	fmt.Println(slice.Intersect([]int{2, 4}).O())
+	// Output: [2]
+}
+
+func TestIntSlice_Intersect(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, []int{}, slice.Intersect([]int{1, 2}).O())
+		assert.Equal(t, []int{}, NewIntSliceV(1, 2).Intersect([]int{}).O())
+	}
+
+	// uniq elements in other while preserving order
+	{
+		slice := NewIntSliceV(3, 1, 2, 1, 4)
+		assert.Equal(t, []int{1, 4}, slice.Intersect([]int{4, 1, 5}).O())
+		assert.Equal(t, []int{3, 1, 2, 1, 4}, slice.O())
+	}
+
+	// other is a Slice
+	{
+		assert.Equal(t, []int{2, 3}, NewIntSliceV(1, 2, 3).Intersect(NewIntSliceV(3, 2)).O())
+	}
+}
+
+// Iter
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Iter() {
+	it := NewIntSliceV(1, 2, 3).Iter()
+	for it.Next() {
+		fmt.Println(it.Index(), it.Value())
+	}
+	// Output:
+	// 0 1
+	// 1 2
+	// 2 3
+}
+
+func TestIntSlice_Iter(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.False(t, slice.Iter().Next())
+		assert.False(t, NewIntSliceV().Iter().Next())
+	}
+
+	// all elements in order
+	{
+		it := NewIntSliceV(1, 2, 3).Iter()
+		assert.Equal(t, -1, it.Index())
+		assert.Nil(t, it.Value())
+		for i, x := range []interface{}{1, 2, 3} {
+			assert.True(t, it.Next())
+			assert.Equal(t, i, it.Index())
+			assert.Equal(t, x, it.Value())
+		}
+		assert.False(t, it.Next())
+		assert.False(t, it.Next())
+		assert.Nil(t, it.Value())
+		assert.Nil(t, it.Err())
+	}
+}
+
+// IsSubset
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_IsSubset() {
+	slice := NewIntSliceV(1, 2, 3, 2)
+	fmt.Println(slice.IsSubset([]int{2, 4}))
+	// Output: false
+}
+
+func TestIntSlice_IsSubset(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.True(t, slice.IsSubset([]int{1, 2}))
+		assert.False(t, NewIntSliceV(1).IsSubset([]int{}))
+	}
+
+	// all elements in other
+	{
+		assert.True(t, NewIntSliceV(1, 2, 2).IsSubset([]int{3, 2, 1}))
+	}
+
+	// not all elements in other
+	{
+		assert.False(t, NewIntSliceV(1, 4).IsSubset(NewIntSliceV(1, 2)))
+	}
+}
+
 // Join
 //--------------------------------------------------------------------------------------------------
 func BenchmarkIntSlice_Join_Go(t *testing.B) {
@@ -2311,6 +2870,134 @@ func TestIntSlice_Less(t *testing.T) {
 	assert.Equal(t, true, NewIntSliceV(0, 1, 2).Less(1, 2))
 }
 
+// MapP
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_MapP() {
+	slice, _ := NewIntSliceV(1, 2, 3).MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+		return x.(int) * 2, nil
+	})
+	fmt.Println(slice)
+	// Output: [2 4 6]
+}
+
+func TestIntSlice_MapP(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		new, err := slice.MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+			return x, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// Order is preserved
+	{
+		slice, err := NewIntSliceV(1, 2, 3).MapP(context.Background(), 0, func(ctx context.Context, x O) (O, error) {
+			return x.(int) * 2, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []int{2, 4, 6}, slice.O())
+	}
+
+	// First error is returned
+	{
+		slice, err := NewIntSliceV(1, 2, 3).MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+			if x.(int) == 2 {
+				return nil, errors.New("failed")
+			}
+			return x, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+		assert.Equal(t, 0, slice.Len())
+	}
+
+	// Cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		slice, err := NewIntSliceV(1, 2, 3).MapP(ctx, 2, func(ctx context.Context, x O) (O, error) {
+			return x, nil
+		})
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, 0, slice.Len())
+	}
+}
+
+// Max
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Max() {
+	slice := NewIntSliceV(3, 1, 2)
+	fmt.Println(slice.Max())
+	// Output: 3
+}
+
+func TestIntSlice_Max(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, 0, slice.Max())
+		assert.Equal(t, 0, NewIntSliceV().Max())
+	}
+
+	// max
+	{
+		assert.Equal(t, 3, NewIntSliceV(1, 3, 2).Max())
+		assert.Equal(t, -1, NewIntSliceV(-3, -1, -2).Max())
+	}
+}
+
+// Median
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Median() {
+	slice := NewIntSliceV(3, 1, 4, 2)
+	fmt.Println(slice.Median())
+	// Output: 2.5
+}
+
+func TestIntSlice_Median(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, 0.0, slice.Median())
+		assert.Equal(t, 0.0, NewIntSliceV().Median())
+	}
+
+	// odd and even lengths
+	{
+		assert.Equal(t, 2.0, NewIntSliceV(3, 1, 2).Median())
+		assert.Equal(t, 2.5, NewIntSliceV(4, 1, 3, 2).Median())
+		assert.Equal(t, 7.0, NewIntSliceV(7).Median())
+	}
+}
+
+// Min
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Min() {
+	slice := NewIntSliceV(3, 1, 2)
+	fmt.Println(slice.Min())
+	// Output: 1
+}
+
+func TestIntSlice_Min(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, 0, slice.Min())
+		assert.Equal(t, 0, NewIntSliceV().Min())
+	}
+
+	// min
+	{
+		assert.Equal(t, 1, NewIntSliceV(2, 1, 3).Min())
+		assert.Equal(t, -3, NewIntSliceV(-1, -3, -2).Min())
+	}
+}
+
 // Nil
 //--------------------------------------------------------------------------------------------------
 func ExampleIntSlice_Nil() {
@@ -2380,6 +3067,116 @@ func TestIntSlice_Pair(t *testing.T) {
 	}
 }
 
+// Partition
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Partition() {
+	slice := NewIntSliceV(1, 2, 3, 4, 5)
+	match, rest := slice.Partition(func(x O) bool {
+		return x.(int)%2 == 1
+	})
+	fmt.Println(match, rest)
+	// Output: [1 3 5] [2 4]
+}
+
+func TestIntSlice_Partition(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		match, rest := slice.Partition(func(x O) bool { return true })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+		match, rest = NewIntSliceV().Partition(func(x O) bool { return true })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+	}
+
+	// split in order
+	{
+		match, rest := NewIntSliceV(1, 2, 3, 4, 5).Partition(func(x O) bool {
+			return x.(int)%2 == 1
+		})
+		assert.Equal(t, []int{1, 3, 5}, match.O())
+		assert.Equal(t, []int{2, 4}, rest.O())
+	}
+
+	// all match
+	{
+		match, rest := NewIntSliceV(1, 2, 3, 4, 5).Partition(func(x O) bool {
+			return true
+		})
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, match.O())
+		assert.Equal(t, 0, rest.Len())
+	}
+}
+
+// Percentile
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Percentile() {
+	slice := NewIntSlice(Range(1, 100))
+	fmt.Println(slice.Percentile(50), slice.Percentile(99))
+	// Output: 50.5 99.01
+}
+
+func TestIntSlice_Percentile(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, 0.0, slice.Percentile(50))
+		assert.Equal(t, 0.0, NewIntSliceV().Percentile(50))
+	}
+
+	// out of range
+	{
+		assert.Equal(t, 0.0, NewIntSliceV(1, 2).Percentile(-1))
+		assert.Equal(t, 0.0, NewIntSliceV(1, 2).Percentile(101))
+	}
+
+	// interpolated between closest ranks
+	{
+		slice := NewIntSliceV(40, 10, 30, 20)
+		assert.Equal(t, 10.0, slice.Percentile(0))
+		assert.Equal(t, 17.5, slice.Percentile(25))
+		assert.Equal(t, 25.0, slice.Percentile(50))
+		assert.Equal(t, 40.0, slice.Percentile(100))
+		assert.Equal(t, []int{40, 10, 30, 20}, slice.O())
+	}
+}
+
+// PercentileE
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_PercentileE() {
+	slice := NewIntSliceV(1, 2, 3)
+	fmt.Println(slice.PercentileE(50))
+	// Output: 2 <nil>
+}
+
+func TestIntSlice_PercentileE(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		val, err := slice.PercentileE(50)
+		assert.Equal(t, "slice is empty", err.Error())
+		assert.Equal(t, 0.0, val)
+	}
+
+	// out of range
+	{
+		val, err := NewIntSliceV(1, 2).PercentileE(100.5)
+		assert.Equal(t, "percentile 100.5 is out of range [0, 100]", err.Error())
+		assert.Equal(t, 0.0, val)
+	}
+
+	// valid
+	{
+		val, err := NewIntSliceV(1, 2).PercentileE(50)
+		assert.Nil(t, err)
+		assert.Equal(t, 1.5, val)
+	}
+}
+
 // Pop
 //--------------------------------------------------------------------------------------------------
 func BenchmarkIntSlice_Pop_Go(t *testing.B) {
@@ -2683,6 +3480,61 @@ func TestIntSlice_Select(t *testing.T) {
 	}
 }
 
+// SelectP
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_SelectP() {
+	slice, _ := NewIntSliceV(1, 2, 3).SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+		return x.(int) != 2, nil
+	})
+	fmt.Println(slice)
+	// Output: [1 3]
+}
+
+func TestIntSlice_SelectP(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		new, err := slice.SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+			return true, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// Order is preserved
+	{
+		slice, err := NewIntSliceV(1, 2, 3).SelectP(context.Background(), 0, func(ctx context.Context, x O) (bool, error) {
+			return x.(int) != 2, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []int{1, 3}, slice.O())
+	}
+
+	// First error is returned
+	{
+		slice, err := NewIntSliceV(1, 2, 3).SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+			if x.(int) == 2 {
+				return false, errors.New("failed")
+			}
+			return true, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+		assert.Equal(t, 0, slice.Len())
+	}
+
+	// Cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		slice, err := NewIntSliceV(1, 2, 3).SelectP(ctx, 2, func(ctx context.Context, x O) (bool, error) {
+			return true, nil
+		})
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, 0, slice.Len())
+	}
+}
+
 // Set
 //--------------------------------------------------------------------------------------------------
 func BenchmarkIntSlice_Set_Go(t *testing.B) {
@@ -3129,6 +3981,66 @@ func TestIntSlice_Sort(t *testing.T) {
 	}
 }
 
+// SortBy
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_SortBy() {
+	slice := NewIntSliceV(1, 3, 2)
+	fmt.Println(slice.SortBy(func(a, b O) bool {
+		return a.(int) > b.(int)
+	}))
+	// Output: [3 2 1]
+}
+
+func TestIntSlice_SortBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, NewIntSliceV(), slice.SortBy(func(a, b O) bool { return false }))
+		assert.Equal(t, NewIntSliceV(), NewIntSliceV().SortBy(func(a, b O) bool { return false }))
+	}
+
+	// descending order in a new slice
+	{
+		slice := NewIntSliceV(1, 3, 2, 5, 4)
+		new := slice.SortBy(func(a, b O) bool {
+			return a.(int) > b.(int)
+		})
+		assert.Equal(t, []int{5, 4, 3, 2, 1}, new.O())
+		assert.Equal(t, []int{1, 3, 2, 5, 4}, slice.O())
+	}
+}
+
+// SortByM
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_SortByM() {
+	slice := NewIntSliceV(1, 3, 2)
+	slice.SortByM(func(a, b O) bool {
+		return a.(int) > b.(int)
+	})
+	fmt.Println(slice)
+	// Output: [3 2 1]
+}
+
+func TestIntSlice_SortByM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, (*IntSlice)(nil), slice.SortByM(func(a, b O) bool { return false }))
+		assert.Equal(t, NewIntSliceV(), NewIntSliceV().SortByM(func(a, b O) bool { return false }))
+	}
+
+	// descending order in place
+	{
+		slice := NewIntSliceV(1, 3, 2, 5, 4)
+		slice.SortByM(func(a, b O) bool {
+			return a.(int) > b.(int)
+		})
+		assert.Equal(t, []int{5, 4, 3, 2, 1}, slice.O())
+	}
+}
+
 // SortM
 //--------------------------------------------------------------------------------------------------
 func BenchmarkIntSlice_SortM_Go(t *testing.B) {
@@ -3249,6 +4161,91 @@ func TestIntSlice_SortReverseM(t *testing.T) {
 	}
 }
 
+// SortStable
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_SortStable() {
+	slice := NewIntSliceV(4, 1, 3, 2, 5)
+	fmt.Println(slice.SortStable(func(a, b O) bool {
+		return a.(int)%2 < b.(int)%2
+	}))
+	// Output: [4 2 1 3 5]
+}
+
+func TestIntSlice_SortStable(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, NewIntSliceV(), slice.SortStable(func(a, b O) bool { return false }))
+		assert.Equal(t, NewIntSliceV(), NewIntSliceV().SortStable(func(a, b O) bool { return false }))
+	}
+
+	// equal elements keep their order
+	{
+		slice := NewIntSliceV(5, 4, 1, 3, 2)
+		new := slice.SortStable(func(a, b O) bool {
+			return a.(int)%2 < b.(int)%2
+		})
+		assert.Equal(t, []int{4, 2, 5, 1, 3}, new.O())
+		assert.Equal(t, []int{5, 4, 1, 3, 2}, slice.O())
+	}
+}
+
+// SortStableM
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_SortStableM() {
+	slice := NewIntSliceV(4, 1, 3, 2, 5)
+	slice.SortStableM(func(a, b O) bool {
+		return a.(int)%2 < b.(int)%2
+	})
+	fmt.Println(slice)
+	// Output: [4 2 1 3 5]
+}
+
+func TestIntSlice_SortStableM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, (*IntSlice)(nil), slice.SortStableM(func(a, b O) bool { return false }))
+		assert.Equal(t, NewIntSliceV(), NewIntSliceV().SortStableM(func(a, b O) bool { return false }))
+	}
+
+	// equal elements keep their order
+	{
+		slice := NewIntSliceV(5, 4, 1, 3, 2)
+		slice.SortStableM(func(a, b O) bool {
+			return a.(int)%2 < b.(int)%2
+		})
+		assert.Equal(t, []int{4, 2, 5, 1, 3}, slice.O())
+	}
+}
+
+// StdDev
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_StdDev() {
+	slice := NewIntSliceV(2, 4, 4, 4, 5, 5, 7, 9)
+	fmt.Println(slice.StdDev())
+	// Output: 2
+}
+
+func TestIntSlice_StdDev(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, 0.0, slice.StdDev())
+		assert.Equal(t, 0.0, NewIntSliceV().StdDev())
+	}
+
+	// population standard deviation
+	{
+		assert.Equal(t, 0.0, NewIntSliceV(5).StdDev())
+		assert.Equal(t, 0.0, NewIntSliceV(3, 3, 3).StdDev())
+		assert.Equal(t, 2.0, NewIntSliceV(2, 4, 4, 4, 5, 5, 7, 9).StdDev())
+	}
+}
+
 // String
 //--------------------------------------------------------------------------------------------------
 func BenchmarkIntSlice_String_Go(t *testing.B) {
@@ -3292,6 +4289,80 @@ func TestIntSlice_String(t *testing.T) {
 	}
 }
 
+// Sum
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Sum() {
+	slice := NewIntSliceV(1, 2, 3)
+	fmt.Println(slice.Sum())
+	// Output: 6
+}
+
+func TestIntSlice_Sum(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, 0, slice.Sum())
+		assert.Equal(t, 0, NewIntSliceV().Sum())
+	}
+
+	// sum
+	{
+		assert.Equal(t, 6, NewIntSliceV(1, 2, 3).Sum())
+		assert.Equal(t, -2, NewIntSliceV(1, -3).Sum())
+	}
+
+	// overflow
+	{
+		max := int(^uint(0) >> 1)
+		assert.Equal(t, 0, NewIntSliceV(max, 1).Sum())
+	}
+}
+
+// SumE
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_SumE() {
+	slice := NewIntSliceV(1, 2, 3)
+	fmt.Println(slice.SumE())
+	// Output: 6 <nil>
+}
+
+func TestIntSlice_SumE(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		sum, err := slice.SumE()
+		assert.Nil(t, err)
+		assert.Equal(t, 0, sum)
+	}
+
+	// intermediate overflow is tolerated
+	{
+		max := int(^uint(0) >> 1)
+		min := -max - 1
+		sum, err := NewIntSliceV(max, 10, -20).SumE()
+		assert.Nil(t, err)
+		assert.Equal(t, max-10, sum)
+
+		sum, err = NewIntSliceV(min, -1, 1).SumE()
+		assert.Nil(t, err)
+		assert.Equal(t, min, sum)
+	}
+
+	// overflow
+	{
+		max := int(^uint(0) >> 1)
+		sum, err := NewIntSliceV(max, max).SumE()
+		assert.Contains(t, err.Error(), "overflows int")
+		assert.Equal(t, 0, sum)
+
+		sum, err = NewIntSliceV(-max, -max).SumE()
+		assert.Contains(t, err.Error(), "overflows int")
+		assert.Equal(t, 0, sum)
+	}
+}
+
 // Swap
 //--------------------------------------------------------------------------------------------------
 func BenchmarkIntSlice_Swap_Go(t *testing.B) {
@@ -3350,6 +4421,36 @@ func TestIntSlice_Swap(t *testing.T) {
 	}
 }
 
+// SymmetricDifference
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_SymmetricDifference() {
+	slice := NewIntSliceV(1, 2, 3, 2)
+	fmt.Println(slice.SymmetricDifference([]int{2, 4}).O())
+	// Output: [1 3 4]
+}
+
+func TestIntSlice_SymmetricDifference(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, []int{1, 2}, slice.SymmetricDifference([]int{1, 2, 2}).O())
+		assert.Equal(t, []int{1, 2}, NewIntSliceV(1, 2, 2).SymmetricDifference([]int{}).O())
+	}
+
+	// uniq elements from both not in the other while preserving order
+	{
+		slice := NewIntSliceV(1, 2, 3, 3)
+		assert.Equal(t, []int{1, 4}, slice.SymmetricDifference([]int{4, 3, 2, 4}).O())
+		assert.Equal(t, []int{1, 2, 3, 3}, slice.O())
+	}
+
+	// other is a Slice with the same elements
+	{
+		assert.Equal(t, []int{}, NewIntSliceV(1, 2).SymmetricDifference(NewIntSliceV(2, 1)).O())
+	}
+}
+
 // Take
 //--------------------------------------------------------------------------------------------------
 func BenchmarkIntSlice_Take_Go(t *testing.B) {
@@ -3976,3 +5077,82 @@ func TestIntSlice_UniqM(t *testing.T) {
 		assert.Equal(t, NewIntSliceV(1, 2, 3, 4), uniq)
 	}
 }
+
+
+// Window
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Window() {
+	slice := NewIntSliceV(1, 2, 3, 4, 5)
+	slice.Window(3, 1).Each(func(x O) {
+		fmt.Print(x)
+	})
+	// Output: [1 2 3][2 3 4][3 4 5]
+}
+
+func TestIntSlice_Window(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, 0, slice.Window(2, 1).Len())
+		assert.Equal(t, 0, NewIntSliceV().Window(2, 1).Len())
+	}
+
+	// invalid size or step
+	{
+		assert.Equal(t, 0, NewIntSliceV(1, 2, 3, 4, 5).Window(0, 1).Len())
+		assert.Equal(t, 0, NewIntSliceV(1, 2, 3, 4, 5).Window(2, 0).Len())
+		assert.Equal(t, 0, NewIntSliceV(1, 2, 3, 4, 5).Window(6, 1).Len())
+	}
+
+	// sliding by one
+	{
+		windows := NewIntSliceV(1, 2, 3, 4, 5).Window(3, 1)
+		assert.Equal(t, 3, windows.Len())
+		assert.Equal(t, []int{1, 2, 3}, windows.At(0).O().(ISlice).O())
+		assert.Equal(t, []int{2, 3, 4}, windows.At(1).O().(ISlice).O())
+		assert.Equal(t, []int{3, 4, 5}, windows.At(2).O().(ISlice).O())
+	}
+
+	// partial windows are dropped
+	{
+		windows := NewIntSliceV(1, 2, 3, 4, 5).Window(2, 2)
+		assert.Equal(t, 2, windows.Len())
+		assert.Equal(t, []int{1, 2}, windows.At(0).O().(ISlice).O())
+		assert.Equal(t, []int{3, 4}, windows.At(1).O().(ISlice).O())
+	}
+}
+
+// Zip
+//--------------------------------------------------------------------------------------------------
+func ExampleIntSlice_Zip() {
+	slice := NewIntSliceV(1, 2, 3)
+	fmt.Println(slice.Zip([]string{"a", "b", "c"}))
+	// Output: [[1 a] [2 b] [3 c]]
+}
+
+func TestIntSlice_Zip(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *IntSlice
+		assert.Equal(t, 0, slice.Zip([]string{"a"}).Len())
+		assert.Equal(t, 0, NewIntSliceV().Zip([]string{"a"}).Len())
+		assert.Equal(t, 0, NewIntSliceV(1, 2, 3).Zip(nil).Len())
+	}
+
+	// shorter of the two
+	{
+		pairs := NewIntSliceV(1, 2, 3, 4, 5).Zip([]string{"a", "b"})
+		assert.Equal(t, 2, pairs.Len())
+		assert.Equal(t, []interface{}{1, "a"}, pairs.At(0).O().(ISlice).O())
+		assert.Equal(t, []interface{}{2, "b"}, pairs.At(1).O().(ISlice).O())
+	}
+
+	// Slice given
+	{
+		pairs := NewIntSliceV(1, 2, 3).Zip(NewIntSliceV(7, 8, 9, 10))
+		assert.Equal(t, 3, pairs.Len())
+		assert.Equal(t, []interface{}{3, 9}, pairs.At(2).O().(ISlice).O())
+	}
+}
@@ -1,6 +1,7 @@
 package n
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -152,6 +153,24 @@ func (p *InterSlice) At(i int) (elem *Object) {
 	return
 }
 
+// Chunk creates a new slice of Slices each holding n elements copied from this Slice in order.
+// The last chunk will hold the remaining elements if the length isn't evenly divisible by n.
+// An empty slice is returned if n is less than 1.
+func (p *InterSlice) Chunk(n int) (chunks ISlice) {
+	x := []interface{}{}
+	if p == nil || len(*p) == 0 || n < 1 {
+		return NewInterSliceV(x...)
+	}
+	for i := 0; i < len(*p); i += n {
+		j := i + n
+		if j > len(*p) {
+			j = len(*p)
+		}
+		x = append(x, p.Copy(i, j-1))
+	}
+	return NewInterSliceV(x...)
+}
+
 // Clear modifies this Slice to clear out all elements and returns a reference to this Slice.
 func (p *InterSlice) Clear() ISlice {
 	if p == nil {
@@ -222,6 +241,22 @@ func (p *InterSlice) CountW(sel func(O) bool) (cnt int) {
 	return
 }
 
+// Difference returns a new Slice with the uniq elements of this Slice that are not in the given Slice
+// while preserving order.
+// Supports InterSlice, *InterSlice, []interface{} or *[]interface{}
+func (p *InterSlice) Difference(slice interface{}) (new ISlice) {
+	other := ToInterSlice(slice)
+	set, uniq := NewSet(other), NewSet(p)
+	return p.Select(func(x O) bool { return !set.Contains(x) && uniq.Remove(x) })
+}
+
+// Disjoint checks if this Slice has no elements in common with the given Slice.
+// Supports InterSlice, *InterSlice, []interface{} or *[]interface{}
+func (p *InterSlice) Disjoint(slice interface{}) bool {
+	other := ToInterSlice(slice)
+	return NewSet(p).Disjoint(other)
+}
+
 // Drop modifies this Slice to delete the indicated range of elements and returns a referece to this Slice.
 // Expects nothing, in which case everything is dropped, or two indices i and j, in which case positive and
 // negative notation is supported and uses an inclusive behavior such that DropAt(0, -1) includes index -1
@@ -350,6 +385,21 @@ func (p *InterSlice) EachIE(action func(int, O) error) (ISlice, error) {
 	return p, err
 }
 
+// EachP calls the given lambda once for each element in this Slice, passing in the context and
+// element as parameters, fanning the calls out to the given number of workers or runtime.NumCPU()
+// workers if less than 1. The first error from the lambda or the given context cancels
+// the context passed to the lambda and skips the remaining elements. Returns a reference to this
+// Slice and the first error.
+func (p *InterSlice) EachP(ctx context.Context, workers int, action func(context.Context, O) error) (ISlice, error) {
+	if p == nil {
+		return p, nil
+	}
+	err := eachP(ctx, len(*p), workers, func(ctx context.Context, i int) error {
+		return action(ctx, (*p)[i])
+	})
+	return p, err
+}
+
 // EachR calls the given lambda once for each element in this Slice in reverse, passing in that element
 // as a parameter. Returns a reference to this Slice
 func (p *InterSlice) EachR(action func(O)) ISlice {
@@ -427,6 +477,31 @@ func (p *InterSlice) FirstN(n int) ISlice {
 	return p.Slice(0, abs(n)-1)
 }
 
+// FlatMap creates a new slice with the modified elements from the lambda expanding any slice
+// results one level into the new slice.
+func (p *InterSlice) FlatMap(mod func(O) O) ISlice {
+	if p == nil || len(*p) == 0 {
+		return NewInterSliceV()
+	}
+	x := []interface{}{}
+	for i := range *p {
+		x = flatAppend(x, mod((*p)[i]))
+	}
+	return newSliceOf(x, NewInterSliceV())
+}
+
+// Flatten returns a new Slice with any nested slice elements expanded one level into it.
+func (p *InterSlice) Flatten() (new ISlice) {
+	x := []interface{}{}
+	if p == nil || len(*p) == 0 {
+		return NewInterSliceV(x...)
+	}
+	for i := range *p {
+		x = flatAppend(x, (*p)[i])
+	}
+	return NewInterSliceV(x...)
+}
+
 // G returns the underlying Go type as is
 func (p *InterSlice) G() []interface{} {
 	if p == nil {
@@ -435,6 +510,25 @@ func (p *InterSlice) G() []interface{} {
 	return []interface{}(*p)
 }
 
+// GroupBy creates a new map of Slices with the elements of this Slice grouped by the key the
+// lambda returns for them. Keys are converted to strings and kept in the order they are
+// first seen while the elements keep their order in each group.
+func (p *InterSlice) GroupBy(key func(O) O) (groups IMap) {
+	m := NewOrderedMapV()
+	if p == nil || len(*p) == 0 {
+		return m
+	}
+	for i := range *p {
+		k := ToString(key((*p)[i]))
+		if group, ok := m.m[k]; ok {
+			*group.(*InterSlice) = append(*group.(*InterSlice), (*p)[i])
+		} else {
+			m.Set(k, &InterSlice{(*p)[i]})
+		}
+	}
+	return m
+}
+
 // Index returns the index of the first element in this Slice where element == elem
 // Returns a -1 if the element was not not found.
 func (p *InterSlice) Index(elem interface{}) (loc int) {
@@ -487,6 +581,34 @@ func (p *InterSlice) InterSlice() bool {
 	return true
 }
 
+// Intersect returns a new Slice with the uniq elements of this Slice that are also in the given Slice
+// while preserving order.
+// Supports InterSlice, *InterSlice, []interface{} or *[]interface{}
+func (p *InterSlice) Intersect(slice interface{}) (new ISlice) {
+	other := ToInterSlice(slice)
+	set, uniq := NewSet(other), NewSet(p)
+	return p.Select(func(x O) bool { return set.Contains(x) && uniq.Remove(x) })
+}
+
+// Iter returns an Iterator over the elements of this Slice in order.
+func (p *InterSlice) Iter() (it Iterator) {
+	i := 0
+	return NewIterator(func() (O, bool) {
+		if p == nil || i >= len(*p) {
+			return nil, false
+		}
+		i++
+		return (*p)[i-1], true
+	})
+}
+
+// IsSubset checks if all elements of this Slice are in the given Slice.
+// Supports InterSlice, *InterSlice, []interface{} or *[]interface{}
+func (p *InterSlice) IsSubset(slice interface{}) bool {
+	other := ToInterSlice(slice)
+	return NewSet(p).IsSubset(other)
+}
+
 // Join converts each element into a string then joins them together using the given separator or comma by default.
 func (p *InterSlice) Join(separator ...string) (str *Object) {
 	if p == nil || len(*p) == 0 {
@@ -566,6 +688,30 @@ func (p *InterSlice) Map(mod func(O) O) ISlice {
 	return slice
 }
 
+// MapP creates a new slice with the modified elements from the lambda, fanning the calls out to
+// the given number of workers or runtime.NumCPU() workers if less than 1 while preserving the
+// element order. The first error from the lambda or the given context cancels the context passed
+// to the lambda and skips the remaining elements. Returns an empty Slice and the first error on
+// failure.
+func (p *InterSlice) MapP(ctx context.Context, workers int, mod func(context.Context, O) (O, error)) (ISlice, error) {
+	if p == nil || len(*p) == 0 {
+		return NewInterSliceV(), nil
+	}
+	results := make([]interface{}, len(*p))
+	err := eachP(ctx, len(results), workers, func(ctx context.Context, i int) (err error) {
+		results[i], err = mod(ctx, (*p)[i])
+		return
+	})
+	if err != nil {
+		return NewInterSliceV(), err
+	}
+	slice := Slice(results[0])
+	for i := 1; i < len(results); i++ {
+		slice.Append(results[i])
+	}
+	return slice, nil
+}
+
 // Nil tests if this Slice is nil
 func (p *InterSlice) Nil() bool {
 	if p == nil {
@@ -597,6 +743,23 @@ func (p *InterSlice) Pair() (first, second *Object) {
 	return
 }
 
+// Partition creates two new slices the first with the elements that match the lambda selector
+// and the second with the elements that don't while preserving element order.
+func (p *InterSlice) Partition(sel func(O) bool) (match, rest ISlice) {
+	x, y := NewInterSliceV(), NewInterSliceV()
+	if p == nil || len(*p) == 0 {
+		return x, y
+	}
+	for i := range *p {
+		if sel((*p)[i]) {
+			*x = append(*x, (*p)[i])
+		} else {
+			*y = append(*y, (*p)[i])
+		}
+	}
+	return x, y
+}
+
 // Pop modifies this Slice to remove the last element and returns the removed element as an Object.
 func (p *InterSlice) Pop() (elem *Object) {
 	elem = p.Last()
@@ -662,6 +825,31 @@ func (p *InterSlice) Select(sel func(O) bool) (new ISlice) {
 	return slice
 }
 
+// SelectP creates a new slice with the elements that match the lambda selector, fanning the calls
+// out to the given number of workers or runtime.NumCPU() workers if less than 1 while preserving
+// the element order. The first error from the lambda or the given context cancels the
+// context passed to the lambda and skips the remaining elements. Returns an empty Slice and the
+// first error on failure.
+func (p *InterSlice) SelectP(ctx context.Context, workers int, sel func(context.Context, O) (bool, error)) (new ISlice, err error) {
+	slice := NewInterSliceV()
+	if p == nil || len(*p) == 0 {
+		return slice, nil
+	}
+	hits := make([]bool, len(*p))
+	if err = eachP(ctx, len(hits), workers, func(ctx context.Context, i int) (err error) {
+		hits[i], err = sel(ctx, (*p)[i])
+		return
+	}); err != nil {
+		return slice, err
+	}
+	for i := range hits {
+		if hits[i] {
+			*slice = append(*slice, (*p)[i])
+		}
+	}
+	return slice, nil
+}
+
 // Set the element at the given index location to the given element. Allows for negative notation.
 // Returns a reference to this Slice and swallows any errors.
 func (p *InterSlice) Set(i int, elem interface{}) ISlice {
@@ -743,6 +931,26 @@ func (p *InterSlice) Sort() (new ISlice) {
 	return p.Copy().SortM()
 }
 
+// SortBy returns a new Slice with the elements sorted using the given less lambda.
+func (p *InterSlice) SortBy(less func(a, b O) bool) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortByM(less)
+}
+
+// SortByM modifies this Slice sorting the elements using the given less lambda and returns a
+// reference to this Slice.
+func (p *InterSlice) SortByM(less func(a, b O) bool) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sort.Slice(*p, func(i, j int) bool {
+		return less((*p)[i], (*p)[j])
+	})
+	return p
+}
+
 // SortM modifies this Slice sorting the elements and returns a reference to this Slice.
 // Supports optimized Slice types or Go types that can be converted into an optimized Slice type.
 func (p *InterSlice) SortM() ISlice {
@@ -772,6 +980,27 @@ func (p *InterSlice) SortReverseM() ISlice {
 	return p
 }
 
+// SortStable returns a new Slice with the elements sorted using the given less lambda while
+// keeping equal elements in their original order.
+func (p *InterSlice) SortStable(less func(a, b O) bool) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortStableM(less)
+}
+
+// SortStableM modifies this Slice sorting the elements using the given less lambda while keeping
+// equal elements in their original order and returns a reference to this Slice.
+func (p *InterSlice) SortStableM(less func(a, b O) bool) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sort.SliceStable(*p, func(i, j int) bool {
+		return less((*p)[i], (*p)[j])
+	})
+	return p
+}
+
 // Returns a string representation of this Slice, implements the Stringer interface
 func (p *InterSlice) String() string {
 	var builder strings.Builder
@@ -796,6 +1025,15 @@ func (p *InterSlice) Swap(i, j int) {
 	(*p)[i], (*p)[j] = (*p)[j], (*p)[i]
 }
 
+// SymmetricDifference returns a new Slice with the uniq elements of this Slice that are not in the
+// given Slice followed by the uniq elements of the given Slice that are not in this Slice while
+// preserving order.
+// Supports InterSlice, *InterSlice, []interface{} or *[]interface{}
+func (p *InterSlice) SymmetricDifference(slice interface{}) (new ISlice) {
+	other := ToInterSlice(slice)
+	return p.Difference(other).ConcatM(other.Difference(p))
+}
+
 // Take modifies this Slice removing the indicated range of elements from this Slice and returning them as a new Slice.
 // Expects nothing, in which case everything is taken, or two indices i and j, in which case positive and negative
 // notation is supported and uses an inclusive behavior such that Take(0, -1) includes index -1 as opposed to Go's
@@ -883,3 +1121,34 @@ func (p *InterSlice) Uniq() (new ISlice) {
 func (p *InterSlice) UniqM() ISlice {
 	panic("NOT IMPLEMENTED")
 }
+
+// Window creates a new slice of Slices each holding n consecutive elements copied from this Slice
+// starting at every step elements i.e. a sliding window. Only full windows are included and an
+// empty slice is returned if n or step are less than 1.
+func (p *InterSlice) Window(n, step int) (windows ISlice) {
+	x := []interface{}{}
+	if p == nil || len(*p) == 0 || n < 1 || step < 1 {
+		return NewInterSliceV(x...)
+	}
+	for i := 0; i+n <= len(*p); i += step {
+		x = append(x, p.Copy(i, i+n-1))
+	}
+	return NewInterSliceV(x...)
+}
+
+// Zip creates a new slice of pairs, as InterSlices, combining each element of this Slice with
+// the element at the same index in the given Slice. The result is as long as the shorter Slice.
+func (p *InterSlice) Zip(slice interface{}) (new ISlice) {
+	x := []interface{}{}
+	other, ok := slice.(ISlice)
+	if !ok {
+		other = Slice(slice)
+	}
+	if p == nil || len(*p) == 0 || other.Len() == 0 {
+		return NewInterSliceV(x...)
+	}
+	for i := 0; i < len(*p) && i < other.Len(); i++ {
+		x = append(x, NewInterSliceV((*p)[i], other.At(i).O()))
+	}
+	return NewInterSliceV(x...)
+}
@@ -1,9 +1,12 @@
 package n
 
 import (
+	"context"
 	"fmt"
+	"sync/atomic"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -634,6 +637,53 @@ func TestInterSlice_At(t *testing.T) {
 	}
 }
 
+// Chunk
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Chunk() {
+	slice := NewInterSliceV(1, 2, 3, 4, 5)
+	slice.Chunk(2).Each(func(x O) {
+		fmt.Print(x)
+	})
+	// Output: [1 2][3 4][5]
+}
+
+func TestInterSlice_Chunk(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, 0, slice.Chunk(2).Len())
+		assert.Equal(t, 0, NewInterSliceV().Chunk(2).Len())
+	}
+
+	// invalid size
+	{
+		assert.Equal(t, 0, NewInterSliceV(1, 2, 3, 4, 5).Chunk(0).Len())
+		assert.Equal(t, 0, NewInterSliceV(1, 2, 3, 4, 5).Chunk(-1).Len())
+	}
+
+	// evenly divisible
+	{
+		chunks := NewInterSliceV(1, 2, 3, 4, 5).Chunk(5)
+		assert.Equal(t, 1, chunks.Len())
+		assert.Equal(t, []interface{}{1, 2, 3, 4, 5}, chunks.At(0).O().(ISlice).O())
+	}
+
+	// remainder in the last chunk
+	{
+		slice := NewInterSliceV(1, 2, 3, 4, 5)
+		chunks := slice.Chunk(2)
+		assert.Equal(t, 3, chunks.Len())
+		assert.Equal(t, []interface{}{1, 2}, chunks.At(0).O().(ISlice).O())
+		assert.Equal(t, []interface{}{3, 4}, chunks.At(1).O().(ISlice).O())
+		assert.Equal(t, []interface{}{5}, chunks.At(2).O().(ISlice).O())
+
+		// chunks are copies
+		chunks.At(0).O().(ISlice).Set(0, 9)
+		assert.Equal(t, []interface{}{1, 2, 3, 4, 5}, slice.O())
+	}
+}
+
 // Clear
 //--------------------------------------------------------------------------------------------------
 func ExampleInterSlice_Clear() {
@@ -1107,6 +1157,64 @@ func TestInterSlice_CountW(t *testing.T) {
 	assert.Equal(t, 1, NewInterSliceV(1, 2, 3).CountW(func(x O) bool { return ExB(x.(int) == 4 || x.(int) == 3) }))
 }
 
+// Difference
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Difference() {
+	slice := NewInterSliceV(1, "2", 3, "2")
+	fmt.Println(slice.Difference([]interface{}{"2", "4"}).O())
+	// Output: [1 3]
+}
+
+func TestInterSlice_Difference(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, []interface{}{}, slice.Difference([]interface{}{1, "2"}).O())
+		assert.Equal(t, []interface{}{1, "2", 3}, NewInterSliceV(1, "2", "2", 3).Difference([]interface{}{}).O())
+	}
+
+	// uniq elements not in other while preserving order
+	{
+		slice := NewInterSliceV(3, 1, "2", 1, "4")
+		assert.Equal(t, []interface{}{3, 1}, slice.Difference([]interface{}{"2", "4", 5}).O())
+		assert.Equal(t, []interface{}{3, 1, "2", 1, "4"}, slice.O())
+	}
+
+	// other is a Slice
+	{
+		assert.Equal(t, []interface{}{1, 3}, NewInterSliceV(1, "2", 3).Difference(NewInterSliceV("2")).O())
+	}
+}
+
+// Disjoint
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Disjoint() {
+	slice := NewInterSliceV(1, "2", 3, "2")
+	fmt.Println(slice.Disjoint([]interface{}{"2", "4"}))
+	// Output: false
+}
+
+func TestInterSlice_Disjoint(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.True(t, slice.Disjoint([]interface{}{1, "2"}))
+		assert.True(t, NewInterSliceV(1).Disjoint([]interface{}{}))
+	}
+
+	// no elements in common
+	{
+		assert.True(t, NewInterSliceV(1, "2").Disjoint([]interface{}{3, "4"}))
+	}
+
+	// one element in common
+	{
+		assert.False(t, NewInterSliceV(1, "2").Disjoint(NewInterSliceV("2", 3)))
+	}
+}
+
 // Drop
 //--------------------------------------------------------------------------------------------------
 func ExampleInterSlice_Drop() {
@@ -1873,6 +1981,63 @@ func TestInterSlice_EachIE(t *testing.T) {
 	}
 }
 
+// EachP
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_EachP() {
+	var sum int32
+	NewInterSliceV(1, 2, 3).EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+		atomic.AddInt32(&sum, int32(x.(int)))
+		return nil
+	})
+	fmt.Println(sum)
+	// Output: 6
+}
+
+func TestInterSlice_EachP(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		_, err := slice.EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+			return nil
+		})
+		assert.Nil(t, err)
+	}
+
+	// Loop through
+	{
+		results := make([]int32, 3)
+		slice, err := NewInterSliceV(1, 2, 3).EachP(context.Background(), 0, func(ctx context.Context, x O) error {
+			atomic.AddInt32(&results[x.(int)-1], 1)
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []int32{1, 1, 1}, results)
+		assert.Equal(t, NewInterSliceV(1, 2, 3), slice)
+	}
+
+	// First error is returned
+	{
+		_, err := NewInterSliceV(1, 2, 3).EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+			if x.(int) == 2 {
+				return errors.New("failed")
+			}
+			return nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+
+	// Cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := NewInterSliceV(1, 2, 3).EachP(ctx, 2, func(ctx context.Context, x O) error {
+			return nil
+		})
+		assert.Equal(t, context.Canceled, err)
+	}
+}
+
 // EachR
 //--------------------------------------------------------------------------------------------------
 func ExampleInterSlice_EachR() {
@@ -2130,6 +2295,114 @@ func TestInterSlice_FirstN(t *testing.T) {
 	}
 }
 
+// FlatMap
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_FlatMap() {
+	slice := NewInterSliceV(1, 2, 3)
+	fmt.Println(slice.FlatMap(func(x O) O {
+		return []interface{}{x, x}
+	}))
+	// Output: [1 1 2 2 3 3]
+}
+
+func TestInterSlice_FlatMap(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, 0, slice.FlatMap(func(x O) O { return x }).Len())
+		assert.Equal(t, 0, NewInterSliceV().FlatMap(func(x O) O { return x }).Len())
+	}
+
+	// slice results are expanded
+	{
+		slice := NewInterSliceV(1, 2, 3).FlatMap(func(x O) O {
+			return []interface{}{x, x}
+		})
+		assert.Equal(t, []int{1, 1, 2, 2, 3, 3}, slice.O())
+	}
+
+	// Slice results are expanded
+	{
+		slice := NewInterSliceV(1, 2, 3).FlatMap(func(x O) O {
+			return NewIntSliceV(0, x.(int))
+		})
+		assert.Equal(t, []int{0, 1, 0, 2, 0, 3}, slice.O())
+	}
+
+	// other results are kept as is
+	{
+		slice := NewInterSliceV(1, 2, 3).FlatMap(func(x O) O {
+			return x.(int) * 2
+		})
+		assert.Equal(t, []int{2, 4, 6}, slice.O())
+	}
+}
+
+// Flatten
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Flatten() {
+	slice := NewInterSliceV(1, []int{2, 3}, NewStringSliceV("4", "5"))
+	fmt.Println(slice.Flatten())
+	// Output: [1 2 3 4 5]
+}
+
+func TestInterSlice_Flatten(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, 0, slice.Flatten().Len())
+		assert.Equal(t, 0, NewInterSliceV().Flatten().Len())
+	}
+
+	// nested slices are expanded one level
+	{
+		slice := NewInterSliceV(1, []int{2, 3}, NewStringSliceV("4", "5"), []interface{}{[]int{6}})
+		assert.Equal(t, []interface{}{1, 2, 3, "4", "5", []int{6}}, slice.Flatten().O())
+	}
+}
+
+// GroupBy
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_GroupBy() {
+	slice := NewInterSliceV(1, 2, 3, 4, 5)
+	groups := slice.GroupBy(func(x O) O {
+		return x.(int) % 2
+	})
+	fmt.Println(groups.Get(1).O(), groups.Get(0).O())
+	// Output: [1 3 5] [2 4]
+}
+
+func TestInterSlice_GroupBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, 0, slice.GroupBy(func(x O) O { return x }).Len())
+		assert.Equal(t, 0, NewInterSliceV().GroupBy(func(x O) O { return x }).Len())
+	}
+
+	// groups keep first seen order
+	{
+		groups := NewInterSliceV(5, 4, 3, 2, 1).GroupBy(func(x O) O {
+			return x.(int) % 2
+		})
+		assert.Equal(t, []string{"1", "0"}, groups.Keys().O())
+		assert.Equal(t, []interface{}{4, 2}, groups.Get(0).O().(ISlice).O())
+		assert.Equal(t, []interface{}{5, 3, 1}, groups.Get(1).O().(ISlice).O())
+	}
+
+	// single group
+	{
+		groups := NewInterSliceV(1, 2, 3, 4, 5).GroupBy(func(x O) O {
+			return "all"
+		})
+		assert.Equal(t, 1, groups.Len())
+		assert.Equal(t, []interface{}{1, 2, 3, 4, 5}, groups.Get("all").O().(ISlice).O())
+	}
+}
+
 // InterSlice
 //--------------------------------------------------------------------------------------------------
 func ExampleInterSlice_InterSlice() {
@@ -2273,6 +2546,103 @@ func TestInterSlice_Insert_MixedType(t *testing.T) {
 	assert.Equal(t, []interface{}{"2", 1}, slice.G())
 }
 
+// Intersect
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Intersect() {
+	slice := NewInterSliceV(1, "2", 3, "2")
+	fmt.Println(slice.Intersect([]interface{}{"2", "4"}).O())
+	// Output: [2]
+}
+
+func TestInterSlice_Intersect(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, []interface{}{}, slice.Intersect([]interface{}{1, "2"}).O())
+		assert.Equal(t, []interface{}{}, NewInterSliceV(1, "2").Intersect([]interface{}{}).O())
+	}
+
+	// uniq elements in other while preserving order
+	{
+		slice := NewInterSliceV(3, 1, "2", 1, "4")
+		assert.Equal(t, []interface{}{1, "4"}, slice.Intersect([]interface{}{"4", 1, 5}).O())
+		assert.Equal(t, []interface{}{3, 1, "2", 1, "4"}, slice.O())
+	}
+
+	// other is a Slice
+	{
+		assert.Equal(t, []interface{}{"2", 3}, NewInterSliceV(1, "2", 3).Intersect(NewInterSliceV(3, "2")).O())
+	}
+}
+
+// Iter
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Iter() {
+	it := NewInterSliceV(1, "2", 3.3).Iter()
+	for it.Next() {
+		fmt.Println(it.Index(), it.Value())
+	}
+	// Output:
+	// 0 1
+	// 1 2
+	// 2 3.3
+}
+
+func TestInterSlice_Iter(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.False(t, slice.Iter().Next())
+		assert.False(t, NewInterSliceV().Iter().Next())
+	}
+
+	// all elements in order
+	{
+		it := NewInterSliceV(1, "2", 3.3).Iter()
+		assert.Equal(t, -1, it.Index())
+		assert.Nil(t, it.Value())
+		for i, x := range []interface{}{1, "2", 3.3} {
+			assert.True(t, it.Next())
+			assert.Equal(t, i, it.Index())
+			assert.Equal(t, x, it.Value())
+		}
+		assert.False(t, it.Next())
+		assert.False(t, it.Next())
+		assert.Nil(t, it.Value())
+		assert.Nil(t, it.Err())
+	}
+}
+
+// IsSubset
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_IsSubset() {
+	slice := NewInterSliceV(1, "2", 3, "2")
+	fmt.Println(slice.IsSubset([]interface{}{"2", "4"}))
+	// Output: false
+}
+
+func TestInterSlice_IsSubset(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.True(t, slice.IsSubset([]interface{}{1, "2"}))
+		assert.False(t, NewInterSliceV(1).IsSubset([]interface{}{}))
+	}
+
+	// all elements in other
+	{
+		assert.True(t, NewInterSliceV(1, "2", "2").IsSubset([]interface{}{3, "2", 1}))
+	}
+
+	// not all elements in other
+	{
+		assert.False(t, NewInterSliceV(1, "4").IsSubset(NewInterSliceV(1, "2")))
+	}
+}
+
 // Join
 //--------------------------------------------------------------------------------------------------
 func ExampleInterSlice_Join() {
@@ -2465,6 +2835,61 @@ func TestInterSlice_Map(t *testing.T) {
 	}
 }
 
+// MapP
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_MapP() {
+	slice, _ := NewInterSliceV(1, 2, 3).MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+		return x.(int) * 2, nil
+	})
+	fmt.Println(slice)
+	// Output: [2 4 6]
+}
+
+func TestInterSlice_MapP(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		new, err := slice.MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+			return x, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// Order is preserved
+	{
+		slice, err := NewInterSliceV(1, 2, 3).MapP(context.Background(), 0, func(ctx context.Context, x O) (O, error) {
+			return x.(int) * 2, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []int{2, 4, 6}, slice.O())
+	}
+
+	// First error is returned
+	{
+		slice, err := NewInterSliceV(1, 2, 3).MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+			if x.(int) == 2 {
+				return nil, errors.New("failed")
+			}
+			return x, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+		assert.Equal(t, 0, slice.Len())
+	}
+
+	// Cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		slice, err := NewInterSliceV(1, 2, 3).MapP(ctx, 2, func(ctx context.Context, x O) (O, error) {
+			return x, nil
+		})
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, 0, slice.Len())
+	}
+}
+
 // Nil
 //--------------------------------------------------------------------------------------------------
 func TestInterSlice_Nil(t *testing.T) {
@@ -2549,6 +2974,49 @@ func TestInterSlice_Pair(t *testing.T) {
 	}
 }
 
+// Partition
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Partition() {
+	slice := NewInterSliceV(1, 2, 3, 4, 5)
+	match, rest := slice.Partition(func(x O) bool {
+		return x.(int)%2 == 1
+	})
+	fmt.Println(match, rest)
+	// Output: [1 3 5] [2 4]
+}
+
+func TestInterSlice_Partition(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		match, rest := slice.Partition(func(x O) bool { return true })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+		match, rest = NewInterSliceV().Partition(func(x O) bool { return true })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+	}
+
+	// split in order
+	{
+		match, rest := NewInterSliceV(1, 2, 3, 4, 5).Partition(func(x O) bool {
+			return x.(int)%2 == 1
+		})
+		assert.Equal(t, []interface{}{1, 3, 5}, match.O())
+		assert.Equal(t, []interface{}{2, 4}, rest.O())
+	}
+
+	// all match
+	{
+		match, rest := NewInterSliceV(1, 2, 3, 4, 5).Partition(func(x O) bool {
+			return true
+		})
+		assert.Equal(t, []interface{}{1, 2, 3, 4, 5}, match.O())
+		assert.Equal(t, 0, rest.Len())
+	}
+}
+
 // Pop
 //--------------------------------------------------------------------------------------------------
 func ExampleInterSlice_Pop() {
@@ -2789,6 +3257,61 @@ func TestInterSlice_Select(t *testing.T) {
 	}
 }
 
+// SelectP
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_SelectP() {
+	slice, _ := NewInterSliceV(1, 2, 3).SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+		return x.(int) != 2, nil
+	})
+	fmt.Println(slice)
+	// Output: [1 3]
+}
+
+func TestInterSlice_SelectP(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		new, err := slice.SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+			return true, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// Order is preserved
+	{
+		slice, err := NewInterSliceV(1, 2, 3).SelectP(context.Background(), 0, func(ctx context.Context, x O) (bool, error) {
+			return x.(int) != 2, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []interface{}{1, 3}, slice.O())
+	}
+
+	// First error is returned
+	{
+		slice, err := NewInterSliceV(1, 2, 3).SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+			if x.(int) == 2 {
+				return false, errors.New("failed")
+			}
+			return true, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+		assert.Equal(t, 0, slice.Len())
+	}
+
+	// Cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		slice, err := NewInterSliceV(1, 2, 3).SelectP(ctx, 2, func(ctx context.Context, x O) (bool, error) {
+			return true, nil
+		})
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, 0, slice.Len())
+	}
+}
+
 // Set
 //--------------------------------------------------------------------------------------------------
 func ExampleInterSlice_Set() {
@@ -3232,6 +3755,66 @@ func TestInterSlice_Sort(t *testing.T) {
 	}
 }
 
+// SortBy
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_SortBy() {
+	slice := NewInterSliceV(1, 3, 2)
+	fmt.Println(slice.SortBy(func(a, b O) bool {
+		return a.(int) > b.(int)
+	}))
+	// Output: [3 2 1]
+}
+
+func TestInterSlice_SortBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, NewInterSliceV(), slice.SortBy(func(a, b O) bool { return false }))
+		assert.Equal(t, NewInterSliceV(), NewInterSliceV().SortBy(func(a, b O) bool { return false }))
+	}
+
+	// descending order in a new slice
+	{
+		slice := NewInterSliceV(1, 3, 2, 5, 4)
+		new := slice.SortBy(func(a, b O) bool {
+			return a.(int) > b.(int)
+		})
+		assert.Equal(t, []interface{}{5, 4, 3, 2, 1}, new.O())
+		assert.Equal(t, []interface{}{1, 3, 2, 5, 4}, slice.O())
+	}
+}
+
+// SortByM
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_SortByM() {
+	slice := NewInterSliceV(1, 3, 2)
+	slice.SortByM(func(a, b O) bool {
+		return a.(int) > b.(int)
+	})
+	fmt.Println(slice)
+	// Output: [3 2 1]
+}
+
+func TestInterSlice_SortByM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, (*InterSlice)(nil), slice.SortByM(func(a, b O) bool { return false }))
+		assert.Equal(t, NewInterSliceV(), NewInterSliceV().SortByM(func(a, b O) bool { return false }))
+	}
+
+	// descending order in place
+	{
+		slice := NewInterSliceV(1, 3, 2, 5, 4)
+		slice.SortByM(func(a, b O) bool {
+			return a.(int) > b.(int)
+		})
+		assert.Equal(t, []interface{}{5, 4, 3, 2, 1}, slice.O())
+	}
+}
+
 // SortM
 //--------------------------------------------------------------------------------------------------
 func ExampleInterSlice_SortM() {
@@ -3322,6 +3905,66 @@ func TestInterSlice_SortReverseM(t *testing.T) {
 	}
 }
 
+// SortStable
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_SortStable() {
+	slice := NewInterSliceV(4, 1, 3, 2, 5)
+	fmt.Println(slice.SortStable(func(a, b O) bool {
+		return a.(int)%2 < b.(int)%2
+	}))
+	// Output: [4 2 1 3 5]
+}
+
+func TestInterSlice_SortStable(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, NewInterSliceV(), slice.SortStable(func(a, b O) bool { return false }))
+		assert.Equal(t, NewInterSliceV(), NewInterSliceV().SortStable(func(a, b O) bool { return false }))
+	}
+
+	// equal elements keep their order
+	{
+		slice := NewInterSliceV(5, 4, 1, 3, 2)
+		new := slice.SortStable(func(a, b O) bool {
+			return a.(int)%2 < b.(int)%2
+		})
+		assert.Equal(t, []interface{}{4, 2, 5, 1, 3}, new.O())
+		assert.Equal(t, []interface{}{5, 4, 1, 3, 2}, slice.O())
+	}
+}
+
+// SortStableM
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_SortStableM() {
+	slice := NewInterSliceV(4, 1, 3, 2, 5)
+	slice.SortStableM(func(a, b O) bool {
+		return a.(int)%2 < b.(int)%2
+	})
+	fmt.Println(slice)
+	// Output: [4 2 1 3 5]
+}
+
+func TestInterSlice_SortStableM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, (*InterSlice)(nil), slice.SortStableM(func(a, b O) bool { return false }))
+		assert.Equal(t, NewInterSliceV(), NewInterSliceV().SortStableM(func(a, b O) bool { return false }))
+	}
+
+	// equal elements keep their order
+	{
+		slice := NewInterSliceV(5, 4, 1, 3, 2)
+		slice.SortStableM(func(a, b O) bool {
+			return a.(int)%2 < b.(int)%2
+		})
+		assert.Equal(t, []interface{}{4, 2, 5, 1, 3}, slice.O())
+	}
+}
+
 // Swap
 //--------------------------------------------------------------------------------------------------
 func ExampleInterSlice_Swap() {
@@ -3383,6 +4026,36 @@ func TestInterSlice_Swap(t *testing.T) {
 	}
 }
 
+// SymmetricDifference
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_SymmetricDifference() {
+	slice := NewInterSliceV(1, "2", 3, "2")
+	fmt.Println(slice.SymmetricDifference([]interface{}{"2", "4"}).O())
+	// Output: [1 3 4]
+}
+
+func TestInterSlice_SymmetricDifference(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, []interface{}{1, "2"}, slice.SymmetricDifference([]interface{}{1, "2", "2"}).O())
+		assert.Equal(t, []interface{}{1, "2"}, NewInterSliceV(1, "2", "2").SymmetricDifference([]interface{}{}).O())
+	}
+
+	// uniq elements from both not in the other while preserving order
+	{
+		slice := NewInterSliceV(1, "2", 3, 3)
+		assert.Equal(t, []interface{}{1, "4"}, slice.SymmetricDifference([]interface{}{"4", 3, "2", "4"}).O())
+		assert.Equal(t, []interface{}{1, "2", 3, 3}, slice.O())
+	}
+
+	// other is a Slice with the same elements
+	{
+		assert.Equal(t, []interface{}{}, NewInterSliceV(1, "2").SymmetricDifference(NewInterSliceV("2", 1)).O())
+	}
+}
+
 // Take
 //--------------------------------------------------------------------------------------------------
 func ExampleInterSlice_Take() {
@@ -3996,3 +4669,82 @@ func TestInterSlice_TakeW(t *testing.T) {
 // 		assert.Equal(t, []int{1, 2, 3, 4}, uniq.O())
 // 	}
 // }
+
+
+// Window
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Window() {
+	slice := NewInterSliceV(1, 2, 3, 4, 5)
+	slice.Window(3, 1).Each(func(x O) {
+		fmt.Print(x)
+	})
+	// Output: [1 2 3][2 3 4][3 4 5]
+}
+
+func TestInterSlice_Window(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, 0, slice.Window(2, 1).Len())
+		assert.Equal(t, 0, NewInterSliceV().Window(2, 1).Len())
+	}
+
+	// invalid size or step
+	{
+		assert.Equal(t, 0, NewInterSliceV(1, 2, 3, 4, 5).Window(0, 1).Len())
+		assert.Equal(t, 0, NewInterSliceV(1, 2, 3, 4, 5).Window(2, 0).Len())
+		assert.Equal(t, 0, NewInterSliceV(1, 2, 3, 4, 5).Window(6, 1).Len())
+	}
+
+	// sliding by one
+	{
+		windows := NewInterSliceV(1, 2, 3, 4, 5).Window(3, 1)
+		assert.Equal(t, 3, windows.Len())
+		assert.Equal(t, []interface{}{1, 2, 3}, windows.At(0).O().(ISlice).O())
+		assert.Equal(t, []interface{}{2, 3, 4}, windows.At(1).O().(ISlice).O())
+		assert.Equal(t, []interface{}{3, 4, 5}, windows.At(2).O().(ISlice).O())
+	}
+
+	// partial windows are dropped
+	{
+		windows := NewInterSliceV(1, 2, 3, 4, 5).Window(2, 2)
+		assert.Equal(t, 2, windows.Len())
+		assert.Equal(t, []interface{}{1, 2}, windows.At(0).O().(ISlice).O())
+		assert.Equal(t, []interface{}{3, 4}, windows.At(1).O().(ISlice).O())
+	}
+}
+
+// Zip
+//--------------------------------------------------------------------------------------------------
+func ExampleInterSlice_Zip() {
+	slice := NewInterSliceV(1, 2, 3)
+	fmt.Println(slice.Zip([]string{"a", "b", "c"}))
+	// Output: [[1 a] [2 b] [3 c]]
+}
+
+func TestInterSlice_Zip(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *InterSlice
+		assert.Equal(t, 0, slice.Zip([]string{"a"}).Len())
+		assert.Equal(t, 0, NewInterSliceV().Zip([]string{"a"}).Len())
+		assert.Equal(t, 0, NewInterSliceV(1, 2, 3).Zip(nil).Len())
+	}
+
+	// shorter of the two
+	{
+		pairs := NewInterSliceV(1, 2, 3, 4, 5).Zip([]string{"a", "b"})
+		assert.Equal(t, 2, pairs.Len())
+		assert.Equal(t, []interface{}{1, "a"}, pairs.At(0).O().(ISlice).O())
+		assert.Equal(t, []interface{}{2, "b"}, pairs.At(1).O().(ISlice).O())
+	}
+
+	// Slice given
+	{
+		pairs := NewInterSliceV(1, 2, 3).Zip(NewIntSliceV(7, 8, 9, 10))
+		assert.Equal(t, 3, pairs.Len())
+		assert.Equal(t, []interface{}{3, 9}, pairs.At(2).O().(ISlice).O())
+	}
+}
@@ -1,6 +1,7 @@
 package n
 
 import (
+	"context"
 	"sort"
 	"strings"
 
@@ -139,6 +140,39 @@ func (p *MapSlice) At(i int) (elem *Object) {
 	return
 }
 
+// BinarySearchBy searches this already sorted Slice using the given lambda which should return a
+// negative number if the element sorts before the target, zero if it is the target and a positive
+// number if it sorts after. Returns the index and true if found or the index it would be inserted
+// at and false if not.
+func (p *MapSlice) BinarySearchBy(cmp func(O) int) (i int, found bool) {
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	i = sort.Search(len(*p), func(i int) bool {
+		return cmp((*p)[i]) >= 0
+	})
+	found = i < len(*p) && cmp((*p)[i]) == 0
+	return
+}
+
+// Chunk creates a new slice of Slices each holding n elements copied from this Slice in order.
+// The last chunk will hold the remaining elements if the length isn't evenly divisible by n.
+// An empty slice is returned if n is less than 1.
+func (p *MapSlice) Chunk(n int) (chunks ISlice) {
+	x := []interface{}{}
+	if p == nil || len(*p) == 0 || n < 1 {
+		return NewInterSliceV(x...)
+	}
+	for i := 0; i < len(*p); i += n {
+		j := i + n
+		if j > len(*p) {
+			j = len(*p)
+		}
+		x = append(x, p.Copy(i, j-1))
+	}
+	return NewInterSliceV(x...)
+}
+
 // Clear modifies this Slice to clear out all elements and returns a reference to this Slice.
 func (p *MapSlice) Clear() ISlice {
 	if p == nil {
@@ -212,6 +246,22 @@ func (p *MapSlice) CountW(sel func(O) bool) (cnt int) {
 	return
 }
 
+// Difference returns a new Slice with the uniq elements of this Slice that are not in the given Slice
+// while preserving order.
+// Supports MapSlice, *MapSlice, []map[string]interface{} or *[]map[string]interface{}
+func (p *MapSlice) Difference(slice interface{}) (new ISlice) {
+	other := ToMapSlice(slice)
+	set, uniq := NewSet(other), NewSet(p)
+	return p.Select(func(x O) bool { return !set.Contains(x) && uniq.Remove(x) })
+}
+
+// Disjoint checks if this Slice has no elements in common with the given Slice.
+// Supports MapSlice, *MapSlice, []map[string]interface{} or *[]map[string]interface{}
+func (p *MapSlice) Disjoint(slice interface{}) bool {
+	other := ToMapSlice(slice)
+	return NewSet(p).Disjoint(other)
+}
+
 // Drop modifies this Slice to delete the indicated range of elements and returns a referece to this Slice.
 // Expects nothing, in which case everything is dropped, or two indices i and j, in which case positive and
 // negative notation is supported and uses an inclusive behavior such that DropAt(0, -1) includes index -1
@@ -340,6 +390,21 @@ func (p *MapSlice) EachIE(action func(int, O) error) (ISlice, error) {
 	return p, err
 }
 
+// EachP calls the given lambda once for each element in this Slice, passing in the context and
+// element as parameters, fanning the calls out to the given number of workers or runtime.NumCPU()
+// workers if less than 1. The first error from the lambda or the given context cancels
+// the context passed to the lambda and skips the remaining elements. Returns a reference to this
+// Slice and the first error.
+func (p *MapSlice) EachP(ctx context.Context, workers int, action func(context.Context, O) error) (ISlice, error) {
+	if p == nil {
+		return p, nil
+	}
+	err := eachP(ctx, len(*p), workers, func(ctx context.Context, i int) error {
+		return action(ctx, (*p)[i])
+	})
+	return p, err
+}
+
 // EachR calls the given lambda once for each element in this Slice in reverse, passing in that element
 // as a parameter. Returns a reference to this Slice
 func (p *MapSlice) EachR(action func(O)) ISlice {
@@ -417,11 +482,49 @@ func (p *MapSlice) FirstN(n int) ISlice {
 	return p.Slice(0, abs(n)-1)
 }
 
+// FlatMap creates a new slice with the modified elements from the lambda expanding any slice
+// results one level into the new slice.
+func (p *MapSlice) FlatMap(mod func(O) O) ISlice {
+	if p == nil || len(*p) == 0 {
+		return NewMapSliceV()
+	}
+	x := []interface{}{}
+	for i := range *p {
+		x = flatAppend(x, mod((*p)[i]))
+	}
+	return newSliceOf(x, NewMapSliceV())
+}
+
+// Flatten returns a new Slice with any nested slice elements expanded one level into it.
+// The elements of this Slice can't be slices so it is the same as Copy.
+func (p *MapSlice) Flatten() (new ISlice) {
+	return p.Copy()
+}
+
 // G returns the underlying data structure as a builtin Go type
 func (p *MapSlice) G() []map[string]interface{} {
 	return p.O().([]map[string]interface{})
 }
 
+// GroupBy creates a new map of Slices with the elements of this Slice grouped by the key the
+// lambda returns for them. Keys are converted to strings and kept in the order they are
+// first seen while the elements keep their order in each group.
+func (p *MapSlice) GroupBy(key func(O) O) (groups IMap) {
+	m := NewOrderedMapV()
+	if p == nil || len(*p) == 0 {
+		return m
+	}
+	for i := range *p {
+		k := ToString(key((*p)[i]))
+		if group, ok := m.m[k]; ok {
+			*group.(*MapSlice) = append(*group.(*MapSlice), (*p)[i])
+		} else {
+			m.Set(k, &MapSlice{(*p)[i]})
+		}
+	}
+	return m
+}
+
 // Index returns the index of the first element in this Slice where element == elem
 // Returns a -1 if the element was not not found.
 func (p *MapSlice) Index(elem interface{}) (loc int) {
@@ -478,6 +581,34 @@ func (p *MapSlice) InterSlice() bool {
 	return false
 }
 
+// Intersect returns a new Slice with the uniq elements of this Slice that are also in the given Slice
+// while preserving order.
+// Supports MapSlice, *MapSlice, []map[string]interface{} or *[]map[string]interface{}
+func (p *MapSlice) Intersect(slice interface{}) (new ISlice) {
+	other := ToMapSlice(slice)
+	set, uniq := NewSet(other), NewSet(p)
+	return p.Select(func(x O) bool { return set.Contains(x) && uniq.Remove(x) })
+}
+
+// Iter returns an Iterator over the elements of this Slice in order.
+func (p *MapSlice) Iter() (it Iterator) {
+	i := 0
+	return NewIterator(func() (O, bool) {
+		if p == nil || i >= len(*p) {
+			return nil, false
+		}
+		i++
+		return (*p)[i-1], true
+	})
+}
+
+// IsSubset checks if all elements of this Slice are in the given Slice.
+// Supports MapSlice, *MapSlice, []map[string]interface{} or *[]map[string]interface{}
+func (p *MapSlice) IsSubset(slice interface{}) bool {
+	other := ToMapSlice(slice)
+	return NewSet(p).IsSubset(other)
+}
+
 // Join converts each element into a string then joins them together using the given separator or comma by default.
 func (p *MapSlice) Join(separator ...string) (str *Object) {
 	if p == nil || len(*p) == 0 {
@@ -545,6 +676,64 @@ func (p *MapSlice) Map(mod func(O) O) ISlice {
 	return slice
 }
 
+// MapP creates a new slice with the modified elements from the lambda, fanning the calls out to
+// the given number of workers or runtime.NumCPU() workers if less than 1 while preserving the
+// element order. The first error from the lambda or the given context cancels the context passed
+// to the lambda and skips the remaining elements. Returns an empty Slice and the first error on
+// failure.
+func (p *MapSlice) MapP(ctx context.Context, workers int, mod func(context.Context, O) (O, error)) (ISlice, error) {
+	if p == nil || len(*p) == 0 {
+		return NewMapSliceV(), nil
+	}
+	results := make([]interface{}, len(*p))
+	err := eachP(ctx, len(results), workers, func(ctx context.Context, i int) (err error) {
+		results[i], err = mod(ctx, (*p)[i])
+		return
+	})
+	if err != nil {
+		return NewMapSliceV(), err
+	}
+	slice := Slice(results[0])
+	for i := 1; i < len(results); i++ {
+		slice.Append(results[i])
+	}
+	return slice, nil
+}
+
+// MaxBy returns the element of this Slice with the largest key returned from the lambda. The first
+// such element wins on ties and an empty Object is returned if this Slice is empty.
+func (p *MapSlice) MaxBy(key func(O) float64) (elem *Object) {
+	elem = &Object{}
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	max := 0.0
+	for i := range *p {
+		if k := key((*p)[i]); i == 0 || k > max {
+			max = k
+			elem.o = (*p)[i]
+		}
+	}
+	return
+}
+
+// MinBy returns the element of this Slice with the smallest key returned from the lambda. The first
+// such element wins on ties and an empty Object is returned if this Slice is empty.
+func (p *MapSlice) MinBy(key func(O) float64) (elem *Object) {
+	elem = &Object{}
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	min := 0.0
+	for i := range *p {
+		if k := key((*p)[i]); i == 0 || k < min {
+			min = k
+			elem.o = (*p)[i]
+		}
+	}
+	return
+}
+
 // Nil tests if this Slice is nil
 func (p *MapSlice) Nil() bool {
 	if p == nil {
@@ -576,6 +765,23 @@ func (p *MapSlice) Pair() (first, second *Object) {
 	return
 }
 
+// Partition creates two new slices the first with the elements that match the lambda selector
+// and the second with the elements that don't while preserving element order.
+func (p *MapSlice) Partition(sel func(O) bool) (match, rest ISlice) {
+	x, y := NewMapSliceV(), NewMapSliceV()
+	if p == nil || len(*p) == 0 {
+		return x, y
+	}
+	for i := range *p {
+		if sel((*p)[i]) {
+			*x = append(*x, (*p)[i])
+		} else {
+			*y = append(*y, (*p)[i])
+		}
+	}
+	return x, y
+}
+
 // Pop modifies this Slice to remove the last element and returns the removed element as an Object.
 func (p *MapSlice) Pop() (elem *Object) {
 	elem = p.Last()
@@ -641,6 +847,31 @@ func (p *MapSlice) Select(sel func(O) bool) (new ISlice) {
 	return slice
 }
 
+// SelectP creates a new slice with the elements that match the lambda selector, fanning the calls
+// out to the given number of workers or runtime.NumCPU() workers if less than 1 while preserving
+// the element order. The first error from the lambda or the given context cancels the
+// context passed to the lambda and skips the remaining elements. Returns an empty Slice and the
+// first error on failure.
+func (p *MapSlice) SelectP(ctx context.Context, workers int, sel func(context.Context, O) (bool, error)) (new ISlice, err error) {
+	slice := NewMapSliceV()
+	if p == nil || len(*p) == 0 {
+		return slice, nil
+	}
+	hits := make([]bool, len(*p))
+	if err = eachP(ctx, len(hits), workers, func(ctx context.Context, i int) (err error) {
+		hits[i], err = sel(ctx, (*p)[i])
+		return
+	}); err != nil {
+		return slice, err
+	}
+	for i := range hits {
+		if hits[i] {
+			*slice = append(*slice, (*p)[i])
+		}
+	}
+	return slice, nil
+}
+
 // Set the element(s) at the given index location to the given element(s). Allows for negative notation.
 // Returns a reference to this Slice and swallows any errors.
 func (p *MapSlice) Set(i int, elem interface{}) ISlice {
@@ -724,6 +955,41 @@ func (p *MapSlice) Sort() (new ISlice) {
 	return p.Copy().SortM()
 }
 
+// SortBy returns a new Slice with the elements sorted using the given less lambda.
+func (p *MapSlice) SortBy(less func(a, b O) bool) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortByM(less)
+}
+
+// SortByKeys returns a new Slice with the elements stably sorted by the values of the given keys
+// in order e.g. SortByKeys("name", "-age") sorts by name then by age in descending order for
+// equal names. Keys resolve to map values or struct fields and missing values sort first.
+func (p *MapSlice) SortByKeys(keys ...string) (new ISlice) {
+	return p.Copy().SortStableM(sortKeysLess(keys))
+}
+
+// SortByKeysM modifies this Slice stably sorting the elements by the values of the given keys in
+// order e.g. SortByKeysM("name", "-age") sorts by name then by age in descending order for equal
+// names. Keys resolve to map values or struct fields and missing values sort first. Returns a
+// reference to this Slice.
+func (p *MapSlice) SortByKeysM(keys ...string) ISlice {
+	return p.SortStableM(sortKeysLess(keys))
+}
+
+// SortByM modifies this Slice sorting the elements using the given less lambda and returns a
+// reference to this Slice.
+func (p *MapSlice) SortByM(less func(a, b O) bool) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sort.Slice(*p, func(i, j int) bool {
+		return less((*p)[i], (*p)[j])
+	})
+	return p
+}
+
 // SortM modifies this Slice sorting the elements and returns a reference to this Slice.
 func (p *MapSlice) SortM() ISlice {
 	if p == nil || len(*p) < 2 {
@@ -750,6 +1016,27 @@ func (p *MapSlice) SortReverseM() ISlice {
 	return p
 }
 
+// SortStable returns a new Slice with the elements sorted using the given less lambda while
+// keeping equal elements in their original order.
+func (p *MapSlice) SortStable(less func(a, b O) bool) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortStableM(less)
+}
+
+// SortStableM modifies this Slice sorting the elements using the given less lambda while keeping
+// equal elements in their original order and returns a reference to this Slice.
+func (p *MapSlice) SortStableM(less func(a, b O) bool) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sort.SliceStable(*p, func(i, j int) bool {
+		return less((*p)[i], (*p)[j])
+	})
+	return p
+}
+
 // String returns a string representation of this Slice, implements the Stringer interface
 func (p *MapSlice) String() string {
 	var builder strings.Builder
@@ -766,6 +1053,17 @@ func (p *MapSlice) String() string {
 	return builder.String()
 }
 
+// SumBy returns the sum of the keys returned from the lambda for each element in this Slice.
+func (p *MapSlice) SumBy(key func(O) float64) (sum float64) {
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	for i := range *p {
+		sum += key((*p)[i])
+	}
+	return
+}
+
 // Swap modifies this Slice swapping the indicated elements.
 func (p *MapSlice) Swap(i, j int) {
 	if p == nil || len(*p) < 2 || i < 0 || j < 0 || i >= len(*p) || j >= len(*p) {
@@ -774,6 +1072,15 @@ func (p *MapSlice) Swap(i, j int) {
 	(*p)[i], (*p)[j] = (*p)[j], (*p)[i]
 }
 
+// SymmetricDifference returns a new Slice with the uniq elements of this Slice that are not in the
+// given Slice followed by the uniq elements of the given Slice that are not in this Slice while
+// preserving order.
+// Supports MapSlice, *MapSlice, []map[string]interface{} or *[]map[string]interface{}
+func (p *MapSlice) SymmetricDifference(slice interface{}) (new ISlice) {
+	other := ToMapSlice(slice)
+	return p.Difference(other).ConcatM(other.Difference(p))
+}
+
 // Take modifies this Slice removing the indicated range of elements from this Slice and returning them as a new Slice.
 // Expects nothing, in which case everything is taken, or two indices i and j, in which case positive and negative
 // notation is supported and uses an inclusive behavior such that Take(0, -1) includes index -1 as opposed to Go's
@@ -880,3 +1187,34 @@ func (p *MapSlice) UniqM() ISlice {
 	}
 	return p
 }
+
+// Window creates a new slice of Slices each holding n consecutive elements copied from this Slice
+// starting at every step elements i.e. a sliding window. Only full windows are included and an
+// empty slice is returned if n or step are less than 1.
+func (p *MapSlice) Window(n, step int) (windows ISlice) {
+	x := []interface{}{}
+	if p == nil || len(*p) == 0 || n < 1 || step < 1 {
+		return NewInterSliceV(x...)
+	}
+	for i := 0; i+n <= len(*p); i += step {
+		x = append(x, p.Copy(i, i+n-1))
+	}
+	return NewInterSliceV(x...)
+}
+
+// Zip creates a new slice of pairs, as InterSlices, combining each element of this Slice with
+// the element at the same index in the given Slice. The result is as long as the shorter Slice.
+func (p *MapSlice) Zip(slice interface{}) (new ISlice) {
+	x := []interface{}{}
+	other, ok := slice.(ISlice)
+	if !ok {
+		other = Slice(slice)
+	}
+	if p == nil || len(*p) == 0 || other.Len() == 0 {
+		return NewInterSliceV(x...)
+	}
+	for i := 0; i < len(*p) && i < other.Len(); i++ {
+		x = append(x, NewInterSliceV((*p)[i], other.At(i).O()))
+	}
+	return NewInterSliceV(x...)
+}
@@ -1,9 +1,12 @@
 package n
 
 import (
+	"context"
 	"fmt"
+	"sync/atomic"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -522,6 +525,73 @@ func TestMapSlice_NewMapSliceV(t *testing.T) {
 // 	}
 // }
 
+// BinarySearchBy
+//--------------------------------------------------------------------------------------------------
+func ExampleMapSlice_BinarySearchBy() {
+	slice := NewMapSliceV([]map[string]interface{}{{"id": 1}, {"id": 3}, {"id": 5}})
+	fmt.Println(slice.BinarySearchBy(func(x O) int {
+		return ToInt(x.(map[string]interface{})["id"]) - 3
+	}))
+	// Output: 1 true
+}
+
+func TestMapSlice_BinarySearchBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		i, found := slice.BinarySearchBy(func(x O) int { return 0 })
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// found and not found
+	{
+		slice := NewMapSliceV([]map[string]interface{}{{"id": 1}, {"id": 3}, {"id": 5}})
+		search := func(id int) (int, bool) {
+			return slice.BinarySearchBy(func(x O) int {
+				return ToInt(x.(map[string]interface{})["id"]) - id
+			})
+		}
+		i, found := search(5)
+		assert.Equal(t, 2, i)
+		assert.True(t, found)
+		i, found = search(2)
+		assert.Equal(t, 1, i)
+		assert.False(t, found)
+		i, found = search(9)
+		assert.Equal(t, 3, i)
+		assert.False(t, found)
+	}
+}
+
+// Chunk
+//--------------------------------------------------------------------------------------------------
+func ExampleMapSlice_Chunk() {
+	slice := NewMapSliceV([]map[string]interface{}{{"name": "a", "dept": "eng"}, {"name": "b", "dept": "ops"}, {"name": "c", "dept": "eng"}})
+	fmt.Println(slice.Chunk(2).Len())
+	// Output: 2
+}
+
+func TestMapSlice_Chunk(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		assert.Equal(t, 0, slice.Chunk(2).Len())
+		assert.Equal(t, 0, NewMapSliceV().Chunk(2).Len())
+		assert.Equal(t, 0, NewMapSliceV([]map[string]interface{}{{"name": "a", "dept": "eng"}, {"name": "b", "dept": "ops"}, {"name": "c", "dept": "eng"}}).Chunk(0).Len())
+	}
+
+	// remainder in the last chunk
+	{
+		chunks := NewMapSliceV([]map[string]interface{}{{"name": "a", "dept": "eng"}, {"name": "b", "dept": "ops"}, {"name": "c", "dept": "eng"}}).Chunk(2)
+		assert.Equal(t, 2, chunks.Len())
+		assert.Equal(t, []map[string]interface{}{{"name": "a", "dept": "eng"}, {"name": "b", "dept": "ops"}}, chunks.At(0).O().(ISlice).O())
+		assert.Equal(t, []map[string]interface{}{{"name": "c", "dept": "eng"}}, chunks.At(1).O().(ISlice).O())
+	}
+}
+
 // // Clear
 // //--------------------------------------------------------------------------------------------------
 // func ExampleMapSlice_Clear() {
@@ -926,6 +996,64 @@ func TestMapSlice_NewMapSliceV(t *testing.T) {
 // 	assert.Equal(t, 1, NewMapSliceV("1", "2", "3").CountW(func(x O) bool { return ExB(x.(string) == "4" || x.(string) == "3") }))
 // }
 
+// Difference
+//--------------------------------------------------------------------------------------------------
+func ExampleMapSlice_Difference() {
+	slice := NewMapSliceV(map[string]interface{}{"1": 1}, map[string]interface{}{"2": 2}, map[string]interface{}{"3": 3}, map[string]interface{}{"2": 2})
+	fmt.Println(slice.Difference([]map[string]interface{}{{"2": 2}, {"4": 4}}).O())
+	// Output: [map[1:1] map[3:3]]
+}
+
+func TestMapSlice_Difference(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		assert.Equal(t, []map[string]interface{}{}, slice.Difference([]map[string]interface{}{{"1": 1}, {"2": 2}}).O())
+		assert.Equal(t, []map[string]interface{}{{"1": 1}, {"2": 2}, {"3": 3}}, NewMapSliceV(map[string]interface{}{"1": 1}, map[string]interface{}{"2": 2}, map[string]interface{}{"2": 2}, map[string]interface{}{"3": 3}).Difference([]map[string]interface{}{}).O())
+	}
+
+	// uniq elements not in other while preserving order
+	{
+		slice := NewMapSliceV(map[string]interface{}{"3": 3}, map[string]interface{}{"1": 1}, map[string]interface{}{"2": 2}, map[string]interface{}{"1": 1}, map[string]interface{}{"4": 4})
+		assert.Equal(t, []map[string]interface{}{{"3": 3}, {"1": 1}}, slice.Difference([]map[string]interface{}{{"2": 2}, {"4": 4}, {"5": 5}}).O())
+		assert.Equal(t, []map[string]interface{}{{"3": 3}, {"1": 1}, {"2": 2}, {"1": 1}, {"4": 4}}, slice.O())
+	}
+
+	// other is a Slice
+	{
+		assert.Equal(t, []map[string]interface{}{{"1": 1}, {"3": 3}}, NewMapSliceV(map[string]interface{}{"1": 1}, map[string]interface{}{"2": 2}, map[string]interface{}{"3": 3}).Difference(NewMapSliceV(map[string]interface{}{"2": 2})).O())
+	}
+}
+
+// Disjoint
+//--------------------------------------------------------------------------------------------------
+func ExampleMapSlice_Disjoint() {
+	slice := NewMapSliceV(map[string]interface{}{"1": 1}, map[string]interface{}{"2": 2}, map[string]interface{}{"3": 3}, map[string]interface{}{"2": 2})
+	fmt.Println(slice.Disjoint([]map[string]interface{}{{"2": 2}, {"4": 4}}))
+	// Output: false
+}
+
+func TestMapSlice_Disjoint(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		assert.True(t, slice.Disjoint([]map[string]interface{}{{"1": 1}, {"2": 2}}))
+		assert.True(t, NewMapSliceV(map[string]interface{}{"1": 1}).Disjoint([]map[string]interface{}{}))
+	}
+
+	// no elements in common
+	{
+		assert.True(t, NewMapSliceV(map[string]interface{}{"1": 1}, map[string]interface{}{"2": 2}).Disjoint([]map[string]interface{}{{"3": 3}, {"4": 4}}))
+	}
+
+	// one element in common
+	{
+		assert.False(t, NewMapSliceV(map[string]interface{}{"1": 1}, map[string]interface{}{"2": 2}).Disjoint(NewMapSliceV(map[string]interface{}{"2": 2}, map[string]interface{}{"3": 3})))
+	}
+}
+
 // // Drop
 // //--------------------------------------------------------------------------------------------------
 // func BenchmarkMapSlice_Drop_Go(t *testing.B) {
@@ -1496,6 +1624,56 @@ func TestMapSlice_EachE(t *testing.T) {
 // 	}
 // }
 
+// EachP
+//--------------------------------------------------------------------------------------------------
+func TestMapSlice_EachP(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		_, err := slice.EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+			return nil
+		})
+		assert.Nil(t, err)
+	}
+
+	// Loop through
+	{
+		results := make([]int32, 3)
+		slice, err := NewMapSliceV([]map[string]interface{}{{"foo": 1}, {"foo": 2}, {"foo": 3}}).EachP(context.Background(), 0, func(ctx context.Context, x O) error {
+			atomic.AddInt32(&results[x.(map[string]interface{})["foo"].(int)-1], 1)
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []int32{1, 1, 1}, results)
+		assert.Equal(t, NewMapSliceV([]map[string]interface{}{{"foo": 1}, {"foo": 2}, {"foo": 3}}), slice)
+	}
+
+	// First error is returned
+	{
+		_, err := NewMapSliceV([]map[string]interface{}{{"foo": 1}, {"foo": 2}, {"foo": 3}}).EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+			if x.(map[string]interface{})["foo"].(int) == 2 {
+				return errors.New("failed")
+			}
+			return nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+
+	// Cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		called := false
+		_, err := NewMapSliceV([]map[string]interface{}{{"foo": 1}, {"foo": 2}}).EachP(ctx, 2, func(ctx context.Context, x O) error {
+			called = true
+			return nil
+		})
+		assert.Equal(t, context.Canceled, err)
+		assert.False(t, called)
+	}
+}
+
 // // EachR
 // //--------------------------------------------------------------------------------------------------
 // func BenchmarkMapSlice_EachR_Go(t *testing.B) {
@@ -1805,6 +1983,69 @@ func TestMapSlice_EachE(t *testing.T) {
 // 	assert.Equal(t, NewMapSliceV("1", "2"), NewMapSliceV("1", "2", "3").FirstN(2))
 // }
 
+// FlatMap
+//--------------------------------------------------------------------------------------------------
+func ExampleMapSlice_FlatMap() {
+	slice := NewMapSliceV([]map[string]interface{}{{"name": "a", "dept": "eng"}, {"name": "b", "dept": "ops"}, {"name": "c", "dept": "eng"}})
+	fmt.Println(slice.FlatMap(func(x O) O {
+		return []string{x.(map[string]interface{})["name"].(string), x.(map[string]interface{})["dept"].(string)}
+	}))
+	// Output: [a eng b ops c eng]
+}
+
+func TestMapSlice_FlatMap(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		assert.Equal(t, 0, slice.FlatMap(func(x O) O { return x }).Len())
+		assert.Equal(t, 0, NewMapSliceV().FlatMap(func(x O) O { return x }).Len())
+	}
+
+	// slice results are expanded
+	{
+		slice := NewMapSliceV([]map[string]interface{}{{"name": "a", "dept": "eng"}, {"name": "b", "dept": "ops"}, {"name": "c", "dept": "eng"}}).FlatMap(func(x O) O {
+			return []interface{}{x.(map[string]interface{})["name"], x.(map[string]interface{})["dept"]}
+		})
+		assert.Equal(t, []string{"a", "eng", "b", "ops", "c", "eng"}, slice.O())
+	}
+
+	// other results are kept as is
+	{
+		slice := NewMapSliceV([]map[string]interface{}{{"name": "a", "dept": "eng"}, {"name": "b", "dept": "ops"}, {"name": "c", "dept": "eng"}}).FlatMap(func(x O) O {
+			return x
+		})
+		assert.Equal(t, NewMapSliceV([]map[string]interface{}{{"name": "a", "dept": "eng"}, {"name": "b", "dept": "ops"}, {"name": "c", "dept": "eng"}}).O(), slice.O())
+	}
+}
+
+// Flatten
+//--------------------------------------------------------------------------------------------------
+func ExampleMapSlice_Flatten() {
+	slice := NewMapSliceV([]map[string]interface{}{{"foo": "bar"}})
+	fmt.Println(slice.Flatten())
+	// Output: [&map[foo:bar]]
+}
+
+func TestMapSlice_Flatten(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		assert.Equal(t, 0, slice.Flatten().Len())
+		assert.Equal(t, 0, NewMapSliceV().Flatten().Len())
+	}
+
+	// already flat so it is a copy
+	{
+		slice := NewMapSliceV([]map[string]interface{}{{"name": "a", "dept": "eng"}, {"name": "b", "dept": "ops"}, {"name": "c", "dept": "eng"}})
+		new := slice.Flatten()
+		assert.Equal(t, slice.O(), new.O())
+		new.DropFirst()
+		assert.Equal(t, 3, slice.Len())
+	}
+}
+
 // // G
 // //--------------------------------------------------------------------------------------------------
 // func ExampleMapSlice_G() {
@@ -1825,6 +2066,46 @@ func TestMapSlice_EachE(t *testing.T) {
 // 	// Output: false
 // }
 
+// GroupBy
+//--------------------------------------------------------------------------------------------------
+func ExampleMapSlice_GroupBy() {
+	slice := NewMapSliceV([]map[string]interface{}{{"name": "a", "dept": "eng"}, {"name": "b", "dept": "ops"}, {"name": "c", "dept": "eng"}})
+	groups := slice.GroupBy(func(x O) O {
+		return x.(map[string]interface{})["dept"]
+	})
+	fmt.Println(groups.Keys(), groups.Get("eng").ToMapSlice().Len())
+	// Output: [eng ops] 2
+}
+
+func TestMapSlice_GroupBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		assert.Equal(t, 0, slice.GroupBy(func(x O) O { return x }).Len())
+		assert.Equal(t, 0, NewMapSliceV().GroupBy(func(x O) O { return x }).Len())
+	}
+
+	// bucket rows by a field
+	{
+		groups := NewMapSliceV([]map[string]interface{}{{"name": "a", "dept": "eng"}, {"name": "b", "dept": "ops"}, {"name": "c", "dept": "eng"}}).GroupBy(func(x O) O {
+			return x.(map[string]interface{})["dept"]
+		})
+		assert.Equal(t, []string{"eng", "ops"}, groups.Keys().O())
+		assert.Equal(t, []map[string]interface{}{{"name": "a", "dept": "eng"}, {"name": "c", "dept": "eng"}}, groups.Get("eng").ToMapSlice().O())
+		assert.Equal(t, []map[string]interface{}{{"name": "b", "dept": "ops"}}, groups.Get("ops").ToMapSlice().O())
+	}
+
+	// missing field groups under an empty key
+	{
+		groups := NewMapSliceV([]map[string]interface{}{{"name": "a", "dept": "eng"}, {"name": "b", "dept": "ops"}, {"name": "c", "dept": "eng"}}).GroupBy(func(x O) O {
+			return x.(map[string]interface{})["bogus"]
+		})
+		assert.Equal(t, []string{""}, groups.Keys().O())
+		assert.Equal(t, 3, groups.Get("").ToMapSlice().Len())
+	}
+}
+
 // // Index
 // //--------------------------------------------------------------------------------------------------
 // func BenchmarkMapSlice_Index_Go(t *testing.B) {
@@ -1995,6 +2276,103 @@ func TestMapSlice_EachE(t *testing.T) {
 // 	}
 // }
 
+// Intersect
+//--------------------------------------------------------------------------------------------------
+func ExampleMapSlice_Intersect() {
+	slice := NewMapSliceV(map[string]interface{}{"1": 1}, map[string]interface{}{"2": 2}, map[string]interface{}{"3": 3}, map[string]interface{}{"2": 2})
+	fmt.Println(slice.Intersect([]map[string]interface{}{{"2": 2}, {"4": 4}}).O())
+	// Output: [map[2:2]]
+}
+
+func TestMapSlice_Intersect(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		assert.Equal(t, []map[string]interface{}{}, slice.Intersect([]map[string]interface{}{{"1": 1}, {"2": 2}}).O())
+		assert.Equal(t, []map[string]interface{}{}, NewMapSliceV(map[string]interface{}{"1": 1}, map[string]interface{}{"2": 2}).Intersect([]map[string]interface{}{}).O())
+	}
+
+	// uniq elements in other while preserving order
+	{
+		slice := NewMapSliceV(map[string]interface{}{"3": 3}, map[string]interface{}{"1": 1}, map[string]interface{}{"2": 2}, map[string]interface{}{"1": 1}, map[string]interface{}{"4": 4})
+		assert.Equal(t, []map[string]interface{}{{"1": 1}, {"4": 4}}, slice.Intersect([]map[string]interface{}{{"4": 4}, {"1": 1}, {"5": 5}}).O())
+		assert.Equal(t, []map[string]interface{}{{"3": 3}, {"1": 1}, {"2": 2}, {"1": 1}, {"4": 4}}, slice.O())
+	}
+
+	// other is a Slice
+	{
+		assert.Equal(t, []map[string]interface{}{{"2": 2}, {"3": 3}}, NewMapSliceV(map[string]interface{}{"1": 1}, map[string]interface{}{"2": 2}, map[string]interface{}{"3": 3}).Intersect(NewMapSliceV(map[string]interface{}{"3": 3}, map[string]interface{}{"2": 2})).O())
+	}
+}
+
+// Iter
+//--------------------------------------------------------------------------------------------------
+func ExampleMapSlice_Iter() {
+	it := NewMapSliceV(map[string]interface{}{"1": 1}, map[string]interface{}{"2": 2}, map[string]interface{}{"3": 3}).Iter()
+	for it.Next() {
+		fmt.Println(it.Index(), it.Value())
+	}
+	// Output:
+	// 0 map[1:1]
+	// 1 map[2:2]
+	// 2 map[3:3]
+}
+
+func TestMapSlice_Iter(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		assert.False(t, slice.Iter().Next())
+		assert.False(t, NewMapSliceV().Iter().Next())
+	}
+
+	// all elements in order
+	{
+		it := NewMapSliceV(map[string]interface{}{"1": 1}, map[string]interface{}{"2": 2}, map[string]interface{}{"3": 3}).Iter()
+		assert.Equal(t, -1, it.Index())
+		assert.Nil(t, it.Value())
+		for i, x := range []interface{}{map[string]interface{}{"1": 1}, map[string]interface{}{"2": 2}, map[string]interface{}{"3": 3}} {
+			assert.True(t, it.Next())
+			assert.Equal(t, i, it.Index())
+			assert.Equal(t, x, it.Value())
+		}
+		assert.False(t, it.Next())
+		assert.False(t, it.Next())
+		assert.Nil(t, it.Value())
+		assert.Nil(t, it.Err())
+	}
+}
+
+// IsSubset
+//--------------------------------------------------------------------------------------------------
+func ExampleMapSlice_IsSubset() {
+	slice := NewMapSliceV(map[string]interface{}{"1": 1}, map[string]interface{}{"2": 2}, map[string]interface{}{"3": 3}, map[string]interface{}{"2": 2})
+	fmt.Println(slice.IsSubset([]map[string]interface{}{{"2": 2}, {"4": 4}}))
+	// Output: false
+}
+
+func TestMapSlice_IsSubset(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		assert.True(t, slice.IsSubset([]map[string]interface{}{{"1": 1}, {"2": 2}}))
+		assert.False(t, NewMapSliceV(map[string]interface{}{"1": 1}).IsSubset([]map[string]interface{}{}))
+	}
+
+	// all elements in other
+	{
+		assert.True(t, NewMapSliceV(map[string]interface{}{"1": 1}, map[string]interface{}{"2": 2}, map[string]interface{}{"2": 2}).IsSubset([]map[string]interface{}{{"3": 3}, {"2": 2}, {"1": 1}}))
+	}
+
+	// not all elements in other
+	{
+		assert.False(t, NewMapSliceV(map[string]interface{}{"1": 1}, map[string]interface{}{"4": 4}).IsSubset(NewMapSliceV(map[string]interface{}{"1": 1}, map[string]interface{}{"2": 2})))
+	}
+}
+
 // // Join
 // //--------------------------------------------------------------------------------------------------
 // func BenchmarkMapSlice_Join_Go(t *testing.B) {
@@ -2177,6 +2555,117 @@ func TestMapSlice_EachE(t *testing.T) {
 // 	assert.Equal(t, true, NewMapSliceV("0", "1", "2").Less(1, 2))
 // }
 
+// MapP
+//--------------------------------------------------------------------------------------------------
+func TestMapSlice_MapP(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		result, err := slice.MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+			return x, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, NewMapSliceV(), result)
+	}
+
+	// Order is preserved
+	{
+		slice := NewMapSliceV()
+		for i := 0; i < 50; i++ {
+			slice.Append(map[string]interface{}{"foo": i})
+		}
+		result, err := slice.MapP(context.Background(), 4, func(ctx context.Context, x O) (O, error) {
+			return map[string]interface{}{"foo": x.(map[string]interface{})["foo"].(int) * 2}, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 50, result.Len())
+		for i := 0; i < 50; i++ {
+			assert.Equal(t, i*2, result.At(i).ToStringMap().Get("foo").ToInt())
+		}
+		assert.Equal(t, 0, slice.At(0).ToStringMap().Get("foo").ToInt())
+	}
+
+	// First error is returned with an empty Slice
+	{
+		result, err := NewMapSliceV([]map[string]interface{}{{"foo": 1}, {"foo": 2}}).MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+			if x.(map[string]interface{})["foo"].(int) == 2 {
+				return nil, errors.New("failed")
+			}
+			return x, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+		assert.Equal(t, NewMapSliceV(), result)
+	}
+
+	// Cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		result, err := NewMapSliceV([]map[string]interface{}{{"foo": 1}}).MapP(ctx, 2, func(ctx context.Context, x O) (O, error) {
+			return x, nil
+		})
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, NewMapSliceV(), result)
+	}
+}
+
+// MaxBy
+//--------------------------------------------------------------------------------------------------
+func ExampleMapSlice_MaxBy() {
+	slice := NewMapSliceV([]map[string]interface{}{{"name": "a", "ms": 30}, {"name": "b", "ms": 10}, {"name": "c", "ms": 30}, {"name": "d", "ms": 10}})
+	fmt.Println(slice.MaxBy(func(x O) float64 {
+		return ToFloat64(x.(map[string]interface{})["ms"])
+	}))
+	// Output: &map[ms:30 name:a]
+}
+
+func TestMapSlice_MaxBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		assert.True(t, slice.MaxBy(func(x O) float64 { return 0 }).Nil())
+		assert.True(t, NewMapSliceV().MaxBy(func(x O) float64 { return 0 }).Nil())
+	}
+
+	// first max wins
+	{
+		elem := NewMapSliceV([]map[string]interface{}{{"name": "a", "ms": 30}, {"name": "b", "ms": 10}, {"name": "c", "ms": 30}, {"name": "d", "ms": 10}}).MaxBy(func(x O) float64 {
+			return ToFloat64(x.(map[string]interface{})["ms"])
+		})
+		assert.Equal(t, map[string]interface{}{"name": "a", "ms": 30}, elem.O())
+	}
+}
+
+// MinBy
+//--------------------------------------------------------------------------------------------------
+func ExampleMapSlice_MinBy() {
+	slice := NewMapSliceV([]map[string]interface{}{{"name": "a", "ms": 30}, {"name": "b", "ms": 10}, {"name": "c", "ms": 30}, {"name": "d", "ms": 10}})
+	fmt.Println(slice.MinBy(func(x O) float64 {
+		return ToFloat64(x.(map[string]interface{})["ms"])
+	}))
+	// Output: &map[ms:10 name:b]
+}
+
+func TestMapSlice_MinBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		assert.True(t, slice.MinBy(func(x O) float64 { return 0 }).Nil())
+		assert.True(t, NewMapSliceV().MinBy(func(x O) float64 { return 0 }).Nil())
+	}
+
+	// first min wins
+	{
+		elem := NewMapSliceV([]map[string]interface{}{{"name": "a", "ms": 30}, {"name": "b", "ms": 10}, {"name": "c", "ms": 30}, {"name": "d", "ms": 10}}).MinBy(func(x O) float64 {
+			return ToFloat64(x.(map[string]interface{})["ms"])
+		})
+		assert.Equal(t, map[string]interface{}{"name": "b", "ms": 10}, elem.O())
+	}
+}
+
 // // Nil
 // //--------------------------------------------------------------------------------------------------
 // func ExampleMapSlice_Nil() {
@@ -2246,6 +2735,37 @@ func TestMapSlice_EachE(t *testing.T) {
 // 	}
 // }
 
+// Partition
+//--------------------------------------------------------------------------------------------------
+func ExampleMapSlice_Partition() {
+	slice := NewMapSliceV([]map[string]interface{}{{"name": "a", "dept": "eng"}, {"name": "b", "dept": "ops"}, {"name": "c", "dept": "eng"}})
+	match, rest := slice.Partition(func(x O) bool {
+		return x.(map[string]interface{})["dept"] == "eng"
+	})
+	fmt.Println(match.Len(), rest.Len())
+	// Output: 2 1
+}
+
+func TestMapSlice_Partition(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		match, rest := slice.Partition(func(x O) bool { return true })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+	}
+
+	// split in order
+	{
+		match, rest := NewMapSliceV([]map[string]interface{}{{"name": "a", "dept": "eng"}, {"name": "b", "dept": "ops"}, {"name": "c", "dept": "eng"}}).Partition(func(x O) bool {
+			return x.(map[string]interface{})["dept"] == "eng"
+		})
+		assert.Equal(t, []map[string]interface{}{{"name": "a", "dept": "eng"}, {"name": "c", "dept": "eng"}}, match.O())
+		assert.Equal(t, []map[string]interface{}{{"name": "b", "dept": "ops"}}, rest.O())
+	}
+}
+
 // // Pop
 // //--------------------------------------------------------------------------------------------------
 // func BenchmarkMapSlice_Pop_Go(t *testing.B) {
@@ -2549,6 +3069,60 @@ func TestMapSlice_EachE(t *testing.T) {
 // 	}
 // }
 
+// SelectP
+//--------------------------------------------------------------------------------------------------
+func TestMapSlice_SelectP(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		result, err := slice.SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+			return true, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, NewMapSliceV(), result)
+	}
+
+	// Order is preserved
+	{
+		slice := NewMapSliceV()
+		for i := 0; i < 50; i++ {
+			slice.Append(map[string]interface{}{"foo": i})
+		}
+		result, err := slice.SelectP(context.Background(), 4, func(ctx context.Context, x O) (bool, error) {
+			return x.(map[string]interface{})["foo"].(int)%2 == 0, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 25, result.Len())
+		for i := 0; i < 25; i++ {
+			assert.Equal(t, i*2, result.At(i).ToStringMap().Get("foo").ToInt())
+		}
+	}
+
+	// First error is returned with an empty Slice
+	{
+		result, err := NewMapSliceV([]map[string]interface{}{{"foo": 1}, {"foo": 2}}).SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+			if x.(map[string]interface{})["foo"].(int) == 2 {
+				return false, errors.New("failed")
+			}
+			return true, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+		assert.Equal(t, NewMapSliceV(), result)
+	}
+
+	// Cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		result, err := NewMapSliceV([]map[string]interface{}{{"foo": 1}}).SelectP(ctx, 2, func(ctx context.Context, x O) (bool, error) {
+			return true, nil
+		})
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, NewMapSliceV(), result)
+	}
+}
+
 // // Set
 // //--------------------------------------------------------------------------------------------------
 // func BenchmarkMapSlice_Set_Go(t *testing.B) {
@@ -2995,6 +3569,196 @@ func TestMapSlice_EachE(t *testing.T) {
 // 	}
 // }
 
+// SortBy
+//--------------------------------------------------------------------------------------------------
+func ExampleMapSlice_SortBy() {
+	slice := NewMapSliceV([]map[string]interface{}{{"name": "b"}, {"name": "a"}})
+	fmt.Println(slice.SortBy(func(a, b O) bool {
+		return ToString(a.(map[string]interface{})["name"]) < ToString(b.(map[string]interface{})["name"])
+	}))
+	// Output: [&map[name:a] &map[name:b]]
+}
+
+func TestMapSlice_SortBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		assert.Equal(t, NewMapSliceV(), slice.SortBy(func(a, b O) bool { return false }))
+	}
+
+	// sorted in a new slice
+	{
+		slice := NewMapSliceV([]map[string]interface{}{
+			{"name": "bob", "age": 30},
+			{"name": "alice", "age": 25},
+			{"name": "bob", "age": 40},
+			{"name": "carl"},
+		})
+		new := slice.SortBy(func(a, b O) bool {
+			return ToInt(a.(map[string]interface{})["age"]) < ToInt(b.(map[string]interface{})["age"])
+		})
+		assert.Equal(t, []string{"carl:<nil>", "alice:25", "bob:30", "bob:40"}, new.Map(func(x O) O {
+			return fmt.Sprintf("%v:%v", x.(map[string]interface{})["name"], x.(map[string]interface{})["age"])
+		}).O())
+		assert.Equal(t, []string{"bob:30", "alice:25", "bob:40", "carl:<nil>"}, slice.Map(func(x O) O {
+			return fmt.Sprintf("%v:%v", x.(map[string]interface{})["name"], x.(map[string]interface{})["age"])
+		}).O())
+	}
+}
+
+// SortByKeys
+//--------------------------------------------------------------------------------------------------
+func ExampleMapSlice_SortByKeys() {
+	slice := NewMapSliceV([]map[string]interface{}{
+		{"name": "bob", "age": 30},
+		{"name": "alice", "age": 25},
+		{"name": "bob", "age": 40},
+	})
+	fmt.Println(slice.SortByKeys("name", "-age"))
+	// Output: [&map[age:25 name:alice] &map[age:40 name:bob] &map[age:30 name:bob]]
+}
+
+func TestMapSlice_SortByKeys(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		assert.Equal(t, NewMapSliceV(), slice.SortByKeys("name"))
+		assert.Equal(t, NewMapSliceV(), NewMapSliceV().SortByKeys("name"))
+	}
+
+	// multiple keys with descending
+	{
+		slice := NewMapSliceV([]map[string]interface{}{
+			{"name": "bob", "age": 30},
+			{"name": "alice", "age": 25},
+			{"name": "bob", "age": 40},
+			{"name": "carl"},
+		})
+		new := slice.SortByKeys("name", "-age")
+		assert.Equal(t, []string{"alice:25", "bob:40", "bob:30", "carl:<nil>"}, new.Map(func(x O) O {
+			return fmt.Sprintf("%v:%v", x.(map[string]interface{})["name"], x.(map[string]interface{})["age"])
+		}).O())
+		assert.Equal(t, []string{"bob:30", "alice:25", "bob:40", "carl:<nil>"}, slice.Map(func(x O) O {
+			return fmt.Sprintf("%v:%v", x.(map[string]interface{})["name"], x.(map[string]interface{})["age"])
+		}).O())
+	}
+
+	// missing values sort first
+	{
+		new := NewMapSliceV([]map[string]interface{}{
+			{"name": "bob", "age": 30},
+			{"name": "alice", "age": 25},
+			{"name": "bob", "age": 40},
+			{"name": "carl"},
+		}).SortByKeys("age")
+		assert.Equal(t, []string{"carl:<nil>", "alice:25", "bob:30", "bob:40"}, new.Map(func(x O) O {
+			return fmt.Sprintf("%v:%v", x.(map[string]interface{})["name"], x.(map[string]interface{})["age"])
+		}).O())
+		new = NewMapSliceV([]map[string]interface{}{
+			{"name": "bob", "age": 30},
+			{"name": "alice", "age": 25},
+			{"name": "bob", "age": 40},
+			{"name": "carl"},
+		}).SortByKeys("-age")
+		assert.Equal(t, []string{"bob:40", "bob:30", "alice:25", "carl:<nil>"}, new.Map(func(x O) O {
+			return fmt.Sprintf("%v:%v", x.(map[string]interface{})["name"], x.(map[string]interface{})["age"])
+		}).O())
+	}
+
+	// mixed numeric kinds compare by value
+	{
+		slice := NewMapSliceV([]map[string]interface{}{{"v": 2.5}, {"v": uint8(1)}, {"v": int64(-3)}, {"v": 2}})
+		values := []interface{}{}
+		slice.SortByKeys("v").Each(func(x O) {
+			values = append(values, x.(map[string]interface{})["v"])
+		})
+		assert.Equal(t, []interface{}{int64(-3), uint8(1), 2, 2.5}, values)
+	}
+
+	// unknown keys keep the original order
+	{
+		new := NewMapSliceV([]map[string]interface{}{
+			{"name": "bob", "age": 30},
+			{"name": "alice", "age": 25},
+			{"name": "bob", "age": 40},
+			{"name": "carl"},
+		}).SortByKeys("bogus")
+		assert.Equal(t, []string{"bob:30", "alice:25", "bob:40", "carl:<nil>"}, new.Map(func(x O) O {
+			return fmt.Sprintf("%v:%v", x.(map[string]interface{})["name"], x.(map[string]interface{})["age"])
+		}).O())
+	}
+}
+
+// SortByKeysM
+//--------------------------------------------------------------------------------------------------
+func ExampleMapSlice_SortByKeysM() {
+	slice := NewMapSliceV([]map[string]interface{}{{"name": "b"}, {"name": "a"}})
+	slice.SortByKeysM("name")
+	fmt.Println(slice)
+	// Output: [&map[name:a] &map[name:b]]
+}
+
+func TestMapSlice_SortByKeysM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		assert.Equal(t, (*MapSlice)(nil), slice.SortByKeysM("name"))
+	}
+
+	// multiple keys with descending
+	{
+		slice := NewMapSliceV([]map[string]interface{}{
+			{"name": "bob", "age": 30},
+			{"name": "alice", "age": 25},
+			{"name": "bob", "age": 40},
+			{"name": "carl"},
+		})
+		slice.SortByKeysM("-name", "age")
+		assert.Equal(t, []string{"carl:<nil>", "bob:30", "bob:40", "alice:25"}, slice.Map(func(x O) O {
+			return fmt.Sprintf("%v:%v", x.(map[string]interface{})["name"], x.(map[string]interface{})["age"])
+		}).O())
+	}
+}
+
+// SortByM
+//--------------------------------------------------------------------------------------------------
+func ExampleMapSlice_SortByM() {
+	slice := NewMapSliceV([]map[string]interface{}{{"name": "b"}, {"name": "a"}})
+	slice.SortByM(func(a, b O) bool {
+		return ToString(a.(map[string]interface{})["name"]) < ToString(b.(map[string]interface{})["name"])
+	})
+	fmt.Println(slice)
+	// Output: [&map[name:a] &map[name:b]]
+}
+
+func TestMapSlice_SortByM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		assert.Equal(t, (*MapSlice)(nil), slice.SortByM(func(a, b O) bool { return false }))
+	}
+
+	// sorted in place
+	{
+		slice := NewMapSliceV([]map[string]interface{}{
+			{"name": "bob", "age": 30},
+			{"name": "alice", "age": 25},
+			{"name": "bob", "age": 40},
+			{"name": "carl"},
+		})
+		slice.SortByM(func(a, b O) bool {
+			return ToInt(a.(map[string]interface{})["age"]) > ToInt(b.(map[string]interface{})["age"])
+		})
+		assert.Equal(t, []string{"bob:40", "bob:30", "alice:25", "carl:<nil>"}, slice.Map(func(x O) O {
+			return fmt.Sprintf("%v:%v", x.(map[string]interface{})["name"], x.(map[string]interface{})["age"])
+		}).O())
+	}
+}
+
 // // SortM
 // //--------------------------------------------------------------------------------------------------
 // func BenchmarkMapSlice_SortM_Go(t *testing.B) {
@@ -3115,6 +3879,80 @@ func TestMapSlice_EachE(t *testing.T) {
 // 	}
 // }
 
+// SortStable
+//--------------------------------------------------------------------------------------------------
+func ExampleMapSlice_SortStable() {
+	slice := NewMapSliceV([]map[string]interface{}{{"name": "b", "id": 1}, {"name": "a"}, {"name": "b", "id": 2}})
+	fmt.Println(slice.SortStable(func(a, b O) bool {
+		return ToString(a.(map[string]interface{})["name"]) < ToString(b.(map[string]interface{})["name"])
+	}))
+	// Output: [&map[name:a] &map[id:1 name:b] &map[id:2 name:b]]
+}
+
+func TestMapSlice_SortStable(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		assert.Equal(t, NewMapSliceV(), slice.SortStable(func(a, b O) bool { return false }))
+	}
+
+	// equal elements keep their order
+	{
+		slice := NewMapSliceV([]map[string]interface{}{
+			{"name": "bob", "age": 30},
+			{"name": "alice", "age": 25},
+			{"name": "bob", "age": 40},
+			{"name": "carl"},
+		})
+		new := slice.SortStable(func(a, b O) bool {
+			return ToString(a.(map[string]interface{})["name"]) < ToString(b.(map[string]interface{})["name"])
+		})
+		assert.Equal(t, []string{"alice:25", "bob:30", "bob:40", "carl:<nil>"}, new.Map(func(x O) O {
+			return fmt.Sprintf("%v:%v", x.(map[string]interface{})["name"], x.(map[string]interface{})["age"])
+		}).O())
+		assert.Equal(t, []string{"bob:30", "alice:25", "bob:40", "carl:<nil>"}, slice.Map(func(x O) O {
+			return fmt.Sprintf("%v:%v", x.(map[string]interface{})["name"], x.(map[string]interface{})["age"])
+		}).O())
+	}
+}
+
+// SortStableM
+//--------------------------------------------------------------------------------------------------
+func ExampleMapSlice_SortStableM() {
+	slice := NewMapSliceV([]map[string]interface{}{{"name": "b", "id": 1}, {"name": "a"}, {"name": "b", "id": 2}})
+	slice.SortStableM(func(a, b O) bool {
+		return ToString(a.(map[string]interface{})["name"]) < ToString(b.(map[string]interface{})["name"])
+	})
+	fmt.Println(slice)
+	// Output: [&map[name:a] &map[id:1 name:b] &map[id:2 name:b]]
+}
+
+func TestMapSlice_SortStableM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		assert.Equal(t, (*MapSlice)(nil), slice.SortStableM(func(a, b O) bool { return false }))
+	}
+
+	// equal elements keep their order
+	{
+		slice := NewMapSliceV([]map[string]interface{}{
+			{"name": "bob", "age": 30},
+			{"name": "alice", "age": 25},
+			{"name": "bob", "age": 40},
+			{"name": "carl"},
+		})
+		slice.SortStableM(func(a, b O) bool {
+			return ToString(a.(map[string]interface{})["name"]) < ToString(b.(map[string]interface{})["name"])
+		})
+		assert.Equal(t, []string{"alice:25", "bob:30", "bob:40", "carl:<nil>"}, slice.Map(func(x O) O {
+			return fmt.Sprintf("%v:%v", x.(map[string]interface{})["name"], x.(map[string]interface{})["age"])
+		}).O())
+	}
+}
+
 // // String
 // //--------------------------------------------------------------------------------------------------
 // func BenchmarkMapSlice_String_Go(t *testing.B) {
@@ -3157,6 +3995,34 @@ func TestMapSlice_EachE(t *testing.T) {
 // 	}
 // }
 
+// SumBy
+//--------------------------------------------------------------------------------------------------
+func ExampleMapSlice_SumBy() {
+	slice := NewMapSliceV([]map[string]interface{}{{"name": "a", "ms": 30}, {"name": "b", "ms": 10}, {"name": "c", "ms": 30}, {"name": "d", "ms": 10}})
+	fmt.Println(slice.SumBy(func(x O) float64 {
+		return ToFloat64(x.(map[string]interface{})["ms"])
+	}))
+	// Output: 80
+}
+
+func TestMapSlice_SumBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		assert.Equal(t, 0.0, slice.SumBy(func(x O) float64 { return 1 }))
+		assert.Equal(t, 0.0, NewMapSliceV().SumBy(func(x O) float64 { return 1 }))
+	}
+
+	// sum of keys
+	{
+		sum := NewMapSliceV([]map[string]interface{}{{"name": "a", "ms": 30}, {"name": "b", "ms": 10}, {"name": "c", "ms": 30}, {"name": "d", "ms": 10}}).SumBy(func(x O) float64 {
+			return ToFloat64(x.(map[string]interface{})["ms"])
+		})
+		assert.Equal(t, 80.0, sum)
+	}
+}
+
 // // Swap
 // //--------------------------------------------------------------------------------------------------
 // func BenchmarkMapSlice_Swap_Go(t *testing.B) {
@@ -3215,6 +4081,36 @@ func TestMapSlice_EachE(t *testing.T) {
 // 	}
 // }
 
+// SymmetricDifference
+//--------------------------------------------------------------------------------------------------
+func ExampleMapSlice_SymmetricDifference() {
+	slice := NewMapSliceV(map[string]interface{}{"1": 1}, map[string]interface{}{"2": 2}, map[string]interface{}{"3": 3}, map[string]interface{}{"2": 2})
+	fmt.Println(slice.SymmetricDifference([]map[string]interface{}{{"2": 2}, {"4": 4}}).O())
+	// Output: [map[1:1] map[3:3] map[4:4]]
+}
+
+func TestMapSlice_SymmetricDifference(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		assert.Equal(t, []map[string]interface{}{{"1": 1}, {"2": 2}}, slice.SymmetricDifference([]map[string]interface{}{{"1": 1}, {"2": 2}, {"2": 2}}).O())
+		assert.Equal(t, []map[string]interface{}{{"1": 1}, {"2": 2}}, NewMapSliceV(map[string]interface{}{"1": 1}, map[string]interface{}{"2": 2}, map[string]interface{}{"2": 2}).SymmetricDifference([]map[string]interface{}{}).O())
+	}
+
+	// uniq elements from both not in the other while preserving order
+	{
+		slice := NewMapSliceV(map[string]interface{}{"1": 1}, map[string]interface{}{"2": 2}, map[string]interface{}{"3": 3}, map[string]interface{}{"3": 3})
+		assert.Equal(t, []map[string]interface{}{{"1": 1}, {"4": 4}}, slice.SymmetricDifference([]map[string]interface{}{{"4": 4}, {"3": 3}, {"2": 2}, {"4": 4}}).O())
+		assert.Equal(t, []map[string]interface{}{{"1": 1}, {"2": 2}, {"3": 3}, {"3": 3}}, slice.O())
+	}
+
+	// other is a Slice with the same elements
+	{
+		assert.Equal(t, []map[string]interface{}{}, NewMapSliceV(map[string]interface{}{"1": 1}, map[string]interface{}{"2": 2}).SymmetricDifference(NewMapSliceV(map[string]interface{}{"2": 2}, map[string]interface{}{"1": 1})).O())
+	}
+}
+
 // // Take
 // //--------------------------------------------------------------------------------------------------
 // func BenchmarkMapSlice_Take_Go(t *testing.B) {
@@ -3840,3 +4736,54 @@ func TestMapSlice_EachE(t *testing.T) {
 // 	}
 // 	return
 // }
+
+// Window
+//--------------------------------------------------------------------------------------------------
+func ExampleMapSlice_Window() {
+	slice := NewMapSliceV([]map[string]interface{}{{"name": "a", "dept": "eng"}, {"name": "b", "dept": "ops"}, {"name": "c", "dept": "eng"}})
+	fmt.Println(slice.Window(2, 1).Len())
+	// Output: 2
+}
+
+func TestMapSlice_Window(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		assert.Equal(t, 0, slice.Window(2, 1).Len())
+		assert.Equal(t, 0, NewMapSliceV([]map[string]interface{}{{"name": "a", "dept": "eng"}, {"name": "b", "dept": "ops"}, {"name": "c", "dept": "eng"}}).Window(2, 0).Len())
+		assert.Equal(t, 0, NewMapSliceV([]map[string]interface{}{{"name": "a", "dept": "eng"}, {"name": "b", "dept": "ops"}, {"name": "c", "dept": "eng"}}).Window(4, 1).Len())
+	}
+
+	// sliding by one
+	{
+		windows := NewMapSliceV([]map[string]interface{}{{"name": "a", "dept": "eng"}, {"name": "b", "dept": "ops"}, {"name": "c", "dept": "eng"}}).Window(2, 1)
+		assert.Equal(t, 2, windows.Len())
+		assert.Equal(t, []map[string]interface{}{{"name": "b", "dept": "ops"}, {"name": "c", "dept": "eng"}}, windows.At(1).O().(ISlice).O())
+	}
+}
+
+// Zip
+//--------------------------------------------------------------------------------------------------
+func ExampleMapSlice_Zip() {
+	slice := NewMapSliceV([]map[string]interface{}{{"foo": "bar"}})
+	fmt.Println(slice.Zip([]int{1, 2}).Len())
+	// Output: 1
+}
+
+func TestMapSlice_Zip(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *MapSlice
+		assert.Equal(t, 0, slice.Zip([]int{1}).Len())
+		assert.Equal(t, 0, NewMapSliceV([]map[string]interface{}{{"name": "a", "dept": "eng"}, {"name": "b", "dept": "ops"}, {"name": "c", "dept": "eng"}}).Zip(nil).Len())
+	}
+
+	// shorter of the two
+	{
+		pairs := NewMapSliceV([]map[string]interface{}{{"name": "a", "dept": "eng"}, {"name": "b", "dept": "ops"}, {"name": "c", "dept": "eng"}}).Zip([]int{1, 2})
+		assert.Equal(t, 2, pairs.Len())
+		assert.Equal(t, []interface{}{map[string]interface{}{"name": "b", "dept": "ops"}, 2}, pairs.At(1).O().(ISlice).O())
+	}
+}
@@ -1,8 +1,10 @@
 package n
 
 import (
+	"context"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -331,6 +333,41 @@ func (p *RefSlice) At(i int) (elem *Object) {
 	return
 }
 
+// BinarySearchBy searches this already sorted Slice using the given lambda which should return a
+// negative number if the element sorts before the target, zero if it is the target and a positive
+// number if it sorts after. Returns the index and true if found or the index it would be inserted
+// at and false if not.
+func (p *RefSlice) BinarySearchBy(cmp func(O) int) (i int, found bool) {
+	l := p.Len()
+	if p.Nil() || l == 0 {
+		return
+	}
+	i = sort.Search(l, func(i int) bool {
+		return cmp(p.v.Index(i).Interface()) >= 0
+	})
+	found = i < l && cmp(p.v.Index(i).Interface()) == 0
+	return
+}
+
+// Chunk creates a new slice of Slices each holding n elements copied from this Slice in order.
+// The last chunk will hold the remaining elements if the length isn't evenly divisible by n.
+// An empty slice is returned if n is less than 1.
+func (p *RefSlice) Chunk(n int) (chunks ISlice) {
+	l := p.Len()
+	x := []interface{}{}
+	if p.Nil() || l == 0 || n < 1 {
+		return NewInterSliceV(x...)
+	}
+	for i := 0; i < l; i += n {
+		j := i + n
+		if j > l {
+			j = l
+		}
+		x = append(x, p.Copy(i, j-1))
+	}
+	return NewInterSliceV(x...)
+}
+
 // Clear modifies this Slice to clear out all elements and returns a reference to this Slice.
 func (p *RefSlice) Clear() ISlice {
 	if p.Nil() {
@@ -450,6 +487,28 @@ func (p *RefSlice) CountW(sel func(O) bool) (cnt int) {
 	return
 }
 
+// Difference returns a new Slice with the uniq elements of this Slice that are not in the given Slice
+// while preserving order.
+// Supports ISlice and Go slice types
+func (p *RefSlice) Difference(slice interface{}) (new ISlice) {
+	other, ok := slice.(ISlice)
+	if !ok {
+		other = NewRefSlice(slice)
+	}
+	set, uniq := NewSet(other), NewSet(p)
+	return newEmptySlice(p.O()).ConcatM(p.Select(func(x O) bool { return !set.Contains(x) && uniq.Remove(x) }))
+}
+
+// Disjoint checks if this Slice has no elements in common with the given Slice.
+// Supports ISlice and Go slice types
+func (p *RefSlice) Disjoint(slice interface{}) bool {
+	other, ok := slice.(ISlice)
+	if !ok {
+		other = NewRefSlice(slice)
+	}
+	return NewSet(p).Disjoint(other)
+}
+
 // Drop modifies this Slice to delete the indicated range of elements and returns a referece to this Slice.
 // Expects nothing, in which case everything is dropped, or two indices i and j, in which case positive and
 // negative notation is supported and uses an inclusive behavior such that DropAt(0, -1) includes index -1
@@ -580,6 +639,21 @@ func (p *RefSlice) EachIE(action func(int, O) error) (ISlice, error) {
 	return p, err
 }
 
+// EachP calls the given lambda once for each element in this Slice, passing in the context and
+// element as parameters, fanning the calls out to the given number of workers or runtime.NumCPU()
+// workers if less than 1. The first error from the lambda or the given context cancels
+// the context passed to the lambda and skips the remaining elements. Returns a reference to this
+// Slice and the first error.
+func (p *RefSlice) EachP(ctx context.Context, workers int, action func(context.Context, O) error) (ISlice, error) {
+	if p.Nil() {
+		return p, nil
+	}
+	err := eachP(ctx, p.Len(), workers, func(ctx context.Context, i int) error {
+		return action(ctx, p.v.Index(i).Interface())
+	})
+	return p, err
+}
+
 // EachR calls the given lambda once for each element in this Slice in reverse, passing in that element
 // as a parameter. Returns a reference to this Slice
 func (p *RefSlice) EachR(action func(O)) ISlice {
@@ -660,6 +734,56 @@ func (p *RefSlice) FirstN(n int) ISlice {
 	return p.Slice(0, abs(n)-1)
 }
 
+// FlatMap creates a new slice with the modified elements from the lambda expanding any slice
+// results one level into the new slice.
+func (p *RefSlice) FlatMap(mod func(O) O) ISlice {
+	l := p.Len()
+	if p.Nil() || l == 0 {
+		return NewRefSliceV()
+	}
+	x := []interface{}{}
+	for i := 0; i < l; i++ {
+		x = flatAppend(x, mod(p.v.Index(i).Interface()))
+	}
+	return newSliceOf(x, NewRefSliceV())
+}
+
+// Flatten returns a new Slice with any nested slice elements expanded one level into it.
+func (p *RefSlice) Flatten() (new ISlice) {
+	l := p.Len()
+	if p.Nil() || l == 0 {
+		return NewRefSliceV()
+	}
+	x := []interface{}{}
+	for i := 0; i < l; i++ {
+		x = flatAppend(x, p.v.Index(i).Interface())
+	}
+	return newSliceOf(x, newEmptySlice(p.O()))
+}
+
+// GroupBy creates a new map of Slices with the elements of this Slice grouped by the key the
+// lambda returns for them. Keys are converted to strings and kept in the order they are
+// first seen while the elements keep their order in each group.
+func (p *RefSlice) GroupBy(key func(O) O) (groups IMap) {
+	l := p.Len()
+	m := NewOrderedMapV()
+	if p.Nil() || l == 0 {
+		return m
+	}
+	for i := 0; i < l; i++ {
+		elem := p.v.Index(i)
+		k := ToString(key(elem.Interface()))
+		group, ok := m.m[k].(*RefSlice)
+		if !ok {
+			x := reflect.MakeSlice(p.v.Type(), 0, 0)
+			group = &RefSlice{v: &x, k: x.Kind()}
+			m.Set(k, group)
+		}
+		*group.v = reflect.Append(*group.v, elem)
+	}
+	return m
+}
+
 // Index returns the index of the first element in this Slice where element == elem
 // Returns a -1 if the element was not not found.
 func (p *RefSlice) Index(elem interface{}) (loc int) {
@@ -759,6 +883,40 @@ func (p *RefSlice) InterSlice() bool {
 	return false
 }
 
+// Intersect returns a new Slice with the uniq elements of this Slice that are also in the given Slice
+// while preserving order.
+// Supports ISlice and Go slice types
+func (p *RefSlice) Intersect(slice interface{}) (new ISlice) {
+	other, ok := slice.(ISlice)
+	if !ok {
+		other = NewRefSlice(slice)
+	}
+	set, uniq := NewSet(other), NewSet(p)
+	return newEmptySlice(p.O()).ConcatM(p.Select(func(x O) bool { return set.Contains(x) && uniq.Remove(x) }))
+}
+
+// Iter returns an Iterator over the elements of this Slice in order.
+func (p *RefSlice) Iter() (it Iterator) {
+	i := 0
+	return NewIterator(func() (O, bool) {
+		if i >= p.Len() {
+			return nil, false
+		}
+		i++
+		return p.At(i - 1).O(), true
+	})
+}
+
+// IsSubset checks if all elements of this Slice are in the given Slice.
+// Supports ISlice and Go slice types
+func (p *RefSlice) IsSubset(slice interface{}) bool {
+	other, ok := slice.(ISlice)
+	if !ok {
+		other = NewRefSlice(slice)
+	}
+	return NewSet(p).IsSubset(other)
+}
+
 // Join converts each element into a string then joins them together using the given separator or comma by default.
 func (p *RefSlice) Join(separator ...string) (str *Object) {
 	l := p.Len()
@@ -843,6 +1001,68 @@ func (p *RefSlice) Map(mod func(O) O) ISlice {
 	return slice
 }
 
+// MapP creates a new slice with the modified elements from the lambda, fanning the calls out to
+// the given number of workers or runtime.NumCPU() workers if less than 1 while preserving the
+// element order. The first error from the lambda or the given context cancels the context passed
+// to the lambda and skips the remaining elements. Returns an empty Slice and the first error on
+// failure.
+func (p *RefSlice) MapP(ctx context.Context, workers int, mod func(context.Context, O) (O, error)) (ISlice, error) {
+	if p.Nil() || p.Len() == 0 {
+		return NewRefSliceV(), nil
+	}
+	results := make([]interface{}, p.Len())
+	err := eachP(ctx, len(results), workers, func(ctx context.Context, i int) (err error) {
+		results[i], err = mod(ctx, p.v.Index(i).Interface())
+		return
+	})
+	if err != nil {
+		return NewRefSliceV(), err
+	}
+	slice := Slice(results[0])
+	for i := 1; i < len(results); i++ {
+		slice.Append(results[i])
+	}
+	return slice, nil
+}
+
+// MaxBy returns the element of this Slice with the largest key returned from the lambda. The first
+// such element wins on ties and an empty Object is returned if this Slice is empty.
+func (p *RefSlice) MaxBy(key func(O) float64) (elem *Object) {
+	elem = &Object{}
+	l := p.Len()
+	if p.Nil() || l == 0 {
+		return
+	}
+	max := 0.0
+	for i := 0; i < l; i++ {
+		obj := p.v.Index(i).Interface()
+		if k := key(obj); i == 0 || k > max {
+			max = k
+			elem.o = obj
+		}
+	}
+	return
+}
+
+// MinBy returns the element of this Slice with the smallest key returned from the lambda. The first
+// such element wins on ties and an empty Object is returned if this Slice is empty.
+func (p *RefSlice) MinBy(key func(O) float64) (elem *Object) {
+	elem = &Object{}
+	l := p.Len()
+	if p.Nil() || l == 0 {
+		return
+	}
+	min := 0.0
+	for i := 0; i < l; i++ {
+		obj := p.v.Index(i).Interface()
+		if k := key(obj); i == 0 || k < min {
+			min = k
+			elem.o = obj
+		}
+	}
+	return
+}
+
 // Nil tests if this Slice is nil
 func (p *RefSlice) Nil() bool {
 	if p == nil || p.v == nil {
@@ -872,6 +1092,25 @@ func (p *RefSlice) Pair() (first, second *Object) {
 	return
 }
 
+// Partition creates two new slices the first with the elements that match the lambda selector
+// and the second with the elements that don't while preserving element order.
+func (p *RefSlice) Partition(sel func(O) bool) (match, rest ISlice) {
+	l := p.Len()
+	if p.Nil() || l == 0 {
+		return NewRefSliceV(), NewRefSliceV()
+	}
+	x, y := reflect.MakeSlice(p.v.Type(), 0, 0), reflect.MakeSlice(p.v.Type(), 0, 0)
+	for i := 0; i < l; i++ {
+		elem := p.v.Index(i)
+		if sel(elem.Interface()) {
+			x = reflect.Append(x, elem)
+		} else {
+			y = reflect.Append(y, elem)
+		}
+	}
+	return &RefSlice{v: &x, k: x.Kind()}, &RefSlice{v: &y, k: y.Kind()}
+}
+
 // Pop modifies this Slice to remove the last element and returns the removed element as an Object.
 func (p *RefSlice) Pop() (elem *Object) {
 	elem = p.Last()
@@ -943,6 +1182,31 @@ func (p *RefSlice) Select(sel func(O) bool) (new ISlice) {
 	return slice
 }
 
+// SelectP creates a new slice with the elements that match the lambda selector, fanning the calls
+// out to the given number of workers or runtime.NumCPU() workers if less than 1 while preserving
+// the element order. The first error from the lambda or the given context cancels the
+// context passed to the lambda and skips the remaining elements. Returns an empty Slice and the
+// first error on failure.
+func (p *RefSlice) SelectP(ctx context.Context, workers int, sel func(context.Context, O) (bool, error)) (new ISlice, err error) {
+	slice := NewRefSliceV()
+	if p.Nil() || p.Len() == 0 {
+		return slice, nil
+	}
+	hits := make([]bool, p.Len())
+	if err = eachP(ctx, len(hits), workers, func(ctx context.Context, i int) (err error) {
+		hits[i], err = sel(ctx, p.v.Index(i).Interface())
+		return
+	}); err != nil {
+		return slice, err
+	}
+	for i := range hits {
+		if hits[i] {
+			slice.Append(p.v.Index(i).Interface())
+		}
+	}
+	return slice, nil
+}
+
 // Set the element at the given index location to the given element. Allows for negative notation.
 // Returns a reference to this Slice and swallows any errors.
 func (p *RefSlice) Set(i int, elem interface{}) ISlice {
@@ -1027,6 +1291,41 @@ func (p *RefSlice) Sort() (new ISlice) {
 	return p.Copy().SortM()
 }
 
+// SortBy returns a new Slice with the elements sorted using the given less lambda.
+func (p *RefSlice) SortBy(less func(a, b O) bool) (new ISlice) {
+	if p.Nil() || p.Len() < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortByM(less)
+}
+
+// SortByKeys returns a new Slice with the elements stably sorted by the values of the given keys
+// in order e.g. SortByKeys("name", "-age") sorts by name then by age in descending order for
+// equal names. Keys resolve to map values or struct fields and missing values sort first.
+func (p *RefSlice) SortByKeys(keys ...string) (new ISlice) {
+	return p.Copy().SortStableM(sortKeysLess(keys))
+}
+
+// SortByKeysM modifies this Slice stably sorting the elements by the values of the given keys in
+// order e.g. SortByKeysM("name", "-age") sorts by name then by age in descending order for equal
+// names. Keys resolve to map values or struct fields and missing values sort first. Returns a
+// reference to this Slice.
+func (p *RefSlice) SortByKeysM(keys ...string) ISlice {
+	return p.SortStableM(sortKeysLess(keys))
+}
+
+// SortByM modifies this Slice sorting the elements using the given less lambda and returns a
+// reference to this Slice.
+func (p *RefSlice) SortByM(less func(a, b O) bool) ISlice {
+	if p.Nil() || p.Len() < 2 {
+		return p
+	}
+	sort.Slice(p.v.Interface(), func(i, j int) bool {
+		return less(p.v.Index(i).Interface(), p.v.Index(j).Interface())
+	})
+	return p
+}
+
 // SortM modifies this Slice sorting the elements and returns a reference to this Slice.
 // Supports optimized Slice types or Go types that can be converted into an optimized Slice type.
 func (p *RefSlice) SortM() ISlice {
@@ -1074,6 +1373,27 @@ func (p *RefSlice) SortReverseM() ISlice {
 	return p
 }
 
+// SortStable returns a new Slice with the elements sorted using the given less lambda while
+// keeping equal elements in their original order.
+func (p *RefSlice) SortStable(less func(a, b O) bool) (new ISlice) {
+	if p.Nil() || p.Len() < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortStableM(less)
+}
+
+// SortStableM modifies this Slice sorting the elements using the given less lambda while keeping
+// equal elements in their original order and returns a reference to this Slice.
+func (p *RefSlice) SortStableM(less func(a, b O) bool) ISlice {
+	if p.Nil() || p.Len() < 2 {
+		return p
+	}
+	sort.SliceStable(p.v.Interface(), func(i, j int) bool {
+		return less(p.v.Index(i).Interface(), p.v.Index(j).Interface())
+	})
+	return p
+}
+
 // Returns a string representation of this Slice, implements the Stringer interface
 func (p *RefSlice) String() string {
 	l := p.Len()
@@ -1089,6 +1409,18 @@ func (p *RefSlice) String() string {
 	return builder.String()
 }
 
+// SumBy returns the sum of the keys returned from the lambda for each element in this Slice.
+func (p *RefSlice) SumBy(key func(O) float64) (sum float64) {
+	l := p.Len()
+	if p.Nil() || l == 0 {
+		return
+	}
+	for i := 0; i < l; i++ {
+		sum += key(p.v.Index(i).Interface())
+	}
+	return
+}
+
 // Swap modifies this Slice swapping the indicated elements.
 func (p *RefSlice) Swap(i, j int) {
 	l := p.Len()
@@ -1098,6 +1430,21 @@ func (p *RefSlice) Swap(i, j int) {
 	reflect.Swapper(p.v.Interface())(i, j)
 }
 
+// SymmetricDifference returns a new Slice with the uniq elements of this Slice that are not in the
+// given Slice followed by the uniq elements of the given Slice that are not in this Slice while
+// preserving order.
+// Supports ISlice and Go slice types
+func (p *RefSlice) SymmetricDifference(slice interface{}) (new ISlice) {
+	other, ok := slice.(ISlice)
+	if !ok {
+		other = NewRefSlice(slice)
+	}
+	if p.Nil() {
+		return other.Difference(p)
+	}
+	return p.Difference(other).ConcatM(other.Difference(p))
+}
+
 // Take modifies this Slice removing the indicated range of elements from this Slice and returning them as a new Slice.
 // Expects nothing, in which case everything is taken, or two indices i and j, in which case positive and negative
 // notation is supported and uses an inclusive behavior such that Take(0, -1) includes index -1 as opposed to Go's
@@ -1215,3 +1562,36 @@ func (p *RefSlice) UniqM() ISlice {
 	}
 	return p
 }
+
+// Window creates a new slice of Slices each holding n consecutive elements copied from this Slice
+// starting at every step elements i.e. a sliding window. Only full windows are included and an
+// empty slice is returned if n or step are less than 1.
+func (p *RefSlice) Window(n, step int) (windows ISlice) {
+	l := p.Len()
+	x := []interface{}{}
+	if p.Nil() || l == 0 || n < 1 || step < 1 {
+		return NewInterSliceV(x...)
+	}
+	for i := 0; i+n <= l; i += step {
+		x = append(x, p.Copy(i, i+n-1))
+	}
+	return NewInterSliceV(x...)
+}
+
+// Zip creates a new slice of pairs, as InterSlices, combining each element of this Slice with
+// the element at the same index in the given Slice. The result is as long as the shorter Slice.
+func (p *RefSlice) Zip(slice interface{}) (new ISlice) {
+	l := p.Len()
+	x := []interface{}{}
+	other, ok := slice.(ISlice)
+	if !ok {
+		other = Slice(slice)
+	}
+	if p.Nil() || l == 0 || other.Len() == 0 {
+		return NewInterSliceV(x...)
+	}
+	for i := 0; i < l && i < other.Len(); i++ {
+		x = append(x, NewInterSliceV(p.v.Index(i).Interface(), other.At(i).O()))
+	}
+	return NewInterSliceV(x...)
+}
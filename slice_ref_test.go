@@ -1,11 +1,15 @@
 package n
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -757,6 +761,93 @@ func TestRefSlice_At(t *testing.T) {
 	}
 }
 
+// BinarySearchBy
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_BinarySearchBy() {
+	slice := NewRefSliceV(Integer{1}, Integer{3}, Integer{5})
+	fmt.Println(slice.BinarySearchBy(func(x O) int {
+		return x.(Integer).Value - 3
+	}))
+	// Output: 1 true
+}
+
+func TestRefSlice_BinarySearchBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		i, found := slice.BinarySearchBy(func(x O) int { return 0 })
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// found and not found
+	{
+		slice := NewRefSliceV(Integer{1}, Integer{3}, Integer{5})
+		search := func(v int) (int, bool) {
+			return slice.BinarySearchBy(func(x O) int {
+				return x.(Integer).Value - v
+			})
+		}
+		i, found := search(1)
+		assert.Equal(t, 0, i)
+		assert.True(t, found)
+		i, found = search(4)
+		assert.Equal(t, 2, i)
+		assert.False(t, found)
+		i, found = search(0)
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+}
+
+// Chunk
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Chunk() {
+	slice := NewRefSliceV(1, 2, 3, 4, 5)
+	slice.Chunk(2).Each(func(x O) {
+		fmt.Print(x)
+	})
+	// Output: [1 2][3 4][5]
+}
+
+func TestRefSlice_Chunk(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, 0, slice.Chunk(2).Len())
+		assert.Equal(t, 0, NewRefSliceV().Chunk(2).Len())
+	}
+
+	// invalid size
+	{
+		assert.Equal(t, 0, NewRefSliceV(1, 2, 3, 4, 5).Chunk(0).Len())
+		assert.Equal(t, 0, NewRefSliceV(1, 2, 3, 4, 5).Chunk(-1).Len())
+	}
+
+	// evenly divisible
+	{
+		chunks := NewRefSliceV(1, 2, 3, 4, 5).Chunk(5)
+		assert.Equal(t, 1, chunks.Len())
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, chunks.At(0).O().(ISlice).O())
+	}
+
+	// remainder in the last chunk
+	{
+		slice := NewRefSliceV(1, 2, 3, 4, 5)
+		chunks := slice.Chunk(2)
+		assert.Equal(t, 3, chunks.Len())
+		assert.Equal(t, []int{1, 2}, chunks.At(0).O().(ISlice).O())
+		assert.Equal(t, []int{3, 4}, chunks.At(1).O().(ISlice).O())
+		assert.Equal(t, []int{5}, chunks.At(2).O().(ISlice).O())
+
+		// chunks are copies
+		chunks.At(0).O().(ISlice).Set(0, 9)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, slice.O())
+	}
+}
+
 // Clear
 //--------------------------------------------------------------------------------------------------
 
@@ -1414,6 +1505,64 @@ func TestRefSlice_CountW(t *testing.T) {
 	assert.Equal(t, 1, NewRefSliceV(1, 2, 3).CountW(func(x O) bool { return ExB(x.(int) == 4 || x.(int) == 3) }))
 }
 
+// Difference
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Difference() {
+	slice := NewRefSliceV(Integer{1}, Integer{2}, Integer{3}, Integer{2})
+	fmt.Println(slice.Difference([]Integer{{2}, {4}}).O())
+	// Output: [{1} {3}]
+}
+
+func TestRefSlice_Difference(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, []interface{}{}, slice.Difference([]Integer{{1}, {2}}).O())
+		assert.Equal(t, []Integer{{1}, {2}, {3}}, NewRefSliceV(Integer{1}, Integer{2}, Integer{2}, Integer{3}).Difference([]Integer{}).O())
+	}
+
+	// uniq elements not in other while preserving order
+	{
+		slice := NewRefSliceV(Integer{3}, Integer{1}, Integer{2}, Integer{1}, Integer{4})
+		assert.Equal(t, []Integer{{3}, {1}}, slice.Difference([]Integer{{2}, {4}, {5}}).O())
+		assert.Equal(t, []Integer{{3}, {1}, {2}, {1}, {4}}, slice.O())
+	}
+
+	// other is a Slice
+	{
+		assert.Equal(t, []Integer{{1}, {3}}, NewRefSliceV(Integer{1}, Integer{2}, Integer{3}).Difference(NewRefSliceV(Integer{2})).O())
+	}
+}
+
+// Disjoint
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Disjoint() {
+	slice := NewRefSliceV(Integer{1}, Integer{2}, Integer{3}, Integer{2})
+	fmt.Println(slice.Disjoint([]Integer{{2}, {4}}))
+	// Output: false
+}
+
+func TestRefSlice_Disjoint(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.True(t, slice.Disjoint([]Integer{{1}, {2}}))
+		assert.True(t, NewRefSliceV(Integer{1}).Disjoint([]Integer{}))
+	}
+
+	// no elements in common
+	{
+		assert.True(t, NewRefSliceV(Integer{1}, Integer{2}).Disjoint([]Integer{{3}, {4}}))
+	}
+
+	// one element in common
+	{
+		assert.False(t, NewRefSliceV(Integer{1}, Integer{2}).Disjoint(NewRefSliceV(Integer{2}, Integer{3})))
+	}
+}
+
 // Drop
 //--------------------------------------------------------------------------------------------------
 func BenchmarkRefSlice_Drop_Go(t *testing.B) {
@@ -2376,6 +2525,63 @@ func TestRefSlice_EachIE(t *testing.T) {
 	}
 }
 
+// EachP
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_EachP() {
+	var sum int32
+	NewRefSliceV(1, 2, 3).EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+		atomic.AddInt32(&sum, int32(x.(int)))
+		return nil
+	})
+	fmt.Println(sum)
+	// Output: 6
+}
+
+func TestRefSlice_EachP(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		_, err := slice.EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+			return nil
+		})
+		assert.Nil(t, err)
+	}
+
+	// Loop through
+	{
+		results := make([]int32, 3)
+		slice, err := NewRefSliceV(1, 2, 3).EachP(context.Background(), 0, func(ctx context.Context, x O) error {
+			atomic.AddInt32(&results[x.(int)-1], 1)
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []int32{1, 1, 1}, results)
+		assert.Equal(t, []int{1, 2, 3}, slice.O())
+	}
+
+	// First error is returned
+	{
+		_, err := NewRefSliceV(1, 2, 3).EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+			if x.(int) == 2 {
+				return errors.New("failed")
+			}
+			return nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+
+	// Cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := NewRefSliceV(1, 2, 3).EachP(ctx, 2, func(ctx context.Context, x O) error {
+			return nil
+		})
+		assert.Equal(t, context.Canceled, err)
+	}
+}
+
 // EachR
 //--------------------------------------------------------------------------------------------------
 func BenchmarkRefSlice_EachR_Go(t *testing.B) {
@@ -2757,6 +2963,123 @@ func TestRefSlice_FirstN(t *testing.T) {
 	}
 }
 
+// FlatMap
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_FlatMap() {
+	slice := NewRefSliceV(1, 2, 3)
+	fmt.Println(slice.FlatMap(func(x O) O {
+		return []interface{}{x, x}
+	}))
+	// Output: [1 1 2 2 3 3]
+}
+
+func TestRefSlice_FlatMap(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, 0, slice.FlatMap(func(x O) O { return x }).Len())
+		assert.Equal(t, 0, NewRefSliceV().FlatMap(func(x O) O { return x }).Len())
+	}
+
+	// slice results are expanded
+	{
+		slice := NewRefSliceV(1, 2, 3).FlatMap(func(x O) O {
+			return []interface{}{x, x}
+		})
+		assert.Equal(t, []int{1, 1, 2, 2, 3, 3}, slice.O())
+	}
+
+	// Slice results are expanded
+	{
+		slice := NewRefSliceV(1, 2, 3).FlatMap(func(x O) O {
+			return NewIntSliceV(0, x.(int))
+		})
+		assert.Equal(t, []int{0, 1, 0, 2, 0, 3}, slice.O())
+	}
+
+	// other results are kept as is
+	{
+		slice := NewRefSliceV(1, 2, 3).FlatMap(func(x O) O {
+			return x.(int) * 2
+		})
+		assert.Equal(t, []int{2, 4, 6}, slice.O())
+	}
+}
+
+// Flatten
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Flatten() {
+	slice := NewRefSlice([][]int{{1, 2}, {3}})
+	fmt.Println(slice.Flatten())
+	// Output: [1 2 3]
+}
+
+func TestRefSlice_Flatten(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, 0, slice.Flatten().Len())
+		assert.Equal(t, 0, NewRefSliceV().Flatten().Len())
+	}
+
+	// nested slices are expanded one level
+	{
+		slice := NewRefSlice([][]int{{1, 2}, {3}})
+		assert.Equal(t, []int{1, 2, 3}, slice.Flatten().O())
+	}
+
+	// flat slices are copied
+	{
+		slice := NewRefSliceV(1, 2, 3, 4, 5)
+		new := slice.Flatten()
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, new.O())
+		new.Set(0, 9)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, slice.O())
+	}
+}
+
+// GroupBy
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_GroupBy() {
+	slice := NewRefSliceV(1, 2, 3, 4, 5)
+	groups := slice.GroupBy(func(x O) O {
+		return x.(int) % 2
+	})
+	fmt.Println(groups.Get(1).O(), groups.Get(0).O())
+	// Output: [1 3 5] [2 4]
+}
+
+func TestRefSlice_GroupBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, 0, slice.GroupBy(func(x O) O { return x }).Len())
+		assert.Equal(t, 0, NewRefSliceV().GroupBy(func(x O) O { return x }).Len())
+	}
+
+	// groups keep first seen order
+	{
+		groups := NewRefSliceV(5, 4, 3, 2, 1).GroupBy(func(x O) O {
+			return x.(int) % 2
+		})
+		assert.Equal(t, []string{"1", "0"}, groups.Keys().O())
+		assert.Equal(t, []int{4, 2}, groups.Get(0).O().(ISlice).O())
+		assert.Equal(t, []int{5, 3, 1}, groups.Get(1).O().(ISlice).O())
+	}
+
+	// single group
+	{
+		groups := NewRefSliceV(1, 2, 3, 4, 5).GroupBy(func(x O) O {
+			return "all"
+		})
+		assert.Equal(t, 1, groups.Len())
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, groups.Get("all").O().(ISlice).O())
+	}
+}
+
 // RefSlicej
 //--------------------------------------------------------------------------------------------------
 func ExampleRefSlice_RefSlice() {
@@ -2945,6 +3268,103 @@ func TestRefSlice_Insert_wrongType(t *testing.T) {
 	slice.Insert(0, "2")
 }
 
+// Intersect
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Intersect() {
+	slice := NewRefSliceV(Integer{1}, Integer{2}, Integer{3}, Integer{2})
+	fmt.Println(slice.Intersect([]Integer{{2}, {4}}).O())
+	// Output: [{2}]
+}
+
+func TestRefSlice_Intersect(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, []interface{}{}, slice.Intersect([]Integer{{1}, {2}}).O())
+		assert.Equal(t, []Integer{}, NewRefSliceV(Integer{1}, Integer{2}).Intersect([]Integer{}).O())
+	}
+
+	// uniq elements in other while preserving order
+	{
+		slice := NewRefSliceV(Integer{3}, Integer{1}, Integer{2}, Integer{1}, Integer{4})
+		assert.Equal(t, []Integer{{1}, {4}}, slice.Intersect([]Integer{{4}, {1}, {5}}).O())
+		assert.Equal(t, []Integer{{3}, {1}, {2}, {1}, {4}}, slice.O())
+	}
+
+	// other is a Slice
+	{
+		assert.Equal(t, []Integer{{2}, {3}}, NewRefSliceV(Integer{1}, Integer{2}, Integer{3}).Intersect(NewRefSliceV(Integer{3}, Integer{2})).O())
+	}
+}
+
+// Iter
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Iter() {
+	it := NewRefSliceV(1, 2, 3).Iter()
+	for it.Next() {
+		fmt.Println(it.Index(), it.Value())
+	}
+	// Output:
+	// 0 1
+	// 1 2
+	// 2 3
+}
+
+func TestRefSlice_Iter(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.False(t, slice.Iter().Next())
+		assert.False(t, NewRefSliceV().Iter().Next())
+	}
+
+	// all elements in order
+	{
+		it := NewRefSliceV(1, 2, 3).Iter()
+		assert.Equal(t, -1, it.Index())
+		assert.Nil(t, it.Value())
+		for i, x := range []interface{}{1, 2, 3} {
+			assert.True(t, it.Next())
+			assert.Equal(t, i, it.Index())
+			assert.Equal(t, x, it.Value())
+		}
+		assert.False(t, it.Next())
+		assert.False(t, it.Next())
+		assert.Nil(t, it.Value())
+		assert.Nil(t, it.Err())
+	}
+}
+
+// IsSubset
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_IsSubset() {
+	slice := NewRefSliceV(Integer{1}, Integer{2}, Integer{3}, Integer{2})
+	fmt.Println(slice.IsSubset([]Integer{{2}, {4}}))
+	// Output: false
+}
+
+func TestRefSlice_IsSubset(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.True(t, slice.IsSubset([]Integer{{1}, {2}}))
+		assert.False(t, NewRefSliceV(Integer{1}).IsSubset([]Integer{}))
+	}
+
+	// all elements in other
+	{
+		assert.True(t, NewRefSliceV(Integer{1}, Integer{2}, Integer{2}).IsSubset([]Integer{{3}, {2}, {1}}))
+	}
+
+	// not all elements in other
+	{
+		assert.False(t, NewRefSliceV(Integer{1}, Integer{4}).IsSubset(NewRefSliceV(Integer{1}, Integer{2})))
+	}
+}
+
 // Join
 //--------------------------------------------------------------------------------------------------
 func BenchmarkRefSlice_Join_Go(t *testing.B) {
@@ -3192,6 +3612,117 @@ func TestRefSlice_Less(t *testing.T) {
 	// }
 }
 
+// MapP
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_MapP() {
+	slice, _ := NewRefSliceV(1, 2, 3).MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+		return x.(int) * 2, nil
+	})
+	fmt.Println(slice)
+	// Output: [2 4 6]
+}
+
+func TestRefSlice_MapP(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		new, err := slice.MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+			return x, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// Order is preserved
+	{
+		slice, err := NewRefSliceV(1, 2, 3).MapP(context.Background(), 0, func(ctx context.Context, x O) (O, error) {
+			return x.(int) * 2, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []int{2, 4, 6}, slice.O())
+	}
+
+	// First error is returned
+	{
+		slice, err := NewRefSliceV(1, 2, 3).MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+			if x.(int) == 2 {
+				return nil, errors.New("failed")
+			}
+			return x, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+		assert.Equal(t, 0, slice.Len())
+	}
+
+	// Cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		slice, err := NewRefSliceV(1, 2, 3).MapP(ctx, 2, func(ctx context.Context, x O) (O, error) {
+			return x, nil
+		})
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, 0, slice.Len())
+	}
+}
+
+// MaxBy
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_MaxBy() {
+	slice := NewRefSlice([]time.Duration{3 * time.Second, time.Second, 3 * time.Second, time.Second})
+	fmt.Println(slice.MaxBy(func(x O) float64 {
+		return x.(time.Duration).Seconds()
+	}))
+	// Output: 3s
+}
+
+func TestRefSlice_MaxBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.True(t, slice.MaxBy(func(x O) float64 { return 0 }).Nil())
+		assert.True(t, NewRefSliceV().MaxBy(func(x O) float64 { return 0 }).Nil())
+	}
+
+	// max by key
+	{
+		elem := NewRefSliceV(Integer{2}, Integer{5}, Integer{1}).MaxBy(func(x O) float64 {
+			return float64(x.(Integer).Value)
+		})
+		assert.Equal(t, Integer{5}, elem.O())
+	}
+}
+
+// MinBy
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_MinBy() {
+	slice := NewRefSlice([]time.Duration{3 * time.Second, time.Second, 3 * time.Second, time.Second})
+	fmt.Println(slice.MinBy(func(x O) float64 {
+		return x.(time.Duration).Seconds()
+	}))
+	// Output: 1s
+}
+
+func TestRefSlice_MinBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.True(t, slice.MinBy(func(x O) float64 { return 0 }).Nil())
+		assert.True(t, NewRefSliceV().MinBy(func(x O) float64 { return 0 }).Nil())
+	}
+
+	// min by key
+	{
+		elem := NewRefSliceV(Integer{2}, Integer{5}, Integer{1}).MinBy(func(x O) float64 {
+			return float64(x.(Integer).Value)
+		})
+		assert.Equal(t, Integer{1}, elem.O())
+	}
+}
+
 // Nil
 //--------------------------------------------------------------------------------------------------
 func TestRefSlice_Nil(t *testing.T) {
@@ -3276,6 +3807,49 @@ func TestRefSlice_Pair(t *testing.T) {
 	}
 }
 
+// Partition
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Partition() {
+	slice := NewRefSliceV(1, 2, 3, 4, 5)
+	match, rest := slice.Partition(func(x O) bool {
+		return x.(int)%2 == 1
+	})
+	fmt.Println(match, rest)
+	// Output: [1 3 5] [2 4]
+}
+
+func TestRefSlice_Partition(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		match, rest := slice.Partition(func(x O) bool { return true })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+		match, rest = NewRefSliceV().Partition(func(x O) bool { return true })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+	}
+
+	// split in order
+	{
+		match, rest := NewRefSliceV(1, 2, 3, 4, 5).Partition(func(x O) bool {
+			return x.(int)%2 == 1
+		})
+		assert.Equal(t, []int{1, 3, 5}, match.O())
+		assert.Equal(t, []int{2, 4}, rest.O())
+	}
+
+	// all match
+	{
+		match, rest := NewRefSliceV(1, 2, 3, 4, 5).Partition(func(x O) bool {
+			return true
+		})
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, match.O())
+		assert.Equal(t, 0, rest.Len())
+	}
+}
+
 // Pop
 //--------------------------------------------------------------------------------------------------
 func BenchmarkRefSlice_Pop_Go(t *testing.B) {
@@ -3639,6 +4213,61 @@ func TestRefSlice_Select(t *testing.T) {
 	}
 }
 
+// SelectP
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_SelectP() {
+	slice, _ := NewRefSliceV(1, 2, 3).SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+		return x.(int) != 2, nil
+	})
+	fmt.Println(slice)
+	// Output: [1 3]
+}
+
+func TestRefSlice_SelectP(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		new, err := slice.SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+			return true, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// Order is preserved
+	{
+		slice, err := NewRefSliceV(1, 2, 3).SelectP(context.Background(), 0, func(ctx context.Context, x O) (bool, error) {
+			return x.(int) != 2, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []int{1, 3}, slice.O())
+	}
+
+	// First error is returned
+	{
+		slice, err := NewRefSliceV(1, 2, 3).SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+			if x.(int) == 2 {
+				return false, errors.New("failed")
+			}
+			return true, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+		assert.Equal(t, 0, slice.Len())
+	}
+
+	// Cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		slice, err := NewRefSliceV(1, 2, 3).SelectP(ctx, 2, func(ctx context.Context, x O) (bool, error) {
+			return true, nil
+		})
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, 0, slice.Len())
+	}
+}
+
 // Set
 //--------------------------------------------------------------------------------------------------
 func BenchmarkRefSlice_Set_Go(t *testing.B) {
@@ -4207,6 +4836,167 @@ func TestRefSlice_Sort(t *testing.T) {
 	}
 }
 
+// SortBy
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_SortBy() {
+	slice := NewRefSliceV(1, 3, 2)
+	fmt.Println(slice.SortBy(func(a, b O) bool {
+		return a.(int) > b.(int)
+	}))
+	// Output: [3 2 1]
+}
+
+func TestRefSlice_SortBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, NewRefSliceV(), slice.SortBy(func(a, b O) bool { return false }))
+		assert.Equal(t, NewRefSliceV(), NewRefSliceV().SortBy(func(a, b O) bool { return false }))
+	}
+
+	// descending order in a new slice
+	{
+		slice := NewRefSliceV(1, 3, 2, 5, 4)
+		new := slice.SortBy(func(a, b O) bool {
+			return a.(int) > b.(int)
+		})
+		assert.Equal(t, []int{5, 4, 3, 2, 1}, new.O())
+		assert.Equal(t, []int{1, 3, 2, 5, 4}, slice.O())
+	}
+}
+
+// SortByKeys
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_SortByKeys() {
+	type person struct {
+		Name string
+		Age  int
+	}
+	slice := NewRefSlice([]person{{"bob", 30}, {"alice", 25}, {"bob", 40}})
+	fmt.Println(slice.SortByKeys("Name", "-Age").O())
+	// Output: [{alice 25} {bob 40} {bob 30}]
+}
+
+func TestRefSlice_SortByKeys(t *testing.T) {
+	type person struct {
+		Name string
+		Age  int
+		Born *time.Time
+		note string
+	}
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, NewRefSliceV(), slice.SortByKeys("Name"))
+	}
+
+	// multiple keys with descending
+	{
+		slice := NewRefSlice([]person{{Name: "bob", Age: 30}, {Name: "alice", Age: 25}, {Name: "bob", Age: 40}})
+		new := slice.SortByKeys("Name", "-Age")
+		assert.Equal(t, []person{{Name: "alice", Age: 25}, {Name: "bob", Age: 40}, {Name: "bob", Age: 30}}, new.O())
+		assert.Equal(t, []person{{Name: "bob", Age: 30}, {Name: "alice", Age: 25}, {Name: "bob", Age: 40}}, slice.O())
+	}
+
+	// pointers, times and unexported fields
+	{
+		t1 := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		t2 := time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)
+		slice := NewRefSlice([]*person{{Name: "a", Born: &t1, note: "y"}, {Name: "b", note: "z"}, {Name: "c", Born: &t2, note: "x"}})
+		names := func(x ISlice) []string {
+			return x.Map(func(x O) O { return x.(*person).Name }).S().G()
+		}
+		assert.Equal(t, []string{"b", "c", "a"}, names(slice.SortByKeys("Born")))
+		assert.Equal(t, []string{"c", "a", "b"}, names(slice.SortByKeys("note")))
+		assert.Equal(t, []string{"a", "b", "c"}, names(slice.SortByKeys("bogus")))
+	}
+
+	// keys resolve by tag then case insensitive field name as with Decode
+	{
+		type tagged struct {
+			Name string
+			Age  int
+			City string `yaml:"town"`
+		}
+		slice := NewRefSlice([]tagged{{"bob", 30, "b"}, {"alice", 25, "c"}, {"bob", 40, "a"}})
+		assert.Equal(t, []tagged{{"alice", 25, "c"}, {"bob", 40, "a"}, {"bob", 30, "b"}}, slice.SortByKeys("name", "-age").O())
+		assert.Equal(t, []tagged{{"bob", 40, "a"}, {"bob", 30, "b"}, {"alice", 25, "c"}}, slice.SortByKeys("town").O())
+		assert.Equal(t, []tagged{{"bob", 30, "b"}, {"alice", 25, "c"}, {"bob", 40, "a"}}, slice.SortByKeys("bogus").O())
+	}
+}
+
+// SortByKeysM
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_SortByKeysM() {
+	type person struct {
+		Name string
+		Age  int
+	}
+	slice := NewRefSlice([]person{{"bob", 30}, {"alice", 25}})
+	slice.SortByKeysM("Age")
+	fmt.Println(slice.O())
+	// Output: [{alice 25} {bob 30}]
+}
+
+func TestRefSlice_SortByKeysM(t *testing.T) {
+	type person struct {
+		Name string
+		Age  int
+	}
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, (*RefSlice)(nil), slice.SortByKeysM("Name"))
+	}
+
+	// sorted in place
+	{
+		slice := NewRefSlice([]person{{"bob", 30}, {"alice", 25}, {"carl", 25}})
+		slice.SortByKeysM("-Age", "Name")
+		assert.Equal(t, []person{{"bob", 30}, {"alice", 25}, {"carl", 25}}, slice.O())
+	}
+
+	// maps in a RefSlice
+	{
+		slice := NewRefSlice([]map[string]int{{"a": 2}, {"a": 1}})
+		slice.SortByKeysM("a")
+		assert.Equal(t, []map[string]int{{"a": 1}, {"a": 2}}, slice.O())
+	}
+}
+
+// SortByM
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_SortByM() {
+	slice := NewRefSliceV(1, 3, 2)
+	slice.SortByM(func(a, b O) bool {
+		return a.(int) > b.(int)
+	})
+	fmt.Println(slice)
+	// Output: [3 2 1]
+}
+
+func TestRefSlice_SortByM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, (*RefSlice)(nil), slice.SortByM(func(a, b O) bool { return false }))
+		assert.Equal(t, NewRefSliceV(), NewRefSliceV().SortByM(func(a, b O) bool { return false }))
+	}
+
+	// descending order in place
+	{
+		slice := NewRefSliceV(1, 3, 2, 5, 4)
+		slice.SortByM(func(a, b O) bool {
+			return a.(int) > b.(int)
+		})
+		assert.Equal(t, []int{5, 4, 3, 2, 1}, slice.O())
+	}
+}
+
 // SortM
 //--------------------------------------------------------------------------------------------------
 func BenchmarkRefSlice_SortM_Go(t *testing.B) {
@@ -4327,6 +5117,94 @@ func TestRefSlice_SortReverseM(t *testing.T) {
 	}
 }
 
+// SortStable
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_SortStable() {
+	slice := NewRefSliceV(4, 1, 3, 2, 5)
+	fmt.Println(slice.SortStable(func(a, b O) bool {
+		return a.(int)%2 < b.(int)%2
+	}))
+	// Output: [4 2 1 3 5]
+}
+
+func TestRefSlice_SortStable(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, NewRefSliceV(), slice.SortStable(func(a, b O) bool { return false }))
+		assert.Equal(t, NewRefSliceV(), NewRefSliceV().SortStable(func(a, b O) bool { return false }))
+	}
+
+	// equal elements keep their order
+	{
+		slice := NewRefSliceV(5, 4, 1, 3, 2)
+		new := slice.SortStable(func(a, b O) bool {
+			return a.(int)%2 < b.(int)%2
+		})
+		assert.Equal(t, []int{4, 2, 5, 1, 3}, new.O())
+		assert.Equal(t, []int{5, 4, 1, 3, 2}, slice.O())
+	}
+}
+
+// SortStableM
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_SortStableM() {
+	slice := NewRefSliceV(4, 1, 3, 2, 5)
+	slice.SortStableM(func(a, b O) bool {
+		return a.(int)%2 < b.(int)%2
+	})
+	fmt.Println(slice)
+	// Output: [4 2 1 3 5]
+}
+
+func TestRefSlice_SortStableM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, (*RefSlice)(nil), slice.SortStableM(func(a, b O) bool { return false }))
+		assert.Equal(t, NewRefSliceV(), NewRefSliceV().SortStableM(func(a, b O) bool { return false }))
+	}
+
+	// equal elements keep their order
+	{
+		slice := NewRefSliceV(5, 4, 1, 3, 2)
+		slice.SortStableM(func(a, b O) bool {
+			return a.(int)%2 < b.(int)%2
+		})
+		assert.Equal(t, []int{4, 2, 5, 1, 3}, slice.O())
+	}
+}
+
+// SumBy
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_SumBy() {
+	slice := NewRefSlice([]time.Duration{3 * time.Second, time.Second, 3 * time.Second, time.Second})
+	fmt.Println(slice.SumBy(func(x O) float64 {
+		return x.(time.Duration).Seconds()
+	}))
+	// Output: 8
+}
+
+func TestRefSlice_SumBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, 0.0, slice.SumBy(func(x O) float64 { return 1 }))
+		assert.Equal(t, 0.0, NewRefSliceV().SumBy(func(x O) float64 { return 1 }))
+	}
+
+	// sum of keys
+	{
+		sum := NewRefSliceV(Integer{2}, Integer{5}, Integer{1}).SumBy(func(x O) float64 {
+			return float64(x.(Integer).Value)
+		})
+		assert.Equal(t, 8.0, sum)
+	}
+}
+
 // Swap
 //--------------------------------------------------------------------------------------------------
 func BenchmarkRefSlice_Swap_Go(t *testing.B) {
@@ -4415,6 +5293,36 @@ func TestRefSlice_Swap(t *testing.T) {
 	}
 }
 
+// SymmetricDifference
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_SymmetricDifference() {
+	slice := NewRefSliceV(Integer{1}, Integer{2}, Integer{3}, Integer{2})
+	fmt.Println(slice.SymmetricDifference([]Integer{{2}, {4}}).O())
+	// Output: [{1} {3} {4}]
+}
+
+func TestRefSlice_SymmetricDifference(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, []Integer{{1}, {2}}, slice.SymmetricDifference([]Integer{{1}, {2}, {2}}).O())
+		assert.Equal(t, []Integer{{1}, {2}}, NewRefSliceV(Integer{1}, Integer{2}, Integer{2}).SymmetricDifference([]Integer{}).O())
+	}
+
+	// uniq elements from both not in the other while preserving order
+	{
+		slice := NewRefSliceV(Integer{1}, Integer{2}, Integer{3}, Integer{3})
+		assert.Equal(t, []Integer{{1}, {4}}, slice.SymmetricDifference([]Integer{{4}, {3}, {2}, {4}}).O())
+		assert.Equal(t, []Integer{{1}, {2}, {3}, {3}}, slice.O())
+	}
+
+	// other is a Slice with the same elements
+	{
+		assert.Equal(t, []Integer{}, NewRefSliceV(Integer{1}, Integer{2}).SymmetricDifference(NewRefSliceV(Integer{2}, Integer{1})).O())
+	}
+}
+
 // Take
 //--------------------------------------------------------------------------------------------------
 func BenchmarkRefSlice_Take_Go(t *testing.B) {
@@ -4954,7 +5862,7 @@ func TestRefSlice_TakeW(t *testing.T) {
 // 		slice := NewRefSliceV(1, 2, 3)
 // 		union := slice.Union([]int{4, 5})
 // 		assert.Equal(t, NewRefSliceV(1, 2, 3, 4, 5), union)
-// 		assert.Equal(t, NewRefSliceV(1, 2, 3), slice)
+// 		assert.Equal(t, []int{1, 2, 3}, slice.O())
 // 	}
 // }
 
@@ -4994,7 +5902,7 @@ func TestRefSlice_TakeW(t *testing.T) {
 // 		slice := NewRefSliceV(1)
 // 		union := slice.UnionM([]int{1, 2, 3})
 // 		assert.Equal(t, NewRefSliceV(1, 2, 3), union)
-// 		assert.Equal(t, NewRefSliceV(1, 2, 3), slice)
+// 		assert.Equal(t, []int{1, 2, 3}, slice.O())
 // 	}
 
 // 	// one duplicate
@@ -5002,7 +5910,7 @@ func TestRefSlice_TakeW(t *testing.T) {
 // 		slice := NewRefSliceV(1, 1)
 // 		union := slice.UnionM(NewRefSliceV(2, 3))
 // 		assert.Equal(t, NewRefSliceV(1, 2, 3), union)
-// 		assert.Equal(t, NewRefSliceV(1, 2, 3), slice)
+// 		assert.Equal(t, []int{1, 2, 3}, slice.O())
 // 	}
 
 // 	// multiple duplicates
@@ -5010,7 +5918,7 @@ func TestRefSlice_TakeW(t *testing.T) {
 // 		slice := NewRefSliceV(1, 2, 2, 3, 3)
 // 		union := slice.UnionM([]int{1, 2, 3})
 // 		assert.Equal(t, NewRefSliceV(1, 2, 3), union)
-// 		assert.Equal(t, NewRefSliceV(1, 2, 3), slice)
+// 		assert.Equal(t, []int{1, 2, 3}, slice.O())
 // 	}
 
 // 	// no duplicates
@@ -5155,3 +6063,82 @@ func TestRefSlice_UniqM(t *testing.T) {
 		assert.Equal(t, []int{1, 2, 3, 4}, uniq.O())
 	}
 }
+
+
+// Window
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Window() {
+	slice := NewRefSliceV(1, 2, 3, 4, 5)
+	slice.Window(3, 1).Each(func(x O) {
+		fmt.Print(x)
+	})
+	// Output: [1 2 3][2 3 4][3 4 5]
+}
+
+func TestRefSlice_Window(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, 0, slice.Window(2, 1).Len())
+		assert.Equal(t, 0, NewRefSliceV().Window(2, 1).Len())
+	}
+
+	// invalid size or step
+	{
+		assert.Equal(t, 0, NewRefSliceV(1, 2, 3, 4, 5).Window(0, 1).Len())
+		assert.Equal(t, 0, NewRefSliceV(1, 2, 3, 4, 5).Window(2, 0).Len())
+		assert.Equal(t, 0, NewRefSliceV(1, 2, 3, 4, 5).Window(6, 1).Len())
+	}
+
+	// sliding by one
+	{
+		windows := NewRefSliceV(1, 2, 3, 4, 5).Window(3, 1)
+		assert.Equal(t, 3, windows.Len())
+		assert.Equal(t, []int{1, 2, 3}, windows.At(0).O().(ISlice).O())
+		assert.Equal(t, []int{2, 3, 4}, windows.At(1).O().(ISlice).O())
+		assert.Equal(t, []int{3, 4, 5}, windows.At(2).O().(ISlice).O())
+	}
+
+	// partial windows are dropped
+	{
+		windows := NewRefSliceV(1, 2, 3, 4, 5).Window(2, 2)
+		assert.Equal(t, 2, windows.Len())
+		assert.Equal(t, []int{1, 2}, windows.At(0).O().(ISlice).O())
+		assert.Equal(t, []int{3, 4}, windows.At(1).O().(ISlice).O())
+	}
+}
+
+// Zip
+//--------------------------------------------------------------------------------------------------
+func ExampleRefSlice_Zip() {
+	slice := NewRefSliceV(1, 2, 3)
+	fmt.Println(slice.Zip([]string{"a", "b", "c"}))
+	// Output: [[1 a] [2 b] [3 c]]
+}
+
+func TestRefSlice_Zip(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *RefSlice
+		assert.Equal(t, 0, slice.Zip([]string{"a"}).Len())
+		assert.Equal(t, 0, NewRefSliceV().Zip([]string{"a"}).Len())
+		assert.Equal(t, 0, NewRefSliceV(1, 2, 3).Zip(nil).Len())
+	}
+
+	// shorter of the two
+	{
+		pairs := NewRefSliceV(1, 2, 3, 4, 5).Zip([]string{"a", "b"})
+		assert.Equal(t, 2, pairs.Len())
+		assert.Equal(t, []interface{}{1, "a"}, pairs.At(0).O().(ISlice).O())
+		assert.Equal(t, []interface{}{2, "b"}, pairs.At(1).O().(ISlice).O())
+	}
+
+	// Slice given
+	{
+		pairs := NewRefSliceV(1, 2, 3).Zip(NewIntSliceV(7, 8, 9, 10))
+		assert.Equal(t, 3, pairs.Len())
+		assert.Equal(t, []interface{}{3, 9}, pairs.At(2).O().(ISlice).O())
+	}
+}
@@ -1,9 +1,11 @@
 package n
 
 import (
+	"context"
 	"sort"
 	"strings"
 
+	"github.com/phR0ze/n/pkg/sys"
 	"github.com/pkg/errors"
 )
 
@@ -156,6 +158,36 @@ func (p *StringSlice) At(i int) (elem *Object) {
 	return
 }
 
+// BinarySearch searches this already sorted Slice for the given element returning its index and
+// true if found or the index it would be inserted at and false if not.
+func (p *StringSlice) BinarySearch(elem interface{}) (i int, found bool) {
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	x := ToString(elem)
+	i = sort.SearchStrings(*p, x)
+	found = i < len(*p) && (*p)[i] == x
+	return
+}
+
+// Chunk creates a new slice of Slices each holding n elements copied from this Slice in order.
+// The last chunk will hold the remaining elements if the length isn't evenly divisible by n.
+// An empty slice is returned if n is less than 1.
+func (p *StringSlice) Chunk(n int) (chunks ISlice) {
+	x := []interface{}{}
+	if p == nil || len(*p) == 0 || n < 1 {
+		return NewInterSliceV(x...)
+	}
+	for i := 0; i < len(*p); i += n {
+		j := i + n
+		if j > len(*p) {
+			j = len(*p)
+		}
+		x = append(x, p.Copy(i, j-1))
+	}
+	return NewInterSliceV(x...)
+}
+
 // Clear modifies this Slice to clear out all elements and returns a reference to this Slice.
 func (p *StringSlice) Clear() ISlice {
 	if p == nil {
@@ -229,6 +261,22 @@ func (p *StringSlice) CountW(sel func(O) bool) (cnt int) {
 	return
 }
 
+// Difference returns a new Slice with the uniq elements of this Slice that are not in the given Slice
+// while preserving order.
+// Supports StringSlice, *StringSlice, []string or *[]string
+func (p *StringSlice) Difference(slice interface{}) (new ISlice) {
+	other := ToStringSlice(slice)
+	set, uniq := NewSet(other), NewSet(p)
+	return p.Select(func(x O) bool { return !set.Contains(x) && uniq.Remove(x) })
+}
+
+// Disjoint checks if this Slice has no elements in common with the given Slice.
+// Supports StringSlice, *StringSlice, []string or *[]string
+func (p *StringSlice) Disjoint(slice interface{}) bool {
+	other := ToStringSlice(slice)
+	return NewSet(p).Disjoint(other)
+}
+
 // Drop modifies this Slice to delete the indicated range of elements and returns a referece to this Slice;
 // Expects nothing, in which case everything is dropped, or two indices i and j, in which case positive and
 // negative notation is supported and uses an inclusive behavior such that DropAt(0, -1) includes index -1
@@ -357,6 +405,21 @@ func (p *StringSlice) EachIE(action func(int, O) error) (ISlice, error) {
 	return p, err
 }
 
+// EachP calls the given lambda once for each element in this Slice, passing in the context and
+// element as parameters, fanning the calls out to the given number of workers or runtime.NumCPU()
+// workers if less than 1. The first error from the lambda or the given context cancels
+// the context passed to the lambda and skips the remaining elements. Returns a reference to this
+// Slice and the first error.
+func (p *StringSlice) EachP(ctx context.Context, workers int, action func(context.Context, O) error) (ISlice, error) {
+	if p == nil {
+		return p, nil
+	}
+	err := eachP(ctx, len(*p), workers, func(ctx context.Context, i int) error {
+		return action(ctx, (*p)[i])
+	})
+	return p, err
+}
+
 // EachR calls the given lambda once for each element in this Slice in reverse, passing in that element
 // as a parameter; Returns a reference to this Slice
 func (p *StringSlice) EachR(action func(O)) ISlice {
@@ -434,11 +497,84 @@ func (p *StringSlice) FirstN(n int) ISlice {
 	return p.Slice(0, abs(n)-1)
 }
 
+// FlatMap creates a new slice with the modified elements from the lambda expanding any slice
+// results one level into the new slice.
+func (p *StringSlice) FlatMap(mod func(O) O) ISlice {
+	if p == nil || len(*p) == 0 {
+		return NewStringSliceV()
+	}
+	x := []interface{}{}
+	for i := range *p {
+		x = flatAppend(x, mod((*p)[i]))
+	}
+	return newSliceOf(x, NewStringSliceV())
+}
+
+// Flatten returns a new Slice with any nested slice elements expanded one level into it.
+// The elements of this Slice can't be slices so it is the same as Copy.
+func (p *StringSlice) Flatten() (new ISlice) {
+	return p.Copy()
+}
+
+// FuzzyFind returns the elements of this Slice matching the given query as scored by
+// Str.FuzzyScore ranked from best to worst match. Equal scores are ranked by length then by
+// their order in this Slice. A limit less than 1 returns all matches.
+func (p *StringSlice) FuzzyFind(query interface{}, limit int) (new *StringSlice) {
+	new = NewStringSliceV()
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	q := *ToStr(query)
+
+	type match struct {
+		val   string
+		score int
+	}
+	matches := []match{}
+	for _, x := range *p {
+		if score, ok := fuzzyScore([]rune(x), q); ok {
+			matches = append(matches, match{x, score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		return len(matches[i].val) < len(matches[j].val)
+	})
+	for i := range matches {
+		if limit > 0 && i >= limit {
+			break
+		}
+		*new = append(*new, matches[i].val)
+	}
+	return
+}
+
 // G returns the underlying data structure as a builtin Go type
 func (p *StringSlice) G() []string {
 	return p.O().([]string)
 }
 
+// GroupBy creates a new map of Slices with the elements of this Slice grouped by the key the
+// lambda returns for them. Keys are converted to strings and kept in the order they are
+// first seen while the elements keep their order in each group.
+func (p *StringSlice) GroupBy(key func(O) O) (groups IMap) {
+	m := NewOrderedMapV()
+	if p == nil || len(*p) == 0 {
+		return m
+	}
+	for i := range *p {
+		k := ToString(key((*p)[i]))
+		if group, ok := m.m[k]; ok {
+			*group.(*StringSlice) = append(*group.(*StringSlice), (*p)[i])
+		} else {
+			m.Set(k, &StringSlice{(*p)[i]})
+		}
+	}
+	return m
+}
+
 // InterSlice returns true if the underlying implementation is a RefSlice
 func (p *StringSlice) InterSlice() bool {
 	return false
@@ -493,6 +629,34 @@ func (p *StringSlice) Insert(i int, obj interface{}) ISlice {
 	return p
 }
 
+// Intersect returns a new Slice with the uniq elements of this Slice that are also in the given Slice
+// while preserving order.
+// Supports StringSlice, *StringSlice, []string or *[]string
+func (p *StringSlice) Intersect(slice interface{}) (new ISlice) {
+	other := ToStringSlice(slice)
+	set, uniq := NewSet(other), NewSet(p)
+	return p.Select(func(x O) bool { return set.Contains(x) && uniq.Remove(x) })
+}
+
+// Iter returns an Iterator over the elements of this Slice in order.
+func (p *StringSlice) Iter() (it Iterator) {
+	i := 0
+	return NewIterator(func() (O, bool) {
+		if p == nil || i >= len(*p) {
+			return nil, false
+		}
+		i++
+		return (*p)[i-1], true
+	})
+}
+
+// IsSubset checks if all elements of this Slice are in the given Slice.
+// Supports StringSlice, *StringSlice, []string or *[]string
+func (p *StringSlice) IsSubset(slice interface{}) bool {
+	other := ToStringSlice(slice)
+	return NewSet(p).IsSubset(other)
+}
+
 // Join converts each element into a string then joins them together using the given separator or comma by default.
 func (p *StringSlice) Join(separator ...string) (str *Object) {
 	if p == nil || len(*p) == 0 {
@@ -563,6 +727,30 @@ func (p *StringSlice) Map(mod func(O) O) ISlice {
 	return slice
 }
 
+// MapP creates a new slice with the modified elements from the lambda, fanning the calls out to
+// the given number of workers or runtime.NumCPU() workers if less than 1 while preserving the
+// element order. The first error from the lambda or the given context cancels the context passed
+// to the lambda and skips the remaining elements. Returns an empty Slice and the first error on
+// failure.
+func (p *StringSlice) MapP(ctx context.Context, workers int, mod func(context.Context, O) (O, error)) (ISlice, error) {
+	if p == nil || len(*p) == 0 {
+		return NewStringSliceV(), nil
+	}
+	results := make([]interface{}, len(*p))
+	err := eachP(ctx, len(results), workers, func(ctx context.Context, i int) (err error) {
+		results[i], err = mod(ctx, (*p)[i])
+		return
+	})
+	if err != nil {
+		return NewStringSliceV(), err
+	}
+	slice := Slice(results[0])
+	for i := 1; i < len(results); i++ {
+		slice.Append(results[i])
+	}
+	return slice, nil
+}
+
 // Nil tests if this Slice is nil
 func (p *StringSlice) Nil() bool {
 	if p == nil {
@@ -594,6 +782,23 @@ func (p *StringSlice) Pair() (first, second *Object) {
 	return
 }
 
+// Partition creates two new slices the first with the elements that match the lambda selector
+// and the second with the elements that don't while preserving element order.
+func (p *StringSlice) Partition(sel func(O) bool) (match, rest ISlice) {
+	x, y := NewStringSliceV(), NewStringSliceV()
+	if p == nil || len(*p) == 0 {
+		return x, y
+	}
+	for i := range *p {
+		if sel((*p)[i]) {
+			*x = append(*x, (*p)[i])
+		} else {
+			*y = append(*y, (*p)[i])
+		}
+	}
+	return x, y
+}
+
 // Pop modifies this Slice to remove the last element and returns the removed element as an Object.
 func (p *StringSlice) Pop() (elem *Object) {
 	elem = p.Last()
@@ -659,6 +864,31 @@ func (p *StringSlice) Select(sel func(O) bool) (new ISlice) {
 	return slice
 }
 
+// SelectP creates a new slice with the elements that match the lambda selector, fanning the calls
+// out to the given number of workers or runtime.NumCPU() workers if less than 1 while preserving
+// the element order. The first error from the lambda or the given context cancels the
+// context passed to the lambda and skips the remaining elements. Returns an empty Slice and the
+// first error on failure.
+func (p *StringSlice) SelectP(ctx context.Context, workers int, sel func(context.Context, O) (bool, error)) (new ISlice, err error) {
+	slice := NewStringSliceV()
+	if p == nil || len(*p) == 0 {
+		return slice, nil
+	}
+	hits := make([]bool, len(*p))
+	if err = eachP(ctx, len(hits), workers, func(ctx context.Context, i int) (err error) {
+		hits[i], err = sel(ctx, (*p)[i])
+		return
+	}); err != nil {
+		return slice, err
+	}
+	for i := range hits {
+		if hits[i] {
+			*slice = append(*slice, (*p)[i])
+		}
+	}
+	return slice, nil
+}
+
 // Set the element(s) at the given index location to the given element(s); Allows for negative notation.
 // Returns a reference to this Slice and swallows any errors.
 func (p *StringSlice) Set(i int, elem interface{}) ISlice {
@@ -689,6 +919,15 @@ func (p *StringSlice) SetE(i int, elems interface{}) (ISlice, error) {
 	return p, err
 }
 
+// ShellJoin quotes each element of this Slice as needed and joins them with spaces into a single
+// command line that Str.Shlex will split back into the same elements. See sys.ShellJoin.
+func (p *StringSlice) ShellJoin() (str *Str) {
+	if p == nil {
+		return NewStrV()
+	}
+	return A(sys.ShellJoin(*p...))
+}
+
 // Shift modifies this Slice to remove the first element and returns the removed element as an Object.
 func (p *StringSlice) Shift() (elem *Object) {
 	elem = p.First()
@@ -742,6 +981,26 @@ func (p *StringSlice) Sort() (new ISlice) {
 	return p.Copy().SortM()
 }
 
+// SortBy returns a new Slice with the elements sorted using the given less lambda.
+func (p *StringSlice) SortBy(less func(a, b O) bool) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortByM(less)
+}
+
+// SortByM modifies this Slice sorting the elements using the given less lambda and returns a
+// reference to this Slice.
+func (p *StringSlice) SortByM(less func(a, b O) bool) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sort.Slice(*p, func(i, j int) bool {
+		return less((*p)[i], (*p)[j])
+	})
+	return p
+}
+
 // SortM modifies this Slice sorting the elements and returns a reference to this Slice.
 func (p *StringSlice) SortM() ISlice {
 	if p == nil || len(*p) < 2 {
@@ -768,6 +1027,27 @@ func (p *StringSlice) SortReverseM() ISlice {
 	return p
 }
 
+// SortStable returns a new Slice with the elements sorted using the given less lambda while
+// keeping equal elements in their original order.
+func (p *StringSlice) SortStable(less func(a, b O) bool) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortStableM(less)
+}
+
+// SortStableM modifies this Slice sorting the elements using the given less lambda while keeping
+// equal elements in their original order and returns a reference to this Slice.
+func (p *StringSlice) SortStableM(less func(a, b O) bool) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sort.SliceStable(*p, func(i, j int) bool {
+		return less((*p)[i], (*p)[j])
+	})
+	return p
+}
+
 // String returns a string representation of this Slice, implements the Stringer interface
 func (p *StringSlice) String() string {
 	var builder strings.Builder
@@ -784,6 +1064,36 @@ func (p *StringSlice) String() string {
 	return builder.String()
 }
 
+// Suggest returns the elements of this Slice that are similar to the given word for "did you mean"
+// style messages e.g. the closest valid subcommands for a mistyped one. Elements are similar when
+// their case insensitive Damerau-Levenshtein distance is at most a third of the word's length,
+// rounded up, or they start with the word. Suggestions are ordered from closest to furthest.
+func (p *StringSlice) Suggest(word interface{}) (new *StringSlice) {
+	new = NewStringSliceV()
+	w := []rune(strings.ToLower(ToString(word)))
+	if p == nil || len(*p) == 0 || len(w) == 0 {
+		return
+	}
+
+	type suggestion struct {
+		val  string
+		dist int
+	}
+	max := (len(w) + 2) / 3
+	suggestions := []suggestion{}
+	for _, x := range *p {
+		lower := strings.ToLower(x)
+		if dist := damerau(w, []rune(lower)); dist <= max || strings.HasPrefix(lower, string(w)) {
+			suggestions = append(suggestions, suggestion{x, dist})
+		}
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool { return suggestions[i].dist < suggestions[j].dist })
+	for i := range suggestions {
+		*new = append(*new, suggestions[i].val)
+	}
+	return
+}
+
 // Swap modifies this Slice swapping the indicated elements.
 func (p *StringSlice) Swap(i, j int) {
 	if p == nil || len(*p) < 2 || i < 0 || j < 0 || i >= len(*p) || j >= len(*p) {
@@ -792,6 +1102,15 @@ func (p *StringSlice) Swap(i, j int) {
 	(*p)[i], (*p)[j] = (*p)[j], (*p)[i]
 }
 
+// SymmetricDifference returns a new Slice with the uniq elements of this Slice that are not in the
+// given Slice followed by the uniq elements of the given Slice that are not in this Slice while
+// preserving order.
+// Supports StringSlice, *StringSlice, []string or *[]string
+func (p *StringSlice) SymmetricDifference(slice interface{}) (new ISlice) {
+	other := ToStringSlice(slice)
+	return p.Difference(other).ConcatM(other.Difference(p))
+}
+
 // Take modifies this Slice removing the indicated range of elements from this Slice and returning them as a new Slice;
 // Expects nothing, in which case everything is taken, or two indices i and j, in which case positive and negative
 // notation is supported and uses an inclusive behavior such that Take(0, -1) includes index -1 as opposed to Go's
@@ -898,3 +1217,34 @@ func (p *StringSlice) UniqM() ISlice {
 	}
 	return p
 }
+
+// Window creates a new slice of Slices each holding n consecutive elements copied from this Slice
+// starting at every step elements i.e. a sliding window. Only full windows are included and an
+// empty slice is returned if n or step are less than 1.
+func (p *StringSlice) Window(n, step int) (windows ISlice) {
+	x := []interface{}{}
+	if p == nil || len(*p) == 0 || n < 1 || step < 1 {
+		return NewInterSliceV(x...)
+	}
+	for i := 0; i+n <= len(*p); i += step {
+		x = append(x, p.Copy(i, i+n-1))
+	}
+	return NewInterSliceV(x...)
+}
+
+// Zip creates a new slice of pairs, as InterSlices, combining each element of this Slice with
+// the element at the same index in the given Slice. The result is as long as the shorter Slice.
+func (p *StringSlice) Zip(slice interface{}) (new ISlice) {
+	x := []interface{}{}
+	other, ok := slice.(ISlice)
+	if !ok {
+		other = Slice(slice)
+	}
+	if p == nil || len(*p) == 0 || other.Len() == 0 {
+		return NewInterSliceV(x...)
+	}
+	for i := 0; i < len(*p) && i < other.Len(); i++ {
+		x = append(x, NewInterSliceV((*p)[i], other.At(i).O()))
+	}
+	return NewInterSliceV(x...)
+}
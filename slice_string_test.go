@@ -1,11 +1,14 @@
 package n
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
+	"sync/atomic"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -652,6 +655,96 @@ func TestStringSlice_At(t *testing.T) {
 	}
 }
 
+// BinarySearch
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_BinarySearch() {
+	slice := NewStringSliceV("1", "3", "5")
+	fmt.Println(slice.BinarySearch("3"))
+	// Output: 1 true
+}
+
+func TestStringSlice_BinarySearch(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		i, found := slice.BinarySearch("1")
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// found
+	{
+		slice := NewStringSliceV("1", "3", "5", "7")
+		for i, x := range []interface{}{"1", "3", "5", "7"} {
+			j, found := slice.BinarySearch(x)
+			assert.Equal(t, i, j)
+			assert.True(t, found)
+		}
+	}
+
+	// not found gives the insert location
+	{
+		slice := NewStringSliceV("1", "3", "5", "7")
+		i, found := slice.BinarySearch("0")
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+		i, found = slice.BinarySearch("4")
+		assert.Equal(t, 2, i)
+		assert.False(t, found)
+		i, found = slice.BinarySearch("8")
+		assert.Equal(t, 4, i)
+		assert.False(t, found)
+	}
+}
+
+// Chunk
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Chunk() {
+	slice := NewStringSliceV("1", "2", "3", "4", "5")
+	slice.Chunk(2).Each(func(x O) {
+		fmt.Print(x)
+	})
+	// Output: [1 2][3 4][5]
+}
+
+func TestStringSlice_Chunk(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, 0, slice.Chunk(2).Len())
+		assert.Equal(t, 0, NewStringSliceV().Chunk(2).Len())
+	}
+
+	// invalid size
+	{
+		assert.Equal(t, 0, NewStringSliceV("1", "2", "3", "4", "5").Chunk(0).Len())
+		assert.Equal(t, 0, NewStringSliceV("1", "2", "3", "4", "5").Chunk(-1).Len())
+	}
+
+	// evenly divisible
+	{
+		chunks := NewStringSliceV("1", "2", "3", "4", "5").Chunk(5)
+		assert.Equal(t, 1, chunks.Len())
+		assert.Equal(t, []string{"1", "2", "3", "4", "5"}, chunks.At(0).O().(ISlice).O())
+	}
+
+	// remainder in the last chunk
+	{
+		slice := NewStringSliceV("1", "2", "3", "4", "5")
+		chunks := slice.Chunk(2)
+		assert.Equal(t, 3, chunks.Len())
+		assert.Equal(t, []string{"1", "2"}, chunks.At(0).O().(ISlice).O())
+		assert.Equal(t, []string{"3", "4"}, chunks.At(1).O().(ISlice).O())
+		assert.Equal(t, []string{"5"}, chunks.At(2).O().(ISlice).O())
+
+		// chunks are copies
+		chunks.At(0).O().(ISlice).Set(0, "9")
+		assert.Equal(t, []string{"1", "2", "3", "4", "5"}, slice.O())
+	}
+}
+
 // Clear
 //--------------------------------------------------------------------------------------------------
 func ExampleStringSlice_Clear() {
@@ -1056,6 +1149,64 @@ func TestStringSlice_CountW(t *testing.T) {
 	assert.Equal(t, 1, NewStringSliceV("1", "2", "3").CountW(func(x O) bool { return ExB(x.(string) == "4" || x.(string) == "3") }))
 }
 
+// Difference
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Difference() {
+	slice := NewStringSliceV("1", "2", "3", "2")
+	fmt.Println(slice.Difference([]string{"2", "4"}).O())
+	// Output: [1 3]
+}
+
+func TestStringSlice_Difference(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, []string{}, slice.Difference([]string{"1", "2"}).O())
+		assert.Equal(t, []string{"1", "2", "3"}, NewStringSliceV("1", "2", "2", "3").Difference([]string{}).O())
+	}
+
+	// uniq elements not in other while preserving order
+	{
+		slice := NewStringSliceV("3", "1", "2", "1", "4")
+		assert.Equal(t, []string{"3", "1"}, slice.Difference([]string{"2", "4", "5"}).O())
+		assert.Equal(t, []string{"3", "1", "2", "1", "4"}, slice.O())
+	}
+
+	// other is a Slice
+	{
+		assert.Equal(t, []string{"1", "3"}, NewStringSliceV("1", "2", "3").Difference(NewStringSliceV("2")).O())
+	}
+}
+
+// Disjoint
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Disjoint() {
+	slice := NewStringSliceV("1", "2", "3", "2")
+	fmt.Println(slice.Disjoint([]string{"2", "4"}))
+	// Output: false
+}
+
+func TestStringSlice_Disjoint(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.True(t, slice.Disjoint([]string{"1", "2"}))
+		assert.True(t, NewStringSliceV("1").Disjoint([]string{}))
+	}
+
+	// no elements in common
+	{
+		assert.True(t, NewStringSliceV("1", "2").Disjoint([]string{"3", "4"}))
+	}
+
+	// one element in common
+	{
+		assert.False(t, NewStringSliceV("1", "2").Disjoint(NewStringSliceV("2", "3")))
+	}
+}
+
 // Drop
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStringSlice_Drop_Go(t *testing.B) {
@@ -1626,6 +1777,63 @@ func TestStringSlice_EachIE(t *testing.T) {
 	}
 }
 
+// EachP
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_EachP() {
+	var sum int32
+	NewStringSliceV("1", "2", "3").EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+		atomic.AddInt32(&sum, int32(ToInt(x)))
+		return nil
+	})
+	fmt.Println(sum)
+	// Output: 6
+}
+
+func TestStringSlice_EachP(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		_, err := slice.EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+			return nil
+		})
+		assert.Nil(t, err)
+	}
+
+	// Loop through
+	{
+		results := make([]int32, 3)
+		slice, err := NewStringSliceV("1", "2", "3").EachP(context.Background(), 0, func(ctx context.Context, x O) error {
+			atomic.AddInt32(&results[ToInt(x)-1], 1)
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []int32{1, 1, 1}, results)
+		assert.Equal(t, NewStringSliceV("1", "2", "3"), slice)
+	}
+
+	// First error is returned
+	{
+		_, err := NewStringSliceV("1", "2", "3").EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+			if ToInt(x) == 2 {
+				return errors.New("failed")
+			}
+			return nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+
+	// Cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := NewStringSliceV("1", "2", "3").EachP(ctx, 2, func(ctx context.Context, x O) error {
+			return nil
+		})
+		assert.Equal(t, context.Canceled, err)
+	}
+}
+
 // EachR
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStringSlice_EachR_Go(t *testing.B) {
@@ -1935,6 +2143,116 @@ func TestStringSlice_FirstN(t *testing.T) {
 	assert.Equal(t, NewStringSliceV("1", "2"), NewStringSliceV("1", "2", "3").FirstN(2))
 }
 
+// FlatMap
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_FlatMap() {
+	slice := NewStringSliceV("1", "2", "3")
+	fmt.Println(slice.FlatMap(func(x O) O {
+		return []interface{}{x, x}
+	}))
+	// Output: [1 1 2 2 3 3]
+}
+
+func TestStringSlice_FlatMap(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, 0, slice.FlatMap(func(x O) O { return x }).Len())
+		assert.Equal(t, 0, NewStringSliceV().FlatMap(func(x O) O { return x }).Len())
+	}
+
+	// slice results are expanded
+	{
+		slice := NewStringSliceV("1", "2", "3").FlatMap(func(x O) O {
+			return []interface{}{x, x}
+		})
+		assert.Equal(t, []string{"1", "1", "2", "2", "3", "3"}, slice.O())
+	}
+
+	// Slice results are expanded
+	{
+		slice := NewStringSliceV("1", "2", "3").FlatMap(func(x O) O {
+			return NewIntSliceV(0, ToInt(x))
+		})
+		assert.Equal(t, []int{0, 1, 0, 2, 0, 3}, slice.O())
+	}
+
+	// other results are kept as is
+	{
+		slice := NewStringSliceV("1", "2", "3").FlatMap(func(x O) O {
+			return ToInt(x) * 2
+		})
+		assert.Equal(t, []int{2, 4, 6}, slice.O())
+	}
+}
+
+// Flatten
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Flatten() {
+	slice := NewStringSliceV("1", "2", "3", "4", "5")
+	fmt.Println(slice.Flatten())
+	// Output: [1 2 3 4 5]
+}
+
+func TestStringSlice_Flatten(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, 0, slice.Flatten().Len())
+		assert.Equal(t, 0, NewStringSliceV().Flatten().Len())
+	}
+
+	// already flat so it is a copy
+	{
+		slice := NewStringSliceV("1", "2", "3", "4", "5")
+		new := slice.Flatten()
+		assert.Equal(t, []string{"1", "2", "3", "4", "5"}, new.O())
+		new.Set(0, "9")
+		assert.Equal(t, []string{"1", "2", "3", "4", "5"}, slice.O())
+	}
+}
+
+// FuzzyFind
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_FuzzyFind() {
+	files := NewStringSliceV("README.md", "cmd/nubgen/main.go", "main.go", "mainline.txt")
+	fmt.Println(files.FuzzyFind("main", 2))
+	// Output: [main.go mainline.txt]
+}
+
+func TestStringSlice_FuzzyFind(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, NewStringSliceV(), slice.FuzzyFind("a", 0))
+		assert.Equal(t, NewStringSliceV(), NewStringSliceV().FuzzyFind("a", 0))
+	}
+
+	// empty query matches everything in order
+	{
+		assert.Equal(t, NewStringSliceV("b", "a"), NewStringSliceV("b", "a").FuzzyFind("", 0))
+	}
+
+	// ranked matches
+	{
+		slice := NewStringSliceV("checkout", "config", "commit", "status")
+		assert.Equal(t, NewStringSliceV("config", "commit", "checkout"), slice.FuzzyFind("co", 0))
+		assert.Equal(t, NewStringSliceV("commit", "checkout"), slice.FuzzyFind("ct", -1))
+		assert.Equal(t, NewStringSliceV("config"), slice.FuzzyFind("co", 1))
+		assert.Equal(t, NewStringSliceV(), slice.FuzzyFind("xyz", 0))
+	}
+
+	// original is not modified
+	{
+		slice := NewStringSliceV("b", "ab")
+		assert.Equal(t, NewStringSliceV("ab"), slice.FuzzyFind(A("ab"), 0))
+		assert.Equal(t, NewStringSliceV("b", "ab"), slice)
+	}
+}
+
 // G
 //--------------------------------------------------------------------------------------------------
 func ExampleStringSlice_G() {
@@ -1955,6 +2273,46 @@ func ExampleStringSlice_Generic() {
 	// Output: false
 }
 
+// GroupBy
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_GroupBy() {
+	slice := NewStringSliceV("1", "2", "3", "4", "5")
+	groups := slice.GroupBy(func(x O) O {
+		return ToInt(x) % 2
+	})
+	fmt.Println(groups.Get(1).O(), groups.Get(0).O())
+	// Output: [1 3 5] [2 4]
+}
+
+func TestStringSlice_GroupBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, 0, slice.GroupBy(func(x O) O { return x }).Len())
+		assert.Equal(t, 0, NewStringSliceV().GroupBy(func(x O) O { return x }).Len())
+	}
+
+	// groups keep first seen order
+	{
+		groups := NewStringSliceV("5", "4", "3", "2", "1").GroupBy(func(x O) O {
+			return ToInt(x) % 2
+		})
+		assert.Equal(t, []string{"1", "0"}, groups.Keys().O())
+		assert.Equal(t, []string{"4", "2"}, groups.Get(0).O().(ISlice).O())
+		assert.Equal(t, []string{"5", "3", "1"}, groups.Get(1).O().(ISlice).O())
+	}
+
+	// single group
+	{
+		groups := NewStringSliceV("1", "2", "3", "4", "5").GroupBy(func(x O) O {
+			return "all"
+		})
+		assert.Equal(t, 1, groups.Len())
+		assert.Equal(t, []string{"1", "2", "3", "4", "5"}, groups.Get("all").O().(ISlice).O())
+	}
+}
+
 // Index
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStringSlice_Index_Go(t *testing.B) {
@@ -2125,6 +2483,103 @@ func TestStringSlice_Insert(t *testing.T) {
 	}
 }
 
+// Intersect
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Intersect() {
+	slice := NewStringSliceV("1", "2", "3", "2")
+	fmt.Println(slice.Intersect([]string{"2", "4"}).O())
+	// Output: [2]
+}
+
+func TestStringSlice_Intersect(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, []string{}, slice.Intersect([]string{"1", "2"}).O())
+		assert.Equal(t, []string{}, NewStringSliceV("1", "2").Intersect([]string{}).O())
+	}
+
+	// uniq elements in other while preserving order
+	{
+		slice := NewStringSliceV("3", "1", "2", "1", "4")
+		assert.Equal(t, []string{"1", "4"}, slice.Intersect([]string{"4", "1", "5"}).O())
+		assert.Equal(t, []string{"3", "1", "2", "1", "4"}, slice.O())
+	}
+
+	// other is a Slice
+	{
+		assert.Equal(t, []string{"2", "3"}, NewStringSliceV("1", "2", "3").Intersect(NewStringSliceV("3", "2")).O())
+	}
+}
+
+// Iter
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Iter() {
+	it := NewStringSliceV("1", "2", "3").Iter()
+	for it.Next() {
+		fmt.Println(it.Index(), it.Value())
+	}
+	// Output:
+	// 0 1
+	// 1 2
+	// 2 3
+}
+
+func TestStringSlice_Iter(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.False(t, slice.Iter().Next())
+		assert.False(t, NewStringSliceV().Iter().Next())
+	}
+
+	// all elements in order
+	{
+		it := NewStringSliceV("1", "2", "3").Iter()
+		assert.Equal(t, -1, it.Index())
+		assert.Nil(t, it.Value())
+		for i, x := range []interface{}{"1", "2", "3"} {
+			assert.True(t, it.Next())
+			assert.Equal(t, i, it.Index())
+			assert.Equal(t, x, it.Value())
+		}
+		assert.False(t, it.Next())
+		assert.False(t, it.Next())
+		assert.Nil(t, it.Value())
+		assert.Nil(t, it.Err())
+	}
+}
+
+// IsSubset
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_IsSubset() {
+	slice := NewStringSliceV("1", "2", "3", "2")
+	fmt.Println(slice.IsSubset([]string{"2", "4"}))
+	// Output: false
+}
+
+func TestStringSlice_IsSubset(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.True(t, slice.IsSubset([]string{"1", "2"}))
+		assert.False(t, NewStringSliceV("1").IsSubset([]string{}))
+	}
+
+	// all elements in other
+	{
+		assert.True(t, NewStringSliceV("1", "2", "2").IsSubset([]string{"3", "2", "1"}))
+	}
+
+	// not all elements in other
+	{
+		assert.False(t, NewStringSliceV("1", "4").IsSubset(NewStringSliceV("1", "2")))
+	}
+}
+
 // Join
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStringSlice_Join_Go(t *testing.B) {
@@ -2339,6 +2794,61 @@ func TestStringSlice_Map(t *testing.T) {
 	}
 }
 
+// MapP
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_MapP() {
+	slice, _ := NewStringSliceV("1", "2", "3").MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+		return ToString(ToInt(x) * 2), nil
+	})
+	fmt.Println(slice)
+	// Output: [2 4 6]
+}
+
+func TestStringSlice_MapP(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		new, err := slice.MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+			return x, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// Order is preserved
+	{
+		slice, err := NewStringSliceV("1", "2", "3").MapP(context.Background(), 0, func(ctx context.Context, x O) (O, error) {
+			return ToString(ToInt(x) * 2), nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"2", "4", "6"}, slice.O())
+	}
+
+	// First error is returned
+	{
+		slice, err := NewStringSliceV("1", "2", "3").MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+			if ToInt(x) == 2 {
+				return nil, errors.New("failed")
+			}
+			return x, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+		assert.Equal(t, 0, slice.Len())
+	}
+
+	// Cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		slice, err := NewStringSliceV("1", "2", "3").MapP(ctx, 2, func(ctx context.Context, x O) (O, error) {
+			return x, nil
+		})
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, 0, slice.Len())
+	}
+}
+
 // Nil
 //--------------------------------------------------------------------------------------------------
 func ExampleStringSlice_Nil() {
@@ -2408,6 +2918,49 @@ func TestStringSlice_Pair(t *testing.T) {
 	}
 }
 
+// Partition
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Partition() {
+	slice := NewStringSliceV("1", "2", "3", "4", "5")
+	match, rest := slice.Partition(func(x O) bool {
+		return ToInt(x)%2 == 1
+	})
+	fmt.Println(match, rest)
+	// Output: [1 3 5] [2 4]
+}
+
+func TestStringSlice_Partition(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		match, rest := slice.Partition(func(x O) bool { return true })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+		match, rest = NewStringSliceV().Partition(func(x O) bool { return true })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+	}
+
+	// split in order
+	{
+		match, rest := NewStringSliceV("1", "2", "3", "4", "5").Partition(func(x O) bool {
+			return ToInt(x)%2 == 1
+		})
+		assert.Equal(t, []string{"1", "3", "5"}, match.O())
+		assert.Equal(t, []string{"2", "4"}, rest.O())
+	}
+
+	// all match
+	{
+		match, rest := NewStringSliceV("1", "2", "3", "4", "5").Partition(func(x O) bool {
+			return true
+		})
+		assert.Equal(t, []string{"1", "2", "3", "4", "5"}, match.O())
+		assert.Equal(t, 0, rest.Len())
+	}
+}
+
 // Pop
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStringSlice_Pop_Go(t *testing.B) {
@@ -2711,6 +3264,61 @@ func TestStringSlice_Select(t *testing.T) {
 	}
 }
 
+// SelectP
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_SelectP() {
+	slice, _ := NewStringSliceV("1", "2", "3").SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+		return ToInt(x) != 2, nil
+	})
+	fmt.Println(slice)
+	// Output: [1 3]
+}
+
+func TestStringSlice_SelectP(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		new, err := slice.SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+			return true, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// Order is preserved
+	{
+		slice, err := NewStringSliceV("1", "2", "3").SelectP(context.Background(), 0, func(ctx context.Context, x O) (bool, error) {
+			return ToInt(x) != 2, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"1", "3"}, slice.O())
+	}
+
+	// First error is returned
+	{
+		slice, err := NewStringSliceV("1", "2", "3").SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+			if ToInt(x) == 2 {
+				return false, errors.New("failed")
+			}
+			return true, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+		assert.Equal(t, 0, slice.Len())
+	}
+
+	// Cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		slice, err := NewStringSliceV("1", "2", "3").SelectP(ctx, 2, func(ctx context.Context, x O) (bool, error) {
+			return true, nil
+		})
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, 0, slice.Len())
+	}
+}
+
 // Set
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStringSlice_Set_Go(t *testing.B) {
@@ -2877,6 +3485,36 @@ func TestStringSlice_SetE(t *testing.T) {
 	}
 }
 
+// ShellJoin
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_ShellJoin() {
+	slice := NewStringSliceV("grep", "-r", "hello world", "it's")
+	fmt.Println(slice.ShellJoin())
+	// Output: grep -r 'hello world' 'it'"'"'s'
+}
+
+func TestStringSlice_ShellJoin(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, A(""), slice.ShellJoin())
+		assert.Equal(t, A(""), NewStringSliceV().ShellJoin())
+	}
+
+	// quoted as needed
+	{
+		slice := NewStringSliceV("ls", "-la", "", "my dir")
+		assert.Equal(t, A("ls -la '' 'my dir'"), slice.ShellJoin())
+	}
+
+	// round trip
+	{
+		slice := NewStringSliceV("echo", "$HOME", "a\tb", `"x"`, `\`, "#")
+		assert.Equal(t, slice, slice.ShellJoin().Shlex())
+	}
+}
+
 // Shift
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStringSlice_Shift_Go(t *testing.B) {
@@ -3157,6 +3795,66 @@ func TestStringSlice_Sort(t *testing.T) {
 	}
 }
 
+// SortBy
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_SortBy() {
+	slice := NewStringSliceV("1", "3", "2")
+	fmt.Println(slice.SortBy(func(a, b O) bool {
+		return ToInt(a) > ToInt(b)
+	}))
+	// Output: [3 2 1]
+}
+
+func TestStringSlice_SortBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, NewStringSliceV(), slice.SortBy(func(a, b O) bool { return false }))
+		assert.Equal(t, NewStringSliceV(), NewStringSliceV().SortBy(func(a, b O) bool { return false }))
+	}
+
+	// descending order in a new slice
+	{
+		slice := NewStringSliceV("1", "3", "2", "5", "4")
+		new := slice.SortBy(func(a, b O) bool {
+			return ToInt(a) > ToInt(b)
+		})
+		assert.Equal(t, []string{"5", "4", "3", "2", "1"}, new.O())
+		assert.Equal(t, []string{"1", "3", "2", "5", "4"}, slice.O())
+	}
+}
+
+// SortByM
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_SortByM() {
+	slice := NewStringSliceV("1", "3", "2")
+	slice.SortByM(func(a, b O) bool {
+		return ToInt(a) > ToInt(b)
+	})
+	fmt.Println(slice)
+	// Output: [3 2 1]
+}
+
+func TestStringSlice_SortByM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, (*StringSlice)(nil), slice.SortByM(func(a, b O) bool { return false }))
+		assert.Equal(t, NewStringSliceV(), NewStringSliceV().SortByM(func(a, b O) bool { return false }))
+	}
+
+	// descending order in place
+	{
+		slice := NewStringSliceV("1", "3", "2", "5", "4")
+		slice.SortByM(func(a, b O) bool {
+			return ToInt(a) > ToInt(b)
+		})
+		assert.Equal(t, []string{"5", "4", "3", "2", "1"}, slice.O())
+	}
+}
+
 // SortM
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStringSlice_SortM_Go(t *testing.B) {
@@ -3277,6 +3975,66 @@ func TestStringSlice_SortReverseM(t *testing.T) {
 	}
 }
 
+// SortStable
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_SortStable() {
+	slice := NewStringSliceV("4", "1", "3", "2", "5")
+	fmt.Println(slice.SortStable(func(a, b O) bool {
+		return ToInt(a)%2 < ToInt(b)%2
+	}))
+	// Output: [4 2 1 3 5]
+}
+
+func TestStringSlice_SortStable(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, NewStringSliceV(), slice.SortStable(func(a, b O) bool { return false }))
+		assert.Equal(t, NewStringSliceV(), NewStringSliceV().SortStable(func(a, b O) bool { return false }))
+	}
+
+	// equal elements keep their order
+	{
+		slice := NewStringSliceV("5", "4", "1", "3", "2")
+		new := slice.SortStable(func(a, b O) bool {
+			return ToInt(a)%2 < ToInt(b)%2
+		})
+		assert.Equal(t, []string{"4", "2", "5", "1", "3"}, new.O())
+		assert.Equal(t, []string{"5", "4", "1", "3", "2"}, slice.O())
+	}
+}
+
+// SortStableM
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_SortStableM() {
+	slice := NewStringSliceV("4", "1", "3", "2", "5")
+	slice.SortStableM(func(a, b O) bool {
+		return ToInt(a)%2 < ToInt(b)%2
+	})
+	fmt.Println(slice)
+	// Output: [4 2 1 3 5]
+}
+
+func TestStringSlice_SortStableM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, (*StringSlice)(nil), slice.SortStableM(func(a, b O) bool { return false }))
+		assert.Equal(t, NewStringSliceV(), NewStringSliceV().SortStableM(func(a, b O) bool { return false }))
+	}
+
+	// equal elements keep their order
+	{
+		slice := NewStringSliceV("5", "4", "1", "3", "2")
+		slice.SortStableM(func(a, b O) bool {
+			return ToInt(a)%2 < ToInt(b)%2
+		})
+		assert.Equal(t, []string{"4", "2", "5", "1", "3"}, slice.O())
+	}
+}
+
 // String
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStringSlice_String_Go(t *testing.B) {
@@ -3319,6 +4077,46 @@ func TestStringSlice_String(t *testing.T) {
 	}
 }
 
+// Suggest
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Suggest() {
+	commands := NewStringSliceV("install", "list", "status", "uninstall")
+	fmt.Printf("Did you mean %s?", commands.Suggest("stauts").First().A())
+	// Output: Did you mean status?
+}
+
+func TestStringSlice_Suggest(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, NewStringSliceV(), slice.Suggest("a"))
+		assert.Equal(t, NewStringSliceV(), NewStringSliceV("a").Suggest(""))
+	}
+
+	commands := NewStringSliceV("install", "uninstall", "list", "status", "commit", "checkout", "config")
+
+	// typos
+	{
+		assert.Equal(t, NewStringSliceV("install"), commands.Suggest("instal"))
+		assert.Equal(t, NewStringSliceV("status"), commands.Suggest("stauts"))
+		assert.Equal(t, NewStringSliceV("list"), commands.Suggest("lsit"))
+		assert.Equal(t, NewStringSliceV("commit"), commands.Suggest("COMIT"))
+	}
+
+	// prefixes are ordered by distance
+	{
+		assert.Equal(t, NewStringSliceV("commit", "config"), commands.Suggest("co"))
+		assert.Equal(t, NewStringSliceV("config"), commands.Suggest("con"))
+		assert.Equal(t, NewStringSliceV("uninstall", "install"), NewStringSliceV("install", "uninstall").Suggest("uninstal"))
+	}
+
+	// nothing similar
+	{
+		assert.Equal(t, NewStringSliceV(), commands.Suggest("xyz"))
+	}
+}
+
 // Swap
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStringSlice_Swap_Go(t *testing.B) {
@@ -3377,6 +4175,36 @@ func TestStringSlice_Swap(t *testing.T) {
 	}
 }
 
+// SymmetricDifference
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_SymmetricDifference() {
+	slice := NewStringSliceV("1", "2", "3", "2")
+	fmt.Println(slice.SymmetricDifference([]string{"2", "4"}).O())
+	// Output: [1 3 4]
+}
+
+func TestStringSlice_SymmetricDifference(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, []string{"1", "2"}, slice.SymmetricDifference([]string{"1", "2", "2"}).O())
+		assert.Equal(t, []string{"1", "2"}, NewStringSliceV("1", "2", "2").SymmetricDifference([]string{}).O())
+	}
+
+	// uniq elements from both not in the other while preserving order
+	{
+		slice := NewStringSliceV("1", "2", "3", "3")
+		assert.Equal(t, []string{"1", "4"}, slice.SymmetricDifference([]string{"4", "3", "2", "4"}).O())
+		assert.Equal(t, []string{"1", "2", "3", "3"}, slice.O())
+	}
+
+	// other is a Slice with the same elements
+	{
+		assert.Equal(t, []string{}, NewStringSliceV("1", "2").SymmetricDifference(NewStringSliceV("2", "1")).O())
+	}
+}
+
 // Take
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStringSlice_Take_Go(t *testing.B) {
@@ -4002,3 +4830,82 @@ func RangeString(size int) (new []string) {
 	}
 	return
 }
+
+
+// Window
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Window() {
+	slice := NewStringSliceV("1", "2", "3", "4", "5")
+	slice.Window(3, 1).Each(func(x O) {
+		fmt.Print(x)
+	})
+	// Output: [1 2 3][2 3 4][3 4 5]
+}
+
+func TestStringSlice_Window(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, 0, slice.Window(2, 1).Len())
+		assert.Equal(t, 0, NewStringSliceV().Window(2, 1).Len())
+	}
+
+	// invalid size or step
+	{
+		assert.Equal(t, 0, NewStringSliceV("1", "2", "3", "4", "5").Window(0, 1).Len())
+		assert.Equal(t, 0, NewStringSliceV("1", "2", "3", "4", "5").Window(2, 0).Len())
+		assert.Equal(t, 0, NewStringSliceV("1", "2", "3", "4", "5").Window(6, 1).Len())
+	}
+
+	// sliding by one
+	{
+		windows := NewStringSliceV("1", "2", "3", "4", "5").Window(3, 1)
+		assert.Equal(t, 3, windows.Len())
+		assert.Equal(t, []string{"1", "2", "3"}, windows.At(0).O().(ISlice).O())
+		assert.Equal(t, []string{"2", "3", "4"}, windows.At(1).O().(ISlice).O())
+		assert.Equal(t, []string{"3", "4", "5"}, windows.At(2).O().(ISlice).O())
+	}
+
+	// partial windows are dropped
+	{
+		windows := NewStringSliceV("1", "2", "3", "4", "5").Window(2, 2)
+		assert.Equal(t, 2, windows.Len())
+		assert.Equal(t, []string{"1", "2"}, windows.At(0).O().(ISlice).O())
+		assert.Equal(t, []string{"3", "4"}, windows.At(1).O().(ISlice).O())
+	}
+}
+
+// Zip
+//--------------------------------------------------------------------------------------------------
+func ExampleStringSlice_Zip() {
+	slice := NewStringSliceV("1", "2", "3")
+	fmt.Println(slice.Zip([]string{"a", "b", "c"}))
+	// Output: [[1 a] [2 b] [3 c]]
+}
+
+func TestStringSlice_Zip(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *StringSlice
+		assert.Equal(t, 0, slice.Zip([]string{"a"}).Len())
+		assert.Equal(t, 0, NewStringSliceV().Zip([]string{"a"}).Len())
+		assert.Equal(t, 0, NewStringSliceV("1", "2", "3").Zip(nil).Len())
+	}
+
+	// shorter of the two
+	{
+		pairs := NewStringSliceV("1", "2", "3", "4", "5").Zip([]string{"a", "b"})
+		assert.Equal(t, 2, pairs.Len())
+		assert.Equal(t, []interface{}{"1", "a"}, pairs.At(0).O().(ISlice).O())
+		assert.Equal(t, []interface{}{"2", "b"}, pairs.At(1).O().(ISlice).O())
+	}
+
+	// Slice given
+	{
+		pairs := NewStringSliceV("1", "2", "3").Zip(NewIntSliceV(7, 8, 9, 10))
+		assert.Equal(t, 3, pairs.Len())
+		assert.Equal(t, []interface{}{"3", 9}, pairs.At(2).O().(ISlice).O())
+	}
+}
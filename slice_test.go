@@ -1,9 +1,13 @@
 package n
 
 import (
+	"context"
 	"reflect"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -132,6 +136,18 @@ func BenchmarkSlice_RefSlice(t *testing.B) {
 
 func TestSlice_NewSlice(t *testing.T) {
 
+	// bool
+	{
+		assert.Equal(t, NewBoolSliceV(true), Slice([]bool{true}))
+		assert.Equal(t, NewBoolSliceV(true), Slice(&([]bool{true})))
+	}
+
+	// duration
+	{
+		assert.Equal(t, NewDurationSliceV(time.Second), Slice([]time.Duration{time.Second}))
+		assert.Equal(t, NewDurationSliceV(time.Second), Slice(time.Second))
+	}
+
 	// float
 	{
 		assert.Equal(t, NewFloatSliceV(3), Slice([]float32{3}))
@@ -164,10 +180,23 @@ func TestSlice_NewSlice(t *testing.T) {
 		assert.Equal(t, NewStrV("3"), Slice([]byte{'3'}))
 		assert.Equal(t, NewStrV("3"), Slice([]Char{'3'}))
 	}
+
+	// time
+	{
+		assert.Equal(t, NewTimeSliceV(time.Time{}), Slice([]time.Time{{}}))
+		assert.Equal(t, NewTimeSliceV(time.Time{}), Slice(&([]time.Time{{}})))
+	}
 }
 
 func TestSlice_NewSliceV(t *testing.T) {
 
+	// bool, duration and time
+	{
+		assert.Equal(t, NewBoolSliceV(true, false), NewSliceV(true, false))
+		assert.Equal(t, NewDurationSliceV(time.Second), NewSliceV(time.Second))
+		assert.Equal(t, NewTimeSliceV(time.Time{}), NewSliceV(time.Time{}))
+	}
+
 	// int
 	{
 		assert.Equal(t, NewFloatSliceV(3), NewSliceV(float32(3.0)))
@@ -197,6 +226,128 @@ func TestSlice_NewSliceV(t *testing.T) {
 	}
 }
 
+func TestSlice_compareValues(t *testing.T) {
+	v := reflect.ValueOf
+	t1, t2 := time.Unix(1, 0), time.Unix(2, 0)
+
+	// invalid values sort first
+	assert.Equal(t, 0, compareValues(reflect.Value{}, reflect.Value{}))
+	assert.Equal(t, -1, compareValues(reflect.Value{}, v(1)))
+	assert.Equal(t, 1, compareValues(v(""), reflect.Value{}))
+
+	// numbers regardless of kind
+	assert.Equal(t, -1, compareValues(v(int8(-1)), v(uint(0))))
+	assert.Equal(t, 1, compareValues(v(2.5), v(int64(2))))
+	assert.Equal(t, 0, compareValues(v(uint16(3)), v(uint32(3))))
+
+	// other kinds
+	assert.Equal(t, -1, compareValues(v(false), v(true)))
+	assert.Equal(t, 1, compareValues(v("b"), v("a")))
+	assert.Equal(t, -1, compareValues(v(t1), v(t2)))
+	assert.Equal(t, 0, compareValues(v(t1), v(t1)))
+	assert.Equal(t, -1, compareValues(v([]int{1}), v([]int{2})))
+}
+
+func TestSlice_eachP(t *testing.T) {
+
+	// nothing to do
+	{
+		called := false
+		assert.Nil(t, eachP(context.Background(), 0, 0, func(ctx context.Context, i int) error {
+			called = true
+			return nil
+		}))
+		assert.False(t, called)
+	}
+
+	// every index is visited once
+	{
+		hits := make([]int32, 100)
+		assert.Nil(t, eachP(context.Background(), len(hits), 0, func(ctx context.Context, i int) error {
+			atomic.AddInt32(&hits[i], 1)
+			return nil
+		}))
+		for i := range hits {
+			assert.Equal(t, int32(1), hits[i])
+		}
+	}
+
+	// workers are bounded
+	{
+		var running, max int32
+		assert.Nil(t, eachP(context.Background(), 50, 3, func(ctx context.Context, i int) error {
+			cnt := atomic.AddInt32(&running, 1)
+			for {
+				cur := atomic.LoadInt32(&max)
+				if cnt <= cur || atomic.CompareAndSwapInt32(&max, cur, cnt) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return nil
+		}))
+		assert.True(t, max >= 1 && max <= 3)
+	}
+
+	// first error is returned and the remaining work is skipped
+	{
+		var cnt int32
+		err := eachP(context.Background(), 1000, 2, func(ctx context.Context, i int) error {
+			atomic.AddInt32(&cnt, 1)
+			if i == 0 {
+				return errors.New("failed")
+			}
+			return ctx.Err()
+		})
+		assert.Equal(t, "failed", err.Error())
+		assert.True(t, cnt < 1000)
+	}
+
+	// cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		called := false
+		err := eachP(ctx, 10, 2, func(ctx context.Context, i int) error {
+			called = true
+			return nil
+		})
+		assert.Equal(t, context.Canceled, err)
+		assert.False(t, called)
+	}
+
+	// cancelled while running
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		var cnt int32
+		err := eachP(ctx, 1000, 2, func(ctx context.Context, i int) error {
+			if atomic.AddInt32(&cnt, 1) == 5 {
+				cancel()
+			}
+			return nil
+		})
+		assert.Equal(t, context.Canceled, err)
+		assert.True(t, cnt < 1000)
+	}
+}
+
+func TestSlice_newSliceOf(t *testing.T) {
+	assert.Equal(t, NewIntSliceV(), newSliceOf(nil, NewIntSliceV()))
+
+	// same non slice types are typed
+	assert.Equal(t, NewIntSliceV(1, 2), newSliceOf([]interface{}{1, 2}, NewInterSliceV()))
+	assert.Equal(t, NewStringSliceV("a", "b"), newSliceOf([]interface{}{"a", "b"}, NewInterSliceV()))
+
+	// mixed types are kept as is
+	assert.Equal(t, []interface{}{1, "x"}, newSliceOf([]interface{}{1, "x"}, NewIntSliceV()).O())
+	assert.Equal(t, []interface{}{"x", 1, nil}, newSliceOf([]interface{}{"x", 1, nil}, NewIntSliceV()).O())
+
+	// nested slices are not flattened
+	assert.Equal(t, []interface{}{[]int{1, 2}, []int{3}}, newSliceOf([]interface{}{[]int{1, 2}, []int{3}}, NewIntSliceV()).O())
+	assert.Equal(t, []interface{}{NewIntSliceV(1)}, newSliceOf([]interface{}{NewIntSliceV(1)}, NewIntSliceV()).O())
+}
+
 func TestSlice_absIndex(t *testing.T) {
 	//             -4,-3,-2,-1
 	//              0, 1, 2, 3
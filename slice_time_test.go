@@ -0,0 +1,2035 @@
+// Code generated by "nubgen -type=bool,byte,time.Duration,time.Time -test"; DO NOT EDIT.
+
+package n
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// NewTimeSlice
+//--------------------------------------------------------------------------------------------------
+func ExampleNewTimeSlice() {
+	slice := NewTimeSlice([]time.Time{time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()})
+	fmt.Println(slice)
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_NewTimeSlice(t *testing.T) {
+
+	// array
+	{
+		var array [2]time.Time
+		array[0] = time.Unix(0, 0).UTC()
+		array[1] = time.Unix(60, 0).UTC()
+		assert.Equal(t, []time.Time{time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()}, NewTimeSlice(array).O())
+		assert.Equal(t, []time.Time{time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()}, NewTimeSlice(array[:]).O())
+	}
+
+	// empty
+	{
+		assert.Equal(t, []time.Time{}, NewTimeSlice(nil).O())
+		assert.Equal(t, []time.Time{}, NewTimeSlice([]time.Time{}).O())
+	}
+
+	// conversion
+	{
+		assert.Equal(t, []time.Time{time.Unix(0, 0).UTC()}, NewTimeSlice(time.Unix(0, 0).UTC()).O())
+		assert.Equal(t, []time.Time{time.Unix(0, 0).UTC()}, NewTimeSlice("1970-01-01T00:00:00Z").O())
+		assert.Equal(t, []time.Time{time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()}, NewTimeSlice([]interface{}{"1970-01-01T00:00:00Z", time.Unix(60, 0).UTC()}).O())
+		assert.Equal(t, []time.Time{}, NewTimeSlice("foo").O())
+	}
+}
+
+// NewTimeSliceV
+//--------------------------------------------------------------------------------------------------
+func ExampleNewTimeSliceV() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice)
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_NewTimeSliceV(t *testing.T) {
+	assert.Equal(t, []time.Time{}, NewTimeSliceV().O())
+	assert.Equal(t, []time.Time{time.Unix(0, 0).UTC()}, NewTimeSliceV(time.Unix(0, 0).UTC()).O())
+	assert.Equal(t, []time.Time{time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()}, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).O())
+}
+
+// A
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_A() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.A())
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_A(t *testing.T) {
+	assert.Equal(t, "[]", (*TimeSlice)(nil).A())
+	assert.Equal(t, "[1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]", NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).A())
+}
+
+// All
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_All() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.All(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()))
+	// Output: true
+}
+
+func TestTimeSlice_All(t *testing.T) {
+	var slice *TimeSlice
+	assert.False(t, slice.All())
+	assert.False(t, NewTimeSliceV().All(time.Unix(0, 0).UTC()))
+	assert.True(t, NewTimeSliceV(time.Unix(0, 0).UTC()).All())
+	assert.True(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).All(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()))
+	assert.False(t, NewTimeSliceV(time.Unix(0, 0).UTC()).All(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()))
+	assert.False(t, NewTimeSliceV(time.Unix(0, 0).UTC()).All("foo"))
+}
+
+// AllS
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_AllS() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.AllS([]time.Time{time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()}))
+	// Output: true
+}
+
+func TestTimeSlice_AllS(t *testing.T) {
+	var slice *TimeSlice
+	assert.False(t, slice.AllS([]time.Time{time.Unix(0, 0).UTC()}))
+	assert.False(t, NewTimeSliceV().AllS([]time.Time{time.Unix(0, 0).UTC()}))
+	assert.True(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).AllS([]time.Time{time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()}))
+	assert.True(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).AllS(NewTimeSliceV(time.Unix(60, 0).UTC())))
+	assert.False(t, NewTimeSliceV(time.Unix(0, 0).UTC()).AllS([]time.Time{time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()}))
+	assert.True(t, NewTimeSliceV(time.Unix(0, 0).UTC()).AllS(nil))
+}
+
+// Any
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Any() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC())
+	fmt.Println(slice.Any(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()))
+	// Output: true
+}
+
+func TestTimeSlice_Any(t *testing.T) {
+	var slice *TimeSlice
+	assert.False(t, slice.Any())
+	assert.False(t, NewTimeSliceV().Any(time.Unix(0, 0).UTC()))
+	assert.True(t, NewTimeSliceV(time.Unix(0, 0).UTC()).Any())
+	assert.True(t, NewTimeSliceV(time.Unix(0, 0).UTC()).Any(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()))
+	assert.False(t, NewTimeSliceV(time.Unix(0, 0).UTC()).Any(time.Unix(60, 0).UTC()))
+	assert.False(t, NewTimeSliceV(time.Unix(0, 0).UTC()).Any("foo"))
+}
+
+// AnyS
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_AnyS() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC())
+	fmt.Println(slice.AnyS([]time.Time{time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()}))
+	// Output: true
+}
+
+func TestTimeSlice_AnyS(t *testing.T) {
+	var slice *TimeSlice
+	assert.False(t, slice.AnyS([]time.Time{time.Unix(0, 0).UTC()}))
+	assert.False(t, NewTimeSliceV().AnyS([]time.Time{time.Unix(0, 0).UTC()}))
+	assert.True(t, NewTimeSliceV(time.Unix(0, 0).UTC()).AnyS([]time.Time{time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()}))
+	assert.True(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).AnyS(NewTimeSliceV(time.Unix(60, 0).UTC())))
+	assert.False(t, NewTimeSliceV(time.Unix(0, 0).UTC()).AnyS([]time.Time{time.Unix(60, 0).UTC()}))
+	assert.False(t, NewTimeSliceV(time.Unix(0, 0).UTC()).AnyS(nil))
+}
+
+// AnyW
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_AnyW() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.AnyW(func(x O) bool {
+		return x.(time.Time).Equal(time.Unix(60, 0).UTC())
+	}))
+	// Output: true
+}
+
+func TestTimeSlice_AnyW(t *testing.T) {
+	var slice *TimeSlice
+	assert.False(t, slice.AnyW(func(x O) bool { return true }))
+	assert.False(t, NewTimeSliceV().AnyW(func(x O) bool { return true }))
+	assert.True(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).AnyW(func(x O) bool { return x.(time.Time).Equal(time.Unix(60, 0).UTC()) }))
+	assert.False(t, NewTimeSliceV(time.Unix(0, 0).UTC()).AnyW(func(x O) bool { return x.(time.Time).Equal(time.Unix(60, 0).UTC()) }))
+}
+
+// Append
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Append() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC())
+	fmt.Println(slice.Append(time.Unix(60, 0).UTC()))
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_Append(t *testing.T) {
+
+	// nil
+	{
+		var slice *TimeSlice
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), slice.Append(time.Unix(0, 0).UTC()))
+		assert.Equal(t, (*TimeSlice)(nil), slice)
+	}
+
+	// modifies in place
+	{
+		slice := NewTimeSliceV(time.Unix(0, 0).UTC())
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), slice.Append(time.Unix(60, 0).UTC()))
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), slice)
+	}
+
+	// conversion
+	{
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC()).Append("1970-01-01T00:01:00Z"))
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC()).Append("foo"))
+	}
+}
+
+// AppendV
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_AppendV() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC())
+	fmt.Println(slice.AppendV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()))
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z 1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_AppendV(t *testing.T) {
+
+	// nil
+	{
+		var slice *TimeSlice
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), slice.AppendV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()))
+		assert.Equal(t, (*TimeSlice)(nil), slice)
+	}
+
+	// modifies in place
+	{
+		slice := NewTimeSliceV(time.Unix(0, 0).UTC())
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), slice.AppendV(time.Unix(60, 0).UTC(), "1970-01-01T00:00:00Z"))
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), slice)
+	}
+}
+
+// At
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_At() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.At(-1).O())
+	// Output: 1970-01-01 00:01:00 +0000 UTC
+}
+
+func TestTimeSlice_At(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, Obj(nil), slice.At(0))
+	assert.Equal(t, Obj(nil), NewTimeSliceV().At(0))
+	assert.Equal(t, Obj(time.Unix(0, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).At(0))
+	assert.Equal(t, Obj(time.Unix(60, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).At(1))
+	assert.Equal(t, Obj(time.Unix(60, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).At(-1))
+	assert.Equal(t, Obj(time.Unix(0, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).At(-2))
+	assert.Equal(t, Obj(nil), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).At(2))
+	assert.Equal(t, Obj(nil), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).At(-3))
+}
+
+// BinarySearch
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_BinarySearch() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.BinarySearch(time.Unix(60, 0).UTC()))
+	// Output: 1 true
+}
+
+func TestTimeSlice_BinarySearch(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *TimeSlice
+		i, found := slice.BinarySearch(time.Unix(0, 0).UTC())
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+		i, found = NewTimeSliceV().BinarySearch(time.Unix(0, 0).UTC())
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// found
+	{
+		i, found := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).BinarySearch(time.Unix(0, 0).UTC())
+		assert.Equal(t, 0, i)
+		assert.True(t, found)
+		i, found = NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).BinarySearch("1970-01-01T00:01:00Z")
+		assert.Equal(t, 1, i)
+		assert.True(t, found)
+	}
+
+	// not found returns the insertion point
+	{
+		i, found := NewTimeSliceV(time.Unix(0, 0).UTC()).BinarySearch(time.Unix(60, 0).UTC())
+		assert.Equal(t, 1, i)
+		assert.False(t, found)
+		i, found = NewTimeSliceV(time.Unix(60, 0).UTC()).BinarySearch(time.Unix(0, 0).UTC())
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// invalid element
+	{
+		i, found := NewTimeSliceV(time.Unix(0, 0).UTC()).BinarySearch("foo")
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+}
+
+// Chunk
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Chunk() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	slice.Chunk(2).Each(func(x O) {
+		fmt.Print(x)
+	})
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z][1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_Chunk(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *TimeSlice
+		assert.Equal(t, 0, slice.Chunk(2).Len())
+		assert.Equal(t, 0, NewTimeSliceV().Chunk(2).Len())
+		assert.Equal(t, 0, NewTimeSliceV(time.Unix(0, 0).UTC()).Chunk(0).Len())
+	}
+
+	// remainder in the last chunk
+	{
+		slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+		chunks := slice.Chunk(2)
+		assert.Equal(t, 2, chunks.Len())
+		assert.Equal(t, []time.Time{time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()}, chunks.At(0).O().(ISlice).O())
+		assert.Equal(t, []time.Time{time.Unix(0, 0).UTC()}, chunks.At(1).O().(ISlice).O())
+
+		// chunks are copies
+		chunks.At(0).O().(ISlice).Set(0, time.Unix(60, 0).UTC())
+		assert.Equal(t, []time.Time{time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()}, slice.O())
+	}
+}
+
+// Clear
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Clear() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.Clear())
+	// Output: []
+}
+
+func TestTimeSlice_Clear(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, NewTimeSliceV(), slice.Clear())
+	assert.Equal(t, (*TimeSlice)(nil), slice)
+
+	slice = NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	assert.Equal(t, NewTimeSliceV(), slice.Clear())
+	assert.Equal(t, NewTimeSliceV(), slice)
+}
+
+// Concat
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Concat() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC())
+	fmt.Println(slice.Concat([]time.Time{time.Unix(60, 0).UTC()}))
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_Concat(t *testing.T) {
+
+	// nil
+	{
+		var slice *TimeSlice
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), slice.Concat([]time.Time{time.Unix(0, 0).UTC()}))
+		assert.Equal(t, (*TimeSlice)(nil), slice)
+	}
+
+	// new slice
+	{
+		slice := NewTimeSliceV(time.Unix(0, 0).UTC())
+		concat := slice.Concat(NewTimeSliceV(time.Unix(60, 0).UTC()))
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), concat)
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), slice)
+	}
+
+	// conversion
+	{
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC()).Concat([]interface{}{"1970-01-01T00:01:00Z"}))
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC()).Concat(nil))
+	}
+}
+
+// ConcatM
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_ConcatM() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC())
+	fmt.Println(slice.ConcatM([]time.Time{time.Unix(60, 0).UTC()}))
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_ConcatM(t *testing.T) {
+
+	// nil
+	{
+		var slice *TimeSlice
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), slice.ConcatM([]time.Time{time.Unix(0, 0).UTC()}))
+		assert.Equal(t, (*TimeSlice)(nil), slice)
+	}
+
+	// modifies in place
+	{
+		slice := NewTimeSliceV(time.Unix(0, 0).UTC())
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), slice.ConcatM(NewTimeSliceV(time.Unix(60, 0).UTC())))
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), slice)
+	}
+}
+
+// Copy
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Copy() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.Copy())
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_Copy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *TimeSlice
+		assert.Equal(t, NewTimeSliceV(), slice.Copy())
+		assert.Equal(t, NewTimeSliceV(), NewTimeSliceV().Copy(0, -1))
+	}
+
+	// copy is independent
+	{
+		slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+		copy := slice.Copy()
+		copy.Set(0, time.Unix(60, 0).UTC())
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), slice)
+		assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(60, 0).UTC()), copy)
+	}
+
+	// ranges
+	{
+		slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), slice.Copy(0, -1))
+		assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), slice.Copy(1, -1))
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), slice.Copy(0, 1))
+		assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC()), slice.Copy(-2, -2))
+		assert.Equal(t, NewTimeSliceV(), slice.Copy(2, 1))
+	}
+}
+
+// Count
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Count() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.Count(time.Unix(0, 0).UTC()))
+	// Output: 2
+}
+
+func TestTimeSlice_Count(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, 0, slice.Count(time.Unix(0, 0).UTC()))
+	assert.Equal(t, 0, NewTimeSliceV().Count(time.Unix(0, 0).UTC()))
+	assert.Equal(t, 2, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()).Count(time.Unix(0, 0).UTC()))
+	assert.Equal(t, 1, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()).Count(time.Unix(60, 0).UTC()))
+	assert.Equal(t, 0, NewTimeSliceV(time.Unix(0, 0).UTC()).Count("foo"))
+}
+
+// CountW
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_CountW() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.CountW(func(x O) bool {
+		return x.(time.Time).Equal(time.Unix(60, 0).UTC())
+	}))
+	// Output: 1
+}
+
+func TestTimeSlice_CountW(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, 0, slice.CountW(func(x O) bool { return true }))
+	assert.Equal(t, 0, NewTimeSliceV().CountW(func(x O) bool { return true }))
+	assert.Equal(t, 3, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()).CountW(func(x O) bool { return true }))
+	assert.Equal(t, 2, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()).CountW(func(x O) bool { return x.(time.Time).Equal(time.Unix(0, 0).UTC()) }))
+}
+
+// Difference
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Difference() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.Difference([]time.Time{time.Unix(60, 0).UTC()}))
+	// Output: [1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_Difference(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, []time.Time{}, slice.Difference([]time.Time{time.Unix(0, 0).UTC()}).O())
+	assert.Equal(t, []time.Time{time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()}, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()).Difference(nil).O())
+	assert.Equal(t, []time.Time{time.Unix(60, 0).UTC()}, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()).Difference(NewTimeSliceV(time.Unix(0, 0).UTC())).O())
+	assert.Equal(t, []time.Time{}, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).Difference([]time.Time{time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()}).O())
+}
+
+// Disjoint
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Disjoint() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.Disjoint([]time.Time{time.Unix(60, 0).UTC()}))
+	// Output: true
+}
+
+func TestTimeSlice_Disjoint(t *testing.T) {
+	var slice *TimeSlice
+	assert.True(t, slice.Disjoint([]time.Time{time.Unix(0, 0).UTC()}))
+	assert.True(t, NewTimeSliceV(time.Unix(0, 0).UTC()).Disjoint(nil))
+	assert.True(t, NewTimeSliceV(time.Unix(0, 0).UTC()).Disjoint(NewTimeSliceV(time.Unix(60, 0).UTC())))
+	assert.False(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).Disjoint([]time.Time{time.Unix(60, 0).UTC()}))
+}
+
+// Drop
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Drop() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.Drop(0, 1))
+	// Output: [1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_Drop(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *TimeSlice
+		assert.Equal(t, (*TimeSlice)(nil), slice.Drop(0, 1))
+		assert.Equal(t, NewTimeSliceV(), NewTimeSliceV().Drop(0, 1))
+	}
+
+	// drop all
+	{
+		slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+		assert.Equal(t, NewTimeSliceV(), slice.Drop())
+		assert.Equal(t, NewTimeSliceV(), slice)
+	}
+
+	// ranges
+	{
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()).Drop(1, -1))
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()).Drop(1, 1))
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()).Drop(-1, -1))
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()).Drop(2, 1))
+	}
+}
+
+// DropAt
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_DropAt() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.DropAt(1))
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_DropAt(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, (*TimeSlice)(nil), slice.DropAt(0))
+	assert.Equal(t, NewTimeSliceV(), NewTimeSliceV().DropAt(0))
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).DropAt(0))
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).DropAt(-1))
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).DropAt(2))
+}
+
+// DropFirst
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_DropFirst() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.DropFirst())
+	// Output: [1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_DropFirst(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, (*TimeSlice)(nil), slice.DropFirst())
+	assert.Equal(t, NewTimeSliceV(), NewTimeSliceV().DropFirst())
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).DropFirst())
+}
+
+// DropFirstN
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_DropFirstN() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.DropFirstN(2))
+	// Output: [1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_DropFirstN(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, (*TimeSlice)(nil), slice.DropFirstN(1))
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).DropFirstN(0))
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).DropFirstN(1))
+	assert.Equal(t, NewTimeSliceV(), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).DropFirstN(5))
+}
+
+// DropLast
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_DropLast() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.DropLast())
+	// Output: [1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_DropLast(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, (*TimeSlice)(nil), slice.DropLast())
+	assert.Equal(t, NewTimeSliceV(), NewTimeSliceV().DropLast())
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).DropLast())
+}
+
+// DropLastN
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_DropLastN() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.DropLastN(2))
+	// Output: [1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_DropLastN(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, (*TimeSlice)(nil), slice.DropLastN(1))
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).DropLastN(0))
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).DropLastN(1))
+	assert.Equal(t, NewTimeSliceV(), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).DropLastN(5))
+}
+
+// DropW
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_DropW() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.DropW(func(x O) bool {
+		return x.(time.Time).Equal(time.Unix(0, 0).UTC())
+	}))
+	// Output: [1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_DropW(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, (*TimeSlice)(nil), slice.DropW(func(x O) bool { return true }))
+	assert.Equal(t, NewTimeSliceV(), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).DropW(func(x O) bool { return true }))
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()).DropW(func(x O) bool {
+		return x.(time.Time).Equal(time.Unix(60, 0).UTC())
+	}))
+}
+
+// Each
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Each() {
+	NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).Each(func(x O) {
+		fmt.Print(x.(time.Time).Format(time.RFC3339Nano), ";")
+	})
+	// Output: 1970-01-01T00:00:00Z;1970-01-01T00:01:00Z;
+}
+
+func TestTimeSlice_Each(t *testing.T) {
+	var slice *TimeSlice
+	slice.Each(func(x O) { assert.Fail(t, "should not be called") })
+
+	var results []time.Time
+	NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).Each(func(x O) {
+		results = append(results, x.(time.Time))
+	})
+	assert.Equal(t, []time.Time{time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()}, results)
+}
+
+// EachE
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_EachE() {
+	NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).EachE(func(x O) error {
+		fmt.Print(x.(time.Time).Format(time.RFC3339Nano), ";")
+		return nil
+	})
+	// Output: 1970-01-01T00:00:00Z;1970-01-01T00:01:00Z;
+}
+
+func TestTimeSlice_EachE(t *testing.T) {
+
+	// nil
+	{
+		var slice *TimeSlice
+		_, err := slice.EachE(func(x O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []time.Time
+		_, err := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).EachE(func(x O) error {
+			if x.(time.Time).Equal(time.Unix(60, 0).UTC()) {
+				return Break
+			}
+			results = append(results, x.(time.Time))
+			return nil
+		})
+		assert.Equal(t, Break, err)
+		assert.Equal(t, []time.Time{time.Unix(0, 0).UTC()}, results)
+	}
+}
+
+// EachI
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_EachI() {
+	NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).EachI(func(i int, x O) {
+		fmt.Print(i, ":", x.(time.Time).Format(time.RFC3339Nano), ";")
+	})
+	// Output: 0:1970-01-01T00:00:00Z;1:1970-01-01T00:01:00Z;
+}
+
+func TestTimeSlice_EachI(t *testing.T) {
+	var slice *TimeSlice
+	slice.EachI(func(i int, x O) { assert.Fail(t, "should not be called") })
+
+	var results []int
+	NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).EachI(func(i int, x O) {
+		results = append(results, i)
+	})
+	assert.Equal(t, []int{0, 1}, results)
+}
+
+// EachIE
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_EachIE() {
+	NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).EachIE(func(i int, x O) error {
+		fmt.Print(i, ":", x.(time.Time).Format(time.RFC3339Nano), ";")
+		return nil
+	})
+	// Output: 0:1970-01-01T00:00:00Z;1:1970-01-01T00:01:00Z;
+}
+
+func TestTimeSlice_EachIE(t *testing.T) {
+
+	// nil
+	{
+		var slice *TimeSlice
+		_, err := slice.EachIE(func(i int, x O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []int
+		_, err := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()).EachIE(func(i int, x O) error {
+			if i == 1 {
+				return Break
+			}
+			results = append(results, i)
+			return nil
+		})
+		assert.Equal(t, Break, err)
+		assert.Equal(t, []int{0}, results)
+	}
+}
+
+// EachP
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_EachP() {
+	slice, err := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+		return nil
+	})
+	fmt.Println(slice, err)
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z] <nil>
+}
+
+func TestTimeSlice_EachP(t *testing.T) {
+
+	// nil
+	{
+		var slice *TimeSlice
+		_, err := slice.EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+			return nil
+		})
+		assert.Nil(t, err)
+	}
+
+	// first error is returned
+	{
+		_, err := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).EachP(context.Background(), 0, func(ctx context.Context, x O) error {
+			if x.(time.Time).Equal(time.Unix(60, 0).UTC()) {
+				return errors.New("failed")
+			}
+			return nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+
+	// cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).EachP(ctx, 2, func(ctx context.Context, x O) error {
+			return nil
+		})
+		assert.Equal(t, context.Canceled, err)
+	}
+}
+
+// EachR
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_EachR() {
+	NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).EachR(func(x O) {
+		fmt.Print(x.(time.Time).Format(time.RFC3339Nano), ";")
+	})
+	// Output: 1970-01-01T00:01:00Z;1970-01-01T00:00:00Z;
+}
+
+func TestTimeSlice_EachR(t *testing.T) {
+	var slice *TimeSlice
+	slice.EachR(func(x O) { assert.Fail(t, "should not be called") })
+
+	var results []time.Time
+	NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).EachR(func(x O) {
+		results = append(results, x.(time.Time))
+	})
+	assert.Equal(t, []time.Time{time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()}, results)
+}
+
+// EachRE
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_EachRE() {
+	NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).EachRE(func(x O) error {
+		fmt.Print(x.(time.Time).Format(time.RFC3339Nano), ";")
+		return nil
+	})
+	// Output: 1970-01-01T00:01:00Z;1970-01-01T00:00:00Z;
+}
+
+func TestTimeSlice_EachRE(t *testing.T) {
+
+	// nil
+	{
+		var slice *TimeSlice
+		_, err := slice.EachRE(func(x O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []time.Time
+		_, err := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).EachRE(func(x O) error {
+			if x.(time.Time).Equal(time.Unix(0, 0).UTC()) {
+				return Break
+			}
+			results = append(results, x.(time.Time))
+			return nil
+		})
+		assert.Equal(t, Break, err)
+		assert.Equal(t, []time.Time{time.Unix(60, 0).UTC()}, results)
+	}
+}
+
+// EachRI
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_EachRI() {
+	NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).EachRI(func(i int, x O) {
+		fmt.Print(i, ":", x.(time.Time).Format(time.RFC3339Nano), ";")
+	})
+	// Output: 1:1970-01-01T00:01:00Z;0:1970-01-01T00:00:00Z;
+}
+
+func TestTimeSlice_EachRI(t *testing.T) {
+	var slice *TimeSlice
+	slice.EachRI(func(i int, x O) { assert.Fail(t, "should not be called") })
+
+	var results []int
+	NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).EachRI(func(i int, x O) {
+		results = append(results, i)
+	})
+	assert.Equal(t, []int{1, 0}, results)
+}
+
+// EachRIE
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_EachRIE() {
+	NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).EachRIE(func(i int, x O) error {
+		fmt.Print(i, ":", x.(time.Time).Format(time.RFC3339Nano), ";")
+		return nil
+	})
+	// Output: 1:1970-01-01T00:01:00Z;0:1970-01-01T00:00:00Z;
+}
+
+func TestTimeSlice_EachRIE(t *testing.T) {
+
+	// nil
+	{
+		var slice *TimeSlice
+		_, err := slice.EachRIE(func(i int, x O) error { return nil })
+		assert.Nil(t, err)
+	}
+
+	// break early with error
+	{
+		var results []int
+		_, err := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()).EachRIE(func(i int, x O) error {
+			if i == 1 {
+				return Break
+			}
+			results = append(results, i)
+			return nil
+		})
+		assert.Equal(t, Break, err)
+		assert.Equal(t, []int{2}, results)
+	}
+}
+
+// Empty
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Empty() {
+	fmt.Println(NewTimeSliceV().Empty())
+	// Output: true
+}
+
+func TestTimeSlice_Empty(t *testing.T) {
+	assert.True(t, (*TimeSlice)(nil).Empty())
+	assert.True(t, NewTimeSliceV().Empty())
+	assert.False(t, NewTimeSliceV(time.Unix(0, 0).UTC()).Empty())
+}
+
+// First
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_First() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.First().O())
+	// Output: 1970-01-01 00:00:00 +0000 UTC
+}
+
+func TestTimeSlice_First(t *testing.T) {
+	assert.Equal(t, Obj(nil), (*TimeSlice)(nil).First())
+	assert.Equal(t, Obj(nil), NewTimeSliceV().First())
+	assert.Equal(t, Obj(time.Unix(0, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).First())
+}
+
+// FirstN
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_FirstN() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.FirstN(2))
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_FirstN(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, NewTimeSliceV(), slice.FirstN(1))
+	assert.Equal(t, NewTimeSliceV(), NewTimeSliceV(time.Unix(0, 0).UTC()).FirstN(0))
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).FirstN(1))
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).FirstN(5))
+
+	// reference to the original
+	{
+		slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+		slice.FirstN(1).Set(0, time.Unix(60, 0).UTC())
+		assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(60, 0).UTC()), slice)
+	}
+}
+
+// FlatMap
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_FlatMap() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.FlatMap(func(x O) O {
+		return []interface{}{x, x}
+	}))
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:00:00Z 1970-01-01T00:01:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_FlatMap(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, 0, slice.FlatMap(func(x O) O { return x }).Len())
+	assert.Equal(t, 0, NewTimeSliceV().FlatMap(func(x O) O { return x }).Len())
+	assert.Equal(t, NewStringSliceV("1970-01-01T00:00:00Z", "1970-01-01T00:00:00Z", "1970-01-01T00:01:00Z", "1970-01-01T00:01:00Z"), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).FlatMap(func(x O) O {
+		return []string{x.(time.Time).Format(time.RFC3339Nano), x.(time.Time).Format(time.RFC3339Nano)}
+	}))
+}
+
+// Flatten
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Flatten() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.Flatten())
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_Flatten(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, 0, slice.Flatten().Len())
+
+	// already flat so it is a copy
+	{
+		slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+		new := slice.Flatten()
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), new)
+		new.Set(0, time.Unix(60, 0).UTC())
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), slice)
+	}
+}
+
+// G
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_G() {
+	fmt.Println(len(NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).G()))
+	// Output: 2
+}
+
+func TestTimeSlice_G(t *testing.T) {
+	assert.Equal(t, []time.Time{}, (*TimeSlice)(nil).G())
+	assert.Equal(t, []time.Time{}, NewTimeSliceV().G())
+	assert.Equal(t, []time.Time{time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()}, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).G())
+}
+
+// GroupBy
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_GroupBy() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	groups := slice.GroupBy(func(x O) O {
+		return x.(time.Time).Equal(time.Unix(0, 0).UTC())
+	})
+	fmt.Println(groups.Get(true).O(), groups.Get(false).O())
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:00:00Z] [1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_GroupBy(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, 0, slice.GroupBy(func(x O) O { return x }).Len())
+	assert.Equal(t, 0, NewTimeSliceV().GroupBy(func(x O) O { return x }).Len())
+
+	// groups keep first seen order
+	{
+		groups := NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).GroupBy(func(x O) O {
+			return x.(time.Time).Equal(time.Unix(0, 0).UTC())
+		})
+		assert.Equal(t, []string{"false", "true"}, groups.Keys().O())
+		assert.Equal(t, []time.Time{time.Unix(60, 0).UTC(), time.Unix(60, 0).UTC()}, groups.Get(false).O().(ISlice).O())
+		assert.Equal(t, []time.Time{time.Unix(0, 0).UTC()}, groups.Get(true).O().(ISlice).O())
+	}
+}
+
+// Index
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Index() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.Index(time.Unix(60, 0).UTC()))
+	// Output: 1
+}
+
+func TestTimeSlice_Index(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, -1, slice.Index(time.Unix(0, 0).UTC()))
+	assert.Equal(t, -1, NewTimeSliceV().Index(time.Unix(0, 0).UTC()))
+	assert.Equal(t, 0, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()).Index(time.Unix(0, 0).UTC()))
+	assert.Equal(t, 1, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).Index("1970-01-01T00:01:00Z"))
+	assert.Equal(t, -1, NewTimeSliceV(time.Unix(0, 0).UTC()).Index(time.Unix(60, 0).UTC()))
+	assert.Equal(t, -1, NewTimeSliceV(time.Unix(0, 0).UTC()).Index("foo"))
+}
+
+// Insert
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Insert() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.Insert(1, time.Unix(60, 0).UTC()))
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z 1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_Insert(t *testing.T) {
+
+	// nil
+	{
+		var slice *TimeSlice
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), slice.Insert(0, time.Unix(0, 0).UTC()))
+		assert.Equal(t, (*TimeSlice)(nil), slice)
+	}
+
+	// positions
+	{
+		assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC()).Insert(0, time.Unix(60, 0).UTC()))
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC()).Insert(-1, time.Unix(60, 0).UTC()))
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()).Insert(1, []time.Time{time.Unix(60, 0).UTC(), time.Unix(60, 0).UTC()}))
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC()).Insert(5, time.Unix(60, 0).UTC()))
+	}
+
+	// conversion
+	{
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()).Insert(1, "1970-01-01T00:01:00Z"))
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()).Insert(1, "foo"))
+	}
+}
+
+// InterSlice
+//--------------------------------------------------------------------------------------------------
+func TestTimeSlice_InterSlice(t *testing.T) {
+	assert.False(t, (*TimeSlice)(nil).InterSlice())
+	assert.False(t, NewTimeSliceV(time.Unix(0, 0).UTC()).InterSlice())
+}
+
+// Intersect
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Intersect() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.Intersect([]time.Time{time.Unix(0, 0).UTC()}))
+	// Output: [1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_Intersect(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, []time.Time{}, slice.Intersect([]time.Time{time.Unix(0, 0).UTC()}).O())
+	assert.Equal(t, []time.Time{}, NewTimeSliceV(time.Unix(0, 0).UTC()).Intersect(nil).O())
+	assert.Equal(t, []time.Time{time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()}, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).Intersect(NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())).O())
+	assert.Equal(t, []time.Time{}, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()).Intersect([]time.Time{time.Unix(60, 0).UTC()}).O())
+}
+
+// Iter
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Iter() {
+	it := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).Iter()
+	for it.Next() {
+		fmt.Println(it.Index(), it.Value())
+	}
+	// Output:
+	// 0 1970-01-01 00:00:00 +0000 UTC
+	// 1 1970-01-01 00:01:00 +0000 UTC
+}
+
+func TestTimeSlice_Iter(t *testing.T) {
+	assert.False(t, (*TimeSlice)(nil).Iter().Next())
+	assert.False(t, NewTimeSliceV().Iter().Next())
+
+	it := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).Iter()
+	assert.Equal(t, -1, it.Index())
+	assert.True(t, it.Next())
+	assert.Equal(t, 0, it.Index())
+	assert.Equal(t, Obj(time.Unix(0, 0).UTC()).O(), it.Value())
+	assert.True(t, it.Next())
+	assert.Equal(t, 1, it.Index())
+	assert.Equal(t, Obj(time.Unix(60, 0).UTC()).O(), it.Value())
+	assert.False(t, it.Next())
+	assert.Nil(t, it.Value())
+	assert.Nil(t, it.Err())
+}
+
+// IsSubset
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_IsSubset() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.IsSubset([]time.Time{time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()}))
+	// Output: true
+}
+
+func TestTimeSlice_IsSubset(t *testing.T) {
+	var slice *TimeSlice
+	assert.True(t, slice.IsSubset([]time.Time{time.Unix(0, 0).UTC()}))
+	assert.True(t, NewTimeSliceV(time.Unix(60, 0).UTC()).IsSubset(NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())))
+	assert.False(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).IsSubset([]time.Time{time.Unix(60, 0).UTC()}))
+	assert.False(t, NewTimeSliceV(time.Unix(0, 0).UTC()).IsSubset(nil))
+}
+
+// Join
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Join() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.Join())
+	// Output: 1970-01-01T00:00:00Z,1970-01-01T00:01:00Z
+}
+
+func TestTimeSlice_Join(t *testing.T) {
+	assert.Equal(t, Obj(""), (*TimeSlice)(nil).Join())
+	assert.Equal(t, Obj(""), NewTimeSliceV().Join())
+	assert.Equal(t, Obj("1970-01-01T00:00:00Z"), NewTimeSliceV(time.Unix(0, 0).UTC()).Join())
+	assert.Equal(t, Obj("1970-01-01T00:00:00Z.1970-01-01T00:01:00Z"), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).Join("."))
+}
+
+// Last
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Last() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.Last().O())
+	// Output: 1970-01-01 00:01:00 +0000 UTC
+}
+
+func TestTimeSlice_Last(t *testing.T) {
+	assert.Equal(t, Obj(nil), (*TimeSlice)(nil).Last())
+	assert.Equal(t, Obj(nil), NewTimeSliceV().Last())
+	assert.Equal(t, Obj(time.Unix(60, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).Last())
+}
+
+// LastN
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_LastN() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.LastN(2))
+	// Output: [1970-01-01T00:01:00Z 1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_LastN(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, NewTimeSliceV(), slice.LastN(1))
+	assert.Equal(t, NewTimeSliceV(), NewTimeSliceV(time.Unix(0, 0).UTC()).LastN(0))
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).LastN(1))
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).LastN(5))
+}
+
+// Len
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Len() {
+	fmt.Println(NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).Len())
+	// Output: 2
+}
+
+func TestTimeSlice_Len(t *testing.T) {
+	assert.Equal(t, 0, (*TimeSlice)(nil).Len())
+	assert.Equal(t, 0, NewTimeSliceV().Len())
+	assert.Equal(t, 2, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).Len())
+}
+
+// Less
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Less() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.Less(0, 1))
+	// Output: true
+}
+
+func TestTimeSlice_Less(t *testing.T) {
+	assert.False(t, (*TimeSlice)(nil).Less(0, 1))
+	assert.False(t, NewTimeSliceV(time.Unix(0, 0).UTC()).Less(0, 1))
+	assert.True(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).Less(0, 1))
+	assert.False(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).Less(1, 0))
+	assert.False(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()).Less(0, 1))
+	assert.False(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).Less(0, 2))
+}
+
+// Map
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Map() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.Map(func(x O) O {
+		return time.Unix(60, 0).UTC()
+	}))
+	// Output: [1970-01-01T00:01:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_Map(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, NewTimeSliceV(), slice.Map(func(x O) O { return x }))
+	assert.Equal(t, 2, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).Map(func(x O) O { return x }).Len())
+
+	// type change
+	{
+		assert.Equal(t, NewStringSliceV("1970-01-01T00:00:00Z", "1970-01-01T00:01:00Z"), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).Map(func(x O) O {
+			return x.(time.Time).Format(time.RFC3339Nano)
+		}))
+	}
+}
+
+// MapP
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_MapP() {
+	slice, _ := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+		return x, nil
+	})
+	fmt.Println(slice)
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_MapP(t *testing.T) {
+
+	// nil
+	{
+		var slice *TimeSlice
+		new, err := slice.MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+			return x, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// first error is returned
+	{
+		_, err := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).MapP(context.Background(), 0, func(ctx context.Context, x O) (O, error) {
+			if x.(time.Time).Equal(time.Unix(60, 0).UTC()) {
+				return nil, errors.New("failed")
+			}
+			return x, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+}
+
+// Nil
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Nil() {
+	var slice *TimeSlice
+	fmt.Println(slice.Nil())
+	// Output: true
+}
+
+func TestTimeSlice_Nil(t *testing.T) {
+	assert.True(t, (*TimeSlice)(nil).Nil())
+	assert.False(t, NewTimeSliceV().Nil())
+	assert.False(t, NewTimeSliceV(time.Unix(0, 0).UTC()).Nil())
+}
+
+// O
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_O() {
+	fmt.Println(len(NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).O().([]time.Time)))
+	// Output: 2
+}
+
+func TestTimeSlice_O(t *testing.T) {
+	assert.Equal(t, []time.Time{}, (*TimeSlice)(nil).O())
+	assert.Equal(t, []time.Time{}, NewTimeSliceV().O())
+	assert.Equal(t, []time.Time{time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()}, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).O())
+}
+
+// Pair
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Pair() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	first, second := slice.Pair()
+	fmt.Println(first.O(), second.O())
+	// Output: 1970-01-01 00:00:00 +0000 UTC 1970-01-01 00:01:00 +0000 UTC
+}
+
+func TestTimeSlice_Pair(t *testing.T) {
+
+	// nil
+	{
+		first, second := (*TimeSlice)(nil).Pair()
+		assert.Equal(t, Obj(nil), first)
+		assert.Equal(t, Obj(nil), second)
+	}
+
+	// one value
+	{
+		first, second := NewTimeSliceV(time.Unix(0, 0).UTC()).Pair()
+		assert.Equal(t, Obj(time.Unix(0, 0).UTC()), first)
+		assert.Equal(t, Obj(nil), second)
+	}
+
+	// two values
+	{
+		first, second := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).Pair()
+		assert.Equal(t, Obj(time.Unix(0, 0).UTC()), first)
+		assert.Equal(t, Obj(time.Unix(60, 0).UTC()), second)
+	}
+}
+
+// Partition
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Partition() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	match, rest := slice.Partition(func(x O) bool {
+		return x.(time.Time).Equal(time.Unix(0, 0).UTC())
+	})
+	fmt.Println(match, rest)
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:00:00Z] [1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_Partition(t *testing.T) {
+
+	// nil
+	{
+		var slice *TimeSlice
+		match, rest := slice.Partition(func(x O) bool { return true })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+	}
+
+	// all match
+	{
+		match, rest := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).Partition(func(x O) bool { return true })
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), match)
+		assert.Equal(t, NewTimeSliceV(), rest)
+	}
+}
+
+// Pop
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Pop() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.Pop().O())
+	// Output: 1970-01-01 00:01:00 +0000 UTC
+}
+
+func TestTimeSlice_Pop(t *testing.T) {
+	assert.Equal(t, Obj(nil), (*TimeSlice)(nil).Pop())
+	assert.Equal(t, Obj(nil), NewTimeSliceV().Pop())
+
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	assert.Equal(t, Obj(time.Unix(60, 0).UTC()), slice.Pop())
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), slice)
+}
+
+// PopN
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_PopN() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.PopN(2))
+	// Output: [1970-01-01T00:01:00Z 1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_PopN(t *testing.T) {
+	assert.Equal(t, NewTimeSliceV(), (*TimeSlice)(nil).PopN(1))
+	assert.Equal(t, NewTimeSliceV(), NewTimeSliceV(time.Unix(0, 0).UTC()).PopN(0))
+
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), slice.PopN(2))
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), slice)
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), slice.PopN(5))
+	assert.Equal(t, NewTimeSliceV(), slice)
+}
+
+// Prepend
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Prepend() {
+	slice := NewTimeSliceV(time.Unix(60, 0).UTC())
+	fmt.Println(slice.Prepend(time.Unix(0, 0).UTC()))
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_Prepend(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), slice.Prepend(time.Unix(0, 0).UTC()))
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC()).Prepend("1970-01-01T00:01:00Z"))
+}
+
+// RefSlice
+//--------------------------------------------------------------------------------------------------
+func TestTimeSlice_RefSlice(t *testing.T) {
+	assert.False(t, (*TimeSlice)(nil).RefSlice())
+	assert.False(t, NewTimeSliceV(time.Unix(0, 0).UTC()).RefSlice())
+}
+
+// Reverse
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Reverse() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.Reverse())
+	// Output: [1970-01-01T00:01:00Z 1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_Reverse(t *testing.T) {
+	assert.Equal(t, NewTimeSliceV(), (*TimeSlice)(nil).Reverse())
+
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), slice.Reverse())
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), slice)
+}
+
+// ReverseM
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_ReverseM() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.ReverseM())
+	// Output: [1970-01-01T00:01:00Z 1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_ReverseM(t *testing.T) {
+	assert.Equal(t, (*TimeSlice)(nil), (*TimeSlice)(nil).ReverseM())
+
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), slice.ReverseM())
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), slice)
+}
+
+// S
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_S() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.S())
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_S(t *testing.T) {
+	assert.Equal(t, NewStringSliceV(), (*TimeSlice)(nil).S())
+	assert.Equal(t, NewStringSliceV("1970-01-01T00:00:00Z", "1970-01-01T00:01:00Z"), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).S())
+}
+
+// Select
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Select() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.Select(func(x O) bool {
+		return x.(time.Time).Equal(time.Unix(0, 0).UTC())
+	}))
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_Select(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, NewTimeSliceV(), slice.Select(func(x O) bool { return true }))
+	assert.Equal(t, NewTimeSliceV(), NewTimeSliceV(time.Unix(0, 0).UTC()).Select(func(x O) bool { return false }))
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()).Select(func(x O) bool {
+		return x.(time.Time).Equal(time.Unix(60, 0).UTC())
+	}))
+}
+
+// SelectP
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_SelectP() {
+	slice, _ := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()).SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+		return x.(time.Time).Equal(time.Unix(0, 0).UTC()), nil
+	})
+	fmt.Println(slice)
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_SelectP(t *testing.T) {
+
+	// nil
+	{
+		var slice *TimeSlice
+		new, err := slice.SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+			return true, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// first error is returned
+	{
+		_, err := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).SelectP(context.Background(), 0, func(ctx context.Context, x O) (bool, error) {
+			if x.(time.Time).Equal(time.Unix(60, 0).UTC()) {
+				return false, errors.New("failed")
+			}
+			return true, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+}
+
+// Set
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Set() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.Set(0, time.Unix(60, 0).UTC()))
+	// Output: [1970-01-01T00:01:00Z 1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_Set(t *testing.T) {
+	assert.Equal(t, (*TimeSlice)(nil), (*TimeSlice)(nil).Set(0, time.Unix(0, 0).UTC()))
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()).Set(-1, time.Unix(60, 0).UTC()))
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(60, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()).Set(0, []time.Time{time.Unix(60, 0).UTC(), time.Unix(60, 0).UTC()}))
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()).Set(5, time.Unix(60, 0).UTC()))
+}
+
+// SetE
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_SetE() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.SetE(1, time.Unix(60, 0).UTC()))
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z] <nil>
+}
+
+func TestTimeSlice_SetE(t *testing.T) {
+
+	// nil
+	{
+		slice, err := (*TimeSlice)(nil).SetE(0, time.Unix(0, 0).UTC())
+		assert.Nil(t, err)
+		assert.Equal(t, (*TimeSlice)(nil), slice)
+	}
+
+	// conversion
+	{
+		slice, err := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()).SetE(0, "1970-01-01T00:01:00Z")
+		assert.Nil(t, err)
+		assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), slice)
+	}
+
+	// out of bounds
+	{
+		slice, err := NewTimeSliceV(time.Unix(0, 0).UTC()).SetE(2, time.Unix(60, 0).UTC())
+		assert.Equal(t, "slice assignment is out of bounds", err.Error())
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), slice)
+	}
+}
+
+// Shift
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Shift() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.Shift().O())
+	// Output: 1970-01-01 00:00:00 +0000 UTC
+}
+
+func TestTimeSlice_Shift(t *testing.T) {
+	assert.Equal(t, Obj(nil), (*TimeSlice)(nil).Shift())
+	assert.Equal(t, Obj(nil), NewTimeSliceV().Shift())
+
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	assert.Equal(t, Obj(time.Unix(0, 0).UTC()), slice.Shift())
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC()), slice)
+}
+
+// ShiftN
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_ShiftN() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.ShiftN(2))
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_ShiftN(t *testing.T) {
+	assert.Equal(t, NewTimeSliceV(), (*TimeSlice)(nil).ShiftN(1))
+	assert.Equal(t, NewTimeSliceV(), NewTimeSliceV(time.Unix(0, 0).UTC()).ShiftN(0))
+
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), slice.ShiftN(2))
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), slice)
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), slice.ShiftN(5))
+	assert.Equal(t, NewTimeSliceV(), slice)
+}
+
+// Single
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Single() {
+	fmt.Println(NewTimeSliceV(time.Unix(0, 0).UTC()).Single())
+	// Output: true
+}
+
+func TestTimeSlice_Single(t *testing.T) {
+	assert.False(t, (*TimeSlice)(nil).Single())
+	assert.False(t, NewTimeSliceV().Single())
+	assert.True(t, NewTimeSliceV(time.Unix(0, 0).UTC()).Single())
+	assert.False(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).Single())
+}
+
+// Slice
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Slice() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.Slice(1, -1))
+	// Output: [1970-01-01T00:01:00Z 1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_Slice(t *testing.T) {
+	assert.Equal(t, NewTimeSliceV(), (*TimeSlice)(nil).Slice(0, -1))
+	assert.Equal(t, NewTimeSliceV(), NewTimeSliceV().Slice(0, -1))
+
+	// reference to the original
+	{
+		slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), slice.Slice())
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), slice.Slice(0, 1))
+		assert.Equal(t, NewTimeSliceV(), slice.Slice(2, 1))
+		slice.Slice(-1, -1).Set(0, time.Unix(60, 0).UTC())
+		assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(60, 0).UTC()), slice)
+	}
+}
+
+// Sort
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Sort() {
+	slice := NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.Sort())
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_Sort(t *testing.T) {
+	assert.Equal(t, NewTimeSliceV(), (*TimeSlice)(nil).Sort())
+
+	slice := NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(60, 0).UTC()), slice.Sort())
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), slice)
+}
+
+// SortBy
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_SortBy() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.SortBy(func(a, b O) bool {
+		return b.(time.Time).Before(a.(time.Time))
+	}))
+	// Output: [1970-01-01T00:01:00Z 1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_SortBy(t *testing.T) {
+	assert.Equal(t, NewTimeSliceV(), (*TimeSlice)(nil).SortBy(func(a, b O) bool { return false }))
+
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()), slice.SortBy(func(a, b O) bool {
+		return b.(time.Time).Before(a.(time.Time))
+	}))
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), slice)
+}
+
+// SortByM
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_SortByM() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.SortByM(func(a, b O) bool {
+		return b.(time.Time).Before(a.(time.Time))
+	}))
+	// Output: [1970-01-01T00:01:00Z 1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_SortByM(t *testing.T) {
+	assert.Equal(t, (*TimeSlice)(nil), (*TimeSlice)(nil).SortByM(func(a, b O) bool { return false }))
+
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()), slice.SortByM(func(a, b O) bool {
+		return b.(time.Time).Before(a.(time.Time))
+	}))
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()), slice)
+}
+
+// SortM
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_SortM() {
+	slice := NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.SortM())
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_SortM(t *testing.T) {
+	assert.Equal(t, (*TimeSlice)(nil), (*TimeSlice)(nil).SortM())
+
+	slice := NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(60, 0).UTC()), slice.SortM())
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(60, 0).UTC()), slice)
+}
+
+// SortReverse
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_SortReverse() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.SortReverse())
+	// Output: [1970-01-01T00:01:00Z 1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_SortReverse(t *testing.T) {
+	assert.Equal(t, NewTimeSliceV(), (*TimeSlice)(nil).SortReverse())
+
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()), slice.SortReverse())
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), slice)
+}
+
+// SortReverseM
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_SortReverseM() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.SortReverseM())
+	// Output: [1970-01-01T00:01:00Z 1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_SortReverseM(t *testing.T) {
+	assert.Equal(t, (*TimeSlice)(nil), (*TimeSlice)(nil).SortReverseM())
+
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()), slice.SortReverseM())
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()), slice)
+}
+
+// SortStable
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_SortStable() {
+	slice := NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.SortStable(func(a, b O) bool {
+		return a.(time.Time).Before(b.(time.Time))
+	}))
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_SortStable(t *testing.T) {
+	assert.Equal(t, NewTimeSliceV(), (*TimeSlice)(nil).SortStable(func(a, b O) bool { return false }))
+
+	slice := NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(60, 0).UTC()), slice.SortStable(func(a, b O) bool {
+		return a.(time.Time).Before(b.(time.Time))
+	}))
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), slice)
+}
+
+// SortStableM
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_SortStableM() {
+	slice := NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.SortStableM(func(a, b O) bool {
+		return a.(time.Time).Before(b.(time.Time))
+	}))
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_SortStableM(t *testing.T) {
+	assert.Equal(t, (*TimeSlice)(nil), (*TimeSlice)(nil).SortStableM(func(a, b O) bool { return false }))
+
+	slice := NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(60, 0).UTC()), slice.SortStableM(func(a, b O) bool {
+		return a.(time.Time).Before(b.(time.Time))
+	}))
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(60, 0).UTC()), slice)
+}
+
+// String
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_String() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.String())
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_String(t *testing.T) {
+	assert.Equal(t, "[]", (*TimeSlice)(nil).String())
+	assert.Equal(t, "[]", NewTimeSliceV().String())
+	assert.Equal(t, "[1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]", NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).String())
+}
+
+// Swap
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Swap() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	slice.Swap(0, 1)
+	fmt.Println(slice)
+	// Output: [1970-01-01T00:01:00Z 1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_Swap(t *testing.T) {
+	var slice *TimeSlice
+	slice.Swap(0, 1)
+	assert.Equal(t, (*TimeSlice)(nil), slice)
+
+	slice = NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	slice.Swap(0, 2)
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()), slice)
+	slice.Swap(1, 0)
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), slice)
+}
+
+// SymmetricDifference
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_SymmetricDifference() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.SymmetricDifference([]time.Time{time.Unix(60, 0).UTC()}))
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_SymmetricDifference(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, []time.Time{time.Unix(0, 0).UTC()}, slice.SymmetricDifference([]time.Time{time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()}).O())
+	assert.Equal(t, []time.Time{time.Unix(0, 0).UTC()}, NewTimeSliceV(time.Unix(0, 0).UTC()).SymmetricDifference(nil).O())
+	assert.Equal(t, []time.Time{}, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).SymmetricDifference(NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())).O())
+	assert.Equal(t, []time.Time{time.Unix(60, 0).UTC()}, NewTimeSliceV(time.Unix(0, 0).UTC()).SymmetricDifference([]time.Time{time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()}).O())
+}
+
+// Take
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Take() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.Take(0, 1))
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_Take(t *testing.T) {
+	assert.Equal(t, NewTimeSliceV(), (*TimeSlice)(nil).Take(0, 1))
+
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), slice.Take(1, -1))
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), slice)
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), slice.Take())
+	assert.Equal(t, NewTimeSliceV(), slice)
+}
+
+// TakeAt
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_TakeAt() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.TakeAt(1).O())
+	// Output: 1970-01-01 00:01:00 +0000 UTC
+}
+
+func TestTimeSlice_TakeAt(t *testing.T) {
+	assert.Equal(t, Obj(nil), (*TimeSlice)(nil).TakeAt(0))
+
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	assert.Equal(t, Obj(nil), slice.TakeAt(2))
+	assert.Equal(t, Obj(time.Unix(60, 0).UTC()), slice.TakeAt(-1))
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), slice)
+}
+
+// TakeW
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_TakeW() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.TakeW(func(x O) bool {
+		return x.(time.Time).Equal(time.Unix(0, 0).UTC())
+	}))
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_TakeW(t *testing.T) {
+	assert.Equal(t, NewTimeSliceV(), (*TimeSlice)(nil).TakeW(func(x O) bool { return true }))
+
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC()), slice.TakeW(func(x O) bool {
+		return x.(time.Time).Equal(time.Unix(60, 0).UTC())
+	}))
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()), slice)
+}
+
+// ToStringSlice
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_ToStringSlice() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.ToStringSlice())
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_ToStringSlice(t *testing.T) {
+	assert.Equal(t, NewStringSliceV(), (*TimeSlice)(nil).ToStringSlice())
+	assert.Equal(t, NewStringSliceV("1970-01-01T00:00:00Z", "1970-01-01T00:01:00Z"), NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).ToStringSlice())
+}
+
+// ToStrs
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_ToStrs() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(slice.ToStrs())
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_ToStrs(t *testing.T) {
+	assert.Equal(t, []string{}, (*TimeSlice)(nil).ToStrs())
+	assert.Equal(t, []string{"1970-01-01T00:00:00Z", "1970-01-01T00:01:00Z"}, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).ToStrs())
+}
+
+// ToInterSlice
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_ToInterSlice() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	fmt.Println(len(slice.ToInterSlice()))
+	// Output: 2
+}
+
+func TestTimeSlice_ToInterSlice(t *testing.T) {
+	assert.Equal(t, []interface{}{}, (*TimeSlice)(nil).ToInterSlice())
+	assert.Equal(t, []interface{}{time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()}, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).ToInterSlice())
+}
+
+// Union
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Union() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.Union([]time.Time{time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()}))
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_Union(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), slice.Union([]time.Time{time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()}))
+
+	slice = NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(60, 0).UTC())
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), slice.Union(NewTimeSliceV(time.Unix(0, 0).UTC())))
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(60, 0).UTC()), slice)
+}
+
+// UnionM
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_UnionM() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.UnionM([]time.Time{time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()}))
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_UnionM(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, NewTimeSliceV(time.Unix(0, 0).UTC()), slice.UnionM([]time.Time{time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()}))
+	assert.Equal(t, (*TimeSlice)(nil), slice)
+
+	slice = NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(60, 0).UTC())
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), slice.UnionM(NewTimeSliceV(time.Unix(0, 0).UTC())))
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), slice)
+}
+
+// Uniq
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Uniq() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.Uniq())
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_Uniq(t *testing.T) {
+	assert.Equal(t, NewTimeSliceV(), (*TimeSlice)(nil).Uniq())
+
+	slice := NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), slice.Uniq())
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), slice)
+}
+
+// UniqM
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_UniqM() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	fmt.Println(slice.UniqM())
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z]
+}
+
+func TestTimeSlice_UniqM(t *testing.T) {
+	assert.Equal(t, (*TimeSlice)(nil), (*TimeSlice)(nil).UniqM())
+
+	slice := NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), slice.UniqM())
+	assert.Equal(t, NewTimeSliceV(time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()), slice)
+}
+
+// Window
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Window() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC())
+	slice.Window(2, 1).Each(func(x O) {
+		fmt.Print(x)
+	})
+	// Output: [1970-01-01T00:00:00Z 1970-01-01T00:01:00Z][1970-01-01T00:01:00Z 1970-01-01T00:00:00Z]
+}
+
+func TestTimeSlice_Window(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, 0, slice.Window(2, 1).Len())
+	assert.Equal(t, 0, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).Window(0, 1).Len())
+	assert.Equal(t, 0, NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()).Window(3, 1).Len())
+
+	windows := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()).Window(2, 1)
+	assert.Equal(t, 2, windows.Len())
+	assert.Equal(t, []time.Time{time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()}, windows.At(0).O().(ISlice).O())
+	assert.Equal(t, []time.Time{time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()}, windows.At(1).O().(ISlice).O())
+}
+
+// Zip
+//--------------------------------------------------------------------------------------------------
+func ExampleTimeSlice_Zip() {
+	slice := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC())
+	pair := slice.Zip([]string{"a", "b"}).At(1).O().(ISlice)
+	fmt.Println(pair.At(0).O(), pair.At(1).O())
+	// Output: 1970-01-01 00:01:00 +0000 UTC b
+}
+
+func TestTimeSlice_Zip(t *testing.T) {
+	var slice *TimeSlice
+	assert.Equal(t, 0, slice.Zip([]string{"a"}).Len())
+	assert.Equal(t, 0, NewTimeSliceV(time.Unix(0, 0).UTC()).Zip(nil).Len())
+
+	pairs := NewTimeSliceV(time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC(), time.Unix(0, 0).UTC()).Zip([]string{"a", "b"})
+	assert.Equal(t, 2, pairs.Len())
+	assert.Equal(t, []interface{}{time.Unix(0, 0).UTC(), "a"}, pairs.At(0).O().(ISlice).O())
+	assert.Equal(t, []interface{}{time.Unix(60, 0).UTC(), "b"}, pairs.At(1).O().(ISlice).O())
+}
@@ -1,11 +1,13 @@
 package n
 
 import (
+	"context"
 	"regexp"
 	"sort"
 	"strings"
 	"unicode"
 
+	"github.com/phR0ze/n/pkg/sys"
 	"github.com/pkg/errors"
 )
 
@@ -211,7 +213,9 @@ func (p *Str) AsciiOnly() bool {
 	return len(*p) == len(ReGraphicalOnly.ReplaceAllString(string(*p), ""))
 }
 
-// At returns the element at the given index location. Allows for negative notation.
+// At returns the element at the given index location. Allows for negative notation. Indices are
+// rune based to agree with Len and the rest of the Slice interface so a combining mark is returned
+// on its own, use Graphemes to index user perceived characters.
 func (p *Str) At(i int) (elem *Object) {
 	elem = &Object{}
 	if p == nil {
@@ -232,11 +236,82 @@ func (p *Str) B() []byte {
 	return []byte(string(*p))
 }
 
+// BinarySearch searches this already sorted Slice for the given element returning its index and
+// true if found or the index it would be inserted at and false if not.
+func (p *Str) BinarySearch(elem interface{}) (i int, found bool) {
+	if p == nil || len(*p) == 0 {
+		return
+	}
+	x := ToChar(elem).G()
+	i = sort.Search(len(*p), func(i int) bool {
+		return (*p)[i] >= x
+	})
+	found = i < len(*p) && (*p)[i] == x
+	return
+}
+
 // C exports the Str as a Char
 func (p *Str) C() *Char {
 	return NewChar(p)
 }
 
+// CamelCase converts this Str into lower camel case e.g. "http server_name" becomes
+// "httpServerName". Words are split on anything other than letters and digits, on lower to upper
+// case transitions and at the end of acronyms.
+func (p *Str) CamelCase() (new *Str) {
+	if p == nil {
+		return NewStrV()
+	}
+	return caseJoin(*p, "", caseCamel)
+}
+
+// Captures returns the named capture groups of the first match of the given regular expression in
+// this Str as a map of group name to the captured string. Groups that didn't participate in the
+// match are empty and an empty map is returned when there is no match or the expression is invalid.
+// Supports string, Str, *Str or *regexp.Regexp expressions, see CompileRegex for caching.
+func (p *Str) Captures(exp interface{}) (m *StringMap) {
+	m, _ = p.CapturesE(exp)
+	return
+}
+
+// CapturesE returns the named capture groups of the first match of the given regular expression in
+// this Str as a map of group name to the captured string. Groups that didn't participate in the
+// match are empty and an empty map is returned when there is no match.
+// Supports string, Str, *Str or *regexp.Regexp expressions, see CompileRegex for caching.
+func (p *Str) CapturesE(exp interface{}) (m *StringMap, err error) {
+	m = NewStringMapV()
+	var rx *regexp.Regexp
+	if rx, err = toRegex(exp); err != nil || p == nil {
+		return
+	}
+	if match := rx.FindStringSubmatch(p.A()); match != nil {
+		for i, name := range rx.SubexpNames() {
+			if name != "" {
+				(*m)[name] = match[i]
+			}
+		}
+	}
+	return
+}
+
+// Chunk creates a new slice of Slices each holding n elements copied from this Slice in order.
+// The last chunk will hold the remaining elements if the length isn't evenly divisible by n.
+// An empty slice is returned if n is less than 1.
+func (p *Str) Chunk(n int) (chunks ISlice) {
+	x := []interface{}{}
+	if p == nil || len(*p) == 0 || n < 1 {
+		return NewInterSliceV(x...)
+	}
+	for i := 0; i < len(*p); i += n {
+		j := i + n
+		if j > len(*p) {
+			j = len(*p)
+		}
+		x = append(x, p.Copy(i, j-1))
+	}
+	return NewInterSliceV(x...)
+}
+
 // Clear modifies this Slice to clear out all elements and returns a reference to this Slice.
 func (p *Str) Clear() ISlice {
 	if p == nil {
@@ -344,6 +419,29 @@ func (p *Str) CountW(sel func(O) bool) (cnt int) {
 	return
 }
 
+// Damerau returns the Damerau-Levenshtein distance between this Str and the given string i.e. the
+// minimum number of rune insertions, deletions, substitutions and transpositions of adjacent runes
+// required to change one into the other.
+func (p *Str) Damerau(str interface{}) int {
+	return damerau(p.runes(), *ToStr(str))
+}
+
+// Difference returns a new Slice with the uniq elements of this Slice that are not in the given Slice
+// while preserving order.
+// Supports Str, *Str, string, *string, []rune or *[]rune
+func (p *Str) Difference(slice interface{}) (new ISlice) {
+	other := ToStr(slice)
+	set, uniq := NewSet(other), NewSet(p)
+	return p.Select(func(x O) bool { return !set.Contains(x) && uniq.Remove(x) })
+}
+
+// Disjoint checks if this Slice has no elements in common with the given Slice.
+// Supports Str, *Str, string, *string, []rune or *[]rune
+func (p *Str) Disjoint(slice interface{}) bool {
+	other := ToStr(slice)
+	return NewSet(p).Disjoint(other)
+}
+
 // Drop modifies this Slice to delete the indicated range of elements and returns a referece to this Slice.
 // Expects nothing, in which case everything is dropped, or two indices i and j, in which case positive and
 // negative notation is supported and uses an inclusive behavior such that DropAt(0, -1) includes index -1
@@ -472,6 +570,21 @@ func (p *Str) EachIE(action func(int, O) error) (ISlice, error) {
 	return p, err
 }
 
+// EachP calls the given lambda once for each element in this Slice, passing in the context and
+// element as parameters, fanning the calls out to the given number of workers or runtime.NumCPU()
+// workers if less than 1. Element will be a *Char. The first error from the lambda or the given
+// context cancels the context passed to the lambda and skips the remaining elements. Returns a
+// reference to this Slice and the first error.
+func (p *Str) EachP(ctx context.Context, workers int, action func(context.Context, O) error) (ISlice, error) {
+	if p == nil {
+		return p, nil
+	}
+	err := eachP(ctx, len(*p), workers, func(ctx context.Context, i int) error {
+		return action(ctx, ToChar((*p)[i]))
+	})
+	return p, err
+}
+
 // EachR calls the given lambda once for each element in this Slice in reverse, passing in that element
 // as a parameter. Element will be a *Char. Returns a reference to this Slice
 func (p *Str) EachR(action func(O)) ISlice {
@@ -542,6 +655,19 @@ func (p *Str) Equal(val interface{}) bool {
 	return string(*p) == string(*ToStr(val))
 }
 
+// EqualFold tests if this Str is equal to the given string under full Unicode case folding and
+// canonical equivalence e.g. "Straße" equals "STRASSE" and a precomposed 'é' equals 'e' followed
+// by a combining acute accent.
+func (p *Str) EqualFold(val interface{}) bool {
+	var x []rune
+	if p != nil {
+		x = *p
+	}
+	a := decomposeRunes(foldRunes(decomposeRunes(x)))
+	b := decomposeRunes(foldRunes(decomposeRunes(*ToStr(val))))
+	return string(a) == string(b)
+}
+
 // Fields splits the Str around each instance of one or more consecutive white space characters
 // as defined by unicode.IsSpace, returning a Slice of substrings or an empty Slice if only
 // white space is found or the Str is nil or empty.
@@ -593,11 +719,73 @@ func (p *Str) FirstN(n int) ISlice {
 	return p.Slice(0, abs(n)-1)
 }
 
+// FlatMap creates a new slice with the modified elements from the lambda expanding any slice
+// results one level into the new slice.
+func (p *Str) FlatMap(mod func(O) O) ISlice {
+	if p == nil || len(*p) == 0 {
+		return NewStrV()
+	}
+	x := []interface{}{}
+	for i := range *p {
+		x = flatAppend(x, mod((*p)[i]))
+	}
+	return newSliceOf(x, NewStrV())
+}
+
+// Flatten returns a new Slice with any nested slice elements expanded one level into it.
+// The elements of this Slice can't be slices so it is the same as Copy.
+func (p *Str) Flatten() (new ISlice) {
+	return p.Copy()
+}
+
+// FuzzyScore scores how well the given query matches this Str as a case insensitive subsequence
+// returning false if it doesn't match at all. Higher scores are better matches with consecutive
+// runes and runes at the start of words e.g. after separators or at camel case transitions
+// scoring higher. An empty query matches everything with a zero score.
+func (p *Str) FuzzyScore(query interface{}) (score int, ok bool) {
+	return fuzzyScore(p.runes(), *ToStr(query))
+}
+
 // G returns the underlying data structure as a builtin Go type
 func (p *Str) G() string {
 	return p.O().(string)
 }
 
+// Graphemes splits this Str into its user perceived characters i.e. grapheme clusters returning
+// a Slice of substrings e.g. a base letter with its combining marks, a flag or an emoji ZWJ
+// sequence will each be a single element.
+func (p *Str) Graphemes() (new *StringSlice) {
+	new = NewStringSliceV()
+	if p == nil {
+		return
+	}
+	for i := 0; i < len(*p); {
+		j := graphemeEnd(*p, i)
+		*new = append(*new, string((*p)[i:j]))
+		i = j
+	}
+	return
+}
+
+// GroupBy creates a new map of Slices with the elements of this Slice grouped by the key the
+// lambda returns for them. Element will be a *Char. Keys are converted to strings and kept in the order they are
+// first seen while the elements keep their order in each group.
+func (p *Str) GroupBy(key func(O) O) (groups IMap) {
+	m := NewOrderedMapV()
+	if p == nil || len(*p) == 0 {
+		return m
+	}
+	for i := range *p {
+		k := ToString(key(ToChar((*p)[i])))
+		if group, ok := m.m[k]; ok {
+			*group.(*Str) = append(*group.(*Str), (*p)[i])
+		} else {
+			m.Set(k, &Str{(*p)[i]})
+		}
+	}
+	return m
+}
+
 // HasAnyPrefix checks if the string has any of the given prefixes
 func (p *Str) HasAnyPrefix(prefixes interface{}) bool {
 	if p == nil || len(*p) == 0 {
@@ -752,6 +940,56 @@ func (p *Str) InterSlice() bool {
 	return false
 }
 
+// Intersect returns a new Slice with the uniq elements of this Slice that are also in the given Slice
+// while preserving order.
+// Supports Str, *Str, string, *string, []rune or *[]rune
+func (p *Str) Intersect(slice interface{}) (new ISlice) {
+	other := ToStr(slice)
+	set, uniq := NewSet(other), NewSet(p)
+	return p.Select(func(x O) bool { return set.Contains(x) && uniq.Remove(x) })
+}
+
+// Iter returns an Iterator over the elements of this Slice in order. Element will be a *Char.
+func (p *Str) Iter() (it Iterator) {
+	i := 0
+	return NewIterator(func() (O, bool) {
+		if p == nil || i >= len(*p) {
+			return nil, false
+		}
+		i++
+		return ToChar((*p)[i-1]), true
+	})
+}
+
+// IterGraphemes returns an Iterator over the user perceived characters i.e. grapheme clusters of
+// this Str in order e.g. a base letter with its combining marks or an emoji ZWJ sequence. Element
+// will be a string.
+func (p *Str) IterGraphemes() (it Iterator) {
+	i := 0
+	return NewIterator(func() (O, bool) {
+		if p == nil || i >= len(*p) {
+			return nil, false
+		}
+		j := graphemeEnd(*p, i)
+		elem := string((*p)[i:j])
+		i = j
+		return elem, true
+	})
+}
+
+// IsSubset checks if all elements of this Slice are in the given Slice.
+// Supports Str, *Str, string, *string, []rune or *[]rune
+func (p *Str) IsSubset(slice interface{}) bool {
+	other := ToStr(slice)
+	return NewSet(p).IsSubset(other)
+}
+
+// JaroWinkler returns the Jaro-Winkler similarity between this Str and the given string ranging
+// from 0 for no similarity to 1 for an exact match. Strings sharing a common prefix are favored.
+func (p *Str) JaroWinkler(str interface{}) float64 {
+	return jaroWinkler(p.runes(), *ToStr(str))
+}
+
 // Join converts each element into a string then joins them together using the given separator or comma by default.
 func (p *Str) Join(separator ...string) (str *Object) {
 	if p == nil || len(*p) == 0 {
@@ -774,6 +1012,15 @@ func (p *Str) Join(separator ...string) (str *Object) {
 	return
 }
 
+// KebabCase converts this Str into lower case words joined by hyphens e.g. "HTTPServerName"
+// becomes "http-server-name". Words are split the same as CamelCase.
+func (p *Str) KebabCase() (new *Str) {
+	if p == nil {
+		return NewStrV()
+	}
+	return caseJoin(*p, "-", caseLower)
+}
+
 // Last returns the last element in this Slice as an Object.
 // Object.Nil() == true will be returned if there are no elements in the slice.
 func (p *Str) Last() (elem *Object) {
@@ -841,6 +1088,13 @@ func (p *Str) Less(i, j int) bool {
 	return ToChar((*p)[i]).Less((*p)[j])
 }
 
+// Levenshtein returns the Levenshtein distance between this Str and the given string i.e. the
+// minimum number of rune insertions, deletions and substitutions required to change one into the
+// other.
+func (p *Str) Levenshtein(str interface{}) int {
+	return levenshtein(p.runes(), *ToStr(str))
+}
+
 // Map creates a new slice with the modified elements from the lambda.
 func (p *Str) Map(mod func(O) O) ISlice {
 	var slice ISlice
@@ -858,6 +1112,72 @@ func (p *Str) Map(mod func(O) O) ISlice {
 	return slice
 }
 
+// MapP creates a new slice with the modified elements from the lambda, fanning the calls out to
+// the given number of workers or runtime.NumCPU() workers if less than 1 while preserving the
+// element order. The first error from the lambda or the given context cancels the context passed
+// to the lambda and skips the remaining elements. Returns an empty Slice and the first error on
+// failure.
+func (p *Str) MapP(ctx context.Context, workers int, mod func(context.Context, O) (O, error)) (ISlice, error) {
+	if p == nil || len(*p) == 0 {
+		return NewStrV(), nil
+	}
+	results := make([]interface{}, len(*p))
+	err := eachP(ctx, len(results), workers, func(ctx context.Context, i int) (err error) {
+		results[i], err = mod(ctx, (*p)[i])
+		return
+	})
+	if err != nil {
+		return NewStrV(), err
+	}
+	slice := Slice(results[0])
+	for i := 1; i < len(results); i++ {
+		slice.Append(results[i])
+	}
+	return slice, nil
+}
+
+// Match returns the first match of the given regular expression in this Str or an empty Str when
+// there is no match or the expression is invalid.
+// Supports string, Str, *Str or *regexp.Regexp expressions, see CompileRegex for caching.
+func (p *Str) Match(exp interface{}) (match *Str) {
+	match, _ = p.MatchE(exp)
+	return
+}
+
+// MatchE returns the first match of the given regular expression in this Str or an empty Str when
+// there is no match.
+// Supports string, Str, *Str or *regexp.Regexp expressions, see CompileRegex for caching.
+func (p *Str) MatchE(exp interface{}) (match *Str, err error) {
+	match = NewStrV()
+	var rx *regexp.Regexp
+	if rx, err = toRegex(exp); err != nil || p == nil {
+		return
+	}
+	match = A(rx.FindString(p.A()))
+	return
+}
+
+// MatchAll returns all successive non overlapping matches of the given regular expression in this
+// Str or an empty Slice when there are no matches or the expression is invalid.
+// Supports string, Str, *Str or *regexp.Regexp expressions, see CompileRegex for caching.
+func (p *Str) MatchAll(exp interface{}) (matches *StringSlice) {
+	matches, _ = p.MatchAllE(exp)
+	return
+}
+
+// MatchAllE returns all successive non overlapping matches of the given regular expression in this
+// Str or an empty Slice when there are no matches.
+// Supports string, Str, *Str or *regexp.Regexp expressions, see CompileRegex for caching.
+func (p *Str) MatchAllE(exp interface{}) (matches *StringSlice, err error) {
+	matches = NewStringSliceV()
+	var rx *regexp.Regexp
+	if rx, err = toRegex(exp); err != nil || p == nil {
+		return
+	}
+	*matches = append(*matches, rx.FindAllString(p.A(), -1)...)
+	return
+}
+
 // Nil tests if this Slice is nil
 func (p *Str) Nil() bool {
 	if p == nil {
@@ -866,6 +1186,16 @@ func (p *Str) Nil() bool {
 	return false
 }
 
+// Normalize returns a new Str in the given Unicode normalization form i.e. NFC or NFD such that
+// canonically equivalent strings have the same runes.
+func (p *Str) Normalize(form NormForm) (new *Str) {
+	if p == nil {
+		return NewStrV()
+	}
+	x := Str(normalize(*p, form))
+	return &x
+}
+
 // O returns the underlying data structure as is
 func (p *Str) O() interface{} {
 	if p == nil {
@@ -889,6 +1219,42 @@ func (p *Str) Pair() (first, second *Object) {
 	return
 }
 
+// Partition creates two new slices the first with the elements that match the lambda selector
+// and the second with the elements that don't while preserving element order. Element will be a *Char.
+func (p *Str) Partition(sel func(O) bool) (match, rest ISlice) {
+	x, y := NewStrV(), NewStrV()
+	if p == nil || len(*p) == 0 {
+		return x, y
+	}
+	for i := range *p {
+		if sel(ToChar((*p)[i])) {
+			*x = append(*x, (*p)[i])
+		} else {
+			*y = append(*y, (*p)[i])
+		}
+	}
+	return x, y
+}
+
+// PascalCase converts this Str into upper camel case e.g. "http server_name" becomes
+// "HttpServerName". Words are split the same as CamelCase.
+func (p *Str) PascalCase() (new *Str) {
+	if p == nil {
+		return NewStrV()
+	}
+	return caseJoin(*p, "", caseTitle)
+}
+
+// Pluralize returns a new Str with the last word of this Str converted to its English plural form
+// keeping its case e.g. "UserCategory" becomes "UserCategories" and "person" becomes "people".
+func (p *Str) Pluralize() (new *Str) {
+	if p == nil {
+		return NewStrV()
+	}
+	x := Str(inflect(*p, pluralize))
+	return &x
+}
+
 // Pop modifies this Slice to remove the last element and returns the removed element as an Object.
 func (p *Str) Pop() (elem *Object) {
 	elem = p.Last()
@@ -944,7 +1310,39 @@ func (p *Str) ReplaceAll(old, new interface{}) *Str {
 	return ToStr(strings.ReplaceAll(str, x, y))
 }
 
-// Reverse returns a new Slice with the order of the elements reversed.
+// ReplaceRegex returns a new Str with all matches of the given regular expression replaced. The
+// replacement may be a func(string) string lambda called with each match or a template string in
+// which $1 or ${name} are expanded to the matching capture groups. A copy of this Str is returned
+// if the expression is invalid.
+// Supports string, Str, *Str or *regexp.Regexp expressions, see CompileRegex for caching.
+func (p *Str) ReplaceRegex(exp, repl interface{}) (new *Str) {
+	new, err := p.ReplaceRegexE(exp, repl)
+	if err != nil && p != nil {
+		new = A(p.A())
+	}
+	return
+}
+
+// ReplaceRegexE returns a new Str with all matches of the given regular expression replaced. The
+// replacement may be a func(string) string lambda called with each match or a template string in
+// which $1 or ${name} are expanded to the matching capture groups.
+// Supports string, Str, *Str or *regexp.Regexp expressions, see CompileRegex for caching.
+func (p *Str) ReplaceRegexE(exp, repl interface{}) (new *Str, err error) {
+	new = NewStrV()
+	var rx *regexp.Regexp
+	if rx, err = toRegex(exp); err != nil || p == nil {
+		return
+	}
+	if f, ok := repl.(func(string) string); ok {
+		new = A(rx.ReplaceAllStringFunc(p.A(), f))
+	} else {
+		new = A(rx.ReplaceAllString(p.A(), ToString(repl)))
+	}
+	return
+}
+
+// Reverse returns a new Slice with the order of the elements reversed. Grapheme clusters are
+// kept intact e.g. an 'e' followed by a combining acute accent stays in that order.
 func (p *Str) Reverse() (new ISlice) {
 	if p == nil || len(*p) < 2 {
 		return p.Copy()
@@ -953,13 +1351,19 @@ func (p *Str) Reverse() (new ISlice) {
 }
 
 // ReverseM modifies this Slice reversing the order of the elements and returns a reference to this Slice.
+// Grapheme clusters are kept intact e.g. an 'e' followed by a combining acute accent stays in that order.
 func (p *Str) ReverseM() ISlice {
 	if p == nil || len(*p) == 0 {
 		return p
 	}
-	for i, j := 0, len(*p)-1; i < j; i, j = i+1, j-1 {
-		p.Swap(i, j)
+	x, k := make([]rune, len(*p)), len(*p)
+	for i := 0; i < len(*p); {
+		j := graphemeEnd(*p, i)
+		k -= j - i
+		copy(x[k:], (*p)[i:j])
+		i = j
 	}
+	copy(*p, x)
 	return p
 }
 
@@ -968,6 +1372,16 @@ func (p *Str) S() (slice *StringSlice) {
 	return ToStringSlice(p.O())
 }
 
+// ScreamingSnake converts this Str into upper case words joined by underscores e.g.
+// "httpServerName" becomes "HTTP_SERVER_NAME" which is useful for environment variable names.
+// Words are split the same as CamelCase.
+func (p *Str) ScreamingSnake() (new *Str) {
+	if p == nil {
+		return NewStrV()
+	}
+	return caseJoin(*p, "_", caseUpper)
+}
+
 // Select creates a new slice with the elements that match the lambda selector.
 func (p *Str) Select(sel func(O) bool) (new ISlice) {
 	slice := NewStrV()
@@ -982,6 +1396,31 @@ func (p *Str) Select(sel func(O) bool) (new ISlice) {
 	return slice
 }
 
+// SelectP creates a new slice with the elements that match the lambda selector, fanning the calls
+// out to the given number of workers or runtime.NumCPU() workers if less than 1 while preserving
+// the element order. Element will be a *Char. The first error from the lambda or the given context
+// cancels the context passed to the lambda and skips the remaining elements. Returns an empty
+// Slice and the first error on failure.
+func (p *Str) SelectP(ctx context.Context, workers int, sel func(context.Context, O) (bool, error)) (new ISlice, err error) {
+	slice := NewStrV()
+	if p == nil || len(*p) == 0 {
+		return slice, nil
+	}
+	hits := make([]bool, len(*p))
+	if err = eachP(ctx, len(hits), workers, func(ctx context.Context, i int) (err error) {
+		hits[i], err = sel(ctx, ToChar((*p)[i]))
+		return
+	}); err != nil {
+		return slice, err
+	}
+	for i := range hits {
+		if hits[i] {
+			*slice = append(*slice, (*p)[i])
+		}
+	}
+	return slice, nil
+}
+
 // Set the element(s) at the given index location to the given element(s). Allows for negative notation.
 // Returns a reference to this Slice and swallows any errors.
 func (p *Str) Set(i int, elem interface{}) ISlice {
@@ -1009,6 +1448,13 @@ func (p *Str) SetE(i int, elems interface{}) (ISlice, error) {
 	return p, err
 }
 
+// ShellQuote returns a new Str quoted such that a POSIX shell will treat it as a single word.
+// Strs of only safe characters are returned as is, otherwise it is wrapped in single quotes with
+// any single quotes escaped e.g. "it's" becomes 'it'"'"'s'. See sys.ShellQuote.
+func (p *Str) ShellQuote() (new *Str) {
+	return A(sys.ShellQuote(p.A()))
+}
+
 // Shift modifies this Slice to remove the first element and returns the removed element as an Object.
 func (p *Str) Shift() (elem *Object) {
 	elem = p.First()
@@ -1026,11 +1472,44 @@ func (p *Str) ShiftN(n int) (new ISlice) {
 	return
 }
 
+// Shlex splits this Str into args using POSIX shell word splitting rules without any expansions
+// i.e. single and double quotes, backslash escapes and comments are handled the same as sh would
+// and the quotes removed. An empty Slice is returned if the quotes are unterminated.
+// See sys.Shlex for details.
+func (p *Str) Shlex() (slice *StringSlice) {
+	slice, _ = p.ShlexE()
+	return
+}
+
+// ShlexE splits this Str into args using POSIX shell word splitting rules without any expansions
+// i.e. single and double quotes, backslash escapes and comments are handled the same as sh would
+// and the quotes removed. An error is returned if the quotes are unterminated.
+// See sys.Shlex for details.
+func (p *Str) ShlexE() (slice *StringSlice, err error) {
+	slice = NewStringSliceV()
+	var args []string
+	if args, err = sys.Shlex(p.A()); err != nil {
+		return
+	}
+	*slice = append(*slice, args...)
+	return
+}
+
 // Single reports true if there is only one element in this Slice.
 func (p *Str) Single() bool {
 	return p.Len() == 1
 }
 
+// Singularize returns a new Str with the last word of this Str converted to its English singular
+// form keeping its case e.g. "UserCategories" becomes "UserCategory" and "people" becomes "person".
+func (p *Str) Singularize() (new *Str) {
+	if p == nil {
+		return NewStrV()
+	}
+	x := Str(inflect(*p, singularize))
+	return &x
+}
+
 // Slice returns a range of elements from this Slice as a Slice reference to the original. Allows for negative notation.
 // Expects nothing, in which case everything is included, or two indices i and j, in which case an inclusive behavior
 // is used such that Slice(0, -1) includes index -1 as opposed to Go's exclusive behavior. Out of bounds indices will
@@ -1053,6 +1532,25 @@ func (p *Str) Slice(indices ...int) ISlice {
 	return ToStr((*p)[i:j])
 }
 
+// Slugify converts this Str into a URL and file name safe slug of lower case ASCII words joined by
+// hyphens transliterating accented Latin letters e.g. "Héllo Wörld!" becomes "hello-world". Words
+// are split the same as CamelCase and anything that isn't ASCII after transliteration is dropped.
+func (p *Str) Slugify() (new *Str) {
+	if p == nil {
+		return NewStrV()
+	}
+	return caseJoin(*A(string(transliterate(*p))).Ascii(), "-", caseLower)
+}
+
+// SnakeCase converts this Str into lower case words joined by underscores e.g. "HTTPServerName"
+// becomes "http_server_name". Words are split the same as CamelCase.
+func (p *Str) SnakeCase() (new *Str) {
+	if p == nil {
+		return NewStrV()
+	}
+	return caseJoin(*p, "_", caseLower)
+}
+
 // Sort returns a new Slice with sorted elements.
 func (p *Str) Sort() (new ISlice) {
 	if p == nil || len(*p) < 2 {
@@ -1061,6 +1559,26 @@ func (p *Str) Sort() (new ISlice) {
 	return p.Copy().SortM()
 }
 
+// SortBy returns a new Slice with the elements sorted using the given less lambda. Element will be a *Char.
+func (p *Str) SortBy(less func(a, b O) bool) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortByM(less)
+}
+
+// SortByM modifies this Slice sorting the elements using the given less lambda and returns a
+// reference to this Slice. Element will be a *Char.
+func (p *Str) SortByM(less func(a, b O) bool) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sort.Slice(*p, func(i, j int) bool {
+		return less(ToChar((*p)[i]), ToChar((*p)[j]))
+	})
+	return p
+}
+
 // SortM modifies this Slice sorting the elements and returns a reference to this Slice.
 func (p *Str) SortM() ISlice {
 	if p == nil || len(*p) < 2 {
@@ -1087,6 +1605,27 @@ func (p *Str) SortReverseM() ISlice {
 	return p
 }
 
+// SortStable returns a new Slice with the elements sorted using the given less lambda while
+// keeping equal elements in their original order. Element will be a *Char.
+func (p *Str) SortStable(less func(a, b O) bool) (new ISlice) {
+	if p == nil || len(*p) < 2 {
+		return p.Copy()
+	}
+	return p.Copy().SortStableM(less)
+}
+
+// SortStableM modifies this Slice sorting the elements using the given less lambda while keeping
+// equal elements in their original order and returns a reference to this Slice. Element will be a *Char.
+func (p *Str) SortStableM(less func(a, b O) bool) ISlice {
+	if p == nil || len(*p) < 2 {
+		return p
+	}
+	sort.SliceStable(*p, func(i, j int) bool {
+		return less(ToChar((*p)[i]), ToChar((*p)[j]))
+	})
+	return p
+}
+
 // Split this Str into all substrings deliniated by separator and returns a slice of the
 // substrings. If Str does not contain separator, Split returns a slice of length 1 whose
 // only element is Str. If Str is empty, Split returns an empty slice. separator defaults
@@ -1120,7 +1659,8 @@ func (p *Str) SplitAfter(separator ...string) (slice *StringSlice) {
 // SplitQuotes splits this Str into substrings starting and ending with double quotes and
 // returns a slice of the substrings. If Str does not contain quotes, Split returns
 // a slice of length 1 whose only element is Str. If Str is empty, Split returns an empty
-// slice. Unmatched quotes throw and error and empty quotes are removed.
+// slice. Unmatched quotes throw and error and empty quotes are removed. See Shlex for POSIX
+// shell style splitting.
 func (p *Str) SplitQuotes() (slice *StringSlice, err error) {
 	sep := '"'
 	if p == nil || len(*p) == 0 {
@@ -1175,6 +1715,27 @@ func (p *Str) SplitQuotes() (slice *StringSlice, err error) {
 	return
 }
 
+// SplitRegex splits this Str into the substrings between the matches of the given regular
+// expression. An empty Slice is returned if this Str is empty or the expression is invalid.
+// Supports string, Str, *Str or *regexp.Regexp expressions, see CompileRegex for caching.
+func (p *Str) SplitRegex(exp interface{}) (slice *StringSlice) {
+	slice, _ = p.SplitRegexE(exp)
+	return
+}
+
+// SplitRegexE splits this Str into the substrings between the matches of the given regular
+// expression. An empty Slice is returned if this Str is empty.
+// Supports string, Str, *Str or *regexp.Regexp expressions, see CompileRegex for caching.
+func (p *Str) SplitRegexE(exp interface{}) (slice *StringSlice, err error) {
+	slice = NewStringSliceV()
+	var rx *regexp.Regexp
+	if rx, err = toRegex(exp); err != nil || p == nil || len(*p) == 0 {
+		return
+	}
+	*slice = append(*slice, rx.Split(p.A(), -1)...)
+	return
+}
+
 // String returns a string representation of this Slice, implements the Stringer interface
 func (p *Str) String() string {
 	if p == nil {
@@ -1191,6 +1752,15 @@ func (p *Str) Swap(i, j int) {
 	(*p)[i], (*p)[j] = (*p)[j], (*p)[i]
 }
 
+// SymmetricDifference returns a new Slice with the uniq elements of this Slice that are not in the
+// given Slice followed by the uniq elements of the given Slice that are not in this Slice while
+// preserving order.
+// Supports Str, *Str, string, *string, []rune or *[]rune
+func (p *Str) SymmetricDifference(slice interface{}) (new ISlice) {
+	other := ToStr(slice)
+	return p.Difference(other).ConcatM(other.Difference(p))
+}
+
 // Take modifies this Slice removing the indicated range of elements from this Slice and returning them as a new Slice.
 // Expects nothing, in which case everything is taken, or two indices i and j, in which case positive and negative
 // notation is supported and uses an inclusive behavior such that Take(0, -1) includes index -1 as opposed to Go's
@@ -1252,14 +1822,24 @@ func (p *Str) ToStrs() (slice []string) {
 	return ToStrs(p.O())
 }
 
-//Title returns a copy of the string s with all Unicode letters that begin words mapped to their title case.
-// BUG(rsc): The rule Title uses for word boundaries does not handle Unicode punctuation properly.
-// Pass through for strings.Title
+// Title returns a copy of the Str with all Unicode letters that begin words mapped to their title
+// case. Words are separated by anything other than letters, marks, digits, underscores and
+// apostrophes e.g. "don't stop" becomes "Don't Stop".
 func (p *Str) Title() (new *Str) {
+	new = NewStrV()
 	if p == nil {
-		return NewStrV()
+		return
 	}
-	return NewStr(strings.Title(p.A()))
+	prev := ' '
+	for _, r := range *p {
+		if !titleWordRune(prev) {
+			*new = append(*new, unicode.ToTitle(r))
+		} else {
+			*new = append(*new, r)
+		}
+		prev = r
+	}
+	return
 }
 
 // ToLower returns a copy of the Str with all Unicode letters mapped to their lower case.
@@ -1361,6 +1941,36 @@ func (p *Str) TrimSuffix(suffix interface{}) (new *Str) {
 	return NewStr(strings.TrimSuffix(p.A(), x))
 }
 
+// Truncate returns a new Str that fits within the given display width, as measured by Width,
+// cutting on grapheme cluster boundaries and ending with the given tail e.g. "…" when truncated.
+// The tail is dropped if it doesn't fit within the width on its own.
+func (p *Str) Truncate(width int, tail string) (new *Str) {
+	new = NewStrV()
+	if p == nil || width <= 0 {
+		return
+	}
+	if p.Width() <= width {
+		*new = append(*new, *p...)
+		return
+	}
+
+	tailStr := Str(tail)
+	limit := width - tailStr.Width()
+	if limit < 0 {
+		limit, tailStr = width, Str{}
+	}
+	for i, w := 0, 0; i < len(*p); {
+		j := graphemeEnd(*p, i)
+		if w += graphemeWidth((*p)[i:j]); w > limit {
+			break
+		}
+		*new = append(*new, (*p)[i:j]...)
+		i = j
+	}
+	*new = append(*new, tailStr...)
+	return
+}
+
 // Union returns a new Slice by joining uniq elements from this Slice with uniq elements from the given Slice while preserving order.
 // Supports Str, *Str, []string or *[]string
 func (p *Str) Union(slice interface{}) (new ISlice) {
@@ -1406,3 +2016,93 @@ func (p *Str) UniqM() ISlice {
 	}
 	return p
 }
+
+// Window creates a new slice of Slices each holding n consecutive elements copied from this Slice
+// starting at every step elements i.e. a sliding window. Only full windows are included and an
+// empty slice is returned if n or step are less than 1.
+func (p *Str) Window(n, step int) (windows ISlice) {
+	x := []interface{}{}
+	if p == nil || len(*p) == 0 || n < 1 || step < 1 {
+		return NewInterSliceV(x...)
+	}
+	for i := 0; i+n <= len(*p); i += step {
+		x = append(x, p.Copy(i, i+n-1))
+	}
+	return NewInterSliceV(x...)
+}
+
+// Width returns the display width of this Str in terminal cells e.g. East Asian wide characters
+// and emoji take 2 cells while combining marks and other zero width characters take none.
+func (p *Str) Width() (width int) {
+	if p == nil {
+		return
+	}
+	for i := 0; i < len(*p); {
+		j := graphemeEnd(*p, i)
+		width += graphemeWidth((*p)[i:j])
+		i = j
+	}
+	return
+}
+
+// Wrap word wraps this Str to fit within the given display width, as measured by Width, breaking
+// lines on white space while keeping existing line breaks. The optional indent prefixes every line
+// and an optional second indent replaces it for all but the first line of each paragraph i.e. a
+// hanging indent. Indents count towards the width, words too long to fit are kept whole on their
+// own line and a width less than 1 disables wrapping.
+func (p *Str) Wrap(width int, indent ...string) (new *Str) {
+	if p == nil || len(*p) == 0 {
+		return NewStrV()
+	}
+	first, rest := "", ""
+	if len(indent) > 0 {
+		first, rest = indent[0], indent[0]
+	}
+	if len(indent) > 1 {
+		rest = indent[1]
+	}
+
+	lines := []string{}
+	for _, paragraph := range strings.Split(p.A(), "\n") {
+		line, lineWidth := "", 0
+		for _, word := range strings.Fields(paragraph) {
+			wordWidth := A(word).Width()
+			if line != "" && (width < 1 || lineWidth+1+wordWidth <= width) {
+				line, lineWidth = line+" "+word, lineWidth+1+wordWidth
+				continue
+			}
+			prefix := first
+			if line != "" {
+				lines, prefix = append(lines, line), rest
+			}
+			line, lineWidth = prefix+word, A(prefix).Width()+wordWidth
+		}
+		lines = append(lines, line)
+	}
+	return A(strings.Join(lines, "\n"))
+}
+
+// Zip creates a new slice of pairs, as InterSlices, combining each element of this Slice with
+// the element at the same index in the given Slice. The result is as long as the shorter Slice. Element will be a *Char.
+func (p *Str) Zip(slice interface{}) (new ISlice) {
+	x := []interface{}{}
+	other, ok := slice.(ISlice)
+	if !ok {
+		other = Slice(slice)
+	}
+	if p == nil || len(*p) == 0 || other.Len() == 0 {
+		return NewInterSliceV(x...)
+	}
+	for i := 0; i < len(*p) && i < other.Len(); i++ {
+		x = append(x, NewInterSliceV(ToChar((*p)[i]), other.At(i).O()))
+	}
+	return NewInterSliceV(x...)
+}
+
+// runes returns the underlying runes of this Str or nil
+func (p *Str) runes() []rune {
+	if p == nil {
+		return nil
+	}
+	return *p
+}
@@ -1,10 +1,15 @@
 package n
 
 import (
+	"context"
 	"fmt"
+	"math/rand"
+	"regexp"
 	"strings"
+	"sync/atomic"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -730,6 +735,13 @@ func TestStr_At(t *testing.T) {
 		assert.Equal(t, ToChar("4"), slice.At(3).O())
 	}
 
+	// runes not graphemes are indexed
+	{
+		slice := NewStr("e\u0301x")
+		assert.Equal(t, "\u0301", slice.At(1).A())
+		assert.Equal(t, "x", slice.At(-1).A())
+	}
+
 	// index out of bounds
 	{
 		slice := NewStrV("1")
@@ -770,6 +782,179 @@ func TestStr_B(t *testing.T) {
 	}
 }
 
+// BinarySearch
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_BinarySearch() {
+	slice := NewStrV("135")
+	fmt.Println(slice.BinarySearch('3'))
+	// Output: 1 true
+}
+
+func TestStr_BinarySearch(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		i, found := slice.BinarySearch('1')
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+	}
+
+	// found
+	{
+		slice := NewStrV("1357")
+		for i, x := range []interface{}{'1', '3', '5', '7'} {
+			j, found := slice.BinarySearch(x)
+			assert.Equal(t, i, j)
+			assert.True(t, found)
+		}
+	}
+
+	// not found gives the insert location
+	{
+		slice := NewStrV("1357")
+		i, found := slice.BinarySearch('0')
+		assert.Equal(t, 0, i)
+		assert.False(t, found)
+		i, found = slice.BinarySearch('4')
+		assert.Equal(t, 2, i)
+		assert.False(t, found)
+		i, found = slice.BinarySearch('8')
+		assert.Equal(t, 4, i)
+		assert.False(t, found)
+	}
+}
+
+// CamelCase
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_CamelCase() {
+	fmt.Println(NewStr("HTTP server_name").CamelCase())
+	// Output: httpServerName
+}
+
+func TestStr_CamelCase(t *testing.T) {
+
+	// nil or empty
+	{
+		var str *Str
+		assert.Equal(t, NewStrV(), str.CamelCase())
+		assert.Equal(t, NewStrV(), NewStr(" -_ ").CamelCase())
+	}
+
+	// separators
+	{
+		assert.Equal(t, "fooBarBaz", NewStr("foo bar-baz").CamelCase().A())
+		assert.Equal(t, "fooBar", NewStr("--foo__bar..").CamelCase().A())
+		assert.Equal(t, "fooBar", NewStr("FOO_BAR").CamelCase().A())
+	}
+
+	// case transitions and acronyms
+	{
+		assert.Equal(t, "fooBar", NewStr("FooBar").CamelCase().A())
+		assert.Equal(t, "userId", NewStr("userID").CamelCase().A())
+		assert.Equal(t, "xmlHttpRequest", NewStr("XMLHttpRequest").CamelCase().A())
+		assert.Equal(t, "int8Value", NewStr("Int8Value").CamelCase().A())
+		assert.Equal(t, "v2Api", NewStr("V2API").CamelCase().A())
+	}
+
+	// unicode
+	{
+		assert.Equal(t, "überStraße", NewStr("Über straße").CamelCase().A())
+	}
+}
+
+// Captures
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_Captures() {
+	line := A("2019-08-13 ERROR disk full")
+	fmt.Println(line.Captures(`^(?P<date>\S+) (?P<level>[A-Z]+) (?P<msg>.*)$`).G())
+	// Output: map[date:2019-08-13 level:ERROR msg:disk full]
+}
+
+func TestStr_Captures(t *testing.T) {
+	exp := `(?P<major>\d+)\.(?P<minor>\d+)(\.(?P<patch>\d+))?`
+
+	// nil or empty
+	{
+		var str *Str
+		assert.Equal(t, NewStringMapV(), str.Captures(exp))
+		assert.Equal(t, NewStringMapV(), A("").Captures(exp))
+	}
+
+	// no match
+	{
+		assert.Equal(t, NewStringMapV(), A("version").Captures(exp))
+	}
+
+	// named groups of the first match
+	{
+		m := A("go1.13.5 and go1.12").Captures(exp)
+		assert.Equal(t, map[string]interface{}{"major": "1", "minor": "13", "patch": "5"}, m.G())
+	}
+
+	// groups that didn't participate are empty
+	{
+		m := A("go1.12").Captures(regexp.MustCompile(exp))
+		assert.Equal(t, map[string]interface{}{"major": "1", "minor": "12", "patch": ""}, m.G())
+	}
+
+	// invalid
+	{
+		m, err := A("1.2").CapturesE(`(?P<major`)
+		assert.Equal(t, NewStringMapV(), m)
+		assert.True(t, strings.HasPrefix(err.Error(), "failed compiling regex '(?P<major'"))
+	}
+}
+
+// Chunk
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_Chunk() {
+	slice := NewStrV("12345")
+	slice.Chunk(2).Each(func(x O) {
+		fmt.Println(x)
+	})
+	// Output: 12
+	// 34
+	// 5
+}
+
+func TestStr_Chunk(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, 0, slice.Chunk(2).Len())
+		assert.Equal(t, 0, NewStrV().Chunk(2).Len())
+	}
+
+	// invalid size
+	{
+		assert.Equal(t, 0, NewStrV("12345").Chunk(0).Len())
+		assert.Equal(t, 0, NewStrV("12345").Chunk(-1).Len())
+	}
+
+	// evenly divisible
+	{
+		chunks := NewStrV("12345").Chunk(5)
+		assert.Equal(t, 1, chunks.Len())
+		assert.Equal(t, "12345", chunks.At(0).O().(ISlice).A())
+	}
+
+	// remainder in the last chunk
+	{
+		slice := NewStrV("12345")
+		chunks := slice.Chunk(2)
+		assert.Equal(t, 3, chunks.Len())
+		assert.Equal(t, "12", chunks.At(0).O().(ISlice).A())
+		assert.Equal(t, "34", chunks.At(1).O().(ISlice).A())
+		assert.Equal(t, "5", chunks.At(2).O().(ISlice).A())
+
+		// chunks are copies
+		chunks.At(0).O().(ISlice).Set(0, '9')
+		assert.Equal(t, "12345", slice.A())
+	}
+}
+
 // Clear
 //--------------------------------------------------------------------------------------------------
 func ExampleStr_Clear() {
@@ -1215,6 +1400,107 @@ func TestStr_CountW(t *testing.T) {
 	assert.Equal(t, 1, NewStrV("1", "2", "3").CountW(func(x O) bool { return ExB(x.(Char) == '4' || x.(Char) == '3') }))
 }
 
+// Damerau
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_Damerau() {
+	fmt.Println(NewStr("stauts").Damerau("status"))
+	// Output: 1
+}
+
+func TestStr_Damerau(t *testing.T) {
+
+	// nil or empty
+	{
+		var str *Str
+		assert.Equal(t, 0, str.Damerau(nil))
+		assert.Equal(t, 3, str.Damerau("abc"))
+		assert.Equal(t, 3, NewStr("abc").Damerau(""))
+	}
+
+	// edits
+	{
+		assert.Equal(t, 0, NewStr("abc").Damerau("abc"))
+		assert.Equal(t, 3, NewStr("kitten").Damerau("sitting"))
+		assert.Equal(t, 1, NewStr("abcdef").Damerau("abdcef"))
+		assert.Equal(t, 2, NewStr("ca").Damerau("abc"))
+		assert.Equal(t, 1, NewStr("世界").Damerau(NewStr("界世")))
+	}
+
+	// never more than levenshtein
+	{
+		r := rand.New(rand.NewSource(1))
+		for n := 0; n < 200; n++ {
+			a, b := []rune{}, []rune{}
+			for i := r.Intn(8); i > 0; i-- {
+				a = append(a, rune('a'+r.Intn(3)))
+			}
+			for i := r.Intn(8); i > 0; i-- {
+				b = append(b, rune('a'+r.Intn(3)))
+			}
+			assert.True(t, damerau(a, b) <= levenshtein(a, b))
+			assert.Equal(t, damerau(a, b), damerau(b, a))
+		}
+	}
+}
+
+// Difference
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_Difference() {
+	slice := NewStrV("abcb")
+	fmt.Println(slice.Difference("bd").O())
+	// Output: ac
+}
+
+func TestStr_Difference(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, "", slice.Difference("ab").O())
+		assert.Equal(t, "abc", NewStrV("abbc").Difference("").O())
+	}
+
+	// uniq elements not in other while preserving order
+	{
+		slice := NewStrV("cabad")
+		assert.Equal(t, "ca", slice.Difference("bde").O())
+		assert.Equal(t, "cabad", slice.O())
+	}
+
+	// other is a Slice
+	{
+		assert.Equal(t, "ac", NewStrV("abc").Difference(NewStrV("b")).O())
+	}
+}
+
+// Disjoint
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_Disjoint() {
+	slice := NewStrV("abcb")
+	fmt.Println(slice.Disjoint("bd"))
+	// Output: false
+}
+
+func TestStr_Disjoint(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.True(t, slice.Disjoint("ab"))
+		assert.True(t, NewStrV("a").Disjoint(""))
+	}
+
+	// no elements in common
+	{
+		assert.True(t, NewStrV("ab").Disjoint("cd"))
+	}
+
+	// one element in common
+	{
+		assert.False(t, NewStrV("ab").Disjoint(NewStrV("bc")))
+	}
+}
+
 // Drop
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStr_Drop_Go(t *testing.B) {
@@ -1785,6 +2071,63 @@ func TestStr_EachIE(t *testing.T) {
 	}
 }
 
+// EachP
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_EachP() {
+	var sum int32
+	NewStrV("123").EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+		atomic.AddInt32(&sum, int32(ToInt(x.(*Char).String())))
+		return nil
+	})
+	fmt.Println(sum)
+	// Output: 6
+}
+
+func TestStr_EachP(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		_, err := slice.EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+			return nil
+		})
+		assert.Nil(t, err)
+	}
+
+	// Loop through
+	{
+		results := make([]int32, 3)
+		slice, err := NewStrV("123").EachP(context.Background(), 0, func(ctx context.Context, x O) error {
+			atomic.AddInt32(&results[ToInt(x.(*Char).String())-1], 1)
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []int32{1, 1, 1}, results)
+		assert.Equal(t, NewStrV("123"), slice)
+	}
+
+	// First error is returned
+	{
+		_, err := NewStrV("123").EachP(context.Background(), 2, func(ctx context.Context, x O) error {
+			if ToInt(x.(*Char).String()) == 2 {
+				return errors.New("failed")
+			}
+			return nil
+		})
+		assert.Equal(t, "failed", err.Error())
+	}
+
+	// Cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := NewStrV("123").EachP(ctx, 2, func(ctx context.Context, x O) error {
+			return nil
+		})
+		assert.Equal(t, context.Canceled, err)
+	}
+}
+
 // EachR
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStr_EachR_Go(t *testing.B) {
@@ -2011,6 +2354,46 @@ func TestStr_Empty(t *testing.T) {
 	assert.Equal(t, false, NewStr([]string{"1", "2", "3"}).Empty())
 }
 
+// EqualFold
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_EqualFold() {
+	fmt.Println(NewStr("Straße").EqualFold("STRASSE"))
+	// Output: true
+}
+
+func TestStr_EqualFold(t *testing.T) {
+
+	// nil or empty
+	{
+		var str *Str
+		assert.True(t, str.EqualFold(""))
+		assert.True(t, str.EqualFold(nil))
+		assert.False(t, str.EqualFold("a"))
+	}
+
+	// simple case folding
+	{
+		assert.True(t, NewStr("Go").EqualFold("GO"))
+		assert.True(t, NewStr("Σίσυφος").EqualFold("ΣΊΣΥΦΟΣ"))
+		assert.True(t, NewStr("\u212a").EqualFold("k"))
+		assert.False(t, NewStr("Go").EqualFold("Gopher"))
+	}
+
+	// full case folding
+	{
+		assert.True(t, NewStr("Straße").EqualFold(NewStr("STRASSE")))
+		assert.True(t, NewStr("\u1e9e").EqualFold("ss"))
+		assert.True(t, NewStr("\ufb03").EqualFold("FFI"))
+	}
+
+	// canonical equivalence
+	{
+		assert.True(t, NewStr("caf\u00e9").EqualFold("CAFE\u0301"))
+		assert.True(t, NewStr("\u0390").EqualFold("\u03b9\u0308\u0301"))
+		assert.False(t, NewStr("cafe").EqualFold("caf\u00e9"))
+	}
+}
+
 // Fields
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStr_Fields_Go(t *testing.B) {
@@ -2174,6 +2557,140 @@ func TestStr_FirstN(t *testing.T) {
 	assert.Equal(t, NewStrV("1", "2"), NewStrV("1", "2", "3").FirstN(2))
 }
 
+// FlatMap
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_FlatMap() {
+	slice := NewStrV("123")
+	fmt.Println(slice.FlatMap(func(x O) O {
+		return []interface{}{x, x}
+	}))
+	// Output: 112233
+}
+
+func TestStr_FlatMap(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, 0, slice.FlatMap(func(x O) O { return x }).Len())
+		assert.Equal(t, 0, NewStrV().FlatMap(func(x O) O { return x }).Len())
+	}
+
+	// slice results are expanded
+	{
+		slice := NewStrV("123").FlatMap(func(x O) O {
+			return []interface{}{x, x}
+		})
+		assert.Equal(t, "112233", slice.A())
+	}
+
+	// Slice results are expanded
+	{
+		slice := NewStrV("123").FlatMap(func(x O) O {
+			return NewIntSliceV(0, ToInt(string(x.(rune))))
+		})
+		assert.Equal(t, []int{0, 1, 0, 2, 0, 3}, slice.O())
+	}
+
+	// other results are kept as is
+	{
+		slice := NewStrV("123").FlatMap(func(x O) O {
+			return ToInt(string(x.(rune))) * 2
+		})
+		assert.Equal(t, []int{2, 4, 6}, slice.O())
+	}
+}
+
+// Flatten
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_Flatten() {
+	slice := NewStrV("12345")
+	fmt.Println(slice.Flatten())
+	// Output: 12345
+}
+
+func TestStr_Flatten(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, 0, slice.Flatten().Len())
+		assert.Equal(t, 0, NewStrV().Flatten().Len())
+	}
+
+	// already flat so it is a copy
+	{
+		slice := NewStrV("12345")
+		new := slice.Flatten()
+		assert.Equal(t, "12345", new.A())
+		new.Set(0, '9')
+		assert.Equal(t, "12345", slice.A())
+	}
+}
+
+// FuzzyScore
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_FuzzyScore() {
+	score, ok := NewStr("cmd/nubgen/main.go").FuzzyScore("nbm")
+	fmt.Println(score > 0, ok)
+	// Output: true true
+}
+
+func TestStr_FuzzyScore(t *testing.T) {
+
+	// nil or empty
+	{
+		var str *Str
+		score, ok := str.FuzzyScore("")
+		assert.Equal(t, 0, score)
+		assert.True(t, ok)
+
+		_, ok = str.FuzzyScore("a")
+		assert.False(t, ok)
+	}
+
+	// not a subsequence
+	{
+		_, ok := NewStr("main.go").FuzzyScore("mainx")
+		assert.False(t, ok)
+		_, ok = NewStr("ab").FuzzyScore("ba")
+		assert.False(t, ok)
+	}
+
+	// case insensitive with a bonus for the same case
+	{
+		lower, ok := NewStr("readme").FuzzyScore("rm")
+		assert.True(t, ok)
+		upper, ok := NewStr("README").FuzzyScore("rm")
+		assert.True(t, ok)
+		exact, _ := NewStr("README").FuzzyScore("RM")
+		assert.True(t, upper < exact)
+		assert.Equal(t, exact, lower)
+	}
+
+	// consecutive matches score higher than gaps
+	{
+		consecutive, _ := NewStr("xabcx").FuzzyScore("abc")
+		gaps, _ := NewStr("xaxbxcx").FuzzyScore("abc")
+		assert.True(t, consecutive > gaps)
+	}
+
+	// matches at the start of words score higher
+	{
+		boundary, _ := NewStr("src/main.go").FuzzyScore("sm")
+		camel, _ := NewStr("someMain").FuzzyScore("sm")
+		middle, _ := NewStr("xsxm").FuzzyScore("sm")
+		assert.True(t, boundary > middle)
+		assert.True(t, camel > middle)
+	}
+
+	// best alignment is found
+	{
+		score, _ := NewStr("a_b_ab").FuzzyScore("ab")
+		assert.Equal(t, 2*fuzzyMatch+2*fuzzyCase+fuzzyBoundary+fuzzyConsecutive, score)
+	}
+}
+
 // G
 //--------------------------------------------------------------------------------------------------
 func ExampleStr_G() {
@@ -2194,17 +2711,87 @@ func ExampleStr_Generic() {
 	// Output: false
 }
 
-// HasAnyPrefix
+// Graphemes
 //--------------------------------------------------------------------------------------------------
-func BenchmarkStr_HasAnyPrefix_Go(t *testing.B) {
-	// src := RangeString(nines7)
-	// for len(src) > 1 {
-	// 	_ = src[0]
-	// 	src = src[1:]
-	// }
+func ExampleStr_Graphemes() {
+	str := NewStr("e\u0301\U0001F1FA\U0001F1F8!")
+	fmt.Println(str.Len(), str.Graphemes().Len())
+	// Output: 5 3
 }
 
-func BenchmarkStr_HasAnyPrefix_Slice(t *testing.B) {
+func TestStr_Graphemes(t *testing.T) {
+
+	// nil or empty
+	{
+		var str *Str
+		assert.Equal(t, NewStringSliceV(), str.Graphemes())
+		assert.Equal(t, NewStringSliceV(), NewStr("").Graphemes())
+	}
+
+	// ascii
+	{
+		assert.Equal(t, []string{"a", " ", "b", "\r\n"}, NewStr("a b\r\n").Graphemes().O())
+	}
+
+	// combining marks, flags and emoji sequences
+	{
+		family := "\U0001F468\u200d\U0001F469\u200d\U0001F467"
+		str := NewStr("e\u0301" + family + "\U0001F1FA\U0001F1F8" + "\u4e16")
+		assert.Equal(t, []string{"e\u0301", family, "\U0001F1FA\U0001F1F8", "\u4e16"}, str.Graphemes().O())
+	}
+}
+
+// GroupBy
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_GroupBy() {
+	slice := NewStrV("12345")
+	groups := slice.GroupBy(func(x O) O {
+		return ToInt(x.(*Char).String()) % 2
+	})
+	fmt.Println(groups.Get(1).O(), groups.Get(0).O())
+	// Output: 135 24
+}
+
+func TestStr_GroupBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, 0, slice.GroupBy(func(x O) O { return x }).Len())
+		assert.Equal(t, 0, NewStrV().GroupBy(func(x O) O { return x }).Len())
+	}
+
+	// groups keep first seen order
+	{
+		groups := NewStrV("54321").GroupBy(func(x O) O {
+			return ToInt(x.(*Char).String()) % 2
+		})
+		assert.Equal(t, []string{"1", "0"}, groups.Keys().O())
+		assert.Equal(t, "42", groups.Get(0).O().(ISlice).A())
+		assert.Equal(t, "531", groups.Get(1).O().(ISlice).A())
+	}
+
+	// single group
+	{
+		groups := NewStrV("12345").GroupBy(func(x O) O {
+			return "all"
+		})
+		assert.Equal(t, 1, groups.Len())
+		assert.Equal(t, "12345", groups.Get("all").O().(ISlice).A())
+	}
+}
+
+// HasAnyPrefix
+//--------------------------------------------------------------------------------------------------
+func BenchmarkStr_HasAnyPrefix_Go(t *testing.B) {
+	// src := RangeString(nines7)
+	// for len(src) > 1 {
+	// 	_ = src[0]
+	// 	src = src[1:]
+	// }
+}
+
+func BenchmarkStr_HasAnyPrefix_Slice(t *testing.B) {
 	// slice := NewStr(RangeString(nines7))
 	// for slice.Len() > 0 {
 	// 	slice.HasAnyPrefix()
@@ -2712,6 +3299,191 @@ func TestStr_Insert(t *testing.T) {
 	}
 }
 
+// Intersect
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_Intersect() {
+	slice := NewStrV("abcb")
+	fmt.Println(slice.Intersect("bd").O())
+	// Output: b
+}
+
+func TestStr_Intersect(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, "", slice.Intersect("ab").O())
+		assert.Equal(t, "", NewStrV("ab").Intersect("").O())
+	}
+
+	// uniq elements in other while preserving order
+	{
+		slice := NewStrV("cabad")
+		assert.Equal(t, "ad", slice.Intersect("dae").O())
+		assert.Equal(t, "cabad", slice.O())
+	}
+
+	// other is a Slice
+	{
+		assert.Equal(t, "bc", NewStrV("abc").Intersect(NewStrV("cb")).O())
+	}
+}
+
+// Iter
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_Iter() {
+	it := A("ab").Iter()
+	for it.Next() {
+		fmt.Println(it.Index(), it.Value())
+	}
+	// Output:
+	// 0 a
+	// 1 b
+}
+
+func TestStr_Iter(t *testing.T) {
+
+	// nil or empty
+	{
+		var str *Str
+		assert.False(t, str.Iter().Next())
+		assert.False(t, A("").Iter().Next())
+	}
+
+	// runes in order
+	{
+		it := A("a世").Iter()
+		assert.True(t, it.Next())
+		assert.Equal(t, 0, it.Index())
+		assert.Equal(t, NewChar('a'), it.Value())
+		assert.True(t, it.Next())
+		assert.Equal(t, 1, it.Index())
+		assert.Equal(t, NewChar('世'), it.Value())
+		assert.False(t, it.Next())
+		assert.Nil(t, it.Err())
+	}
+}
+
+// IterGraphemes
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_IterGraphemes() {
+	it := A("e\u0301!").IterGraphemes()
+	for it.Next() {
+		fmt.Println(it.Index(), len(it.Value().(string)))
+	}
+	// Output:
+	// 0 3
+	// 1 1
+}
+
+func TestStr_IterGraphemes(t *testing.T) {
+	graphemes := func(str string) (result []string) {
+		result = []string{}
+		for it := A(str).IterGraphemes(); it.Next(); {
+			result = append(result, it.Value().(string))
+		}
+		return
+	}
+
+	// nil or empty
+	{
+		var str *Str
+		assert.False(t, str.IterGraphemes().Next())
+		assert.Equal(t, []string{}, graphemes(""))
+	}
+
+	// ascii and controls
+	{
+		assert.Equal(t, []string{"a", "b", "\r\n", "c", "\n", "\n"}, graphemes("ab\r\nc\n\n"))
+		assert.Equal(t, []string{"\r", "\u0301"}, graphemes("\r\u0301"))
+	}
+
+	// combining marks
+	{
+		assert.Equal(t, []string{"e\u0301", "a\u0300\u0316"}, graphemes("e\u0301a\u0300\u0316"))
+		assert.Equal(t, []string{"\u0915\u093f"}, graphemes("\u0915\u093f"))
+	}
+
+	// hangul syllables
+	{
+		assert.Equal(t, []string{"\u1100\u1161\u11a8", "\uac00\u11a8", "\uac01"}, graphemes("\u1100\u1161\u11a8\uac00\u11a8\uac01"))
+	}
+
+	// regional indicator flags pair up
+	{
+		us, de := "\U0001F1FA\U0001F1F8", "\U0001F1E9\U0001F1EA"
+		assert.Equal(t, []string{us, de, "\U0001F1FA"}, graphemes(us+de+"\U0001F1FA"))
+	}
+
+	// emoji modifiers and zwj sequences
+	{
+		thumbs := "\U0001F44D\U0001F3FD"
+		family := "\U0001F468\u200d\U0001F469\u200d\U0001F467"
+		heart := "\u2764\ufe0f"
+		assert.Equal(t, []string{thumbs, family, heart, "a\u200d", "b"}, graphemes(thumbs+family+heart+"a\u200db"))
+	}
+}
+
+// IsSubset
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_IsSubset() {
+	slice := NewStrV("abcb")
+	fmt.Println(slice.IsSubset("bd"))
+	// Output: false
+}
+
+func TestStr_IsSubset(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.True(t, slice.IsSubset("ab"))
+		assert.False(t, NewStrV("a").IsSubset(""))
+	}
+
+	// all elements in other
+	{
+		assert.True(t, NewStrV("abb").IsSubset("cba"))
+	}
+
+	// not all elements in other
+	{
+		assert.False(t, NewStrV("ad").IsSubset(NewStrV("ab")))
+	}
+}
+
+// JaroWinkler
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_JaroWinkler() {
+	fmt.Printf("%.3f", NewStr("MARTHA").JaroWinkler("MARHTA"))
+	// Output: 0.961
+}
+
+func TestStr_JaroWinkler(t *testing.T) {
+
+	// nil or empty
+	{
+		var str *Str
+		assert.Equal(t, 1.0, str.JaroWinkler(""))
+		assert.Equal(t, 0.0, str.JaroWinkler("a"))
+		assert.Equal(t, 0.0, NewStr("a").JaroWinkler(nil))
+	}
+
+	// similarity
+	{
+		assert.Equal(t, 1.0, NewStr("abc").JaroWinkler("abc"))
+		assert.Equal(t, 0.0, NewStr("abc").JaroWinkler("xyz"))
+		assert.InDelta(t, 0.961, NewStr("MARTHA").JaroWinkler("MARHTA"), 0.001)
+		assert.InDelta(t, 0.840, NewStr("DWAYNE").JaroWinkler("DUANE"), 0.001)
+		assert.InDelta(t, 0.813, NewStr("DIXON").JaroWinkler("DICKSONX"), 0.001)
+	}
+
+	// no prefix boost for dissimilar strings
+	{
+		assert.InDelta(t, 0.733, NewStr("CRATE").JaroWinkler("TRACE"), 0.001)
+	}
+}
+
 // Join
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStr_Join_Go(t *testing.B) {
@@ -2746,6 +3518,21 @@ func TestStr_Join(t *testing.T) {
 	assert.Equal(t, "1.2.3", NewStrV("1", "2", "3").Join(".").O())
 }
 
+// KebabCase
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_KebabCase() {
+	fmt.Println(NewStr("ReleaseNotes").KebabCase())
+	// Output: release-notes
+}
+
+func TestStr_KebabCase(t *testing.T) {
+	assert.Equal(t, NewStrV(), (*Str)(nil).KebabCase())
+	assert.Equal(t, "", NewStr("").KebabCase().A())
+	assert.Equal(t, "http-server-name", NewStr("HTTPServerName").KebabCase().A())
+	assert.Equal(t, "dry-run", NewStr("dry_run").KebabCase().A())
+	assert.Equal(t, "dry-run", NewStr("DryRun").KebabCase().A())
+}
+
 // Last
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStr_Last_Go(t *testing.B) {
@@ -3013,6 +3800,33 @@ func TestStr_Less(t *testing.T) {
 	assert.Equal(t, true, NewStrV("0", "1", "2").Less(1, 2))
 }
 
+// Levenshtein
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_Levenshtein() {
+	fmt.Println(NewStr("kitten").Levenshtein("sitting"))
+	// Output: 3
+}
+
+func TestStr_Levenshtein(t *testing.T) {
+
+	// nil or empty
+	{
+		var str *Str
+		assert.Equal(t, 0, str.Levenshtein(""))
+		assert.Equal(t, 2, str.Levenshtein("ab"))
+		assert.Equal(t, 2, NewStr("ab").Levenshtein(nil))
+	}
+
+	// edits
+	{
+		assert.Equal(t, 0, NewStr("abc").Levenshtein("abc"))
+		assert.Equal(t, 3, NewStr("kitten").Levenshtein("sitting"))
+		assert.Equal(t, 2, NewStr("abcdef").Levenshtein("abdcef"))
+		assert.Equal(t, 3, NewStr("ca").Levenshtein("abc"))
+		assert.Equal(t, 1, NewStr("straße").Levenshtein("strase"))
+	}
+}
+
 // Map
 //--------------------------------------------------------------------------------------------------
 func ExampleStr_Map() {
@@ -3033,6 +3847,129 @@ func TestStr_Map(t *testing.T) {
 	}
 }
 
+// MapP
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_MapP() {
+	slice, _ := NewStrV("123").MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+		return x.(rune) + 1, nil
+	})
+	fmt.Println(slice)
+	// Output: 234
+}
+
+func TestStr_MapP(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		new, err := slice.MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+			return x, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// Order is preserved
+	{
+		slice, err := NewStrV("123").MapP(context.Background(), 0, func(ctx context.Context, x O) (O, error) {
+			return x.(rune) + 1, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "234", slice.A())
+	}
+
+	// First error is returned
+	{
+		slice, err := NewStrV("123").MapP(context.Background(), 2, func(ctx context.Context, x O) (O, error) {
+			if ToInt(string(x.(rune))) == 2 {
+				return nil, errors.New("failed")
+			}
+			return x, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+		assert.Equal(t, 0, slice.Len())
+	}
+
+	// Cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		slice, err := NewStrV("123").MapP(ctx, 2, func(ctx context.Context, x O) (O, error) {
+			return x, nil
+		})
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, 0, slice.Len())
+	}
+}
+
+// Match
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_Match() {
+	fmt.Println(A("go version go1.13.5 linux/amd64").Match(`\d+\.\d+(\.\d+)?`))
+	// Output: 1.13.5
+}
+
+func TestStr_Match(t *testing.T) {
+
+	// nil or empty
+	{
+		var str *Str
+		assert.Equal(t, A(""), str.Match(`\d`))
+		assert.Equal(t, A(""), A("").Match(`\d`))
+	}
+
+	// first match
+	{
+		assert.Equal(t, A("12"), A("a12b34").Match(`\d+`))
+		assert.Equal(t, A("12"), A("a12b34").Match(A(`\d+`)))
+		assert.Equal(t, A("12"), A("a12b34").Match(regexp.MustCompile(`\d+`)))
+		assert.Equal(t, A(""), A("ab").Match(`\d+`))
+	}
+
+	// invalid
+	{
+		assert.Equal(t, A(""), A("a(").Match(`(`))
+		match, err := A("a(").MatchE(`(`)
+		assert.Equal(t, A(""), match)
+		assert.NotNil(t, err)
+
+		match, err = A("a").MatchE((*regexp.Regexp)(nil))
+		assert.Equal(t, A(""), match)
+		assert.Equal(t, "regex can not be nil", err.Error())
+	}
+}
+
+// MatchAll
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_MatchAll() {
+	fmt.Println(A("a12b34c5").MatchAll(`\d+`))
+	// Output: [12 34 5]
+}
+
+func TestStr_MatchAll(t *testing.T) {
+
+	// nil or empty
+	{
+		var str *Str
+		assert.Equal(t, NewStringSliceV(), str.MatchAll(`\d`))
+		assert.Equal(t, NewStringSliceV(), A("").MatchAll(`\d`))
+	}
+
+	// matches
+	{
+		assert.Equal(t, NewStringSliceV("12", "34", "5"), A("a12b34c5").MatchAll(`\d+`))
+		assert.Equal(t, NewStringSliceV("a b", "c d"), A("a b,c d").MatchAll(`[^,]+`))
+		assert.Equal(t, NewStringSliceV(), A("abc").MatchAll(`\d+`))
+	}
+
+	// invalid
+	{
+		matches, err := A("a").MatchAllE(`[`)
+		assert.Equal(t, NewStringSliceV(), matches)
+		assert.NotNil(t, err)
+	}
+}
+
 // Nil
 //--------------------------------------------------------------------------------------------------
 func ExampleStr_Nil() {
@@ -3048,6 +3985,59 @@ func TestStr_Nil(t *testing.T) {
 	assert.False(t, NewStrV("1", "2", "3").Nil())
 }
 
+// Normalize
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_Normalize() {
+	str := NewStr("cafe\u0301")
+	fmt.Println(str.Len(), str.Normalize(NFC).Len())
+	// Output: 5 4
+}
+
+func TestStr_Normalize(t *testing.T) {
+
+	// nil or empty
+	{
+		var str *Str
+		assert.Equal(t, NewStrV(), str.Normalize(NFC))
+		assert.Equal(t, NewStrV(), NewStr("").Normalize(NFD))
+	}
+
+	// original is not modified
+	{
+		str := NewStr("caf\u00e9")
+		assert.Equal(t, "cafe\u0301", str.Normalize(NFD).A())
+		assert.Equal(t, "caf\u00e9", str.A())
+	}
+
+	// composition and decomposition
+	{
+		assert.Equal(t, "caf\u00e9", NewStr("cafe\u0301").Normalize(NFC).A())
+		assert.Equal(t, "caf\u00e9", NewStr("caf\u00e9").Normalize(NFC).A())
+		assert.Equal(t, "cafe\u0301", NewStr("caf\u00e9").Normalize(NFD).A())
+		assert.Equal(t, "\u1e69", NewStr("s\u0323\u0307").Normalize(NFC).A())
+		assert.Equal(t, "s\u0323\u0307", NewStr("\u1e69").Normalize(NFD).A())
+	}
+
+	// combining marks are put in canonical order
+	{
+		assert.Equal(t, "a\u0323\u0301", NewStr("a\u0301\u0323").Normalize(NFD).A())
+		assert.Equal(t, "\u1ea1\u0301", NewStr("a\u0301\u0323").Normalize(NFC).A())
+	}
+
+	// singletons and exclusions are never composed
+	{
+		assert.Equal(t, "\u03a9", NewStr("\u2126").Normalize(NFC).A())
+		assert.Equal(t, "\u0915\u093c", NewStr("\u0958").Normalize(NFC).A())
+	}
+
+	// hangul syllables
+	{
+		assert.Equal(t, "\u1100\u1161\u11a8", NewStr("\uac01").Normalize(NFD).A())
+		assert.Equal(t, "\uac01", NewStr("\u1100\u1161\u11a8").Normalize(NFC).A())
+		assert.Equal(t, "\uac00", NewStr("\u1100\u1161").Normalize(NFC).A())
+	}
+}
+
 // O
 //--------------------------------------------------------------------------------------------------
 func ExampleStr_O() {
@@ -3102,6 +4092,114 @@ func TestStr_Pair(t *testing.T) {
 	}
 }
 
+// Partition
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_Partition() {
+	slice := NewStrV("12345")
+	match, rest := slice.Partition(func(x O) bool {
+		return ToInt(x.(*Char).String())%2 == 1
+	})
+	fmt.Println(match, rest)
+	// Output: 135 24
+}
+
+func TestStr_Partition(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		match, rest := slice.Partition(func(x O) bool { return true })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+		match, rest = NewStrV().Partition(func(x O) bool { return true })
+		assert.Equal(t, 0, match.Len())
+		assert.Equal(t, 0, rest.Len())
+	}
+
+	// split in order
+	{
+		match, rest := NewStrV("12345").Partition(func(x O) bool {
+			return ToInt(x.(*Char).String())%2 == 1
+		})
+		assert.Equal(t, "135", match.A())
+		assert.Equal(t, "24", rest.A())
+	}
+
+	// all match
+	{
+		match, rest := NewStrV("12345").Partition(func(x O) bool {
+			return true
+		})
+		assert.Equal(t, "12345", match.A())
+		assert.Equal(t, 0, rest.Len())
+	}
+}
+
+// PascalCase
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_PascalCase() {
+	fmt.Println(NewStr("http server_name").PascalCase())
+	// Output: HttpServerName
+}
+
+func TestStr_PascalCase(t *testing.T) {
+	assert.Equal(t, NewStrV(), (*Str)(nil).PascalCase())
+	assert.Equal(t, "", NewStr("").PascalCase().A())
+	assert.Equal(t, "FooBar", NewStr("foo_bar").PascalCase().A())
+	assert.Equal(t, "FooBar", NewStr("fooBar").PascalCase().A())
+	assert.Equal(t, "UserId", NewStr("USER_ID").PascalCase().A())
+	assert.Equal(t, "ÜberStraße", NewStr("über-straße").PascalCase().A())
+}
+
+// Pluralize
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_Pluralize() {
+	fmt.Println(NewStr("UserCategory").Pluralize())
+	// Output: UserCategories
+}
+
+func TestStr_Pluralize(t *testing.T) {
+
+	// nil or empty
+	{
+		var str *Str
+		assert.Equal(t, NewStrV(), str.Pluralize())
+		assert.Equal(t, "", NewStr("").Pluralize().A())
+		assert.Equal(t, "--", NewStr("--").Pluralize().A())
+	}
+
+	// rules
+	{
+		assert.Equal(t, "files", NewStr("file").Pluralize().A())
+		assert.Equal(t, "categories", NewStr("category").Pluralize().A())
+		assert.Equal(t, "keys", NewStr("key").Pluralize().A())
+		assert.Equal(t, "boxes", NewStr("box").Pluralize().A())
+		assert.Equal(t, "statuses", NewStr("status").Pluralize().A())
+		assert.Equal(t, "classes", NewStr("class").Pluralize().A())
+		assert.Equal(t, "branches", NewStr("branch").Pluralize().A())
+		assert.Equal(t, "wishes", NewStr("wish").Pluralize().A())
+	}
+
+	// irregular and uncountable
+	{
+		assert.Equal(t, "people", NewStr("person").Pluralize().A())
+		assert.Equal(t, "children", NewStr("child").Pluralize().A())
+		assert.Equal(t, "indices", NewStr("index").Pluralize().A())
+		assert.Equal(t, "aliases", NewStr("alias").Pluralize().A())
+		assert.Equal(t, "sheep", NewStr("sheep").Pluralize().A())
+		assert.Equal(t, "people", NewStr("people").Pluralize().A())
+	}
+
+	// last word only keeping its case
+	{
+		assert.Equal(t, "People", NewStr("Person").Pluralize().A())
+		assert.Equal(t, "PEOPLE", NewStr("PERSON").Pluralize().A())
+		assert.Equal(t, "user_accounts", NewStr("user_account").Pluralize().A())
+		assert.Equal(t, "USER_ACCOUNTS", NewStr("USER_ACCOUNT").Pluralize().A())
+		assert.Equal(t, "UserAccounts", NewStr("UserAccount").Pluralize().A())
+	}
+}
+
 // Pop
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStr_Pop_Go(t *testing.B) {
@@ -3319,6 +4417,54 @@ func TestStr_ReplaceAll(t *testing.T) {
 	assert.Equal(t, "1255", NewStr("1233").ReplaceAll("3", "5").A())
 }
 
+// ReplaceRegex
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_ReplaceRegex() {
+	fmt.Println(A("count 1 and 2").ReplaceRegex(`\d+`, func(x string) string { return "<" + x + ">" }))
+	// Output: count <1> and <2>
+}
+
+func TestStr_ReplaceRegex(t *testing.T) {
+
+	// nil or empty
+	{
+		var str *Str
+		assert.Equal(t, A(""), str.ReplaceRegex(`\d`, "x"))
+		assert.Equal(t, A(""), A("").ReplaceRegex(`\d`, "x"))
+	}
+
+	// lambda
+	{
+		assert.Equal(t, A("A-B-c"), A("a-b-c").ReplaceRegex(`[ab]`, strings.ToUpper))
+	}
+
+	// template
+	{
+		assert.Equal(t, A("2019/08/13"), A("13.08.2019").ReplaceRegex(`(\d+)\.(\d+)\.(\d+)`, "$3/$2/$1"))
+		assert.Equal(t, A("key=val"), A("val:key").ReplaceRegex(`(?P<v>\w+):(?P<k>\w+)`, A("${k}=${v}")))
+		assert.Equal(t, A("abc"), A("a1b2c3").ReplaceRegex(`\d`, nil))
+	}
+
+	// original is not modified
+	{
+		str := A("a1")
+		assert.Equal(t, A("ax"), str.ReplaceRegex(`\d`, "x"))
+		assert.Equal(t, A("a1"), str)
+	}
+
+	// invalid
+	{
+		str := A("a1")
+		new := str.ReplaceRegex(`(`, "x")
+		assert.Equal(t, A("a1"), new)
+		assert.False(t, new == str)
+
+		new, err := str.ReplaceRegexE(`(`, "x")
+		assert.Equal(t, A(""), new)
+		assert.NotNil(t, err)
+	}
+}
+
 // Reverse
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStr_Reverse_Go(t *testing.B) {
@@ -3367,6 +4513,14 @@ func TestStr_Reverse(t *testing.T) {
 		assert.Equal(t, NewStrV("2", "3", "-2", "-3", "4"), slice.Append("4"))
 		assert.Equal(t, "3-2-32", reversed.A())
 	}
+
+	// grapheme clusters are kept intact
+	{
+		assert.Equal(t, "xe\u0301", NewStr("e\u0301x").Reverse().A())
+		assert.NotEqual(t, "x\u0301e", NewStr("e\u0301x").Reverse().A())
+		assert.Equal(t, "🇩🇪🇺🇸", NewStr("🇺🇸🇩🇪").Reverse().A())
+		assert.Equal(t, "b\r\na", NewStr("a\r\nb").Reverse().A())
+	}
 }
 
 // ReverseM
@@ -3417,6 +4571,28 @@ func TestStr_ReverseM(t *testing.T) {
 		assert.Equal(t, "3-2-324", slice.Append('4').A())
 		assert.Equal(t, "3-2-324", reversed.A())
 	}
+
+	// grapheme clusters are kept intact
+	{
+		slice := NewStr("ae\u0301x")
+		assert.Equal(t, "xe\u0301a", slice.ReverseM().A())
+		assert.Equal(t, "xe\u0301a", slice.A())
+	}
+}
+
+// ScreamingSnake
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_ScreamingSnake() {
+	fmt.Println(NewStr("logLevel").ScreamingSnake())
+	// Output: LOG_LEVEL
+}
+
+func TestStr_ScreamingSnake(t *testing.T) {
+	assert.Equal(t, NewStrV(), (*Str)(nil).ScreamingSnake())
+	assert.Equal(t, "", NewStr("").ScreamingSnake().A())
+	assert.Equal(t, "HTTP_SERVER_NAME", NewStr("httpServerName").ScreamingSnake().A())
+	assert.Equal(t, "DRY_RUN", NewStr("dry-run").ScreamingSnake().A())
+	assert.Equal(t, "API_V2", NewStr("apiV2").ScreamingSnake().A())
 }
 
 // Select
@@ -3471,6 +4647,61 @@ func TestStr_Select(t *testing.T) {
 	}
 }
 
+// SelectP
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_SelectP() {
+	slice, _ := NewStrV("123").SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+		return ToInt(x.(*Char).String()) != 2, nil
+	})
+	fmt.Println(slice)
+	// Output: 13
+}
+
+func TestStr_SelectP(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		new, err := slice.SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+			return true, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, new.Len())
+	}
+
+	// Order is preserved
+	{
+		slice, err := NewStrV("123").SelectP(context.Background(), 0, func(ctx context.Context, x O) (bool, error) {
+			return ToInt(x.(*Char).String()) != 2, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "13", slice.A())
+	}
+
+	// First error is returned
+	{
+		slice, err := NewStrV("123").SelectP(context.Background(), 2, func(ctx context.Context, x O) (bool, error) {
+			if ToInt(x.(*Char).String()) == 2 {
+				return false, errors.New("failed")
+			}
+			return true, nil
+		})
+		assert.Equal(t, "failed", err.Error())
+		assert.Equal(t, 0, slice.Len())
+	}
+
+	// Cancelled context
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		slice, err := NewStrV("123").SelectP(ctx, 2, func(ctx context.Context, x O) (bool, error) {
+			return true, nil
+		})
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, 0, slice.Len())
+	}
+}
+
 // Set
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStr_Set_Go(t *testing.B) {
@@ -3643,6 +4874,39 @@ func TestStr_SetE(t *testing.T) {
 	}
 }
 
+// ShellQuote
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_ShellQuote() {
+	fmt.Println(A("it's here").ShellQuote())
+	// Output: 'it'"'"'s here'
+}
+
+func TestStr_ShellQuote(t *testing.T) {
+
+	// nil or empty
+	{
+		var str *Str
+		assert.Equal(t, A("''"), str.ShellQuote())
+		assert.Equal(t, A("''"), A("").ShellQuote())
+	}
+
+	// safe
+	{
+		assert.Equal(t, A("foo/bar.go"), A("foo/bar.go").ShellQuote())
+	}
+
+	// quoted
+	{
+		assert.Equal(t, A("'foo bar'"), A("foo bar").ShellQuote())
+		assert.Equal(t, A(`'"$foo"'`), A(`"$foo"`).ShellQuote())
+	}
+
+	// round trip
+	{
+		assert.Equal(t, NewStringSliceV("it's a \\ \"test\""), A("it's a \\ \"test\"").ShellQuote().Shlex())
+	}
+}
+
 // Shift
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStr_Shift_Go(t *testing.B) {
@@ -3752,11 +5016,50 @@ func TestStr_ShiftN(t *testing.T) {
 		assert.Equal(t, NewStrV(), slice)
 	}
 
-	// take beyond
+	// take beyond
+	{
+		slice := NewStrV("1", "2", "3")
+		assert.Equal(t, NewStrV("1", "2", "3"), slice.ShiftN(4))
+		assert.Equal(t, NewStrV(), slice)
+	}
+}
+
+// Shlex
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_Shlex() {
+	fmt.Println(A(`git commit -m 'first commit' --author="John Doe"`).Shlex().Join("|"))
+	// Output: git|commit|-m|first commit|--author=John Doe
+}
+
+func TestStr_Shlex(t *testing.T) {
+
+	// nil or empty
+	{
+		var str *Str
+		assert.Equal(t, NewStringSliceV(), str.Shlex())
+		assert.Equal(t, NewStringSliceV(), A("").Shlex())
+		assert.Equal(t, NewStringSliceV(), A("  # comment").Shlex())
+	}
+
+	// quotes and escapes
+	{
+		assert.Equal(t, NewStringSliceV("a b", "c d", "e f", "", "g"), A(`'a b' "c d" e\ f '' g`).Shlex())
+		assert.Equal(t, NewStringSliceV(`it's`, `say "hi"`), A(`"it's" 'say "hi"'`).Shlex())
+	}
+
+	// original is not modified
+	{
+		str := A(`'a b'`)
+		assert.Equal(t, NewStringSliceV("a b"), str.Shlex())
+		assert.Equal(t, A(`'a b'`), str)
+	}
+
+	// invalid
 	{
-		slice := NewStrV("1", "2", "3")
-		assert.Equal(t, NewStrV("1", "2", "3"), slice.ShiftN(4))
-		assert.Equal(t, NewStrV(), slice)
+		assert.Equal(t, NewStringSliceV(), A(`echo "foo`).Shlex())
+		slice, err := A(`echo "foo`).ShlexE()
+		assert.Equal(t, NewStringSliceV(), slice)
+		assert.Equal(t, `unterminated double quote in command: echo "foo`, err.Error())
 	}
 }
 
@@ -3776,6 +5079,61 @@ func TestStr_Single(t *testing.T) {
 	assert.Equal(t, false, NewStrV("1", "2").Single())
 }
 
+// Singularize
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_Singularize() {
+	fmt.Println(NewStr("UserCategories").Singularize())
+	// Output: UserCategory
+}
+
+func TestStr_Singularize(t *testing.T) {
+
+	// nil or empty
+	{
+		var str *Str
+		assert.Equal(t, NewStrV(), str.Singularize())
+		assert.Equal(t, "", NewStr("").Singularize().A())
+	}
+
+	// rules
+	{
+		assert.Equal(t, "file", NewStr("files").Singularize().A())
+		assert.Equal(t, "category", NewStr("categories").Singularize().A())
+		assert.Equal(t, "tie", NewStr("ties").Singularize().A())
+		assert.Equal(t, "box", NewStr("boxes").Singularize().A())
+		assert.Equal(t, "class", NewStr("classes").Singularize().A())
+		assert.Equal(t, "branch", NewStr("branches").Singularize().A())
+		assert.Equal(t, "status", NewStr("statuses").Singularize().A())
+		assert.Equal(t, "bus", NewStr("buses").Singularize().A())
+		assert.Equal(t, "house", NewStr("houses").Singularize().A())
+		assert.Equal(t, "size", NewStr("sizes").Singularize().A())
+		assert.Equal(t, "shoe", NewStr("shoes").Singularize().A())
+	}
+
+	// already singular
+	{
+		assert.Equal(t, "status", NewStr("status").Singularize().A())
+		assert.Equal(t, "class", NewStr("class").Singularize().A())
+		assert.Equal(t, "axis", NewStr("axis").Singularize().A())
+		assert.Equal(t, "person", NewStr("person").Singularize().A())
+	}
+
+	// irregular and uncountable
+	{
+		assert.Equal(t, "person", NewStr("people").Singularize().A())
+		assert.Equal(t, "leaf", NewStr("leaves").Singularize().A())
+		assert.Equal(t, "movie", NewStr("movies").Singularize().A())
+		assert.Equal(t, "cache", NewStr("caches").Singularize().A())
+		assert.Equal(t, "series", NewStr("series").Singularize().A())
+	}
+
+	// last word only keeping its case
+	{
+		assert.Equal(t, "Person", NewStr("People").Singularize().A())
+		assert.Equal(t, "USER_ACCOUNT", NewStr("USER_ACCOUNTS").Singularize().A())
+	}
+}
+
 // Slice
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStr_Slice_Go(t *testing.B) {
@@ -3883,6 +5241,59 @@ func TestStr_Slice(t *testing.T) {
 	}
 }
 
+// Slugify
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_Slugify() {
+	fmt.Println(NewStr("Héllo Wörld!").Slugify())
+	// Output: hello-world
+}
+
+func TestStr_Slugify(t *testing.T) {
+
+	// nil or empty
+	{
+		var str *Str
+		assert.Equal(t, NewStrV(), str.Slugify())
+		assert.Equal(t, "", NewStr("").Slugify().A())
+		assert.Equal(t, "", NewStr("!?").Slugify().A())
+	}
+
+	// punctuation and white space
+	{
+		assert.Equal(t, "release-notes-v1-2", NewStr("  Release notes: v1.2!").Slugify().A())
+		assert.Equal(t, "release-notes", NewStr("ReleaseNotes").Slugify().A())
+	}
+
+	// transliteration
+	{
+		assert.Equal(t, "creme-brulee", NewStr("Crème Brûlée").Slugify().A())
+		assert.Equal(t, "uber-strasse", NewStr("Über Straße").Slugify().A())
+		assert.Equal(t, "smorrebrod-aeble", NewStr("Smørrebrød æble").Slugify().A())
+		assert.Equal(t, "lodz", NewStr("Łódź").Slugify().A())
+	}
+
+	// non latin is dropped
+	{
+		assert.Equal(t, "hello", NewStr("hello \u4e16\u754c").Slugify().A())
+	}
+}
+
+// SnakeCase
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_SnakeCase() {
+	fmt.Println(NewStr("HTTPServerName").SnakeCase())
+	// Output: http_server_name
+}
+
+func TestStr_SnakeCase(t *testing.T) {
+	assert.Equal(t, NewStrV(), (*Str)(nil).SnakeCase())
+	assert.Equal(t, "", NewStr("").SnakeCase().A())
+	assert.Equal(t, "http_server_name", NewStr("HTTPServerName").SnakeCase().A())
+	assert.Equal(t, "dry_run", NewStr("dry-run").SnakeCase().A())
+	assert.Equal(t, "already_snake", NewStr("already_snake").SnakeCase().A())
+	assert.Equal(t, "user_id_2", NewStr("user ID 2").SnakeCase().A())
+}
+
 // Sort
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStr_Sort_Go(t *testing.B) {
@@ -3923,6 +5334,66 @@ func TestStr_Sort(t *testing.T) {
 	}
 }
 
+// SortBy
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_SortBy() {
+	slice := NewStrV("132")
+	fmt.Println(slice.SortBy(func(a, b O) bool {
+		return ToInt(a.(*Char).String()) > ToInt(b.(*Char).String())
+	}))
+	// Output: 321
+}
+
+func TestStr_SortBy(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, NewStrV(""), slice.SortBy(func(a, b O) bool { return false }))
+		assert.Equal(t, NewStrV(""), NewStrV("").SortBy(func(a, b O) bool { return false }))
+	}
+
+	// descending order in a new slice
+	{
+		slice := NewStrV("13254")
+		new := slice.SortBy(func(a, b O) bool {
+			return ToInt(a.(*Char).String()) > ToInt(b.(*Char).String())
+		})
+		assert.Equal(t, "54321", new.A())
+		assert.Equal(t, "13254", slice.A())
+	}
+}
+
+// SortByM
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_SortByM() {
+	slice := NewStrV("132")
+	slice.SortByM(func(a, b O) bool {
+		return ToInt(a.(*Char).String()) > ToInt(b.(*Char).String())
+	})
+	fmt.Println(slice)
+	// Output: 321
+}
+
+func TestStr_SortByM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, (*Str)(nil), slice.SortByM(func(a, b O) bool { return false }))
+		assert.Equal(t, NewStrV(""), NewStrV("").SortByM(func(a, b O) bool { return false }))
+	}
+
+	// descending order in place
+	{
+		slice := NewStrV("13254")
+		slice.SortByM(func(a, b O) bool {
+			return ToInt(a.(*Char).String()) > ToInt(b.(*Char).String())
+		})
+		assert.Equal(t, "54321", slice.A())
+	}
+}
+
 // SortM
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStr_SortM_Go(t *testing.B) {
@@ -4043,6 +5514,66 @@ func TestStr_SortReverseM(t *testing.T) {
 	}
 }
 
+// SortStable
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_SortStable() {
+	slice := NewStrV("41325")
+	fmt.Println(slice.SortStable(func(a, b O) bool {
+		return ToInt(a.(*Char).String())%2 < ToInt(b.(*Char).String())%2
+	}))
+	// Output: 42135
+}
+
+func TestStr_SortStable(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, NewStrV(""), slice.SortStable(func(a, b O) bool { return false }))
+		assert.Equal(t, NewStrV(""), NewStrV("").SortStable(func(a, b O) bool { return false }))
+	}
+
+	// equal elements keep their order
+	{
+		slice := NewStrV("54132")
+		new := slice.SortStable(func(a, b O) bool {
+			return ToInt(a.(*Char).String())%2 < ToInt(b.(*Char).String())%2
+		})
+		assert.Equal(t, "42513", new.A())
+		assert.Equal(t, "54132", slice.A())
+	}
+}
+
+// SortStableM
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_SortStableM() {
+	slice := NewStrV("41325")
+	slice.SortStableM(func(a, b O) bool {
+		return ToInt(a.(*Char).String())%2 < ToInt(b.(*Char).String())%2
+	})
+	fmt.Println(slice)
+	// Output: 42135
+}
+
+func TestStr_SortStableM(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, (*Str)(nil), slice.SortStableM(func(a, b O) bool { return false }))
+		assert.Equal(t, NewStrV(""), NewStrV("").SortStableM(func(a, b O) bool { return false }))
+	}
+
+	// equal elements keep their order
+	{
+		slice := NewStrV("54132")
+		slice.SortStableM(func(a, b O) bool {
+			return ToInt(a.(*Char).String())%2 < ToInt(b.(*Char).String())%2
+		})
+		assert.Equal(t, "42513", slice.A())
+	}
+}
+
 // Split
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStr_Split_Go(t *testing.B) {
@@ -4186,6 +5717,37 @@ func TestStr_SplitDouble(t *testing.T) {
 	}
 }
 
+// SplitRegex
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_SplitRegex() {
+	fmt.Println(A("a, b;c").SplitRegex(`[,;]\s*`))
+	// Output: [a b c]
+}
+
+func TestStr_SplitRegex(t *testing.T) {
+
+	// nil or empty
+	{
+		var str *Str
+		assert.Equal(t, NewStringSliceV(), str.SplitRegex(`,`))
+		assert.Equal(t, NewStringSliceV(), A("").SplitRegex(`,`))
+	}
+
+	// split
+	{
+		assert.Equal(t, NewStringSliceV("a", "b", "c"), A("a1b22c").SplitRegex(`\d+`))
+		assert.Equal(t, NewStringSliceV("", "a", ""), A(",a,").SplitRegex(`,`))
+		assert.Equal(t, NewStringSliceV("abc"), A("abc").SplitRegex(`\d`))
+	}
+
+	// invalid
+	{
+		slice, err := A("a").SplitRegexE(`*`)
+		assert.Equal(t, NewStringSliceV(), slice)
+		assert.NotNil(t, err)
+	}
+}
+
 // String
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStr_String_Go(t *testing.B) {
@@ -4286,6 +5848,36 @@ func TestStr_Swap(t *testing.T) {
 	}
 }
 
+// SymmetricDifference
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_SymmetricDifference() {
+	slice := NewStrV("abcb")
+	fmt.Println(slice.SymmetricDifference("bd").O())
+	// Output: acd
+}
+
+func TestStr_SymmetricDifference(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, "ab", slice.SymmetricDifference("abb").O())
+		assert.Equal(t, "ab", NewStrV("abb").SymmetricDifference("").O())
+	}
+
+	// uniq elements from both not in the other while preserving order
+	{
+		slice := NewStrV("abcc")
+		assert.Equal(t, "ad", slice.SymmetricDifference("dcbd").O())
+		assert.Equal(t, "abcc", slice.O())
+	}
+
+	// other is a Slice with the same elements
+	{
+		assert.Equal(t, "", NewStrV("ab").SymmetricDifference(NewStrV("ba")).O())
+	}
+}
+
 // Take
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStr_Take_Go(t *testing.B) {
@@ -4656,8 +6248,16 @@ func ExampleStr_Title() {
 }
 
 func TestStr_Title(t *testing.T) {
+	assert.Equal(t, NewStrV(), (*Str)(nil).Title())
 	assert.Equal(t, "This Is A Test", NewStr("this is a test").Title().A())
 	assert.Equal(t, "This   Is   A   Test", NewStr("this   is   a   test").Title().A())
+
+	// unicode punctuation and apostrophes
+	{
+		assert.Equal(t, "Don't Stop", NewStr("don't stop").Title().A())
+		assert.Equal(t, "«Élan» — Über", NewStr("«élan» — über").Title().A())
+		assert.Equal(t, "Foo_bar Baz-Qux", NewStr("foo_bar baz-qux").Title().A())
+	}
 }
 
 // ToLower
@@ -4956,6 +6556,50 @@ func TestStr_TrimSuffix(t *testing.T) {
 	assert.Equal(t, " This   Is   A", NewStr(" This   Is   A   Test  ").TrimSuffix("   Test  ").A())
 }
 
+// Truncate
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_Truncate() {
+	fmt.Println(NewStr("Hello World").Truncate(8, "…"))
+	// Output: Hello W…
+}
+
+func TestStr_Truncate(t *testing.T) {
+
+	// nil or empty
+	{
+		var str *Str
+		assert.Equal(t, NewStrV(), str.Truncate(5, "…"))
+		assert.Equal(t, NewStrV(), NewStr("").Truncate(5, "…"))
+		assert.Equal(t, NewStrV(), NewStr("abc").Truncate(0, "…"))
+	}
+
+	// fits
+	{
+		str := NewStr("abc")
+		assert.Equal(t, "abc", str.Truncate(3, "…").A())
+		assert.Equal(t, "abc", str.Truncate(5, "...").A())
+	}
+
+	// truncated
+	{
+		assert.Equal(t, "ab…", NewStr("abcdef").Truncate(3, "…").A())
+		assert.Equal(t, "a...", NewStr("abcdef").Truncate(4, "...").A())
+		assert.Equal(t, "abc", NewStr("abcdef").Truncate(3, "").A())
+	}
+
+	// tail wider than width is dropped
+	{
+		assert.Equal(t, "ab", NewStr("abcdef").Truncate(2, "...").A())
+	}
+
+	// wide characters and graphemes are never split
+	{
+		assert.Equal(t, "\u4e16\u754c…", NewStr("\u4e16\u754c\u4f60\u597d").Truncate(6, "…").A())
+		assert.Equal(t, "\u4e16…", NewStr("\u4e16\u754c\u4f60\u597d").Truncate(4, "…").A())
+		assert.Equal(t, "e\u0301e\u0301…", NewStr("e\u0301e\u0301e\u0301e\u0301").Truncate(3, "…").A())
+	}
+}
+
 // Union
 //--------------------------------------------------------------------------------------------------
 func BenchmarkStr_Union_Go(t *testing.B) {
@@ -5229,3 +6873,182 @@ func TestStr_UniqM(t *testing.T) {
 		assert.Equal(t, NewStrV("1", "2", "3", "4"), uniq)
 	}
 }
+
+
+// Window
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_Window() {
+	slice := NewStrV("12345")
+	slice.Window(3, 1).Each(func(x O) {
+		fmt.Println(x)
+	})
+	// Output: 123
+	// 234
+	// 345
+}
+
+func TestStr_Window(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, 0, slice.Window(2, 1).Len())
+		assert.Equal(t, 0, NewStrV().Window(2, 1).Len())
+	}
+
+	// invalid size or step
+	{
+		assert.Equal(t, 0, NewStrV("12345").Window(0, 1).Len())
+		assert.Equal(t, 0, NewStrV("12345").Window(2, 0).Len())
+		assert.Equal(t, 0, NewStrV("12345").Window(6, 1).Len())
+	}
+
+	// sliding by one
+	{
+		windows := NewStrV("12345").Window(3, 1)
+		assert.Equal(t, 3, windows.Len())
+		assert.Equal(t, "123", windows.At(0).O().(ISlice).A())
+		assert.Equal(t, "234", windows.At(1).O().(ISlice).A())
+		assert.Equal(t, "345", windows.At(2).O().(ISlice).A())
+	}
+
+	// partial windows are dropped
+	{
+		windows := NewStrV("12345").Window(2, 2)
+		assert.Equal(t, 2, windows.Len())
+		assert.Equal(t, "12", windows.At(0).O().(ISlice).A())
+		assert.Equal(t, "34", windows.At(1).O().(ISlice).A())
+	}
+}
+
+// Width
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_Width() {
+	fmt.Println(NewStr("Go").Width(), NewStr("\u4e16\u754c").Width())
+	// Output: 2 4
+}
+
+func TestStr_Width(t *testing.T) {
+
+	// nil or empty
+	{
+		var str *Str
+		assert.Equal(t, 0, str.Width())
+		assert.Equal(t, 0, NewStr("").Width())
+	}
+
+	// ascii and zero width
+	{
+		assert.Equal(t, 5, NewStr("hello").Width())
+		assert.Equal(t, 4, NewStr("cafe\u0301").Width())
+		assert.Equal(t, 2, NewStr("a\u200bb").Width())
+		assert.Equal(t, 2, NewStr("a\tb").Width())
+	}
+
+	// east asian wide and fullwidth
+	{
+		assert.Equal(t, 4, NewStr("\u4e16\u754c").Width())
+		assert.Equal(t, 2, NewStr("\uff21").Width())
+		assert.Equal(t, 2, NewStr("\uac01").Width())
+		assert.Equal(t, 2, NewStr("\u1100\u1161\u11a8").Width())
+	}
+
+	// emoji
+	{
+		assert.Equal(t, 2, NewStr("\U0001F600").Width())
+		assert.Equal(t, 2, NewStr("\U0001F44D\U0001F3FD").Width())
+		assert.Equal(t, 2, NewStr("\U0001F468\u200d\U0001F469\u200d\U0001F467").Width())
+		assert.Equal(t, 2, NewStr("\u2764\ufe0f").Width())
+		assert.Equal(t, 2, NewStr("\U0001F1FA\U0001F1F8").Width())
+	}
+}
+
+// Wrap
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_Wrap() {
+	fmt.Println(NewStr("The quick brown fox jumps over the lazy dog").Wrap(16, "", "  "))
+	// Output:
+	// The quick brown
+	//   fox jumps over
+	//   the lazy dog
+}
+
+func TestStr_Wrap(t *testing.T) {
+
+	// nil or empty
+	{
+		var str *Str
+		assert.Equal(t, NewStrV(), str.Wrap(10))
+		assert.Equal(t, NewStrV(), NewStr("").Wrap(10))
+	}
+
+	// wrapping
+	{
+		assert.Equal(t, "one two\nthree", NewStr("one two three").Wrap(7).A())
+		assert.Equal(t, "one two\nthree", NewStr("one two three").Wrap(12).A())
+		assert.Equal(t, "one two three", NewStr("one two three").Wrap(13).A())
+		assert.Equal(t, "one two three", NewStr("  one   two\tthree  ").Wrap(80).A())
+	}
+
+	// width disabled
+	{
+		assert.Equal(t, "one two three", NewStr("one two three").Wrap(0).A())
+		assert.Equal(t, "> one two", NewStr("one two").Wrap(-1, "> ").A())
+	}
+
+	// long words are kept whole
+	{
+		assert.Equal(t, "a\nsupercalifragilistic\nb", NewStr("a supercalifragilistic b").Wrap(5).A())
+	}
+
+	// line breaks and paragraphs are kept
+	{
+		assert.Equal(t, "one\ntwo\n\nthree\n", NewStr("one\ntwo\n\nthree\n").Wrap(10).A())
+	}
+
+	// indent and hanging indent
+	{
+		assert.Equal(t, "  one two\n  three", NewStr("one two three").Wrap(9, "  ").A())
+		assert.Equal(t, "- one two\n  three\n\n- four", NewStr("one two three\n\nfour").Wrap(9, "- ", "  ").A())
+	}
+
+	// display width
+	{
+		assert.Equal(t, "\u4e16\u754c\n\u4f60\u597d", NewStr("\u4e16\u754c \u4f60\u597d").Wrap(6).A())
+		assert.Equal(t, "caf\u00e9 caf\u00e9", NewStr("caf\u00e9 caf\u00e9").Wrap(9).A())
+	}
+}
+
+// Zip
+//--------------------------------------------------------------------------------------------------
+func ExampleStr_Zip() {
+	slice := NewStrV("123")
+	fmt.Println(slice.Zip([]string{"a", "b", "c"}))
+	// Output: [[1 a] [2 b] [3 c]]
+}
+
+func TestStr_Zip(t *testing.T) {
+
+	// nil or empty
+	{
+		var slice *Str
+		assert.Equal(t, 0, slice.Zip([]string{"a"}).Len())
+		assert.Equal(t, 0, NewStrV().Zip([]string{"a"}).Len())
+		assert.Equal(t, 0, NewStrV("123").Zip(nil).Len())
+	}
+
+	// shorter of the two
+	{
+		pairs := NewStrV("12345").Zip([]string{"a", "b"})
+		assert.Equal(t, 2, pairs.Len())
+		assert.Equal(t, []interface{}{ToChar('1'), "a"}, pairs.At(0).O().(ISlice).O())
+		assert.Equal(t, []interface{}{ToChar('2'), "b"}, pairs.At(1).O().(ISlice).O())
+	}
+
+	// Slice given
+	{
+		pairs := NewStrV("123").Zip(NewIntSliceV(7, 8, 9, 10))
+		assert.Equal(t, 3, pairs.Len())
+		assert.Equal(t, []interface{}{ToChar('3'), 9}, pairs.At(2).O().(ISlice).O())
+	}
+}
@@ -0,0 +1,295 @@
+package n
+
+import (
+	"context"
+	"io"
+	"reflect"
+	"sync"
+
+	"github.com/phR0ze/n/pkg/sys"
+	"github.com/pkg/errors"
+)
+
+// Stream provides a lazy channel backed sequence of elements for working with unbounded inputs
+// using the familiar Slice idioms. Elements are pulled through the chained operations one at a time
+// as the terminal operation consumes them which provides natural backpressure, and the whole
+// pipeline can be cancelled via the given context. A Stream can only be consumed once.
+type Stream struct {
+	ctx    context.Context    // parent context given by the caller
+	done   context.Context    // pipeline context cancelled on Close
+	cancel context.CancelFunc // cancels the pipeline context
+	next   func() (O, bool)   // pulls the next element if one exists
+	err    *streamErr         // first error encountered by the pipeline
+}
+
+// streamErr tracks the first error encountered by a Stream pipeline
+type streamErr struct {
+	sync.Mutex
+	err error
+}
+
+// NewStream creates a new Stream from the given source. Supports receive channels of any element
+// type, io.Reader which is streamed line by line, generator lambdas of type func() (O, bool) which
+// return false once exhausted, Iterators whose error fails the Stream, Slices and Go slices. A nil
+// context defaults to context.Background.
+func NewStream(ctx context.Context, obj interface{}) (new *Stream) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	new = &Stream{ctx: ctx, err: &streamErr{}}
+	new.done, new.cancel = context.WithCancel(ctx)
+
+	switch x := obj.(type) {
+	case nil:
+		new.next = func() (O, bool) { return nil, false }
+	case func() (O, bool):
+		new.next = func() (O, bool) {
+			if new.done.Err() != nil {
+				return nil, false
+			}
+			return x()
+		}
+	case Iterator:
+		new.next = func() (O, bool) {
+			if new.done.Err() != nil {
+				return nil, false
+			}
+			if !x.Next() {
+				if err := x.Err(); err != nil {
+					new.fail(err)
+				}
+				return nil, false
+			}
+			return x.Value(), true
+		}
+	case io.Reader:
+		ch := make(chan O)
+		go func() {
+			defer close(ch)
+			if err := sys.EachLine(x, func(line string) error {
+				select {
+				case ch <- line:
+					return nil
+				case <-new.done.Done():
+					return new.done.Err()
+				}
+			}); err != nil && new.done.Err() == nil {
+				new.fail(err)
+			}
+		}()
+		new.next = new.recv(reflect.ValueOf(ch))
+	default:
+		v := reflect.ValueOf(obj)
+		switch {
+		case v.Kind() == reflect.Chan:
+			if v.Type().ChanDir()&reflect.RecvDir == 0 {
+				new.fail(errors.Errorf("unable to stream from send only channel of type %T", obj))
+				new.next = func() (O, bool) { return nil, false }
+			} else {
+				new.next = new.recv(v)
+			}
+		default:
+			// Variadic elements are kept as is rather than converted to the first element's type
+			slice, ok := obj.(ISlice)
+			if x, inter := obj.([]interface{}); inter {
+				slice = NewInterSliceV(x...)
+			} else if !ok {
+				slice = Slice(obj)
+			}
+			i, l := 0, slice.Len()
+			new.next = func() (O, bool) {
+				if new.done.Err() != nil || i >= l {
+					return nil, false
+				}
+				i++
+				return slice.At(i - 1).O(), true
+			}
+		}
+	}
+	return
+}
+
+// NewStreamV creates a new Stream from the given variadic elements.
+func NewStreamV(elems ...interface{}) (new *Stream) {
+	return NewStream(context.Background(), elems)
+}
+
+// Close cancels this Stream releasing any resources held by the source. Terminal operations close
+// the Stream automatically, only streams that are abandoned before being consumed need closing.
+func (p *Stream) Close() {
+	if p == nil {
+		return
+	}
+	p.cancel()
+}
+
+// Count consumes this Stream and returns the number of elements. Check Err for failures.
+func (p *Stream) Count() (cnt int) {
+	p.Each(func(O) { cnt++ })
+	return
+}
+
+// Each consumes this Stream calling the given lambda once for each element and returns the first
+// error encountered by the Stream.
+func (p *Stream) Each(action func(O)) (err error) {
+	return p.EachE(func(x O) error {
+		action(x)
+		return nil
+	})
+}
+
+// EachE consumes this Stream calling the given lambda once for each element. Iteration stops and
+// the Stream is closed on the first error from the lambda which is returned else the first error
+// encountered by the Stream is returned.
+func (p *Stream) EachE(action func(O) error) (err error) {
+	if p == nil {
+		return
+	}
+	defer p.Close()
+	for {
+		x, ok := p.next()
+		if !ok {
+			break
+		}
+		if err = action(x); err != nil {
+			return
+		}
+	}
+	return p.Err()
+}
+
+// Err returns the first error encountered by this Stream's source or the parent context's error
+// if the parent context was cancelled.
+func (p *Stream) Err() (err error) {
+	if p == nil {
+		return
+	}
+	p.err.Lock()
+	err = p.err.err
+	p.err.Unlock()
+	if err == nil {
+		err = p.ctx.Err()
+	}
+	return
+}
+
+// First consumes the first element of this Stream returning it as an Object and closes the Stream.
+func (p *Stream) First() (elem *Object) {
+	elem = &Object{}
+	if p == nil {
+		return
+	}
+	defer p.Close()
+	if x, ok := p.next(); ok {
+		elem.o = x
+	}
+	return
+}
+
+// Map lazily creates a new Stream with the modified elements from the lambda.
+func (p *Stream) Map(mod func(O) O) (new *Stream) {
+	if p == nil {
+		return NewStreamV()
+	}
+	return p.chain(func() (O, bool) {
+		x, ok := p.next()
+		if !ok {
+			return nil, false
+		}
+		return mod(x), true
+	})
+}
+
+// Select lazily creates a new Stream with the elements that match the lambda selector.
+func (p *Stream) Select(sel func(O) bool) (new *Stream) {
+	if p == nil {
+		return NewStreamV()
+	}
+	return p.chain(func() (O, bool) {
+		for {
+			x, ok := p.next()
+			if !ok {
+				return nil, false
+			}
+			if sel(x) {
+				return x, true
+			}
+		}
+	})
+}
+
+// Slice consumes this Stream and returns the elements as a new Slice. Check Err for failures.
+func (p *Stream) Slice() (new ISlice) {
+	var vals []interface{}
+	p.Each(func(x O) { vals = append(vals, x) })
+	return newSliceOf(vals, NewInterSliceV())
+}
+
+// Take lazily creates a new Stream with at most the first n elements of this Stream.
+// No further elements are pulled from the source once n is reached.
+func (p *Stream) Take(n int) (new *Stream) {
+	if p == nil {
+		return NewStreamV()
+	}
+	i := 0
+	return p.chain(func() (O, bool) {
+		if i >= n {
+			return nil, false
+		}
+		i++
+		return p.next()
+	})
+}
+
+// Uniq lazily creates a new Stream with all non uniq elements removed while preserving element
+// order. The elements seen so far are tracked in a Set so memory grows with the number of uniq elements.
+func (p *Stream) Uniq() (new *Stream) {
+	if p == nil {
+		return NewStreamV()
+	}
+	seen := NewSetV()
+	return p.chain(func() (O, bool) {
+		for {
+			x, ok := p.next()
+			if !ok {
+				return nil, false
+			}
+			if seen.Add(x) {
+				return x, true
+			}
+		}
+	})
+}
+
+// chain creates a new Stream sharing this Stream's context and error tracking
+func (p *Stream) chain(next func() (O, bool)) *Stream {
+	return &Stream{ctx: p.ctx, done: p.done, cancel: p.cancel, next: next, err: p.err}
+}
+
+// fail records the given error if it is the first error encountered by this Stream
+func (p *Stream) fail(err error) {
+	p.err.Lock()
+	if p.err.err == nil {
+		p.err.err = err
+	}
+	p.err.Unlock()
+}
+
+// recv returns a lambda pulling the next element from the given channel until it is closed or
+// the Stream is cancelled
+func (p *Stream) recv(ch reflect.Value) func() (O, bool) {
+	cases := []reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(p.done.Done())},
+		{Dir: reflect.SelectRecv, Chan: ch},
+	}
+	return func() (O, bool) {
+		if p.done.Err() != nil {
+			return nil, false
+		}
+		i, x, ok := reflect.Select(cases)
+		if i == 0 || !ok {
+			return nil, false
+		}
+		return x.Interface(), true
+	}
+}
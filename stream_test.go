@@ -0,0 +1,378 @@
+package n
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// NewStream
+//--------------------------------------------------------------------------------------------------
+func ExampleNewStream() {
+	reader := strings.NewReader("error: disk\ninfo: ok\nerror: net\n")
+	stream := NewStream(context.Background(), reader)
+	fmt.Println(stream.Select(func(x O) bool { return strings.HasPrefix(x.(string), "error") }).Slice())
+	// Output: [error: disk error: net]
+}
+
+func TestNewStream(t *testing.T) {
+
+	// nil
+	{
+		stream := NewStream(nil, nil)
+		assert.Equal(t, 0, stream.Count())
+		assert.Nil(t, stream.Err())
+	}
+
+	// receive channel
+	{
+		ch := make(chan string, 3)
+		ch <- "1"
+		ch <- "2"
+		close(ch)
+		assert.Equal(t, NewStringSliceV("1", "2"), NewStream(nil, ch).Slice())
+		assert.Equal(t, 0, NewStream(nil, (<-chan string)(ch)).Count())
+	}
+
+	// send only channel
+	{
+		stream := NewStream(nil, make(chan<- int))
+		assert.Equal(t, 0, stream.Count())
+		assert.Equal(t, "unable to stream from send only channel of type chan<- int", stream.Err().Error())
+	}
+
+	// reader
+	{
+		stream := NewStream(nil, strings.NewReader("1\n2\n3"))
+		assert.Equal(t, NewStringSliceV("1", "2", "3"), stream.Slice())
+		assert.Nil(t, stream.Err())
+	}
+
+	// reader failure
+	{
+		stream := NewStream(nil, iotest.TimeoutReader(strings.NewReader("1\n2")))
+		assert.Equal(t, 1, stream.Count())
+		assert.Equal(t, "failed reading lines: timeout", stream.Err().Error())
+	}
+
+	// long lines
+	{
+		long := strings.Repeat("a", 70000)
+		stream := NewStream(nil, strings.NewReader(long+"\n"+long))
+		assert.Equal(t, NewStringSliceV(long, long), stream.Slice())
+		assert.Nil(t, stream.Err())
+	}
+
+	// generator
+	{
+		i := 0
+		stream := NewStream(nil, func() (O, bool) {
+			i++
+			return i, i <= 3
+		})
+		assert.Equal(t, NewIntSliceV(1, 2, 3), stream.Slice())
+	}
+
+	// iterator
+	{
+		stream := NewStream(nil, NewStringSliceV("1", "2").Iter())
+		assert.Equal(t, NewStringSliceV("1", "2"), stream.Slice())
+		assert.Nil(t, stream.Err())
+	}
+
+	// iterator failure
+	{
+		i := 0
+		stream := NewStream(nil, NewIteratorE(func() (O, bool, error) {
+			if i++; i > 2 {
+				return nil, false, errors.New("iterator failed")
+			}
+			return i, true, nil
+		}))
+		assert.Equal(t, 2, stream.Count())
+		assert.Equal(t, "iterator failed", stream.Err().Error())
+	}
+
+	// slices
+	{
+		assert.Equal(t, NewIntSliceV(1, 2), NewStream(nil, []int{1, 2}).Slice())
+		assert.Equal(t, NewIntSliceV(1, 2), NewStream(nil, NewIntSliceV(1, 2)).Slice())
+		assert.Equal(t, NewStringSliceV("a"), NewStream(nil, "a").Slice())
+	}
+}
+
+// NewStreamV
+//--------------------------------------------------------------------------------------------------
+func TestNewStreamV(t *testing.T) {
+	assert.Equal(t, NewInterSliceV(), NewStreamV().Slice())
+	assert.Equal(t, NewIntSliceV(1, 2), NewStreamV(1, 2).Slice())
+	assert.Equal(t, NewStringSliceV("1", "2"), NewStreamV("1", "2").Slice())
+}
+
+// Close
+//--------------------------------------------------------------------------------------------------
+func TestStream_Close(t *testing.T) {
+	(*Stream)(nil).Close()
+
+	// source goroutine is released
+	{
+		reader, writer := io.Pipe()
+		stream := NewStream(nil, reader)
+		go func() {
+			for {
+				if _, err := writer.Write([]byte("line\n")); err != nil {
+					return
+				}
+			}
+		}()
+		assert.Equal(t, NewStringSliceV("line", "line"), stream.Take(2).Slice())
+		stream.Close()
+		assert.Nil(t, stream.Err())
+		reader.Close()
+	}
+
+	// closed stream has no more elements
+	{
+		stream := NewStreamV(1, 2, 3)
+		stream.Close()
+		assert.Equal(t, 0, stream.Count())
+		assert.Nil(t, stream.Err())
+	}
+}
+
+// Count
+//--------------------------------------------------------------------------------------------------
+func TestStream_Count(t *testing.T) {
+	assert.Equal(t, 0, (*Stream)(nil).Count())
+	assert.Equal(t, 0, NewStreamV().Count())
+	assert.Equal(t, 3, NewStreamV(1, 2, 3).Count())
+}
+
+// Each
+//--------------------------------------------------------------------------------------------------
+func ExampleStream_Each() {
+	NewStream(nil, strings.NewReader("1\n2\n3")).Each(func(x O) {
+		fmt.Print(x)
+	})
+	// Output: 123
+}
+
+func TestStream_Each(t *testing.T) {
+
+	// nil or empty
+	{
+		assert.Nil(t, (*Stream)(nil).Each(func(x O) {}))
+		assert.Nil(t, NewStreamV().Each(func(x O) {}))
+	}
+
+	// cancelled by parent context
+	{
+		ch := make(chan int)
+		go func() {
+			for i := 0; ; i++ {
+				ch <- i
+			}
+		}()
+		var results []O
+		ctx, cancel := context.WithCancel(context.Background())
+		err := NewStream(ctx, ch).Each(func(x O) {
+			results = append(results, x)
+			if len(results) == 2 {
+				cancel()
+			}
+		})
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, []O{0, 1}, results)
+	}
+
+	// happy
+	{
+		var results []O
+		assert.Nil(t, NewStreamV(1, 2, 3).Each(func(x O) {
+			results = append(results, x)
+		}))
+		assert.Equal(t, []O{1, 2, 3}, results)
+	}
+}
+
+// EachE
+//--------------------------------------------------------------------------------------------------
+func TestStream_EachE(t *testing.T) {
+
+	// nil or empty
+	{
+		assert.Nil(t, (*Stream)(nil).EachE(func(x O) error { return nil }))
+		assert.Nil(t, NewStreamV().EachE(func(x O) error { return nil }))
+	}
+
+	// stop on lambda error
+	{
+		var results []O
+		err := NewStreamV(1, 2, 3).EachE(func(x O) error {
+			if x == 2 {
+				return errors.New("stop")
+			}
+			results = append(results, x)
+			return nil
+		})
+		assert.Equal(t, "stop", err.Error())
+		assert.Equal(t, []O{1}, results)
+	}
+}
+
+// Err
+//--------------------------------------------------------------------------------------------------
+func TestStream_Err(t *testing.T) {
+	assert.Nil(t, (*Stream)(nil).Err())
+	assert.Nil(t, NewStreamV(1).Err())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	stream := NewStream(ctx, []int{1, 2})
+	assert.Equal(t, 0, stream.Count())
+	assert.Equal(t, context.Canceled, stream.Err())
+}
+
+// First
+//--------------------------------------------------------------------------------------------------
+func TestStream_First(t *testing.T) {
+	assert.Nil(t, (*Stream)(nil).First().O())
+	assert.Nil(t, NewStreamV().First().O())
+
+	// only the first element is pulled
+	{
+		pulled := 0
+		stream := NewStream(nil, func() (O, bool) {
+			pulled++
+			return pulled, true
+		})
+		assert.Equal(t, 1, stream.First().O())
+		assert.Equal(t, 1, pulled)
+		assert.Equal(t, 0, stream.Count())
+	}
+
+	// reader
+	{
+		assert.Equal(t, "1", NewStream(nil, strings.NewReader("1\n2\n")).First().O())
+	}
+}
+
+// Map
+//--------------------------------------------------------------------------------------------------
+func ExampleStream_Map() {
+	stream := NewStreamV(1, 2, 3)
+	fmt.Println(stream.Map(func(x O) O { return x.(int) * 2 }).Slice())
+	// Output: [2 4 6]
+}
+
+func TestStream_Map(t *testing.T) {
+	assert.Equal(t, 0, (*Stream)(nil).Map(func(x O) O { return x }).Count())
+	assert.Equal(t, 0, NewStreamV().Map(func(x O) O { return x }).Count())
+
+	// lazy evaluation
+	{
+		calls := 0
+		stream := NewStreamV(1, 2, 3).Map(func(x O) O {
+			calls++
+			return ToString(x)
+		})
+		assert.Equal(t, 0, calls)
+		assert.Equal(t, "1", stream.First().O())
+		assert.Equal(t, 1, calls)
+	}
+
+	// type change
+	{
+		stream := NewStreamV(1, 2, 3).Map(func(x O) O { return ToString(x) })
+		assert.Equal(t, NewStringSliceV("1", "2", "3"), stream.Slice())
+	}
+}
+
+// Select
+//--------------------------------------------------------------------------------------------------
+func ExampleStream_Select() {
+	stream := NewStreamV(1, 2, 3)
+	fmt.Println(stream.Select(func(x O) bool { return x.(int) > 1 }).Slice())
+	// Output: [2 3]
+}
+
+func TestStream_Select(t *testing.T) {
+	assert.Equal(t, 0, (*Stream)(nil).Select(func(x O) bool { return true }).Count())
+	assert.Equal(t, 0, NewStreamV().Select(func(x O) bool { return true }).Count())
+
+	assert.Equal(t, NewIntSliceV(2, 4), NewStreamV(1, 2, 3, 4).Select(func(x O) bool {
+		return x.(int)%2 == 0
+	}).Slice())
+	assert.Equal(t, NewInterSliceV(), NewStreamV(1, 2, 3).Select(func(x O) bool {
+		return false
+	}).Slice())
+}
+
+// Slice
+//--------------------------------------------------------------------------------------------------
+func TestStream_Slice(t *testing.T) {
+	assert.Equal(t, NewInterSliceV(), (*Stream)(nil).Slice())
+	assert.Equal(t, NewInterSliceV(), NewStreamV().Slice())
+	assert.Equal(t, NewFloatSliceV(1.5, 2.5), NewStreamV(1.5, 2.5).Slice())
+
+	// mixed types are kept as is
+	assert.Equal(t, NewInterSliceV(1, "a", 2), NewStreamV(1, "a", 2).Slice())
+	assert.Equal(t, NewInterSliceV([]int{1}, []int{2}), NewStreamV([]int{1}, []int{2}).Slice())
+}
+
+// Take
+//--------------------------------------------------------------------------------------------------
+func ExampleStream_Take() {
+	i := 0
+	stream := NewStream(nil, func() (O, bool) {
+		i++
+		return i, true
+	})
+	fmt.Println(stream.Take(3).Slice())
+	// Output: [1 2 3]
+}
+
+func TestStream_Take(t *testing.T) {
+	assert.Equal(t, 0, (*Stream)(nil).Take(1).Count())
+	assert.Equal(t, 0, NewStreamV().Take(1).Count())
+	assert.Equal(t, 0, NewStreamV(1, 2).Take(0).Count())
+	assert.Equal(t, 0, NewStreamV(1, 2).Take(-1).Count())
+	assert.Equal(t, NewIntSliceV(1, 2), NewStreamV(1, 2).Take(5).Slice())
+
+	// backpressure only pulls what is needed
+	{
+		pulled := 0
+		stream := NewStream(nil, func() (O, bool) {
+			pulled++
+			return pulled, true
+		})
+		assert.Equal(t, NewIntSliceV(2, 4), stream.Select(func(x O) bool { return x.(int)%2 == 0 }).Take(2).Slice())
+		assert.Equal(t, 4, pulled)
+	}
+}
+
+// Uniq
+//--------------------------------------------------------------------------------------------------
+func ExampleStream_Uniq() {
+	stream := NewStream(nil, strings.NewReader("a\nb\na\nc\nb"))
+	fmt.Println(stream.Uniq().Slice())
+	// Output: [a b c]
+}
+
+func TestStream_Uniq(t *testing.T) {
+	assert.Equal(t, 0, (*Stream)(nil).Uniq().Count())
+	assert.Equal(t, 0, NewStreamV().Uniq().Count())
+	assert.Equal(t, NewIntSliceV(3, 1, 2), NewStreamV(3, 1, 3, 2, 1).Uniq().Slice())
+	assert.Equal(t, NewIntSliceV(1, 2), NewStreamV(1, 2, 1, 3).Uniq().Take(2).Slice())
+
+	// mixed types are kept distinct
+	var vals []interface{}
+	NewStream(context.Background(), NewInterSliceV(1, "a", "1", 1, int64(1), 2.5, "a")).Uniq().Each(func(x O) { vals = append(vals, x) })
+	assert.Equal(t, []interface{}{1, "a", "1", int64(1), 2.5}, vals)
+}
@@ -0,0 +1,284 @@
+package n
+
+import (
+	"unicode"
+)
+
+// NormForm identifies a Unicode normalization form
+type NormForm int
+
+const (
+	// NFC is the canonical decomposition followed by canonical composition e.g. 'e' followed by
+	// a combining acute accent becomes 'é'
+	NFC NormForm = iota
+
+	// NFD is the canonical decomposition e.g. 'é' becomes 'e' followed by a combining acute accent
+	NFD
+)
+
+// Hangul syllable types used for grapheme cluster boundaries
+const (
+	hangulNone = iota
+	hangulL
+	hangulV
+	hangulT
+	hangulLV
+	hangulLVT
+)
+
+// Hangul syllable constants used to algorithmically compose and decompose syllables
+const (
+	hangulSBase  = 0xAC00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11A7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulSCount = hangulLCount * hangulNCount
+)
+
+// graphemeEnd returns the index of the rune following the end of the user perceived character
+// i.e. extended grapheme cluster starting at the given index. This is a practical approximation of
+// the Unicode Standard Annex #29 rules covering CRLF, controls, combining marks, Hangul syllables,
+// regional indicator flags and emoji modifier and ZWJ sequences.
+func graphemeEnd(runes []rune, i int) int {
+	n := len(runes)
+	if i >= n {
+		return n
+	}
+	r, j := runes[i], i+1
+
+	// CR LF is a single cluster while other controls are always on their own
+	if r == '\r' {
+		if j < n && runes[j] == '\n' {
+			j++
+		}
+		return j
+	}
+	if graphemeControl(r) {
+		return j
+	}
+
+	// Regional indicators pair up to form flags and Hangul jamo combine into syllables
+	if graphemeRegional(r) {
+		if j < n && graphemeRegional(runes[j]) {
+			j++
+		}
+	} else if typ := graphemeHangul(r); typ != hangulNone {
+		for ; j < n; j++ {
+			next := graphemeHangul(runes[j])
+			if !(typ == hangulL && next != hangulNone && next != hangulT) &&
+				!((typ == hangulLV || typ == hangulV) && (next == hangulV || next == hangulT)) &&
+				!((typ == hangulLVT || typ == hangulT) && next == hangulT) {
+				break
+			}
+			typ = next
+		}
+	}
+
+	// Extending marks and emoji ZWJ sequences join the cluster
+	pict := graphemePictographic(r)
+	for j < n {
+		switch x := runes[j]; {
+		case graphemeExtend(x):
+			j++
+		case x == '\u200d':
+			j++
+			if pict && j < n && graphemePictographic(runes[j]) {
+				j++
+			}
+		default:
+			return j
+		}
+	}
+	return j
+}
+
+// graphemeControl checks if the given rune is a control that is never joined with other runes
+func graphemeControl(r rune) bool {
+	return r == '\n' || r == '\u2028' || r == '\u2029' || unicode.Is(unicode.Cc, r)
+}
+
+// graphemeExtend checks if the given rune extends the preceding rune e.g. combining marks,
+// variation selectors, emoji modifiers and tags
+func graphemeExtend(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) ||
+		(r >= 0x1F3FB && r <= 0x1F3FF) || (r >= 0xE0020 && r <= 0xE007F)
+}
+
+// graphemeHangul returns the Hangul syllable type of the given rune
+func graphemeHangul(r rune) int {
+	switch {
+	case (r >= 0x1100 && r <= 0x115F) || (r >= 0xA960 && r <= 0xA97C):
+		return hangulL
+	case (r >= 0x1160 && r <= 0x11A7) || (r >= 0xD7B0 && r <= 0xD7C6):
+		return hangulV
+	case (r >= 0x11A8 && r <= 0x11FF) || (r >= 0xD7CB && r <= 0xD7FB):
+		return hangulT
+	case r >= 0xAC00 && r <= 0xD7A3:
+		if (r-0xAC00)%28 == 0 {
+			return hangulLV
+		}
+		return hangulLVT
+	}
+	return hangulNone
+}
+
+// graphemePictographic checks if the given rune is an emoji or other pictographic symbol
+func graphemePictographic(r rune) bool {
+	switch {
+	case r == 0xA9 || r == 0xAE || r == 0x203C || r == 0x2049 || r == 0x2122 || r == 0x2139:
+		return true
+	case (r >= 0x2194 && r <= 0x21AA) || (r >= 0x2300 && r <= 0x23FF) || (r >= 0x2600 && r <= 0x27BF):
+		return true
+	case (r >= 0x2B00 && r <= 0x2BFF) || (r >= 0x1F000 && r <= 0x1FAFF):
+		return !graphemeRegional(r) && !(r >= 0x1F3FB && r <= 0x1F3FF)
+	}
+	return false
+}
+
+// graphemeRegional checks if the given rune is a regional indicator used to form flags
+func graphemeRegional(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+// graphemeWidth returns the display width in terminal cells of the given grapheme cluster which is
+// the width of its first visible rune or 2 for emoji presentation sequences and flags.
+func graphemeWidth(grapheme []rune) (width int) {
+	for _, r := range grapheme {
+		if width = runeWidth(r); width > 0 {
+			break
+		}
+	}
+	if width == 1 && len(grapheme) > 1 {
+		if graphemeRegional(grapheme[0]) {
+			return 2
+		}
+		for _, r := range grapheme[1:] {
+			if r == '\ufe0f' {
+				return 2
+			}
+		}
+	}
+	return
+}
+
+// runeWidth returns the display width in terminal cells of the given rune i.e. 0 for controls,
+// combining marks and other zero width runes, 2 for East Asian Wide and Fullwidth runes and 1 for
+// everything else.
+func runeWidth(r rune) int {
+	switch {
+	case unicode.In(r, unicode.Cc, unicode.Cf, unicode.Mn, unicode.Me):
+		return 0
+	case graphemeHangul(r) == hangulV || graphemeHangul(r) == hangulT:
+		return 0
+	case unicode.Is(unicodeWide, r):
+		return 2
+	}
+	return 1
+}
+
+// foldRunes returns the full Unicode case folding of the given runes for caseless matching e.g.
+// 'ß' folds to "ss". Runes are folded to the smallest rune of their simple case folding orbit.
+func foldRunes(runes []rune) []rune {
+	result := make([]rune, 0, len(runes))
+	for _, r := range runes {
+		if fold, ok := unicodeFold[r]; ok {
+			for _, x := range fold {
+				result = append(result, foldRune(x))
+			}
+		} else {
+			result = append(result, foldRune(r))
+		}
+	}
+	return result
+}
+
+// foldRune returns the smallest rune equivalent to the given rune under simple case folding
+func foldRune(r rune) rune {
+	min := r
+	for x := unicode.SimpleFold(r); x != r; x = unicode.SimpleFold(x) {
+		if x < min {
+			min = x
+		}
+	}
+	return min
+}
+
+// normalize returns the given runes in the given Unicode normalization form
+func normalize(runes []rune, form NormForm) []rune {
+	if form == NFD {
+		return decomposeRunes(runes)
+	}
+	return composeRunes(decomposeRunes(runes))
+}
+
+// decomposeRunes returns the canonical decomposition of the given runes with combining marks in
+// canonical order.
+func decomposeRunes(runes []rune) []rune {
+	result := make([]rune, 0, len(runes))
+	for _, r := range runes {
+		if s := r - hangulSBase; s >= 0 && s < hangulSCount {
+			result = append(result, hangulLBase+s/hangulNCount, hangulVBase+(s%hangulNCount)/hangulTCount)
+			if t := s % hangulTCount; t != 0 {
+				result = append(result, hangulTBase+t)
+			}
+		} else if decomp, ok := unicodeDecomposition[r]; ok {
+			result = append(result, decomp...)
+		} else {
+			result = append(result, r)
+		}
+	}
+
+	// Stable sort sequences of combining marks by their combining class
+	for i := 1; i < len(result); i++ {
+		ccc := unicodeCombining[result[i]]
+		for j := i; ccc != 0 && j > 0 && unicodeCombining[result[j-1]] > ccc; j-- {
+			result[j], result[j-1] = result[j-1], result[j]
+		}
+	}
+	return result
+}
+
+// composeRunes returns the canonical composition of the given canonically decomposed runes
+func composeRunes(runes []rune) []rune {
+	result := make([]rune, 0, len(runes))
+	starter, last := -1, uint8(0)
+	for _, r := range runes {
+		ccc := unicodeCombining[r]
+
+		// Compose with the last starter unless blocked by a mark of the same or higher class
+		if starter != -1 && (starter == len(result)-1 || (last != 0 && last < ccc)) {
+			if composite, ok := composeRune(result[starter], r); ok {
+				result[starter] = composite
+				continue
+			}
+		}
+		if ccc == 0 {
+			starter = len(result)
+		}
+		result = append(result, r)
+		last = ccc
+	}
+	return result
+}
+
+// composeRune returns the primary composite of the given pair of runes if one exists
+func composeRune(a, b rune) (rune, bool) {
+	if l, v := a-hangulLBase, b-hangulVBase; l >= 0 && l < hangulLCount && v >= 0 && v < hangulVCount {
+		return hangulSBase + (l*hangulVCount+v)*hangulTCount, true
+	}
+	if s, t := a-hangulSBase, b-hangulTBase; s >= 0 && s < hangulSCount && s%hangulTCount == 0 && t > 0 && t < hangulTCount {
+		return a + t, true
+	}
+	composite, ok := unicodeComposition[[2]rune{a, b}]
+	return composite, ok
+}
+
+// titleWordRune checks if the given rune is part of a word rather than a word separator for the
+// purposes of title casing
+func titleWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r) || r == '_' || r == '\'' || r == '\u2019'
+}